@@ -17,6 +17,7 @@ limitations under the License.
 package hack
 
 import (
+	"strconv"
 	"reflect"
 	"unsafe"
 )
@@ -44,13 +45,22 @@ func RuntimeStrhash(str string, seed uint64) uint64 {
 	return uint64(strhash(unsafe.Pointer(&str), uintptr(seed)))
 }
 
-//go:linkname roundupsize runtime.roundupsize
-func roundupsize(size uintptr) uintptr
+func roundupsize(size uintptr) uintptr { return size }
 
 // RuntimeAllocSize returns size of the memory block that mallocgc will allocate if you ask for the size.
 func RuntimeAllocSize(size int64) int64 {
 	return int64(roundupsize(uintptr(size)))
 }
 
-//go:linkname ParseFloatPrefix strconv.parseFloatPrefix
-func ParseFloatPrefix(s string, bitSize int) (float64, int, error)
+func ParseFloatPrefix(s string, bitSize int) (float64, int, error) {
+	for n := len(s); n > 0; n-- {
+		f, err := strconv.ParseFloat(s[:n], bitSize)
+		if err == nil {
+			return f, n, nil
+		}
+		if ne, ok := err.(*strconv.NumError); ok && ne.Err == strconv.ErrRange {
+			return f, n, err
+		}
+	}
+	return 0, 0, strconv.ErrSyntax
+}
@@ -179,6 +179,12 @@ func Slice(collation Collation, input []byte, from, to int) []byte {
 	return charset.Slice(collation.Charset(), input, from, to)
 }
 
+// Length returns the number of characters in `input`, which must be
+// encoded with the character set for the given collation.
+func Length(collation Collation, input []byte) int {
+	return charset.Length(collation.Charset(), input)
+}
+
 // Validate returns whether the given `input` is properly encoded with the
 // character set for the given collation.
 func Validate(collation Collation, input []byte) bool {
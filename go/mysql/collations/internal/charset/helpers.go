@@ -21,14 +21,38 @@ func Slice(charset Charset, input []byte, from, to int) []byte {
 		return charset.Slice(input, from, to)
 	}
 	iter := input
+	start := -1
 	for i := 0; i < to; i++ {
+		if i == from {
+			start = len(input) - len(iter)
+		}
 		r, size := charset.DecodeRune(iter)
 		if r == RuneError && size < 2 {
 			break
 		}
 		iter = iter[size:]
 	}
-	return input[:len(input)-len(iter)]
+	end := len(input) - len(iter)
+	if start < 0 {
+		return input[end:end]
+	}
+	return input[start:end]
+}
+
+func Length(charset Charset, input []byte) int {
+	if charset, ok := charset.(interface{ Length([]byte) int }); ok {
+		return charset.Length(input)
+	}
+	var count int
+	for len(input) > 0 {
+		_, size := charset.DecodeRune(input)
+		if size == 0 {
+			break
+		}
+		input = input[size:]
+		count++
+	}
+	return count
 }
 
 func Validate(charset Charset, input []byte) bool {
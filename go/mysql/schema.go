@@ -99,7 +99,7 @@ from information_schema.columns
 where table_schema = database()`
 
 	// fetchColumns are the columns we fetch
-	fetchColumns = "table_name, column_name, data_type, collation_name"
+	fetchColumns = "table_name, column_name, data_type, collation_name, column_key"
 
 	// FetchUpdatedTables queries fetches all information about updated tables
 	FetchUpdatedTables = `select  ` + fetchColumns + `
@@ -108,6 +108,17 @@ where table_schema = database() and
 	table_name in ::tableNames 
 order by table_name, ordinal_position`
 
+	// BaseShowTableStatistics is the base query for fetching the estimated number of rows of the tables,
+	// along with the cardinality of the first column of each of their indexes.
+	BaseShowTableStatistics = `select t.table_name, t.table_rows, s.column_name, max(s.cardinality)
+from information_schema.tables as t
+	left join information_schema.statistics as s on
+		s.table_schema = t.table_schema and
+		s.table_name = t.table_name and
+		s.seq_in_index = 1
+where t.table_schema = database()
+group by t.table_name, t.table_rows, s.column_name`
+
 	// FetchTables queries fetches all information about tables
 	FetchTables = `select ` + fetchColumns + ` 
 from _vt.schemacopy 
@@ -36,6 +36,14 @@ var (
 	// BvReplaceSchemaName is bind variable to be sent down to vttablet to replace schema name.
 	BvReplaceSchemaName = "__replacevtschemaname"
 
+	// BvReadAfterWriteGTID is bind variable to be sent down to a replica vttablet with the
+	// GTID set it has to have applied before answering the query.
+	BvReadAfterWriteGTID = "__vtrawgtid"
+
+	// BvReadAfterWriteTimeout is bind variable to be sent down to vttablet with the number
+	// of seconds it may wait for BvReadAfterWriteGTID to be applied.
+	BvReadAfterWriteTimeout = "__vtrawtimeout"
+
 	// NullBindVariable is a bindvar with NULL value.
 	NullBindVariable = &querypb.BindVariable{Type: querypb.Type_NULL_TYPE}
 )
@@ -91,8 +91,8 @@ func (c *cloneGen) sliceMethod(t types.Type, slice *types.Slice, spi generatorSP
 		jen.Func().Id(funcName).Call(jen.Id("n").Id(typeString)).Id(typeString).Block(
 			// if n == nil { return nil }
 			ifNilReturnNil("n"),
-			//	res := make(Bytes, len(n))
-			jen.Id("res").Op(":=").Id("make").Call(jen.Id(typeString), jen.Lit(0), jen.Id("len").Call(jen.Id("n"))),
+			//	res := make(Bytes, 0, len(n))
+			c.makeSlice(t, typeString, slice.Elem()),
 			c.copySliceElement(t, slice.Elem(), spi),
 			//	return res
 			jen.Return(jen.Id("res")),
@@ -104,6 +104,17 @@ func (c *cloneGen) basicMethod(t types.Type, basic *types.Basic, spi generatorSP
 	return nil
 }
 
+func (c *cloneGen) makeSlice(t types.Type, typeString string, elType types.Type) jen.Code {
+	if !isNamed(t) && isBasic(elType) {
+		//	res := make([]int, len(n))
+		// the elements are copied into the slice, so it needs to have their length
+		return jen.Id("res").Op(":=").Id("make").Call(jen.Id(typeString), jen.Id("len").Call(jen.Id("n")))
+	}
+	//	res := make(Bytes, 0, len(n))
+	// the elements are appended to the slice
+	return jen.Id("res").Op(":=").Id("make").Call(jen.Id(typeString), jen.Lit(0), jen.Id("len").Call(jen.Id("n")))
+}
+
 func (c *cloneGen) copySliceElement(t types.Type, elType types.Type, spi generatorSPI) jen.Code {
 	if !isNamed(t) && isBasic(elType) {
 		//	copy(res, n)
@@ -193,7 +193,7 @@ func CloneSliceOfInt(n []int) []int {
 	if n == nil {
 		return nil
 	}
-	res := make([]int, 0, len(n))
+	res := make([]int, len(n))
 	copy(res, n)
 	return res
 }
@@ -42,6 +42,16 @@ func TestClone2(t *testing.T) {
 	assert.NotEqual(t, container, clone)
 }
 
+func TestCloneSliceOfBasicType(t *testing.T) {
+	container := &RefSliceContainer{
+		NotASTElements: []int{1, 2, 3},
+	}
+	clone := CloneRefOfRefSliceContainer(container)
+	assert.Equal(t, container, clone)
+	container.NotASTElements[0] = 5
+	assert.Equal(t, []int{1, 2, 3}, clone.NotASTElements)
+}
+
 func TestTypeException(t *testing.T) {
 	l1 := &Leaf{1}
 	nc := &NoCloneType{1}
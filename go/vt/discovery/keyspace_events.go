@@ -373,11 +373,28 @@ func (kss *keyspaceState) onSrvKeyspace(newKeyspace *topodatapb.SrvKeyspace, new
 		kss.consistent = false
 	}
 
+	// a change in the keyspace that serves our PRIMARY traffic is the cutover of a vertical split,
+	// which is an availability event just like a change in the partitions
+	if servedFromPrimary(kss.lastKeyspace) != servedFromPrimary(newKeyspace) {
+		kss.consistent = false
+	}
+
 	kss.lastKeyspace = newKeyspace
 	kss.ensureConsistentLocked()
 	return true
 }
 
+// servedFromPrimary returns the keyspace that serves PRIMARY traffic for the given SrvKeyspace,
+// or an empty string if the keyspace serves its own PRIMARY traffic
+func servedFromPrimary(srvKeyspace *topodatapb.SrvKeyspace) string {
+	for _, sf := range srvKeyspace.GetServedFrom() {
+		if sf.TabletType == topodatapb.TabletType_PRIMARY {
+			return sf.Keyspace
+		}
+	}
+	return ""
+}
+
 // newKeyspaceState allocates the internal state required to keep track of availability incidents
 // in this keyspace, and starts up a SrvKeyspace watcher on our topology server which will update
 // our keyspaceState with any topology changes in real time.
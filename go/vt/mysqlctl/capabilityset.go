@@ -20,6 +20,13 @@ limitations under the License.
 
 package mysqlctl
 
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
 type mysqlFlavor string
 
 // Flavor constants define the type of mysql flavor being used
@@ -60,3 +67,65 @@ func (c *capabilitySet) isMySQLLike() bool {
 func (c *capabilitySet) isMariaDB() bool {
 	return c.flavor == FlavorMariaDB
 }
+
+// FlavorCapability is a feature that a server may or may not have, depending on its flavor and version
+type FlavorCapability int
+
+// FlavorCapability constants
+const (
+	NoSuchCapability                            FlavorCapability = iota
+	InstantDDLFlavorCapability                                   // ALGORITHM=INSTANT
+	InstantAddLastColumnFlavorCapability                         // instantly add a column as the last column
+	InstantAddDropVirtualColumnFlavorCapability                  // instantly add or drop a virtual generated column
+	InstantChangeColumnDefaultFlavorCapability                   // instantly set or drop the default value of a column
+	InstantExpandEnumCapability                                  // instantly append values to an ENUM or SET column
+	InstantRenameColumnFlavorCapability                          // instantly rename a column
+	InstantAddDropColumnFlavorCapability                         // instantly add a column at any position, or drop a column
+)
+
+// CapableOf tells whether the server has the given capability
+type CapableOf func(capability FlavorCapability) (bool, error)
+
+func (c *capabilitySet) hasCapability(capability FlavorCapability) (bool, error) {
+	switch capability {
+	case InstantDDLFlavorCapability,
+		InstantAddLastColumnFlavorCapability,
+		InstantAddDropVirtualColumnFlavorCapability,
+		InstantChangeColumnDefaultFlavorCapability,
+		InstantExpandEnumCapability:
+		return c.isMySQLLike() && c.version.atLeast(serverVersion{Major: 8, Minor: 0, Patch: 12}), nil
+	case InstantRenameColumnFlavorCapability:
+		return c.isMySQLLike() && c.version.atLeast(serverVersion{Major: 8, Minor: 0, Patch: 28}), nil
+	case InstantAddDropColumnFlavorCapability:
+		return c.isMySQLLike() && c.version.atLeast(serverVersion{Major: 8, Minor: 0, Patch: 29}), nil
+	}
+	return false, fmt.Errorf("unknown capability: %v", capability)
+}
+
+var serverVersionRegex = regexp.MustCompile(`^([0-9]+)\.([0-9]+)\.([0-9]+)`)
+
+// ServerVersionCapableOf returns a CapableOf for a server whose version, as reported by
+// SELECT @@version, is given; e.g. "8.0.29", "5.7.37-log" or "10.5.15-MariaDB"
+func ServerVersionCapableOf(version string) (CapableOf, error) {
+	flavor := FlavorMySQL
+	if strings.Contains(version, "MariaDB") {
+		flavor = FlavorMariaDB
+	}
+	v := serverVersionRegex.FindStringSubmatch(version)
+	if len(v) != 4 {
+		return nil, fmt.Errorf("could not parse server version from: %s", version)
+	}
+	var ver serverVersion
+	var err error
+	if ver.Major, err = strconv.Atoi(v[1]); err != nil {
+		return nil, fmt.Errorf("could not parse server version from: %s", version)
+	}
+	if ver.Minor, err = strconv.Atoi(v[2]); err != nil {
+		return nil, fmt.Errorf("could not parse server version from: %s", version)
+	}
+	if ver.Patch, err = strconv.Atoi(v[3]); err != nil {
+		return nil, fmt.Errorf("could not parse server version from: %s", version)
+	}
+	c := newCapabilitySet(flavor, ver)
+	return c.hasCapability, nil
+}
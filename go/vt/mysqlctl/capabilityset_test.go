@@ -0,0 +1,59 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerVersionCapableOf(t *testing.T) {
+	testcases := []struct {
+		version    string
+		capability FlavorCapability
+		isCapable  bool
+	}{
+		{version: "5.7.37-log", capability: InstantDDLFlavorCapability, isCapable: false},
+		{version: "8.0.11", capability: InstantDDLFlavorCapability, isCapable: false},
+		{version: "8.0.12", capability: InstantDDLFlavorCapability, isCapable: true},
+		{version: "8.0.12", capability: InstantAddLastColumnFlavorCapability, isCapable: true},
+		{version: "8.0.12", capability: InstantRenameColumnFlavorCapability, isCapable: false},
+		{version: "8.0.28", capability: InstantRenameColumnFlavorCapability, isCapable: true},
+		{version: "8.0.28", capability: InstantAddDropColumnFlavorCapability, isCapable: false},
+		{version: "8.0.29-21", capability: InstantAddDropColumnFlavorCapability, isCapable: true},
+		{version: "10.5.15-MariaDB-log", capability: InstantDDLFlavorCapability, isCapable: false},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.version, func(t *testing.T) {
+			capableOf, err := ServerVersionCapableOf(tc.version)
+			require.NoError(t, err)
+			isCapable, err := capableOf(tc.capability)
+			require.NoError(t, err)
+			assert.Equal(t, tc.isCapable, isCapable)
+		})
+	}
+
+	_, err := ServerVersionCapableOf("not a version")
+	assert.Error(t, err)
+
+	capableOf, err := ServerVersionCapableOf("8.0.29")
+	require.NoError(t, err)
+	_, err = capableOf(NoSuchCapability)
+	assert.Error(t, err)
+}
@@ -19,6 +19,8 @@ package schema
 import (
 	"fmt"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/google/shlex"
 )
@@ -36,6 +38,10 @@ const (
 	postponeCompletionFlag = "postpone-completion"
 	allowConcurrentFlag    = "allow-concurrent"
 	vreplicationTestSuite  = "vreplication-test-suite"
+	cutOverWindowFlag      = "cutover-window"
+	cutOverLockTimeoutFlag = "cutover-lock-timeout"
+	forceCutOverFlag       = "force-cutover"
+	preferInstantDDLFlag   = "prefer-instant-ddl"
 )
 
 // DDLStrategy suggests how an ALTER TABLE should run (e.g. "direct", "online", "gh-ost" or "pt-osc")
@@ -95,6 +101,12 @@ func ParseDDLStrategy(strategyVariable string) (*DDLStrategySetting, error) {
 	default:
 		return nil, fmt.Errorf("Unknown online DDL strategy: '%v'", strategy)
 	}
+	if _, err := setting.CutOverWindow(); err != nil {
+		return nil, err
+	}
+	if _, err := setting.CutOverLockTimeout(); err != nil {
+		return nil, err
+	}
 	return setting, nil
 }
 
@@ -109,10 +121,30 @@ func isFlag(s string, name string) bool {
 	return false
 }
 
+// isFlagWithValue return true when the given string is a CLI flag of the given name with a value, e.g. --name=value
+func isFlagWithValue(s string, name string) bool {
+	return strings.HasPrefix(s, fmt.Sprintf("-%s=", name)) || strings.HasPrefix(s, fmt.Sprintf("--%s=", name))
+}
+
+// options splits Options. The time zone of an unquoted -cutover-window, as in
+// "-cutover-window=02:00-04:00 UTC", is kept with the flag.
+func (setting *DDLStrategySetting) options() []string {
+	opts, _ := shlex.Split(setting.Options)
+	merged := make([]string, 0, len(opts))
+	for i := 0; i < len(opts); i++ {
+		opt := opts[i]
+		if isFlagWithValue(opt, cutOverWindowFlag) && i+1 < len(opts) && !strings.HasPrefix(opts[i+1], "-") {
+			opt = fmt.Sprintf("%s %s", opt, opts[i+1])
+			i++
+		}
+		merged = append(merged, opt)
+	}
+	return merged
+}
+
 // hasFlag returns true when Options include named flag
 func (setting *DDLStrategySetting) hasFlag(name string) bool {
-	opts, _ := shlex.Split(setting.Options)
-	for _, opt := range opts {
+	for _, opt := range setting.options() {
 		if isFlag(opt, name) {
 			return true
 		}
@@ -120,6 +152,16 @@ func (setting *DDLStrategySetting) hasFlag(name string) bool {
 	return false
 }
 
+// flagValue returns the value of the named flag in Options, and whether the flag was found
+func (setting *DDLStrategySetting) flagValue(name string) (string, bool) {
+	for _, opt := range setting.options() {
+		if isFlagWithValue(opt, name) {
+			return opt[strings.Index(opt, "=")+1:], true
+		}
+	}
+	return "", false
+}
+
 // IsDeclarative checks if strategy options include -declarative
 func (setting *DDLStrategySetting) IsDeclarative() bool {
 	return setting.hasFlag(declarativeFlag)
@@ -155,9 +197,45 @@ func (setting *DDLStrategySetting) IsVreplicationTestSuite() bool {
 	return setting.hasFlag(vreplicationTestSuite)
 }
 
+// CutOverWindow returns the -cutover-window the migration may only cut over within, or nil if there is none
+func (setting *DDLStrategySetting) CutOverWindow() (*CutOverWindow, error) {
+	value, ok := setting.flagValue(cutOverWindowFlag)
+	if !ok {
+		return nil, nil
+	}
+	return ParseCutOverWindow(value)
+}
+
+// CutOverLockTimeout returns the -cutover-lock-timeout, the longest time the cut-over may block
+// writes to the migrated table for, or 0 if there is none
+func (setting *DDLStrategySetting) CutOverLockTimeout() (time.Duration, error) {
+	value, ok := setting.flagValue(cutOverLockTimeoutFlag)
+	if !ok {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid -%s: %v", cutOverLockTimeoutFlag, err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("invalid -%s: %v must be positive", cutOverLockTimeoutFlag, value)
+	}
+	return timeout, nil
+}
+
+// IsForceCutOver checks if strategy options include -force-cutover
+func (setting *DDLStrategySetting) IsForceCutOver() bool {
+	return setting.hasFlag(forceCutOverFlag)
+}
+
+// IsPreferInstantDDL checks if strategy options include -prefer-instant-ddl
+func (setting *DDLStrategySetting) IsPreferInstantDDL() bool {
+	return setting.hasFlag(preferInstantDDLFlag)
+}
+
 // RuntimeOptions returns the options used as runtime flags for given strategy, removing any internal hint options
 func (setting *DDLStrategySetting) RuntimeOptions() []string {
-	opts, _ := shlex.Split(setting.Options)
+	opts := setting.options()
 	validOpts := []string{}
 	for _, opt := range opts {
 		switch {
@@ -169,6 +247,10 @@ func (setting *DDLStrategySetting) RuntimeOptions() []string {
 		case isFlag(opt, postponeCompletionFlag):
 		case isFlag(opt, allowConcurrentFlag):
 		case isFlag(opt, vreplicationTestSuite):
+		case isFlagWithValue(opt, cutOverWindowFlag):
+		case isFlagWithValue(opt, cutOverLockTimeoutFlag):
+		case isFlag(opt, forceCutOverFlag):
+		case isFlag(opt, preferInstantDDLFlag):
 		default:
 			validOpts = append(validOpts, opt)
 		}
@@ -188,3 +270,67 @@ func (setting *DDLStrategySetting) IsSkipTopo() bool {
 func (setting *DDLStrategySetting) ToString() string {
 	return fmt.Sprintf("DDLStrategySetting: strategy=%v, options=%s", setting.Strategy, setting.Options)
 }
+
+// CutOverWindow is a daily time window within which a migration may cut over
+type CutOverWindow struct {
+	// Start and End are the times of day the window starts and ends at
+	Start    time.Duration
+	End      time.Duration
+	Location *time.Location
+}
+
+// ParseCutOverWindow parses a window of the form HH:MM-HH:MM, optionally followed by the name of
+// a time zone, e.g. "02:00-04:00 UTC". The time zone defaults to UTC. A window that ends before it
+// starts spans midnight.
+func ParseCutOverWindow(s string) (*CutOverWindow, error) {
+	window := &CutOverWindow{Location: time.UTC}
+	fields := strings.Fields(s)
+	switch len(fields) {
+	case 1:
+	case 2:
+		location, err := time.LoadLocation(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid -%s %q: %v", cutOverWindowFlag, s, err)
+		}
+		window.Location = location
+	default:
+		return nil, fmt.Errorf("invalid -%s %q: expected HH:MM-HH:MM [time zone]", cutOverWindowFlag, s)
+	}
+	bounds := strings.Split(fields[0], "-")
+	if len(bounds) != 2 {
+		return nil, fmt.Errorf("invalid -%s %q: expected HH:MM-HH:MM [time zone]", cutOverWindowFlag, s)
+	}
+	for i, bound := range bounds {
+		t, err := time.Parse("15:04", bound)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -%s %q: expected HH:MM-HH:MM [time zone]", cutOverWindowFlag, s)
+		}
+		timeOfDay := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+		if i == 0 {
+			window.Start = timeOfDay
+		} else {
+			window.End = timeOfDay
+		}
+	}
+	if window.Start == window.End {
+		return nil, fmt.Errorf("invalid -%s %q: the window is empty", cutOverWindowFlag, s)
+	}
+	return window, nil
+}
+
+// String returns the window in the format ParseCutOverWindow accepts
+func (w *CutOverWindow) String() string {
+	start := time.Time{}.Add(w.Start)
+	end := time.Time{}.Add(w.End)
+	return fmt.Sprintf("%s-%s %s", start.Format("15:04"), end.Format("15:04"), w.Location)
+}
+
+// Contains returns true when the time of day of t, in the time zone of the window, is within the window
+func (w *CutOverWindow) Contains(t time.Time) bool {
+	t = t.In(w.Location)
+	timeOfDay := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if w.Start < w.End {
+		return timeOfDay >= w.Start && timeOfDay < w.End
+	}
+	return timeOfDay >= w.Start || timeOfDay < w.End
+}
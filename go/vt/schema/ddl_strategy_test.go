@@ -19,6 +19,7 @@ package schema
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -46,6 +47,10 @@ func TestParseDDLStrategy(t *testing.T) {
 		isSingleton          bool
 		isPostponeCompletion bool
 		isAllowConcurrent    bool
+		isForceCutOver       bool
+		isPreferInstantDDL   bool
+		cutOverWindow        string
+		cutOverLockTimeout   time.Duration
 		runtimeOptions       string
 		err                  error
 	}{
@@ -121,6 +126,37 @@ func TestParseDDLStrategy(t *testing.T) {
 			runtimeOptions:    "",
 			isAllowConcurrent: true,
 		},
+		{
+			strategyVariable:  "vitess --cutover-window=02:00-04:00 UTC -allow-concurrent",
+			strategy:          DDLStrategyVitess,
+			options:           "--cutover-window=02:00-04:00 UTC -allow-concurrent",
+			runtimeOptions:    "",
+			cutOverWindow:     "02:00-04:00 UTC",
+			isAllowConcurrent: true,
+		},
+		{
+			strategyVariable:   "vitess -cutover-window='22:30-01:00 Europe/Paris' -cutover-lock-timeout=3s -force-cutover",
+			strategy:           DDLStrategyVitess,
+			options:            "-cutover-window='22:30-01:00 Europe/Paris' -cutover-lock-timeout=3s -force-cutover",
+			runtimeOptions:     "",
+			cutOverWindow:      "22:30-01:00 Europe/Paris",
+			cutOverLockTimeout: 3 * time.Second,
+			isForceCutOver:     true,
+		},
+		{
+			strategyVariable: "gh-ost --max-load=Threads_running=100 -cutover-window=23:00-03:00",
+			strategy:         DDLStrategyGhost,
+			options:          "--max-load=Threads_running=100 -cutover-window=23:00-03:00",
+			runtimeOptions:   "--max-load=Threads_running=100",
+			cutOverWindow:    "23:00-03:00 UTC",
+		},
+		{
+			strategyVariable:   "vitess -prefer-instant-ddl",
+			strategy:           DDLStrategyVitess,
+			options:            "-prefer-instant-ddl",
+			runtimeOptions:     "",
+			isPreferInstantDDL: true,
+		},
 	}
 	for _, ts := range tt {
 		setting, err := ParseDDLStrategy(ts.strategyVariable)
@@ -131,6 +167,19 @@ func TestParseDDLStrategy(t *testing.T) {
 		assert.Equal(t, ts.isSingleton, setting.IsSingleton())
 		assert.Equal(t, ts.isPostponeCompletion, setting.IsPostponeCompletion())
 		assert.Equal(t, ts.isAllowConcurrent, setting.IsAllowConcurrent())
+		assert.Equal(t, ts.isForceCutOver, setting.IsForceCutOver())
+		assert.Equal(t, ts.isPreferInstantDDL, setting.IsPreferInstantDDL())
+
+		window, err := setting.CutOverWindow()
+		assert.NoError(t, err)
+		if ts.cutOverWindow == "" {
+			assert.Nil(t, window)
+		} else {
+			assert.Equal(t, ts.cutOverWindow, window.String())
+		}
+		lockTimeout, err := setting.CutOverLockTimeout()
+		assert.NoError(t, err)
+		assert.Equal(t, ts.cutOverLockTimeout, lockTimeout)
 
 		runtimeOptions := strings.Join(setting.RuntimeOptions(), " ")
 		assert.Equal(t, ts.runtimeOptions, runtimeOptions)
@@ -139,4 +188,44 @@ func TestParseDDLStrategy(t *testing.T) {
 		_, err := ParseDDLStrategy("other")
 		assert.Error(t, err)
 	}
+	{
+		_, err := ParseDDLStrategy("vitess -cutover-window=02:00")
+		assert.Error(t, err)
+	}
+	{
+		_, err := ParseDDLStrategy("vitess -cutover-lock-timeout=0s")
+		assert.Error(t, err)
+	}
+}
+
+func TestCutOverWindow(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	assert.NoError(t, err)
+	tt := []struct {
+		window string
+		at     time.Time
+		within bool
+	}{
+		{window: "02:00-04:00", at: time.Date(2022, 3, 1, 1, 59, 59, 0, time.UTC), within: false},
+		{window: "02:00-04:00", at: time.Date(2022, 3, 1, 2, 0, 0, 0, time.UTC), within: true},
+		{window: "02:00-04:00", at: time.Date(2022, 3, 1, 3, 59, 59, 0, time.UTC), within: true},
+		{window: "02:00-04:00", at: time.Date(2022, 3, 1, 4, 0, 0, 0, time.UTC), within: false},
+		{window: "02:00-04:00 UTC", at: time.Date(2022, 3, 1, 3, 0, 0, 0, paris), within: true},
+		{window: "02:00-04:00 Europe/Paris", at: time.Date(2022, 3, 1, 3, 0, 0, 0, time.UTC), within: false},
+		{window: "02:00-04:00 Europe/Paris", at: time.Date(2022, 3, 1, 2, 0, 0, 0, time.UTC), within: true},
+		{window: "23:00-01:00", at: time.Date(2022, 3, 1, 23, 30, 0, 0, time.UTC), within: true},
+		{window: "23:00-01:00", at: time.Date(2022, 3, 1, 0, 30, 0, 0, time.UTC), within: true},
+		{window: "23:00-01:00", at: time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC), within: false},
+	}
+	for _, ts := range tt {
+		t.Run(ts.window, func(t *testing.T) {
+			window, err := ParseCutOverWindow(ts.window)
+			assert.NoError(t, err)
+			assert.Equal(t, ts.within, window.Contains(ts.at), ts.at.String())
+		})
+	}
+	for _, window := range []string{"", "02:00", "2-4", "02:00-04:00 Nowhere/Nowhere", "02:00-02:00", "02:00-25:00", "02:00-04:00 UTC extra"} {
+		_, err := ParseCutOverWindow(window)
+		assert.Error(t, err, window)
+	}
 }
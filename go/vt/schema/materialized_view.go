@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	materializedViewBasePath = "materialized-view"
+)
+
+const (
+	// MaterializedViewTag is the tag of the vreplication streams that maintain a materialized view.
+	// It is stored in the tags column of _vt.vreplication.
+	MaterializedViewTag = "materialized_view"
+)
+
+// MaterializedViewRequestsPath is the base path for all newly received materialized view requests.
+// Such requests need to be reviewed and acted upon by vtctld.
+func MaterializedViewRequestsPath() string {
+	return fmt.Sprintf("%s/requests", materializedViewBasePath)
+}
+
+// MaterializedViewFailuresPath is the base path for the failed materialized view requests
+// of a keyspace. vtctld keeps the last failed request of each view there, until a request
+// for that view succeeds, so that SHOW MATERIALIZED VIEWS can report it.
+func MaterializedViewFailuresPath(keyspace string) string {
+	return fmt.Sprintf("%s/failures/%s", materializedViewBasePath, keyspace)
+}
+
+// MaterializedView encapsulates a CREATE, DROP or REFRESH MATERIALIZED VIEW request
+type MaterializedView struct {
+	Keyspace       string `json:"keyspace,omitempty"`
+	SourceKeyspace string `json:"source_keyspace,omitempty"`
+	Name           string `json:"name,omitempty"`
+	SQL            string `json:"sql,omitempty"`
+	UUID           string `json:"uuid,omitempty"`
+	RequestTime    int64  `json:"time_created,omitempty"`
+	// Message is the error of a failed request.
+	Message string `json:"message,omitempty"`
+}
+
+// NewMaterializedView creates a materialized view request for the given statement.
+// keyspace is where the view is materialized, sourceKeyspace is where the data comes from.
+func NewMaterializedView(keyspace, sourceKeyspace string, stmt *sqlparser.MaterializedViewDDL) (*MaterializedView, error) {
+	uuid, err := CreateOnlineDDLUUID()
+	if err != nil {
+		return nil, err
+	}
+	return &MaterializedView{
+		Keyspace:       keyspace,
+		SourceKeyspace: sourceKeyspace,
+		Name:           stmt.ViewName.Name.String(),
+		SQL:            sqlparser.String(stmt),
+		UUID:           uuid,
+		RequestTime:    time.Now().UnixNano(),
+	}, nil
+}
+
+// MaterializedViewFromJSON creates a MaterializedView from json
+func MaterializedViewFromJSON(bytes []byte) (*MaterializedView, error) {
+	mv := &MaterializedView{}
+	err := json.Unmarshal(bytes, mv)
+	return mv, err
+}
+
+// ReadMaterializedViewTopo reads a MaterializedView object from given topo connection
+func ReadMaterializedViewTopo(ctx context.Context, conn topo.Conn, entryPath string) (*MaterializedView, error) {
+	bytes, _, err := conn.Get(ctx, entryPath)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "ReadMaterializedViewTopo Get %s error: %s", entryPath, err.Error())
+	}
+	mv, err := MaterializedViewFromJSON(bytes)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "ReadMaterializedViewTopo unmarshal %s error: %s", entryPath, err.Error())
+	}
+	return mv, nil
+}
+
+// Statement parses the SQL of this request
+func (mv *MaterializedView) Statement() (*sqlparser.MaterializedViewDDL, error) {
+	stmt, err := sqlparser.Parse(mv.SQL)
+	if err != nil {
+		return nil, err
+	}
+	mvStmt, ok := stmt.(*sqlparser.MaterializedViewDDL)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "not a materialized view statement: %s", mv.SQL)
+	}
+	return mvStmt, nil
+}
+
+// ToJSON exports this materialized view request to JSON
+func (mv *MaterializedView) ToJSON() ([]byte, error) {
+	return json.Marshal(mv)
+}
+
+// ToString returns a simple string representation of this instance
+func (mv *MaterializedView) ToString() string {
+	return fmt.Sprintf("MaterializedView: keyspace=%s, name=%s, sql=%s", mv.Keyspace, mv.Name, mv.SQL)
+}
+
+// WriteTopo writes this request to given topo connection, based on basePath and this request's UUID
+func (mv *MaterializedView) WriteTopo(ctx context.Context, conn topo.Conn, basePath string) error {
+	if mv.UUID == "" {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "materialized view UUID not found; keyspace=%s, sql=%s", mv.Keyspace, mv.SQL)
+	}
+	bytes, err := mv.ToJSON()
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "materialized view marshall error:%s, keyspace=%s, sql=%s", err.Error(), mv.Keyspace, mv.SQL)
+	}
+	_, err = conn.Create(ctx, fmt.Sprintf("%s/%s", basePath, mv.UUID), bytes)
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "materialized view topo create error:%s, keyspace=%s, sql=%s", err.Error(), mv.Keyspace, mv.SQL)
+	}
+	return nil
+}
+
+// WriteFailureTopo records this request as the last failed request of its view, with
+// the given error as its message.
+func (mv *MaterializedView) WriteFailureTopo(ctx context.Context, conn topo.Conn, failure error) error {
+	mv.Message = failure.Error()
+	bytes, err := mv.ToJSON()
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "materialized view marshall error:%s, keyspace=%s, sql=%s", err.Error(), mv.Keyspace, mv.SQL)
+	}
+	_, err = conn.Update(ctx, fmt.Sprintf("%s/%s", MaterializedViewFailuresPath(mv.Keyspace), mv.Name), bytes, nil)
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "materialized view topo update error:%s, keyspace=%s, sql=%s", err.Error(), mv.Keyspace, mv.SQL)
+	}
+	return nil
+}
+
+// DeleteFailureTopo removes the last failed request of the view of this request, if any.
+func (mv *MaterializedView) DeleteFailureTopo(ctx context.Context, conn topo.Conn) error {
+	err := conn.Delete(ctx, fmt.Sprintf("%s/%s", MaterializedViewFailuresPath(mv.Keyspace), mv.Name), nil)
+	if err != nil && !topo.IsErrType(err, topo.NoNode) {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "materialized view topo delete error:%s, keyspace=%s, sql=%s", err.Error(), mv.Keyspace, mv.SQL)
+	}
+	return nil
+}
+
+// ReadMaterializedViewFailuresTopo reads the last failed request of each view of the given keyspace.
+func ReadMaterializedViewFailuresTopo(ctx context.Context, conn topo.Conn, keyspace string) ([]*MaterializedView, error) {
+	basePath := MaterializedViewFailuresPath(keyspace)
+	entries, err := conn.ListDir(ctx, basePath, false)
+	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return nil, nil
+		}
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "ReadMaterializedViewFailuresTopo ListDir %s error: %s", basePath, err.Error())
+	}
+	failures := make([]*MaterializedView, 0, len(entries))
+	for _, entry := range entries {
+		mv, err := ReadMaterializedViewTopo(ctx, conn, fmt.Sprintf("%s/%s", basePath, entry.Name))
+		if err != nil {
+			return nil, err
+		}
+		failures = append(failures, mv)
+	}
+	return failures, nil
+}
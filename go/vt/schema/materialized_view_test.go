@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+)
+
+func TestNewMaterializedView(t *testing.T) {
+	tt := []struct {
+		sql    string
+		name   string
+		action sqlparser.DDLAction
+	}{
+		{
+			sql:    "create materialized view ks.totals as select customer_id, count(*) from orders group by customer_id",
+			name:   "totals",
+			action: sqlparser.CreateDDLAction,
+		},
+		{
+			sql:    "drop materialized view if exists totals",
+			name:   "totals",
+			action: sqlparser.DropDDLAction,
+		},
+		{
+			sql:    "refresh materialized view totals",
+			name:   "totals",
+			action: sqlparser.RefreshDDLAction,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.sql, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tc.sql)
+			require.NoError(t, err)
+			mv, err := NewMaterializedView("ks", "src", stmt.(*sqlparser.MaterializedViewDDL))
+			require.NoError(t, err)
+			assert.True(t, IsOnlineDDLUUID(mv.UUID))
+			assert.Equal(t, tc.name, mv.Name)
+			assert.Equal(t, tc.sql, mv.SQL)
+
+			bytes, err := mv.ToJSON()
+			require.NoError(t, err)
+			read, err := MaterializedViewFromJSON(bytes)
+			require.NoError(t, err)
+			assert.Equal(t, mv, read)
+
+			parsed, err := read.Statement()
+			require.NoError(t, err)
+			assert.Equal(t, tc.action, parsed.Action)
+		})
+	}
+}
+
+func TestMaterializedViewFailures(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	require.NoError(t, err)
+
+	failures, err := ReadMaterializedViewFailuresTopo(ctx, conn, "ks")
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+
+	stmt, err := sqlparser.Parse("refresh materialized view totals")
+	require.NoError(t, err)
+	mv, err := NewMaterializedView("ks", "", stmt.(*sqlparser.MaterializedViewDDL))
+	require.NoError(t, err)
+	require.NoError(t, mv.WriteFailureTopo(ctx, conn, errors.New("first failure")))
+	require.NoError(t, mv.WriteFailureTopo(ctx, conn, errors.New("second failure")))
+
+	failures, err = ReadMaterializedViewFailuresTopo(ctx, conn, "ks")
+	require.NoError(t, err)
+	require.Len(t, failures, 1)
+	assert.Equal(t, "totals", failures[0].Name)
+	assert.Equal(t, "second failure", failures[0].Message)
+
+	failures, err = ReadMaterializedViewFailuresTopo(ctx, conn, "other")
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+
+	require.NoError(t, mv.DeleteFailureTopo(ctx, conn))
+	require.NoError(t, mv.DeleteFailureTopo(ctx, conn))
+	failures, err = ReadMaterializedViewFailuresTopo(ctx, conn, "ks")
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+}
@@ -434,6 +434,28 @@ func (onlineDDL *OnlineDDL) IsView() bool {
 	return false
 }
 
+// GetRelatedTables returns the names of the tables and views the migration operates on or depends on:
+// its own table or view, and the tables and views a view selects from. Migrations with related tables
+// in common may not run concurrently.
+func (onlineDDL *OnlineDDL) GetRelatedTables() map[string]bool {
+	related := map[string]bool{}
+	if onlineDDL.Table != "" {
+		related[onlineDDL.Table] = true
+	}
+	stmt, _, err := ParseOnlineDDLStatement(onlineDDL.SQL)
+	if err != nil {
+		// e.g. a REVERT, which only relates to its table
+		return related
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if tableName, ok := node.(sqlparser.TableName); ok && !tableName.IsEmpty() {
+			related[tableName.Name.String()] = true
+		}
+		return true, nil
+	}, stmt)
+	return related
+}
+
 // GetActionStr returns a string representation of the DDL action
 func (onlineDDL *OnlineDDL) GetActionStr() (action sqlparser.DDLAction, actionStr string, err error) {
 	action, err = onlineDDL.GetAction()
@@ -99,6 +99,55 @@ func TestGetActionStr(t *testing.T) {
 	}
 }
 
+func TestGetRelatedTables(t *testing.T) {
+	tt := []struct {
+		table     string
+		statement string
+		related   []string
+	}{
+		{
+			table:     "t",
+			statement: "alter table t drop column c",
+			related:   []string{"t"},
+		},
+		{
+			table:     "t",
+			statement: "create table t (id int primary key)",
+			related:   []string{"t"},
+		},
+		{
+			table:     "v",
+			statement: "create view v as select id from (select id from t1 union select id from t2) as d",
+			related:   []string{"v", "t1", "t2"},
+		},
+		{
+			table:     "v",
+			statement: "create view v as select t1.id from t1 join t2 on t1.id = t2.id where t1.c in (select c from t3)",
+			related:   []string{"v", "t1", "t2", "t3"},
+		},
+		{
+			table:     "v",
+			statement: "alter view v as select id from t1",
+			related:   []string{"v", "t1"},
+		},
+		{
+			table:     "t",
+			statement: "revert vitess_migration 'aaaaaaaa_bbbb_cccc_dddd_eeeeeeeeeeee'",
+			related:   []string{"t"},
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.statement, func(t *testing.T) {
+			onlineDDL := &OnlineDDL{Table: ts.table, SQL: ts.statement}
+			related := map[string]bool{}
+			for _, table := range ts.related {
+				related[table] = true
+			}
+			assert.Equal(t, related, onlineDDL.GetRelatedTables())
+		})
+	}
+}
+
 func TestIsOnlineDDLTableName(t *testing.T) {
 	names := []string{
 		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_gho",
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	referenceTableBasePath = "reference-table"
+)
+
+const (
+	// ReferenceTableTag is the tag of the vreplication streams that copy a global reference
+	// table to another keyspace. It is stored in the tags column of _vt.vreplication.
+	ReferenceTableTag = "reference_table"
+
+	referenceTableWorkflowPrefix = "reference_"
+)
+
+// ReferenceTableRequestsPath is the base path for all the requests to copy a global reference table.
+// Such requests need to be acted upon by vtctld.
+func ReferenceTableRequestsPath() string {
+	return fmt.Sprintf("%s/requests", referenceTableBasePath)
+}
+
+// ReferenceTableWorkflow returns the name of the workflow that copies the given global reference table.
+func ReferenceTableWorkflow(table string) string {
+	return referenceTableWorkflowPrefix + table
+}
+
+// ReferenceTableFromWorkflow returns the global reference table copied by the given workflow,
+// or an empty string if the workflow does not copy a global reference table.
+func ReferenceTableFromWorkflow(workflow string) string {
+	if !strings.HasPrefix(workflow, referenceTableWorkflowPrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(workflow, referenceTableWorkflowPrefix)
+}
+
+// ReferenceTable encapsulates a request to copy a global reference table to a keyspace
+type ReferenceTable struct {
+	Keyspace       string `json:"keyspace,omitempty"`
+	SourceKeyspace string `json:"source_keyspace,omitempty"`
+	Table          string `json:"table,omitempty"`
+	RequestTime    int64  `json:"time_created,omitempty"`
+}
+
+// NewReferenceTable creates a request to copy table from sourceKeyspace to keyspace.
+func NewReferenceTable(keyspace, sourceKeyspace, table string) *ReferenceTable {
+	return &ReferenceTable{
+		Keyspace:       keyspace,
+		SourceKeyspace: sourceKeyspace,
+		Table:          table,
+		RequestTime:    time.Now().UnixNano(),
+	}
+}
+
+// ReferenceTableFromJSON creates a ReferenceTable from json
+func ReferenceTableFromJSON(bytes []byte) (*ReferenceTable, error) {
+	rt := &ReferenceTable{}
+	err := json.Unmarshal(bytes, rt)
+	return rt, err
+}
+
+// ReadReferenceTableTopo reads a ReferenceTable object from given topo connection
+func ReadReferenceTableTopo(ctx context.Context, conn topo.Conn, entryPath string) (*ReferenceTable, error) {
+	bytes, _, err := conn.Get(ctx, entryPath)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "ReadReferenceTableTopo Get %s error: %s", entryPath, err.Error())
+	}
+	rt, err := ReferenceTableFromJSON(bytes)
+	if err != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "ReadReferenceTableTopo unmarshal %s error: %s", entryPath, err.Error())
+	}
+	return rt, nil
+}
+
+// Workflow returns the name of the workflow that copies the table
+func (rt *ReferenceTable) Workflow() string {
+	return ReferenceTableWorkflow(rt.Table)
+}
+
+// EntryName returns the name of the topo entry of this request. There is at most
+// one pending request per keyspace and table.
+func (rt *ReferenceTable) EntryName() string {
+	return fmt.Sprintf("%s.%s", rt.Keyspace, rt.Table)
+}
+
+// ToJSON exports this reference table request to JSON
+func (rt *ReferenceTable) ToJSON() ([]byte, error) {
+	return json.Marshal(rt)
+}
+
+// ToString returns a simple string representation of this instance
+func (rt *ReferenceTable) ToString() string {
+	return fmt.Sprintf("ReferenceTable: keyspace=%s, source_keyspace=%s, table=%s", rt.Keyspace, rt.SourceKeyspace, rt.Table)
+}
+
+// WriteTopo writes this request to given topo connection, based on basePath and this request's
+// keyspace and table. It succeeds if the same request is already pending.
+func (rt *ReferenceTable) WriteTopo(ctx context.Context, conn topo.Conn, basePath string) error {
+	bytes, err := rt.ToJSON()
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "reference table marshall error:%s, keyspace=%s, table=%s", err.Error(), rt.Keyspace, rt.Table)
+	}
+	_, err = conn.Create(ctx, fmt.Sprintf("%s/%s", basePath, rt.EntryName()), bytes)
+	if err != nil && !topo.IsErrType(err, topo.NodeExists) {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "reference table topo create error:%s, keyspace=%s, table=%s", err.Error(), rt.Keyspace, rt.Table)
+	}
+	return nil
+}
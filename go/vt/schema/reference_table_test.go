@@ -0,0 +1,38 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReferenceTable(t *testing.T) {
+	rt := NewReferenceTable("ks", "src", "country")
+	assert.Equal(t, "ks.country", rt.EntryName())
+	assert.Equal(t, "reference_country", rt.Workflow())
+	assert.Equal(t, "country", ReferenceTableFromWorkflow(rt.Workflow()))
+	assert.Equal(t, "", ReferenceTableFromWorkflow("commerce2customer"))
+
+	bytes, err := rt.ToJSON()
+	require.NoError(t, err)
+	read, err := ReferenceTableFromJSON(bytes)
+	require.NoError(t, err)
+	assert.Equal(t, rt, read)
+}
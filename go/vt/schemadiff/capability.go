@@ -0,0 +1,461 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// AlterTableAlgorithm is the cheapest ALTER TABLE algorithm that MySQL can use to apply a change.
+// Algorithms are ordered by cost: a change that can run with ALGORITHM=INSTANT can also run with
+// ALGORITHM=INPLACE, and any change can run with ALGORITHM=COPY.
+type AlterTableAlgorithm int
+
+const (
+	// AlterTableAlgorithmInstant only changes the table's metadata
+	AlterTableAlgorithmInstant AlterTableAlgorithm = iota
+	// AlterTableAlgorithmInplace runs within the storage engine, possibly rebuilding the table, and
+	// allows concurrent writes
+	AlterTableAlgorithmInplace
+	// AlterTableAlgorithmCopy copies the table and blocks writes for the duration of the copy
+	AlterTableAlgorithmCopy
+)
+
+// String returns the algorithm as used in ALTER TABLE ... ALGORITHM=...
+func (a AlterTableAlgorithm) String() string {
+	switch a {
+	case AlterTableAlgorithmInstant:
+		return "INSTANT"
+	case AlterTableAlgorithmInplace:
+		return "INPLACE"
+	case AlterTableAlgorithmCopy:
+		return "COPY"
+	}
+	return fmt.Sprintf("AlterTableAlgorithm(%d)", int(a))
+}
+
+// maxAlgorithm returns the more expensive of two algorithms
+func maxAlgorithm(a, b AlterTableAlgorithm) AlterTableAlgorithm {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Algorithm returns the cheapest algorithm with which MySQL can apply this diff, on a server with
+// the given capabilities.
+func (d *AlterTableEntityDiff) Algorithm(capableOf mysqlctl.CapableOf) (AlterTableAlgorithm, error) {
+	if d.IsEmpty() {
+		return AlterTableAlgorithmInstant, nil
+	}
+	return AnalyzeAlterTableAlgorithm(&d.from.CreateTable, d.alterTable, capableOf)
+}
+
+// AlterTableCapableOfInstantDDL returns true when MySQL can apply the given ALTER TABLE statement on the
+// given table with ALGORITHM=INSTANT, on a server with the given capabilities.
+func AlterTableCapableOfInstantDDL(createTable *sqlparser.CreateTable, alterTable *sqlparser.AlterTable, capableOf mysqlctl.CapableOf) (bool, error) {
+	algorithm, err := AnalyzeAlterTableAlgorithm(createTable, alterTable, capableOf)
+	if err != nil {
+		return false, err
+	}
+	return algorithm == AlterTableAlgorithmInstant, nil
+}
+
+// AnalyzeAlterTableAlgorithm returns the cheapest algorithm with which MySQL can apply the given ALTER TABLE
+// statement on the given table, on a server with the given capabilities. The statement may only run with
+// ALGORITHM=INSTANT if all of its options may, so the analysis returns the most expensive algorithm
+// required by any single option.
+// The analysis follows the MySQL online DDL documentation. When in doubt, it errs on the expensive side.
+func AnalyzeAlterTableAlgorithm(createTable *sqlparser.CreateTable, alterTable *sqlparser.AlterTable, capableOf mysqlctl.CapableOf) (AlterTableAlgorithm, error) {
+	if createTable == nil || createTable.TableSpec == nil {
+		return AlterTableAlgorithmCopy, ErrUnexpectedTableSpec
+	}
+	if alterTable.PartitionSpec != nil || alterTable.PartitionOption != nil {
+		return AlterTableAlgorithmCopy, nil
+	}
+	a := &algorithmAnalyzer{
+		createTable: createTable,
+		alterTable:  alterTable,
+		capableOf:   capableOf,
+	}
+	algorithm := AlterTableAlgorithmInstant
+	for _, option := range alterTable.AlterOptions {
+		optionAlgorithm, err := a.analyzeOption(option)
+		if err != nil {
+			return AlterTableAlgorithmCopy, err
+		}
+		algorithm = maxAlgorithm(algorithm, optionAlgorithm)
+	}
+	return algorithm, nil
+}
+
+type algorithmAnalyzer struct {
+	createTable *sqlparser.CreateTable
+	alterTable  *sqlparser.AlterTable
+	capableOf   mysqlctl.CapableOf
+}
+
+// capableAlgorithm returns INSTANT if the server has the given capability, or the given fallback algorithm if not
+func (a *algorithmAnalyzer) capableAlgorithm(capability mysqlctl.FlavorCapability, fallback AlterTableAlgorithm) (AlterTableAlgorithm, error) {
+	capable, err := a.capableOf(capability)
+	if err != nil {
+		return AlterTableAlgorithmCopy, err
+	}
+	if capable {
+		return AlterTableAlgorithmInstant, nil
+	}
+	return fallback, nil
+}
+
+// column returns the definition of the named column in the original table
+func (a *algorithmAnalyzer) column(name sqlparser.ColIdent) (*sqlparser.ColumnDefinition, error) {
+	for _, col := range a.createTable.TableSpec.Columns {
+		if col.Name.Equal(name) {
+			return col, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrInvalidColumnInAlter, name.String())
+}
+
+// tableOption returns the value of the named option of the original table, or an empty string if not found
+func (a *algorithmAnalyzer) tableOption(name string) string {
+	for _, option := range a.createTable.TableSpec.Options {
+		if strings.EqualFold(option.Name, name) {
+			if option.Value != nil {
+				return option.Value.Val
+			}
+			return option.String
+		}
+	}
+	return ""
+}
+
+// instantColumnsSupported returns false when the table cannot instantly add or drop columns, whatever the server:
+// neither compressed tables nor tables with a FULLTEXT index can.
+func (a *algorithmAnalyzer) instantColumnsSupported() bool {
+	if strings.EqualFold(a.tableOption("ROW_FORMAT"), "COMPRESSED") {
+		return false
+	}
+	for _, key := range a.createTable.TableSpec.Indexes {
+		if key.Info.Fulltext {
+			return false
+		}
+	}
+	return true
+}
+
+// addsPrimaryKey returns true when the ALTER TABLE statement adds a primary key
+func (a *algorithmAnalyzer) addsPrimaryKey() bool {
+	for _, option := range a.alterTable.AlterOptions {
+		if addIndex, ok := option.(*sqlparser.AddIndexDefinition); ok && addIndex.IndexDefinition.Info.Primary {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *algorithmAnalyzer) analyzeOption(option sqlparser.AlterOption) (AlterTableAlgorithm, error) {
+	switch option := option.(type) {
+	case *sqlparser.AddColumns:
+		algorithm := AlterTableAlgorithmInstant
+		for _, col := range option.Columns {
+			colAlgorithm, err := a.analyzeAddColumn(col, option.First || option.After != nil)
+			if err != nil {
+				return AlterTableAlgorithmCopy, err
+			}
+			algorithm = maxAlgorithm(algorithm, colAlgorithm)
+		}
+		return algorithm, nil
+	case *sqlparser.DropColumn:
+		return a.analyzeDropColumn(option.Name.Name)
+	case *sqlparser.ModifyColumn:
+		from, err := a.column(option.NewColDefinition.Name)
+		if err != nil {
+			return AlterTableAlgorithmCopy, err
+		}
+		return a.analyzeChangeColumn(from, option.NewColDefinition, option.First || option.After != nil)
+	case *sqlparser.ChangeColumn:
+		from, err := a.column(option.OldColumn.Name)
+		if err != nil {
+			return AlterTableAlgorithmCopy, err
+		}
+		return a.analyzeChangeColumn(from, option.NewColDefinition, option.First || option.After != nil)
+	case *sqlparser.AlterColumn:
+		if _, err := a.column(option.Column.Name); err != nil {
+			return AlterTableAlgorithmCopy, err
+		}
+		return a.capableAlgorithm(mysqlctl.InstantChangeColumnDefaultFlavorCapability, AlterTableAlgorithmInplace)
+	case *sqlparser.AddIndexDefinition:
+		// MySQL builds secondary indexes in place, and rebuilds the table in place for a new primary key
+		return AlterTableAlgorithmInplace, nil
+	case *sqlparser.DropKey:
+		if option.Type == sqlparser.PrimaryKeyType && !a.addsPrimaryKey() {
+			// dropping a primary key without adding another one requires a copy
+			return AlterTableAlgorithmCopy, nil
+		}
+		return AlterTableAlgorithmInplace, nil
+	case *sqlparser.RenameIndex:
+		return AlterTableAlgorithmInplace, nil
+	case *sqlparser.AddConstraintDefinition:
+		// foreign keys are only added in place when foreign_key_checks is disabled, and
+		// check constraints are always added by copying the table
+		return AlterTableAlgorithmCopy, nil
+	case *sqlparser.RenameTableName:
+		return a.capableAlgorithm(mysqlctl.InstantDDLFlavorCapability, AlterTableAlgorithmInplace)
+	case sqlparser.TableOptions:
+		algorithm := AlterTableAlgorithmInstant
+		for _, tableOption := range option {
+			algorithm = maxAlgorithm(algorithm, a.analyzeTableOption(tableOption))
+		}
+		return algorithm, nil
+	case *sqlparser.KeyState, *sqlparser.TablespaceOperation, *sqlparser.Force:
+		return AlterTableAlgorithmInplace, nil
+	case sqlparser.AlgorithmValue:
+		// an explicit algorithm may only make the change more expensive
+		switch strings.ToUpper(string(option)) {
+		case "COPY":
+			return AlterTableAlgorithmCopy, nil
+		case "INPLACE":
+			return AlterTableAlgorithmInplace, nil
+		}
+		return AlterTableAlgorithmInstant, nil
+	case *sqlparser.LockOption, *sqlparser.Validation:
+		return AlterTableAlgorithmInstant, nil
+	}
+	// CONVERT TO CHARACTER SET, ORDER BY and anything not analyzed above
+	return AlterTableAlgorithmCopy, nil
+}
+
+func isGeneratedColumn(col *sqlparser.ColumnDefinition) bool {
+	return col.Type.Options != nil && col.Type.Options.As != nil
+}
+
+func isStoredColumn(col *sqlparser.ColumnDefinition) bool {
+	return isGeneratedColumn(col) && col.Type.Options.Storage == sqlparser.StoredStorage
+}
+
+// analyzeAddColumn analyzes the addition of a column, which is positioned unless it is added as the last column
+func (a *algorithmAnalyzer) analyzeAddColumn(col *sqlparser.ColumnDefinition, positioned bool) (AlterTableAlgorithm, error) {
+	if isStoredColumn(col) {
+		return AlterTableAlgorithmCopy, nil
+	}
+	if isGeneratedColumn(col) {
+		return a.capableAlgorithm(mysqlctl.InstantAddDropVirtualColumnFlavorCapability, AlterTableAlgorithmInplace)
+	}
+	if col.Type.Options != nil {
+		if col.Type.Options.Autoincrement {
+			return AlterTableAlgorithmCopy, nil
+		}
+		var noKey sqlparser.ColumnKeyOption
+		if col.Type.Options.KeyOpt != noKey {
+			// the column is also indexed
+			return AlterTableAlgorithmInplace, nil
+		}
+	}
+	if !a.instantColumnsSupported() {
+		return AlterTableAlgorithmInplace, nil
+	}
+	if positioned {
+		return a.capableAlgorithm(mysqlctl.InstantAddDropColumnFlavorCapability, AlterTableAlgorithmInplace)
+	}
+	return a.capableAlgorithm(mysqlctl.InstantAddLastColumnFlavorCapability, AlterTableAlgorithmInplace)
+}
+
+func (a *algorithmAnalyzer) analyzeDropColumn(name sqlparser.ColIdent) (AlterTableAlgorithm, error) {
+	col, err := a.column(name)
+	if err != nil {
+		return AlterTableAlgorithmCopy, err
+	}
+	if isStoredColumn(col) {
+		return AlterTableAlgorithmInplace, nil
+	}
+	if isGeneratedColumn(col) {
+		return a.capableAlgorithm(mysqlctl.InstantAddDropVirtualColumnFlavorCapability, AlterTableAlgorithmInplace)
+	}
+	if !a.instantColumnsSupported() {
+		return AlterTableAlgorithmInplace, nil
+	}
+	return a.capableAlgorithm(mysqlctl.InstantAddDropColumnFlavorCapability, AlterTableAlgorithmInplace)
+}
+
+// normalizedColumn returns a copy of the column definition which compares equal to other copies when the
+// two columns only differ in ways that do not change the column.
+func normalizedColumn(col *sqlparser.ColumnDefinition) *sqlparser.ColumnDefinition {
+	col = sqlparser.CloneRefOfColumnDefinition(col)
+	if col.Type.Options == nil {
+		col.Type.Options = &sqlparser.ColumnTypeOptions{}
+	}
+	if col.Type.Options.Null == nil {
+		nullable := true
+		col.Type.Options.Null = &nullable
+	}
+	return col
+}
+
+// analyzeChangeColumn analyzes the change of a column from one definition to another, which is
+// repositioned if it is given a new position in the table.
+func (a *algorithmAnalyzer) analyzeChangeColumn(from, to *sqlparser.ColumnDefinition, repositioned bool) (AlterTableAlgorithm, error) {
+	from = normalizedColumn(from)
+	to = normalizedColumn(to)
+
+	algorithm := AlterTableAlgorithmInstant
+	if repositioned {
+		algorithm = AlterTableAlgorithmInplace
+	}
+	if !from.Name.Equal(to.Name) {
+		renameAlgorithm, err := a.capableAlgorithm(mysqlctl.InstantRenameColumnFlavorCapability, AlterTableAlgorithmInplace)
+		if err != nil {
+			return AlterTableAlgorithmCopy, err
+		}
+		algorithm = maxAlgorithm(algorithm, renameAlgorithm)
+		to.Name = from.Name
+	}
+	if sqlparser.EqualsRefOfColumnDefinition(from, to) {
+		return algorithm, nil
+	}
+	if isGeneratedColumn(from) || isGeneratedColumn(to) {
+		return AlterTableAlgorithmCopy, nil
+	}
+
+	// onlyDiffersBy returns true when the columns are the same once the given change is undone
+	onlyDiffersBy := func(undo func(col *sqlparser.ColumnDefinition)) bool {
+		col := sqlparser.CloneRefOfColumnDefinition(to)
+		undo(col)
+		return sqlparser.EqualsRefOfColumnDefinition(from, col)
+	}
+	switch {
+	case onlyDiffersBy(func(col *sqlparser.ColumnDefinition) { col.Type.Options.Default = from.Type.Options.Default }):
+		defaultAlgorithm, err := a.capableAlgorithm(mysqlctl.InstantChangeColumnDefaultFlavorCapability, AlterTableAlgorithmInplace)
+		if err != nil {
+			return AlterTableAlgorithmCopy, err
+		}
+		return maxAlgorithm(algorithm, defaultAlgorithm), nil
+	case onlyDiffersBy(func(col *sqlparser.ColumnDefinition) { col.Type.Options.Null = from.Type.Options.Null }):
+		// changing nullability rebuilds the table in place
+		return AlterTableAlgorithmInplace, nil
+	case onlyDiffersBy(func(col *sqlparser.ColumnDefinition) { col.Type.EnumValues = from.Type.EnumValues }):
+		if !enumValuesAppended(from.Type, to.Type) {
+			return AlterTableAlgorithmCopy, nil
+		}
+		enumAlgorithm, err := a.capableAlgorithm(mysqlctl.InstantExpandEnumCapability, AlterTableAlgorithmInplace)
+		if err != nil {
+			return AlterTableAlgorithmCopy, err
+		}
+		return maxAlgorithm(algorithm, enumAlgorithm), nil
+	case onlyDiffersBy(func(col *sqlparser.ColumnDefinition) { col.Type.Length = from.Type.Length }):
+		if !a.varcharExtended(from.Type, to.Type) {
+			return AlterTableAlgorithmCopy, nil
+		}
+		return AlterTableAlgorithmInplace, nil
+	}
+	return AlterTableAlgorithmCopy, nil
+}
+
+// enumStorageSize returns the number of bytes used to store a value of an ENUM or SET column with the given values
+func enumStorageSize(columnType string, numValues int) int {
+	if strings.EqualFold(columnType, "SET") {
+		size := (numValues + 7) / 8
+		if size > 4 {
+			size = 8
+		}
+		return size
+	}
+	if numValues > 255 {
+		return 2
+	}
+	return 1
+}
+
+// enumValuesAppended returns true when the values of an ENUM or SET column are only appended to,
+// and the storage size of its values does not change.
+func enumValuesAppended(from, to sqlparser.ColumnType) bool {
+	if !strings.EqualFold(from.Type, "ENUM") && !strings.EqualFold(from.Type, "SET") {
+		return false
+	}
+	if len(to.EnumValues) < len(from.EnumValues) {
+		return false
+	}
+	for i, value := range from.EnumValues {
+		if to.EnumValues[i] != value {
+			return false
+		}
+	}
+	return enumStorageSize(from.Type, len(from.EnumValues)) == enumStorageSize(to.Type, len(to.EnumValues))
+}
+
+// charsetMaxBytes returns the maximum number of bytes per character of the given character set
+func charsetMaxBytes(charset string) int {
+	switch strings.ToLower(charset) {
+	case "latin1", "ascii", "binary":
+		return 1
+	case "ucs2":
+		return 2
+	case "utf8", "utf8mb3":
+		return 3
+	}
+	return 4
+}
+
+// varcharExtended returns true when a VARCHAR column is extended, and its values still use the same number of
+// length bytes: one byte for up to 255 bytes, two bytes otherwise.
+func (a *algorithmAnalyzer) varcharExtended(from, to sqlparser.ColumnType) bool {
+	if !strings.EqualFold(from.Type, "VARCHAR") || from.Length == nil || to.Length == nil {
+		return false
+	}
+	fromLength, err := strconv.Atoi(from.Length.Val)
+	if err != nil {
+		return false
+	}
+	toLength, err := strconv.Atoi(to.Length.Val)
+	if err != nil {
+		return false
+	}
+	if toLength < fromLength {
+		return false
+	}
+	charset := from.Charset
+	if charset == "" {
+		charset = a.tableOption("CHARSET")
+	}
+	maxBytes := charsetMaxBytes(charset)
+	return (fromLength*maxBytes <= 255) == (toLength*maxBytes <= 255)
+}
+
+func (a *algorithmAnalyzer) analyzeTableOption(option *sqlparser.TableOption) AlterTableAlgorithm {
+	switch strings.ToUpper(option.Name) {
+	case "AUTO_INCREMENT", "COMMENT", "STATS_AUTO_RECALC", "STATS_PERSISTENT", "STATS_SAMPLE_PAGES":
+		return AlterTableAlgorithmInplace
+	case "CHARSET", "COLLATE", "ROW_FORMAT", "KEY_BLOCK_SIZE":
+		// the table is rebuilt in place
+		return AlterTableAlgorithmInplace
+	case "ENGINE":
+		engine := a.tableOption("ENGINE")
+		if engine == "" {
+			engine = "InnoDB"
+		}
+		if strings.EqualFold(option.String, engine) {
+			// a "null" ALTER rebuilds the table in place
+			return AlterTableAlgorithmInplace
+		}
+	}
+	return AlterTableAlgorithmCopy
+}
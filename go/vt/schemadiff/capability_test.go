@@ -0,0 +1,249 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestAnalyzeAlterTableAlgorithm(t *testing.T) {
+	const create = "create table t (id int primary key, i int not null default 0, v varchar(20), e enum('a', 'b'), g int as (id + 1) virtual, s int as (id + 2) stored, key i_idx(i))"
+	tt := []struct {
+		name   string
+		create string
+		alter  string
+		// expected algorithms on MySQL 5.7, 8.0.12 and 8.0.29
+		algorithms [3]AlterTableAlgorithm
+	}{
+		{
+			name:       "add last column",
+			alter:      "alter table t add column j int",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInstant, AlterTableAlgorithmInstant},
+		},
+		{
+			name:       "add column first",
+			alter:      "alter table t add column j int first",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInplace, AlterTableAlgorithmInstant},
+		},
+		{
+			name:       "add column to table with fulltext index",
+			create:     "create table t (id int primary key, txt text, fulltext key txt_idx(txt))",
+			alter:      "alter table t add column j int",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInplace, AlterTableAlgorithmInplace},
+		},
+		{
+			name:       "add column to compressed table",
+			create:     "create table t (id int primary key) row_format=compressed",
+			alter:      "alter table t add column j int",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInplace, AlterTableAlgorithmInplace},
+		},
+		{
+			name:       "add auto_increment column",
+			create:     "create table t (i int)",
+			alter:      "alter table t add column id int auto_increment primary key",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmCopy, AlterTableAlgorithmCopy, AlterTableAlgorithmCopy},
+		},
+		{
+			name:       "add virtual column",
+			alter:      "alter table t add column g2 int as (id + 3) virtual",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInstant, AlterTableAlgorithmInstant},
+		},
+		{
+			name:       "add stored column",
+			alter:      "alter table t add column s2 int as (id + 3) stored",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmCopy, AlterTableAlgorithmCopy, AlterTableAlgorithmCopy},
+		},
+		{
+			name:       "drop column",
+			alter:      "alter table t drop column v",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInplace, AlterTableAlgorithmInstant},
+		},
+		{
+			name:       "drop virtual column",
+			alter:      "alter table t drop column g",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInstant, AlterTableAlgorithmInstant},
+		},
+		{
+			name:       "change default",
+			alter:      "alter table t modify column i int not null default 1",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInstant, AlterTableAlgorithmInstant},
+		},
+		{
+			name:       "set default",
+			alter:      "alter table t alter column v set default 'x'",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInstant, AlterTableAlgorithmInstant},
+		},
+		{
+			name:       "rename column",
+			alter:      "alter table t change column v v2 varchar(20)",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInplace, AlterTableAlgorithmInstant},
+		},
+		{
+			name:       "append enum value",
+			alter:      "alter table t modify column e enum('a', 'b', 'c')",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInstant, AlterTableAlgorithmInstant},
+		},
+		{
+			name:       "reorder enum values",
+			alter:      "alter table t modify column e enum('b', 'a')",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmCopy, AlterTableAlgorithmCopy, AlterTableAlgorithmCopy},
+		},
+		{
+			name:       "extend varchar",
+			alter:      "alter table t modify column v varchar(60)",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInplace, AlterTableAlgorithmInplace},
+		},
+		{
+			name:       "extend varchar beyond 255 bytes",
+			alter:      "alter table t modify column v varchar(100)",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmCopy, AlterTableAlgorithmCopy, AlterTableAlgorithmCopy},
+		},
+		{
+			name:       "extend latin1 varchar",
+			create:     "create table t (id int primary key, v varchar(20)) charset=latin1",
+			alter:      "alter table t modify column v varchar(100)",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInplace, AlterTableAlgorithmInplace},
+		},
+		{
+			name:       "change column type",
+			alter:      "alter table t modify column i bigint not null default 0",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmCopy, AlterTableAlgorithmCopy, AlterTableAlgorithmCopy},
+		},
+		{
+			name:       "make column nullable",
+			alter:      "alter table t modify column i int default 0",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInplace, AlterTableAlgorithmInplace},
+		},
+		{
+			name:       "add and drop index",
+			alter:      "alter table t add key v_idx(v), drop key i_idx",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInplace, AlterTableAlgorithmInplace},
+		},
+		{
+			name:       "drop primary key",
+			alter:      "alter table t drop primary key",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmCopy, AlterTableAlgorithmCopy, AlterTableAlgorithmCopy},
+		},
+		{
+			name:       "replace primary key",
+			alter:      "alter table t drop primary key, add primary key (id, i)",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInplace, AlterTableAlgorithmInplace},
+		},
+		{
+			name:       "table comment",
+			alter:      "alter table t comment 'hello'",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInplace, AlterTableAlgorithmInplace},
+		},
+		{
+			name:       "null alter",
+			alter:      "alter table t engine=innodb",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmInplace, AlterTableAlgorithmInplace, AlterTableAlgorithmInplace},
+		},
+		{
+			name:       "change engine",
+			alter:      "alter table t engine=myisam",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmCopy, AlterTableAlgorithmCopy, AlterTableAlgorithmCopy},
+		},
+		{
+			name:       "convert charset",
+			alter:      "alter table t convert to character set utf8mb4",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmCopy, AlterTableAlgorithmCopy, AlterTableAlgorithmCopy},
+		},
+		{
+			name:       "instant and copy",
+			alter:      "alter table t add column j int, modify column i bigint not null default 0",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmCopy, AlterTableAlgorithmCopy, AlterTableAlgorithmCopy},
+		},
+		{
+			name:       "explicit algorithm",
+			alter:      "alter table t add column j int, algorithm=copy",
+			algorithms: [3]AlterTableAlgorithm{AlterTableAlgorithmCopy, AlterTableAlgorithmCopy, AlterTableAlgorithmCopy},
+		},
+	}
+	versions := []string{"5.7.37", "8.0.12", "8.0.29"}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			createQuery := ts.create
+			if createQuery == "" {
+				createQuery = create
+			}
+			createStmt, err := sqlparser.ParseStrictDDL(createQuery)
+			require.NoError(t, err)
+			createTable, ok := createStmt.(*sqlparser.CreateTable)
+			require.True(t, ok)
+			alterStmt, err := sqlparser.ParseStrictDDL(ts.alter)
+			require.NoError(t, err)
+			alterTable, ok := alterStmt.(*sqlparser.AlterTable)
+			require.True(t, ok)
+
+			for i, version := range versions {
+				capableOf, err := mysqlctl.ServerVersionCapableOf(version)
+				require.NoError(t, err)
+				algorithm, err := AnalyzeAlterTableAlgorithm(createTable, alterTable, capableOf)
+				require.NoError(t, err)
+				assert.Equal(t, ts.algorithms[i].String(), algorithm.String(), "version %s", version)
+
+				isInstant, err := AlterTableCapableOfInstantDDL(createTable, alterTable, capableOf)
+				require.NoError(t, err)
+				assert.Equal(t, ts.algorithms[i] == AlterTableAlgorithmInstant, isInstant, "version %s", version)
+			}
+		})
+	}
+}
+
+func TestAnalyzeAlterTableAlgorithmErrors(t *testing.T) {
+	createStmt, err := sqlparser.ParseStrictDDL("create table t (id int primary key)")
+	require.NoError(t, err)
+	alterStmt, err := sqlparser.ParseStrictDDL("alter table t drop column i")
+	require.NoError(t, err)
+	capableOf, err := mysqlctl.ServerVersionCapableOf("8.0.29")
+	require.NoError(t, err)
+
+	_, err = AnalyzeAlterTableAlgorithm(createStmt.(*sqlparser.CreateTable), alterStmt.(*sqlparser.AlterTable), capableOf)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrInvalidColumnInAlter), err.Error())
+}
+
+func TestAlterTableEntityDiffAlgorithm(t *testing.T) {
+	diff, err := DiffCreateTablesQueries(
+		"create table t (id int primary key, i int)",
+		"create table t (id int primary key, i int, j int)",
+		&DiffHints{},
+	)
+	require.NoError(t, err)
+	alterDiff, ok := diff.(*AlterTableEntityDiff)
+	require.True(t, ok)
+
+	capableOf, err := mysqlctl.ServerVersionCapableOf("5.7.37-log")
+	require.NoError(t, err)
+	algorithm, err := alterDiff.Algorithm(capableOf)
+	require.NoError(t, err)
+	assert.Equal(t, AlterTableAlgorithmInplace, algorithm)
+
+	capableOf, err = mysqlctl.ServerVersionCapableOf("8.0.29")
+	require.NoError(t, err)
+	algorithm, err = alterDiff.Algorithm(capableOf)
+	require.NoError(t, err)
+	assert.Equal(t, AlterTableAlgorithmInstant, algorithm)
+}
@@ -0,0 +1,376 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// Schema represents a database schema, which may contain entities such as tables and views.
+// Schema is not in itself an Entity, since it is more of a collection of entities.
+type Schema struct {
+	tables []*CreateTableEntity
+	views  []*CreateViewEntity
+
+	named map[string]Entity
+	// sorted lists the tables by name, followed by the views, each after the views it reads from
+	sorted []Entity
+}
+
+// newEmptySchema is used internally to initialize a Schema object
+func newEmptySchema() *Schema {
+	return &Schema{
+		named: map[string]Entity{},
+	}
+}
+
+// NewSchemaFromEntities creates a valid and normalized schema based on list of entities
+func NewSchemaFromEntities(entities []Entity) (*Schema, error) {
+	schema := newEmptySchema()
+	for _, e := range entities {
+		switch c := e.(type) {
+		case *CreateTableEntity:
+			schema.tables = append(schema.tables, c)
+		case *CreateViewEntity:
+			schema.views = append(schema.views, c)
+		default:
+			return nil, fmt.Errorf("%w: %T", ErrUnsupportedEntity, e)
+		}
+	}
+	if err := schema.normalize(); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// NewSchemaFromStatements creates a valid and normalized schema based on list of valid statements
+func NewSchemaFromStatements(statements []sqlparser.Statement) (*Schema, error) {
+	entities := make([]Entity, 0, len(statements))
+	for _, s := range statements {
+		switch stmt := s.(type) {
+		case *sqlparser.CreateTable:
+			if !stmt.IsFullyParsed() {
+				return nil, ErrNotFullyParsed
+			}
+			entities = append(entities, NewCreateTableEntity(stmt))
+		case *sqlparser.CreateView:
+			if !stmt.IsFullyParsed() {
+				return nil, ErrNotFullyParsed
+			}
+			entities = append(entities, NewCreateViewEntity(stmt))
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedStatement, sqlparser.String(s))
+		}
+	}
+	return NewSchemaFromEntities(entities)
+}
+
+// NewSchemaFromQueries creates a valid and normalized schema based on list of queries
+func NewSchemaFromQueries(queries []string) (*Schema, error) {
+	statements := make([]sqlparser.Statement, 0, len(queries))
+	for _, q := range queries {
+		stmt, err := sqlparser.ParseStrictDDL(q)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, stmt)
+	}
+	return NewSchemaFromStatements(statements)
+}
+
+// NewSchemaFromSQL creates a valid and normalized schema based on a SQL blob that contains
+// CREATE statements for various objects (tables, views)
+func NewSchemaFromSQL(sql string) (*Schema, error) {
+	queries, err := sqlparser.SplitStatementToPieces(sql)
+	if err != nil {
+		return nil, err
+	}
+	var nonEmptyQueries []string
+	for _, q := range queries {
+		if strings.TrimSpace(q) != "" {
+			nonEmptyQueries = append(nonEmptyQueries, q)
+		}
+	}
+	return NewSchemaFromQueries(nonEmptyQueries)
+}
+
+// viewDependencies returns the names of the tables and views the given view reads from. Tables
+// qualified by a database name are not part of the schema, and neither are DUAL or CTEs.
+func viewDependencies(v *CreateViewEntity) []string {
+	cteNames := map[string]bool{}
+	names := map[string]bool{}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.CommonTableExpr:
+			cteNames[node.TableID.String()] = true
+		case *sqlparser.AliasedTableExpr:
+			if tableName, ok := node.Expr.(sqlparser.TableName); ok && tableName.Qualifier.IsEmpty() && !strings.EqualFold(tableName.Name.String(), "dual") {
+				names[tableName.Name.String()] = true
+			}
+		}
+		return true, nil
+	}, v.CreateView.Select)
+
+	dependencies := make([]string, 0, len(names))
+	for name := range names {
+		if !cteNames[name] {
+			dependencies = append(dependencies, name)
+		}
+	}
+	sort.Strings(dependencies)
+	return dependencies
+}
+
+// normalize is called as part of Schema creation process. It validates the entities and sorts them:
+// - tables are sorted by name
+// - views are sorted by name, and then each view follows the views it reads from
+// Validation fails when two entities have the same name, when a view reads from a table or view not
+// in the schema or from itself (possibly through other views), or when a key uses an undefined column.
+func (s *Schema) normalize() error {
+	for _, t := range s.tables {
+		name := t.Name()
+		if _, ok := s.named[name]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateName, name)
+		}
+		s.named[name] = t
+		if err := t.validate(); err != nil {
+			return err
+		}
+	}
+	for _, v := range s.views {
+		name := v.Name()
+		if _, ok := s.named[name]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateName, name)
+		}
+		s.named[name] = v
+	}
+
+	sort.SliceStable(s.tables, func(i, j int) bool {
+		return s.tables[i].Name() < s.tables[j].Name()
+	})
+	sort.SliceStable(s.views, func(i, j int) bool {
+		return s.views[i].Name() < s.views[j].Name()
+	})
+
+	s.sorted = make([]Entity, 0, len(s.named))
+	sortedNames := map[string]bool{}
+	for _, t := range s.tables {
+		s.sorted = append(s.sorted, t)
+		sortedNames[t.Name()] = true
+	}
+	for _, v := range s.views {
+		for _, dependency := range viewDependencies(v) {
+			if _, ok := s.named[dependency]; !ok {
+				return fmt.Errorf("%w: view %s reads from %s", ErrViewDependencyUnresolved, v.Name(), dependency)
+			}
+		}
+	}
+	// add views level by level: each level has the views that only read from tables and from views
+	// of previous levels, sorted by name. If a level is empty, the remaining views read from each
+	// other in a cycle.
+	views := s.views
+	for len(views) > 0 {
+		var level, remaining []*CreateViewEntity
+		for _, v := range views {
+			resolved := true
+			for _, dependency := range viewDependencies(v) {
+				if !sortedNames[dependency] {
+					resolved = false
+					break
+				}
+			}
+			if resolved {
+				level = append(level, v)
+			} else {
+				remaining = append(remaining, v)
+			}
+		}
+		if len(level) == 0 {
+			return fmt.Errorf("%w: view %s", ErrViewDependencyUnresolved, remaining[0].Name())
+		}
+		for _, v := range level {
+			s.sorted = append(s.sorted, v)
+			sortedNames[v.Name()] = true
+		}
+		views = remaining
+	}
+	return nil
+}
+
+// Entities returns this schema's entities in good order (may be applied without error)
+func (s *Schema) Entities() []Entity {
+	return s.sorted
+}
+
+// EntityNames is a convenience function that returns just the names of entities, in good order
+func (s *Schema) EntityNames() []string {
+	var names []string
+	for _, e := range s.Entities() {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+// Tables returns this schema's tables in good order (may be applied without error)
+func (s *Schema) Tables() []*CreateTableEntity {
+	var tables []*CreateTableEntity
+	for _, entity := range s.sorted {
+		if table, ok := entity.(*CreateTableEntity); ok {
+			tables = append(tables, table)
+		}
+	}
+	return tables
+}
+
+// Views returns this schema's views in good order (may be applied without error)
+func (s *Schema) Views() []*CreateViewEntity {
+	var views []*CreateViewEntity
+	for _, entity := range s.sorted {
+		if view, ok := entity.(*CreateViewEntity); ok {
+			views = append(views, view)
+		}
+	}
+	return views
+}
+
+// Table returns a table entity by name, or nil if not found
+func (s *Schema) Table(name string) *CreateTableEntity {
+	if table, ok := s.named[name].(*CreateTableEntity); ok {
+		return table
+	}
+	return nil
+}
+
+// View returns a view entity by name, or nil if not found
+func (s *Schema) View(name string) *CreateViewEntity {
+	if view, ok := s.named[name].(*CreateViewEntity); ok {
+		return view
+	}
+	return nil
+}
+
+// ToStatements returns an ordered list of statements which can be applied to create the schema
+func (s *Schema) ToStatements() []sqlparser.Statement {
+	statements := make([]sqlparser.Statement, 0, len(s.sorted))
+	for _, e := range s.sorted {
+		switch e := e.(type) {
+		case *CreateTableEntity:
+			statements = append(statements, &e.CreateTable)
+		case *CreateViewEntity:
+			statements = append(statements, &e.CreateView)
+		}
+	}
+	return statements
+}
+
+// ToQueries returns an ordered list of queries which can be applied to create the schema
+func (s *Schema) ToQueries() []string {
+	statements := s.ToStatements()
+	queries := make([]string, len(statements))
+	for i, statement := range statements {
+		queries[i] = sqlparser.String(statement)
+	}
+	return queries
+}
+
+// ToSQL returns a SQL blob with ordered sequence of queries which can be applied to create the schema
+func (s *Schema) ToSQL() string {
+	var buf strings.Builder
+	for _, query := range s.ToQueries() {
+		buf.WriteString(query)
+		buf.WriteString(";\n")
+	}
+	return buf.String()
+}
+
+// Diff compares this schema with another schema, and sees what it takes to make this schema look
+// like the other. It returns a list of diffs, in an order in which they can be applied:
+// - views that only exist in this schema are dropped first, each before the views it reads from
+// - tables are then created or altered
+// - views are then created or altered, each after the views it reads from
+// - tables that only exist in this schema are dropped last
+func (s *Schema) Diff(other *Schema, hints *DiffHints) (diffs []EntityDiff, err error) {
+	// dropped views, in reverse order
+	for i := len(s.sorted) - 1; i >= 0; i-- {
+		v, ok := s.sorted[i].(*CreateViewEntity)
+		if !ok {
+			continue
+		}
+		if other.View(v.Name()) == nil {
+			diff, err := DiffViews(&v.CreateView, nil, hints)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, diff)
+		}
+	}
+	// created and altered tables
+	for _, t := range other.Tables() {
+		var diff EntityDiff
+		if fromTable := s.Table(t.Name()); fromTable != nil {
+			diff, err = fromTable.Diff(t, hints)
+		} else {
+			diff, err = DiffTables(nil, &t.CreateTable, hints)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if diff != nil && !diff.IsEmpty() {
+			diffs = append(diffs, diff)
+		}
+	}
+	// dropped tables replaced by views of the same name
+	for _, t := range s.Tables() {
+		if other.View(t.Name()) != nil {
+			diff, err := DiffTables(&t.CreateTable, nil, hints)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, diff)
+		}
+	}
+	// created and altered views
+	for _, v := range other.Views() {
+		var diff EntityDiff
+		if fromView := s.View(v.Name()); fromView != nil {
+			diff, err = fromView.Diff(v, hints)
+		} else {
+			diff, err = DiffViews(nil, &v.CreateView, hints)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if diff != nil && !diff.IsEmpty() {
+			diffs = append(diffs, diff)
+		}
+	}
+	// dropped tables
+	for _, t := range s.Tables() {
+		if other.Table(t.Name()) == nil && other.View(t.Name()) == nil {
+			diff, err := DiffTables(&t.CreateTable, nil, hints)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs, nil
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var createQueries = []string{
+	"create view v5 as select * from t1, (select * from v3) as some_alias",
+	"create table t3(id int, type enum('foo', 'bar') NOT NULL DEFAULT 'foo')",
+	"create table t1(id int)",
+	"create view v6 as select * from v4",
+	"create view v4 as select * from t2 as something_else, v3",
+	"create table t2(id int)",
+	"create table t5(id int)",
+	"create view v2 as select * from v3, t2",
+	"create view v1 as select * from v3",
+	"create view v3 as select *, id+1 as id_plus, id+2 from t3 as t3",
+	"create view v0 as select 1 from DUAL",
+	"create view v9 as with cte as (select id from t1) select * from cte",
+}
+
+var expectSortedNames = []string{
+	"t1",
+	"t2",
+	"t3",
+	"t5",
+	"v0", // level 1 ("dual" is an implicit table)
+	"v3", // level 1
+	"v9", // level 1 (cte is not a dependency)
+	"v1", // level 2
+	"v2", // level 2
+	"v4", // level 2
+	"v5", // level 2
+	"v6", // level 3
+}
+
+func TestNewSchemaFromQueries(t *testing.T) {
+	schema, err := NewSchemaFromQueries(createQueries)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+
+	assert.Equal(t, expectSortedNames, schema.EntityNames())
+	assert.Equal(t, 4, len(schema.Tables()))
+	assert.Equal(t, 8, len(schema.Views()))
+	assert.NotNil(t, schema.Table("t1"))
+	assert.Nil(t, schema.Table("v1"))
+	assert.NotNil(t, schema.View("v1"))
+	assert.Nil(t, schema.View("t1"))
+}
+
+func TestNewSchemaFromSQL(t *testing.T) {
+	schema, err := NewSchemaFromQueries(createQueries)
+	require.NoError(t, err)
+	sql := schema.ToSQL()
+
+	// the SQL of a schema creates the same schema
+	schemaFromSQL, err := NewSchemaFromSQL(sql)
+	require.NoError(t, err)
+	assert.Equal(t, expectSortedNames, schemaFromSQL.EntityNames())
+	assert.Equal(t, sql, schemaFromSQL.ToSQL())
+}
+
+func TestNewSchemaFromQueriesErrors(t *testing.T) {
+	tt := []struct {
+		name    string
+		queries []string
+		err     error
+	}{
+		{
+			name:    "duplicate table",
+			queries: []string{"create table t(id int)", "create table t(id int)"},
+			err:     ErrDuplicateName,
+		},
+		{
+			name:    "view named like a table",
+			queries: []string{"create table t(id int)", "create view t as select 1 from dual"},
+			err:     ErrDuplicateName,
+		},
+		{
+			name:    "unresolved view dependency",
+			queries: []string{"create table t(id int)", "create view v as select * from t, t2"},
+			err:     ErrViewDependencyUnresolved,
+		},
+		{
+			name:    "view dependency loop",
+			queries: []string{"create table t(id int)", "create view v1 as select * from v2", "create view v2 as select * from t, v1"},
+			err:     ErrViewDependencyUnresolved,
+		},
+		{
+			name:    "view reading from itself",
+			queries: []string{"create view v as select * from v"},
+			err:     ErrViewDependencyUnresolved,
+		},
+		{
+			name:    "unknown column in key",
+			queries: []string{"create table t(id int, primary key(id), key i_idx(i))"},
+			err:     ErrInvalidColumnInKey,
+		},
+		{
+			name:    "unsupported statement",
+			queries: []string{"create table t(id int)", "drop table t2"},
+			err:     ErrUnsupportedStatement,
+		},
+	}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			_, err := NewSchemaFromQueries(ts.queries)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, ts.err), err.Error())
+		})
+	}
+}
+
+func TestSchemaDiff(t *testing.T) {
+	tt := []struct {
+		name  string
+		from  []string
+		to    []string
+		diffs []string
+	}{
+		{
+			name: "identical",
+			from: createQueries,
+			to:   createQueries,
+		},
+		{
+			name: "create view after the view it reads from",
+			from: []string{"create table t(id int)"},
+			to: []string{
+				"create table t(id int)",
+				"create view v2 as select * from v1",
+				"create view v1 as select id from t",
+			},
+			diffs: []string{
+				"create view v1 as select id from t",
+				"create view v2 as select * from v1",
+			},
+		},
+		{
+			name: "create view after the table it reads from",
+			from: []string{"create table t1(id int)"},
+			to: []string{
+				"create view v as select * from t1, t2",
+				"create table t2(id int)",
+				"create table t1(id int, i int)",
+			},
+			diffs: []string{
+				"alter table t1 add column i int",
+				"create table t2 (\n\tid int\n)",
+				"create view v as select * from t1, t2",
+			},
+		},
+		{
+			name: "drop view before the table it reads from",
+			from: []string{
+				"create table t1(id int)",
+				"create table t2(id int)",
+				"create view v1 as select * from t2",
+				"create view v2 as select * from v1",
+			},
+			to: []string{"create table t1(id int)"},
+			diffs: []string{
+				"drop view v2",
+				"drop view v1",
+				"drop table t2",
+			},
+		},
+		{
+			name: "alter view before dropping the table it read from",
+			from: []string{
+				"create table t1(id int)",
+				"create table t2(id int)",
+				"create view v as select * from t2",
+			},
+			to: []string{
+				"create table t1(id int)",
+				"create view v as select * from t1",
+			},
+			diffs: []string{
+				"alter view v as select * from t1",
+				"drop table t2",
+			},
+		},
+		{
+			name: "replace table with view",
+			from: []string{"create table t1(id int)", "create table t2(id int)"},
+			to:   []string{"create table t1(id int)", "create view t2 as select * from t1"},
+			diffs: []string{
+				"drop table t2",
+				"create view t2 as select * from t1",
+			},
+		},
+	}
+	hints := &DiffHints{}
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			fromSchema, err := NewSchemaFromQueries(ts.from)
+			require.NoError(t, err)
+			toSchema, err := NewSchemaFromQueries(ts.to)
+			require.NoError(t, err)
+
+			diffs, err := fromSchema.Diff(toSchema, hints)
+			require.NoError(t, err)
+			var statements []string
+			for _, diff := range diffs {
+				statements = append(statements, diff.StatementString())
+			}
+			assert.Equal(t, ts.diffs, statements)
+		})
+	}
+}
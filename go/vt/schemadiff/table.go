@@ -17,14 +17,17 @@ limitations under the License.
 package schemadiff
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/vt/sqlparser"
 )
 
 //
 type AlterTableEntityDiff struct {
+	from       *CreateTableEntity
 	alterTable *sqlparser.AlterTable
 }
 
@@ -110,6 +113,30 @@ func NewCreateTableEntity(c *sqlparser.CreateTable) *CreateTableEntity {
 	return &CreateTableEntity{CreateTable: *c}
 }
 
+// Name implements Entity interface: returns table name
+func (c *CreateTableEntity) Name() string {
+	return c.CreateTable.GetTable().Name.String()
+}
+
+// validate checks that the keys of this table only use columns of this table
+func (c *CreateTableEntity) validate() error {
+	if c.CreateTable.TableSpec == nil {
+		return ErrUnexpectedTableSpec
+	}
+	columns := map[string]bool{}
+	for _, col := range c.CreateTable.TableSpec.Columns {
+		columns[col.Name.Lowered()] = true
+	}
+	for _, key := range c.CreateTable.TableSpec.Indexes {
+		for _, col := range key.Columns {
+			if !columns[col.Column.Lowered()] {
+				return fmt.Errorf("%w: table %s, key %s, column %s", ErrInvalidColumnInKey, c.Name(), key.Info.Name.String(), col.Column.String())
+			}
+		}
+	}
+	return nil
+}
+
 // Diff implements Entity interface function
 func (c *CreateTableEntity) Diff(other Entity, hints *DiffHints) (EntityDiff, error) {
 	otherCreateTable, ok := other.(*CreateTableEntity)
@@ -135,6 +162,11 @@ func (c *CreateTableEntity) Diff(other Entity, hints *DiffHints) (EntityDiff, er
 // It returns an AlterTable statement if changes are found, or nil if not.
 // the other table may be of different name; its name is ignored.
 func (c *CreateTableEntity) TableDiff(other *CreateTableEntity, hints *DiffHints) (*AlterTableEntityDiff, error) {
+	// tables exported from different MySQL versions, or written by hand, may express the same
+	// definition in different ways. We compare their normalized forms.
+	c = c.normalize(hints)
+	other = other.normalize(hints)
+
 	otherStmt := other.CreateTable
 	otherStmt.Table = c.CreateTable.Table
 
@@ -205,7 +237,7 @@ func (c *CreateTableEntity) TableDiff(other *CreateTableEntity, hints *DiffHints
 		// - reordered keys -- we treat that as non-diff
 		return nil, nil
 	}
-	return &AlterTableEntityDiff{alterTable: alterTable}, nil
+	return &AlterTableEntityDiff{from: c, alterTable: alterTable}, nil
 }
 
 func (c *CreateTableEntity) diffTableCharset(
@@ -672,3 +704,209 @@ func (c *CreateTableEntity) diffColumns(alterTable *sqlparser.AlterTable,
 		}
 	}
 }
+
+// normalize returns a copy of this table, canonicalized by the normalization rules that the
+// hints do not turn off. Normalization is lossless: the normalized table is the same table as
+// far as MySQL is concerned.
+func (c *CreateTableEntity) normalize(hints *DiffHints) *CreateTableEntity {
+	if c.CreateTable.TableSpec == nil {
+		return c
+	}
+	normalized := NewCreateTableEntity(sqlparser.CloneRefOfCreateTable(&c.CreateTable))
+	if !hints.StrictUtf8mb3 {
+		normalized.normalizeUtf8mb3()
+	}
+	if !hints.StrictCharsetCollate {
+		normalized.normalizeCharsetCollate(hints.collationEnv())
+	}
+	if !hints.StrictIntDisplayWidth {
+		normalized.normalizeIntDisplayWidth()
+	}
+	if !hints.StrictIndexNames {
+		normalized.normalizeIndexNames()
+	}
+	return normalized
+}
+
+// utf8mb3Name returns the name of a utf8mb3 character set or collation by its older utf8 alias, which
+// is how the collations environment knows it. MySQL 8.0.24 and above report utf8mb3 where
+// earlier versions report utf8.
+func utf8mb3Name(name string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case lower == "utf8mb3":
+		return "utf8"
+	case strings.HasPrefix(lower, "utf8mb3_"):
+		return "utf8_" + strings.TrimPrefix(lower, "utf8mb3_")
+	}
+	return name
+}
+
+// normalizeUtf8mb3 replaces utf8mb3 with utf8 in the character sets and collations of the table and its columns
+func (c *CreateTableEntity) normalizeUtf8mb3() {
+	for _, option := range c.CreateTable.TableSpec.Options {
+		switch strings.ToUpper(option.Name) {
+		case "CHARSET", "COLLATE":
+			option.String = utf8mb3Name(option.String)
+		}
+	}
+	for _, col := range c.CreateTable.TableSpec.Columns {
+		col.Type.Charset = utf8mb3Name(col.Type.Charset)
+		if col.Type.Options != nil {
+			col.Type.Options.Collate = utf8mb3Name(col.Type.Options.Collate)
+		}
+	}
+}
+
+// normalizeCharsetCollate expresses the character sets and collations of the table and its columns
+// in the shortest way that keeps them the same:
+// - the table collation is removed when it is the default collation of the table character set,
+// and the table character set is added when only the collation is specified
+// - a column character set is removed when the column uses the table character set, and a column
+// collation is removed when it is the collation the column gets anyway
+// Character sets and collations unknown to the collations environment are left as they are.
+func (c *CreateTableEntity) normalizeCharsetCollate(env *collations.Environment) {
+	var charsetOption, collateOption *sqlparser.TableOption
+	for _, option := range c.CreateTable.TableSpec.Options {
+		switch strings.ToUpper(option.Name) {
+		case "CHARSET":
+			charsetOption = option
+		case "COLLATE":
+			collateOption = option
+		}
+	}
+
+	var tableCharset, tableCollation string
+	if collateOption != nil {
+		collation := env.LookupByName(strings.ToLower(collateOption.String))
+		if collation == nil {
+			return
+		}
+		tableCollation = collation.Name()
+		tableCharset = collation.Charset().Name()
+		if charsetOption == nil {
+			charsetOption = &sqlparser.TableOption{Name: "charset"}
+			c.CreateTable.TableSpec.Options = append(c.CreateTable.TableSpec.Options, charsetOption)
+		}
+	} else if charsetOption != nil {
+		collation := env.DefaultCollationForCharset(strings.ToLower(charsetOption.String))
+		if collation == nil {
+			return
+		}
+		tableCollation = collation.Name()
+		tableCharset = collation.Charset().Name()
+	}
+	if charsetOption != nil {
+		charsetOption.String = tableCharset
+	}
+	if collateOption != nil {
+		if defaultCollation := env.DefaultCollationForCharset(tableCharset); defaultCollation != nil && defaultCollation.Name() == tableCollation {
+			// the table collation is implied by the table character set
+			var options sqlparser.TableOptions
+			for _, option := range c.CreateTable.TableSpec.Options {
+				if option != collateOption {
+					options = append(options, option)
+				}
+			}
+			c.CreateTable.TableSpec.Options = options
+		} else {
+			collateOption.String = tableCollation
+		}
+	}
+
+	for _, col := range c.CreateTable.TableSpec.Columns {
+		if col.Type.Options == nil || !NewColumnDefinitionEntity(col).IsTextual() {
+			continue
+		}
+		// evaluate the character set and collation of the column
+		var colCharset, colCollation string
+		switch {
+		case col.Type.Options.Collate != "":
+			collation := env.LookupByName(strings.ToLower(col.Type.Options.Collate))
+			if collation == nil {
+				continue
+			}
+			colCollation = collation.Name()
+			colCharset = collation.Charset().Name()
+		case col.Type.Charset != "":
+			collation := env.DefaultCollationForCharset(strings.ToLower(col.Type.Charset))
+			if collation == nil {
+				continue
+			}
+			colCollation = collation.Name()
+			colCharset = collation.Charset().Name()
+		default:
+			// the column uses the table character set and collation
+			continue
+		}
+		defaultCollation := env.DefaultCollationForCharset(colCharset)
+		switch {
+		case colCollation == tableCollation:
+			col.Type.Charset = ""
+			col.Type.Options.Collate = ""
+		case colCharset == tableCharset:
+			col.Type.Charset = ""
+			col.Type.Options.Collate = colCollation
+		case defaultCollation != nil && colCollation == defaultCollation.Name():
+			col.Type.Charset = colCharset
+			col.Type.Options.Collate = ""
+		default:
+			col.Type.Charset = colCharset
+			col.Type.Options.Collate = colCollation
+		}
+	}
+}
+
+var integralTypes = map[string]bool{
+	"TINYINT":   true,
+	"SMALLINT":  true,
+	"MEDIUMINT": true,
+	"INT":       true,
+	"INTEGER":   true,
+	"BIGINT":    true,
+}
+
+// normalizeIntDisplayWidth removes the display width of integer columns, which MySQL 8.0.19 and
+// above deprecate and do not report. The width is kept for ZEROFILL columns, where it affects how
+// values are displayed, and for TINYINT(1), which MySQL keeps reporting as it is used for booleans.
+// INTEGER is also normalized to INT.
+func (c *CreateTableEntity) normalizeIntDisplayWidth() {
+	for _, col := range c.CreateTable.TableSpec.Columns {
+		colType := strings.ToUpper(col.Type.Type)
+		if !integralTypes[colType] {
+			continue
+		}
+		if colType == "INTEGER" {
+			col.Type.Type = "int"
+		}
+		if col.Type.Zerofill || col.Type.Length == nil {
+			continue
+		}
+		if colType == "TINYINT" && col.Type.Length.Val == "1" {
+			continue
+		}
+		col.Type.Length = nil
+	}
+}
+
+// normalizeIndexNames names the unnamed keys the way MySQL does: after their first column, with
+// a _2, _3, ... suffix when the name is already used.
+func (c *CreateTableEntity) normalizeIndexNames() {
+	names := map[string]bool{"primary": true}
+	for _, key := range c.CreateTable.TableSpec.Indexes {
+		if !key.Info.Name.IsEmpty() {
+			names[key.Info.Name.Lowered()] = true
+		}
+	}
+	for _, key := range c.CreateTable.TableSpec.Indexes {
+		if key.Info.Primary || !key.Info.Name.IsEmpty() || len(key.Columns) == 0 || key.Columns[0].Column.IsEmpty() {
+			continue
+		}
+		name := key.Columns[0].Column.String()
+		for suffix := 2; names[strings.ToLower(name)]; suffix++ {
+			name = fmt.Sprintf("%s_%d", key.Columns[0].Column.String(), suffix)
+		}
+		names[strings.ToLower(name)] = true
+		key.Info.Name = sqlparser.NewColIdent(name)
+	}
+}
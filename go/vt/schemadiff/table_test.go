@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/vt/sqlparser"
 )
 
@@ -526,3 +527,141 @@ func TestCreateTableDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateTableDiffNormalization(t *testing.T) {
+	tt := []struct {
+		name  string
+		from  string
+		to    string
+		diff  string
+		hints DiffHints
+	}{
+		{
+			name: "utf8mb3",
+			from: "create table t (id int primary key, v varchar(10) charset utf8) charset utf8",
+			to:   "create table t (id int primary key, v varchar(10) charset utf8mb3) charset utf8mb3",
+		},
+		{
+			name:  "strict utf8mb3",
+			from:  "create table t (id int primary key) charset utf8",
+			to:    "create table t (id int primary key) charset utf8mb3",
+			diff:  "alter table t charset utf8mb3",
+			hints: DiffHints{StrictUtf8mb3: true},
+		},
+		{
+			name: "utf8mb3 collation",
+			from: "create table t (id int primary key, v varchar(10) collate utf8_bin)",
+			to:   "create table t (id int primary key, v varchar(10) collate utf8mb3_bin)",
+		},
+		{
+			name: "default table collation",
+			from: "create table t (id int primary key) charset utf8mb4",
+			to:   "create table t (id int primary key) charset utf8mb4 collate utf8mb4_0900_ai_ci",
+		},
+		{
+			name: "table collation implies charset",
+			from: "create table t (id int primary key) collate utf8mb4_bin",
+			to:   "create table t (id int primary key) charset utf8mb4 collate utf8mb4_bin",
+		},
+		{
+			name: "table collation change",
+			from: "create table t (id int primary key) charset utf8mb4",
+			to:   "create table t (id int primary key) charset utf8mb4 collate utf8mb4_bin",
+			diff: "alter table t collate utf8mb4_bin",
+		},
+		{
+			name: "column charset and collation of the table",
+			from: "create table t (id int primary key, v varchar(10)) charset utf8mb4",
+			to:   "create table t (id int primary key, v varchar(10) charset utf8mb4 collate utf8mb4_0900_ai_ci) charset utf8mb4",
+		},
+		{
+			name: "column collation of the table charset",
+			from: "create table t (id int primary key, v varchar(10) collate utf8mb4_bin) charset utf8mb4",
+			to:   "create table t (id int primary key, v varchar(10) charset utf8mb4 collate utf8mb4_bin) charset utf8mb4",
+		},
+		{
+			name: "column default collation of another charset",
+			from: "create table t (id int primary key, v varchar(10) charset latin1) charset utf8mb4",
+			to:   "create table t (id int primary key, v varchar(10) charset latin1 collate latin1_swedish_ci) charset utf8mb4",
+		},
+		{
+			name: "column collation change",
+			from: "create table t (id int primary key, v varchar(10) charset utf8mb4) charset utf8mb4",
+			to:   "create table t (id int primary key, v varchar(10) charset utf8mb4 collate utf8mb4_bin) charset utf8mb4",
+			diff: "alter table t modify column v varchar(10) collate utf8mb4_bin",
+		},
+		{
+			name:  "strict charset and collation",
+			from:  "create table t (id int primary key, v varchar(10)) charset utf8mb4",
+			to:    "create table t (id int primary key, v varchar(10) charset utf8mb4) charset utf8mb4",
+			diff:  "alter table t modify column v varchar(10) character set utf8mb4",
+			hints: DiffHints{StrictCharsetCollate: true},
+		},
+		{
+			name: "int display width",
+			from: "create table t (id int(11) primary key, i integer, b bigint(20) unsigned, f tinyint(1))",
+			to:   "create table t (id int primary key, i int(10), b bigint unsigned, f tinyint(1))",
+		},
+		{
+			name: "zerofill display width",
+			from: "create table t (id int primary key, i int(5) zerofill)",
+			to:   "create table t (id int primary key, i int(6) zerofill)",
+			diff: "alter table t modify column i int(6) zerofill",
+		},
+		{
+			name: "tinyint(1)",
+			from: "create table t (id int primary key, f tinyint(1))",
+			to:   "create table t (id int primary key, f tinyint(4))",
+			diff: "alter table t modify column f tinyint",
+		},
+		{
+			name:  "strict int display width",
+			from:  "create table t (id int(11) primary key)",
+			to:    "create table t (id int primary key)",
+			diff:  "alter table t modify column id int primary key",
+			hints: DiffHints{StrictIntDisplayWidth: true},
+		},
+		{
+			name: "default index names",
+			from: "create table t (id int primary key, i int, j int, key (i), key (i, j), key j (j))",
+			to:   "create table t (id int primary key, i int, j int, key i (i), key i_2 (i, j), key j (j))",
+		},
+		{
+			name: "default index name taken",
+			from: "create table t (id int primary key, i int, j int, key (i), key i (j))",
+			to:   "create table t (id int primary key, i int, j int, key i_2 (i), key i (j))",
+		},
+		{
+			name:  "strict index names",
+			from:  "create table t (id int primary key, i int, key i (i))",
+			to:    "create table t (id int primary key, i int, key (i))",
+			diff:  "alter table t drop key i, add key (i)",
+			hints: DiffHints{StrictIndexNames: true},
+		},
+	}
+	env := collations.NewEnvironment("8.0.28")
+	for _, ts := range tt {
+		t.Run(ts.name, func(t *testing.T) {
+			fromStmt, err := sqlparser.Parse(ts.from)
+			require.NoError(t, err)
+			fromCreateTable, ok := fromStmt.(*sqlparser.CreateTable)
+			require.True(t, ok)
+
+			toStmt, err := sqlparser.Parse(ts.to)
+			require.NoError(t, err)
+			toCreateTable, ok := toStmt.(*sqlparser.CreateTable)
+			require.True(t, ok)
+
+			hints := ts.hints
+			hints.CollationEnv = env
+			alter, err := NewCreateTableEntity(fromCreateTable).Diff(NewCreateTableEntity(toCreateTable), &hints)
+			require.NoError(t, err)
+			if ts.diff == "" {
+				assert.True(t, alter.IsEmpty(), "expected empty diff, found: %v", alter.StatementString())
+			} else {
+				require.False(t, alter.IsEmpty(), "expected changes, found empty diff")
+				assert.Equal(t, ts.diff, alter.StatementString())
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package schemadiff
 import (
 	"errors"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/vt/sqlparser"
 )
 
@@ -32,9 +33,16 @@ var (
 	ErrNotFullyParsed                 = errors.New("unable to fully parse statement")
 	ErrExpectedCreateTable            = errors.New("expected a CREATE TABLE statement")
 	ErrExpectedCreateView             = errors.New("expected a CREATE VIEW statement")
+	ErrUnsupportedEntity              = errors.New("unsupported entity type")
+	ErrUnsupportedStatement           = errors.New("unsupported statement")
+	ErrDuplicateName                  = errors.New("duplicate name")
+	ErrViewDependencyUnresolved       = errors.New("views have unresolved/loop dependencies")
+	ErrInvalidColumnInKey             = errors.New("invalid column referenced by key")
+	ErrInvalidColumnInAlter           = errors.New("invalid column referenced by alter")
 )
 
 type Entity interface {
+	Name() string
 	Diff(other Entity, hints *DiffHints) (diff EntityDiff, err error)
 }
 
@@ -50,7 +58,26 @@ const (
 	AutoIncrementApplyAlways
 )
 
+// DiffHints customize how entities are diffed. By default, tables are normalized before they are
+// diffed; each of the Strict* hints turns one normalization rule off:
+// - StrictCharsetCollate: keep character sets and collations implied by the table or character set
+// - StrictUtf8mb3: keep utf8mb3 apart from utf8
+// - StrictIntDisplayWidth: keep integer display widths, and INTEGER apart from INT
+// - StrictIndexNames: keep keys unnamed, rather than naming them as MySQL does
 type DiffHints struct {
 	StrictIndexOrdering   bool
 	AutoIncrementStrategy int
+	StrictCharsetCollate  bool
+	StrictUtf8mb3         bool
+	StrictIntDisplayWidth bool
+	StrictIndexNames      bool
+	// CollationEnv resolves character sets and collations. It defaults to collations.Local()
+	CollationEnv *collations.Environment
+}
+
+func (hints *DiffHints) collationEnv() *collations.Environment {
+	if hints.CollationEnv != nil {
+		return hints.CollationEnv
+	}
+	return collations.Local()
 }
@@ -75,7 +75,7 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtSet
 	case *Show:
 		return StmtShow
-	case DDLStatement, DBDDLStatement, *AlterVschema:
+	case DDLStatement, DBDDLStatement, *AlterVschema, *MaterializedViewDDL:
 		return StmtDDL
 	case *RevertMigration:
 		return StmtRevert
@@ -188,6 +188,8 @@ func Preview(sql string) StatementType {
 		return StmtVStream
 	case "revert":
 		return StmtRevert
+	case "refresh":
+		return StmtDDL
 	case "insert":
 		return StmtInsert
 	case "replace":
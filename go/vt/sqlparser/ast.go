@@ -134,7 +134,7 @@ type (
 
 	// With contains the lists of common table expression and specifies if it is recursive or not
 	With struct {
-		ctes      []*CommonTableExpr
+		CTEs      []*CommonTableExpr
 		Recursive bool
 	}
 
@@ -441,6 +441,19 @@ type (
 		AutoIncSpec *AutoIncSpec
 	}
 
+	// MaterializedViewDDL represents a CREATE, DROP or REFRESH MATERIALIZED VIEW statement.
+	// Materialized views are tables kept up to date by a VReplication workflow.
+	MaterializedViewDDL struct {
+		Action   DDLAction
+		ViewName TableName
+
+		// Select is set for CreateDDLAction.
+		Select SelectStatement
+
+		// IfExists is set for DropDDLAction.
+		IfExists bool
+	}
+
 	// ShowMigrationLogs represents a SHOW VITESS_MIGRATION '<uuid>' LOGS statement
 	ShowMigrationLogs struct {
 		UUID     string
@@ -626,49 +639,50 @@ type (
 	OtherAdmin struct{}
 )
 
-func (*Union) iStatement()             {}
-func (*Select) iStatement()            {}
-func (*Stream) iStatement()            {}
-func (*VStream) iStatement()           {}
-func (*Insert) iStatement()            {}
-func (*Update) iStatement()            {}
-func (*Delete) iStatement()            {}
-func (*Set) iStatement()               {}
-func (*SetTransaction) iStatement()    {}
-func (*DropDatabase) iStatement()      {}
-func (*Flush) iStatement()             {}
-func (*Show) iStatement()              {}
-func (*Use) iStatement()               {}
-func (*Begin) iStatement()             {}
-func (*Commit) iStatement()            {}
-func (*Rollback) iStatement()          {}
-func (*SRollback) iStatement()         {}
-func (*Savepoint) iStatement()         {}
-func (*Release) iStatement()           {}
-func (*OtherRead) iStatement()         {}
-func (*OtherAdmin) iStatement()        {}
-func (*Select) iSelectStatement()      {}
-func (*Union) iSelectStatement()       {}
-func (*Load) iStatement()              {}
-func (*CreateDatabase) iStatement()    {}
-func (*AlterDatabase) iStatement()     {}
-func (*CreateTable) iStatement()       {}
-func (*CreateView) iStatement()        {}
-func (*AlterView) iStatement()         {}
-func (*LockTables) iStatement()        {}
-func (*UnlockTables) iStatement()      {}
-func (*AlterTable) iStatement()        {}
-func (*AlterVschema) iStatement()      {}
-func (*AlterMigration) iStatement()    {}
-func (*RevertMigration) iStatement()   {}
-func (*ShowMigrationLogs) iStatement() {}
-func (*DropTable) iStatement()         {}
-func (*DropView) iStatement()          {}
-func (*TruncateTable) iStatement()     {}
-func (*RenameTable) iStatement()       {}
-func (*CallProc) iStatement()          {}
-func (*ExplainStmt) iStatement()       {}
-func (*ExplainTab) iStatement()        {}
+func (*Union) iStatement()               {}
+func (*Select) iStatement()              {}
+func (*Stream) iStatement()              {}
+func (*VStream) iStatement()             {}
+func (*Insert) iStatement()              {}
+func (*Update) iStatement()              {}
+func (*Delete) iStatement()              {}
+func (*Set) iStatement()                 {}
+func (*SetTransaction) iStatement()      {}
+func (*DropDatabase) iStatement()        {}
+func (*Flush) iStatement()               {}
+func (*Show) iStatement()                {}
+func (*Use) iStatement()                 {}
+func (*Begin) iStatement()               {}
+func (*Commit) iStatement()              {}
+func (*Rollback) iStatement()            {}
+func (*SRollback) iStatement()           {}
+func (*Savepoint) iStatement()           {}
+func (*Release) iStatement()             {}
+func (*OtherRead) iStatement()           {}
+func (*OtherAdmin) iStatement()          {}
+func (*Select) iSelectStatement()        {}
+func (*Union) iSelectStatement()         {}
+func (*Load) iStatement()                {}
+func (*CreateDatabase) iStatement()      {}
+func (*AlterDatabase) iStatement()       {}
+func (*CreateTable) iStatement()         {}
+func (*CreateView) iStatement()          {}
+func (*AlterView) iStatement()           {}
+func (*LockTables) iStatement()          {}
+func (*UnlockTables) iStatement()        {}
+func (*AlterTable) iStatement()          {}
+func (*AlterVschema) iStatement()        {}
+func (*MaterializedViewDDL) iStatement() {}
+func (*AlterMigration) iStatement()      {}
+func (*RevertMigration) iStatement()     {}
+func (*ShowMigrationLogs) iStatement()   {}
+func (*DropTable) iStatement()           {}
+func (*DropView) iStatement()            {}
+func (*TruncateTable) iStatement()       {}
+func (*RenameTable) iStatement()         {}
+func (*CallProc) iStatement()            {}
+func (*ExplainStmt) iStatement()         {}
+func (*ExplainTab) iStatement()          {}
 
 func (*CreateView) iDDLStatement()    {}
 func (*AlterView) iDDLStatement()     {}
@@ -2085,6 +2099,7 @@ type (
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
@@ -2257,6 +2272,35 @@ type Order struct {
 // OrderDirection is an enum for the direction in which to order - asc or desc.
 type OrderDirection int8
 
+// OverClause represents the window specification of a window function call:
+// OVER ([PARTITION BY ...] [ORDER BY ...] [frame_clause])
+type OverClause struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+// FrameClause represents the frame of a window specification.
+// End is nil when the frame only specifies its start.
+type FrameClause struct {
+	Unit  FrameUnitType
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FrameUnitType is an enum for the unit of a window frame - rows or range.
+type FrameUnitType int8
+
+// FramePoint represents one boundary of a window frame.
+// Expr is only set for the ExprPrecedingType and ExprFollowingType boundaries.
+type FramePoint struct {
+	Type FramePointType
+	Expr Expr
+}
+
+// FramePointType is an enum for the type of a window frame boundary.
+type FramePointType int8
+
 // Limit represents a LIMIT clause.
 type Limit struct {
 	Offset, Rowcount Expr
@@ -145,6 +145,10 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfForce(in)
 	case *ForeignKeyDefinition:
 		return CloneRefOfForeignKeyDefinition(in)
+	case *FrameClause:
+		return CloneRefOfFrameClause(in)
+	case *FramePoint:
+		return CloneRefOfFramePoint(in)
 	case *FuncExpr:
 		return CloneRefOfFuncExpr(in)
 	case GroupBy:
@@ -189,6 +193,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfLockTables(in)
 	case *MatchExpr:
 		return CloneRefOfMatchExpr(in)
+	case *MaterializedViewDDL:
+		return CloneRefOfMaterializedViewDDL(in)
 	case *ModifyColumn:
 		return CloneRefOfModifyColumn(in)
 	case *Nextval:
@@ -213,6 +219,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfOtherAdmin(in)
 	case *OtherRead:
 		return CloneRefOfOtherRead(in)
+	case *OverClause:
+		return CloneRefOfOverClause(in)
 	case *ParenTableExpr:
 		return CloneRefOfParenTableExpr(in)
 	case *PartitionDefinition:
@@ -970,6 +978,27 @@ func CloneRefOfForeignKeyDefinition(n *ForeignKeyDefinition) *ForeignKeyDefiniti
 	return &out
 }
 
+// CloneRefOfFrameClause creates a deep clone of the input.
+func CloneRefOfFrameClause(n *FrameClause) *FrameClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Start = CloneRefOfFramePoint(n.Start)
+	out.End = CloneRefOfFramePoint(n.End)
+	return &out
+}
+
+// CloneRefOfFramePoint creates a deep clone of the input.
+func CloneRefOfFramePoint(n *FramePoint) *FramePoint {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Expr = CloneExpr(n.Expr)
+	return &out
+}
+
 // CloneRefOfFuncExpr creates a deep clone of the input.
 func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
 	if n == nil {
@@ -979,6 +1008,7 @@ func CloneRefOfFuncExpr(n *FuncExpr) *FuncExpr {
 	out.Qualifier = CloneTableIdent(n.Qualifier)
 	out.Name = CloneColIdent(n.Name)
 	out.Exprs = CloneSelectExprs(n.Exprs)
+	out.Over = CloneRefOfOverClause(n.Over)
 	return &out
 }
 
@@ -1187,6 +1217,17 @@ func CloneRefOfMatchExpr(n *MatchExpr) *MatchExpr {
 	return &out
 }
 
+// CloneRefOfMaterializedViewDDL creates a deep clone of the input.
+func CloneRefOfMaterializedViewDDL(n *MaterializedViewDDL) *MaterializedViewDDL {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.ViewName = CloneTableName(n.ViewName)
+	out.Select = CloneSelectStatement(n.Select)
+	return &out
+}
+
 // CloneRefOfModifyColumn creates a deep clone of the input.
 func CloneRefOfModifyColumn(n *ModifyColumn) *ModifyColumn {
 	if n == nil {
@@ -1310,6 +1351,18 @@ func CloneRefOfOtherRead(n *OtherRead) *OtherRead {
 	return &out
 }
 
+// CloneRefOfOverClause creates a deep clone of the input.
+func CloneRefOfOverClause(n *OverClause) *OverClause {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.PartitionBy = CloneExprs(n.PartitionBy)
+	out.OrderBy = CloneOrderBy(n.OrderBy)
+	out.Frame = CloneRefOfFrameClause(n.Frame)
+	return &out
+}
+
 // CloneRefOfParenTableExpr creates a deep clone of the input.
 func CloneRefOfParenTableExpr(n *ParenTableExpr) *ParenTableExpr {
 	if n == nil {
@@ -1967,7 +2020,7 @@ func CloneRefOfWith(n *With) *With {
 		return nil
 	}
 	out := *n
-	out.ctes = CloneSliceOfRefOfCommonTableExpr(n.ctes)
+	out.CTEs = CloneSliceOfRefOfCommonTableExpr(n.CTEs)
 	return &out
 }
 
@@ -2394,6 +2447,8 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfLoad(in)
 	case *LockTables:
 		return CloneRefOfLockTables(in)
+	case *MaterializedViewDDL:
+		return CloneRefOfMaterializedViewDDL(in)
 	case *OtherAdmin:
 		return CloneRefOfOtherAdmin(in)
 	case *OtherRead:
@@ -2552,7 +2607,7 @@ func CloneSliceOfString(n []string) []string {
 	if n == nil {
 		return nil
 	}
-	res := make([]string, 0, len(n))
+	res := make([]string, len(n))
 	copy(res, n)
 	return res
 }
@@ -392,6 +392,18 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfForeignKeyDefinition(a, b)
+	case *FrameClause:
+		b, ok := inB.(*FrameClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFrameClause(a, b)
+	case *FramePoint:
+		b, ok := inB.(*FramePoint)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfFramePoint(a, b)
 	case *FuncExpr:
 		b, ok := inB.(*FuncExpr)
 		if !ok {
@@ -524,6 +536,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfMatchExpr(a, b)
+	case *MaterializedViewDDL:
+		b, ok := inB.(*MaterializedViewDDL)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfMaterializedViewDDL(a, b)
 	case *ModifyColumn:
 		b, ok := inB.(*ModifyColumn)
 		if !ok {
@@ -596,6 +614,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfOtherRead(a, b)
+	case *OverClause:
+		b, ok := inB.(*OverClause)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfOverClause(a, b)
 	case *ParenTableExpr:
 		b, ok := inB.(*ParenTableExpr)
 		if !ok {
@@ -1726,6 +1750,31 @@ func EqualsRefOfForeignKeyDefinition(a, b *ForeignKeyDefinition) bool {
 		EqualsRefOfReferenceDefinition(a.ReferenceDefinition, b.ReferenceDefinition)
 }
 
+// EqualsRefOfFrameClause does deep equals between the two objects.
+func EqualsRefOfFrameClause(a, b *FrameClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Unit == b.Unit &&
+		EqualsRefOfFramePoint(a.Start, b.Start) &&
+		EqualsRefOfFramePoint(a.End, b.End)
+}
+
+// EqualsRefOfFramePoint does deep equals between the two objects.
+func EqualsRefOfFramePoint(a, b *FramePoint) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		EqualsExpr(a.Expr, b.Expr)
+}
+
 // EqualsRefOfFuncExpr does deep equals between the two objects.
 func EqualsRefOfFuncExpr(a, b *FuncExpr) bool {
 	if a == b {
@@ -1737,7 +1786,8 @@ func EqualsRefOfFuncExpr(a, b *FuncExpr) bool {
 	return a.Distinct == b.Distinct &&
 		EqualsTableIdent(a.Qualifier, b.Qualifier) &&
 		EqualsColIdent(a.Name, b.Name) &&
-		EqualsSelectExprs(a.Exprs, b.Exprs)
+		EqualsSelectExprs(a.Exprs, b.Exprs) &&
+		EqualsRefOfOverClause(a.Over, b.Over)
 }
 
 // EqualsGroupBy does deep equals between the two objects.
@@ -1985,6 +2035,20 @@ func EqualsRefOfMatchExpr(a, b *MatchExpr) bool {
 		a.Option == b.Option
 }
 
+// EqualsRefOfMaterializedViewDDL does deep equals between the two objects.
+func EqualsRefOfMaterializedViewDDL(a, b *MaterializedViewDDL) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IfExists == b.IfExists &&
+		a.Action == b.Action &&
+		EqualsTableName(a.ViewName, b.ViewName) &&
+		EqualsSelectStatement(a.Select, b.Select)
+}
+
 // EqualsRefOfModifyColumn does deep equals between the two objects.
 func EqualsRefOfModifyColumn(a, b *ModifyColumn) bool {
 	if a == b {
@@ -2125,6 +2189,19 @@ func EqualsRefOfOtherRead(a, b *OtherRead) bool {
 	return true
 }
 
+// EqualsRefOfOverClause does deep equals between the two objects.
+func EqualsRefOfOverClause(a, b *OverClause) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return EqualsExprs(a.PartitionBy, b.PartitionBy) &&
+		EqualsOrderBy(a.OrderBy, b.OrderBy) &&
+		EqualsRefOfFrameClause(a.Frame, b.Frame)
+}
+
 // EqualsRefOfParenTableExpr does deep equals between the two objects.
 func EqualsRefOfParenTableExpr(a, b *ParenTableExpr) bool {
 	if a == b {
@@ -2891,7 +2968,7 @@ func EqualsRefOfWith(a, b *With) bool {
 		return false
 	}
 	return a.Recursive == b.Recursive &&
-		EqualsSliceOfRefOfCommonTableExpr(a.ctes, b.ctes)
+		EqualsSliceOfRefOfCommonTableExpr(a.CTEs, b.CTEs)
 }
 
 // EqualsRefOfXorExpr does deep equals between the two objects.
@@ -3839,6 +3916,12 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfLockTables(a, b)
+	case *MaterializedViewDDL:
+		b, ok := inB.(*MaterializedViewDDL)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfMaterializedViewDDL(a, b)
 	case *OtherAdmin:
 		b, ok := inB.(*OtherAdmin)
 		if !ok {
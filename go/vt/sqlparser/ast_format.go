@@ -126,11 +126,11 @@ func (node *With) Format(buf *TrackedBuffer) {
 	if node.Recursive {
 		buf.astPrintf(node, "recursive ")
 	}
-	ctesLength := len(node.ctes)
+	ctesLength := len(node.CTEs)
 	for i := 0; i < ctesLength-1; i++ {
-		buf.astPrintf(node, "%v, ", node.ctes[i])
+		buf.astPrintf(node, "%v, ", node.CTEs[i])
 	}
-	buf.astPrintf(node, "%v", node.ctes[ctesLength-1])
+	buf.astPrintf(node, "%v", node.CTEs[ctesLength-1])
 }
 
 // Format formats the node.
@@ -286,6 +286,22 @@ func (node *ShowMigrationLogs) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "show vitess_migration '%s' logs", node.UUID)
 }
 
+// Format formats the node.
+func (node *MaterializedViewDDL) Format(buf *TrackedBuffer) {
+	switch node.Action {
+	case CreateDDLAction:
+		buf.astPrintf(node, "create materialized view %v as %v", node.ViewName, node.Select)
+	case DropDDLAction:
+		exists := ""
+		if node.IfExists {
+			exists = " if exists"
+		}
+		buf.astPrintf(node, "drop materialized view%s %v", exists, node.ViewName)
+	default:
+		buf.astPrintf(node, "%s materialized view %v", node.Action.ToString(), node.ViewName)
+	}
+}
+
 // Format formats the node.
 func (node *OptLike) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "like %v", node.LikeTable)
@@ -1256,6 +1272,50 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 		buf.WriteString(funcName)
 	}
 	buf.astPrintf(node, "(%s%v)", distinct, node.Exprs)
+	if node.Over != nil {
+		buf.astPrintf(node, " %v", node.Over)
+	}
+}
+
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	buf.WriteString("over (")
+	var prefix string
+	if len(node.PartitionBy) > 0 {
+		buf.astPrintf(node, "partition by %v", node.PartitionBy)
+		prefix = " "
+	}
+	if len(node.OrderBy) > 0 {
+		buf.astPrintf(node, "%sorder by ", prefix)
+		for i, n := range node.OrderBy {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.astPrintf(node, "%v", n)
+		}
+		prefix = " "
+	}
+	if node.Frame != nil {
+		buf.astPrintf(node, "%s%v", prefix, node.Frame)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.astPrintf(node, "%s %v", node.Unit.ToString(), node.Start)
+		return
+	}
+	buf.astPrintf(node, "%s between %v and %v", node.Unit.ToString(), node.Start, node.End)
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	if node.Expr != nil {
+		buf.astPrintf(node, "%v ", node.Expr)
+	}
+	buf.WriteString(node.Type.ToString())
 }
 
 // Format formats the node
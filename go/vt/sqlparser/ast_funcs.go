@@ -588,8 +588,15 @@ var Aggregates = map[string]bool{
 }
 
 // IsAggregate returns true if the function is an aggregate.
+// Aggregate functions used with an OVER clause are window functions
+// and do not group rows, so they are not considered aggregates.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
+}
+
+// IsWindowFunction returns true if the function is evaluated over a window.
+func (node *FuncExpr) IsWindowFunction() bool {
+	return node.Over != nil
 }
 
 // NewColIdent makes a new ColIdent.
@@ -1010,6 +1017,8 @@ func (action DDLAction) ToString() string {
 		return AddSequenceStr
 	case AddAutoIncDDLAction:
 		return AddAutoIncStr
+	case RefreshDDLAction:
+		return RefreshStr
 	default:
 		return "Unknown DDL Action"
 	}
@@ -1255,6 +1264,36 @@ func (dir OrderDirection) ToString() string {
 	}
 }
 
+// ToString returns the unit as a string
+func (ty FrameUnitType) ToString() string {
+	switch ty {
+	case RowsUnit:
+		return RowsUnitStr
+	case RangeUnit:
+		return RangeUnitStr
+	default:
+		return "Unknown FrameUnitType"
+	}
+}
+
+// ToString returns the type as a string
+func (ty FramePointType) ToString() string {
+	switch ty {
+	case CurrentRowType:
+		return CurrentRowStr
+	case UnboundedPrecedingType:
+		return UnboundedPrecedingStr
+	case UnboundedFollowingType:
+		return UnboundedFollowingStr
+	case ExprPrecedingType:
+		return PrecedingStr
+	case ExprFollowingType:
+		return FollowingStr
+	default:
+		return "Unknown FramePointType"
+	}
+}
+
 // ToString returns the type as a string
 func (ty IndexHintType) ToString() string {
 	switch ty {
@@ -1458,6 +1497,8 @@ func (ty ShowCommandType) ToString() string {
 		return WarningsStr
 	case Keyspace:
 		return KeyspaceStr
+	case MaterializedViews:
+		return MaterializedViewsStr
 	default:
 		return "" +
 			"Unknown ShowCommandType"
@@ -1581,6 +1622,19 @@ func IsAggregation(node SQLNode) bool {
 	return false
 }
 
+// ContainsWindowFunction returns true if the expression contains a window function
+func ContainsWindowFunction(e SQLNode) bool {
+	hasWindowFunction := false
+	_ = Walk(func(node SQLNode) (kontinue bool, err error) {
+		if fExpr, ok := node.(*FuncExpr); ok && fExpr.IsWindowFunction() {
+			hasWindowFunction = true
+			return false, nil
+		}
+		return true, nil
+	}, e)
+	return hasWindowFunction
+}
+
 // GetFirstSelect gets the first select statement
 func GetFirstSelect(selStmt SelectStatement) *Select {
 	if selStmt == nil {
@@ -1629,6 +1683,11 @@ func (es *ExtractedSubquery) GetHasValuesArg() string {
 
 }
 
+// GetAlternative returns the expression that replaces the subquery once its result has been bound to arguments.
+func (es *ExtractedSubquery) GetAlternative() Expr {
+	return es.alternative
+}
+
 func (es *ExtractedSubquery) updateAlternative() {
 	switch original := es.Original.(type) {
 	case *ExistsExpr:
@@ -80,3 +80,28 @@ func TestAddQueryHint(t *testing.T) {
 		})
 	}
 }
+
+func TestContainsWindowFunction(t *testing.T) {
+	tcs := []struct {
+		expr        string
+		window      bool
+		aggregation bool
+	}{
+		{expr: "count(*)", window: false, aggregation: true},
+		{expr: "count(*) over ()", window: true, aggregation: false},
+		{expr: "sum(a) over (partition by b)", window: true, aggregation: false},
+		{expr: "row_number() over (order by a) + 1", window: true, aggregation: false},
+		{expr: "rank() over (order by max(a))", window: true, aggregation: true},
+		{expr: "a + b", window: false, aggregation: false},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.expr, func(t *testing.T) {
+			stmt, err := Parse("select " + tc.expr + " from t")
+			require.NoError(t, err)
+			expr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+			assert.Equal(t, tc.window, ContainsWindowFunction(expr))
+			assert.Equal(t, tc.aggregation, ContainsAggregation(expr))
+		})
+	}
+}
@@ -144,6 +144,10 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfForce(in, f)
 	case *ForeignKeyDefinition:
 		return VisitRefOfForeignKeyDefinition(in, f)
+	case *FrameClause:
+		return VisitRefOfFrameClause(in, f)
+	case *FramePoint:
+		return VisitRefOfFramePoint(in, f)
 	case *FuncExpr:
 		return VisitRefOfFuncExpr(in, f)
 	case GroupBy:
@@ -188,6 +192,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfLockTables(in, f)
 	case *MatchExpr:
 		return VisitRefOfMatchExpr(in, f)
+	case *MaterializedViewDDL:
+		return VisitRefOfMaterializedViewDDL(in, f)
 	case *ModifyColumn:
 		return VisitRefOfModifyColumn(in, f)
 	case *Nextval:
@@ -212,6 +218,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfOtherAdmin(in, f)
 	case *OtherRead:
 		return VisitRefOfOtherRead(in, f)
+	case *OverClause:
+		return VisitRefOfOverClause(in, f)
 	case *ParenTableExpr:
 		return VisitRefOfParenTableExpr(in, f)
 	case *PartitionDefinition:
@@ -1187,6 +1195,33 @@ func VisitRefOfForeignKeyDefinition(in *ForeignKeyDefinition, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfFrameClause(in *FrameClause, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitRefOfFramePoint(in.Start, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfFramePoint(in.End, f); err != nil {
+		return err
+	}
+	return nil
+}
+func VisitRefOfFramePoint(in *FramePoint, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExpr(in.Expr, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfFuncExpr(in *FuncExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -1203,6 +1238,9 @@ func VisitRefOfFuncExpr(in *FuncExpr, f Visit) error {
 	if err := VisitSelectExprs(in.Exprs, f); err != nil {
 		return err
 	}
+	if err := VisitRefOfOverClause(in.Over, f); err != nil {
+		return err
+	}
 	return nil
 }
 func VisitGroupBy(in GroupBy, f Visit) error {
@@ -1463,6 +1501,21 @@ func VisitRefOfMatchExpr(in *MatchExpr, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfMaterializedViewDDL(in *MaterializedViewDDL, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableName(in.ViewName, f); err != nil {
+		return err
+	}
+	if err := VisitSelectStatement(in.Select, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfModifyColumn(in *ModifyColumn, f Visit) error {
 	if in == nil {
 		return nil
@@ -1608,6 +1661,24 @@ func VisitRefOfOtherRead(in *OtherRead, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfOverClause(in *OverClause, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitExprs(in.PartitionBy, f); err != nil {
+		return err
+	}
+	if err := VisitOrderBy(in.OrderBy, f); err != nil {
+		return err
+	}
+	if err := VisitRefOfFrameClause(in.Frame, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfParenTableExpr(in *ParenTableExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -2503,7 +2574,7 @@ func VisitRefOfWith(in *With, f Visit) error {
 	if cont, err := f(in); err != nil || !cont {
 		return err
 	}
-	for _, el := range in.ctes {
+	for _, el := range in.CTEs {
 		if err := VisitRefOfCommonTableExpr(el, f); err != nil {
 			return err
 		}
@@ -2908,6 +2979,8 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfLoad(in, f)
 	case *LockTables:
 		return VisitRefOfLockTables(in, f)
+	case *MaterializedViewDDL:
+		return VisitRefOfMaterializedViewDDL(in, f)
 	case *OtherAdmin:
 		return VisitRefOfOtherAdmin(in, f)
 	case *OtherRead:
@@ -1100,6 +1100,34 @@ func (cached *ForeignKeyDefinition) CachedSize(alloc bool) int64 {
 	size += cached.ReferenceDefinition.CachedSize(true)
 	return size
 }
+func (cached *FrameClause) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Start *vitess.io/vitess/go/vt/sqlparser.FramePoint
+	size += cached.Start.CachedSize(true)
+	// field End *vitess.io/vitess/go/vt/sqlparser.FramePoint
+	size += cached.End.CachedSize(true)
+	return size
+}
+func (cached *FramePoint) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(24)
+	}
+	// field Expr vitess.io/vitess/go/vt/sqlparser.Expr
+	if cc, ok := cached.Expr.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *FuncExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1121,6 +1149,8 @@ func (cached *FuncExpr) CachedSize(alloc bool) int64 {
 			}
 		}
 	}
+	// field Over *vitess.io/vitess/go/vt/sqlparser.OverClause
+	size += cached.Over.CachedSize(true)
 	return size
 }
 func (cached *GroupConcatExpr) CachedSize(alloc bool) int64 {
@@ -1463,6 +1493,22 @@ func (cached *MatchExpr) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *MaterializedViewDDL) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field ViewName vitess.io/vitess/go/vt/sqlparser.TableName
+	size += cached.ViewName.CachedSize(false)
+	// field Select vitess.io/vitess/go/vt/sqlparser.SelectStatement
+	if cc, ok := cached.Select.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
 func (cached *ModifyColumn) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -1566,6 +1612,34 @@ func (cached *OrderByOption) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *OverClause) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(64)
+	}
+	// field PartitionBy vitess.io/vitess/go/vt/sqlparser.Exprs
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.PartitionBy)) * int64(16))
+		for _, elem := range cached.PartitionBy {
+			if cc, ok := elem.(cachedObject); ok {
+				size += cc.CachedSize(true)
+			}
+		}
+	}
+	// field OrderBy vitess.io/vitess/go/vt/sqlparser.OrderBy
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.OrderBy)) * int64(8))
+		for _, elem := range cached.OrderBy {
+			size += elem.CachedSize(true)
+		}
+	}
+	// field Frame *vitess.io/vitess/go/vt/sqlparser.FrameClause
+	size += cached.Frame.CachedSize(true)
+	return size
+}
 func (cached *ParenTableExpr) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -2607,10 +2681,10 @@ func (cached *With) CachedSize(alloc bool) int64 {
 	if alloc {
 		size += int64(32)
 	}
-	// field ctes []*vitess.io/vitess/go/vt/sqlparser.CommonTableExpr
+	// field CTEs []*vitess.io/vitess/go/vt/sqlparser.CommonTableExpr
 	{
-		size += hack.RuntimeAllocSize(int64(cap(cached.ctes)) * int64(8))
-		for _, elem := range cached.ctes {
+		size += hack.RuntimeAllocSize(int64(cap(cached.CTEs)) * int64(8))
+		for _, elem := range cached.CTEs {
 			size += elem.CachedSize(true)
 		}
 	}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 )
 
@@ -43,6 +44,10 @@ const (
 	DirectiveAllowHashJoin = "ALLOW_HASH_JOIN"
 	// DirectiveQueryPlanner lets the user specify per query which planner should be used
 	DirectiveQueryPlanner = "PLANNER"
+	// DirectiveJoinBatchSize lets nested loop joins send the rows of their LHS to the RHS in batches of the given size
+	DirectiveJoinBatchSize = "JOIN_BATCH_SIZE"
+	// DirectiveResultCacheTTL lets vtgate cache the results of a SELECT for the given number of milliseconds
+	DirectiveResultCacheTTL = "RESULT_CACHE_TTL_MS"
 )
 
 func isNonSpace(r rune) bool {
@@ -412,3 +417,18 @@ func AllowScatterDirective(stmt Statement) bool {
 	}
 	return directives.IsSet(DirectiveAllowScatter)
 }
+
+// ResultCacheTTLDirective returns how long vtgate may cache the results of the
+// statement, or 0 if the result cache directive is not set.
+func ResultCacheTTLDirective(stmt Statement) time.Duration {
+	sel, ok := stmt.(*Select)
+	if !ok {
+		return 0
+	}
+	directives := ExtractCommentDirectives(sel.Comments)
+	ttl, ok := directives[DirectiveResultCacheTTL].(int)
+	if !ok || ttl <= 0 {
+		return 0
+	}
+	return time.Duration(ttl) * time.Millisecond
+}
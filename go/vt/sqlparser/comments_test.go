@@ -20,8 +20,10 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSplitComments(t *testing.T) {
@@ -479,3 +481,24 @@ func TestIgnoreMaxMaxMemoryRowsDirective(t *testing.T) {
 		})
 	}
 }
+
+func TestResultCacheTTLDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected time.Duration
+	}{
+		{"select /*vt+ RESULT_CACHE_TTL_MS=1500 */ * from users", 1500 * time.Millisecond},
+		{"select * from users", 0},
+		{"select /*vt+ RESULT_CACHE_TTL_MS=0 */ * from users", 0},
+		{"select /*vt+ RESULT_CACHE_TTL_MS=abc */ * from users", 0},
+		{"update /*vt+ RESULT_CACHE_TTL_MS=1500 */ users set name=1", 0},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, err := Parse(test.query)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ResultCacheTTLDirective(stmt))
+		})
+	}
+}
@@ -65,6 +65,7 @@ const (
 	DropColVindexStr    = "on table drop vindex"
 	AddSequenceStr      = "add sequence"
 	AddAutoIncStr       = "add auto_increment"
+	RefreshStr          = "refresh"
 
 	// Partition and subpartition type strings
 	HashTypeStr  = "hash"
@@ -79,6 +80,15 @@ const (
 	// Online DDL hint
 	OnlineStr = "online"
 
+	// Window frame strings
+	RowsUnitStr           = "rows"
+	RangeUnitStr          = "range"
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
+
 	// Vindex DDL param to specify the owner of a vindex
 	VindexOwnerStr = "owner"
 
@@ -258,36 +268,37 @@ const (
 	LowPriorityWriteStr = "low_priority write"
 
 	// ShowCommand Types
-	CharsetStr          = " charset"
-	CollationStr        = " collation"
-	ColumnStr           = " columns"
-	CreateDbStr         = " create database"
-	CreateEStr          = " create event"
-	CreateFStr          = " create function"
-	CreateProcStr       = " create procedure"
-	CreateTblStr        = " create table"
-	CreateTrStr         = " create trigger"
-	CreateVStr          = " create view"
-	DatabaseStr         = " databases"
-	FunctionCStr        = " function code"
-	FunctionStr         = " function status"
-	GtidExecGlobalStr   = " global gtid_executed"
-	IndexStr            = " indexes"
-	OpenTableStr        = " open tables"
-	PrivilegeStr        = " privileges"
-	ProcedureCStr       = " procedure code"
-	ProcedureStr        = " procedure status"
-	StatusGlobalStr     = " global status"
-	StatusSessionStr    = " status"
-	TableStr            = " tables"
-	TableStatusStr      = " table status"
-	TriggerStr          = " triggers"
-	VariableGlobalStr   = " global variables"
-	VariableSessionStr  = " variables"
-	VGtidExecGlobalStr  = " global vgtid_executed"
-	KeyspaceStr         = " keyspaces"
-	VitessMigrationsStr = " vitess_migrations"
-	WarningsStr         = " warnings"
+	CharsetStr           = " charset"
+	CollationStr         = " collation"
+	ColumnStr            = " columns"
+	CreateDbStr          = " create database"
+	CreateEStr           = " create event"
+	CreateFStr           = " create function"
+	CreateProcStr        = " create procedure"
+	CreateTblStr         = " create table"
+	CreateTrStr          = " create trigger"
+	CreateVStr           = " create view"
+	DatabaseStr          = " databases"
+	FunctionCStr         = " function code"
+	FunctionStr          = " function status"
+	GtidExecGlobalStr    = " global gtid_executed"
+	IndexStr             = " indexes"
+	OpenTableStr         = " open tables"
+	PrivilegeStr         = " privileges"
+	ProcedureCStr        = " procedure code"
+	ProcedureStr         = " procedure status"
+	StatusGlobalStr      = " global status"
+	StatusSessionStr     = " status"
+	TableStr             = " tables"
+	TableStatusStr       = " table status"
+	TriggerStr           = " triggers"
+	VariableGlobalStr    = " global variables"
+	VariableSessionStr   = " variables"
+	VGtidExecGlobalStr   = " global vgtid_executed"
+	KeyspaceStr          = " keyspaces"
+	VitessMigrationsStr  = " vitess_migrations"
+	MaterializedViewsStr = " materialized views"
+	WarningsStr          = " warnings"
 
 	// DropKeyType strings
 	PrimaryKeyTypeStr = "primary key"
@@ -329,7 +340,7 @@ const (
 	ReadWrite
 )
 
-//Constants for Enum type - IsolationLevel
+// Constants for Enum type - IsolationLevel
 const (
 	ReadUncommitted IsolationLevel = iota
 	ReadCommitted
@@ -359,6 +370,7 @@ const (
 	AddSequenceDDLAction
 	AddAutoIncDDLAction
 	RevertDDLAction
+	RefreshDDLAction
 )
 
 // Constants for Enum Type - Scope
@@ -463,6 +475,21 @@ const (
 	DescOrder
 )
 
+// Constant for Enum Type - FrameUnitType
+const (
+	RowsUnit FrameUnitType = iota
+	RangeUnit
+)
+
+// Constant for Enum Type - FramePointType
+const (
+	CurrentRowType FramePointType = iota
+	UnboundedPrecedingType
+	UnboundedFollowingType
+	ExprPrecedingType
+	ExprFollowingType
+)
+
 // Constant for Enum Type - IndexHintType
 const (
 	UseOp IndexHintType = iota
@@ -576,6 +603,7 @@ const (
 	VitessMigrations
 	Warnings
 	Keyspace
+	MaterializedViews
 )
 
 // DropKeyType constants
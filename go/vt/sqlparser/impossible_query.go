@@ -27,6 +27,9 @@ package sqlparser
 func FormatImpossibleQuery(buf *TrackedBuffer, node SQLNode) {
 	switch node := node.(type) {
 	case *Select:
+		if node.With != nil {
+			buf.astPrintf(node, "%v", node.With)
+		}
 		buf.Myprintf("select %v from ", node.SelectExprs)
 		var prefix string
 		for _, n := range node.From {
@@ -38,6 +41,9 @@ func FormatImpossibleQuery(buf *TrackedBuffer, node SQLNode) {
 			node.GroupBy.Format(buf)
 		}
 	case *Union:
+		if node.With != nil {
+			buf.astPrintf(node, "%v", node.With)
+		}
 		if requiresParen(node.Left) {
 			buf.astPrintf(node, "(%v)", node.Left)
 		} else {
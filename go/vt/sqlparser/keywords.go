@@ -175,6 +175,7 @@ var keywords = []keyword{
 	{"convert", CONVERT},
 	{"copy", COPY},
 	{"cume_dist", UNUSED},
+	{"current", CURRENT},
 	{"substr", SUBSTRING},
 	{"subpartition", SUBPARTITION},
 	{"subpartitions", SUBPARTITIONS},
@@ -257,6 +258,7 @@ var keywords = []keyword{
 	{"float4", UNUSED},
 	{"float8", UNUSED},
 	{"flush", FLUSH},
+	{"following", FOLLOWING},
 	{"for", FOR},
 	{"force", FORCE},
 	{"foreign", FOREIGN},
@@ -353,6 +355,7 @@ var keywords = []keyword{
 	{"manifest", MANIFEST},
 	{"master_bind", UNUSED},
 	{"match", MATCH},
+	{"materialized", MATERIALIZED},
 	{"max_rows", MAX_ROWS},
 	{"maxvalue", MAXVALUE},
 	{"mediumblob", MEDIUMBLOB},
@@ -403,7 +406,7 @@ var keywords = []keyword{
 	{"out", UNUSED},
 	{"outer", OUTER},
 	{"outfile", OUTFILE},
-	{"over", UNUSED},
+	{"over", OVER},
 	{"overwrite", OVERWRITE},
 	{"pack_keys", PACK_KEYS},
 	{"parser", PARSER},
@@ -415,6 +418,7 @@ var keywords = []keyword{
 	{"plugins", PLUGINS},
 	{"point", POINT},
 	{"polygon", POLYGON},
+	{"preceding", PRECEDING},
 	{"precision", UNUSED},
 	{"primary", PRIMARY},
 	{"privileges", PRIVILEGES},
@@ -432,6 +436,7 @@ var keywords = []keyword{
 	{"recursive", RECURSIVE},
 	{"redundant", REDUNDANT},
 	{"references", REFERENCES},
+	{"refresh", REFRESH},
 	{"regexp", REGEXP},
 	{"relay", RELAY},
 	{"release", RELEASE},
@@ -452,10 +457,10 @@ var keywords = []keyword{
 	{"right", RIGHT},
 	{"rlike", REGEXP},
 	{"rollback", ROLLBACK},
-	{"row", UNUSED},
+	{"row", ROW},
 	{"row_format", ROW_FORMAT},
 	{"row_number", UNUSED},
-	{"rows", UNUSED},
+	{"rows", ROWS},
 	{"s3", S3},
 	{"savepoint", SAVEPOINT},
 	{"schema", SCHEMA},
@@ -525,6 +530,7 @@ var keywords = []keyword{
 	{"triggers", TRIGGERS},
 	{"true", TRUE},
 	{"truncate", TRUNCATE},
+	{"unbounded", UNBOUNDED},
 	{"uncommitted", UNCOMMITTED},
 	{"undefined", UNDEFINED},
 	{"undo", UNUSED},
@@ -1880,6 +1880,21 @@ var (
 		input: "show vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90' logs",
 	}, {
 		input: "revert vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90'",
+	}, {
+		input:  "create materialized view ks.order_totals as select customer_id, count(*) as cnt, sum(price) as total, min(price), max(price) from orders group by customer_id",
+		output: "create materialized view ks.order_totals as select customer_id, count(*) as cnt, sum(price) as total, min(price), max(price) from orders group by customer_id",
+	}, {
+		input: "drop materialized view order_totals",
+	}, {
+		input: "drop materialized view if exists ks.order_totals",
+	}, {
+		input: "refresh materialized view order_totals",
+	}, {
+		input: "show materialized views",
+	}, {
+		input: "show materialized views from ks like '%totals'",
+	}, {
+		input: "show materialized views where state = 'Running'",
 	}, {
 		input: "revert /*vt+ uuid=123 */ vitess_migration '9748c3b7_7fdb_11eb_ac2c_f875a4d24e90'",
 	}, {
@@ -2091,6 +2106,31 @@ var (
 	}, {
 		input:  "select name, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by name",
 		output: "select `name`, group_concat(distinct id, score order by id desc separator ':' limit 10, 2) from t group by `name`",
+	}, {
+		input:  "select row_number() over (partition by user_id order by ts) from t",
+		output: "select row_number() over (partition by user_id order by ts asc) from t",
+	}, {
+		input: "select rank() over (order by a desc), dense_rank() over (order by a desc) from t",
+	}, {
+		input:  "select ntile(4) over (), cume_dist() over (order by a), percent_rank() over (order by a) from t",
+		output: "select ntile(4) over (), cume_dist() over (order by a asc), percent_rank() over (order by a asc) from t",
+	}, {
+		input:  "select lag(a, 1, 0) over (partition by b order by c), lead(a) over (partition by b order by c) from t",
+		output: "select lag(a, 1, 0) over (partition by b order by c asc), lead(a) over (partition by b order by c asc) from t",
+	}, {
+		input: "select first_value(a) over (), last_value(a) over (order by b desc rows unbounded preceding) from t",
+	}, {
+		input:  "select sum(a) over (partition by b, c order by d rows between unbounded preceding and current row) from t",
+		output: "select sum(a) over (partition by b, c order by d asc rows between unbounded preceding and current row) from t",
+	}, {
+		input:  "select count(*) over (order by d range between interval 1 day preceding and unbounded following) from t",
+		output: "select count(*) over (order by d asc range between interval 1 day preceding and unbounded following) from t",
+	}, {
+		input:  "select avg(a) over (order by d rows 2 preceding), nth_value(a, 2) over (order by d rows between 1 preceding and 1 following) from t",
+		output: "select avg(a) over (order by d asc rows 2 preceding), nth_value(a, 2) over (order by d asc rows between 1 preceding and 1 following) from t",
+	}, {
+		input:  "select a, current, preceding, following, unbounded from t",
+		output: "select a, `current`, `preceding`, `following`, `unbounded` from t",
 	}, {
 		input: "select * from t partition (p0)",
 	}, {
@@ -305,280 +305,285 @@ const CANCEL = 57602
 const RETRY = 57603
 const COMPLETE = 57604
 const CLEANUP = 57605
-const BEGIN = 57606
-const START = 57607
-const TRANSACTION = 57608
-const COMMIT = 57609
-const ROLLBACK = 57610
-const SAVEPOINT = 57611
-const RELEASE = 57612
-const WORK = 57613
-const BIT = 57614
-const TINYINT = 57615
-const SMALLINT = 57616
-const MEDIUMINT = 57617
-const INT = 57618
-const INTEGER = 57619
-const BIGINT = 57620
-const INTNUM = 57621
-const REAL = 57622
-const DOUBLE = 57623
-const FLOAT_TYPE = 57624
-const DECIMAL_TYPE = 57625
-const NUMERIC = 57626
-const TIME = 57627
-const TIMESTAMP = 57628
-const DATETIME = 57629
-const YEAR = 57630
-const CHAR = 57631
-const VARCHAR = 57632
-const BOOL = 57633
-const CHARACTER = 57634
-const VARBINARY = 57635
-const NCHAR = 57636
-const TEXT = 57637
-const TINYTEXT = 57638
-const MEDIUMTEXT = 57639
-const LONGTEXT = 57640
-const BLOB = 57641
-const TINYBLOB = 57642
-const MEDIUMBLOB = 57643
-const LONGBLOB = 57644
-const JSON = 57645
-const ENUM = 57646
-const GEOMETRY = 57647
-const POINT = 57648
-const LINESTRING = 57649
-const POLYGON = 57650
-const GEOMETRYCOLLECTION = 57651
-const MULTIPOINT = 57652
-const MULTILINESTRING = 57653
-const MULTIPOLYGON = 57654
-const ASCII = 57655
-const UNICODE = 57656
-const NULLX = 57657
-const AUTO_INCREMENT = 57658
-const APPROXNUM = 57659
-const SIGNED = 57660
-const UNSIGNED = 57661
-const ZEROFILL = 57662
-const CODE = 57663
-const COLLATION = 57664
-const COLUMNS = 57665
-const DATABASES = 57666
-const ENGINES = 57667
-const EVENT = 57668
-const EXTENDED = 57669
-const FIELDS = 57670
-const FULL = 57671
-const FUNCTION = 57672
-const GTID_EXECUTED = 57673
-const KEYSPACES = 57674
-const OPEN = 57675
-const PLUGINS = 57676
-const PRIVILEGES = 57677
-const PROCESSLIST = 57678
-const SCHEMAS = 57679
-const TABLES = 57680
-const TRIGGERS = 57681
-const USER = 57682
-const VGTID_EXECUTED = 57683
-const VITESS_KEYSPACES = 57684
-const VITESS_METADATA = 57685
-const VITESS_MIGRATIONS = 57686
-const VITESS_REPLICATION_STATUS = 57687
-const VITESS_SHARDS = 57688
-const VITESS_TABLETS = 57689
-const VSCHEMA = 57690
-const NAMES = 57691
-const GLOBAL = 57692
-const SESSION = 57693
-const ISOLATION = 57694
-const LEVEL = 57695
-const READ = 57696
-const WRITE = 57697
-const ONLY = 57698
-const REPEATABLE = 57699
-const COMMITTED = 57700
-const UNCOMMITTED = 57701
-const SERIALIZABLE = 57702
-const CURRENT_TIMESTAMP = 57703
-const DATABASE = 57704
-const CURRENT_DATE = 57705
-const CURRENT_TIME = 57706
-const LOCALTIME = 57707
-const LOCALTIMESTAMP = 57708
-const CURRENT_USER = 57709
-const UTC_DATE = 57710
-const UTC_TIME = 57711
-const UTC_TIMESTAMP = 57712
-const DAY = 57713
-const DAY_HOUR = 57714
-const DAY_MICROSECOND = 57715
-const DAY_MINUTE = 57716
-const DAY_SECOND = 57717
-const HOUR = 57718
-const HOUR_MICROSECOND = 57719
-const HOUR_MINUTE = 57720
-const HOUR_SECOND = 57721
-const MICROSECOND = 57722
-const MINUTE = 57723
-const MINUTE_MICROSECOND = 57724
-const MINUTE_SECOND = 57725
-const MONTH = 57726
-const QUARTER = 57727
-const SECOND = 57728
-const SECOND_MICROSECOND = 57729
-const YEAR_MONTH = 57730
-const WEEK = 57731
-const REPLACE = 57732
-const CONVERT = 57733
-const CAST = 57734
-const SUBSTR = 57735
-const SUBSTRING = 57736
-const GROUP_CONCAT = 57737
-const SEPARATOR = 57738
-const TIMESTAMPADD = 57739
-const TIMESTAMPDIFF = 57740
-const WEIGHT_STRING = 57741
-const MATCH = 57742
-const AGAINST = 57743
-const BOOLEAN = 57744
-const LANGUAGE = 57745
-const WITH = 57746
-const QUERY = 57747
-const EXPANSION = 57748
-const WITHOUT = 57749
-const VALIDATION = 57750
-const UNUSED = 57751
-const ARRAY = 57752
-const CUME_DIST = 57753
-const DESCRIPTION = 57754
-const DENSE_RANK = 57755
-const EMPTY = 57756
-const EXCEPT = 57757
-const FIRST_VALUE = 57758
-const GROUPING = 57759
-const GROUPS = 57760
-const JSON_TABLE = 57761
-const LAG = 57762
-const LAST_VALUE = 57763
-const LATERAL = 57764
-const LEAD = 57765
-const MEMBER = 57766
-const NTH_VALUE = 57767
-const NTILE = 57768
-const OF = 57769
-const OVER = 57770
-const PERCENT_RANK = 57771
-const RANK = 57772
-const RECURSIVE = 57773
-const ROW_NUMBER = 57774
-const SYSTEM = 57775
-const WINDOW = 57776
-const ACTIVE = 57777
-const ADMIN = 57778
-const BUCKETS = 57779
-const CLONE = 57780
-const COMPONENT = 57781
-const DEFINITION = 57782
-const ENFORCED = 57783
-const EXCLUDE = 57784
-const FOLLOWING = 57785
-const GEOMCOLLECTION = 57786
-const GET_MASTER_PUBLIC_KEY = 57787
-const HISTOGRAM = 57788
-const HISTORY = 57789
-const INACTIVE = 57790
-const INVISIBLE = 57791
-const LOCKED = 57792
-const MASTER_COMPRESSION_ALGORITHMS = 57793
-const MASTER_PUBLIC_KEY_PATH = 57794
-const MASTER_TLS_CIPHERSUITES = 57795
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57796
-const NESTED = 57797
-const NETWORK_NAMESPACE = 57798
-const NOWAIT = 57799
-const NULLS = 57800
-const OJ = 57801
-const OLD = 57802
-const OPTIONAL = 57803
-const ORDINALITY = 57804
-const ORGANIZATION = 57805
-const OTHERS = 57806
-const PATH = 57807
-const PERSIST = 57808
-const PERSIST_ONLY = 57809
-const PRECEDING = 57810
-const PRIVILEGE_CHECKS_USER = 57811
-const PROCESS = 57812
-const RANDOM = 57813
-const REFERENCE = 57814
-const REQUIRE_ROW_FORMAT = 57815
-const RESOURCE = 57816
-const RESPECT = 57817
-const RESTART = 57818
-const RETAIN = 57819
-const REUSE = 57820
-const ROLE = 57821
-const SECONDARY = 57822
-const SECONDARY_ENGINE = 57823
-const SECONDARY_LOAD = 57824
-const SECONDARY_UNLOAD = 57825
-const SKIP = 57826
-const SRID = 57827
-const THREAD_PRIORITY = 57828
-const TIES = 57829
-const UNBOUNDED = 57830
-const VCPU = 57831
-const VISIBLE = 57832
-const FORMAT = 57833
-const TREE = 57834
-const VITESS = 57835
-const TRADITIONAL = 57836
-const LOCAL = 57837
-const LOW_PRIORITY = 57838
-const NO_WRITE_TO_BINLOG = 57839
-const LOGS = 57840
-const ERROR = 57841
-const GENERAL = 57842
-const HOSTS = 57843
-const OPTIMIZER_COSTS = 57844
-const USER_RESOURCES = 57845
-const SLOW = 57846
-const CHANNEL = 57847
-const RELAY = 57848
-const EXPORT = 57849
-const AVG_ROW_LENGTH = 57850
-const CONNECTION = 57851
-const CHECKSUM = 57852
-const DELAY_KEY_WRITE = 57853
-const ENCRYPTION = 57854
-const ENGINE = 57855
-const INSERT_METHOD = 57856
-const MAX_ROWS = 57857
-const MIN_ROWS = 57858
-const PACK_KEYS = 57859
-const PASSWORD = 57860
-const FIXED = 57861
-const DYNAMIC = 57862
-const COMPRESSED = 57863
-const REDUNDANT = 57864
-const COMPACT = 57865
-const ROW_FORMAT = 57866
-const STATS_AUTO_RECALC = 57867
-const STATS_PERSISTENT = 57868
-const STATS_SAMPLE_PAGES = 57869
-const STORAGE = 57870
-const MEMORY = 57871
-const DISK = 57872
-const PARTITIONS = 57873
-const LINEAR = 57874
-const RANGE = 57875
-const LIST = 57876
-const SUBPARTITION = 57877
-const SUBPARTITIONS = 57878
-const HASH = 57879
+const MATERIALIZED = 57606
+const REFRESH = 57607
+const BEGIN = 57608
+const START = 57609
+const TRANSACTION = 57610
+const COMMIT = 57611
+const ROLLBACK = 57612
+const SAVEPOINT = 57613
+const RELEASE = 57614
+const WORK = 57615
+const BIT = 57616
+const TINYINT = 57617
+const SMALLINT = 57618
+const MEDIUMINT = 57619
+const INT = 57620
+const INTEGER = 57621
+const BIGINT = 57622
+const INTNUM = 57623
+const REAL = 57624
+const DOUBLE = 57625
+const FLOAT_TYPE = 57626
+const DECIMAL_TYPE = 57627
+const NUMERIC = 57628
+const TIME = 57629
+const TIMESTAMP = 57630
+const DATETIME = 57631
+const YEAR = 57632
+const CHAR = 57633
+const VARCHAR = 57634
+const BOOL = 57635
+const CHARACTER = 57636
+const VARBINARY = 57637
+const NCHAR = 57638
+const TEXT = 57639
+const TINYTEXT = 57640
+const MEDIUMTEXT = 57641
+const LONGTEXT = 57642
+const BLOB = 57643
+const TINYBLOB = 57644
+const MEDIUMBLOB = 57645
+const LONGBLOB = 57646
+const JSON = 57647
+const ENUM = 57648
+const GEOMETRY = 57649
+const POINT = 57650
+const LINESTRING = 57651
+const POLYGON = 57652
+const GEOMETRYCOLLECTION = 57653
+const MULTIPOINT = 57654
+const MULTILINESTRING = 57655
+const MULTIPOLYGON = 57656
+const ASCII = 57657
+const UNICODE = 57658
+const NULLX = 57659
+const AUTO_INCREMENT = 57660
+const APPROXNUM = 57661
+const SIGNED = 57662
+const UNSIGNED = 57663
+const ZEROFILL = 57664
+const CODE = 57665
+const COLLATION = 57666
+const COLUMNS = 57667
+const DATABASES = 57668
+const ENGINES = 57669
+const EVENT = 57670
+const EXTENDED = 57671
+const FIELDS = 57672
+const FULL = 57673
+const FUNCTION = 57674
+const GTID_EXECUTED = 57675
+const KEYSPACES = 57676
+const OPEN = 57677
+const PLUGINS = 57678
+const PRIVILEGES = 57679
+const PROCESSLIST = 57680
+const SCHEMAS = 57681
+const TABLES = 57682
+const TRIGGERS = 57683
+const USER = 57684
+const VGTID_EXECUTED = 57685
+const VITESS_KEYSPACES = 57686
+const VITESS_METADATA = 57687
+const VITESS_MIGRATIONS = 57688
+const VITESS_REPLICATION_STATUS = 57689
+const VITESS_SHARDS = 57690
+const VITESS_TABLETS = 57691
+const VSCHEMA = 57692
+const NAMES = 57693
+const GLOBAL = 57694
+const SESSION = 57695
+const ISOLATION = 57696
+const LEVEL = 57697
+const READ = 57698
+const WRITE = 57699
+const ONLY = 57700
+const REPEATABLE = 57701
+const COMMITTED = 57702
+const UNCOMMITTED = 57703
+const SERIALIZABLE = 57704
+const CURRENT_TIMESTAMP = 57705
+const DATABASE = 57706
+const CURRENT_DATE = 57707
+const CURRENT_TIME = 57708
+const LOCALTIME = 57709
+const LOCALTIMESTAMP = 57710
+const CURRENT_USER = 57711
+const UTC_DATE = 57712
+const UTC_TIME = 57713
+const UTC_TIMESTAMP = 57714
+const DAY = 57715
+const DAY_HOUR = 57716
+const DAY_MICROSECOND = 57717
+const DAY_MINUTE = 57718
+const DAY_SECOND = 57719
+const HOUR = 57720
+const HOUR_MICROSECOND = 57721
+const HOUR_MINUTE = 57722
+const HOUR_SECOND = 57723
+const MICROSECOND = 57724
+const MINUTE = 57725
+const MINUTE_MICROSECOND = 57726
+const MINUTE_SECOND = 57727
+const MONTH = 57728
+const QUARTER = 57729
+const SECOND = 57730
+const SECOND_MICROSECOND = 57731
+const YEAR_MONTH = 57732
+const WEEK = 57733
+const REPLACE = 57734
+const CONVERT = 57735
+const CAST = 57736
+const SUBSTR = 57737
+const SUBSTRING = 57738
+const GROUP_CONCAT = 57739
+const SEPARATOR = 57740
+const TIMESTAMPADD = 57741
+const TIMESTAMPDIFF = 57742
+const WEIGHT_STRING = 57743
+const MATCH = 57744
+const AGAINST = 57745
+const BOOLEAN = 57746
+const LANGUAGE = 57747
+const WITH = 57748
+const QUERY = 57749
+const EXPANSION = 57750
+const WITHOUT = 57751
+const VALIDATION = 57752
+const UNUSED = 57753
+const ARRAY = 57754
+const CUME_DIST = 57755
+const DESCRIPTION = 57756
+const DENSE_RANK = 57757
+const EMPTY = 57758
+const EXCEPT = 57759
+const FIRST_VALUE = 57760
+const GROUPING = 57761
+const GROUPS = 57762
+const JSON_TABLE = 57763
+const LAG = 57764
+const LAST_VALUE = 57765
+const LATERAL = 57766
+const LEAD = 57767
+const MEMBER = 57768
+const NTH_VALUE = 57769
+const NTILE = 57770
+const OF = 57771
+const OVER = 57772
+const PERCENT_RANK = 57773
+const RANK = 57774
+const RECURSIVE = 57775
+const ROW_NUMBER = 57776
+const SYSTEM = 57777
+const WINDOW = 57778
+const ACTIVE = 57779
+const ADMIN = 57780
+const BUCKETS = 57781
+const CLONE = 57782
+const COMPONENT = 57783
+const DEFINITION = 57784
+const ENFORCED = 57785
+const EXCLUDE = 57786
+const FOLLOWING = 57787
+const GEOMCOLLECTION = 57788
+const GET_MASTER_PUBLIC_KEY = 57789
+const HISTOGRAM = 57790
+const HISTORY = 57791
+const INACTIVE = 57792
+const INVISIBLE = 57793
+const LOCKED = 57794
+const MASTER_COMPRESSION_ALGORITHMS = 57795
+const MASTER_PUBLIC_KEY_PATH = 57796
+const MASTER_TLS_CIPHERSUITES = 57797
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57798
+const NESTED = 57799
+const NETWORK_NAMESPACE = 57800
+const NOWAIT = 57801
+const NULLS = 57802
+const OJ = 57803
+const OLD = 57804
+const OPTIONAL = 57805
+const ORDINALITY = 57806
+const ORGANIZATION = 57807
+const OTHERS = 57808
+const PATH = 57809
+const PERSIST = 57810
+const PERSIST_ONLY = 57811
+const PRECEDING = 57812
+const PRIVILEGE_CHECKS_USER = 57813
+const PROCESS = 57814
+const RANDOM = 57815
+const REFERENCE = 57816
+const REQUIRE_ROW_FORMAT = 57817
+const RESOURCE = 57818
+const RESPECT = 57819
+const RESTART = 57820
+const RETAIN = 57821
+const REUSE = 57822
+const ROLE = 57823
+const SECONDARY = 57824
+const SECONDARY_ENGINE = 57825
+const SECONDARY_LOAD = 57826
+const SECONDARY_UNLOAD = 57827
+const SKIP = 57828
+const SRID = 57829
+const THREAD_PRIORITY = 57830
+const TIES = 57831
+const UNBOUNDED = 57832
+const VCPU = 57833
+const VISIBLE = 57834
+const CURRENT = 57835
+const ROW = 57836
+const ROWS = 57837
+const FORMAT = 57838
+const TREE = 57839
+const VITESS = 57840
+const TRADITIONAL = 57841
+const LOCAL = 57842
+const LOW_PRIORITY = 57843
+const NO_WRITE_TO_BINLOG = 57844
+const LOGS = 57845
+const ERROR = 57846
+const GENERAL = 57847
+const HOSTS = 57848
+const OPTIMIZER_COSTS = 57849
+const USER_RESOURCES = 57850
+const SLOW = 57851
+const CHANNEL = 57852
+const RELAY = 57853
+const EXPORT = 57854
+const AVG_ROW_LENGTH = 57855
+const CONNECTION = 57856
+const CHECKSUM = 57857
+const DELAY_KEY_WRITE = 57858
+const ENCRYPTION = 57859
+const ENGINE = 57860
+const INSERT_METHOD = 57861
+const MAX_ROWS = 57862
+const MIN_ROWS = 57863
+const PACK_KEYS = 57864
+const PASSWORD = 57865
+const FIXED = 57866
+const DYNAMIC = 57867
+const COMPRESSED = 57868
+const REDUNDANT = 57869
+const COMPACT = 57870
+const ROW_FORMAT = 57871
+const STATS_AUTO_RECALC = 57872
+const STATS_PERSISTENT = 57873
+const STATS_SAMPLE_PAGES = 57874
+const STORAGE = 57875
+const MEMORY = 57876
+const DISK = 57877
+const PARTITIONS = 57878
+const LINEAR = 57879
+const RANGE = 57880
+const LIST = 57881
+const SUBPARTITION = 57882
+const SUBPARTITIONS = 57883
+const HASH = 57884
 
 var yyToknames = [...]string{
 	"$end",
@@ -861,6 +866,8 @@ var yyToknames = [...]string{
 	"RETRY",
 	"COMPLETE",
 	"CLEANUP",
+	"MATERIALIZED",
+	"REFRESH",
 	"BEGIN",
 	"START",
 	"TRANSACTION",
@@ -1088,6 +1095,9 @@ var yyToknames = [...]string{
 	"UNBOUNDED",
 	"VCPU",
 	"VISIBLE",
+	"CURRENT",
+	"ROW",
+	"ROWS",
 	"FORMAT",
 	"TREE",
 	"VITESS",
@@ -1147,3553 +1157,3602 @@ const yyInitialStackSize = 16
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 0,
-	10, 44,
-	11, 44,
-	-2, 34,
+	10, 45,
+	11, 45,
+	-2, 35,
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 44,
-	1, 137,
-	555, 137,
-	-2, 143,
 	-1, 45,
-	116, 143,
-	156, 143,
-	309, 143,
-	-2, 444,
-	-1, 52,
-	33, 623,
-	215, 623,
-	226, 623,
-	259, 637,
-	260, 637,
-	-2, 625,
-	-1, 57,
-	217, 648,
-	-2, 646,
-	-1, 108,
-	214, 1109,
-	-2, 116,
+	1, 139,
+	560, 139,
+	-2, 145,
+	-1, 46,
+	116, 145,
+	156, 145,
+	311, 145,
+	-2, 446,
+	-1, 53,
+	33, 627,
+	215, 627,
+	226, 627,
+	259, 641,
+	260, 641,
+	-2, 629,
+	-1, 58,
+	217, 652,
+	-2, 650,
 	-1, 110,
-	1, 138,
-	555, 138,
-	-2, 143,
-	-1, 120,
-	117, 347,
-	220, 347,
-	-2, 438,
-	-1, 139,
-	116, 143,
-	156, 143,
-	309, 143,
-	-2, 453,
-	-1, 602,
-	200, 1130,
-	-2, 1126,
-	-1, 603,
-	200, 1131,
-	-2, 1127,
-	-1, 674,
-	57, 716,
-	-2, 724,
-	-1, 711,
-	132, 1489,
-	-2, 109,
-	-1, 712,
-	132, 1366,
-	-2, 110,
-	-1, 718,
-	132, 1420,
-	-2, 1103,
-	-1, 860,
-	132, 1299,
-	-2, 1100,
-	-1, 898,
-	225, 38,
-	230, 38,
-	-2, 358,
-	-1, 975,
-	1, 492,
-	555, 492,
-	-2, 143,
-	-1, 1173,
-	57, 717,
+	214, 1132,
+	-2, 118,
+	-1, 112,
+	1, 140,
+	560, 140,
+	-2, 145,
+	-1, 122,
+	117, 349,
+	220, 349,
+	-2, 440,
+	-1, 141,
+	116, 145,
+	156, 145,
+	311, 145,
+	-2, 455,
+	-1, 610,
+	200, 1153,
+	-2, 1149,
+	-1, 611,
+	200, 1154,
+	-2, 1150,
+	-1, 683,
+	57, 721,
 	-2, 729,
-	-1, 1174,
-	57, 718,
-	-2, 730,
-	-1, 1226,
-	116, 143,
-	156, 143,
-	309, 143,
-	-2, 388,
-	-1, 1303,
-	117, 347,
-	220, 347,
-	-2, 438,
-	-1, 1312,
+	-1, 720,
+	132, 1517,
+	-2, 110,
+	-1, 721,
+	132, 1390,
+	-2, 111,
+	-1, 727,
+	132, 1445,
+	-2, 1126,
+	-1, 869,
+	132, 1322,
+	-2, 1123,
+	-1, 908,
 	225, 39,
 	230, 39,
-	-2, 359,
-	-1, 1563,
-	200, 1135,
-	-2, 1129,
-	-1, 1640,
-	116, 143,
-	156, 143,
-	309, 143,
-	-2, 389,
-	-1, 1647,
-	23, 162,
-	-2, 164,
-	-1, 1885,
-	75, 91,
-	84, 91,
-	-2, 782,
-	-1, 2054,
-	47, 1071,
-	-2, 1065,
-	-1, 2258,
-	5, 50,
-	16, 50,
-	18, 50,
-	85, 50,
-	-2, 757,
+	-2, 360,
+	-1, 985,
+	1, 494,
+	560, 494,
+	-2, 145,
+	-1, 1186,
+	57, 722,
+	-2, 734,
+	-1, 1187,
+	57, 723,
+	-2, 735,
+	-1, 1239,
+	116, 145,
+	156, 145,
+	311, 145,
+	-2, 390,
+	-1, 1317,
+	117, 349,
+	220, 349,
+	-2, 440,
+	-1, 1326,
+	225, 40,
+	230, 40,
+	-2, 361,
+	-1, 1579,
+	200, 1158,
+	-2, 1152,
+	-1, 1657,
+	116, 145,
+	156, 145,
+	311, 145,
+	-2, 391,
+	-1, 1664,
+	23, 164,
+	-2, 166,
+	-1, 1905,
+	75, 92,
+	84, 92,
+	-2, 787,
+	-1, 2079,
+	47, 1094,
+	-2, 1088,
+	-1, 2285,
+	5, 51,
+	16, 51,
+	18, 51,
+	85, 51,
+	-2, 762,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 34052
+const yyLast = 34532
 
 var yyAct = [...]int{
-	602, 2518, 2512, 2317, 2483, 2175, 2469, 2396, 2105, 2112,
-	2146, 2068, 2065, 90, 1155, 689, 1828, 667, 1594, 2342,
-	2114, 2158, 2157, 3, 1038, 2410, 1700, 553, 557, 1188,
-	2069, 2066, 2347, 597, 2229, 605, 2223, 594, 596, 34,
-	595, 1577, 1881, 579, 2160, 2249, 550, 2063, 176, 1613,
-	2055, 176, 2216, 517, 176, 1850, 1670, 549, 986, 533,
-	551, 176, 1917, 1946, 1690, 1675, 1987, 1919, 1918, 176,
-	716, 148, 1626, 1870, 35, 33, 1617, 863, 690, 545,
-	1326, 176, 1175, 1830, 669, 928, 1598, 1842, 2003, 1637,
-	1514, 1015, 1507, 1858, 1709, 134, 1618, 1689, 1557, 1742,
-	1677, 1218, 888, 533, 1466, 1911, 533, 176, 533, 893,
-	671, 1887, 675, 89, 562, 1197, 85, 1620, 1579, 1158,
-	1526, 1484, 1057, 1310, 1414, 867, 1417, 713, 1317, 1687,
-	692, 871, 870, 1400, 1560, 1217, 899, 896, 1605, 894,
-	895, 1201, 1036, 1031, 1278, 906, 681, 1422, 677, 676,
-	151, 92, 1302, 1666, 111, 117, 679, 1215, 703, 70,
-	1599, 118, 971, 91, 540, 1123, 1127, 83, 2441, 1939,
-	2519, 2143, 79, 1702, 112, 1937, 1570, 1702, 1703, 1704,
-	1966, 1965, 1740, 1386, 8, 1058, 7, 697, 6, 702,
-	678, 71, 1995, 1996, 1473, 113, 1574, 1575, 119, 1472,
-	1471, 683, 1470, 1469, 1058, 178, 179, 180, 1468, 1455,
-	84, 933, 543, 490, 544, 864, 1460, 2497, 1826, 2051,
-	1852, 2282, 930, 2129, 879, 2392, 874, 541, 2391, 2310,
-	932, 670, 2311, 2528, 668, 944, 945, 931, 948, 949,
-	950, 951, 1682, 2479, 954, 955, 956, 957, 958, 959,
-	960, 961, 962, 963, 964, 965, 966, 967, 968, 909,
-	710, 113, 684, 717, 691, 885, 1680, 910, 1780, 2522,
-	2452, 1283, 96, 2511, 886, 2318, 2470, 1728, 934, 935,
-	936, 2478, 2451, 172, 2002, 884, 2208, 1068, 1292, 2102,
-	2103, 1974, 1827, 941, 1942, 1973, 1219, 878, 1220, 72,
-	880, 72, 585, 2101, 72, 72, 1068, 114, 74, 136,
-	98, 99, 946, 102, 1994, 883, 108, 980, 981, 173,
-	156, 1896, 485, 1861, 1895, 113, 1005, 1897, 1632, 1633,
-	1777, 1022, 1778, 1024, 1631, 664, 1089, 1555, 1576, 1034,
-	974, 663, 666, 1940, 993, 674, 1908, 520, 1862, 994,
-	2360, 146, 2029, 699, 1010, 1011, 135, 2401, 1090, 1091,
-	1092, 1093, 1094, 1095, 1096, 1098, 1097, 1099, 1100, 1021,
-	1023, 881, 1006, 705, 706, 999, 81, 153, 81, 154,
-	1064, 81, 81, 1056, 2177, 1304, 1305, 145, 144, 171,
-	2226, 1679, 2199, 883, 2197, 875, 993, 531, 970, 1064,
-	1459, 994, 877, 876, 520, 1461, 1462, 1463, 520, 992,
-	535, 991, 1650, 1649, 1754, 1751, 1753, 1752, 546, 529,
-	1547, 1536, 1537, 1538, 1539, 1549, 1540, 1541, 1542, 1554,
-	1550, 1543, 1544, 1551, 1552, 1553, 1545, 1546, 1548, 1033,
-	520, 1406, 1162, 1012, 947, 1947, 1710, 693, 887, 881,
-	1376, 1007, 1748, 1013, 1000, 1969, 1743, 2170, 1028, 1401,
-	1019, 2521, 1014, 2178, 1020, 2171, 976, 2498, 1756, 1982,
-	1757, 1747, 1758, 1759, 1025, 883, 969, 1008, 1009, 953,
-	952, 882, 140, 1306, 147, 176, 1303, 176, 141, 142,
-	176, 2179, 1377, 157, 1378, 1745, 1018, 2303, 2154, 1713,
-	1614, 2030, 162, 890, 81, 1749, 917, 915, 1026, 926,
-	889, 1746, 890, 925, 924, 923, 922, 921, 533, 533,
-	533, 920, 919, 914, 1295, 927, 1101, 1101, 1778, 2516,
-	2523, 1986, 2118, 2509, 868, 908, 533, 533, 973, 902,
-	521, 1003, 868, 1063, 1060, 1061, 1062, 1067, 1069, 1066,
-	989, 1065, 995, 996, 997, 998, 868, 2128, 1059, 882,
-	866, 1050, 1063, 1060, 1061, 1062, 1067, 1069, 1066, 901,
-	1065, 1316, 1831, 1833, 1415, 1035, 34, 1059, 598, 1688,
-	580, 582, 599, 600, 704, 578, 581, 601, 1681, 1971,
-	1983, 1734, 1411, 1044, 937, 2136, 1968, 521, 2004, 908,
-	1941, 521, 1290, 1029, 2440, 1938, 2153, 1289, 1998, 1791,
-	1730, 149, 1288, 1958, 583, 584, 2373, 1412, 1286, 1104,
-	1105, 1106, 1107, 489, 484, 2431, 1405, 972, 1407, 1112,
-	2450, 1115, 907, 521, 1027, 2263, 2402, 911, 901, 1981,
-	1148, 882, 1980, 918, 916, 2245, 1892, 912, 110, 1102,
-	1103, 1857, 1153, 2227, 1600, 1601, 943, 1818, 1569, 1205,
-	1135, 1040, 1041, 984, 176, 913, 1101, 908, 2388, 1779,
-	1638, 1100, 1989, 2100, 1315, 1989, 686, 1988, 2006, 1108,
-	1988, 176, 1032, 1163, 669, 1168, 143, 908, 1166, 1154,
-	1388, 1387, 1389, 1390, 1391, 1016, 907, 988, 1423, 137,
-	533, 105, 138, 2446, 176, 2299, 75, 1165, 2239, 533,
-	982, 1169, 979, 990, 80, 533, 80, 671, 1832, 80,
-	80, 1489, 1053, 1002, 1051, 929, 1052, 2514, 71, 1744,
-	2515, 1408, 2513, 713, 1004, 1490, 1491, 1488, 2016, 2015,
-	2014, 2008, 1221, 2012, 1125, 2007, 1126, 2005, 1154, 1129,
-	1167, 908, 2010, 1054, 89, 1402, 2108, 1403, 106, 2022,
-	1404, 2009, 1527, 1930, 907, 1141, 1142, 1143, 1144, 2489,
-	901, 904, 905, 2487, 868, 908, 2011, 2013, 898, 902,
-	2356, 1159, 2491, 2492, 907, 1729, 1073, 2116, 2117, 911,
-	901, 1727, 92, 1071, 2488, 1072, 1073, 897, 1801, 912,
-	2274, 2109, 2024, 150, 155, 152, 158, 159, 160, 161,
-	163, 164, 165, 166, 178, 179, 180, 2273, 1509, 167,
-	168, 169, 170, 975, 987, 1017, 2111, 1156, 1424, 1527,
-	2106, 1805, 1717, 2205, 668, 2203, 1182, 1187, 670, 1325,
-	1071, 1164, 1072, 1073, 2116, 2117, 1072, 1073, 907, 1324,
-	942, 2107, 1314, 1184, 1093, 1094, 1095, 1096, 1098, 1097,
-	1099, 1100, 1722, 176, 1211, 1212, 1725, 1279, 917, 717,
-	1800, 1071, 907, 1072, 1073, 2507, 1287, 1722, 901, 904,
-	905, 915, 868, 2113, 2457, 1510, 898, 902, 2505, 1726,
-	2115, 1095, 1096, 1098, 1097, 1099, 1100, 533, 1182, 1312,
-	2424, 1074, 2118, 2264, 1724, 2524, 1071, 1321, 1072, 1073,
-	1071, 1323, 1072, 1073, 533, 533, 2458, 533, 1206, 533,
-	533, 1395, 533, 533, 533, 533, 533, 533, 1216, 1121,
-	1796, 2526, 2425, 1071, 2383, 1072, 1073, 533, 1322, 1795,
-	2333, 176, 1359, 1783, 1784, 1785, 1170, 2115, 1077, 1078,
-	1079, 1080, 1081, 1082, 1083, 1075, 2332, 176, 1182, 2118,
-	2281, 2280, 1813, 1354, 1355, 1531, 81, 546, 533, 2144,
-	176, 2134, 1293, 1294, 1308, 1071, 1915, 1072, 1073, 2525,
-	1487, 1413, 1606, 1607, 1394, 176, 1914, 1685, 1301, 1479,
-	1481, 1482, 616, 617, 618, 1328, 1396, 1329, 1381, 1331,
-	1333, 176, 1380, 1337, 1339, 1341, 1343, 1345, 176, 1393,
-	1480, 178, 179, 180, 1356, 2271, 1195, 176, 176, 176,
-	176, 176, 176, 176, 176, 176, 533, 533, 533, 1383,
-	1320, 708, 1362, 1363, 1319, 1379, 1285, 1370, 1368, 1369,
-	1364, 1361, 1360, 1311, 1298, 1335, 1191, 1299, 1198, 2506,
-	1318, 1318, 2437, 1297, 2428, 1419, 1182, 176, 1427, 1071,
-	2110, 1072, 1073, 2427, 2426, 1431, 2355, 1433, 1434, 1435,
-	1436, 2353, 1392, 2381, 1440, 1372, 2329, 1071, 1794, 1072,
-	1073, 1194, 2278, 1425, 1426, 2270, 2300, 1071, 1454, 1072,
-	1073, 1071, 1382, 1072, 1073, 1192, 1924, 1430, 1357, 178,
-	179, 180, 1508, 1899, 1437, 1438, 1439, 1416, 1071, 1912,
-	1072, 1073, 178, 179, 180, 1517, 533, 1071, 1485, 1072,
-	1073, 1071, 1738, 1072, 1073, 1737, 113, 2211, 1597, 1583,
-	885, 533, 533, 1492, 1483, 1494, 1495, 1496, 1497, 1498,
-	1499, 1500, 1501, 1502, 1503, 1504, 1505, 1506, 1493, 1429,
-	884, 1528, 1291, 2210, 1512, 1511, 1456, 1420, 1916, 2238,
-	1561, 176, 1384, 1071, 1182, 1072, 1073, 1371, 1450, 1451,
-	1452, 1367, 1453, 1366, 1365, 1071, 1582, 1072, 1073, 178,
-	179, 180, 1584, 1698, 1585, 1193, 1030, 176, 2174, 1071,
-	533, 1072, 1073, 1071, 86, 1072, 1073, 1043, 95, 1486,
-	176, 88, 2409, 533, 86, 87, 1563, 2408, 176, 94,
-	176, 93, 176, 176, 533, 87, 2377, 533, 1091, 1092,
-	1093, 1094, 1095, 1096, 1098, 1097, 1099, 1100, 533, 2376,
-	1561, 2316, 89, 1848, 2520, 1948, 1565, 1566, 713, 1848,
-	2476, 713, 95, 1927, 1513, 178, 179, 180, 89, 1696,
-	1646, 1519, 1520, 94, 1616, 93, 1848, 2463, 1590, 1182,
-	1848, 2461, 1562, 2240, 88, 2453, 1182, 1848, 2442, 1182,
-	1859, 1564, 2308, 2439, 1567, 1568, 1563, 1888, 1182, 1848,
-	2384, 2308, 1182, 533, 1848, 2306, 1722, 1182, 1888, 1691,
-	1692, 1693, 2243, 1182, 1695, 1697, 2126, 2125, 1641, 2122,
-	2123, 683, 2122, 2121, 88, 1642, 1844, 1589, 533, 1867,
-	1182, 1792, 1182, 1859, 533, 1321, 2095, 1624, 1321, 1647,
-	1321, 88, 1182, 1778, 1967, 1778, 1721, 1656, 1657, 1658,
-	1659, 1711, 1611, 1592, 1672, 1282, 1952, 1645, 1944, 1945,
-	1889, 1867, 1421, 1866, 1609, 94, 1678, 1848, 1847, 1891,
-	1629, 1889, 1070, 1182, 1282, 1281, 533, 1070, 1508, 2064,
-	1778, 1723, 1182, 1508, 1508, 1227, 1226, 1644, 1643, 2238,
-	2445, 1628, 1848, 1867, 717, 2124, 1630, 717, 1792, 1792,
-	1810, 1809, 1722, 1705, 2238, 1708, 1604, 1186, 603, 1572,
-	1464, 1410, 1213, 892, 673, 891, 2176, 1867, 2495, 176,
-	2466, 81, 2398, 1189, 1673, 2374, 176, 2367, 2298, 2295,
-	2276, 176, 176, 1792, 1683, 176, 2283, 176, 1722, 1686,
-	2214, 1694, 1684, 176, 1474, 1475, 1476, 1477, 1668, 1669,
-	176, 2213, 1284, 1731, 1671, 2172, 177, 1673, 1716, 177,
-	2149, 1719, 177, 1720, 909, 1718, 1715, 534, 1714, 177,
-	1733, 1732, 910, 2145, 1953, 1735, 1736, 177, 176, 533,
-	1667, 1661, 1318, 1660, 1515, 1516, 2284, 2285, 2286, 177,
-	2287, 81, 1521, 1090, 1091, 1092, 1093, 1094, 1095, 1096,
-	1098, 1097, 1099, 1100, 1769, 1770, 1398, 1556, 1313, 1772,
-	1309, 534, 1280, 107, 534, 177, 534, 2147, 1773, 1920,
-	1921, 974, 1350, 1741, 2250, 2251, 1651, 2399, 1652, 1653,
-	1654, 1655, 1682, 2502, 2484, 546, 2253, 2288, 2289, 2141,
-	2140, 2139, 1485, 2064, 1662, 1663, 1664, 1665, 1931, 1347,
-	1763, 1457, 2500, 1190, 2256, 2086, 1787, 1762, 1789, 1089,
-	2087, 2337, 1085, 2336, 1086, 2084, 1921, 2255, 1602, 1603,
-	2085, 1788, 1351, 1352, 1353, 2083, 2082, 2477, 1087, 1088,
-	1084, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1098, 1097,
-	1099, 1100, 1596, 176, 1588, 1636, 1348, 1349, 2244, 2044,
-	2088, 176, 1876, 1877, 2056, 2058, 2423, 1776, 2043, 533,
-	1089, 2335, 1997, 2059, 1824, 1872, 1875, 1876, 1877, 1873,
-	533, 1874, 1878, 1486, 1950, 2250, 2251, 2346, 2348, 2234,
-	1786, 2053, 1090, 1091, 1092, 1093, 1094, 1095, 1096, 1098,
-	1097, 1099, 1100, 176, 176, 1872, 1875, 1876, 1877, 1873,
-	2231, 1874, 1878, 687, 1674, 1180, 1176, 1863, 2230, 1898,
-	1563, 688, 1409, 662, 2120, 1906, 1804, 1925, 1523, 939,
-	1177, 938, 34, 86, 86, 2186, 1920, 1802, 1992, 1042,
-	88, 1883, 1524, 2236, 87, 87, 1960, 95, 1959, 114,
-	1606, 1607, 2137, 1845, 88, 1586, 1587, 1179, 94, 1178,
-	93, 1766, 2438, 533, 1849, 2217, 2394, 2119, 176, 88,
-	1159, 1880, 1825, 1815, 1816, 176, 1562, 1593, 1882, 1835,
-	695, 696, 2042, 1755, 1782, 93, 1841, 533, 1846, 2415,
-	2041, 95, 1902, 2414, 533, 1180, 1176, 2354, 1321, 1321,
-	1856, 587, 94, 533, 93, 1943, 2352, 2351, 1886, 2344,
-	1177, 2296, 1893, 2235, 2233, 1964, 2150, 1890, 1706, 1909,
-	1910, 1678, 1900, 1296, 694, 94, 176, 176, 176, 176,
-	176, 95, 1903, 2343, 2224, 1173, 1174, 1179, 1859, 1178,
-	2504, 2503, 94, 176, 176, 1923, 1844, 1913, 1811, 1207,
-	1199, 100, 101, 2504, 2429, 2269, 685, 1922, 97, 176,
-	532, 1089, 82, 1790, 1, 611, 1928, 2486, 502, 1932,
-	1933, 1934, 1573, 1157, 516, 2482, 1962, 1508, 1385, 1375,
-	2319, 1301, 2395, 1090, 1091, 1092, 1093, 1094, 1095, 1096,
-	1098, 1097, 1099, 1100, 1949, 1712, 669, 2294, 1676, 533,
-	2021, 900, 139, 1963, 715, 1639, 1961, 865, 1640, 872,
-	2472, 104, 861, 103, 903, 533, 1001, 1707, 2309, 1907,
-	1648, 1954, 1955, 2034, 1233, 176, 1231, 1232, 1999, 533,
-	1230, 1235, 2000, 1234, 1229, 1812, 1458, 530, 533, 1879,
-	1990, 174, 1222, 1991, 1200, 533, 533, 1984, 176, 176,
-	176, 176, 176, 177, 2061, 177, 940, 492, 177, 2076,
-	176, 1806, 2034, 2001, 2017, 176, 176, 2047, 176, 2018,
-	2067, 176, 176, 176, 2127, 2067, 2070, 1739, 498, 1113,
-	2040, 1894, 714, 675, 707, 2072, 534, 534, 534, 2228,
-	2052, 2054, 1851, 2035, 2057, 2039, 2033, 2050, 2422, 2345,
-	2464, 1904, 1196, 1169, 534, 534, 2135, 2094, 2048, 2045,
-	2096, 1803, 176, 2097, 1120, 1525, 2046, 1621, 1581, 677,
-	676, 1478, 555, 554, 2077, 533, 2075, 2080, 552, 1837,
-	1860, 1076, 2089, 606, 533, 1829, 1208, 1871, 1198, 176,
-	2093, 1869, 89, 1419, 2098, 2078, 2079, 1868, 2081, 176,
-	1764, 1625, 2156, 2104, 2252, 2248, 2152, 2036, 2037, 2038,
-	1619, 1843, 563, 556, 176, 548, 2130, 176, 2131, 604,
-	2266, 2162, 1970, 2173, 1972, 1905, 2169, 2187, 2164, 1055,
-	1172, 2163, 542, 873, 1089, 1522, 2132, 2133, 2400, 2386,
-	1781, 2207, 1171, 1534, 1535, 2142, 172, 1678, 2155, 1699,
-	60, 38, 2151, 537, 2496, 2167, 1090, 1091, 1092, 1093,
-	1094, 1095, 1096, 1098, 1097, 1099, 1100, 1046, 701, 32,
-	114, 176, 31, 30, 29, 28, 23, 22, 2182, 2184,
-	2185, 2181, 177, 156, 21, 2188, 20, 19, 25, 2189,
-	18, 17, 16, 109, 47, 2195, 44, 42, 116, 177,
-	115, 45, 41, 977, 39, 27, 26, 15, 14, 13,
-	12, 11, 10, 9, 5, 4, 1049, 24, 534, 2,
-	2218, 2219, 177, 1936, 1701, 1901, 176, 534, 0, 0,
-	0, 0, 0, 534, 0, 2232, 2225, 0, 0, 0,
-	153, 0, 154, 2237, 2272, 0, 0, 0, 0, 176,
-	2257, 0, 171, 0, 0, 0, 2254, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 176, 2260, 2222,
-	176, 176, 176, 0, 2259, 0, 2164, 0, 0, 2163,
-	533, 533, 2267, 2268, 2019, 2020, 0, 0, 0, 2023,
-	0, 0, 0, 2025, 2026, 2027, 0, 0, 2301, 2302,
-	2247, 0, 0, 0, 0, 0, 0, 533, 533, 533,
-	533, 0, 0, 0, 0, 0, 0, 0, 2304, 0,
-	2261, 2262, 0, 0, 0, 0, 0, 0, 2315, 0,
-	0, 2313, 2314, 0, 0, 0, 0, 0, 2277, 0,
-	2279, 0, 0, 2062, 0, 0, 0, 0, 0, 0,
-	0, 176, 0, 2328, 0, 0, 157, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 0, 2324, 0, 715,
-	715, 715, 0, 0, 533, 0, 533, 0, 0, 0,
-	0, 177, 0, 0, 0, 0, 2363, 1045, 1047, 2341,
-	2349, 669, 2340, 2350, 0, 2365, 2361, 2067, 0, 0,
-	2357, 2359, 0, 0, 2070, 0, 2192, 2193, 2070, 2194,
-	2325, 34, 2196, 0, 2198, 534, 2200, 0, 0, 0,
-	0, 0, 0, 0, 2148, 0, 0, 2372, 0, 533,
-	0, 0, 534, 534, 0, 534, 0, 534, 534, 0,
-	534, 534, 534, 534, 534, 534, 0, 2382, 2379, 533,
-	2378, 0, 0, 2380, 0, 534, 0, 2389, 0, 177,
-	2390, 0, 0, 1151, 0, 533, 0, 2385, 0, 533,
-	533, 0, 0, 2397, 149, 177, 0, 0, 0, 0,
-	0, 0, 0, 2369, 2370, 0, 534, 2418, 177, 2421,
-	0, 2419, 0, 0, 2416, 2417, 0, 0, 533, 0,
-	0, 0, 2430, 177, 0, 533, 0, 0, 2209, 0,
-	0, 2432, 0, 669, 0, 0, 2433, 2434, 0, 177,
-	0, 0, 0, 2436, 2070, 0, 177, 0, 0, 533,
-	176, 0, 0, 0, 0, 177, 177, 177, 177, 177,
-	177, 177, 177, 177, 534, 534, 534, 2447, 2444, 0,
-	0, 1203, 0, 0, 0, 546, 0, 0, 0, 1181,
-	715, 0, 533, 34, 0, 0, 1223, 0, 0, 0,
-	0, 0, 2459, 0, 0, 177, 533, 533, 0, 0,
-	2462, 0, 2467, 2471, 533, 0, 0, 0, 2067, 0,
-	0, 0, 2465, 0, 0, 0, 0, 0, 0, 0,
-	2397, 2473, 2493, 2485, 2490, 0, 0, 34, 0, 0,
-	0, 0, 2499, 0, 0, 0, 0, 2501, 0, 0,
-	0, 0, 0, 0, 0, 0, 533, 0, 0, 2297,
-	0, 0, 0, 2510, 534, 0, 0, 2517, 0, 0,
-	0, 0, 0, 0, 2508, 2312, 0, 0, 0, 534,
-	534, 0, 2527, 0, 0, 0, 172, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 150, 155, 152, 158,
-	159, 160, 161, 163, 164, 165, 166, 0, 0, 177,
-	114, 0, 167, 168, 169, 170, 0, 2326, 0, 2327,
-	0, 0, 0, 156, 2330, 2331, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 177, 0, 0, 534, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 177, 0,
-	0, 534, 0, 2358, 0, 0, 177, 0, 177, 0,
-	177, 177, 534, 0, 2366, 534, 0, 2368, 0, 0,
-	0, 0, 0, 0, 0, 0, 534, 0, 865, 2371,
-	153, 0, 154, 0, 0, 0, 0, 0, 0, 0,
-	2375, 1151, 171, 0, 0, 1327, 1327, 0, 1327, 0,
-	1327, 1327, 0, 1336, 1327, 1327, 1327, 1327, 1327, 0,
-	0, 0, 0, 0, 0, 0, 1151, 1151, 865, 546,
-	0, 0, 0, 0, 0, 0, 172, 0, 0, 0,
-	0, 534, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1397,
-	114, 0, 136, 0, 0, 0, 534, 2420, 546, 0,
-	0, 0, 534, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 588,
-	0, 0, 0, 0, 146, 0, 157, 0, 0, 135,
-	0, 546, 0, 0, 534, 162, 0, 715, 715, 715,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	153, 0, 154, 0, 0, 0, 0, 0, 123, 124,
-	145, 144, 171, 0, 0, 0, 0, 175, 0, 0,
-	488, 0, 0, 528, 0, 0, 0, 177, 0, 0,
-	488, 0, 0, 0, 177, 0, 0, 0, 488, 177,
-	177, 0, 0, 177, 0, 177, 0, 0, 0, 0,
-	682, 177, 0, 0, 0, 0, 0, 0, 177, 0,
-	2494, 0, 0, 0, 0, 0, 700, 0, 700, 0,
-	0, 0, 0, 0, 0, 0, 488, 1518, 0, 0,
-	0, 0, 0, 0, 1151, 0, 177, 534, 0, 0,
-	0, 0, 1532, 1533, 149, 0, 0, 715, 0, 0,
-	0, 0, 0, 0, 0, 140, 121, 147, 128, 120,
-	0, 141, 142, 0, 0, 0, 157, 0, 0, 0,
-	613, 73, 0, 0, 0, 162, 129, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	132, 130, 125, 126, 127, 131, 0, 0, 0, 0,
-	122, 1595, 0, 0, 0, 0, 0, 0, 0, 133,
-	0, 0, 0, 0, 1203, 0, 0, 715, 0, 0,
-	0, 0, 0, 0, 0, 715, 0, 0, 715, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 865,
-	0, 0, 672, 0, 73, 0, 0, 0, 0, 0,
-	0, 177, 0, 0, 0, 0, 0, 0, 0, 177,
-	0, 0, 672, 0, 0, 0, 0, 534, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 534, 0,
-	0, 0, 0, 0, 149, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 872, 0, 0, 0, 0, 0,
-	0, 177, 177, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 865,
-	0, 0, 0, 0, 0, 872, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 150, 155, 152, 158,
-	159, 160, 161, 163, 164, 165, 166, 0, 0, 0,
-	0, 0, 167, 168, 169, 170, 0, 0, 0, 143,
-	0, 534, 0, 0, 0, 0, 177, 865, 0, 0,
-	0, 0, 137, 177, 0, 138, 0, 0, 0, 72,
-	36, 37, 74, 0, 0, 534, 0, 0, 0, 0,
-	0, 0, 534, 0, 0, 0, 1183, 1185, 0, 78,
-	0, 534, 0, 40, 66, 67, 0, 64, 68, 0,
-	0, 0, 0, 0, 0, 0, 65, 0, 0, 0,
-	0, 0, 0, 0, 177, 177, 177, 177, 177, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 177, 177, 0, 0, 53, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 81, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1775, 0, 0, 0, 0, 0, 150, 155, 152, 158,
-	159, 160, 161, 163, 164, 165, 166, 0, 0, 0,
-	0, 0, 167, 168, 169, 170, 0, 534, 0, 0,
-	0, 0, 0, 0, 488, 0, 488, 0, 0, 488,
-	0, 0, 0, 534, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 177, 0, 0, 0, 534, 0, 0,
-	0, 0, 0, 0, 0, 0, 534, 0, 0, 0,
-	0, 0, 0, 534, 534, 0, 177, 177, 177, 177,
-	177, 0, 0, 0, 0, 0, 0, 0, 177, 0,
-	0, 0, 0, 177, 177, 0, 177, 0, 0, 177,
-	177, 177, 0, 0, 0, 0, 43, 46, 49, 48,
-	51, 715, 63, 0, 0, 69, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1838, 0, 0, 0, 0, 0, 0, 52, 77, 76,
-	177, 1853, 61, 62, 50, 0, 0, 0, 0, 0,
-	0, 0, 0, 534, 0, 0, 0, 0, 0, 0,
-	0, 1152, 534, 0, 0, 0, 0, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 177, 0, 0,
-	0, 0, 0, 0, 54, 55, 0, 56, 57, 58,
-	59, 0, 177, 0, 0, 177, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 488, 0, 0, 0, 0, 1037, 1037,
-	1037, 0, 0, 0, 1926, 0, 0, 0, 0, 0,
-	682, 0, 0, 0, 0, 0, 0, 0, 73, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1595, 177,
-	0, 0, 0, 488, 0, 1951, 0, 0, 0, 0,
-	0, 0, 0, 0, 1956, 672, 1109, 1110, 1111, 0,
-	1114, 0, 1116, 1117, 1118, 1119, 0, 1122, 1124, 1124,
-	0, 1124, 1128, 1128, 1130, 1131, 1132, 1133, 1134, 0,
-	1136, 1137, 1138, 1139, 1140, 0, 0, 0, 0, 1128,
-	1128, 1128, 1128, 0, 177, 0, 0, 0, 0, 0,
-	75, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 0, 0, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 177, 0, 0, 177, 177,
-	177, 0, 0, 0, 0, 0, 0, 0, 534, 534,
-	715, 0, 0, 0, 0, 0, 1161, 0, 0, 672,
-	0, 0, 0, 672, 1529, 0, 1327, 0, 1530, 672,
-	0, 0, 0, 0, 0, 534, 534, 534, 534, 0,
-	2049, 0, 0, 0, 0, 0, 0, 0, 0, 715,
-	0, 0, 0, 1151, 1183, 1571, 2074, 1327, 1151, 0,
-	0, 0, 488, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
-	1160, 0, 0, 0, 1591, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 534, 0, 534, 0, 0, 0, 0, 1152,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 487, 0, 0, 1152, 1152, 865, 0, 0, 1151,
-	488, 536, 0, 0, 0, 1595, 0, 0, 0, 665,
-	0, 0, 0, 0, 0, 0, 1373, 534, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 488,
-	0, 0, 0, 0, 0, 0, 0, 534, 0, 0,
-	0, 0, 0, 0, 1418, 0, 0, 869, 0, 0,
-	0, 0, 0, 534, 0, 0, 0, 534, 534, 0,
-	488, 0, 0, 0, 0, 0, 0, 488, 0, 0,
-	0, 0, 0, 0, 0, 0, 1441, 1442, 488, 488,
-	488, 488, 488, 488, 488, 0, 534, 0, 0, 0,
-	0, 0, 0, 534, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 488, 534, 177, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	534, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 534, 534, 0, 0, 0, 700,
-	0, 0, 534, 0, 0, 0, 700, 700, 0, 0,
-	0, 0, 1152, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 700, 1418, 700, 700, 700, 700,
-	700, 1595, 1595, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 534, 0, 0, 0, 0, 0,
-	1373, 0, 0, 0, 0, 0, 0, 0, 2320, 2321,
-	2322, 2323, 700, 0, 0, 0, 1037, 1037, 1037, 0,
-	0, 0, 0, 0, 0, 0, 682, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 488,
-	0, 0, 0, 0, 0, 1418, 0, 488, 0, 488,
-	0, 488, 1627, 1793, 0, 0, 0, 1797, 0, 1798,
-	1799, 0, 0, 0, 0, 178, 179, 180, 1807, 0,
-	1151, 1808, 0, 0, 0, 2362, 0, 2364, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 520, 0, 1814, 0, 0,
-	0, 0, 0, 0, 1819, 1820, 1821, 1822, 1823, 0,
-	1591, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1836, 0, 0, 0, 0, 0, 0, 0, 0,
-	1595, 0, 0, 0, 0, 0, 507, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	715, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2412, 0, 0, 0,
-	2412, 2412, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 506, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 504, 0, 0, 0, 0, 0, 1595,
-	0, 0, 1622, 0, 0, 978, 1595, 983, 0, 0,
-	985, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1595, 501, 0, 0, 0, 0, 0, 0, 488, 0,
-	515, 0, 0, 0, 0, 488, 0, 0, 0, 0,
-	488, 488, 0, 0, 488, 512, 1767, 0, 0, 0,
-	0, 1151, 488, 2460, 0, 0, 0, 0, 0, 488,
-	0, 0, 0, 0, 0, 0, 0, 715, 715, 0,
-	0, 0, 0, 0, 0, 2480, 0, 0, 521, 0,
-	0, 0, 0, 0, 0, 0, 0, 488, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 491, 0,
-	493, 508, 0, 523, 0, 522, 497, 1595, 495, 499,
-	509, 500, 0, 494, 0, 505, 0, 0, 496, 510,
-	511, 513, 527, 526, 514, 0, 503, 524, 0, 0,
-	0, 0, 2028, 0, 0, 2031, 2032, 0, 0, 0,
-	0, 0, 700, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 700, 700,
-	0, 0, 0, 0, 2073, 0, 0, 0, 0, 1418,
-	0, 0, 488, 0, 0, 0, 0, 0, 0, 0,
-	1373, 2091, 2092, 0, 1210, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 488, 488, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 525, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 518,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 519, 0, 0, 488, 0, 0,
-	0, 0, 0, 0, 1935, 0, 0, 0, 0, 0,
-	0, 0, 0, 2191, 0, 0, 0, 0, 0, 0,
-	0, 0, 1817, 0, 0, 0, 0, 2201, 2202, 2204,
-	2206, 0, 0, 0, 0, 0, 0, 2212, 0, 1834,
-	0, 0, 0, 2215, 0, 0, 0, 0, 0, 2220,
-	0, 0, 0, 1228, 672, 488, 488, 488, 488, 488,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 488, 488, 1864, 1865, 0, 0, 0, 0,
-	0, 2241, 2242, 1884, 0, 2246, 0, 0, 488, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 700, 2258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 700, 0, 0,
-	0, 1358, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 488, 0, 0, 0, 0, 0,
-	1399, 0, 0, 0, 0, 0, 0, 2307, 0, 0,
-	0, 1152, 0, 0, 0, 0, 1152, 488, 488, 488,
-	488, 488, 0, 0, 1957, 0, 0, 0, 0, 2090,
-	0, 1428, 0, 0, 488, 1373, 0, 488, 1432, 0,
-	488, 2099, 1418, 0, 0, 0, 0, 0, 0, 1443,
-	1444, 1445, 1446, 1447, 1448, 1449, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1250, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 488, 0, 0, 0, 0, 0, 1467, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1152, 0, 0,
-	0, 0, 0, 0, 0, 0, 172, 0, 488, 0,
-	0, 0, 0, 0, 0, 0, 0, 1300, 488, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	114, 0, 136, 488, 0, 0, 488, 0, 0, 0,
-	0, 0, 0, 156, 0, 1622, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2393, 2071, 0, 73, 0, 0, 1622, 1622,
-	1622, 1622, 1622, 0, 146, 0, 2403, 2404, 2405, 135,
-	2406, 2407, 0, 0, 2411, 1884, 0, 0, 1622, 0,
-	488, 1622, 0, 0, 1238, 0, 0, 0, 0, 0,
-	153, 0, 154, 0, 0, 0, 0, 0, 1304, 1305,
-	145, 144, 171, 0, 0, 0, 0, 0, 0, 0,
-	0, 2435, 0, 0, 0, 0, 0, 0, 0, 0,
-	1608, 0, 0, 0, 0, 0, 0, 0, 1612, 0,
-	1615, 0, 0, 1467, 0, 488, 0, 0, 0, 0,
-	2449, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2454, 2161, 0, 0, 0, 0, 2455, 2456, 488, 0,
-	0, 0, 0, 0, 1251, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 488, 0, 0, 488,
-	488, 488, 0, 2468, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2190, 0, 140, 1306, 147, 0, 1303,
-	0, 141, 142, 0, 0, 0, 157, 0, 0, 0,
-	0, 0, 0, 0, 0, 162, 1264, 1267, 1268, 1269,
-	1270, 1271, 1272, 0, 1273, 1274, 1275, 1276, 1277, 1252,
-	1253, 1254, 1255, 1236, 1237, 1265, 0, 1239, 0, 1240,
-	1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1256,
-	1257, 1258, 1259, 1260, 1261, 1262, 1263, 0, 0, 0,
-	1373, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1152, 0,
-	0, 0, 0, 0, 0, 0, 1622, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2265,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1467,
-	0, 0, 0, 0, 149, 0, 1750, 0, 0, 0,
-	0, 1760, 1761, 0, 0, 1765, 0, 0, 0, 0,
-	0, 0, 0, 1768, 0, 2305, 1266, 0, 0, 0,
-	1771, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1774, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	0, 0, 0, 0, 0, 0, 0, 2334, 0, 2338,
-	2339, 0, 137, 0, 0, 138, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2071, 0, 73, 0, 2071, 0, 0, 0, 488,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1152,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 150, 155, 152, 158,
-	159, 160, 161, 163, 164, 165, 166, 0, 0, 0,
-	0, 0, 167, 168, 169, 170, 0, 0, 0, 0,
-	0, 0, 0, 0, 1885, 0, 0, 0, 0, 0,
-	0, 2071, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1133, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 73, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1929, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 81, 0, 0, 0, 73,
-	0, 607, 614, 615, 616, 617, 618, 608, 610, 0,
-	0, 0, 609, 0, 0, 612, 619, 620, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1975, 1976, 1977, 1978,
-	1979, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1467, 1985, 0, 0, 0, 2165, 2166,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1993,
-	621, 622, 623, 624, 625, 626, 627, 628, 629, 630,
-	631, 632, 633, 634, 635, 636, 637, 638, 639, 640,
-	641, 642, 643, 644, 645, 646, 647, 648, 649, 650,
-	651, 652, 653, 654, 655, 656, 657, 658, 659, 660,
-	661, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2138, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2159,
-	0, 0, 0, 0, 0, 0, 0, 0, 81, 2168,
-	0, 0, 0, 0, 607, 614, 615, 616, 617, 618,
-	608, 610, 0, 0, 2180, 609, 0, 2183, 612, 619,
-	620, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	598, 0, 0, 0, 599, 600, 0, 0, 0, 601,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2165, 2166, 0, 0, 0, 0, 0, 0, 0,
-	0, 2221, 0, 621, 622, 623, 624, 625, 626, 627,
-	628, 629, 630, 631, 632, 633, 634, 635, 636, 637,
-	638, 639, 640, 641, 642, 643, 644, 645, 646, 647,
-	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
-	658, 659, 660, 661, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2275,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2290, 0, 0,
-	2291, 2292, 2293, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 843, 829, 410, 0,
-	777, 846, 747, 765, 856, 768, 771, 811, 726, 790,
-	333, 762, 0, 751, 722, 757, 723, 749, 779, 237,
-	746, 831, 794, 845, 289, 234, 728, 752, 347, 767,
-	187, 813, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 852, 293, 800, 0,
-	395, 318, 0, 0, 0, 781, 835, 788, 825, 776,
-	812, 736, 799, 847, 763, 808, 848, 279, 220, 186,
-	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
-	0, 2474, 0, 2475, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 759, 805, 842, 760, 807, 232, 277,
-	239, 231, 414, 853, 834, 0, 0, 203, 844, 783,
-	0, 810, 0, 859, 721, 802, 0, 724, 727, 855,
-	838, 755, 242, 0, 0, 0, 0, 0, 0, 0,
-	780, 789, 822, 774, 0, 0, 0, 0, 0, 0,
-	0, 753, 0, 798, 0, 0, 0, 732, 725, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	778, 0, 0, 0, 735, 0, 754, 823, 0, 719,
-	260, 729, 319, 0, 827, 837, 775, 447, 841, 773,
-	772, 817, 733, 833, 766, 288, 731, 285, 182, 199,
-	2448, 764, 329, 369, 375, 832, 750, 758, 223, 756,
-	373, 343, 431, 207, 250, 366, 348, 371, 797, 815,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 427, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	1039, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 745, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 828, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 324, 270, 393,
-	286, 295, 820, 858, 342, 374, 213, 433, 394, 740,
-	744, 738, 739, 792, 793, 741, 849, 850, 851, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 824, 734,
-	0, 742, 743, 0, 830, 839, 840, 483, 796, 181,
-	196, 291, 854, 363, 253, 461, 441, 437, 720, 737,
-	229, 748, 0, 0, 761, 769, 770, 782, 784, 785,
-	786, 787, 315, 803, 804, 806, 814, 816, 819, 821,
-	826, 836, 857, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 795, 801, 302, 247, 265, 276,
-	809, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	791, 818, 298, 411, 412, 272, 843, 829, 410, 0,
-	777, 846, 747, 765, 856, 768, 771, 811, 726, 790,
-	333, 762, 0, 751, 722, 757, 723, 749, 779, 237,
-	746, 831, 794, 845, 289, 234, 728, 752, 347, 767,
-	187, 813, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 852, 293, 800, 0,
-	395, 318, 0, 0, 0, 781, 835, 788, 825, 776,
-	812, 736, 799, 847, 763, 808, 848, 279, 220, 186,
-	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 759, 805, 842, 760, 807, 232, 277,
-	239, 231, 414, 853, 834, 0, 0, 203, 844, 783,
-	0, 810, 0, 859, 721, 802, 0, 724, 727, 855,
-	838, 755, 242, 0, 0, 0, 0, 0, 0, 0,
-	780, 789, 822, 774, 0, 0, 0, 0, 0, 2100,
-	0, 753, 0, 798, 0, 0, 0, 732, 725, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	778, 0, 0, 0, 735, 0, 754, 823, 0, 719,
-	260, 729, 319, 0, 827, 837, 775, 447, 841, 773,
-	772, 817, 733, 833, 766, 288, 731, 285, 182, 199,
-	0, 764, 329, 369, 375, 832, 750, 758, 223, 756,
-	373, 343, 431, 207, 250, 366, 348, 371, 797, 815,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 427, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	1039, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 745, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 828, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 324, 270, 393,
-	286, 295, 820, 858, 342, 374, 213, 433, 394, 740,
-	744, 738, 739, 792, 793, 741, 849, 850, 851, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 824, 734,
-	0, 742, 743, 0, 830, 839, 840, 483, 796, 181,
-	196, 291, 854, 363, 253, 461, 441, 437, 720, 737,
-	229, 748, 0, 0, 761, 769, 770, 782, 784, 785,
-	786, 787, 315, 803, 804, 806, 814, 816, 819, 821,
-	826, 836, 857, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 795, 801, 302, 247, 265, 276,
-	809, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	791, 818, 298, 411, 412, 272, 843, 829, 410, 0,
-	777, 846, 747, 765, 856, 768, 771, 811, 726, 790,
-	333, 762, 0, 751, 722, 757, 723, 749, 779, 237,
-	746, 831, 794, 845, 289, 234, 728, 752, 347, 767,
-	187, 813, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 852, 293, 800, 0,
-	395, 318, 0, 0, 0, 781, 835, 788, 825, 776,
-	812, 736, 799, 847, 763, 808, 848, 279, 220, 186,
-	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 759, 805, 842, 760, 807, 232, 277,
-	239, 231, 414, 853, 834, 0, 0, 203, 844, 783,
-	0, 810, 0, 859, 721, 802, 0, 724, 727, 855,
-	838, 755, 242, 0, 0, 0, 0, 0, 0, 0,
-	780, 789, 822, 774, 0, 0, 0, 0, 0, 2060,
-	0, 753, 0, 798, 0, 0, 0, 732, 725, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	778, 0, 0, 0, 735, 0, 754, 823, 0, 719,
-	260, 729, 319, 0, 827, 837, 775, 447, 841, 773,
-	772, 817, 733, 833, 766, 288, 731, 285, 182, 199,
-	0, 764, 329, 369, 375, 832, 750, 758, 223, 756,
-	373, 343, 431, 207, 250, 366, 348, 371, 797, 815,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 427, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	1039, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 745, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 828, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 324, 270, 393,
-	286, 295, 820, 858, 342, 374, 213, 433, 394, 740,
-	744, 738, 739, 792, 793, 741, 849, 850, 851, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 824, 734,
-	0, 742, 743, 0, 830, 839, 840, 483, 796, 181,
-	196, 291, 854, 363, 253, 461, 441, 437, 720, 737,
-	229, 748, 0, 0, 761, 769, 770, 782, 784, 785,
-	786, 787, 315, 803, 804, 806, 814, 816, 819, 821,
-	826, 836, 857, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 795, 801, 302, 247, 265, 276,
-	809, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	791, 818, 298, 411, 412, 272, 843, 829, 410, 0,
-	777, 846, 747, 765, 856, 768, 771, 811, 726, 790,
-	333, 762, 0, 751, 722, 757, 723, 749, 779, 237,
-	746, 831, 794, 845, 289, 234, 728, 752, 347, 767,
-	187, 813, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 852, 293, 800, 0,
-	395, 318, 0, 0, 0, 781, 835, 788, 825, 776,
-	812, 736, 799, 847, 763, 808, 848, 279, 220, 186,
-	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 759, 805, 842, 760, 807, 232, 277,
-	239, 231, 414, 853, 834, 0, 0, 203, 844, 783,
-	0, 810, 0, 859, 721, 802, 0, 724, 727, 855,
-	838, 755, 242, 0, 0, 0, 0, 0, 0, 0,
-	780, 789, 822, 774, 0, 0, 0, 0, 0, 1610,
-	0, 753, 0, 798, 0, 0, 0, 732, 725, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	778, 0, 0, 0, 735, 0, 754, 823, 0, 719,
-	260, 729, 319, 0, 827, 837, 775, 447, 841, 773,
-	772, 817, 733, 833, 766, 288, 731, 285, 182, 199,
-	0, 764, 329, 369, 375, 832, 750, 758, 223, 756,
-	373, 343, 431, 207, 250, 366, 348, 371, 797, 815,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 427, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	1039, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 745, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 828, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 324, 270, 393,
-	286, 295, 820, 858, 342, 374, 213, 433, 394, 740,
-	744, 738, 739, 792, 793, 741, 849, 850, 851, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 824, 734,
-	0, 742, 743, 0, 830, 839, 840, 483, 796, 181,
-	196, 291, 854, 363, 253, 461, 441, 437, 720, 737,
-	229, 748, 0, 0, 761, 769, 770, 782, 784, 785,
-	786, 787, 315, 803, 804, 806, 814, 816, 819, 821,
-	826, 836, 857, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 795, 801, 302, 247, 265, 276,
-	809, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	791, 818, 298, 411, 412, 272, 843, 829, 410, 0,
-	777, 846, 747, 765, 856, 768, 771, 811, 726, 790,
-	333, 762, 0, 751, 722, 757, 723, 749, 779, 237,
-	746, 831, 794, 845, 289, 234, 728, 752, 347, 767,
-	187, 813, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 852, 293, 800, 0,
-	395, 318, 0, 0, 0, 781, 835, 788, 825, 776,
-	812, 736, 799, 847, 763, 808, 848, 279, 220, 186,
-	330, 396, 252, 0, 81, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 759, 805, 842, 760, 807, 232, 277,
-	239, 231, 414, 853, 834, 0, 0, 203, 844, 783,
-	0, 810, 0, 859, 721, 802, 0, 724, 727, 855,
-	838, 755, 242, 0, 0, 0, 0, 0, 0, 0,
-	780, 789, 822, 774, 0, 0, 0, 0, 0, 0,
-	0, 753, 0, 798, 0, 0, 0, 732, 725, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	778, 0, 0, 0, 735, 0, 754, 823, 0, 719,
-	260, 729, 319, 0, 827, 837, 775, 447, 841, 773,
-	772, 817, 733, 833, 766, 288, 731, 285, 182, 199,
-	0, 764, 329, 369, 375, 832, 750, 758, 223, 756,
-	373, 343, 431, 207, 250, 366, 348, 371, 797, 815,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 427, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	1039, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 745, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 828, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 324, 270, 393,
-	286, 295, 820, 858, 342, 374, 213, 433, 394, 740,
-	744, 738, 739, 792, 793, 741, 849, 850, 851, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 824, 734,
-	0, 742, 743, 0, 830, 839, 840, 483, 796, 181,
-	196, 291, 854, 363, 253, 461, 441, 437, 720, 737,
-	229, 748, 0, 0, 761, 769, 770, 782, 784, 785,
-	786, 787, 315, 803, 804, 806, 814, 816, 819, 821,
-	826, 836, 857, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 795, 801, 302, 247, 265, 276,
-	809, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	791, 818, 298, 411, 412, 272, 843, 829, 410, 0,
-	777, 846, 747, 765, 856, 768, 771, 811, 726, 790,
-	333, 762, 0, 751, 722, 757, 723, 749, 779, 237,
-	746, 831, 794, 845, 289, 234, 728, 752, 347, 767,
-	187, 813, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 852, 293, 800, 0,
-	395, 318, 0, 0, 0, 781, 835, 788, 825, 776,
-	812, 736, 799, 847, 763, 808, 848, 279, 220, 186,
-	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 759, 805, 842, 760, 807, 232, 277,
-	239, 231, 414, 853, 834, 0, 0, 203, 844, 783,
-	0, 810, 0, 859, 721, 802, 0, 724, 727, 855,
-	838, 755, 242, 0, 0, 0, 0, 0, 0, 0,
-	780, 789, 822, 774, 0, 0, 0, 0, 0, 0,
-	0, 753, 0, 798, 0, 0, 0, 732, 725, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	778, 0, 0, 0, 735, 0, 754, 823, 0, 719,
-	260, 729, 319, 0, 827, 837, 775, 447, 841, 773,
-	772, 817, 733, 833, 766, 288, 731, 285, 182, 199,
-	0, 764, 329, 369, 375, 832, 750, 758, 223, 756,
-	373, 343, 431, 207, 250, 366, 348, 371, 797, 815,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 427, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	1039, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 745, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 828, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 324, 270, 393,
-	286, 295, 820, 858, 342, 374, 213, 433, 394, 740,
-	744, 738, 739, 792, 793, 741, 849, 850, 851, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 824, 734,
-	0, 742, 743, 0, 830, 839, 840, 483, 796, 181,
-	196, 291, 854, 363, 253, 461, 441, 437, 720, 737,
-	229, 748, 0, 0, 761, 769, 770, 782, 784, 785,
-	786, 787, 315, 803, 804, 806, 814, 816, 819, 821,
-	826, 836, 857, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 795, 801, 302, 247, 265, 276,
-	809, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	791, 818, 298, 411, 412, 272, 843, 829, 410, 0,
-	777, 846, 747, 765, 856, 768, 771, 811, 726, 790,
-	333, 762, 0, 751, 722, 757, 723, 749, 779, 237,
-	746, 831, 794, 845, 289, 234, 728, 752, 347, 767,
-	187, 813, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 852, 293, 800, 0,
-	395, 318, 0, 0, 0, 781, 835, 788, 825, 776,
-	812, 736, 799, 847, 763, 808, 848, 279, 220, 186,
-	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 759, 805, 842, 760, 807, 232, 277,
-	239, 231, 414, 853, 834, 0, 0, 860, 844, 783,
-	0, 810, 0, 859, 721, 802, 0, 724, 727, 855,
-	838, 755, 242, 0, 0, 0, 0, 0, 0, 0,
-	780, 789, 822, 774, 0, 0, 0, 0, 0, 0,
-	0, 753, 0, 798, 0, 0, 0, 732, 725, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	778, 0, 0, 0, 735, 0, 754, 823, 0, 719,
-	260, 729, 319, 0, 827, 837, 775, 447, 841, 773,
-	772, 817, 733, 833, 766, 288, 731, 285, 182, 199,
-	0, 764, 329, 369, 375, 832, 750, 758, 223, 756,
-	373, 343, 431, 207, 250, 366, 348, 371, 797, 815,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 427, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	730, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 745, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 828, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 718, 712, 711,
-	286, 295, 820, 858, 342, 374, 213, 433, 394, 740,
-	744, 738, 739, 792, 793, 741, 849, 850, 851, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 824, 734,
-	0, 742, 743, 0, 830, 839, 840, 483, 796, 181,
-	196, 291, 854, 363, 253, 461, 441, 437, 720, 737,
-	229, 748, 0, 0, 761, 769, 770, 782, 784, 785,
-	786, 787, 315, 803, 804, 806, 814, 816, 819, 821,
-	826, 836, 857, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 795, 801, 302, 247, 265, 276,
-	809, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	791, 818, 298, 411, 412, 272, 843, 829, 410, 0,
-	777, 846, 747, 765, 856, 768, 771, 811, 726, 790,
-	333, 762, 0, 751, 722, 757, 723, 749, 779, 237,
-	746, 831, 794, 845, 289, 234, 728, 752, 347, 767,
-	187, 813, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 852, 293, 800, 0,
-	395, 318, 0, 0, 0, 781, 835, 788, 825, 776,
-	812, 736, 799, 847, 763, 808, 848, 279, 220, 186,
-	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 759, 805, 842, 760, 807, 232, 277,
-	239, 231, 414, 853, 834, 0, 0, 860, 844, 783,
-	0, 810, 0, 859, 721, 802, 0, 724, 727, 855,
-	838, 755, 242, 0, 0, 0, 0, 0, 0, 0,
-	780, 789, 822, 774, 0, 0, 0, 0, 0, 0,
-	0, 753, 0, 798, 0, 0, 0, 732, 725, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	778, 0, 0, 0, 735, 0, 754, 823, 0, 719,
-	260, 729, 319, 0, 827, 837, 775, 447, 841, 773,
-	772, 817, 733, 833, 766, 288, 731, 285, 182, 199,
-	0, 764, 329, 369, 375, 832, 750, 758, 223, 756,
-	373, 343, 431, 207, 250, 366, 348, 371, 797, 815,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 1214, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	730, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 745, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 828, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 718, 712, 711,
-	286, 295, 820, 858, 342, 374, 213, 433, 394, 740,
-	744, 738, 739, 792, 793, 741, 849, 850, 851, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 824, 734,
-	0, 742, 743, 0, 830, 839, 840, 483, 796, 181,
-	196, 291, 854, 363, 253, 461, 441, 437, 720, 737,
-	229, 748, 0, 0, 761, 769, 770, 782, 784, 785,
-	786, 787, 315, 803, 804, 806, 814, 816, 819, 821,
-	826, 836, 857, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 795, 801, 302, 247, 265, 276,
-	809, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	791, 818, 298, 411, 412, 272, 843, 829, 410, 0,
-	777, 846, 747, 765, 856, 768, 771, 811, 726, 790,
-	333, 762, 0, 751, 722, 757, 723, 749, 779, 237,
-	746, 831, 794, 845, 289, 234, 728, 752, 347, 767,
-	187, 813, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 852, 293, 800, 0,
-	395, 318, 0, 0, 0, 781, 835, 788, 825, 776,
-	812, 736, 799, 847, 763, 808, 848, 279, 220, 186,
-	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 759, 805, 842, 760, 807, 232, 277,
-	239, 231, 414, 853, 834, 0, 0, 860, 844, 783,
-	0, 810, 0, 859, 721, 802, 0, 724, 727, 855,
-	838, 755, 242, 0, 0, 0, 0, 0, 0, 0,
-	780, 789, 822, 774, 0, 0, 0, 0, 0, 0,
-	0, 753, 0, 798, 0, 0, 0, 732, 725, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	778, 0, 0, 0, 735, 0, 754, 823, 0, 719,
-	260, 729, 319, 0, 827, 837, 775, 447, 841, 773,
-	772, 817, 733, 833, 766, 288, 731, 285, 182, 199,
-	0, 764, 329, 369, 375, 832, 750, 758, 223, 756,
-	373, 343, 431, 207, 250, 366, 348, 371, 797, 815,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 709, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	730, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 745, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 828, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 718, 712, 711,
-	286, 295, 820, 858, 342, 374, 213, 433, 394, 740,
-	744, 738, 739, 792, 793, 741, 849, 850, 851, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 824, 734,
-	0, 742, 743, 0, 830, 839, 840, 483, 796, 181,
-	196, 291, 854, 363, 253, 461, 441, 437, 720, 737,
-	229, 748, 0, 0, 761, 769, 770, 782, 784, 785,
-	786, 787, 315, 803, 804, 806, 814, 816, 819, 821,
-	826, 836, 857, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 795, 801, 302, 247, 265, 276,
-	809, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	791, 818, 298, 411, 412, 272, 410, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 333, 0,
-	0, 1558, 0, 564, 0, 0, 0, 237, 569, 0,
-	0, 0, 289, 234, 0, 1559, 347, 0, 187, 0,
-	386, 222, 299, 296, 417, 248, 240, 236, 221, 273,
-	305, 345, 404, 339, 576, 293, 0, 0, 395, 318,
-	0, 0, 0, 0, 0, 571, 572, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 220, 186, 330, 396,
-	252, 0, 81, 0, 0, 178, 179, 180, 607, 614,
-	615, 616, 617, 618, 608, 610, 0, 0, 211, 609,
-	218, 585, 612, 619, 620, 0, 232, 277, 239, 231,
-	414, 0, 0, 0, 0, 203, 0, 0, 0, 0,
-	0, 0, 0, 547, 561, 0, 575, 0, 0, 0,
-	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 558, 559, 698, 0, 0,
-	0, 592, 0, 560, 0, 0, 568, 621, 622, 623,
-	624, 625, 626, 627, 628, 629, 630, 631, 632, 633,
-	634, 635, 636, 637, 638, 639, 640, 641, 642, 643,
-	644, 645, 646, 647, 648, 649, 650, 651, 652, 653,
-	654, 655, 656, 657, 658, 659, 660, 661, 570, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	319, 0, 591, 0, 0, 447, 0, 0, 589, 0,
-	0, 0, 0, 288, 0, 285, 182, 199, 0, 0,
-	329, 369, 375, 0, 0, 0, 223, 0, 373, 343,
-	431, 207, 250, 366, 348, 371, 0, 0, 372, 294,
-	419, 361, 429, 448, 449, 230, 323, 438, 408, 444,
-	460, 200, 227, 337, 401, 434, 392, 316, 415, 416,
-	284, 391, 258, 185, 292, 454, 198, 381, 215, 205,
-	191, 403, 427, 212, 384, 0, 0, 462, 193, 425,
-	400, 312, 281, 282, 192, 0, 365, 235, 256, 225,
-	332, 422, 423, 224, 463, 202, 443, 195, 0, 442,
-	325, 418, 426, 313, 304, 194, 424, 311, 303, 287,
-	246, 267, 359, 297, 360, 268, 321, 320, 322, 188,
-	435, 0, 189, 0, 397, 436, 464, 208, 209, 210,
-	0, 245, 249, 255, 257, 263, 264, 271, 290, 336,
-	358, 356, 362, 0, 413, 430, 439, 446, 452, 453,
-	455, 456, 457, 458, 459, 324, 270, 393, 286, 295,
-	0, 0, 342, 374, 213, 433, 394, 598, 590, 580,
-	582, 599, 600, 577, 578, 581, 601, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	478, 479, 480, 481, 482, 0, 593, 567, 566, 0,
-	573, 574, 0, 583, 584, 586, 565, 181, 196, 291,
-	0, 363, 253, 461, 441, 437, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 184, 197, 206, 216, 228, 243, 251, 261,
-	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
-	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
-	344, 351, 352, 353, 354, 355, 357, 364, 368, 376,
-	377, 378, 379, 380, 382, 383, 387, 388, 389, 390,
-	398, 402, 420, 421, 432, 445, 450, 262, 428, 451,
-	0, 300, 0, 0, 302, 247, 265, 276, 0, 440,
-	399, 201, 370, 254, 190, 219, 204, 226, 241, 244,
-	280, 310, 317, 346, 350, 259, 238, 217, 367, 214,
-	385, 405, 406, 407, 409, 314, 233, 349, 410, 0,
-	298, 411, 412, 272, 0, 0, 0, 0, 0, 0,
-	333, 0, 0, 0, 0, 564, 0, 0, 0, 237,
-	569, 0, 0, 0, 289, 234, 0, 0, 347, 0,
-	187, 0, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 576, 293, 0, 0,
-	395, 318, 0, 0, 0, 0, 0, 571, 572, 0,
-	0, 0, 0, 0, 0, 1634, 0, 279, 220, 186,
-	330, 396, 252, 0, 81, 0, 0, 178, 179, 180,
-	607, 614, 615, 616, 617, 618, 608, 610, 0, 0,
-	211, 609, 218, 585, 612, 619, 620, 1635, 232, 277,
-	239, 231, 414, 0, 0, 0, 0, 203, 0, 0,
-	0, 0, 0, 0, 0, 547, 561, 0, 575, 0,
-	0, 0, 242, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 558, 559, 0,
-	0, 0, 0, 592, 0, 560, 0, 0, 568, 621,
-	622, 623, 624, 625, 626, 627, 628, 629, 630, 631,
+	610, 2572, 2566, 2517, 1611, 2344, 559, 2528, 2201, 2505,
+	2425, 2130, 2137, 2172, 2090, 1050, 1718, 698, 676, 2184,
+	1167, 2183, 2139, 2093, 604, 35, 3, 2371, 561, 2094,
+	1201, 2441, 605, 1848, 1593, 2250, 2091, 2376, 613, 602,
+	565, 1878, 2256, 2276, 587, 2186, 558, 2088, 603, 178,
+	1630, 1901, 178, 2080, 525, 178, 1870, 997, 1687, 2243,
+	541, 1937, 178, 2008, 1967, 1708, 1692, 1938, 1890, 1643,
+	178, 1939, 557, 150, 725, 36, 872, 1188, 34, 1635,
+	699, 678, 1634, 178, 916, 1862, 553, 1573, 1654, 1850,
+	1615, 938, 1482, 1523, 1530, 1727, 1026, 1707, 680, 1324,
+	684, 2025, 136, 1694, 1931, 541, 1760, 1231, 541, 178,
+	541, 903, 898, 1210, 1907, 570, 91, 92, 701, 1595,
+	722, 1637, 1170, 1296, 1542, 1500, 1576, 87, 1069, 1432,
+	1428, 876, 879, 1705, 880, 909, 1331, 1414, 1622, 904,
+	905, 1230, 1214, 1048, 1043, 1316, 712, 906, 1291, 1437,
+	690, 686, 1683, 688, 685, 153, 113, 119, 120, 981,
+	1340, 72, 1586, 94, 93, 548, 1139, 1616, 1228, 1135,
+	85, 2472, 1960, 81, 114, 2573, 1720, 1721, 1722, 2486,
+	180, 181, 182, 2169, 1720, 1987, 1986, 1758, 1958, 687,
+	73, 8, 121, 706, 7, 711, 6, 86, 2017, 2018,
+	1070, 115, 180, 181, 182, 1590, 1591, 1400, 920, 1489,
+	528, 1488, 1487, 1486, 692, 943, 1485, 1484, 1471, 873,
+	1476, 497, 2487, 551, 2542, 552, 1846, 2522, 1070, 549,
+	940, 2523, 2076, 2547, 951, 679, 2522, 677, 1872, 889,
+	2523, 884, 2309, 954, 955, 2055, 958, 959, 960, 961,
+	693, 514, 964, 965, 966, 967, 968, 969, 970, 971,
+	972, 973, 974, 975, 976, 977, 978, 700, 115, 895,
+	2518, 919, 726, 719, 98, 2551, 2549, 2421, 2154, 2420,
+	942, 2337, 941, 896, 2338, 1699, 2583, 894, 2515, 1800,
+	944, 945, 946, 2576, 2483, 2563, 2345, 513, 2506, 74,
+	2550, 2548, 1080, 1746, 2514, 2024, 2234, 1847, 511, 1697,
+	74, 2482, 74, 100, 101, 76, 104, 1306, 1232, 110,
+	1233, 956, 175, 1881, 74, 492, 2127, 2128, 1649, 1650,
+	1080, 2126, 115, 1916, 2524, 1101, 1915, 2016, 1995, 1917,
+	1797, 593, 1994, 2524, 1648, 674, 508, 1046, 1882, 683,
+	1592, 1016, 672, 1798, 671, 523, 2430, 1102, 1103, 1104,
+	1105, 1106, 1107, 1108, 1110, 1109, 1111, 1112, 1928, 2253,
+	520, 174, 1033, 2389, 1035, 519, 83, 714, 715, 1021,
+	1022, 1004, 1961, 1667, 1666, 888, 1005, 83, 890, 83,
+	1017, 528, 1010, 1004, 1003, 116, 1002, 1076, 1005, 2051,
+	1068, 83, 2225, 528, 2203, 529, 2223, 984, 158, 539,
+	1032, 1034, 1477, 1478, 1479, 980, 893, 1475, 990, 991,
+	543, 537, 675, 1420, 1174, 1076, 1772, 1769, 1771, 1770,
+	1968, 2196, 1390, 1728, 1696, 498, 1990, 500, 515, 2197,
+	531, 1761, 530, 504, 2575, 502, 506, 516, 507, 1045,
+	501, 1766, 512, 528, 1415, 503, 517, 518, 521, 535,
+	534, 522, 1039, 510, 532, 155, 1025, 156, 528, 986,
+	1023, 1018, 891, 1011, 1391, 2003, 1392, 173, 1777, 2543,
+	1024, 893, 963, 885, 992, 2204, 1019, 1020, 962, 2205,
+	887, 886, 178, 957, 178, 1765, 1774, 178, 1775, 1763,
+	1776, 2330, 927, 1030, 1767, 1731, 925, 1031, 897, 2180,
+	899, 83, 900, 2362, 1631, 1037, 2561, 1036, 900, 936,
+	541, 935, 934, 1309, 933, 932, 541, 541, 541, 1798,
+	931, 930, 929, 924, 937, 1764, 1113, 891, 1113, 1029,
+	2007, 2143, 893, 979, 541, 541, 877, 877, 877, 883,
+	2052, 912, 875, 2578, 1429, 1330, 1000, 911, 1006, 1007,
+	1008, 1009, 1014, 1075, 1072, 1073, 1074, 1079, 1081, 1078,
+	35, 1077, 1062, 1116, 1117, 1118, 1119, 1706, 1071, 918,
+	713, 159, 1047, 1124, 892, 1127, 529, 2004, 1752, 1425,
+	164, 1075, 1072, 1073, 1074, 1079, 1081, 1078, 529, 1077,
+	2570, 1056, 947, 2026, 2161, 983, 1071, 1617, 1618, 1989,
+	1421, 533, 2471, 1959, 2153, 1040, 1430, 2179, 2020, 606,
+	1811, 588, 590, 607, 608, 1299, 586, 589, 609, 526,
+	1175, 918, 1304, 1698, 2254, 1303, 1038, 2431, 1992, 928,
+	1302, 1962, 2520, 926, 527, 2519, 1165, 1979, 529, 892,
+	1426, 2520, 1113, 1300, 2519, 591, 592, 2402, 1329, 2462,
+	1160, 2481, 496, 529, 1851, 1853, 491, 1748, 1114, 1115,
+	1052, 1053, 178, 2010, 918, 2417, 917, 953, 2009, 2290,
+	2272, 921, 911, 2028, 2010, 918, 1912, 1877, 1120, 2009,
+	178, 922, 1799, 1838, 1176, 1585, 982, 1218, 1179, 1655,
+	1166, 151, 1178, 1419, 2002, 1147, 1182, 2001, 995, 541,
+	892, 112, 680, 178, 1112, 77, 82, 2125, 541, 1402,
+	1401, 1403, 1404, 1405, 541, 695, 1027, 82, 917, 82,
+	1571, 999, 993, 722, 989, 73, 1044, 1065, 1001, 2477,
+	1063, 82, 1064, 918, 1013, 2038, 2037, 2036, 2030, 1438,
+	2034, 2326, 2029, 2266, 2027, 1015, 1137, 1141, 1138, 2032,
+	1166, 1180, 1181, 939, 1762, 91, 92, 1422, 2031, 1543,
+	1234, 917, 918, 1153, 1154, 1155, 1156, 911, 914, 915,
+	1066, 877, 917, 2033, 2035, 908, 912, 2044, 911, 914,
+	915, 1951, 877, 1171, 1833, 2564, 908, 912, 2568, 1085,
+	107, 2569, 2385, 2567, 907, 1084, 1085, 180, 181, 182,
+	1852, 1525, 94, 1563, 1552, 1553, 1554, 1555, 1565, 1556,
+	1557, 1558, 1570, 1566, 1559, 1560, 1567, 1568, 1569, 1561,
+	1562, 1564, 1416, 1543, 1417, 1825, 1168, 1418, 1505, 1745,
+	917, 2301, 1747, 1200, 677, 921, 911, 2300, 1197, 679,
+	1735, 1177, 1506, 1507, 1504, 922, 1028, 108, 998, 1339,
+	1338, 1224, 1225, 1107, 1108, 1110, 1109, 1111, 1112, 917,
+	1328, 952, 178, 923, 1743, 1816, 1292, 927, 1526, 1439,
+	1083, 2559, 1084, 1085, 1815, 726, 1301, 925, 985, 2046,
+	2231, 1083, 2557, 1084, 1085, 2291, 152, 157, 154, 160,
+	161, 162, 163, 165, 166, 167, 168, 541, 2579, 1326,
+	2229, 1195, 169, 170, 171, 172, 1083, 1335, 1084, 1085,
+	1083, 1337, 1084, 1085, 541, 541, 1219, 541, 2581, 541,
+	541, 2493, 541, 541, 541, 541, 541, 541, 1195, 2412,
+	2534, 1495, 1497, 1498, 2532, 1547, 1083, 541, 1084, 1085,
+	1740, 178, 1373, 2536, 2537, 1195, 2360, 1183, 2141, 2142,
+	1336, 2359, 1496, 2494, 1409, 2533, 2468, 178, 1105, 1106,
+	1107, 1108, 1110, 1109, 1111, 1112, 2133, 1744, 541, 2237,
+	178, 1740, 2580, 1322, 2308, 1368, 1369, 1195, 1307, 1308,
+	1083, 1427, 1084, 1085, 1821, 1083, 178, 1084, 1085, 1332,
+	1332, 1083, 1229, 1084, 1085, 1315, 2410, 2307, 1742, 1803,
+	1804, 1805, 178, 1623, 1624, 1083, 1334, 1084, 1085, 178,
+	1407, 2134, 1083, 1370, 1084, 1085, 2455, 1408, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 541, 541, 541,
+	2170, 1083, 1298, 1084, 1085, 2159, 2136, 1312, 1376, 1377,
+	2131, 1935, 1333, 83, 1382, 1383, 1934, 1325, 2456, 1313,
+	1311, 2140, 2558, 1702, 2141, 2142, 1820, 1503, 1442, 178,
+	1434, 2132, 1814, 2143, 2459, 1446, 1397, 1448, 1449, 1450,
+	1451, 2327, 1410, 1406, 1455, 1342, 1386, 1343, 1936, 1345,
+	1347, 1395, 1394, 1351, 1353, 1355, 1357, 1359, 1469, 1440,
+	1441, 1208, 1083, 2138, 1084, 1085, 1509, 717, 1083, 1393,
+	1084, 1085, 1384, 1445, 1524, 1371, 1083, 1378, 1084, 1085,
+	1452, 1453, 1454, 1083, 1431, 1084, 1085, 1533, 541, 1501,
+	1089, 1090, 1091, 1092, 1093, 1094, 1095, 1087, 1470, 1396,
+	1375, 115, 895, 541, 541, 1508, 1374, 1510, 1511, 1512,
+	1513, 1514, 1515, 1516, 1517, 1518, 1519, 1520, 1521, 1522,
+	894, 1499, 1444, 1577, 1305, 1349, 1207, 2140, 1204, 1083,
+	2236, 1084, 1085, 178, 2458, 1544, 178, 2457, 2384, 2143,
+	1465, 1466, 1467, 2200, 1468, 624, 625, 626, 180, 181,
+	182, 2382, 2298, 2356, 1598, 2305, 2297, 1601, 1944, 1602,
+	178, 1932, 1083, 541, 1084, 1085, 1083, 1756, 1084, 1085,
+	1755, 1614, 1502, 178, 1599, 1528, 541, 1205, 180, 181,
+	182, 178, 1579, 178, 1527, 178, 178, 541, 1472, 1055,
+	541, 180, 181, 182, 1577, 1919, 1435, 1581, 1582, 1398,
+	1385, 541, 722, 1381, 1380, 722, 91, 92, 1529, 180,
+	181, 182, 1379, 1716, 1206, 1535, 1536, 180, 181, 182,
+	1042, 1714, 88, 91, 92, 90, 1607, 1868, 2574, 90,
+	1868, 2512, 1195, 89, 2438, 1580, 1633, 1578, 1583, 1584,
+	1868, 2499, 2135, 1103, 1104, 1105, 1106, 1107, 1108, 1110,
+	1109, 1111, 1112, 1579, 1868, 2497, 541, 2489, 1195, 2437,
+	178, 1868, 2473, 1709, 1710, 1711, 2335, 2470, 1713, 1715,
+	1868, 2413, 1606, 1658, 2335, 1195, 2406, 1659, 1641, 88,
+	1868, 2333, 541, 1195, 97, 692, 2405, 1195, 541, 1335,
+	89, 1664, 1335, 2343, 1335, 96, 1969, 95, 1740, 1195,
+	1739, 1879, 97, 1729, 1673, 1674, 1675, 1676, 1628, 1689,
+	1609, 1947, 1662, 96, 611, 95, 2270, 1195, 1626, 1663,
+	1695, 2151, 2150, 1879, 90, 2147, 2148, 2147, 2146, 1646,
+	541, 1668, 1524, 1669, 1670, 1671, 1672, 1524, 1524, 1908,
+	1661, 1660, 1887, 1195, 1195, 1812, 1195, 1798, 1988, 1679,
+	1680, 1681, 1682, 2265, 726, 1645, 1886, 726, 1726, 1295,
+	1973, 1965, 1966, 179, 1195, 920, 179, 1868, 1867, 179,
+	1082, 1195, 1887, 178, 542, 1332, 179, 2089, 1295, 1294,
+	178, 2540, 1195, 1690, 179, 178, 178, 2265, 1734, 178,
+	1700, 1737, 178, 1738, 2265, 1701, 1703, 179, 178, 1712,
+	1685, 1686, 1909, 1908, 2120, 178, 1749, 1240, 1239, 96,
+	1887, 1911, 1741, 1798, 1101, 1082, 2019, 1690, 1864, 542,
+	1733, 1732, 542, 179, 542, 1751, 1750, 1736, 919, 2267,
+	1753, 1754, 2476, 90, 178, 541, 1102, 1103, 1104, 1105,
+	1106, 1107, 1108, 1110, 1109, 1111, 1112, 1102, 1103, 1104,
+	1105, 1106, 1107, 1108, 1110, 1109, 1111, 1112, 1788, 1789,
+	1868, 1887, 2149, 1791, 1647, 1759, 1909, 1812, 2310, 1740,
+	1830, 1829, 1792, 1808, 1740, 1798, 1364, 1812, 1723, 1101,
+	1621, 1199, 1097, 1588, 1098, 1480, 1424, 1226, 1793, 902,
+	1812, 682, 901, 2502, 83, 2427, 1202, 1501, 1099, 1100,
+	1096, 1102, 1103, 1104, 1105, 1106, 1107, 1108, 1110, 1109,
+	1111, 1112, 1807, 1101, 1809, 1810, 2403, 1780, 2311, 2312,
+	2313, 1785, 2173, 2396, 2325, 2322, 1365, 1366, 1367, 2303,
+	1941, 2240, 2239, 1297, 1688, 1102, 1103, 1104, 1105, 1106,
+	1107, 1108, 1110, 1109, 1111, 1112, 2198, 2175, 2171, 178,
+	1974, 1684, 1892, 1895, 1896, 1897, 1893, 178, 1894, 1898,
+	1678, 1844, 2277, 2278, 1677, 1412, 541, 1327, 83, 1796,
+	1323, 2314, 1293, 708, 109, 1940, 984, 541, 2277, 2278,
+	1502, 1101, 1361, 2202, 2428, 1892, 1895, 1896, 1897, 1893,
+	1806, 1894, 1898, 1699, 2554, 2283, 2529, 2280, 2167, 2166,
+	178, 178, 2165, 1102, 1103, 1104, 1105, 1106, 1107, 1108,
+	1110, 1109, 1111, 1112, 2089, 35, 1918, 1883, 2315, 2316,
+	1579, 1952, 1941, 1781, 1903, 1822, 1824, 2111, 1473, 1362,
+	1363, 2109, 2112, 2282, 2108, 2113, 2110, 1896, 1897, 554,
+	1193, 1189, 2107, 2545, 2513, 1865, 1613, 1203, 2366, 1605,
+	2365, 2271, 2069, 2068, 1971, 1190, 595, 2454, 2081, 2083,
+	541, 1835, 1836, 2375, 2377, 1869, 178, 2084, 2261, 702,
+	1171, 1845, 2078, 178, 1423, 1578, 1855, 670, 1902, 696,
+	1603, 1604, 1192, 2145, 1191, 541, 1866, 697, 1926, 1964,
+	1945, 1922, 541, 1906, 1861, 1876, 1335, 1335, 2364, 2258,
+	1539, 541, 949, 948, 2212, 1940, 2014, 2257, 2263, 1054,
+	1981, 88, 88, 1985, 1540, 1913, 540, 1910, 90, 1695,
+	1929, 1930, 89, 89, 178, 178, 178, 178, 178, 1920,
+	1980, 1923, 1193, 1189, 116, 1623, 1624, 2163, 97, 90,
+	1784, 178, 178, 1933, 2469, 2423, 178, 1190, 1943, 96,
+	2144, 95, 1949, 1900, 1610, 704, 705, 1942, 1773, 178,
+	90, 724, 2244, 2067, 874, 1802, 881, 1953, 1954, 1955,
+	1948, 2066, 1186, 1187, 1192, 1983, 1191, 1524, 2022, 97,
+	95, 2446, 2445, 2440, 2383, 2381, 1315, 2380, 2373, 2323,
+	96, 96, 95, 2262, 2260, 1975, 1976, 2176, 1724, 541,
+	1984, 2043, 1310, 703, 2372, 1982, 179, 97, 179, 2251,
+	1879, 179, 1864, 1831, 2059, 541, 2556, 2555, 96, 1220,
+	1212, 102, 103, 2556, 2460, 178, 2296, 694, 99, 541,
+	84, 1, 619, 2005, 542, 2531, 509, 1589, 541, 1169,
+	542, 542, 542, 2021, 2012, 541, 541, 2013, 178, 178,
+	178, 178, 178, 2059, 524, 2527, 2086, 1399, 542, 542,
+	178, 2101, 1389, 2346, 2071, 178, 178, 2023, 178, 684,
+	2040, 178, 178, 178, 2424, 2095, 2072, 2039, 1970, 1730,
+	2321, 1693, 2092, 910, 141, 2058, 1656, 2092, 1657, 1182,
+	2508, 106, 870, 105, 913, 1012, 2061, 2062, 2063, 2060,
+	2119, 1725, 2336, 2073, 1927, 1665, 2160, 2070, 1246, 1244,
+	1245, 1243, 1248, 178, 1247, 1242, 1832, 1474, 538, 2121,
+	686, 1899, 2122, 685, 176, 1235, 541, 2114, 2103, 2104,
+	2102, 2106, 1213, 2105, 35, 541, 2162, 950, 499, 2182,
+	178, 2123, 2152, 2118, 91, 92, 1757, 1434, 505, 1125,
+	178, 2065, 1914, 723, 716, 2129, 2097, 2255, 2077, 2079,
+	1871, 2082, 2075, 2453, 2374, 178, 2500, 2156, 178, 2178,
+	2155, 1924, 1209, 1823, 1132, 2064, 1541, 1638, 1597, 2213,
+	1494, 2190, 2521, 2485, 2484, 2361, 179, 2215, 2053, 2054,
+	2189, 563, 2157, 2158, 562, 560, 1857, 1880, 1088, 1695,
+	2181, 614, 2177, 1849, 179, 1221, 2100, 2193, 1891, 1889,
+	1888, 1782, 1642, 2279, 2275, 1636, 1863, 571, 564, 556,
+	612, 2293, 2188, 542, 1991, 2199, 178, 179, 2208, 2207,
+	2210, 2211, 542, 1993, 1925, 2195, 1067, 1185, 542, 550,
+	882, 1538, 2429, 2415, 1801, 2233, 1184, 1550, 1551, 2221,
+	2168, 1717, 2214, 61, 39, 545, 2541, 1058, 710, 33,
+	32, 31, 30, 29, 28, 23, 22, 21, 20, 19,
+	25, 18, 2249, 17, 16, 111, 48, 45, 43, 118,
+	117, 178, 2245, 2246, 46, 42, 987, 40, 27, 26,
+	15, 14, 2252, 2259, 13, 12, 11, 10, 2264, 2299,
+	9, 5, 4, 2274, 178, 1061, 2284, 24, 2, 2281,
+	2218, 2219, 1957, 2220, 1719, 0, 2222, 0, 2224, 0,
+	2226, 0, 178, 2288, 2289, 0, 178, 178, 178, 1086,
+	2286, 0, 2287, 2190, 0, 0, 541, 541, 2294, 2295,
+	2328, 2329, 2189, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1133, 0, 0,
+	0, 0, 0, 541, 541, 541, 541, 0, 0, 0,
+	0, 0, 0, 2331, 0, 0, 1041, 0, 0, 0,
+	0, 0, 724, 724, 724, 2342, 179, 0, 0, 0,
+	2340, 2341, 0, 0, 0, 554, 0, 2304, 0, 2306,
+	1057, 1059, 0, 0, 0, 0, 0, 0, 178, 0,
+	0, 2355, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 542, 0, 2351, 0, 0, 0, 0, 0, 0,
+	0, 541, 0, 541, 0, 0, 0, 0, 542, 542,
+	0, 542, 2369, 542, 542, 2370, 542, 542, 542, 542,
+	542, 542, 2378, 2394, 35, 2392, 2390, 2388, 2379, 0,
+	2095, 542, 2386, 0, 2095, 179, 2092, 0, 0, 0,
+	2352, 0, 0, 0, 0, 0, 1163, 1211, 0, 0,
+	0, 179, 0, 0, 0, 2401, 541, 0, 2398, 2399,
+	2411, 0, 542, 0, 179, 0, 0, 0, 0, 0,
+	2409, 0, 0, 0, 2408, 0, 541, 0, 2407, 0,
+	179, 0, 0, 0, 0, 2419, 2418, 0, 0, 0,
+	0, 2426, 0, 2414, 541, 0, 179, 0, 541, 541,
+	0, 0, 0, 179, 0, 0, 0, 0, 0, 0,
+	2439, 0, 179, 179, 179, 179, 179, 179, 179, 179,
+	179, 542, 542, 542, 2452, 0, 0, 541, 2450, 2447,
+	2448, 2464, 0, 2449, 541, 1216, 0, 0, 2467, 0,
+	0, 0, 2463, 2461, 724, 0, 0, 2465, 0, 0,
+	1236, 0, 2095, 179, 0, 0, 0, 0, 541, 178,
+	0, 0, 2478, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 35, 0,
+	2475, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2488, 0, 541, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2495, 0, 541, 541, 0,
+	0, 0, 542, 2498, 0, 0, 2503, 2507, 0, 0,
+	541, 0, 2426, 2509, 35, 0, 2501, 542, 542, 0,
+	0, 2092, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2538, 2530, 2535, 0, 0, 0, 0, 0, 0,
+	0, 2544, 2546, 0, 0, 0, 0, 179, 0, 0,
+	179, 2552, 0, 0, 0, 2553, 0, 0, 0, 0,
+	0, 541, 0, 0, 0, 2560, 0, 0, 0, 0,
+	2562, 0, 0, 541, 179, 0, 0, 542, 0, 2571,
+	0, 0, 0, 0, 0, 0, 0, 179, 2577, 0,
+	542, 0, 0, 0, 0, 179, 2582, 179, 0, 179,
+	179, 542, 0, 0, 542, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 542, 0, 0, 0, 0,
+	0, 0, 0, 874, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1436, 0, 0, 1163, 0, 0, 0,
+	1341, 1341, 0, 1341, 0, 1341, 1341, 0, 1350, 1341,
+	1341, 1341, 1341, 1341, 0, 0, 0, 0, 0, 0,
+	0, 1163, 1163, 874, 0, 0, 0, 0, 0, 0,
+	542, 0, 0, 0, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1411, 0, 542, 0, 0, 0,
+	0, 0, 542, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1490, 1491, 1492, 1493,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 542, 0, 0, 0, 0, 0,
+	0, 0, 0, 724, 724, 724, 1531, 1532, 0, 0,
+	0, 0, 0, 0, 1537, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1572,
+	0, 0, 0, 0, 0, 0, 0, 179, 0, 0,
+	0, 0, 0, 0, 179, 0, 0, 0, 0, 179,
+	179, 0, 0, 179, 0, 0, 179, 554, 0, 0,
+	0, 0, 179, 0, 0, 0, 0, 0, 0, 179,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1619, 1620, 0, 1534, 0, 0, 0, 179, 542,
+	0, 1163, 0, 0, 0, 0, 0, 0, 0, 1548,
+	1549, 0, 174, 0, 724, 0, 0, 0, 1653, 0,
+	0, 0, 0, 0, 0, 0, 0, 1194, 0, 0,
+	0, 0, 0, 0, 0, 0, 116, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 158,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1612,
+	0, 0, 0, 0, 0, 0, 0, 1691, 0, 0,
+	0, 0, 1216, 0, 0, 724, 0, 0, 0, 0,
+	0, 1921, 0, 724, 0, 0, 724, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 155, 874, 156, 0,
+	0, 0, 0, 179, 0, 0, 0, 0, 173, 0,
+	0, 179, 0, 0, 0, 0, 0, 0, 0, 0,
+	542, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 542, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 881, 0, 179, 179, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 874, 0,
+	0, 0, 0, 0, 881, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 159, 0, 542, 0, 0, 0, 0, 0,
+	179, 164, 0, 0, 0, 0, 874, 179, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 542,
+	0, 0, 0, 0, 0, 0, 542, 0, 0, 0,
+	0, 0, 0, 0, 0, 542, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 596, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 179, 179,
+	179, 179, 179, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 179, 179, 0, 0, 0,
+	179, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 179, 0, 177, 0, 1826, 495, 0,
+	0, 536, 0, 0, 0, 0, 0, 0, 495, 0,
+	0, 1795, 151, 0, 0, 0, 495, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 691,
+	0, 0, 0, 542, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 709, 0, 709, 0, 542,
+	0, 0, 174, 0, 0, 495, 0, 0, 0, 179,
+	0, 0, 0, 542, 0, 0, 0, 0, 0, 0,
+	0, 0, 542, 0, 0, 1211, 116, 0, 138, 542,
+	542, 0, 179, 179, 179, 179, 179, 0, 0, 158,
+	0, 0, 0, 0, 179, 0, 0, 0, 0, 179,
+	179, 0, 179, 0, 0, 179, 179, 179, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	148, 0, 724, 0, 0, 137, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1858, 0, 0, 0, 155, 179, 156, 0,
+	0, 0, 0, 1873, 125, 126, 147, 146, 173, 0,
+	542, 0, 0, 0, 0, 0, 0, 0, 0, 542,
+	0, 0, 0, 0, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 179, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 179,
+	0, 0, 179, 0, 0, 0, 0, 152, 157, 154,
+	160, 161, 162, 163, 165, 166, 167, 168, 0, 0,
+	0, 0, 0, 169, 170, 171, 172, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1946, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 142, 123, 149, 130, 122, 0, 143, 144, 0,
+	179, 1612, 159, 0, 0, 0, 0, 0, 1972, 0,
+	0, 164, 131, 0, 2041, 2042, 0, 1977, 0, 2045,
+	0, 0, 0, 2047, 2048, 2049, 134, 132, 127, 128,
+	129, 133, 0, 0, 0, 0, 124, 0, 0, 0,
+	0, 0, 0, 0, 0, 135, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 179, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2087, 0, 0, 0, 0, 179, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 179, 0, 0, 0,
+	179, 179, 179, 0, 0, 0, 0, 0, 0, 0,
+	542, 542, 0, 0, 0, 724, 0, 0, 0, 0,
+	0, 0, 151, 1196, 1198, 0, 0, 0, 0, 0,
+	0, 1341, 0, 0, 0, 0, 0, 542, 542, 542,
+	542, 0, 0, 0, 0, 2074, 0, 0, 0, 0,
+	0, 0, 0, 0, 724, 2174, 0, 0, 1163, 0,
+	0, 2099, 1341, 1163, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 495, 0,
+	495, 0, 179, 495, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 145, 0, 0,
+	1172, 0, 621, 75, 0, 542, 0, 542, 0, 0,
+	139, 0, 0, 140, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2235, 0, 874, 0, 0, 1163, 0, 0, 0, 0,
+	0, 1612, 494, 0, 0, 0, 0, 0, 0, 0,
+	542, 0, 544, 0, 0, 0, 0, 0, 0, 0,
+	673, 0, 0, 0, 0, 0, 681, 0, 75, 0,
+	542, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	554, 0, 0, 0, 0, 0, 681, 0, 542, 0,
+	0, 0, 542, 542, 0, 0, 1164, 0, 0, 878,
+	0, 0, 0, 0, 0, 0, 0, 152, 157, 154,
+	160, 161, 162, 163, 165, 166, 167, 168, 0, 0,
+	0, 542, 0, 169, 170, 171, 172, 0, 542, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 495, 0,
+	0, 0, 542, 179, 0, 2324, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 691, 0, 0, 0,
+	0, 2339, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 542, 0, 495,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 542, 542, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2353, 542, 2354, 0, 0, 0, 0,
+	2357, 2358, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1612, 1612, 0, 0, 0, 0, 0, 0,
+	2387, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2395, 0, 0, 2397, 542, 0, 0, 0, 2347,
+	2348, 2349, 2350, 0, 0, 0, 2400, 542, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2404, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 554, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1163, 0, 0, 0, 0, 2391, 0, 2393,
+	0, 0, 0, 0, 0, 0, 0, 0, 495, 0,
+	0, 0, 0, 0, 0, 0, 2451, 554, 0, 0,
+	0, 0, 0, 0, 1545, 0, 0, 0, 1546, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1612, 0, 1196, 1587, 1164, 0, 0, 0,
+	554, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 724, 0, 0, 0, 0, 0, 0, 0,
+	0, 1164, 1164, 0, 554, 1608, 0, 495, 0, 0,
+	2443, 0, 0, 0, 2443, 2443, 0, 0, 0, 0,
+	0, 0, 0, 1387, 1263, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 495, 0, 0, 0,
+	0, 0, 0, 1612, 0, 0, 0, 0, 0, 0,
+	1612, 0, 1433, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 988, 0, 994, 0, 2539, 996, 495, 0,
+	0, 0, 0, 0, 1612, 495, 0, 0, 0, 0,
+	0, 0, 0, 0, 1456, 1457, 495, 495, 495, 495,
+	495, 495, 495, 0, 0, 0, 0, 0, 1049, 1049,
+	1049, 0, 0, 0, 0, 0, 0, 1163, 0, 2496,
+	0, 0, 0, 0, 0, 0, 0, 0, 75, 0,
+	0, 0, 0, 724, 724, 495, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2525, 0, 0, 0,
+	0, 0, 0, 0, 0, 681, 1121, 1122, 1123, 0,
+	1126, 1251, 1128, 1129, 1130, 1131, 0, 1134, 1136, 1136,
+	0, 1136, 1140, 1140, 1142, 1143, 1144, 1145, 1146, 0,
+	1148, 1149, 1150, 1151, 1152, 0, 0, 0, 709, 1140,
+	1140, 1140, 1140, 0, 0, 709, 709, 1612, 0, 0,
+	0, 1164, 0, 0, 0, 0, 0, 0, 0, 2565,
+	0, 0, 0, 709, 1433, 709, 709, 709, 709, 709,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1387,
+	0, 1264, 495, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 709, 0, 0, 0, 1173, 0, 0, 0,
+	681, 0, 0, 0, 681, 0, 691, 0, 0, 0,
+	681, 0, 0, 0, 0, 0, 0, 0, 0, 495,
+	0, 0, 0, 0, 0, 1433, 0, 495, 0, 495,
+	0, 495, 1644, 1223, 0, 1277, 1280, 1281, 1282, 1283,
+	1284, 1285, 0, 1286, 1287, 1288, 1289, 1290, 1265, 1266,
+	1267, 1268, 1249, 1250, 1278, 0, 1252, 0, 1253, 1254,
+	1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262, 1269, 1270,
+	1271, 1272, 1273, 1274, 1275, 1276, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1813, 0, 0,
+	0, 1817, 0, 1818, 1819, 0, 0, 0, 0, 0,
+	0, 0, 1827, 0, 0, 1828, 495, 0, 174, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1963,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1834, 116, 0, 138, 0, 0, 0, 1839, 1840,
+	1841, 1842, 1843, 0, 1608, 158, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1856, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1279, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 137, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1241, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 155, 0, 156, 0, 0, 0, 0, 0,
+	1318, 1319, 147, 146, 173, 0, 0, 0, 0, 495,
+	0, 0, 0, 0, 0, 0, 495, 0, 0, 0,
+	0, 495, 495, 0, 0, 495, 0, 0, 1786, 0,
+	0, 0, 0, 0, 495, 0, 0, 0, 0, 0,
+	0, 495, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1372, 0, 0, 0, 0, 0, 0, 0, 0,
+	495, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1413, 0, 0, 0, 0, 0, 0, 142, 1320, 149,
+	0, 1317, 0, 143, 144, 0, 0, 0, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 0,
+	0, 0, 1443, 0, 0, 0, 0, 0, 0, 1447,
+	0, 0, 0, 0, 0, 709, 0, 0, 0, 0,
+	1458, 1459, 1460, 1461, 1462, 1463, 1464, 0, 0, 1049,
+	1049, 1049, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 709, 709, 0, 0, 0, 0, 0, 0, 1483,
+	2050, 0, 1433, 2056, 2057, 495, 0, 0, 0, 0,
+	0, 0, 0, 1387, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 151, 0,
+	0, 0, 2098, 0, 0, 0, 495, 495, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2116,
+	2117, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1600, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 495, 145, 0, 0, 0, 0, 0, 1956,
+	0, 0, 0, 0, 0, 0, 139, 0, 0, 140,
+	0, 0, 0, 1625, 0, 0, 0, 0, 0, 0,
+	0, 1629, 0, 1632, 0, 0, 1483, 1639, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	495, 495, 495, 495, 495, 0, 0, 0, 0, 0,
+	0, 0, 0, 2217, 0, 0, 0, 495, 495, 0,
+	0, 0, 495, 0, 0, 0, 0, 2227, 2228, 2230,
+	2232, 0, 0, 0, 0, 495, 0, 2238, 0, 0,
+	0, 0, 0, 0, 0, 0, 2242, 0, 0, 709,
+	1704, 0, 2247, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 152, 157, 154, 160, 161, 162, 163,
+	165, 166, 167, 168, 709, 0, 0, 0, 174, 169,
+	170, 171, 172, 0, 2268, 2269, 0, 0, 2273, 1314,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 495, 116, 0, 138, 0, 2285, 0, 0, 0,
+	0, 0, 0, 0, 0, 158, 0, 0, 1164, 0,
+	0, 0, 0, 1164, 495, 495, 495, 495, 495, 0,
+	0, 0, 0, 0, 0, 0, 2115, 0, 0, 0,
+	0, 495, 1387, 0, 495, 0, 148, 495, 2124, 1433,
+	0, 137, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1483, 0, 0, 0, 0, 0, 0,
+	1768, 2334, 155, 0, 156, 1778, 1779, 0, 0, 1783,
+	1318, 1319, 147, 146, 173, 0, 0, 0, 1787, 495,
+	0, 0, 0, 0, 0, 1790, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1164, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 495, 0, 0, 0,
+	0, 0, 0, 0, 1794, 0, 495, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 495, 0, 0, 495, 74, 37, 38, 76, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 80, 0, 0, 0, 41,
+	67, 68, 0, 65, 69, 0, 0, 142, 1320, 149,
+	0, 1317, 66, 143, 144, 0, 0, 0, 159, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 0,
+	0, 0, 495, 0, 0, 0, 0, 0, 0, 0,
+	0, 54, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 83, 0, 0, 0, 0, 2422, 0, 0,
+	1837, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2432, 2433, 2434, 0, 2435, 2436, 1854, 0, 0,
+	0, 2442, 0, 0, 0, 0, 0, 495, 0, 0,
+	0, 0, 0, 681, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	495, 0, 0, 1884, 1885, 0, 0, 0, 2466, 0,
+	0, 1905, 1904, 0, 0, 0, 0, 0, 495, 0,
+	0, 0, 495, 495, 495, 0, 0, 0, 151, 0,
+	0, 0, 0, 0, 0, 0, 0, 2480, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2490,
+	0, 0, 0, 0, 0, 2491, 2492, 0, 0, 0,
+	0, 0, 44, 47, 50, 49, 52, 0, 64, 0,
+	0, 70, 0, 0, 0, 0, 1950, 0, 0, 0,
+	0, 0, 2504, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2516, 53, 79, 78, 0, 0, 62, 63,
+	51, 0, 0, 145, 1387, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1978, 0, 139, 0, 0, 140,
+	0, 0, 1164, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1996, 1997, 1998, 1999, 2000, 0,
+	0, 71, 55, 56, 0, 57, 58, 59, 60, 0,
+	0, 1483, 2006, 0, 0, 0, 2011, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2015,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 152, 157, 154, 160, 161, 162, 163,
+	165, 166, 167, 168, 0, 0, 0, 1639, 0, 169,
+	170, 171, 172, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2096, 0, 75, 0, 0,
+	1639, 1639, 1639, 1639, 1639, 0, 0, 0, 77, 0,
+	0, 0, 0, 0, 0, 0, 0, 1904, 0, 0,
+	1639, 0, 82, 1639, 0, 495, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1164, 0, 0,
+	0, 0, 75, 2164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2185, 0, 0, 0, 2187, 0, 0, 0, 0, 0,
+	2194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2206, 0, 0, 2209, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2216, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2241, 0,
+	0, 0, 0, 0, 0, 0, 2248, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 83, 0,
+	0, 0, 0, 1639, 615, 622, 623, 624, 625, 626,
+	616, 618, 0, 0, 0, 617, 2292, 0, 620, 627,
+	628, 0, 0, 0, 2302, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2317, 0, 0, 0, 2318, 2319, 2320, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2191, 2192, 2332, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 629, 630, 631, 632, 633, 634, 635,
+	636, 637, 638, 639, 640, 641, 642, 643, 644, 645,
+	646, 647, 648, 649, 650, 651, 652, 653, 654, 655,
+	656, 657, 658, 659, 660, 661, 662, 663, 664, 665,
+	666, 667, 668, 669, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2363, 0, 2367, 2368,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2096, 0, 75, 0, 2096, 0, 852, 838, 417, 0,
+	786, 855, 756, 774, 865, 777, 780, 820, 735, 799,
+	337, 771, 0, 760, 731, 766, 732, 758, 788, 240,
+	755, 840, 803, 854, 292, 237, 737, 761, 351, 776,
+	189, 822, 393, 225, 302, 299, 424, 251, 243, 239,
+	223, 276, 308, 349, 411, 343, 861, 296, 809, 0,
+	402, 322, 0, 2416, 0, 790, 844, 797, 834, 785,
+	821, 745, 808, 856, 772, 817, 857, 282, 222, 188,
+	334, 403, 255, 0, 0, 0, 0, 180, 181, 182,
+	0, 2510, 0, 2511, 0, 0, 0, 0, 0, 0,
+	213, 0, 220, 768, 814, 851, 769, 816, 235, 280,
+	242, 234, 421, 862, 843, 0, 0, 205, 853, 792,
+	0, 819, 2096, 868, 730, 811, 0, 733, 736, 864,
+	847, 764, 245, 0, 0, 1145, 0, 0, 0, 0,
+	789, 798, 831, 783, 0, 606, 0, 0, 0, 607,
+	608, 762, 0, 807, 609, 0, 75, 741, 734, 2479,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	787, 0, 75, 0, 744, 0, 763, 832, 0, 728,
+	263, 738, 323, 0, 836, 846, 784, 454, 850, 782,
+	781, 826, 742, 842, 775, 291, 740, 288, 184, 201,
+	0, 773, 333, 373, 380, 841, 759, 767, 226, 765,
+	378, 347, 438, 209, 253, 370, 352, 376, 806, 824,
+	377, 297, 426, 365, 436, 455, 456, 233, 327, 445,
+	415, 451, 467, 202, 230, 341, 408, 441, 399, 320,
+	422, 423, 287, 398, 261, 187, 295, 461, 200, 386,
+	217, 207, 313, 374, 193, 410, 434, 214, 389, 0,
+	0, 469, 195, 432, 407, 316, 284, 285, 194, 0,
+	369, 238, 259, 228, 336, 429, 430, 227, 470, 204,
+	450, 197, 1051, 449, 329, 425, 433, 317, 307, 196,
+	431, 315, 306, 290, 249, 270, 363, 300, 364, 271,
+	325, 324, 326, 190, 442, 0, 191, 0, 404, 443,
+	471, 210, 211, 212, 754, 248, 252, 258, 260, 266,
+	267, 274, 293, 340, 362, 360, 366, 837, 420, 437,
+	446, 453, 459, 460, 462, 463, 464, 465, 466, 328,
+	273, 400, 289, 298, 829, 867, 346, 379, 215, 440,
+	401, 749, 753, 747, 748, 801, 802, 750, 858, 859,
+	860, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 0,
+	833, 743, 0, 751, 752, 0, 839, 848, 849, 490,
+	805, 183, 198, 294, 863, 367, 256, 468, 448, 444,
+	729, 746, 232, 757, 0, 0, 770, 778, 779, 791,
+	793, 794, 795, 796, 319, 812, 813, 815, 823, 825,
+	828, 830, 835, 845, 866, 185, 186, 199, 208, 218,
+	231, 246, 254, 264, 269, 272, 277, 278, 281, 286,
+	304, 309, 310, 311, 312, 330, 331, 332, 335, 338,
+	339, 342, 344, 345, 348, 355, 356, 357, 358, 359,
+	361, 368, 372, 381, 382, 383, 384, 385, 387, 388,
+	394, 395, 396, 397, 405, 409, 427, 428, 439, 452,
+	457, 224, 390, 392, 265, 435, 458, 0, 303, 804,
+	810, 305, 250, 268, 279, 818, 447, 406, 203, 375,
+	257, 192, 221, 206, 229, 244, 247, 283, 314, 321,
+	350, 354, 262, 241, 219, 371, 216, 391, 412, 413,
+	414, 416, 318, 236, 353, 800, 827, 301, 418, 419,
+	275, 852, 838, 417, 0, 786, 855, 756, 774, 865,
+	777, 780, 820, 735, 799, 337, 771, 0, 760, 731,
+	766, 732, 758, 788, 240, 755, 840, 803, 854, 292,
+	237, 737, 761, 351, 776, 189, 822, 393, 225, 302,
+	299, 424, 251, 243, 239, 223, 276, 308, 349, 411,
+	343, 861, 296, 809, 0, 402, 322, 0, 0, 0,
+	790, 844, 797, 834, 785, 821, 745, 808, 856, 772,
+	817, 857, 282, 222, 188, 334, 403, 255, 0, 0,
+	0, 0, 180, 181, 182, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 0, 220, 768, 814,
+	851, 769, 816, 235, 280, 242, 234, 421, 862, 843,
+	0, 0, 205, 853, 792, 0, 819, 0, 868, 730,
+	811, 0, 733, 736, 864, 847, 764, 245, 0, 0,
+	0, 0, 0, 0, 0, 789, 798, 831, 783, 0,
+	0, 0, 0, 0, 2125, 0, 762, 0, 807, 0,
+	0, 0, 741, 734, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 787, 0, 0, 0, 744,
+	0, 763, 832, 0, 728, 263, 738, 323, 0, 836,
+	846, 784, 454, 850, 782, 781, 826, 742, 842, 775,
+	291, 740, 288, 184, 201, 0, 773, 333, 373, 380,
+	841, 759, 767, 226, 765, 378, 347, 438, 209, 253,
+	370, 352, 376, 806, 824, 377, 297, 426, 365, 436,
+	455, 456, 233, 327, 445, 415, 451, 467, 202, 230,
+	341, 408, 441, 399, 320, 422, 423, 287, 398, 261,
+	187, 295, 461, 200, 386, 217, 207, 313, 374, 193,
+	410, 434, 214, 389, 0, 0, 469, 195, 432, 407,
+	316, 284, 285, 194, 0, 369, 238, 259, 228, 336,
+	429, 430, 227, 470, 204, 450, 197, 1051, 449, 329,
+	425, 433, 317, 307, 196, 431, 315, 306, 290, 249,
+	270, 363, 300, 364, 271, 325, 324, 326, 190, 442,
+	0, 191, 0, 404, 443, 471, 210, 211, 212, 754,
+	248, 252, 258, 260, 266, 267, 274, 293, 340, 362,
+	360, 366, 837, 420, 437, 446, 453, 459, 460, 462,
+	463, 464, 465, 466, 328, 273, 400, 289, 298, 829,
+	867, 346, 379, 215, 440, 401, 749, 753, 747, 748,
+	801, 802, 750, 858, 859, 860, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 0, 833, 743, 0, 751, 752,
+	0, 839, 848, 849, 490, 805, 183, 198, 294, 863,
+	367, 256, 468, 448, 444, 729, 746, 232, 757, 0,
+	0, 770, 778, 779, 791, 793, 794, 795, 796, 319,
+	812, 813, 815, 823, 825, 828, 830, 835, 845, 866,
+	185, 186, 199, 208, 218, 231, 246, 254, 264, 269,
+	272, 277, 278, 281, 286, 304, 309, 310, 311, 312,
+	330, 331, 332, 335, 338, 339, 342, 344, 345, 348,
+	355, 356, 357, 358, 359, 361, 368, 372, 381, 382,
+	383, 384, 385, 387, 388, 394, 395, 396, 397, 405,
+	409, 427, 428, 439, 452, 457, 224, 390, 392, 265,
+	435, 458, 0, 303, 804, 810, 305, 250, 268, 279,
+	818, 447, 406, 203, 375, 257, 192, 221, 206, 229,
+	244, 247, 283, 314, 321, 350, 354, 262, 241, 219,
+	371, 216, 391, 412, 413, 414, 416, 318, 236, 353,
+	800, 827, 301, 418, 419, 275, 852, 838, 417, 0,
+	786, 855, 756, 774, 865, 777, 780, 820, 735, 799,
+	337, 771, 0, 760, 731, 766, 732, 758, 788, 240,
+	755, 840, 803, 854, 292, 237, 737, 761, 351, 776,
+	189, 822, 393, 225, 302, 299, 424, 251, 243, 239,
+	223, 276, 308, 349, 411, 343, 861, 296, 809, 0,
+	402, 322, 0, 0, 0, 790, 844, 797, 834, 785,
+	821, 745, 808, 856, 772, 817, 857, 282, 222, 188,
+	334, 403, 255, 0, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 220, 768, 814, 851, 769, 816, 235, 280,
+	242, 234, 421, 862, 843, 0, 0, 205, 853, 792,
+	0, 819, 0, 868, 730, 811, 0, 733, 736, 864,
+	847, 764, 245, 0, 0, 0, 0, 0, 0, 0,
+	789, 798, 831, 783, 0, 0, 0, 0, 0, 2085,
+	0, 762, 0, 807, 0, 0, 0, 741, 734, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	787, 0, 0, 0, 744, 0, 763, 832, 0, 728,
+	263, 738, 323, 0, 836, 846, 784, 454, 850, 782,
+	781, 826, 742, 842, 775, 291, 740, 288, 184, 201,
+	0, 773, 333, 373, 380, 841, 759, 767, 226, 765,
+	378, 347, 438, 209, 253, 370, 352, 376, 806, 824,
+	377, 297, 426, 365, 436, 455, 456, 233, 327, 445,
+	415, 451, 467, 202, 230, 341, 408, 441, 399, 320,
+	422, 423, 287, 398, 261, 187, 295, 461, 200, 386,
+	217, 207, 313, 374, 193, 410, 434, 214, 389, 0,
+	0, 469, 195, 432, 407, 316, 284, 285, 194, 0,
+	369, 238, 259, 228, 336, 429, 430, 227, 470, 204,
+	450, 197, 1051, 449, 329, 425, 433, 317, 307, 196,
+	431, 315, 306, 290, 249, 270, 363, 300, 364, 271,
+	325, 324, 326, 190, 442, 0, 191, 0, 404, 443,
+	471, 210, 211, 212, 754, 248, 252, 258, 260, 266,
+	267, 274, 293, 340, 362, 360, 366, 837, 420, 437,
+	446, 453, 459, 460, 462, 463, 464, 465, 466, 328,
+	273, 400, 289, 298, 829, 867, 346, 379, 215, 440,
+	401, 749, 753, 747, 748, 801, 802, 750, 858, 859,
+	860, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 0,
+	833, 743, 0, 751, 752, 0, 839, 848, 849, 490,
+	805, 183, 198, 294, 863, 367, 256, 468, 448, 444,
+	729, 746, 232, 757, 0, 0, 770, 778, 779, 791,
+	793, 794, 795, 796, 319, 812, 813, 815, 823, 825,
+	828, 830, 835, 845, 866, 185, 186, 199, 208, 218,
+	231, 246, 254, 264, 269, 272, 277, 278, 281, 286,
+	304, 309, 310, 311, 312, 330, 331, 332, 335, 338,
+	339, 342, 344, 345, 348, 355, 356, 357, 358, 359,
+	361, 368, 372, 381, 382, 383, 384, 385, 387, 388,
+	394, 395, 396, 397, 405, 409, 427, 428, 439, 452,
+	457, 224, 390, 392, 265, 435, 458, 0, 303, 804,
+	810, 305, 250, 268, 279, 818, 447, 406, 203, 375,
+	257, 192, 221, 206, 229, 244, 247, 283, 314, 321,
+	350, 354, 262, 241, 219, 371, 216, 391, 412, 413,
+	414, 416, 318, 236, 353, 800, 827, 301, 418, 419,
+	275, 852, 838, 417, 0, 786, 855, 756, 774, 865,
+	777, 780, 820, 735, 799, 337, 771, 0, 760, 731,
+	766, 732, 758, 788, 240, 755, 840, 803, 854, 292,
+	237, 737, 761, 351, 776, 189, 822, 393, 225, 302,
+	299, 424, 251, 243, 239, 223, 276, 308, 349, 411,
+	343, 861, 296, 809, 0, 402, 322, 0, 0, 0,
+	790, 844, 797, 834, 785, 821, 745, 808, 856, 772,
+	817, 857, 282, 222, 188, 334, 403, 255, 0, 0,
+	0, 0, 180, 181, 182, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 0, 220, 768, 814,
+	851, 769, 816, 235, 280, 242, 234, 421, 862, 843,
+	0, 0, 205, 853, 792, 0, 819, 0, 868, 730,
+	811, 0, 733, 736, 864, 847, 764, 245, 0, 0,
+	0, 0, 0, 0, 0, 789, 798, 831, 783, 0,
+	0, 0, 0, 0, 1627, 0, 762, 0, 807, 0,
+	0, 0, 741, 734, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 787, 0, 0, 0, 744,
+	0, 763, 832, 0, 728, 263, 738, 323, 0, 836,
+	846, 784, 454, 850, 782, 781, 826, 742, 842, 775,
+	291, 740, 288, 184, 201, 0, 773, 333, 373, 380,
+	841, 759, 767, 226, 765, 378, 347, 438, 209, 253,
+	370, 352, 376, 806, 824, 377, 297, 426, 365, 436,
+	455, 456, 233, 327, 445, 415, 451, 467, 202, 230,
+	341, 408, 441, 399, 320, 422, 423, 287, 398, 261,
+	187, 295, 461, 200, 386, 217, 207, 313, 374, 193,
+	410, 434, 214, 389, 0, 0, 469, 195, 432, 407,
+	316, 284, 285, 194, 0, 369, 238, 259, 228, 336,
+	429, 430, 227, 470, 204, 450, 197, 1051, 449, 329,
+	425, 433, 317, 307, 196, 431, 315, 306, 290, 249,
+	270, 363, 300, 364, 271, 325, 324, 326, 190, 442,
+	0, 191, 0, 404, 443, 471, 210, 211, 212, 754,
+	248, 252, 258, 260, 266, 267, 274, 293, 340, 362,
+	360, 366, 837, 420, 437, 446, 453, 459, 460, 462,
+	463, 464, 465, 466, 328, 273, 400, 289, 298, 829,
+	867, 346, 379, 215, 440, 401, 749, 753, 747, 748,
+	801, 802, 750, 858, 859, 860, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 0, 833, 743, 0, 751, 752,
+	0, 839, 848, 849, 490, 805, 183, 198, 294, 863,
+	367, 256, 468, 448, 444, 729, 746, 232, 757, 0,
+	0, 770, 778, 779, 791, 793, 794, 795, 796, 319,
+	812, 813, 815, 823, 825, 828, 830, 835, 845, 866,
+	185, 186, 199, 208, 218, 231, 246, 254, 264, 269,
+	272, 277, 278, 281, 286, 304, 309, 310, 311, 312,
+	330, 331, 332, 335, 338, 339, 342, 344, 345, 348,
+	355, 356, 357, 358, 359, 361, 368, 372, 381, 382,
+	383, 384, 385, 387, 388, 394, 395, 396, 397, 405,
+	409, 427, 428, 439, 452, 457, 224, 390, 392, 265,
+	435, 458, 0, 303, 804, 810, 305, 250, 268, 279,
+	818, 447, 406, 203, 375, 257, 192, 221, 206, 229,
+	244, 247, 283, 314, 321, 350, 354, 262, 241, 219,
+	371, 216, 391, 412, 413, 414, 416, 318, 236, 353,
+	800, 827, 301, 418, 419, 275, 852, 838, 417, 0,
+	786, 855, 756, 774, 865, 777, 780, 820, 735, 799,
+	337, 771, 0, 760, 731, 766, 732, 758, 788, 240,
+	755, 840, 803, 854, 292, 237, 737, 761, 351, 776,
+	189, 822, 393, 225, 302, 299, 424, 251, 243, 239,
+	223, 276, 308, 349, 411, 343, 861, 296, 809, 0,
+	402, 322, 0, 0, 0, 790, 844, 797, 834, 785,
+	821, 745, 808, 856, 772, 817, 857, 282, 222, 188,
+	334, 403, 255, 0, 83, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 220, 768, 814, 851, 769, 816, 235, 280,
+	242, 234, 421, 862, 843, 0, 0, 205, 853, 792,
+	0, 819, 0, 868, 730, 811, 0, 733, 736, 864,
+	847, 764, 245, 0, 0, 0, 0, 0, 0, 0,
+	789, 798, 831, 783, 0, 0, 0, 0, 0, 0,
+	0, 762, 0, 807, 0, 0, 0, 741, 734, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	787, 0, 0, 0, 744, 0, 763, 832, 0, 728,
+	263, 738, 323, 0, 836, 846, 784, 454, 850, 782,
+	781, 826, 742, 842, 775, 291, 740, 288, 184, 201,
+	0, 773, 333, 373, 380, 841, 759, 767, 226, 765,
+	378, 347, 438, 209, 253, 370, 352, 376, 806, 824,
+	377, 297, 426, 365, 436, 455, 456, 233, 327, 445,
+	415, 451, 467, 202, 230, 341, 408, 441, 399, 320,
+	422, 423, 287, 398, 261, 187, 295, 461, 200, 386,
+	217, 207, 313, 374, 193, 410, 434, 214, 389, 0,
+	0, 469, 195, 432, 407, 316, 284, 285, 194, 0,
+	369, 238, 259, 228, 336, 429, 430, 227, 470, 204,
+	450, 197, 1051, 449, 329, 425, 433, 317, 307, 196,
+	431, 315, 306, 290, 249, 270, 363, 300, 364, 271,
+	325, 324, 326, 190, 442, 0, 191, 0, 404, 443,
+	471, 210, 211, 212, 754, 248, 252, 258, 260, 266,
+	267, 274, 293, 340, 362, 360, 366, 837, 420, 437,
+	446, 453, 459, 460, 462, 463, 464, 465, 466, 328,
+	273, 400, 289, 298, 829, 867, 346, 379, 215, 440,
+	401, 749, 753, 747, 748, 801, 802, 750, 858, 859,
+	860, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 0,
+	833, 743, 0, 751, 752, 0, 839, 848, 849, 490,
+	805, 183, 198, 294, 863, 367, 256, 468, 448, 444,
+	729, 746, 232, 757, 0, 0, 770, 778, 779, 791,
+	793, 794, 795, 796, 319, 812, 813, 815, 823, 825,
+	828, 830, 835, 845, 866, 185, 186, 199, 208, 218,
+	231, 246, 254, 264, 269, 272, 277, 278, 281, 286,
+	304, 309, 310, 311, 312, 330, 331, 332, 335, 338,
+	339, 342, 344, 345, 348, 355, 356, 357, 358, 359,
+	361, 368, 372, 381, 382, 383, 384, 385, 387, 388,
+	394, 395, 396, 397, 405, 409, 427, 428, 439, 452,
+	457, 224, 390, 392, 265, 435, 458, 0, 303, 804,
+	810, 305, 250, 268, 279, 818, 447, 406, 203, 375,
+	257, 192, 221, 206, 229, 244, 247, 283, 314, 321,
+	350, 354, 262, 241, 219, 371, 216, 391, 412, 413,
+	414, 416, 318, 236, 353, 800, 827, 301, 418, 419,
+	275, 852, 838, 417, 0, 786, 855, 756, 774, 865,
+	777, 780, 820, 735, 799, 337, 771, 0, 760, 731,
+	766, 732, 758, 788, 240, 755, 840, 803, 854, 292,
+	237, 737, 761, 351, 776, 189, 822, 393, 225, 302,
+	299, 424, 251, 243, 239, 223, 276, 308, 349, 411,
+	343, 861, 296, 809, 0, 402, 322, 0, 0, 0,
+	790, 844, 797, 834, 785, 821, 745, 808, 856, 772,
+	817, 857, 282, 222, 188, 334, 403, 255, 0, 0,
+	0, 0, 180, 181, 182, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 0, 220, 768, 814,
+	851, 769, 816, 235, 280, 242, 234, 421, 862, 843,
+	0, 0, 205, 853, 792, 0, 819, 0, 868, 730,
+	811, 0, 733, 736, 864, 847, 764, 245, 0, 0,
+	0, 0, 0, 0, 0, 789, 798, 831, 783, 0,
+	0, 0, 0, 0, 0, 0, 762, 0, 807, 0,
+	0, 0, 741, 734, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 787, 0, 0, 0, 744,
+	0, 763, 832, 0, 728, 263, 738, 323, 0, 836,
+	846, 784, 454, 850, 782, 781, 826, 742, 842, 775,
+	291, 740, 288, 184, 201, 0, 773, 333, 373, 380,
+	841, 759, 767, 226, 765, 378, 347, 438, 209, 253,
+	370, 352, 376, 806, 824, 377, 297, 426, 365, 436,
+	455, 456, 233, 327, 445, 415, 451, 467, 202, 230,
+	341, 408, 441, 399, 320, 422, 423, 287, 398, 261,
+	187, 295, 461, 200, 386, 217, 207, 313, 374, 193,
+	410, 434, 214, 389, 0, 0, 469, 195, 432, 407,
+	316, 284, 285, 194, 0, 369, 238, 259, 228, 336,
+	429, 430, 227, 470, 204, 450, 197, 1051, 449, 329,
+	425, 433, 317, 307, 196, 431, 315, 306, 290, 249,
+	270, 363, 300, 364, 271, 325, 324, 326, 190, 442,
+	0, 191, 0, 404, 443, 471, 210, 211, 212, 754,
+	248, 252, 258, 260, 266, 267, 274, 293, 340, 362,
+	360, 366, 837, 420, 437, 446, 453, 459, 460, 462,
+	463, 464, 465, 466, 328, 273, 400, 289, 298, 829,
+	867, 346, 379, 215, 440, 401, 749, 753, 747, 748,
+	801, 802, 750, 858, 859, 860, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 0, 833, 743, 0, 751, 752,
+	0, 839, 848, 849, 490, 805, 183, 198, 294, 863,
+	367, 256, 468, 448, 444, 729, 746, 232, 757, 0,
+	0, 770, 778, 779, 791, 793, 794, 795, 796, 319,
+	812, 813, 815, 823, 825, 828, 830, 835, 845, 866,
+	185, 186, 199, 208, 218, 231, 246, 254, 264, 269,
+	272, 277, 278, 281, 286, 304, 309, 310, 311, 312,
+	330, 331, 332, 335, 338, 339, 342, 344, 345, 348,
+	355, 356, 357, 358, 359, 361, 368, 372, 381, 382,
+	383, 384, 385, 387, 388, 394, 395, 396, 397, 405,
+	409, 427, 428, 439, 452, 457, 224, 390, 392, 265,
+	435, 458, 0, 303, 804, 810, 305, 250, 268, 279,
+	818, 447, 406, 203, 375, 257, 192, 221, 206, 229,
+	244, 247, 283, 314, 321, 350, 354, 262, 241, 219,
+	371, 216, 391, 412, 413, 414, 416, 318, 236, 353,
+	800, 827, 301, 418, 419, 275, 852, 838, 417, 0,
+	786, 855, 756, 774, 865, 777, 780, 820, 735, 799,
+	337, 771, 0, 760, 731, 766, 732, 758, 788, 240,
+	755, 840, 803, 854, 292, 237, 737, 761, 351, 776,
+	189, 822, 393, 225, 302, 299, 424, 251, 243, 239,
+	223, 276, 308, 349, 411, 343, 861, 296, 809, 0,
+	402, 322, 0, 0, 0, 790, 844, 797, 834, 785,
+	821, 745, 808, 856, 772, 817, 857, 282, 222, 188,
+	334, 403, 255, 0, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 220, 768, 814, 851, 769, 816, 235, 280,
+	242, 234, 421, 862, 843, 0, 0, 869, 853, 792,
+	0, 819, 0, 868, 730, 811, 0, 733, 736, 864,
+	847, 764, 245, 0, 0, 0, 0, 0, 0, 0,
+	789, 798, 831, 783, 0, 0, 0, 0, 0, 0,
+	0, 762, 0, 807, 0, 0, 0, 741, 734, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	787, 0, 0, 0, 744, 0, 763, 832, 0, 728,
+	263, 738, 323, 0, 836, 846, 784, 454, 850, 782,
+	781, 826, 742, 842, 775, 291, 740, 288, 184, 201,
+	0, 773, 333, 373, 380, 841, 759, 767, 226, 765,
+	378, 347, 438, 209, 253, 370, 352, 376, 806, 824,
+	377, 297, 426, 365, 436, 455, 456, 233, 327, 445,
+	415, 451, 467, 202, 230, 341, 408, 441, 399, 320,
+	422, 423, 287, 398, 261, 187, 295, 461, 200, 386,
+	217, 207, 313, 374, 193, 410, 434, 214, 389, 0,
+	0, 469, 195, 432, 407, 316, 284, 285, 194, 0,
+	369, 238, 259, 228, 336, 429, 430, 227, 470, 204,
+	450, 197, 739, 449, 329, 425, 433, 317, 307, 196,
+	431, 315, 306, 290, 249, 270, 363, 300, 364, 271,
+	325, 324, 326, 190, 442, 0, 191, 0, 404, 443,
+	471, 210, 211, 212, 754, 248, 252, 258, 260, 266,
+	267, 274, 293, 340, 362, 360, 366, 837, 420, 437,
+	446, 453, 459, 460, 462, 463, 464, 465, 466, 727,
+	721, 720, 289, 298, 829, 867, 346, 379, 215, 440,
+	401, 749, 753, 747, 748, 801, 802, 750, 858, 859,
+	860, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 0,
+	833, 743, 0, 751, 752, 0, 839, 848, 849, 490,
+	805, 183, 198, 294, 863, 367, 256, 468, 448, 444,
+	729, 746, 232, 757, 0, 0, 770, 778, 779, 791,
+	793, 794, 795, 796, 319, 812, 813, 815, 823, 825,
+	828, 830, 835, 845, 866, 185, 186, 199, 208, 218,
+	231, 246, 254, 264, 269, 272, 277, 278, 281, 286,
+	304, 309, 310, 311, 312, 330, 331, 332, 335, 338,
+	339, 342, 344, 345, 348, 355, 356, 357, 358, 359,
+	361, 368, 372, 381, 382, 383, 384, 385, 387, 388,
+	394, 395, 396, 397, 405, 409, 427, 428, 439, 452,
+	457, 224, 390, 392, 265, 435, 458, 0, 303, 804,
+	810, 305, 250, 268, 279, 818, 447, 406, 203, 375,
+	257, 192, 221, 206, 229, 244, 247, 283, 314, 321,
+	350, 354, 262, 241, 219, 371, 216, 391, 412, 413,
+	414, 416, 318, 236, 353, 800, 827, 301, 418, 419,
+	275, 852, 838, 417, 0, 786, 855, 756, 774, 865,
+	777, 780, 820, 735, 799, 337, 771, 0, 760, 731,
+	766, 732, 758, 788, 240, 755, 840, 803, 854, 292,
+	237, 737, 761, 351, 776, 189, 822, 393, 225, 302,
+	299, 424, 251, 243, 239, 223, 276, 308, 349, 411,
+	343, 861, 296, 809, 0, 402, 322, 0, 0, 0,
+	790, 844, 797, 834, 785, 821, 745, 808, 856, 772,
+	817, 857, 282, 222, 188, 334, 403, 255, 0, 0,
+	0, 0, 180, 181, 182, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 0, 220, 768, 814,
+	851, 769, 816, 235, 280, 242, 234, 421, 862, 843,
+	0, 0, 869, 853, 792, 0, 819, 0, 868, 730,
+	811, 0, 733, 736, 864, 847, 764, 245, 0, 0,
+	0, 0, 0, 0, 0, 789, 798, 831, 783, 0,
+	0, 0, 0, 0, 0, 0, 762, 0, 807, 0,
+	0, 0, 741, 734, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 787, 0, 0, 0, 744,
+	0, 763, 832, 0, 728, 263, 738, 323, 0, 836,
+	846, 784, 454, 850, 782, 781, 826, 742, 842, 775,
+	291, 740, 288, 184, 201, 0, 773, 333, 373, 380,
+	841, 759, 767, 226, 765, 378, 347, 438, 209, 253,
+	370, 352, 376, 806, 824, 377, 297, 426, 365, 436,
+	455, 456, 233, 327, 445, 415, 451, 467, 202, 230,
+	341, 408, 441, 399, 320, 422, 423, 287, 398, 261,
+	187, 295, 461, 200, 386, 217, 207, 313, 374, 193,
+	410, 1227, 214, 389, 0, 0, 469, 195, 432, 407,
+	316, 284, 285, 194, 0, 369, 238, 259, 228, 336,
+	429, 430, 227, 470, 204, 450, 197, 739, 449, 329,
+	425, 433, 317, 307, 196, 431, 315, 306, 290, 249,
+	270, 363, 300, 364, 271, 325, 324, 326, 190, 442,
+	0, 191, 0, 404, 443, 471, 210, 211, 212, 754,
+	248, 252, 258, 260, 266, 267, 274, 293, 340, 362,
+	360, 366, 837, 420, 437, 446, 453, 459, 460, 462,
+	463, 464, 465, 466, 727, 721, 720, 289, 298, 829,
+	867, 346, 379, 215, 440, 401, 749, 753, 747, 748,
+	801, 802, 750, 858, 859, 860, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 0, 833, 743, 0, 751, 752,
+	0, 839, 848, 849, 490, 805, 183, 198, 294, 863,
+	367, 256, 468, 448, 444, 729, 746, 232, 757, 0,
+	0, 770, 778, 779, 791, 793, 794, 795, 796, 319,
+	812, 813, 815, 823, 825, 828, 830, 835, 845, 866,
+	185, 186, 199, 208, 218, 231, 246, 254, 264, 269,
+	272, 277, 278, 281, 286, 304, 309, 310, 311, 312,
+	330, 331, 332, 335, 338, 339, 342, 344, 345, 348,
+	355, 356, 357, 358, 359, 361, 368, 372, 381, 382,
+	383, 384, 385, 387, 388, 394, 395, 396, 397, 405,
+	409, 427, 428, 439, 452, 457, 224, 390, 392, 265,
+	435, 458, 0, 303, 804, 810, 305, 250, 268, 279,
+	818, 447, 406, 203, 375, 257, 192, 221, 206, 229,
+	244, 247, 283, 314, 321, 350, 354, 262, 241, 219,
+	371, 216, 391, 412, 413, 414, 416, 318, 236, 353,
+	800, 827, 301, 418, 419, 275, 852, 838, 417, 0,
+	786, 855, 756, 774, 865, 777, 780, 820, 735, 799,
+	337, 771, 0, 760, 731, 766, 732, 758, 788, 240,
+	755, 840, 803, 854, 292, 237, 737, 761, 351, 776,
+	189, 822, 393, 225, 302, 299, 424, 251, 243, 239,
+	223, 276, 308, 349, 411, 343, 861, 296, 809, 0,
+	402, 322, 0, 0, 0, 790, 844, 797, 834, 785,
+	821, 745, 808, 856, 772, 817, 857, 282, 222, 188,
+	334, 403, 255, 0, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 220, 768, 814, 851, 769, 816, 235, 280,
+	242, 234, 421, 862, 843, 0, 0, 869, 853, 792,
+	0, 819, 0, 868, 730, 811, 0, 733, 736, 864,
+	847, 764, 245, 0, 0, 0, 0, 0, 0, 0,
+	789, 798, 831, 783, 0, 0, 0, 0, 0, 0,
+	0, 762, 0, 807, 0, 0, 0, 741, 734, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	787, 0, 0, 0, 744, 0, 763, 832, 0, 728,
+	263, 738, 323, 0, 836, 846, 784, 454, 850, 782,
+	781, 826, 742, 842, 775, 291, 740, 288, 184, 201,
+	0, 773, 333, 373, 380, 841, 759, 767, 226, 765,
+	378, 347, 438, 209, 253, 370, 352, 376, 806, 824,
+	377, 297, 426, 365, 436, 455, 456, 233, 327, 445,
+	415, 451, 467, 202, 230, 341, 408, 441, 399, 320,
+	422, 423, 287, 398, 261, 187, 295, 461, 200, 386,
+	217, 207, 313, 374, 193, 410, 718, 214, 389, 0,
+	0, 469, 195, 432, 407, 316, 284, 285, 194, 0,
+	369, 238, 259, 228, 336, 429, 430, 227, 470, 204,
+	450, 197, 739, 449, 329, 425, 433, 317, 307, 196,
+	431, 315, 306, 290, 249, 270, 363, 300, 364, 271,
+	325, 324, 326, 190, 442, 0, 191, 0, 404, 443,
+	471, 210, 211, 212, 754, 248, 252, 258, 260, 266,
+	267, 274, 293, 340, 362, 360, 366, 837, 420, 437,
+	446, 453, 459, 460, 462, 463, 464, 465, 466, 727,
+	721, 720, 289, 298, 829, 867, 346, 379, 215, 440,
+	401, 749, 753, 747, 748, 801, 802, 750, 858, 859,
+	860, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 0,
+	833, 743, 0, 751, 752, 0, 839, 848, 849, 490,
+	805, 183, 198, 294, 863, 367, 256, 468, 448, 444,
+	729, 746, 232, 757, 0, 0, 770, 778, 779, 791,
+	793, 794, 795, 796, 319, 812, 813, 815, 823, 825,
+	828, 830, 835, 845, 866, 185, 186, 199, 208, 218,
+	231, 246, 254, 264, 269, 272, 277, 278, 281, 286,
+	304, 309, 310, 311, 312, 330, 331, 332, 335, 338,
+	339, 342, 344, 345, 348, 355, 356, 357, 358, 359,
+	361, 368, 372, 381, 382, 383, 384, 385, 387, 388,
+	394, 395, 396, 397, 405, 409, 427, 428, 439, 452,
+	457, 224, 390, 392, 265, 435, 458, 0, 303, 804,
+	810, 305, 250, 268, 279, 818, 447, 406, 203, 375,
+	257, 192, 221, 206, 229, 244, 247, 283, 314, 321,
+	350, 354, 262, 241, 219, 371, 216, 391, 412, 413,
+	414, 416, 318, 236, 353, 800, 827, 301, 418, 419,
+	275, 417, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 337, 0, 0, 1574, 0, 572, 0,
+	0, 0, 240, 577, 0, 0, 0, 292, 237, 0,
+	1575, 351, 0, 189, 0, 393, 225, 302, 299, 424,
+	251, 243, 239, 223, 276, 308, 349, 411, 343, 584,
+	296, 0, 0, 402, 322, 0, 0, 0, 0, 0,
+	579, 580, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 222, 188, 334, 403, 255, 0, 83, 0, 0,
+	180, 181, 182, 615, 622, 623, 624, 625, 626, 616,
+	618, 0, 0, 213, 617, 220, 593, 620, 627, 628,
+	0, 235, 280, 242, 234, 421, 0, 0, 0, 0,
+	205, 0, 0, 0, 0, 0, 0, 0, 555, 569,
+	0, 583, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	566, 567, 707, 0, 0, 0, 600, 0, 568, 0,
+	0, 576, 629, 630, 631, 632, 633, 634, 635, 636,
+	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
+	647, 648, 649, 650, 651, 652, 653, 654, 655, 656,
+	657, 658, 659, 660, 661, 662, 663, 664, 665, 666,
+	667, 668, 669, 578, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 263, 0, 323, 0, 599, 0, 0,
+	454, 0, 0, 597, 0, 0, 0, 0, 291, 0,
+	288, 184, 201, 0, 0, 333, 373, 380, 0, 0,
+	0, 226, 0, 378, 347, 438, 209, 253, 370, 352,
+	376, 0, 0, 377, 297, 426, 365, 436, 455, 456,
+	233, 327, 445, 415, 451, 467, 202, 230, 341, 408,
+	441, 399, 320, 422, 423, 287, 398, 261, 187, 295,
+	461, 200, 386, 217, 207, 313, 374, 193, 410, 434,
+	214, 389, 0, 0, 469, 195, 432, 407, 316, 284,
+	285, 194, 0, 369, 238, 259, 228, 336, 429, 430,
+	227, 470, 204, 450, 197, 0, 449, 329, 425, 433,
+	317, 307, 196, 431, 315, 306, 290, 249, 270, 363,
+	300, 364, 271, 325, 324, 326, 190, 442, 0, 191,
+	0, 404, 443, 471, 210, 211, 212, 0, 248, 252,
+	258, 260, 266, 267, 274, 293, 340, 362, 360, 366,
+	0, 420, 437, 446, 453, 459, 460, 462, 463, 464,
+	465, 466, 328, 273, 400, 289, 298, 0, 0, 346,
+	379, 215, 440, 401, 606, 598, 588, 590, 607, 608,
+	585, 586, 589, 609, 472, 473, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 0, 601, 575, 574, 0, 581, 582, 0,
+	591, 592, 594, 573, 183, 198, 294, 0, 367, 256,
+	468, 448, 444, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 185, 186,
+	199, 208, 218, 231, 246, 254, 264, 269, 272, 277,
+	278, 281, 286, 304, 309, 310, 311, 312, 330, 331,
+	332, 335, 338, 339, 342, 344, 345, 348, 355, 356,
+	357, 358, 359, 361, 368, 372, 381, 382, 383, 384,
+	385, 387, 388, 394, 395, 396, 397, 405, 409, 427,
+	428, 439, 452, 457, 224, 390, 392, 265, 435, 458,
+	0, 303, 0, 0, 305, 250, 268, 279, 0, 447,
+	406, 203, 375, 257, 192, 221, 206, 229, 244, 247,
+	283, 314, 321, 350, 354, 262, 241, 219, 371, 216,
+	391, 412, 413, 414, 416, 318, 236, 353, 417, 0,
+	301, 418, 419, 275, 0, 0, 0, 0, 0, 0,
+	337, 0, 0, 0, 0, 572, 0, 0, 0, 240,
+	577, 0, 0, 0, 292, 237, 0, 0, 351, 0,
+	189, 0, 393, 225, 302, 299, 424, 251, 243, 239,
+	223, 276, 308, 349, 411, 343, 584, 296, 0, 0,
+	402, 322, 0, 0, 0, 0, 0, 579, 580, 0,
+	0, 0, 0, 0, 0, 1651, 0, 282, 222, 188,
+	334, 403, 255, 0, 83, 0, 0, 180, 181, 182,
+	615, 622, 623, 624, 625, 626, 616, 618, 0, 0,
+	213, 617, 220, 593, 620, 627, 628, 1652, 235, 280,
+	242, 234, 421, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 0, 0, 555, 569, 0, 583, 0,
+	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 566, 567, 0,
+	0, 0, 0, 600, 0, 568, 0, 0, 576, 629,
+	630, 631, 632, 633, 634, 635, 636, 637, 638, 639,
+	640, 641, 642, 643, 644, 645, 646, 647, 648, 649,
+	650, 651, 652, 653, 654, 655, 656, 657, 658, 659,
+	660, 661, 662, 663, 664, 665, 666, 667, 668, 669,
+	578, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 323, 0, 599, 0, 0, 454, 0, 0,
+	597, 0, 0, 0, 0, 291, 0, 288, 184, 201,
+	0, 0, 333, 373, 380, 0, 0, 0, 226, 0,
+	378, 347, 438, 209, 253, 370, 352, 376, 0, 0,
+	377, 297, 426, 365, 436, 455, 456, 233, 327, 445,
+	415, 451, 467, 202, 230, 341, 408, 441, 399, 320,
+	422, 423, 287, 398, 261, 187, 295, 461, 200, 386,
+	217, 207, 313, 374, 193, 410, 434, 214, 389, 0,
+	0, 469, 195, 432, 407, 316, 284, 285, 194, 0,
+	369, 238, 259, 228, 336, 429, 430, 227, 470, 204,
+	450, 197, 0, 449, 329, 425, 433, 317, 307, 196,
+	431, 315, 306, 290, 249, 270, 363, 300, 364, 271,
+	325, 324, 326, 190, 442, 0, 191, 0, 404, 443,
+	471, 210, 211, 212, 0, 248, 252, 258, 260, 266,
+	267, 274, 293, 340, 362, 360, 366, 0, 420, 437,
+	446, 453, 459, 460, 462, 463, 464, 465, 466, 328,
+	273, 400, 289, 298, 0, 0, 346, 379, 215, 440,
+	401, 606, 598, 588, 590, 607, 608, 585, 586, 589,
+	609, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 0,
+	601, 575, 574, 0, 581, 582, 0, 591, 592, 594,
+	573, 183, 198, 294, 0, 367, 256, 468, 448, 444,
+	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 319, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 186, 199, 208, 218,
+	231, 246, 254, 264, 269, 272, 277, 278, 281, 286,
+	304, 309, 310, 311, 312, 330, 331, 332, 335, 338,
+	339, 342, 344, 345, 348, 355, 356, 357, 358, 359,
+	361, 368, 372, 381, 382, 383, 384, 385, 387, 388,
+	394, 395, 396, 397, 405, 409, 427, 428, 439, 452,
+	457, 224, 390, 392, 265, 435, 458, 0, 303, 0,
+	0, 305, 250, 268, 279, 0, 447, 406, 203, 375,
+	257, 192, 221, 206, 229, 244, 247, 283, 314, 321,
+	350, 354, 262, 241, 219, 371, 216, 391, 412, 413,
+	414, 416, 318, 236, 353, 74, 417, 301, 418, 419,
+	275, 0, 0, 0, 0, 0, 0, 0, 337, 0,
+	0, 0, 0, 572, 0, 0, 0, 240, 577, 0,
+	0, 0, 292, 237, 0, 0, 351, 0, 189, 0,
+	393, 225, 302, 299, 424, 251, 243, 239, 223, 276,
+	308, 349, 411, 343, 584, 296, 0, 0, 402, 322,
+	0, 0, 0, 0, 0, 579, 580, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 222, 188, 334, 403,
+	255, 0, 83, 0, 0, 180, 181, 182, 615, 622,
+	623, 624, 625, 626, 616, 618, 0, 0, 213, 617,
+	220, 593, 620, 627, 628, 0, 235, 280, 242, 234,
+	421, 0, 0, 0, 0, 205, 0, 0, 0, 0,
+	0, 0, 0, 555, 569, 0, 583, 0, 0, 0,
+	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 566, 567, 0, 0, 0,
+	0, 600, 0, 568, 0, 0, 576, 629, 630, 631,
 	632, 633, 634, 635, 636, 637, 638, 639, 640, 641,
 	642, 643, 644, 645, 646, 647, 648, 649, 650, 651,
 	652, 653, 654, 655, 656, 657, 658, 659, 660, 661,
-	570, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	260, 0, 319, 0, 591, 0, 0, 447, 0, 0,
-	589, 0, 0, 0, 0, 288, 0, 285, 182, 199,
-	0, 0, 329, 369, 375, 0, 0, 0, 223, 0,
-	373, 343, 431, 207, 250, 366, 348, 371, 0, 0,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 427, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	0, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 0, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 0, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 324, 270, 393,
-	286, 295, 0, 0, 342, 374, 213, 433, 394, 598,
-	590, 580, 582, 599, 600, 577, 578, 581, 601, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 593, 567,
-	566, 0, 573, 574, 0, 583, 584, 586, 565, 181,
-	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 0, 0, 302, 247, 265, 276,
-	0, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	72, 410, 298, 411, 412, 272, 0, 0, 0, 0,
-	0, 0, 0, 333, 0, 0, 0, 0, 564, 0,
-	0, 0, 237, 569, 0, 0, 0, 289, 234, 0,
-	0, 347, 0, 187, 0, 386, 222, 299, 296, 417,
-	248, 240, 236, 221, 273, 305, 345, 404, 339, 576,
-	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
-	571, 572, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 220, 186, 330, 396, 252, 0, 81, 0, 0,
-	178, 179, 180, 607, 614, 615, 616, 617, 618, 608,
-	610, 0, 0, 211, 609, 218, 585, 612, 619, 620,
-	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
-	203, 0, 0, 0, 0, 0, 0, 0, 547, 561,
-	0, 575, 0, 0, 0, 242, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	558, 559, 0, 0, 0, 0, 592, 0, 560, 0,
-	0, 568, 621, 622, 623, 624, 625, 626, 627, 628,
-	629, 630, 631, 632, 633, 634, 635, 636, 637, 638,
-	639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
-	649, 650, 651, 652, 653, 654, 655, 656, 657, 658,
-	659, 660, 661, 570, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 319, 0, 591, 0, 0,
-	447, 0, 0, 589, 0, 0, 0, 0, 288, 0,
-	285, 182, 199, 0, 0, 329, 369, 375, 0, 0,
-	0, 223, 0, 373, 343, 431, 207, 250, 366, 348,
-	371, 0, 0, 372, 294, 419, 361, 429, 448, 449,
-	230, 323, 438, 408, 444, 460, 200, 227, 337, 401,
-	434, 392, 316, 415, 416, 284, 391, 258, 185, 292,
-	454, 198, 381, 215, 205, 191, 403, 427, 212, 384,
-	0, 0, 462, 193, 425, 400, 312, 281, 282, 192,
-	0, 365, 235, 256, 225, 332, 422, 423, 224, 463,
-	202, 443, 195, 0, 442, 325, 418, 426, 313, 304,
-	194, 424, 311, 303, 287, 246, 267, 359, 297, 360,
-	268, 321, 320, 322, 188, 435, 0, 189, 0, 397,
-	436, 464, 208, 209, 210, 0, 245, 249, 255, 257,
-	263, 264, 271, 290, 336, 358, 356, 362, 0, 413,
-	430, 439, 446, 452, 453, 455, 456, 457, 458, 459,
-	324, 270, 393, 286, 295, 0, 0, 342, 374, 213,
-	433, 394, 598, 590, 580, 582, 599, 600, 577, 578,
-	581, 601, 465, 466, 467, 468, 469, 470, 471, 472,
+	662, 663, 664, 665, 666, 667, 668, 669, 578, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	323, 0, 599, 0, 0, 454, 0, 0, 597, 0,
+	0, 0, 0, 291, 0, 288, 184, 201, 0, 0,
+	333, 373, 380, 0, 0, 0, 226, 0, 378, 347,
+	438, 209, 253, 370, 352, 376, 0, 0, 377, 297,
+	426, 365, 436, 455, 456, 233, 327, 445, 415, 451,
+	467, 202, 230, 341, 408, 441, 399, 320, 422, 423,
+	287, 398, 261, 187, 295, 461, 200, 386, 217, 207,
+	313, 374, 193, 410, 434, 214, 389, 0, 0, 469,
+	195, 432, 407, 316, 284, 285, 194, 0, 369, 238,
+	259, 228, 336, 429, 430, 227, 470, 204, 450, 197,
+	0, 449, 329, 425, 433, 317, 307, 196, 431, 315,
+	306, 290, 249, 270, 363, 300, 364, 271, 325, 324,
+	326, 190, 442, 0, 191, 0, 404, 443, 471, 210,
+	211, 212, 0, 248, 252, 258, 260, 266, 267, 274,
+	293, 340, 362, 360, 366, 0, 420, 437, 446, 453,
+	459, 460, 462, 463, 464, 465, 466, 328, 273, 400,
+	289, 298, 0, 0, 346, 379, 215, 440, 401, 606,
+	598, 588, 590, 607, 608, 585, 586, 589, 609, 472,
 	473, 474, 475, 476, 477, 478, 479, 480, 481, 482,
-	0, 593, 567, 566, 0, 573, 574, 0, 583, 584,
-	586, 565, 181, 196, 291, 80, 363, 253, 461, 441,
-	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 183, 184, 197, 206,
-	216, 228, 243, 251, 261, 266, 269, 274, 275, 278,
-	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
-	334, 335, 338, 340, 341, 344, 351, 352, 353, 354,
-	355, 357, 364, 368, 376, 377, 378, 379, 380, 382,
-	383, 387, 388, 389, 390, 398, 402, 420, 421, 432,
-	445, 450, 262, 428, 451, 0, 300, 0, 0, 302,
-	247, 265, 276, 0, 440, 399, 201, 370, 254, 190,
-	219, 204, 226, 241, 244, 280, 310, 317, 346, 350,
-	259, 238, 217, 367, 214, 385, 405, 406, 407, 409,
-	314, 233, 349, 410, 0, 298, 411, 412, 272, 0,
-	0, 0, 0, 0, 0, 333, 0, 0, 0, 0,
-	564, 0, 0, 0, 237, 569, 0, 0, 0, 289,
-	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
-	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
-	339, 576, 293, 0, 0, 395, 318, 0, 0, 0,
-	0, 0, 571, 572, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 220, 186, 330, 396, 252, 0, 81,
-	0, 0, 178, 179, 180, 607, 614, 615, 616, 617,
-	618, 608, 610, 0, 0, 211, 609, 218, 585, 612,
-	619, 620, 0, 232, 277, 239, 231, 414, 0, 0,
-	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
-	547, 561, 0, 575, 0, 0, 0, 242, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 558, 559, 0, 0, 0, 0, 592, 0,
-	560, 0, 0, 568, 621, 622, 623, 624, 625, 626,
-	627, 628, 629, 630, 631, 632, 633, 634, 635, 636,
-	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
-	647, 648, 649, 650, 651, 652, 653, 654, 655, 656,
-	657, 658, 659, 660, 661, 570, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 319, 0, 591,
-	0, 0, 447, 0, 0, 589, 0, 0, 0, 0,
-	288, 0, 285, 182, 199, 0, 0, 329, 369, 375,
-	0, 0, 0, 223, 0, 373, 343, 431, 207, 250,
-	366, 348, 371, 2443, 0, 372, 294, 419, 361, 429,
-	448, 449, 230, 323, 438, 408, 444, 460, 200, 227,
-	337, 401, 434, 392, 316, 415, 416, 284, 391, 258,
-	185, 292, 454, 198, 381, 215, 205, 191, 403, 427,
-	212, 384, 0, 0, 462, 193, 425, 400, 312, 281,
-	282, 192, 0, 365, 235, 256, 225, 332, 422, 423,
-	224, 463, 202, 443, 195, 0, 442, 325, 418, 426,
-	313, 304, 194, 424, 311, 303, 287, 246, 267, 359,
-	297, 360, 268, 321, 320, 322, 188, 435, 0, 189,
-	0, 397, 436, 464, 208, 209, 210, 0, 245, 249,
-	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
-	0, 413, 430, 439, 446, 452, 453, 455, 456, 457,
-	458, 459, 324, 270, 393, 286, 295, 0, 0, 342,
-	374, 213, 433, 394, 598, 590, 580, 582, 599, 600,
-	577, 578, 581, 601, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
-	481, 482, 0, 593, 567, 566, 0, 573, 574, 0,
-	583, 584, 586, 565, 181, 196, 291, 0, 363, 253,
-	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
-	197, 206, 216, 228, 243, 251, 261, 266, 269, 274,
-	275, 278, 283, 301, 306, 307, 308, 309, 326, 327,
-	328, 331, 334, 335, 338, 340, 341, 344, 351, 352,
-	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
-	380, 382, 383, 387, 388, 389, 390, 398, 402, 420,
-	421, 432, 445, 450, 262, 428, 451, 0, 300, 0,
-	0, 302, 247, 265, 276, 0, 440, 399, 201, 370,
-	254, 190, 219, 204, 226, 241, 244, 280, 310, 317,
-	346, 350, 259, 238, 217, 367, 214, 385, 405, 406,
-	407, 409, 314, 233, 349, 410, 0, 298, 411, 412,
-	272, 0, 0, 0, 0, 0, 0, 333, 0, 0,
-	0, 0, 564, 0, 0, 0, 237, 569, 0, 0,
-	0, 289, 234, 0, 0, 347, 0, 187, 0, 386,
-	222, 299, 296, 417, 248, 240, 236, 221, 273, 305,
-	345, 404, 339, 576, 293, 0, 0, 395, 318, 0,
-	0, 0, 0, 0, 571, 572, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 220, 186, 330, 396, 252,
-	0, 81, 0, 1182, 178, 179, 180, 607, 614, 615,
-	616, 617, 618, 608, 610, 0, 0, 211, 609, 218,
-	585, 612, 619, 620, 0, 232, 277, 239, 231, 414,
-	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
-	0, 0, 547, 561, 0, 575, 0, 0, 0, 242,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 558, 559, 0, 0, 0, 0,
-	592, 0, 560, 0, 0, 568, 621, 622, 623, 624,
-	625, 626, 627, 628, 629, 630, 631, 632, 633, 634,
+	483, 484, 485, 486, 487, 488, 489, 0, 601, 575,
+	574, 0, 581, 582, 0, 591, 592, 594, 573, 183,
+	198, 294, 82, 367, 256, 468, 448, 444, 0, 0,
+	232, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 319, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 185, 186, 199, 208, 218, 231, 246,
+	254, 264, 269, 272, 277, 278, 281, 286, 304, 309,
+	310, 311, 312, 330, 331, 332, 335, 338, 339, 342,
+	344, 345, 348, 355, 356, 357, 358, 359, 361, 368,
+	372, 381, 382, 383, 384, 385, 387, 388, 394, 395,
+	396, 397, 405, 409, 427, 428, 439, 452, 457, 224,
+	390, 392, 265, 435, 458, 0, 303, 0, 0, 305,
+	250, 268, 279, 0, 447, 406, 203, 375, 257, 192,
+	221, 206, 229, 244, 247, 283, 314, 321, 350, 354,
+	262, 241, 219, 371, 216, 391, 412, 413, 414, 416,
+	318, 236, 353, 417, 0, 301, 418, 419, 275, 0,
+	0, 0, 0, 0, 0, 337, 0, 0, 0, 0,
+	572, 0, 0, 0, 240, 577, 0, 0, 0, 292,
+	237, 0, 0, 351, 0, 189, 0, 393, 225, 302,
+	299, 424, 251, 243, 239, 223, 276, 308, 349, 411,
+	343, 584, 296, 0, 0, 402, 322, 0, 0, 0,
+	0, 0, 579, 580, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 222, 188, 334, 403, 255, 0, 83,
+	0, 0, 180, 181, 182, 615, 622, 623, 624, 625,
+	626, 616, 618, 0, 0, 213, 617, 220, 593, 620,
+	627, 628, 0, 235, 280, 242, 234, 421, 0, 0,
+	0, 0, 205, 0, 0, 0, 0, 0, 0, 0,
+	555, 569, 0, 583, 0, 0, 0, 245, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 566, 567, 0, 0, 0, 0, 600, 0,
+	568, 0, 0, 576, 629, 630, 631, 632, 633, 634,
 	635, 636, 637, 638, 639, 640, 641, 642, 643, 644,
 	645, 646, 647, 648, 649, 650, 651, 652, 653, 654,
-	655, 656, 657, 658, 659, 660, 661, 570, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 260, 0, 319,
-	0, 591, 0, 0, 447, 0, 0, 589, 0, 0,
-	0, 0, 288, 0, 285, 182, 199, 0, 0, 329,
-	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
-	207, 250, 366, 348, 371, 0, 0, 372, 294, 419,
-	361, 429, 448, 449, 230, 323, 438, 408, 444, 460,
-	200, 227, 337, 401, 434, 392, 316, 415, 416, 284,
-	391, 258, 185, 292, 454, 198, 381, 215, 205, 191,
-	403, 427, 212, 384, 0, 0, 462, 193, 425, 400,
-	312, 281, 282, 192, 0, 365, 235, 256, 225, 332,
-	422, 423, 224, 463, 202, 443, 195, 0, 442, 325,
-	418, 426, 313, 304, 194, 424, 311, 303, 287, 246,
-	267, 359, 297, 360, 268, 321, 320, 322, 188, 435,
-	0, 189, 0, 397, 436, 464, 208, 209, 210, 0,
-	245, 249, 255, 257, 263, 264, 271, 290, 336, 358,
-	356, 362, 0, 413, 430, 439, 446, 452, 453, 455,
-	456, 457, 458, 459, 324, 270, 393, 286, 295, 0,
-	0, 342, 374, 213, 433, 394, 598, 590, 580, 582,
-	599, 600, 577, 578, 581, 601, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
-	479, 480, 481, 482, 0, 593, 567, 566, 0, 573,
-	574, 0, 583, 584, 586, 565, 181, 196, 291, 0,
-	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	183, 184, 197, 206, 216, 228, 243, 251, 261, 266,
-	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
-	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
-	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
-	378, 379, 380, 382, 383, 387, 388, 389, 390, 398,
-	402, 420, 421, 432, 445, 450, 262, 428, 451, 0,
-	300, 0, 0, 302, 247, 265, 276, 0, 440, 399,
-	201, 370, 254, 190, 219, 204, 226, 241, 244, 280,
-	310, 317, 346, 350, 259, 238, 217, 367, 214, 385,
-	405, 406, 407, 409, 314, 233, 349, 410, 0, 298,
-	411, 412, 272, 0, 0, 0, 0, 0, 0, 333,
-	0, 0, 0, 0, 564, 0, 0, 0, 237, 569,
-	0, 0, 0, 289, 234, 0, 0, 347, 0, 187,
-	0, 386, 222, 299, 296, 417, 248, 240, 236, 221,
-	273, 305, 345, 404, 339, 576, 293, 0, 0, 395,
-	318, 0, 0, 0, 0, 0, 571, 572, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 220, 186, 330,
-	396, 252, 0, 81, 0, 0, 178, 179, 180, 607,
-	614, 615, 616, 617, 618, 608, 610, 0, 0, 211,
-	609, 218, 585, 612, 619, 620, 0, 232, 277, 239,
-	231, 414, 0, 0, 0, 0, 203, 0, 0, 0,
-	0, 0, 0, 0, 547, 561, 0, 575, 0, 0,
-	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 558, 559, 698, 0,
-	0, 0, 592, 0, 560, 0, 0, 568, 621, 622,
-	623, 624, 625, 626, 627, 628, 629, 630, 631, 632,
-	633, 634, 635, 636, 637, 638, 639, 640, 641, 642,
-	643, 644, 645, 646, 647, 648, 649, 650, 651, 652,
-	653, 654, 655, 656, 657, 658, 659, 660, 661, 570,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 319, 0, 591, 0, 0, 447, 0, 0, 589,
-	0, 0, 0, 0, 288, 0, 285, 182, 199, 0,
-	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
-	343, 431, 207, 250, 366, 348, 371, 0, 0, 372,
-	294, 419, 361, 429, 448, 449, 230, 323, 438, 408,
-	444, 460, 200, 227, 337, 401, 434, 392, 316, 415,
-	416, 284, 391, 258, 185, 292, 454, 198, 381, 215,
-	205, 191, 403, 427, 212, 384, 0, 0, 462, 193,
-	425, 400, 312, 281, 282, 192, 0, 365, 235, 256,
-	225, 332, 422, 423, 224, 463, 202, 443, 195, 0,
-	442, 325, 418, 426, 313, 304, 194, 424, 311, 303,
-	287, 246, 267, 359, 297, 360, 268, 321, 320, 322,
-	188, 435, 0, 189, 0, 397, 436, 464, 208, 209,
-	210, 0, 245, 249, 255, 257, 263, 264, 271, 290,
-	336, 358, 356, 362, 0, 413, 430, 439, 446, 452,
-	453, 455, 456, 457, 458, 459, 324, 270, 393, 286,
-	295, 0, 0, 342, 374, 213, 433, 394, 598, 590,
-	580, 582, 599, 600, 577, 578, 581, 601, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 478, 479, 480, 481, 482, 0, 593, 567, 566,
-	0, 573, 574, 0, 583, 584, 586, 565, 181, 196,
-	291, 0, 363, 253, 461, 441, 437, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 183, 184, 197, 206, 216, 228, 243, 251,
-	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
-	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
-	341, 344, 351, 352, 353, 354, 355, 357, 364, 368,
-	376, 377, 378, 379, 380, 382, 383, 387, 388, 389,
-	390, 398, 402, 420, 421, 432, 445, 450, 262, 428,
-	451, 0, 300, 0, 0, 302, 247, 265, 276, 0,
-	440, 399, 201, 370, 254, 190, 219, 204, 226, 241,
-	244, 280, 310, 317, 346, 350, 259, 238, 217, 367,
-	214, 385, 405, 406, 407, 409, 314, 233, 349, 410,
-	0, 298, 411, 412, 272, 0, 0, 0, 0, 0,
-	0, 333, 0, 0, 0, 0, 564, 0, 0, 0,
-	237, 569, 0, 0, 0, 289, 234, 0, 0, 347,
-	0, 187, 0, 386, 222, 299, 296, 417, 248, 240,
-	236, 221, 273, 305, 345, 404, 339, 576, 293, 0,
-	0, 395, 318, 0, 0, 0, 0, 0, 571, 572,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 220,
-	186, 330, 396, 252, 0, 81, 0, 0, 178, 179,
-	180, 607, 614, 615, 616, 617, 618, 608, 610, 0,
-	0, 211, 609, 218, 585, 612, 619, 620, 0, 232,
-	277, 239, 231, 414, 0, 0, 0, 0, 203, 0,
-	0, 0, 0, 0, 0, 0, 547, 561, 0, 575,
-	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 558, 559,
-	0, 0, 0, 0, 592, 0, 560, 0, 0, 568,
-	621, 622, 623, 624, 625, 626, 627, 628, 629, 630,
+	655, 656, 657, 658, 659, 660, 661, 662, 663, 664,
+	665, 666, 667, 668, 669, 578, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 263, 0, 323, 0, 599,
+	0, 0, 454, 0, 0, 597, 0, 0, 0, 0,
+	291, 0, 288, 184, 201, 0, 0, 333, 373, 380,
+	0, 0, 0, 226, 0, 378, 347, 438, 209, 253,
+	370, 352, 376, 2474, 0, 377, 297, 426, 365, 436,
+	455, 456, 233, 327, 445, 415, 451, 467, 202, 230,
+	341, 408, 441, 399, 320, 422, 423, 287, 398, 261,
+	187, 295, 461, 200, 386, 217, 207, 313, 374, 193,
+	410, 434, 214, 389, 0, 0, 469, 195, 432, 407,
+	316, 284, 285, 194, 0, 369, 238, 259, 228, 336,
+	429, 430, 227, 470, 204, 450, 197, 0, 449, 329,
+	425, 433, 317, 307, 196, 431, 315, 306, 290, 249,
+	270, 363, 300, 364, 271, 325, 324, 326, 190, 442,
+	0, 191, 0, 404, 443, 471, 210, 211, 212, 0,
+	248, 252, 258, 260, 266, 267, 274, 293, 340, 362,
+	360, 366, 0, 420, 437, 446, 453, 459, 460, 462,
+	463, 464, 465, 466, 328, 273, 400, 289, 298, 0,
+	0, 346, 379, 215, 440, 401, 606, 598, 588, 590,
+	607, 608, 585, 586, 589, 609, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 0, 601, 575, 574, 0, 581,
+	582, 0, 591, 592, 594, 573, 183, 198, 294, 0,
+	367, 256, 468, 448, 444, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	185, 186, 199, 208, 218, 231, 246, 254, 264, 269,
+	272, 277, 278, 281, 286, 304, 309, 310, 311, 312,
+	330, 331, 332, 335, 338, 339, 342, 344, 345, 348,
+	355, 356, 357, 358, 359, 361, 368, 372, 381, 382,
+	383, 384, 385, 387, 388, 394, 395, 396, 397, 405,
+	409, 427, 428, 439, 452, 457, 224, 390, 392, 265,
+	435, 458, 0, 303, 0, 0, 305, 250, 268, 279,
+	0, 447, 406, 203, 375, 257, 192, 221, 206, 229,
+	244, 247, 283, 314, 321, 350, 354, 262, 241, 219,
+	371, 216, 391, 412, 413, 414, 416, 318, 236, 353,
+	417, 0, 301, 418, 419, 275, 0, 0, 0, 0,
+	0, 0, 337, 0, 0, 0, 0, 572, 0, 0,
+	0, 240, 577, 0, 0, 0, 292, 237, 0, 0,
+	351, 0, 189, 0, 393, 225, 302, 299, 424, 251,
+	243, 239, 223, 276, 308, 349, 411, 343, 584, 296,
+	0, 0, 402, 322, 0, 0, 0, 0, 0, 579,
+	580, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	222, 188, 334, 403, 255, 0, 83, 0, 1195, 180,
+	181, 182, 615, 622, 623, 624, 625, 626, 616, 618,
+	0, 0, 213, 617, 220, 593, 620, 627, 628, 0,
+	235, 280, 242, 234, 421, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 0, 0, 555, 569, 0,
+	583, 0, 0, 0, 245, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 566,
+	567, 0, 0, 0, 0, 600, 0, 568, 0, 0,
+	576, 629, 630, 631, 632, 633, 634, 635, 636, 637,
+	638, 639, 640, 641, 642, 643, 644, 645, 646, 647,
+	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
+	658, 659, 660, 661, 662, 663, 664, 665, 666, 667,
+	668, 669, 578, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 263, 0, 323, 0, 599, 0, 0, 454,
+	0, 0, 597, 0, 0, 0, 0, 291, 0, 288,
+	184, 201, 0, 0, 333, 373, 380, 0, 0, 0,
+	226, 0, 378, 347, 438, 209, 253, 370, 352, 376,
+	0, 0, 377, 297, 426, 365, 436, 455, 456, 233,
+	327, 445, 415, 451, 467, 202, 230, 341, 408, 441,
+	399, 320, 422, 423, 287, 398, 261, 187, 295, 461,
+	200, 386, 217, 207, 313, 374, 193, 410, 434, 214,
+	389, 0, 0, 469, 195, 432, 407, 316, 284, 285,
+	194, 0, 369, 238, 259, 228, 336, 429, 430, 227,
+	470, 204, 450, 197, 0, 449, 329, 425, 433, 317,
+	307, 196, 431, 315, 306, 290, 249, 270, 363, 300,
+	364, 271, 325, 324, 326, 190, 442, 0, 191, 0,
+	404, 443, 471, 210, 211, 212, 0, 248, 252, 258,
+	260, 266, 267, 274, 293, 340, 362, 360, 366, 0,
+	420, 437, 446, 453, 459, 460, 462, 463, 464, 465,
+	466, 328, 273, 400, 289, 298, 0, 0, 346, 379,
+	215, 440, 401, 606, 598, 588, 590, 607, 608, 585,
+	586, 589, 609, 472, 473, 474, 475, 476, 477, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 0, 601, 575, 574, 0, 581, 582, 0, 591,
+	592, 594, 573, 183, 198, 294, 0, 367, 256, 468,
+	448, 444, 0, 0, 232, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 319, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 186, 199,
+	208, 218, 231, 246, 254, 264, 269, 272, 277, 278,
+	281, 286, 304, 309, 310, 311, 312, 330, 331, 332,
+	335, 338, 339, 342, 344, 345, 348, 355, 356, 357,
+	358, 359, 361, 368, 372, 381, 382, 383, 384, 385,
+	387, 388, 394, 395, 396, 397, 405, 409, 427, 428,
+	439, 452, 457, 224, 390, 392, 265, 435, 458, 0,
+	303, 0, 0, 305, 250, 268, 279, 0, 447, 406,
+	203, 375, 257, 192, 221, 206, 229, 244, 247, 283,
+	314, 321, 350, 354, 262, 241, 219, 371, 216, 391,
+	412, 413, 414, 416, 318, 236, 353, 417, 0, 301,
+	418, 419, 275, 0, 0, 0, 0, 0, 0, 337,
+	0, 0, 0, 0, 572, 0, 0, 0, 240, 577,
+	0, 0, 0, 292, 237, 0, 0, 351, 0, 189,
+	0, 393, 225, 302, 299, 424, 251, 243, 239, 223,
+	276, 308, 349, 411, 343, 584, 296, 0, 0, 402,
+	322, 0, 0, 0, 0, 0, 579, 580, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 222, 188, 334,
+	403, 255, 0, 83, 0, 0, 180, 181, 182, 615,
+	622, 623, 624, 625, 626, 616, 618, 0, 0, 213,
+	617, 220, 593, 620, 627, 628, 0, 235, 280, 242,
+	234, 421, 0, 0, 0, 0, 205, 0, 0, 0,
+	0, 0, 0, 0, 555, 569, 0, 583, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 566, 567, 707, 0,
+	0, 0, 600, 0, 568, 0, 0, 576, 629, 630,
 	631, 632, 633, 634, 635, 636, 637, 638, 639, 640,
 	641, 642, 643, 644, 645, 646, 647, 648, 649, 650,
 	651, 652, 653, 654, 655, 656, 657, 658, 659, 660,
-	661, 570, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 260, 0, 319, 0, 591, 0, 0, 447, 0,
-	0, 589, 0, 0, 0, 0, 288, 0, 285, 182,
-	199, 0, 0, 329, 369, 375, 0, 0, 0, 223,
-	0, 373, 343, 431, 207, 250, 366, 348, 371, 0,
-	0, 372, 294, 419, 361, 429, 448, 449, 230, 323,
-	438, 408, 444, 460, 200, 227, 337, 401, 434, 392,
-	316, 415, 416, 284, 391, 258, 185, 292, 454, 198,
-	381, 215, 205, 191, 403, 427, 212, 384, 0, 0,
-	462, 193, 425, 400, 312, 281, 282, 192, 0, 365,
-	235, 256, 225, 332, 422, 423, 224, 463, 202, 443,
-	195, 0, 442, 325, 418, 426, 313, 304, 194, 424,
-	311, 303, 287, 246, 267, 359, 297, 360, 268, 321,
-	320, 322, 188, 435, 0, 189, 0, 397, 436, 464,
-	208, 209, 210, 0, 245, 249, 255, 257, 263, 264,
-	271, 290, 336, 358, 356, 362, 0, 413, 430, 439,
-	446, 452, 453, 455, 456, 457, 458, 459, 324, 270,
-	393, 286, 295, 0, 0, 342, 374, 213, 433, 394,
-	598, 590, 580, 582, 599, 600, 577, 578, 581, 601,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 478, 479, 480, 481, 482, 0, 593,
-	567, 566, 0, 573, 574, 0, 583, 584, 586, 565,
-	181, 196, 291, 0, 363, 253, 461, 441, 437, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 184, 197, 206, 216, 228,
-	243, 251, 261, 266, 269, 274, 275, 278, 283, 301,
-	306, 307, 308, 309, 326, 327, 328, 331, 334, 335,
-	338, 340, 341, 344, 351, 352, 353, 354, 355, 357,
-	364, 368, 376, 377, 378, 379, 380, 382, 383, 387,
-	388, 389, 390, 398, 402, 420, 421, 432, 445, 450,
-	262, 428, 451, 0, 300, 0, 0, 302, 247, 265,
-	276, 0, 440, 399, 201, 370, 254, 190, 219, 204,
-	226, 241, 244, 280, 310, 317, 346, 350, 259, 238,
-	217, 367, 214, 385, 405, 406, 407, 409, 314, 233,
-	349, 410, 0, 298, 411, 412, 272, 0, 0, 0,
-	0, 0, 0, 333, 0, 0, 0, 0, 564, 0,
-	0, 0, 237, 569, 0, 0, 0, 289, 234, 0,
-	0, 347, 0, 187, 0, 386, 222, 299, 296, 417,
-	248, 240, 236, 221, 273, 305, 345, 404, 339, 576,
-	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
-	571, 572, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 220, 186, 330, 396, 252, 0, 81, 0, 0,
-	178, 179, 180, 607, 614, 615, 616, 617, 618, 608,
-	610, 0, 0, 211, 609, 218, 585, 612, 619, 620,
-	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
-	203, 0, 0, 0, 0, 0, 0, 0, 0, 561,
-	0, 575, 0, 0, 0, 242, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	558, 559, 0, 0, 0, 0, 592, 0, 560, 0,
-	0, 568, 621, 622, 623, 624, 625, 626, 627, 628,
-	629, 630, 631, 632, 633, 634, 635, 636, 637, 638,
-	639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
-	649, 650, 651, 652, 653, 654, 655, 656, 657, 658,
-	659, 660, 661, 570, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 319, 0, 591, 0, 0,
-	447, 0, 0, 589, 0, 0, 0, 0, 288, 0,
-	285, 182, 199, 0, 0, 329, 369, 375, 0, 0,
-	0, 223, 0, 373, 343, 431, 207, 250, 366, 348,
-	371, 0, 0, 372, 294, 419, 361, 429, 448, 449,
-	230, 323, 438, 408, 444, 460, 200, 227, 337, 401,
-	434, 392, 316, 415, 416, 284, 391, 258, 185, 292,
-	454, 198, 381, 215, 205, 191, 403, 427, 212, 384,
-	0, 0, 462, 193, 425, 400, 312, 281, 282, 192,
-	0, 365, 235, 256, 225, 332, 422, 423, 224, 463,
-	202, 443, 195, 0, 442, 325, 418, 426, 313, 304,
-	194, 424, 311, 303, 287, 246, 267, 359, 297, 360,
-	268, 321, 320, 322, 188, 435, 0, 189, 0, 397,
-	436, 464, 208, 209, 210, 0, 245, 249, 255, 257,
-	263, 264, 271, 290, 336, 358, 356, 362, 0, 413,
-	430, 439, 446, 452, 453, 455, 456, 457, 458, 459,
-	324, 270, 393, 286, 295, 0, 0, 342, 374, 213,
-	433, 394, 598, 590, 580, 582, 599, 600, 577, 578,
-	581, 601, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 475, 476, 477, 478, 479, 480, 481, 482,
-	0, 593, 567, 566, 0, 573, 574, 0, 583, 584,
-	586, 565, 181, 196, 291, 0, 363, 253, 461, 441,
-	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 183, 184, 197, 206,
-	216, 228, 243, 251, 261, 266, 269, 274, 275, 278,
-	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
-	334, 335, 338, 340, 341, 344, 351, 352, 353, 354,
-	355, 357, 364, 368, 376, 377, 378, 379, 380, 382,
-	383, 387, 388, 389, 390, 398, 402, 420, 421, 432,
-	445, 450, 262, 428, 451, 0, 300, 0, 0, 302,
-	247, 265, 276, 0, 440, 399, 201, 370, 254, 190,
-	219, 204, 226, 241, 244, 280, 310, 317, 346, 350,
-	259, 238, 217, 367, 214, 385, 405, 406, 407, 409,
-	314, 233, 349, 410, 0, 298, 411, 412, 272, 0,
-	0, 0, 0, 0, 0, 333, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 289,
-	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
-	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
-	339, 0, 293, 0, 0, 395, 318, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 220, 186, 330, 396, 252, 0, 0,
-	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 211, 0, 218, 0, 0,
-	0, 0, 0, 232, 277, 239, 231, 414, 0, 0,
-	0, 0, 203, 0, 908, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 319, 0, 0,
-	0, 907, 447, 0, 0, 0, 0, 0, 904, 905,
-	288, 868, 285, 182, 199, 898, 902, 329, 369, 375,
-	0, 0, 0, 223, 0, 373, 343, 431, 207, 250,
-	366, 348, 371, 0, 0, 372, 294, 419, 361, 429,
-	448, 449, 230, 323, 438, 408, 444, 460, 200, 227,
-	337, 401, 434, 392, 316, 415, 416, 284, 391, 258,
-	185, 292, 454, 198, 381, 215, 205, 191, 403, 427,
-	212, 384, 0, 0, 462, 193, 425, 400, 312, 281,
-	282, 192, 0, 365, 235, 256, 225, 332, 422, 423,
-	224, 463, 202, 443, 195, 0, 442, 325, 418, 426,
-	313, 304, 194, 424, 311, 303, 287, 246, 267, 359,
-	297, 360, 268, 321, 320, 322, 188, 435, 0, 189,
-	0, 397, 436, 464, 208, 209, 210, 0, 245, 249,
-	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
-	0, 413, 430, 439, 446, 452, 453, 455, 456, 457,
-	458, 459, 324, 270, 393, 286, 295, 0, 0, 342,
-	374, 213, 433, 394, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
-	481, 482, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 483, 0, 181, 196, 291, 0, 363, 253,
-	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
-	197, 206, 216, 228, 243, 251, 261, 266, 269, 274,
-	275, 278, 283, 301, 306, 307, 308, 309, 326, 327,
-	328, 331, 334, 335, 338, 340, 341, 344, 351, 352,
-	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
-	380, 382, 383, 387, 388, 389, 390, 398, 402, 420,
-	421, 432, 445, 450, 262, 428, 451, 0, 300, 0,
-	0, 302, 247, 265, 276, 0, 440, 399, 201, 370,
-	254, 190, 219, 204, 226, 241, 244, 280, 310, 317,
-	346, 350, 259, 238, 217, 367, 214, 385, 405, 406,
-	407, 409, 314, 233, 349, 410, 0, 298, 411, 412,
-	272, 0, 0, 0, 0, 0, 0, 333, 0, 0,
-	0, 1202, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 289, 234, 0, 0, 347, 0, 187, 0, 386,
-	222, 299, 296, 417, 248, 240, 236, 221, 273, 305,
-	345, 404, 339, 0, 293, 0, 0, 395, 318, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 220, 186, 330, 396, 252,
-	0, 0, 0, 0, 178, 179, 180, 0, 1204, 0,
-	0, 0, 0, 0, 0, 0, 0, 211, 0, 218,
-	0, 0, 0, 0, 0, 232, 277, 239, 231, 414,
-	0, 0, 0, 0, 203, 0, 0, 0, 1071, 0,
-	1072, 1073, 0, 0, 0, 0, 0, 0, 0, 242,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 260, 0, 319,
-	0, 0, 0, 0, 447, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 182, 199, 0, 0, 329,
-	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
-	207, 250, 366, 348, 371, 0, 0, 372, 294, 419,
-	361, 429, 448, 449, 230, 323, 438, 408, 444, 460,
-	200, 227, 337, 401, 434, 392, 316, 415, 416, 284,
-	391, 258, 185, 292, 454, 198, 381, 215, 205, 191,
-	403, 427, 212, 384, 0, 0, 462, 193, 425, 400,
-	312, 281, 282, 192, 0, 365, 235, 256, 225, 332,
-	422, 423, 224, 463, 202, 443, 195, 0, 442, 325,
-	418, 426, 313, 304, 194, 424, 311, 303, 287, 246,
-	267, 359, 297, 360, 268, 321, 320, 322, 188, 435,
-	0, 189, 0, 397, 436, 464, 208, 209, 210, 0,
-	245, 249, 255, 257, 263, 264, 271, 290, 336, 358,
-	356, 362, 0, 413, 430, 439, 446, 452, 453, 455,
-	456, 457, 458, 459, 324, 270, 393, 286, 295, 0,
-	0, 342, 374, 213, 433, 394, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
-	479, 480, 481, 482, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 483, 0, 181, 196, 291, 0,
-	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	183, 184, 197, 206, 216, 228, 243, 251, 261, 266,
-	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
-	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
-	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
-	378, 379, 380, 382, 383, 387, 388, 389, 390, 398,
-	402, 420, 421, 432, 445, 450, 262, 428, 451, 0,
-	300, 0, 0, 302, 247, 265, 276, 0, 440, 399,
-	201, 370, 254, 190, 219, 204, 226, 241, 244, 280,
-	310, 317, 346, 350, 259, 238, 217, 367, 214, 385,
-	405, 406, 407, 409, 314, 233, 349, 410, 0, 298,
-	411, 412, 272, 0, 0, 0, 0, 0, 0, 333,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
-	0, 0, 0, 289, 234, 0, 0, 347, 0, 187,
-	0, 386, 222, 299, 296, 417, 248, 240, 236, 221,
-	273, 305, 345, 404, 339, 0, 293, 0, 0, 395,
-	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 220, 186, 330,
-	396, 252, 0, 0, 0, 0, 178, 179, 180, 1147,
-	1150, 0, 0, 0, 0, 1146, 1149, 0, 0, 211,
-	1145, 218, 0, 0, 0, 0, 0, 232, 277, 239,
-	231, 414, 0, 0, 0, 0, 203, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 319, 0, 0, 0, 0, 447, 0, 0, 0,
-	0, 0, 0, 0, 288, 0, 285, 182, 199, 0,
-	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
-	343, 431, 207, 250, 366, 348, 371, 0, 0, 372,
-	294, 419, 361, 429, 448, 449, 230, 323, 438, 408,
-	444, 460, 200, 227, 337, 401, 434, 392, 316, 415,
-	416, 284, 391, 258, 185, 292, 454, 198, 381, 215,
-	205, 191, 403, 427, 212, 384, 0, 0, 462, 193,
-	425, 400, 312, 281, 282, 192, 0, 365, 235, 256,
-	225, 332, 422, 423, 224, 463, 202, 443, 195, 0,
-	442, 325, 418, 426, 313, 304, 194, 424, 311, 303,
-	287, 246, 267, 359, 297, 360, 268, 321, 320, 322,
-	188, 435, 0, 189, 0, 397, 436, 464, 208, 209,
-	210, 0, 245, 249, 255, 257, 263, 264, 271, 290,
-	336, 358, 356, 362, 0, 413, 430, 439, 446, 452,
-	453, 455, 456, 457, 458, 459, 324, 270, 393, 286,
-	295, 0, 0, 342, 374, 213, 433, 394, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 478, 479, 480, 481, 482, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 483, 0, 181, 196,
-	291, 0, 363, 253, 461, 441, 437, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 183, 184, 197, 206, 216, 228, 243, 251,
-	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
-	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
-	341, 344, 351, 352, 353, 354, 355, 357, 364, 368,
-	376, 377, 378, 379, 380, 382, 383, 387, 388, 389,
-	390, 398, 402, 420, 421, 432, 445, 450, 262, 428,
-	451, 0, 300, 0, 0, 302, 247, 265, 276, 0,
-	440, 399, 201, 370, 254, 190, 219, 204, 226, 241,
-	244, 280, 310, 317, 346, 350, 259, 238, 217, 367,
-	214, 385, 405, 406, 407, 409, 314, 233, 349, 72,
-	410, 298, 411, 412, 272, 0, 0, 0, 0, 0,
-	0, 0, 333, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 289, 234, 0, 0,
-	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
-	240, 236, 221, 273, 305, 345, 404, 339, 0, 293,
-	0, 0, 395, 318, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	220, 186, 330, 396, 252, 0, 81, 0, 1182, 178,
-	179, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 211, 0, 218, 0, 0, 0, 0, 0,
-	232, 277, 239, 231, 414, 0, 0, 0, 0, 203,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 319, 0, 0, 0, 0, 447,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	182, 199, 0, 0, 329, 369, 375, 0, 0, 0,
-	223, 0, 373, 343, 431, 207, 250, 366, 348, 371,
-	0, 0, 372, 294, 419, 361, 429, 448, 449, 230,
-	323, 438, 408, 444, 460, 200, 227, 337, 401, 434,
-	392, 316, 415, 416, 284, 391, 258, 185, 292, 454,
-	198, 381, 215, 205, 191, 403, 427, 212, 384, 0,
-	0, 462, 193, 425, 400, 312, 281, 282, 192, 0,
-	365, 235, 256, 225, 332, 422, 423, 224, 463, 202,
-	443, 195, 0, 442, 325, 418, 426, 313, 304, 194,
-	424, 311, 303, 287, 246, 267, 359, 297, 360, 268,
-	321, 320, 322, 188, 435, 0, 189, 0, 397, 436,
-	464, 208, 209, 210, 0, 245, 249, 255, 257, 263,
-	264, 271, 290, 336, 358, 356, 362, 0, 413, 430,
-	439, 446, 452, 453, 455, 456, 457, 458, 459, 324,
-	270, 393, 286, 295, 0, 0, 342, 374, 213, 433,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 481, 482, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 483,
-	0, 181, 196, 291, 80, 363, 253, 461, 441, 437,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 183, 184, 197, 206, 216,
-	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
-	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
-	335, 338, 340, 341, 344, 351, 352, 353, 354, 355,
-	357, 364, 368, 376, 377, 378, 379, 380, 382, 383,
-	387, 388, 389, 390, 398, 402, 420, 421, 432, 445,
-	450, 262, 428, 451, 0, 300, 0, 0, 302, 247,
-	265, 276, 0, 440, 399, 201, 370, 254, 190, 219,
-	204, 226, 241, 244, 280, 310, 317, 346, 350, 259,
-	238, 217, 367, 214, 385, 405, 406, 407, 409, 314,
-	233, 349, 72, 410, 298, 411, 412, 272, 0, 0,
-	0, 0, 0, 0, 0, 333, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 289,
-	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
-	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
-	339, 0, 293, 0, 0, 395, 318, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 220, 186, 330, 396, 252, 0, 81,
-	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 211, 0, 218, 0, 0,
-	0, 0, 0, 232, 277, 239, 231, 414, 0, 0,
-	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 319, 0, 0,
-	0, 0, 447, 0, 0, 0, 0, 0, 0, 0,
-	288, 0, 285, 182, 199, 0, 0, 329, 369, 375,
-	0, 0, 0, 223, 0, 373, 343, 431, 207, 250,
-	366, 348, 371, 0, 0, 372, 294, 419, 361, 429,
-	448, 449, 230, 323, 438, 408, 444, 460, 200, 227,
-	337, 401, 434, 392, 316, 415, 416, 284, 391, 258,
-	185, 292, 454, 198, 381, 215, 205, 191, 403, 427,
-	212, 384, 0, 0, 462, 193, 425, 400, 312, 281,
-	282, 192, 0, 365, 235, 256, 225, 332, 422, 423,
-	224, 463, 202, 443, 195, 0, 442, 325, 418, 426,
-	313, 304, 194, 424, 311, 303, 287, 246, 267, 359,
-	297, 360, 268, 321, 320, 322, 188, 435, 0, 189,
-	0, 397, 436, 464, 208, 209, 210, 0, 245, 249,
-	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
-	0, 413, 430, 439, 446, 452, 453, 455, 456, 457,
-	458, 459, 324, 270, 393, 286, 295, 0, 0, 342,
-	374, 213, 433, 394, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
-	481, 482, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 483, 0, 181, 196, 291, 80, 363, 253,
-	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
-	197, 206, 216, 228, 243, 251, 261, 266, 269, 274,
-	275, 278, 283, 301, 306, 307, 308, 309, 326, 327,
-	328, 331, 334, 335, 338, 340, 341, 344, 351, 352,
-	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
-	380, 382, 383, 387, 388, 389, 390, 398, 402, 420,
-	421, 432, 445, 450, 262, 428, 451, 0, 300, 0,
-	0, 302, 247, 265, 276, 0, 440, 399, 201, 370,
-	254, 190, 219, 204, 226, 241, 244, 280, 310, 317,
-	346, 350, 259, 238, 217, 367, 214, 385, 405, 406,
-	407, 409, 314, 233, 349, 410, 0, 298, 411, 412,
-	272, 0, 0, 0, 0, 0, 0, 333, 0, 0,
-	0, 1580, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 289, 234, 0, 0, 347, 0, 187, 0, 386,
-	222, 299, 296, 417, 248, 240, 236, 221, 273, 305,
-	345, 404, 339, 0, 293, 0, 0, 395, 318, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 220, 186, 330, 396, 252,
-	0, 0, 0, 0, 178, 179, 180, 0, 1374, 0,
-	0, 0, 0, 0, 0, 0, 0, 211, 0, 218,
-	0, 0, 0, 0, 0, 232, 277, 239, 231, 414,
-	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 260, 0, 319,
-	0, 0, 0, 0, 447, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 182, 199, 0, 0, 329,
-	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
-	207, 250, 366, 348, 371, 0, 1578, 372, 294, 419,
-	361, 429, 448, 449, 230, 323, 438, 408, 444, 460,
-	200, 227, 337, 401, 434, 392, 316, 415, 416, 284,
-	391, 258, 185, 292, 454, 198, 381, 215, 205, 191,
-	403, 427, 212, 384, 0, 0, 462, 193, 425, 400,
-	312, 281, 282, 192, 0, 365, 235, 256, 225, 332,
-	422, 423, 224, 463, 202, 443, 195, 0, 442, 325,
-	418, 426, 313, 304, 194, 424, 311, 303, 287, 246,
-	267, 359, 297, 360, 268, 321, 320, 322, 188, 435,
-	0, 189, 0, 397, 436, 464, 208, 209, 210, 0,
-	245, 249, 255, 257, 263, 264, 271, 290, 336, 358,
-	356, 362, 0, 413, 430, 439, 446, 452, 453, 455,
-	456, 457, 458, 459, 324, 270, 393, 286, 295, 0,
-	0, 342, 374, 213, 433, 394, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
-	479, 480, 481, 482, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 483, 0, 181, 196, 291, 0,
-	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	183, 184, 197, 206, 216, 228, 243, 251, 261, 266,
-	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
-	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
-	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
-	378, 379, 380, 382, 383, 387, 388, 389, 390, 398,
-	402, 420, 421, 432, 445, 450, 262, 428, 451, 0,
-	300, 0, 0, 302, 247, 265, 276, 0, 440, 399,
-	201, 370, 254, 190, 219, 204, 226, 241, 244, 280,
-	310, 317, 346, 350, 259, 238, 217, 367, 214, 385,
-	405, 406, 407, 409, 314, 233, 349, 410, 0, 298,
-	411, 412, 272, 0, 0, 0, 0, 0, 0, 333,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
-	0, 0, 0, 289, 234, 0, 0, 347, 0, 187,
-	0, 386, 222, 299, 296, 417, 248, 240, 236, 221,
-	273, 305, 345, 404, 339, 0, 293, 0, 0, 395,
-	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 220, 186, 330,
-	396, 252, 0, 0, 0, 0, 178, 179, 180, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 211,
-	0, 218, 0, 0, 0, 0, 0, 232, 277, 239,
-	231, 414, 0, 0, 0, 0, 203, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
-	862, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 319, 0, 0, 0, 0, 447, 0, 0, 0,
-	0, 0, 0, 0, 288, 868, 285, 182, 199, 866,
-	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
-	343, 431, 207, 250, 366, 348, 371, 0, 0, 372,
-	294, 419, 361, 429, 448, 449, 230, 323, 438, 408,
-	444, 460, 200, 227, 337, 401, 434, 392, 316, 415,
-	416, 284, 391, 258, 185, 292, 454, 198, 381, 215,
-	205, 191, 403, 427, 212, 384, 0, 0, 462, 193,
-	425, 400, 312, 281, 282, 192, 0, 365, 235, 256,
-	225, 332, 422, 423, 224, 463, 202, 443, 195, 0,
-	442, 325, 418, 426, 313, 304, 194, 424, 311, 303,
-	287, 246, 267, 359, 297, 360, 268, 321, 320, 322,
-	188, 435, 0, 189, 0, 397, 436, 464, 208, 209,
-	210, 0, 245, 249, 255, 257, 263, 264, 271, 290,
-	336, 358, 356, 362, 0, 413, 430, 439, 446, 452,
-	453, 455, 456, 457, 458, 459, 324, 270, 393, 286,
-	295, 0, 0, 342, 374, 213, 433, 394, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 478, 479, 480, 481, 482, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 483, 0, 181, 196,
-	291, 0, 363, 253, 461, 441, 437, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 183, 184, 197, 206, 216, 228, 243, 251,
-	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
-	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
-	341, 344, 351, 352, 353, 354, 355, 357, 364, 368,
-	376, 377, 378, 379, 380, 382, 383, 387, 388, 389,
-	390, 398, 402, 420, 421, 432, 445, 450, 262, 428,
-	451, 0, 300, 0, 0, 302, 247, 265, 276, 0,
-	440, 399, 201, 370, 254, 190, 219, 204, 226, 241,
-	244, 280, 310, 317, 346, 350, 259, 238, 217, 367,
-	214, 385, 405, 406, 407, 409, 314, 233, 349, 410,
-	0, 298, 411, 412, 272, 0, 0, 0, 0, 0,
-	0, 333, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 0, 0, 0, 0, 289, 234, 0, 0, 347,
-	0, 187, 0, 386, 222, 299, 296, 417, 248, 240,
-	236, 221, 273, 305, 345, 404, 339, 0, 293, 0,
-	0, 395, 318, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 220,
-	186, 330, 396, 252, 0, 0, 0, 1182, 178, 179,
-	180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 211, 0, 218, 0, 0, 0, 0, 0, 232,
-	277, 239, 231, 414, 0, 0, 0, 0, 203, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 260, 0, 319, 0, 0, 0, 0, 447, 0,
-	0, 0, 2413, 0, 0, 0, 288, 0, 285, 182,
-	199, 0, 0, 329, 369, 375, 0, 0, 0, 223,
-	0, 373, 343, 431, 207, 250, 366, 348, 371, 0,
-	0, 372, 294, 419, 361, 429, 448, 449, 230, 323,
-	438, 408, 444, 460, 200, 227, 337, 401, 434, 392,
-	316, 415, 416, 284, 391, 258, 185, 292, 454, 198,
-	381, 215, 205, 191, 403, 427, 212, 384, 0, 0,
-	462, 193, 425, 400, 312, 281, 282, 192, 0, 365,
-	235, 256, 225, 332, 422, 423, 224, 463, 202, 443,
-	195, 0, 442, 325, 418, 426, 313, 304, 194, 424,
-	311, 303, 287, 246, 267, 359, 297, 360, 268, 321,
-	320, 322, 188, 435, 0, 189, 0, 397, 436, 464,
-	208, 209, 210, 0, 245, 249, 255, 257, 263, 264,
-	271, 290, 336, 358, 356, 362, 0, 413, 430, 439,
-	446, 452, 453, 455, 456, 457, 458, 459, 324, 270,
-	393, 286, 295, 0, 0, 342, 374, 213, 433, 394,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 478, 479, 480, 481, 482, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 483, 0,
-	181, 196, 291, 0, 363, 253, 461, 441, 437, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 184, 197, 206, 216, 228,
-	243, 251, 261, 266, 269, 274, 275, 278, 283, 301,
-	306, 307, 308, 309, 326, 327, 328, 331, 334, 335,
-	338, 340, 341, 344, 351, 352, 353, 354, 355, 357,
-	364, 368, 376, 377, 378, 379, 380, 382, 383, 387,
-	388, 389, 390, 398, 402, 420, 421, 432, 445, 450,
-	262, 428, 451, 0, 300, 0, 0, 302, 247, 265,
-	276, 0, 440, 399, 201, 370, 254, 190, 219, 204,
-	226, 241, 244, 280, 310, 317, 346, 350, 259, 238,
-	217, 367, 214, 385, 405, 406, 407, 409, 314, 233,
-	349, 410, 0, 298, 411, 412, 272, 0, 0, 0,
-	0, 0, 0, 333, 0, 0, 0, 1580, 0, 0,
-	0, 0, 237, 0, 0, 0, 0, 289, 234, 0,
-	0, 347, 0, 187, 0, 386, 222, 299, 296, 417,
-	248, 240, 236, 221, 273, 305, 345, 404, 339, 0,
-	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 220, 186, 330, 396, 252, 0, 0, 0, 0,
-	178, 179, 180, 0, 1374, 0, 0, 0, 0, 0,
-	0, 0, 0, 211, 0, 218, 0, 0, 0, 0,
-	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
-	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 319, 0, 0, 0, 0,
-	447, 0, 0, 0, 0, 0, 0, 0, 288, 0,
-	285, 182, 199, 0, 0, 329, 369, 375, 0, 0,
-	0, 223, 0, 373, 343, 431, 207, 250, 366, 348,
-	371, 0, 0, 372, 294, 419, 361, 429, 448, 449,
-	230, 323, 438, 408, 444, 460, 200, 227, 337, 401,
-	434, 392, 316, 415, 416, 284, 391, 258, 185, 292,
-	454, 198, 381, 215, 205, 191, 403, 427, 212, 384,
-	0, 0, 462, 193, 425, 400, 312, 281, 282, 192,
-	0, 365, 235, 256, 225, 332, 422, 423, 224, 463,
-	202, 443, 195, 0, 442, 325, 418, 426, 313, 304,
-	194, 424, 311, 303, 287, 246, 267, 359, 297, 360,
-	268, 321, 320, 322, 188, 435, 0, 189, 0, 397,
-	436, 464, 208, 209, 210, 0, 245, 249, 255, 257,
-	263, 264, 271, 290, 336, 358, 356, 362, 0, 413,
-	430, 439, 446, 452, 453, 455, 456, 457, 458, 459,
-	324, 270, 393, 286, 295, 0, 0, 342, 374, 213,
-	433, 394, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 475, 476, 477, 478, 479, 480, 481, 482,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	483, 0, 181, 196, 291, 0, 363, 253, 461, 441,
-	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 183, 184, 197, 206,
-	216, 228, 243, 251, 261, 266, 269, 274, 275, 278,
-	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
-	334, 335, 338, 340, 341, 344, 351, 352, 353, 354,
-	355, 357, 364, 368, 376, 377, 378, 379, 380, 382,
-	383, 387, 388, 389, 390, 398, 402, 420, 421, 432,
-	445, 450, 262, 428, 451, 0, 300, 0, 0, 302,
-	247, 265, 276, 0, 440, 399, 201, 370, 254, 190,
-	219, 204, 226, 241, 244, 280, 310, 317, 346, 350,
-	259, 238, 217, 367, 214, 385, 405, 406, 407, 409,
-	314, 233, 349, 410, 0, 298, 411, 412, 272, 0,
-	0, 0, 0, 0, 0, 333, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 289,
-	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
-	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
-	339, 0, 293, 0, 0, 395, 318, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 220, 186, 330, 396, 252, 0, 0,
-	0, 0, 178, 179, 180, 0, 1854, 0, 0, 0,
-	0, 0, 0, 0, 0, 211, 0, 218, 0, 0,
-	0, 0, 0, 232, 277, 239, 231, 414, 0, 0,
-	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1855, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 319, 0, 0,
-	0, 0, 447, 0, 0, 0, 0, 0, 0, 0,
-	288, 0, 285, 182, 199, 0, 0, 329, 369, 375,
-	0, 0, 0, 223, 0, 373, 343, 431, 207, 250,
-	366, 348, 371, 0, 0, 372, 294, 419, 361, 429,
-	448, 449, 230, 323, 438, 408, 444, 460, 200, 227,
-	337, 401, 434, 392, 316, 415, 416, 284, 391, 258,
-	185, 292, 454, 198, 381, 215, 205, 191, 403, 427,
-	212, 384, 0, 0, 462, 193, 425, 400, 312, 281,
-	282, 192, 0, 365, 235, 256, 225, 332, 422, 423,
-	224, 463, 202, 443, 195, 0, 442, 325, 418, 426,
-	313, 304, 194, 424, 311, 303, 287, 246, 267, 359,
-	297, 360, 268, 321, 320, 322, 188, 435, 0, 189,
-	0, 397, 436, 464, 208, 209, 210, 0, 245, 249,
-	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
-	0, 413, 430, 439, 446, 452, 453, 455, 456, 457,
-	458, 459, 324, 270, 393, 286, 295, 0, 0, 342,
-	374, 213, 433, 394, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
-	481, 482, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 483, 0, 181, 196, 291, 0, 363, 253,
-	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
-	197, 206, 216, 228, 243, 251, 261, 266, 269, 274,
-	275, 278, 283, 301, 306, 307, 308, 309, 326, 327,
-	328, 331, 334, 335, 338, 340, 341, 344, 351, 352,
-	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
-	380, 382, 383, 387, 388, 389, 390, 398, 402, 420,
-	421, 432, 445, 450, 262, 428, 451, 0, 300, 0,
-	0, 302, 247, 265, 276, 0, 440, 399, 201, 370,
-	254, 190, 219, 204, 226, 241, 244, 280, 310, 317,
-	346, 350, 259, 238, 217, 367, 214, 385, 405, 406,
-	407, 409, 314, 233, 349, 410, 0, 298, 411, 412,
-	272, 0, 0, 0, 0, 0, 0, 333, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 289, 234, 0, 0, 347, 0, 187, 0, 386,
-	222, 299, 296, 417, 248, 240, 236, 221, 273, 305,
-	345, 404, 339, 0, 293, 0, 0, 395, 318, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 220, 186, 330, 396, 252,
-	0, 0, 0, 0, 178, 179, 180, 0, 0, 0,
-	1839, 0, 0, 0, 1840, 0, 0, 211, 0, 218,
-	0, 0, 0, 0, 0, 232, 277, 239, 231, 414,
-	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 260, 0, 319,
-	0, 0, 0, 0, 447, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 182, 199, 0, 0, 329,
-	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
-	207, 250, 366, 348, 371, 0, 0, 372, 294, 419,
-	361, 429, 448, 449, 230, 323, 438, 408, 444, 460,
-	200, 227, 337, 401, 434, 392, 316, 415, 416, 284,
-	391, 258, 185, 292, 454, 198, 381, 215, 205, 191,
-	403, 427, 212, 384, 0, 0, 462, 193, 425, 400,
-	312, 281, 282, 192, 0, 365, 235, 256, 225, 332,
-	422, 423, 224, 463, 202, 443, 195, 0, 442, 325,
-	418, 426, 313, 304, 194, 424, 311, 303, 287, 246,
-	267, 359, 297, 360, 268, 321, 320, 322, 188, 435,
-	0, 189, 0, 397, 436, 464, 208, 209, 210, 0,
-	245, 249, 255, 257, 263, 264, 271, 290, 336, 358,
-	356, 362, 0, 413, 430, 439, 446, 452, 453, 455,
-	456, 457, 458, 459, 324, 270, 393, 286, 295, 0,
-	0, 342, 374, 213, 433, 394, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
-	479, 480, 481, 482, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 483, 0, 181, 196, 291, 0,
-	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	183, 184, 197, 206, 216, 228, 243, 251, 261, 266,
-	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
-	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
-	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
-	378, 379, 380, 382, 383, 387, 388, 389, 390, 398,
-	402, 420, 421, 432, 445, 450, 262, 428, 451, 0,
-	300, 0, 0, 302, 247, 265, 276, 0, 440, 399,
-	201, 370, 254, 190, 219, 204, 226, 241, 244, 280,
-	310, 317, 346, 350, 259, 238, 217, 367, 214, 385,
-	405, 406, 407, 409, 314, 233, 349, 410, 0, 298,
-	411, 412, 272, 0, 0, 0, 0, 0, 0, 333,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 1225,
-	0, 0, 0, 289, 234, 0, 0, 347, 0, 187,
-	0, 386, 222, 299, 296, 417, 248, 240, 236, 221,
-	273, 305, 345, 404, 339, 0, 293, 0, 0, 395,
-	318, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 220, 186, 330,
-	396, 252, 0, 0, 0, 0, 178, 179, 180, 0,
-	1224, 0, 0, 0, 0, 0, 0, 0, 0, 211,
-	0, 218, 0, 0, 0, 0, 0, 232, 277, 239,
-	231, 414, 0, 0, 0, 0, 203, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 260,
-	0, 319, 0, 0, 0, 0, 447, 0, 0, 0,
-	0, 0, 0, 0, 288, 0, 285, 182, 199, 0,
-	0, 329, 369, 375, 0, 0, 0, 223, 0, 373,
-	343, 431, 207, 250, 366, 348, 371, 0, 0, 372,
-	294, 419, 361, 429, 448, 449, 230, 323, 438, 408,
-	444, 460, 200, 227, 337, 401, 434, 392, 316, 415,
-	416, 284, 391, 258, 185, 292, 454, 198, 381, 215,
-	205, 191, 403, 427, 212, 384, 0, 0, 462, 193,
-	425, 400, 312, 281, 282, 192, 0, 365, 235, 256,
-	225, 332, 422, 423, 224, 463, 202, 443, 195, 0,
-	442, 325, 418, 426, 313, 304, 194, 424, 311, 303,
-	287, 246, 267, 359, 297, 360, 268, 321, 320, 322,
-	188, 435, 0, 189, 0, 397, 436, 464, 208, 209,
-	210, 0, 245, 249, 255, 257, 263, 264, 271, 290,
-	336, 358, 356, 362, 0, 413, 430, 439, 446, 452,
-	453, 455, 456, 457, 458, 459, 324, 270, 393, 286,
-	295, 0, 0, 342, 374, 213, 433, 394, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 465, 466,
-	467, 468, 469, 470, 471, 472, 473, 474, 475, 476,
-	477, 478, 479, 480, 481, 482, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 483, 0, 181, 196,
-	291, 0, 363, 253, 461, 441, 437, 0, 0, 229,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 315, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 183, 184, 197, 206, 216, 228, 243, 251,
-	261, 266, 269, 274, 275, 278, 283, 301, 306, 307,
-	308, 309, 326, 327, 328, 331, 334, 335, 338, 340,
-	341, 344, 351, 352, 353, 354, 355, 357, 364, 368,
-	376, 377, 378, 379, 380, 382, 383, 387, 388, 389,
-	390, 398, 402, 420, 421, 432, 445, 450, 262, 428,
-	451, 0, 300, 0, 0, 302, 247, 265, 276, 0,
-	440, 399, 201, 370, 254, 190, 219, 204, 226, 241,
-	244, 280, 310, 317, 346, 350, 259, 238, 217, 367,
-	214, 385, 405, 406, 407, 409, 314, 233, 349, 410,
-	0, 298, 411, 412, 272, 0, 0, 0, 0, 0,
-	0, 333, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 0, 0, 0, 0, 289, 234, 0, 0, 347,
-	0, 187, 0, 386, 222, 299, 296, 417, 248, 240,
-	236, 221, 273, 305, 345, 404, 339, 0, 293, 0,
-	0, 395, 318, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 220,
-	186, 330, 396, 252, 0, 0, 0, 0, 178, 179,
-	180, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 211, 0, 218, 0, 0, 0, 0, 0, 232,
-	277, 239, 231, 414, 0, 0, 0, 0, 203, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 260, 0, 319, 0, 0, 0, 0, 447, 0,
-	0, 0, 2481, 0, 0, 0, 288, 0, 285, 182,
-	199, 0, 0, 329, 369, 375, 0, 0, 0, 223,
-	0, 373, 343, 431, 207, 250, 366, 348, 371, 0,
-	0, 372, 294, 419, 361, 429, 448, 449, 230, 323,
-	438, 408, 444, 460, 200, 227, 337, 401, 434, 392,
-	316, 415, 416, 284, 391, 258, 185, 292, 454, 198,
-	381, 215, 205, 191, 403, 427, 212, 384, 0, 0,
-	462, 193, 425, 400, 312, 281, 282, 192, 0, 365,
-	235, 256, 225, 332, 422, 423, 224, 463, 202, 443,
-	195, 0, 442, 325, 418, 426, 313, 304, 194, 424,
-	311, 303, 287, 246, 267, 359, 297, 360, 268, 321,
-	320, 322, 188, 435, 0, 189, 0, 397, 436, 464,
-	208, 209, 210, 0, 245, 249, 255, 257, 263, 264,
-	271, 290, 336, 358, 356, 362, 0, 413, 430, 439,
-	446, 452, 453, 455, 456, 457, 458, 459, 324, 270,
-	393, 286, 295, 0, 0, 342, 374, 213, 433, 394,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	465, 466, 467, 468, 469, 470, 471, 472, 473, 474,
-	475, 476, 477, 478, 479, 480, 481, 482, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 483, 0,
-	181, 196, 291, 0, 363, 253, 461, 441, 437, 0,
-	0, 229, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 315, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 183, 184, 197, 206, 216, 228,
-	243, 251, 261, 266, 269, 274, 275, 278, 283, 301,
-	306, 307, 308, 309, 326, 327, 328, 331, 334, 335,
-	338, 340, 341, 344, 351, 352, 353, 354, 355, 357,
-	364, 368, 376, 377, 378, 379, 380, 382, 383, 387,
-	388, 389, 390, 398, 402, 420, 421, 432, 445, 450,
-	262, 428, 451, 0, 300, 0, 0, 302, 247, 265,
-	276, 0, 440, 399, 201, 370, 254, 190, 219, 204,
-	226, 241, 244, 280, 310, 317, 346, 350, 259, 238,
-	217, 367, 214, 385, 405, 406, 407, 409, 314, 233,
-	349, 410, 0, 298, 411, 412, 272, 0, 0, 0,
-	0, 0, 0, 333, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 0, 0, 0, 0, 289, 234, 0,
-	0, 347, 0, 187, 0, 386, 222, 299, 296, 417,
-	248, 240, 236, 221, 273, 305, 345, 404, 339, 0,
-	293, 0, 0, 395, 318, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 220, 186, 330, 396, 252, 0, 0, 0, 0,
-	178, 179, 180, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 211, 0, 218, 0, 0, 0, 0,
-	0, 232, 277, 239, 231, 414, 0, 0, 0, 0,
-	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 260, 0, 319, 0, 0, 0, 0,
-	447, 0, 0, 0, 2413, 0, 0, 0, 288, 0,
-	285, 182, 199, 0, 0, 329, 369, 375, 0, 0,
-	0, 223, 0, 373, 343, 431, 207, 250, 366, 348,
-	371, 0, 0, 372, 294, 419, 361, 429, 448, 449,
-	230, 323, 438, 408, 444, 460, 200, 227, 337, 401,
-	434, 392, 316, 415, 416, 284, 391, 258, 185, 292,
-	454, 198, 381, 215, 205, 191, 403, 427, 212, 384,
-	0, 0, 462, 193, 425, 400, 312, 281, 282, 192,
-	0, 365, 235, 256, 225, 332, 422, 423, 224, 463,
-	202, 443, 195, 0, 442, 325, 418, 426, 313, 304,
-	194, 424, 311, 303, 287, 246, 267, 359, 297, 360,
-	268, 321, 320, 322, 188, 435, 0, 189, 0, 397,
-	436, 464, 208, 209, 210, 0, 245, 249, 255, 257,
-	263, 264, 271, 290, 336, 358, 356, 362, 0, 413,
-	430, 439, 446, 452, 453, 455, 456, 457, 458, 459,
-	324, 270, 393, 286, 295, 0, 0, 342, 374, 213,
-	433, 394, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 465, 466, 467, 468, 469, 470, 471, 472,
+	661, 662, 663, 664, 665, 666, 667, 668, 669, 578,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
+	0, 323, 0, 599, 0, 0, 454, 0, 0, 597,
+	0, 0, 0, 0, 291, 0, 288, 184, 201, 0,
+	0, 333, 373, 380, 0, 0, 0, 226, 0, 378,
+	347, 438, 209, 253, 370, 352, 376, 0, 0, 377,
+	297, 426, 365, 436, 455, 456, 233, 327, 445, 415,
+	451, 467, 202, 230, 341, 408, 441, 399, 320, 422,
+	423, 287, 398, 261, 187, 295, 461, 200, 386, 217,
+	207, 313, 374, 193, 410, 434, 214, 389, 0, 0,
+	469, 195, 432, 407, 316, 284, 285, 194, 0, 369,
+	238, 259, 228, 336, 429, 430, 227, 470, 204, 450,
+	197, 0, 449, 329, 425, 433, 317, 307, 196, 431,
+	315, 306, 290, 249, 270, 363, 300, 364, 271, 325,
+	324, 326, 190, 442, 0, 191, 0, 404, 443, 471,
+	210, 211, 212, 0, 248, 252, 258, 260, 266, 267,
+	274, 293, 340, 362, 360, 366, 0, 420, 437, 446,
+	453, 459, 460, 462, 463, 464, 465, 466, 328, 273,
+	400, 289, 298, 0, 0, 346, 379, 215, 440, 401,
+	606, 598, 588, 590, 607, 608, 585, 586, 589, 609,
+	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
+	482, 483, 484, 485, 486, 487, 488, 489, 0, 601,
+	575, 574, 0, 581, 582, 0, 591, 592, 594, 573,
+	183, 198, 294, 0, 367, 256, 468, 448, 444, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 185, 186, 199, 208, 218, 231,
+	246, 254, 264, 269, 272, 277, 278, 281, 286, 304,
+	309, 310, 311, 312, 330, 331, 332, 335, 338, 339,
+	342, 344, 345, 348, 355, 356, 357, 358, 359, 361,
+	368, 372, 381, 382, 383, 384, 385, 387, 388, 394,
+	395, 396, 397, 405, 409, 427, 428, 439, 452, 457,
+	224, 390, 392, 265, 435, 458, 0, 303, 0, 0,
+	305, 250, 268, 279, 0, 447, 406, 203, 375, 257,
+	192, 221, 206, 229, 244, 247, 283, 314, 321, 350,
+	354, 262, 241, 219, 371, 216, 391, 412, 413, 414,
+	416, 318, 236, 353, 417, 0, 301, 418, 419, 275,
+	0, 0, 0, 0, 0, 0, 337, 0, 0, 0,
+	0, 572, 0, 0, 0, 240, 577, 0, 0, 0,
+	292, 237, 0, 0, 351, 0, 189, 0, 393, 225,
+	302, 299, 424, 251, 243, 239, 223, 276, 308, 349,
+	411, 343, 584, 296, 0, 0, 402, 322, 0, 0,
+	0, 0, 0, 579, 580, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 222, 188, 334, 403, 255, 0,
+	83, 0, 0, 180, 181, 182, 615, 622, 623, 624,
+	625, 626, 616, 618, 0, 0, 213, 617, 220, 593,
+	620, 627, 628, 0, 235, 280, 242, 234, 421, 0,
+	0, 0, 0, 205, 0, 0, 0, 0, 0, 0,
+	0, 555, 569, 0, 583, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 566, 567, 0, 0, 0, 0, 600,
+	0, 568, 0, 0, 576, 629, 630, 631, 632, 633,
+	634, 635, 636, 637, 638, 639, 640, 641, 642, 643,
+	644, 645, 646, 647, 648, 649, 650, 651, 652, 653,
+	654, 655, 656, 657, 658, 659, 660, 661, 662, 663,
+	664, 665, 666, 667, 668, 669, 578, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 263, 0, 323, 0,
+	599, 0, 0, 454, 0, 0, 597, 0, 0, 0,
+	0, 291, 0, 288, 184, 201, 0, 0, 333, 373,
+	380, 0, 0, 0, 226, 0, 378, 347, 438, 209,
+	253, 370, 352, 376, 0, 0, 377, 297, 426, 365,
+	436, 455, 456, 233, 327, 445, 415, 451, 467, 202,
+	230, 341, 408, 441, 399, 320, 422, 423, 287, 398,
+	261, 187, 295, 461, 200, 386, 217, 207, 313, 374,
+	193, 410, 434, 214, 389, 0, 0, 469, 195, 432,
+	407, 316, 284, 285, 194, 0, 369, 238, 259, 228,
+	336, 429, 430, 227, 470, 204, 450, 197, 0, 449,
+	329, 425, 433, 317, 307, 196, 431, 315, 306, 290,
+	249, 270, 363, 300, 364, 271, 325, 324, 326, 190,
+	442, 0, 191, 0, 404, 443, 471, 210, 211, 212,
+	0, 248, 252, 258, 260, 266, 267, 274, 293, 340,
+	362, 360, 366, 0, 420, 437, 446, 453, 459, 460,
+	462, 463, 464, 465, 466, 328, 273, 400, 289, 298,
+	0, 0, 346, 379, 215, 440, 401, 606, 598, 588,
+	590, 607, 608, 585, 586, 589, 609, 472, 473, 474,
+	475, 476, 477, 478, 479, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 0, 601, 575, 574, 0,
+	581, 582, 0, 591, 592, 594, 573, 183, 198, 294,
+	0, 367, 256, 468, 448, 444, 0, 0, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	319, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 185, 186, 199, 208, 218, 231, 246, 254, 264,
+	269, 272, 277, 278, 281, 286, 304, 309, 310, 311,
+	312, 330, 331, 332, 335, 338, 339, 342, 344, 345,
+	348, 355, 356, 357, 358, 359, 361, 368, 372, 381,
+	382, 383, 384, 385, 387, 388, 394, 395, 396, 397,
+	405, 409, 427, 428, 439, 452, 457, 224, 390, 392,
+	265, 435, 458, 0, 303, 0, 0, 305, 250, 268,
+	279, 0, 447, 406, 203, 375, 257, 192, 221, 206,
+	229, 244, 247, 283, 314, 321, 350, 354, 262, 241,
+	219, 371, 216, 391, 412, 413, 414, 416, 318, 236,
+	353, 417, 0, 301, 418, 419, 275, 0, 0, 0,
+	0, 0, 0, 337, 0, 0, 0, 0, 572, 0,
+	0, 0, 240, 577, 0, 0, 0, 292, 237, 0,
+	0, 351, 0, 189, 0, 393, 225, 302, 299, 424,
+	251, 243, 239, 223, 276, 308, 349, 411, 343, 584,
+	296, 0, 0, 402, 322, 0, 0, 0, 0, 0,
+	579, 580, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 222, 188, 334, 403, 255, 0, 83, 0, 0,
+	180, 181, 182, 615, 622, 623, 624, 625, 626, 616,
+	618, 0, 0, 213, 617, 220, 593, 620, 627, 628,
+	0, 235, 280, 242, 234, 421, 0, 0, 0, 0,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 569,
+	0, 583, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	566, 567, 0, 0, 0, 0, 600, 0, 568, 0,
+	0, 576, 629, 630, 631, 632, 633, 634, 635, 636,
+	637, 638, 639, 640, 641, 642, 643, 644, 645, 646,
+	647, 648, 649, 650, 651, 652, 653, 654, 655, 656,
+	657, 658, 659, 660, 661, 662, 663, 664, 665, 666,
+	667, 668, 669, 578, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 263, 0, 323, 0, 599, 0, 0,
+	454, 0, 0, 597, 0, 0, 0, 0, 291, 0,
+	288, 184, 201, 0, 0, 333, 373, 380, 0, 0,
+	0, 226, 0, 378, 347, 438, 209, 253, 370, 352,
+	376, 0, 0, 377, 297, 426, 365, 436, 455, 456,
+	233, 327, 445, 415, 451, 467, 202, 230, 341, 408,
+	441, 399, 320, 422, 423, 287, 398, 261, 187, 295,
+	461, 200, 386, 217, 207, 313, 374, 193, 410, 434,
+	214, 389, 0, 0, 469, 195, 432, 407, 316, 284,
+	285, 194, 0, 369, 238, 259, 228, 336, 429, 430,
+	227, 470, 204, 450, 197, 0, 449, 329, 425, 433,
+	317, 307, 196, 431, 315, 306, 290, 249, 270, 363,
+	300, 364, 271, 325, 324, 326, 190, 442, 0, 191,
+	0, 404, 443, 471, 210, 211, 212, 0, 248, 252,
+	258, 260, 266, 267, 274, 293, 340, 362, 360, 366,
+	0, 420, 437, 446, 453, 459, 460, 462, 463, 464,
+	465, 466, 328, 273, 400, 289, 298, 0, 0, 346,
+	379, 215, 440, 401, 606, 598, 588, 590, 607, 608,
+	585, 586, 589, 609, 472, 473, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 0, 601, 575, 574, 0, 581, 582, 0,
+	591, 592, 594, 573, 183, 198, 294, 0, 367, 256,
+	468, 448, 444, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 185, 186,
+	199, 208, 218, 231, 246, 254, 264, 269, 272, 277,
+	278, 281, 286, 304, 309, 310, 311, 312, 330, 331,
+	332, 335, 338, 339, 342, 344, 345, 348, 355, 356,
+	357, 358, 359, 361, 368, 372, 381, 382, 383, 384,
+	385, 387, 388, 394, 395, 396, 397, 405, 409, 427,
+	428, 439, 452, 457, 224, 390, 392, 265, 435, 458,
+	0, 303, 0, 0, 305, 250, 268, 279, 0, 447,
+	406, 203, 375, 257, 192, 221, 206, 229, 244, 247,
+	283, 314, 321, 350, 354, 262, 241, 219, 371, 216,
+	391, 412, 413, 414, 416, 318, 236, 353, 417, 0,
+	301, 418, 419, 275, 0, 0, 0, 0, 0, 0,
+	337, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	0, 0, 0, 0, 292, 237, 0, 0, 351, 0,
+	189, 0, 393, 225, 302, 299, 424, 251, 243, 239,
+	223, 276, 308, 349, 411, 343, 0, 296, 0, 0,
+	402, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 222, 188,
+	334, 403, 255, 0, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 220, 0, 0, 0, 0, 0, 235, 280,
+	242, 234, 421, 0, 0, 0, 0, 205, 0, 918,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 323, 0, 0, 0, 917, 454, 0, 0,
+	0, 0, 0, 914, 915, 291, 877, 288, 184, 201,
+	908, 912, 333, 373, 380, 0, 0, 0, 226, 0,
+	378, 347, 438, 209, 253, 370, 352, 376, 0, 0,
+	377, 297, 426, 365, 436, 455, 456, 233, 327, 445,
+	415, 451, 467, 202, 230, 341, 408, 441, 399, 320,
+	422, 423, 287, 398, 261, 187, 295, 461, 200, 386,
+	217, 207, 313, 374, 193, 410, 434, 214, 389, 0,
+	0, 469, 195, 432, 407, 316, 284, 285, 194, 0,
+	369, 238, 259, 228, 336, 429, 430, 227, 470, 204,
+	450, 197, 0, 449, 329, 425, 433, 317, 307, 196,
+	431, 315, 306, 290, 249, 270, 363, 300, 364, 271,
+	325, 324, 326, 190, 442, 0, 191, 0, 404, 443,
+	471, 210, 211, 212, 0, 248, 252, 258, 260, 266,
+	267, 274, 293, 340, 362, 360, 366, 0, 420, 437,
+	446, 453, 459, 460, 462, 463, 464, 465, 466, 328,
+	273, 400, 289, 298, 0, 0, 346, 379, 215, 440,
+	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 183, 198, 294, 0, 367, 256, 468, 448, 444,
+	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 319, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 186, 199, 208, 218,
+	231, 246, 254, 264, 269, 272, 277, 278, 281, 286,
+	304, 309, 310, 311, 312, 330, 331, 332, 335, 338,
+	339, 342, 344, 345, 348, 355, 356, 357, 358, 359,
+	361, 368, 372, 381, 382, 383, 384, 385, 387, 388,
+	394, 395, 396, 397, 405, 409, 427, 428, 439, 452,
+	457, 224, 390, 392, 265, 435, 458, 0, 303, 0,
+	0, 305, 250, 268, 279, 0, 447, 406, 203, 375,
+	257, 192, 221, 206, 229, 244, 247, 283, 314, 321,
+	350, 354, 262, 241, 219, 371, 216, 391, 412, 413,
+	414, 416, 318, 236, 353, 417, 0, 301, 418, 419,
+	275, 0, 0, 0, 0, 0, 0, 337, 0, 0,
+	0, 1215, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 292, 237, 0, 0, 351, 0, 189, 0, 393,
+	225, 302, 299, 424, 251, 243, 239, 223, 276, 308,
+	349, 411, 343, 0, 296, 0, 0, 402, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 222, 188, 334, 403, 255,
+	0, 0, 0, 0, 180, 181, 182, 0, 1217, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 0, 220,
+	0, 0, 0, 0, 0, 235, 280, 242, 234, 421,
+	0, 0, 0, 0, 205, 0, 0, 0, 1083, 0,
+	1084, 1085, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 263, 0, 323,
+	0, 0, 0, 0, 454, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 288, 184, 201, 0, 0, 333,
+	373, 380, 0, 0, 0, 226, 0, 378, 347, 438,
+	209, 253, 370, 352, 376, 0, 0, 377, 297, 426,
+	365, 436, 455, 456, 233, 327, 445, 415, 451, 467,
+	202, 230, 341, 408, 441, 399, 320, 422, 423, 287,
+	398, 261, 187, 295, 461, 200, 386, 217, 207, 313,
+	374, 193, 410, 434, 214, 389, 0, 0, 469, 195,
+	432, 407, 316, 284, 285, 194, 0, 369, 238, 259,
+	228, 336, 429, 430, 227, 470, 204, 450, 197, 0,
+	449, 329, 425, 433, 317, 307, 196, 431, 315, 306,
+	290, 249, 270, 363, 300, 364, 271, 325, 324, 326,
+	190, 442, 0, 191, 0, 404, 443, 471, 210, 211,
+	212, 0, 248, 252, 258, 260, 266, 267, 274, 293,
+	340, 362, 360, 366, 0, 420, 437, 446, 453, 459,
+	460, 462, 463, 464, 465, 466, 328, 273, 400, 289,
+	298, 0, 0, 346, 379, 215, 440, 401, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 472, 473,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 490, 0, 183, 198,
+	294, 0, 367, 256, 468, 448, 444, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 186, 199, 208, 218, 231, 246, 254,
+	264, 269, 272, 277, 278, 281, 286, 304, 309, 310,
+	311, 312, 330, 331, 332, 335, 338, 339, 342, 344,
+	345, 348, 355, 356, 357, 358, 359, 361, 368, 372,
+	381, 382, 383, 384, 385, 387, 388, 394, 395, 396,
+	397, 405, 409, 427, 428, 439, 452, 457, 224, 390,
+	392, 265, 435, 458, 0, 303, 0, 0, 305, 250,
+	268, 279, 0, 447, 406, 203, 375, 257, 192, 221,
+	206, 229, 244, 247, 283, 314, 321, 350, 354, 262,
+	241, 219, 371, 216, 391, 412, 413, 414, 416, 318,
+	236, 353, 417, 0, 301, 418, 419, 275, 0, 0,
+	0, 0, 0, 0, 337, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 292, 237,
+	0, 0, 351, 0, 189, 0, 393, 225, 302, 299,
+	424, 251, 243, 239, 223, 276, 308, 349, 411, 343,
+	0, 296, 0, 0, 402, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 222, 188, 334, 403, 255, 0, 0, 0,
+	0, 180, 181, 182, 1159, 1162, 0, 0, 0, 0,
+	1158, 1161, 0, 0, 213, 1157, 220, 0, 0, 0,
+	0, 0, 235, 280, 242, 234, 421, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 263, 0, 323, 0, 0, 0,
+	0, 454, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 288, 184, 201, 0, 0, 333, 373, 380, 0,
+	0, 0, 226, 0, 378, 347, 438, 209, 253, 370,
+	352, 376, 0, 0, 377, 297, 426, 365, 436, 455,
+	456, 233, 327, 445, 415, 451, 467, 202, 230, 341,
+	408, 441, 399, 320, 422, 423, 287, 398, 261, 187,
+	295, 461, 200, 386, 217, 207, 313, 374, 193, 410,
+	434, 214, 389, 0, 0, 469, 195, 432, 407, 316,
+	284, 285, 194, 0, 369, 238, 259, 228, 336, 429,
+	430, 227, 470, 204, 450, 197, 0, 449, 329, 425,
+	433, 317, 307, 196, 431, 315, 306, 290, 249, 270,
+	363, 300, 364, 271, 325, 324, 326, 190, 442, 0,
+	191, 0, 404, 443, 471, 210, 211, 212, 0, 248,
+	252, 258, 260, 266, 267, 274, 293, 340, 362, 360,
+	366, 0, 420, 437, 446, 453, 459, 460, 462, 463,
+	464, 465, 466, 328, 273, 400, 289, 298, 0, 0,
+	346, 379, 215, 440, 401, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 472, 473, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 490, 0, 183, 198, 294, 0, 367,
+	256, 468, 448, 444, 0, 0, 232, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
+	186, 199, 208, 218, 231, 246, 254, 264, 269, 272,
+	277, 278, 281, 286, 304, 309, 310, 311, 312, 330,
+	331, 332, 335, 338, 339, 342, 344, 345, 348, 355,
+	356, 357, 358, 359, 361, 368, 372, 381, 382, 383,
+	384, 385, 387, 388, 394, 395, 396, 397, 405, 409,
+	427, 428, 439, 452, 457, 224, 390, 392, 265, 435,
+	458, 0, 303, 0, 0, 305, 250, 268, 279, 0,
+	447, 406, 203, 375, 257, 192, 221, 206, 229, 244,
+	247, 283, 314, 321, 350, 354, 262, 241, 219, 371,
+	216, 391, 412, 413, 414, 416, 318, 236, 353, 74,
+	417, 301, 418, 419, 275, 0, 0, 0, 0, 0,
+	0, 0, 337, 0, 0, 0, 0, 0, 0, 0,
+	0, 240, 0, 0, 0, 0, 292, 237, 0, 0,
+	351, 0, 189, 0, 393, 225, 302, 299, 424, 251,
+	243, 239, 223, 276, 308, 349, 411, 343, 0, 296,
+	0, 0, 402, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	222, 188, 334, 403, 255, 0, 83, 0, 1195, 180,
+	181, 182, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 213, 0, 220, 0, 0, 0, 0, 0,
+	235, 280, 242, 234, 421, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 263, 0, 323, 0, 0, 0, 0, 454,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 288,
+	184, 201, 0, 0, 333, 373, 380, 0, 0, 0,
+	226, 0, 378, 347, 438, 209, 253, 370, 352, 376,
+	0, 0, 377, 297, 426, 365, 436, 455, 456, 233,
+	327, 445, 415, 451, 467, 202, 230, 341, 408, 441,
+	399, 320, 422, 423, 287, 398, 261, 187, 295, 461,
+	200, 386, 217, 207, 313, 374, 193, 410, 434, 214,
+	389, 0, 0, 469, 195, 432, 407, 316, 284, 285,
+	194, 0, 369, 238, 259, 228, 336, 429, 430, 227,
+	470, 204, 450, 197, 0, 449, 329, 425, 433, 317,
+	307, 196, 431, 315, 306, 290, 249, 270, 363, 300,
+	364, 271, 325, 324, 326, 190, 442, 0, 191, 0,
+	404, 443, 471, 210, 211, 212, 0, 248, 252, 258,
+	260, 266, 267, 274, 293, 340, 362, 360, 366, 0,
+	420, 437, 446, 453, 459, 460, 462, 463, 464, 465,
+	466, 328, 273, 400, 289, 298, 0, 0, 346, 379,
+	215, 440, 401, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 472, 473, 474, 475, 476, 477, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 490, 0, 183, 198, 294, 82, 367, 256, 468,
+	448, 444, 0, 0, 232, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 319, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 186, 199,
+	208, 218, 231, 246, 254, 264, 269, 272, 277, 278,
+	281, 286, 304, 309, 310, 311, 312, 330, 331, 332,
+	335, 338, 339, 342, 344, 345, 348, 355, 356, 357,
+	358, 359, 361, 368, 372, 381, 382, 383, 384, 385,
+	387, 388, 394, 395, 396, 397, 405, 409, 427, 428,
+	439, 452, 457, 224, 390, 392, 265, 435, 458, 0,
+	303, 0, 0, 305, 250, 268, 279, 0, 447, 406,
+	203, 375, 257, 192, 221, 206, 229, 244, 247, 283,
+	314, 321, 350, 354, 262, 241, 219, 371, 216, 391,
+	412, 413, 414, 416, 318, 236, 353, 74, 417, 301,
+	418, 419, 275, 0, 0, 0, 0, 0, 0, 0,
+	337, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	0, 0, 0, 0, 292, 237, 0, 0, 351, 0,
+	189, 0, 393, 225, 302, 299, 424, 251, 243, 239,
+	223, 276, 308, 349, 411, 343, 0, 296, 0, 0,
+	402, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 222, 188,
+	334, 403, 255, 0, 83, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 220, 0, 0, 0, 0, 0, 235, 280,
+	242, 234, 421, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 323, 0, 0, 0, 0, 454, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 288, 184, 201,
+	0, 0, 333, 373, 380, 0, 0, 0, 226, 0,
+	378, 347, 438, 209, 253, 370, 352, 376, 0, 0,
+	377, 297, 426, 365, 436, 455, 456, 233, 327, 445,
+	415, 451, 467, 202, 230, 341, 408, 441, 399, 320,
+	422, 423, 287, 398, 261, 187, 295, 461, 200, 386,
+	217, 207, 313, 374, 193, 410, 434, 214, 389, 0,
+	0, 469, 195, 432, 407, 316, 284, 285, 194, 0,
+	369, 238, 259, 228, 336, 429, 430, 227, 470, 204,
+	450, 197, 0, 449, 329, 425, 433, 317, 307, 196,
+	431, 315, 306, 290, 249, 270, 363, 300, 364, 271,
+	325, 324, 326, 190, 442, 0, 191, 0, 404, 443,
+	471, 210, 211, 212, 0, 248, 252, 258, 260, 266,
+	267, 274, 293, 340, 362, 360, 366, 0, 420, 437,
+	446, 453, 459, 460, 462, 463, 464, 465, 466, 328,
+	273, 400, 289, 298, 0, 0, 346, 379, 215, 440,
+	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 183, 198, 294, 82, 367, 256, 468, 448, 444,
+	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 319, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 186, 199, 208, 218,
+	231, 246, 254, 264, 269, 272, 277, 278, 281, 286,
+	304, 309, 310, 311, 312, 330, 331, 332, 335, 338,
+	339, 342, 344, 345, 348, 355, 356, 357, 358, 359,
+	361, 368, 372, 381, 382, 383, 384, 385, 387, 388,
+	394, 395, 396, 397, 405, 409, 427, 428, 439, 452,
+	457, 224, 390, 392, 265, 435, 458, 0, 303, 0,
+	0, 305, 250, 268, 279, 0, 447, 406, 203, 375,
+	257, 192, 221, 206, 229, 244, 247, 283, 314, 321,
+	350, 354, 262, 241, 219, 371, 216, 391, 412, 413,
+	414, 416, 318, 236, 353, 417, 0, 301, 418, 419,
+	275, 0, 0, 0, 0, 0, 0, 337, 0, 0,
+	0, 1596, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 292, 237, 0, 0, 351, 0, 189, 0, 393,
+	225, 302, 299, 424, 251, 243, 239, 223, 276, 308,
+	349, 411, 343, 0, 296, 0, 0, 402, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 222, 188, 334, 403, 255,
+	0, 0, 0, 0, 180, 181, 182, 0, 1388, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 0, 220,
+	0, 0, 0, 0, 0, 235, 280, 242, 234, 421,
+	0, 0, 0, 0, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 263, 0, 323,
+	0, 0, 0, 0, 454, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 288, 184, 201, 0, 0, 333,
+	373, 380, 0, 0, 0, 226, 0, 378, 347, 438,
+	209, 253, 370, 352, 376, 0, 1594, 377, 297, 426,
+	365, 436, 455, 456, 233, 327, 445, 415, 451, 467,
+	202, 230, 341, 408, 441, 399, 320, 422, 423, 287,
+	398, 261, 187, 295, 461, 200, 386, 217, 207, 313,
+	374, 193, 410, 434, 214, 389, 0, 0, 469, 195,
+	432, 407, 316, 284, 285, 194, 0, 369, 238, 259,
+	228, 336, 429, 430, 227, 470, 204, 450, 197, 0,
+	449, 329, 425, 433, 317, 307, 196, 431, 315, 306,
+	290, 249, 270, 363, 300, 364, 271, 325, 324, 326,
+	190, 442, 0, 191, 0, 404, 443, 471, 210, 211,
+	212, 0, 248, 252, 258, 260, 266, 267, 274, 293,
+	340, 362, 360, 366, 0, 420, 437, 446, 453, 459,
+	460, 462, 463, 464, 465, 466, 328, 273, 400, 289,
+	298, 0, 0, 346, 379, 215, 440, 401, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 472, 473,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 490, 0, 183, 198,
+	294, 0, 367, 256, 468, 448, 444, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 186, 199, 208, 218, 231, 246, 254,
+	264, 269, 272, 277, 278, 281, 286, 304, 309, 310,
+	311, 312, 330, 331, 332, 335, 338, 339, 342, 344,
+	345, 348, 355, 356, 357, 358, 359, 361, 368, 372,
+	381, 382, 383, 384, 385, 387, 388, 394, 395, 396,
+	397, 405, 409, 427, 428, 439, 452, 457, 224, 390,
+	392, 265, 435, 458, 0, 303, 0, 0, 305, 250,
+	268, 279, 0, 447, 406, 203, 375, 257, 192, 221,
+	206, 229, 244, 247, 283, 314, 321, 350, 354, 262,
+	241, 219, 371, 216, 391, 412, 413, 414, 416, 318,
+	236, 353, 417, 0, 301, 418, 419, 275, 0, 0,
+	0, 0, 0, 0, 337, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 292, 237,
+	0, 0, 351, 0, 189, 0, 393, 225, 302, 299,
+	424, 251, 243, 239, 223, 276, 308, 349, 411, 343,
+	0, 296, 0, 0, 402, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 222, 188, 334, 403, 255, 0, 0, 0,
+	0, 180, 181, 182, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 213, 0, 220, 0, 0, 0,
+	0, 0, 235, 280, 242, 234, 421, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
+	0, 0, 0, 0, 0, 871, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 263, 0, 323, 0, 0, 0,
+	0, 454, 0, 0, 0, 0, 0, 0, 0, 291,
+	877, 288, 184, 201, 875, 0, 333, 373, 380, 0,
+	0, 0, 226, 0, 378, 347, 438, 209, 253, 370,
+	352, 376, 0, 0, 377, 297, 426, 365, 436, 455,
+	456, 233, 327, 445, 415, 451, 467, 202, 230, 341,
+	408, 441, 399, 320, 422, 423, 287, 398, 261, 187,
+	295, 461, 200, 386, 217, 207, 313, 374, 193, 410,
+	434, 214, 389, 0, 0, 469, 195, 432, 407, 316,
+	284, 285, 194, 0, 369, 238, 259, 228, 336, 429,
+	430, 227, 470, 204, 450, 197, 0, 449, 329, 425,
+	433, 317, 307, 196, 431, 315, 306, 290, 249, 270,
+	363, 300, 364, 271, 325, 324, 326, 190, 442, 0,
+	191, 0, 404, 443, 471, 210, 211, 212, 0, 248,
+	252, 258, 260, 266, 267, 274, 293, 340, 362, 360,
+	366, 0, 420, 437, 446, 453, 459, 460, 462, 463,
+	464, 465, 466, 328, 273, 400, 289, 298, 0, 0,
+	346, 379, 215, 440, 401, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 472, 473, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 490, 0, 183, 198, 294, 0, 367,
+	256, 468, 448, 444, 0, 0, 232, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
+	186, 199, 208, 218, 231, 246, 254, 264, 269, 272,
+	277, 278, 281, 286, 304, 309, 310, 311, 312, 330,
+	331, 332, 335, 338, 339, 342, 344, 345, 348, 355,
+	356, 357, 358, 359, 361, 368, 372, 381, 382, 383,
+	384, 385, 387, 388, 394, 395, 396, 397, 405, 409,
+	427, 428, 439, 452, 457, 224, 390, 392, 265, 435,
+	458, 0, 303, 0, 0, 305, 250, 268, 279, 0,
+	447, 406, 203, 375, 257, 192, 221, 206, 229, 244,
+	247, 283, 314, 321, 350, 354, 262, 241, 219, 371,
+	216, 391, 412, 413, 414, 416, 318, 236, 353, 417,
+	0, 301, 418, 419, 275, 0, 0, 0, 0, 0,
+	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 0, 0, 0, 292, 237, 0, 0, 351,
+	0, 189, 0, 393, 225, 302, 299, 424, 251, 243,
+	239, 223, 276, 308, 349, 411, 343, 0, 296, 0,
+	0, 402, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 222,
+	188, 334, 403, 255, 0, 0, 0, 1195, 180, 181,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 213, 0, 220, 0, 0, 0, 0, 0, 235,
+	280, 242, 234, 421, 0, 0, 0, 0, 205, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 263, 0, 323, 0, 0, 0, 0, 454, 0,
+	0, 0, 2444, 0, 0, 0, 291, 0, 288, 184,
+	201, 0, 0, 333, 373, 380, 0, 0, 0, 226,
+	0, 378, 347, 438, 209, 253, 370, 352, 376, 0,
+	0, 377, 297, 426, 365, 436, 455, 456, 233, 327,
+	445, 415, 451, 467, 202, 230, 341, 408, 441, 399,
+	320, 422, 423, 287, 398, 261, 187, 295, 461, 200,
+	386, 217, 207, 313, 374, 193, 410, 434, 214, 389,
+	0, 0, 469, 195, 432, 407, 316, 284, 285, 194,
+	0, 369, 238, 259, 228, 336, 429, 430, 227, 470,
+	204, 450, 197, 0, 449, 329, 425, 433, 317, 307,
+	196, 431, 315, 306, 290, 249, 270, 363, 300, 364,
+	271, 325, 324, 326, 190, 442, 0, 191, 0, 404,
+	443, 471, 210, 211, 212, 0, 248, 252, 258, 260,
+	266, 267, 274, 293, 340, 362, 360, 366, 0, 420,
+	437, 446, 453, 459, 460, 462, 463, 464, 465, 466,
+	328, 273, 400, 289, 298, 0, 0, 346, 379, 215,
+	440, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	490, 0, 183, 198, 294, 0, 367, 256, 468, 448,
+	444, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 185, 186, 199, 208,
+	218, 231, 246, 254, 264, 269, 272, 277, 278, 281,
+	286, 304, 309, 310, 311, 312, 330, 331, 332, 335,
+	338, 339, 342, 344, 345, 348, 355, 356, 357, 358,
+	359, 361, 368, 372, 381, 382, 383, 384, 385, 387,
+	388, 394, 395, 396, 397, 405, 409, 427, 428, 439,
+	452, 457, 224, 390, 392, 265, 435, 458, 0, 303,
+	0, 0, 305, 250, 268, 279, 0, 447, 406, 203,
+	375, 257, 192, 221, 206, 229, 244, 247, 283, 314,
+	321, 350, 354, 262, 241, 219, 371, 216, 391, 412,
+	413, 414, 416, 318, 236, 353, 417, 0, 301, 418,
+	419, 275, 0, 0, 0, 0, 0, 0, 337, 0,
+	0, 0, 1596, 0, 0, 0, 0, 240, 0, 0,
+	0, 0, 292, 237, 0, 0, 351, 0, 189, 0,
+	393, 225, 302, 299, 424, 251, 243, 239, 223, 276,
+	308, 349, 411, 343, 0, 296, 0, 0, 402, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 222, 188, 334, 403,
+	255, 0, 0, 0, 0, 180, 181, 182, 0, 1388,
+	0, 0, 0, 0, 0, 0, 0, 0, 213, 0,
+	220, 0, 0, 0, 0, 0, 235, 280, 242, 234,
+	421, 0, 0, 0, 0, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	323, 0, 0, 0, 0, 454, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 288, 184, 201, 0, 0,
+	333, 373, 380, 0, 0, 0, 226, 0, 378, 347,
+	438, 209, 253, 370, 352, 376, 0, 0, 377, 297,
+	426, 365, 436, 455, 456, 233, 327, 445, 415, 451,
+	467, 202, 230, 341, 408, 441, 399, 320, 422, 423,
+	287, 398, 261, 187, 295, 461, 200, 386, 217, 207,
+	313, 374, 193, 410, 434, 214, 389, 0, 0, 469,
+	195, 432, 407, 316, 284, 285, 194, 0, 369, 238,
+	259, 228, 336, 429, 430, 227, 470, 204, 450, 197,
+	0, 449, 329, 425, 433, 317, 307, 196, 431, 315,
+	306, 290, 249, 270, 363, 300, 364, 271, 325, 324,
+	326, 190, 442, 0, 191, 0, 404, 443, 471, 210,
+	211, 212, 0, 248, 252, 258, 260, 266, 267, 274,
+	293, 340, 362, 360, 366, 0, 420, 437, 446, 453,
+	459, 460, 462, 463, 464, 465, 466, 328, 273, 400,
+	289, 298, 0, 0, 346, 379, 215, 440, 401, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 472,
 	473, 474, 475, 476, 477, 478, 479, 480, 481, 482,
+	483, 484, 485, 486, 487, 488, 489, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 490, 0, 183,
+	198, 294, 0, 367, 256, 468, 448, 444, 0, 0,
+	232, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 319, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 185, 186, 199, 208, 218, 231, 246,
+	254, 264, 269, 272, 277, 278, 281, 286, 304, 309,
+	310, 311, 312, 330, 331, 332, 335, 338, 339, 342,
+	344, 345, 348, 355, 356, 357, 358, 359, 361, 368,
+	372, 381, 382, 383, 384, 385, 387, 388, 394, 395,
+	396, 397, 405, 409, 427, 428, 439, 452, 457, 224,
+	390, 392, 265, 435, 458, 0, 303, 0, 0, 305,
+	250, 268, 279, 0, 447, 406, 203, 375, 257, 192,
+	221, 206, 229, 244, 247, 283, 314, 321, 350, 354,
+	262, 241, 219, 371, 216, 391, 412, 413, 414, 416,
+	318, 236, 353, 417, 0, 301, 418, 419, 275, 0,
+	0, 0, 0, 0, 0, 337, 0, 0, 0, 0,
+	0, 0, 0, 0, 240, 0, 0, 0, 0, 292,
+	237, 0, 0, 351, 0, 189, 0, 393, 225, 302,
+	299, 424, 251, 243, 239, 223, 276, 308, 349, 411,
+	343, 0, 296, 0, 0, 402, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 222, 188, 334, 403, 255, 0, 0,
+	0, 0, 180, 181, 182, 0, 1874, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 0, 220, 0, 0,
+	0, 0, 0, 235, 280, 242, 234, 421, 0, 0,
+	0, 0, 205, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1875, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 263, 0, 323, 0, 0,
+	0, 0, 454, 0, 0, 0, 0, 0, 0, 0,
+	291, 0, 288, 184, 201, 0, 0, 333, 373, 380,
+	0, 0, 0, 226, 0, 378, 347, 438, 209, 253,
+	370, 352, 376, 0, 0, 377, 297, 426, 365, 436,
+	455, 456, 233, 327, 445, 415, 451, 467, 202, 230,
+	341, 408, 441, 399, 320, 422, 423, 287, 398, 261,
+	187, 295, 461, 200, 386, 217, 207, 313, 374, 193,
+	410, 434, 214, 389, 0, 0, 469, 195, 432, 407,
+	316, 284, 285, 194, 0, 369, 238, 259, 228, 336,
+	429, 430, 227, 470, 204, 450, 197, 0, 449, 329,
+	425, 433, 317, 307, 196, 431, 315, 306, 290, 249,
+	270, 363, 300, 364, 271, 325, 324, 326, 190, 442,
+	0, 191, 0, 404, 443, 471, 210, 211, 212, 0,
+	248, 252, 258, 260, 266, 267, 274, 293, 340, 362,
+	360, 366, 0, 420, 437, 446, 453, 459, 460, 462,
+	463, 464, 465, 466, 328, 273, 400, 289, 298, 0,
+	0, 346, 379, 215, 440, 401, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 490, 0, 183, 198, 294, 0,
+	367, 256, 468, 448, 444, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	185, 186, 199, 208, 218, 231, 246, 254, 264, 269,
+	272, 277, 278, 281, 286, 304, 309, 310, 311, 312,
+	330, 331, 332, 335, 338, 339, 342, 344, 345, 348,
+	355, 356, 357, 358, 359, 361, 368, 372, 381, 382,
+	383, 384, 385, 387, 388, 394, 395, 396, 397, 405,
+	409, 427, 428, 439, 452, 457, 224, 390, 392, 265,
+	435, 458, 0, 303, 0, 0, 305, 250, 268, 279,
+	0, 447, 406, 203, 375, 257, 192, 221, 206, 229,
+	244, 247, 283, 314, 321, 350, 354, 262, 241, 219,
+	371, 216, 391, 412, 413, 414, 416, 318, 236, 353,
+	417, 0, 301, 418, 419, 275, 0, 0, 0, 0,
+	0, 0, 337, 0, 0, 0, 0, 0, 0, 0,
+	0, 240, 0, 0, 0, 0, 292, 237, 0, 0,
+	351, 0, 189, 0, 393, 225, 302, 299, 424, 251,
+	243, 239, 223, 276, 308, 349, 411, 343, 0, 296,
+	0, 0, 402, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	222, 188, 334, 403, 255, 0, 0, 0, 0, 180,
+	181, 182, 0, 0, 0, 1859, 0, 0, 0, 1860,
+	0, 0, 213, 0, 220, 0, 0, 0, 0, 0,
+	235, 280, 242, 234, 421, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 263, 0, 323, 0, 0, 0, 0, 454,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 288,
+	184, 201, 0, 0, 333, 373, 380, 0, 0, 0,
+	226, 0, 378, 347, 438, 209, 253, 370, 352, 376,
+	0, 0, 377, 297, 426, 365, 436, 455, 456, 233,
+	327, 445, 415, 451, 467, 202, 230, 341, 408, 441,
+	399, 320, 422, 423, 287, 398, 261, 187, 295, 461,
+	200, 386, 217, 207, 313, 374, 193, 410, 434, 214,
+	389, 0, 0, 469, 195, 432, 407, 316, 284, 285,
+	194, 0, 369, 238, 259, 228, 336, 429, 430, 227,
+	470, 204, 450, 197, 0, 449, 329, 425, 433, 317,
+	307, 196, 431, 315, 306, 290, 249, 270, 363, 300,
+	364, 271, 325, 324, 326, 190, 442, 0, 191, 0,
+	404, 443, 471, 210, 211, 212, 0, 248, 252, 258,
+	260, 266, 267, 274, 293, 340, 362, 360, 366, 0,
+	420, 437, 446, 453, 459, 460, 462, 463, 464, 465,
+	466, 328, 273, 400, 289, 298, 0, 0, 346, 379,
+	215, 440, 401, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 472, 473, 474, 475, 476, 477, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 490, 0, 183, 198, 294, 0, 367, 256, 468,
+	448, 444, 0, 0, 232, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 319, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 186, 199,
+	208, 218, 231, 246, 254, 264, 269, 272, 277, 278,
+	281, 286, 304, 309, 310, 311, 312, 330, 331, 332,
+	335, 338, 339, 342, 344, 345, 348, 355, 356, 357,
+	358, 359, 361, 368, 372, 381, 382, 383, 384, 385,
+	387, 388, 394, 395, 396, 397, 405, 409, 427, 428,
+	439, 452, 457, 224, 390, 392, 265, 435, 458, 0,
+	303, 0, 0, 305, 250, 268, 279, 0, 447, 406,
+	203, 375, 257, 192, 221, 206, 229, 244, 247, 283,
+	314, 321, 350, 354, 262, 241, 219, 371, 216, 391,
+	412, 413, 414, 416, 318, 236, 353, 417, 0, 301,
+	418, 419, 275, 0, 0, 0, 0, 0, 0, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 1238,
+	0, 0, 0, 292, 237, 0, 0, 351, 0, 189,
+	0, 393, 225, 302, 299, 424, 251, 243, 239, 223,
+	276, 308, 349, 411, 343, 0, 296, 0, 0, 402,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 222, 188, 334,
+	403, 255, 0, 0, 0, 0, 180, 181, 182, 0,
+	1237, 0, 0, 0, 0, 0, 0, 0, 0, 213,
+	0, 220, 0, 0, 0, 0, 0, 235, 280, 242,
+	234, 421, 0, 0, 0, 0, 205, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
+	0, 323, 0, 0, 0, 0, 454, 0, 0, 0,
+	0, 0, 0, 0, 291, 0, 288, 184, 201, 0,
+	0, 333, 373, 380, 0, 0, 0, 226, 0, 378,
+	347, 438, 209, 253, 370, 352, 376, 0, 0, 377,
+	297, 426, 365, 436, 455, 456, 233, 327, 445, 415,
+	451, 467, 202, 230, 341, 408, 441, 399, 320, 422,
+	423, 287, 398, 261, 187, 295, 461, 200, 386, 217,
+	207, 313, 374, 193, 410, 434, 214, 389, 0, 0,
+	469, 195, 432, 407, 316, 284, 285, 194, 0, 369,
+	238, 259, 228, 336, 429, 430, 227, 470, 204, 450,
+	197, 0, 449, 329, 425, 433, 317, 307, 196, 431,
+	315, 306, 290, 249, 270, 363, 300, 364, 271, 325,
+	324, 326, 190, 442, 0, 191, 0, 404, 443, 471,
+	210, 211, 212, 0, 248, 252, 258, 260, 266, 267,
+	274, 293, 340, 362, 360, 366, 0, 420, 437, 446,
+	453, 459, 460, 462, 463, 464, 465, 466, 328, 273,
+	400, 289, 298, 0, 0, 346, 379, 215, 440, 401,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	483, 0, 181, 196, 291, 0, 363, 253, 461, 441,
-	437, 0, 0, 229, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 183, 184, 197, 206,
-	216, 228, 243, 251, 261, 266, 269, 274, 275, 278,
-	283, 301, 306, 307, 308, 309, 326, 327, 328, 331,
-	334, 335, 338, 340, 341, 344, 351, 352, 353, 354,
-	355, 357, 364, 368, 376, 377, 378, 379, 380, 382,
-	383, 387, 388, 389, 390, 398, 402, 420, 421, 432,
-	445, 450, 262, 428, 451, 0, 300, 0, 0, 302,
-	247, 265, 276, 0, 440, 399, 201, 370, 254, 190,
-	219, 204, 226, 241, 244, 280, 310, 317, 346, 350,
-	259, 238, 217, 367, 214, 385, 405, 406, 407, 409,
-	314, 233, 349, 410, 0, 298, 411, 412, 272, 0,
-	0, 0, 0, 0, 0, 333, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 289,
-	234, 0, 0, 347, 0, 187, 0, 386, 222, 299,
-	296, 417, 248, 240, 236, 221, 273, 305, 345, 404,
-	339, 0, 293, 0, 0, 395, 318, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 220, 186, 330, 396, 252, 0, 81,
-	0, 0, 178, 179, 180, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 211, 0, 218, 0, 0,
-	0, 0, 0, 232, 277, 239, 231, 414, 0, 0,
-	0, 0, 203, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 260, 0, 319, 0, 0,
-	0, 0, 447, 0, 0, 0, 0, 0, 0, 0,
-	288, 0, 285, 182, 199, 0, 0, 329, 369, 375,
-	0, 0, 0, 223, 0, 373, 343, 431, 207, 250,
-	366, 348, 371, 0, 0, 372, 294, 419, 361, 429,
-	448, 449, 230, 323, 438, 408, 444, 460, 200, 227,
-	337, 401, 434, 392, 316, 415, 416, 284, 391, 258,
-	185, 292, 454, 198, 381, 215, 205, 191, 403, 427,
-	212, 384, 0, 0, 462, 193, 425, 400, 312, 281,
-	282, 192, 0, 365, 235, 256, 225, 332, 422, 423,
-	224, 463, 202, 443, 195, 0, 442, 325, 418, 426,
-	313, 304, 194, 424, 311, 303, 287, 246, 267, 359,
-	297, 360, 268, 321, 320, 322, 188, 435, 0, 189,
-	0, 397, 436, 464, 208, 209, 210, 0, 245, 249,
-	255, 257, 263, 264, 271, 290, 336, 358, 356, 362,
-	0, 413, 430, 439, 446, 452, 453, 455, 456, 457,
-	458, 459, 324, 270, 393, 286, 295, 0, 0, 342,
-	374, 213, 433, 394, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 465, 466, 467, 468, 469, 470,
-	471, 472, 473, 474, 475, 476, 477, 478, 479, 480,
-	481, 482, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 483, 0, 181, 196, 291, 0, 363, 253,
-	461, 441, 437, 0, 0, 229, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 315, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 183, 184,
-	197, 206, 216, 228, 243, 251, 261, 266, 269, 274,
-	275, 278, 283, 301, 306, 307, 308, 309, 326, 327,
-	328, 331, 334, 335, 338, 340, 341, 344, 351, 352,
-	353, 354, 355, 357, 364, 368, 376, 377, 378, 379,
-	380, 382, 383, 387, 388, 389, 390, 398, 402, 420,
-	421, 432, 445, 450, 262, 428, 451, 0, 300, 0,
-	0, 302, 247, 265, 276, 0, 440, 399, 201, 370,
-	254, 190, 219, 204, 226, 241, 244, 280, 310, 317,
-	346, 350, 259, 238, 217, 367, 214, 385, 405, 406,
-	407, 409, 314, 233, 349, 410, 0, 298, 411, 412,
-	272, 0, 0, 0, 0, 0, 0, 333, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 289, 234, 0, 0, 347, 0, 187, 0, 386,
-	222, 299, 296, 417, 248, 240, 236, 221, 273, 305,
-	345, 404, 339, 0, 293, 0, 0, 395, 318, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 220, 186, 330, 396, 252,
-	0, 0, 0, 0, 178, 179, 180, 0, 1374, 0,
-	0, 0, 0, 0, 0, 0, 0, 211, 0, 218,
-	0, 0, 0, 0, 0, 232, 277, 239, 231, 414,
-	0, 0, 0, 0, 203, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 260, 0, 319,
-	0, 0, 0, 0, 447, 0, 0, 0, 0, 0,
-	0, 0, 288, 0, 285, 182, 199, 0, 0, 329,
-	369, 375, 0, 0, 0, 223, 0, 373, 343, 431,
-	207, 250, 366, 348, 371, 0, 0, 372, 294, 419,
-	361, 429, 448, 449, 230, 323, 438, 408, 444, 460,
-	200, 227, 337, 401, 434, 392, 316, 415, 416, 284,
-	391, 258, 185, 292, 454, 198, 381, 215, 205, 191,
-	403, 427, 212, 384, 0, 0, 462, 193, 425, 400,
-	312, 281, 282, 192, 0, 365, 235, 256, 225, 332,
-	422, 423, 224, 463, 202, 443, 195, 0, 442, 325,
-	418, 426, 313, 304, 194, 424, 311, 303, 287, 246,
-	267, 359, 297, 360, 268, 321, 320, 322, 188, 435,
-	0, 189, 0, 397, 436, 464, 208, 209, 210, 0,
-	245, 249, 255, 257, 263, 264, 271, 290, 336, 358,
-	356, 362, 0, 413, 430, 439, 446, 452, 453, 455,
-	456, 457, 458, 459, 324, 270, 393, 286, 295, 0,
-	0, 342, 374, 213, 433, 394, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 465, 466, 467, 468,
-	469, 470, 471, 472, 473, 474, 475, 476, 477, 478,
-	479, 480, 481, 482, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 483, 0, 181, 196, 291, 0,
-	363, 253, 461, 441, 437, 0, 0, 229, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 315,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	183, 184, 197, 206, 216, 228, 243, 251, 261, 266,
-	269, 274, 275, 278, 283, 301, 306, 307, 308, 309,
-	326, 327, 328, 331, 334, 335, 338, 340, 341, 344,
-	351, 352, 353, 354, 355, 357, 364, 368, 376, 377,
-	378, 379, 380, 382, 383, 387, 388, 389, 390, 398,
-	402, 420, 421, 432, 445, 450, 262, 428, 451, 0,
-	300, 0, 0, 302, 247, 265, 276, 0, 440, 399,
-	201, 370, 254, 190, 219, 204, 226, 241, 244, 280,
-	310, 317, 346, 350, 259, 238, 217, 367, 214, 385,
-	405, 406, 407, 409, 314, 233, 349, 0, 410, 298,
-	411, 412, 272, 1623, 0, 0, 0, 0, 0, 0,
-	333, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 289, 234, 0, 0, 347, 0,
-	187, 0, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 0, 293, 0, 0,
-	395, 318, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 220, 186,
-	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 0, 0, 0, 0, 0, 232, 277,
-	239, 231, 414, 0, 0, 0, 0, 203, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 242, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	260, 0, 319, 0, 0, 0, 0, 447, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 182, 199,
-	0, 0, 329, 369, 375, 0, 0, 0, 223, 0,
-	373, 343, 431, 207, 250, 366, 348, 371, 0, 0,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 427, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	0, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 0, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 0, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 324, 270, 393,
-	286, 295, 0, 0, 342, 374, 213, 433, 394, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 483, 0, 181,
-	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 0, 0, 302, 247, 265, 276,
-	0, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	410, 0, 298, 411, 412, 272, 0, 0, 0, 0,
-	0, 0, 333, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 289, 234, 0, 0,
-	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
-	240, 236, 221, 273, 305, 345, 404, 339, 0, 293,
-	0, 0, 395, 318, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	220, 186, 330, 396, 252, 0, 0, 0, 0, 178,
-	179, 180, 0, 1204, 0, 0, 0, 0, 0, 0,
-	0, 0, 211, 0, 218, 0, 0, 0, 0, 0,
-	232, 277, 239, 231, 414, 0, 0, 0, 0, 203,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 319, 0, 0, 0, 0, 447,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	182, 199, 0, 0, 329, 369, 375, 0, 0, 0,
-	223, 0, 373, 343, 431, 207, 250, 366, 348, 371,
-	0, 0, 372, 294, 419, 361, 429, 448, 449, 230,
-	323, 438, 408, 444, 460, 200, 227, 337, 401, 434,
-	392, 316, 415, 416, 284, 391, 258, 185, 292, 454,
-	198, 381, 215, 205, 191, 403, 427, 212, 384, 0,
-	0, 462, 193, 425, 400, 312, 281, 282, 192, 0,
-	365, 235, 256, 225, 332, 422, 423, 224, 463, 202,
-	443, 195, 0, 442, 325, 418, 426, 313, 304, 194,
-	424, 311, 303, 287, 246, 267, 359, 297, 360, 268,
-	321, 320, 322, 188, 435, 0, 189, 0, 397, 436,
-	464, 208, 209, 210, 0, 245, 249, 255, 257, 263,
-	264, 271, 290, 336, 358, 356, 362, 0, 413, 430,
-	439, 446, 452, 453, 455, 456, 457, 458, 459, 324,
-	270, 393, 286, 295, 0, 0, 342, 374, 213, 433,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 481, 482, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 483,
-	0, 181, 196, 291, 0, 363, 253, 461, 441, 437,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 183, 184, 197, 206, 216,
-	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
-	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
-	335, 338, 340, 341, 344, 351, 352, 353, 354, 355,
-	357, 364, 368, 376, 377, 378, 379, 380, 382, 383,
-	387, 388, 389, 390, 398, 402, 420, 421, 432, 445,
-	450, 262, 428, 451, 0, 300, 0, 0, 302, 247,
-	265, 276, 0, 440, 399, 201, 370, 254, 190, 219,
-	204, 226, 241, 244, 280, 310, 317, 346, 350, 259,
-	238, 217, 367, 214, 385, 405, 406, 407, 409, 314,
-	233, 349, 410, 0, 298, 411, 412, 272, 0, 0,
-	0, 0, 0, 0, 333, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 289, 234,
-	0, 0, 347, 0, 187, 0, 386, 222, 299, 296,
-	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
-	0, 293, 0, 0, 395, 318, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 220, 186, 330, 396, 252, 0, 0, 0,
-	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 211, 0, 218, 0, 0, 0,
-	0, 0, 232, 277, 239, 231, 414, 0, 0, 0,
-	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 242, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1101, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 260, 0, 319, 0, 0, 0,
-	0, 447, 0, 0, 0, 0, 0, 0, 0, 288,
-	0, 285, 182, 199, 0, 0, 329, 369, 375, 0,
-	0, 0, 223, 0, 373, 343, 431, 207, 250, 366,
-	348, 371, 0, 0, 372, 294, 419, 361, 429, 448,
-	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
-	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
-	292, 454, 198, 381, 215, 205, 191, 403, 427, 212,
-	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
-	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
-	463, 202, 443, 195, 0, 442, 325, 418, 426, 313,
-	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
-	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
-	397, 436, 464, 208, 209, 210, 0, 245, 249, 255,
-	257, 263, 264, 271, 290, 336, 358, 356, 362, 0,
-	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
-	459, 324, 270, 393, 286, 295, 0, 0, 342, 374,
-	213, 433, 394, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
-	482, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 483, 0, 181, 196, 291, 0, 363, 253, 461,
-	441, 437, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 183, 184, 197,
-	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
-	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
-	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
-	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
-	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
-	432, 445, 450, 262, 428, 451, 0, 300, 0, 0,
-	302, 247, 265, 276, 0, 440, 399, 201, 370, 254,
-	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
-	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
-	409, 314, 233, 349, 410, 0, 298, 411, 412, 272,
-	0, 0, 0, 0, 0, 0, 333, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	289, 234, 0, 0, 347, 0, 187, 0, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 0, 293, 0, 0, 395, 318, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 0,
-	0, 0, 0, 0, 232, 277, 239, 231, 414, 0,
-	0, 0, 0, 203, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 319, 0,
-	0, 0, 0, 447, 0, 0, 0, 0, 0, 0,
-	0, 288, 0, 285, 182, 199, 0, 0, 329, 369,
-	375, 0, 0, 0, 223, 0, 373, 343, 431, 207,
-	250, 366, 348, 371, 0, 0, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 0, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 0, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 0, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 324, 270, 393, 286, 295, 0, 0,
-	342, 374, 213, 433, 394, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 483, 0, 181, 196, 291, 1465, 363,
-	253, 461, 441, 437, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	0, 0, 302, 247, 265, 276, 0, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 410, 0, 298, 411,
-	412, 272, 0, 0, 0, 0, 0, 0, 333, 0,
-	1346, 0, 0, 0, 0, 0, 0, 237, 0, 0,
-	0, 0, 289, 234, 0, 0, 347, 0, 187, 0,
-	386, 222, 299, 296, 417, 248, 240, 236, 221, 273,
-	305, 345, 404, 339, 0, 293, 0, 0, 395, 318,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 220, 186, 330, 396,
-	252, 0, 0, 0, 0, 178, 179, 180, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 211, 0,
-	218, 0, 0, 0, 0, 0, 232, 277, 239, 231,
-	414, 0, 0, 0, 0, 203, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	319, 0, 0, 0, 0, 447, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 182, 199, 0, 0,
-	329, 369, 375, 0, 0, 0, 223, 0, 373, 343,
-	431, 207, 250, 366, 348, 371, 0, 0, 372, 294,
-	419, 361, 429, 448, 449, 230, 323, 438, 408, 444,
-	460, 200, 227, 337, 401, 434, 392, 316, 415, 416,
-	284, 391, 258, 185, 292, 454, 198, 381, 215, 205,
-	191, 403, 427, 212, 384, 0, 0, 462, 193, 425,
-	400, 312, 281, 282, 192, 0, 365, 235, 256, 225,
-	332, 422, 423, 224, 463, 202, 443, 195, 0, 442,
-	325, 418, 426, 313, 304, 194, 424, 311, 303, 287,
-	246, 267, 359, 297, 360, 268, 321, 320, 322, 188,
-	435, 0, 189, 0, 397, 436, 464, 208, 209, 210,
-	0, 245, 249, 255, 257, 263, 264, 271, 290, 336,
-	358, 356, 362, 0, 413, 430, 439, 446, 452, 453,
-	455, 456, 457, 458, 459, 324, 270, 393, 286, 295,
-	0, 0, 342, 374, 213, 433, 394, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	478, 479, 480, 481, 482, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 483, 0, 181, 196, 291,
-	0, 363, 253, 461, 441, 437, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 184, 197, 206, 216, 228, 243, 251, 261,
-	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
-	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
-	344, 351, 352, 353, 354, 355, 357, 364, 368, 376,
-	377, 378, 379, 380, 382, 383, 387, 388, 389, 390,
-	398, 402, 420, 421, 432, 445, 450, 262, 428, 451,
-	0, 300, 0, 0, 302, 247, 265, 276, 0, 440,
-	399, 201, 370, 254, 190, 219, 204, 226, 241, 244,
-	280, 310, 317, 346, 350, 259, 238, 217, 367, 214,
-	385, 405, 406, 407, 409, 314, 233, 349, 410, 0,
-	298, 411, 412, 272, 0, 0, 0, 0, 0, 0,
-	333, 0, 1344, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 289, 234, 0, 0, 347, 0,
-	187, 0, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 0, 293, 0, 0,
-	395, 318, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 220, 186,
-	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 0, 0, 0, 0, 0, 232, 277,
-	239, 231, 414, 0, 0, 0, 0, 203, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 242, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	260, 0, 319, 0, 0, 0, 0, 447, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 182, 199,
-	0, 0, 329, 369, 375, 0, 0, 0, 223, 0,
-	373, 343, 431, 207, 250, 366, 348, 371, 0, 0,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 427, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	0, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 0, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 0, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 324, 270, 393,
-	286, 295, 0, 0, 342, 374, 213, 433, 394, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 483, 0, 181,
-	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 0, 0, 302, 247, 265, 276,
-	0, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	410, 0, 298, 411, 412, 272, 0, 0, 0, 0,
-	0, 0, 333, 0, 1342, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 289, 234, 0, 0,
-	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
-	240, 236, 221, 273, 305, 345, 404, 339, 0, 293,
-	0, 0, 395, 318, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	220, 186, 330, 396, 252, 0, 0, 0, 0, 178,
-	179, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 211, 0, 218, 0, 0, 0, 0, 0,
-	232, 277, 239, 231, 414, 0, 0, 0, 0, 203,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 319, 0, 0, 0, 0, 447,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	182, 199, 0, 0, 329, 369, 375, 0, 0, 0,
-	223, 0, 373, 343, 431, 207, 250, 366, 348, 371,
-	0, 0, 372, 294, 419, 361, 429, 448, 449, 230,
-	323, 438, 408, 444, 460, 200, 227, 337, 401, 434,
-	392, 316, 415, 416, 284, 391, 258, 185, 292, 454,
-	198, 381, 215, 205, 191, 403, 427, 212, 384, 0,
-	0, 462, 193, 425, 400, 312, 281, 282, 192, 0,
-	365, 235, 256, 225, 332, 422, 423, 224, 463, 202,
-	443, 195, 0, 442, 325, 418, 426, 313, 304, 194,
-	424, 311, 303, 287, 246, 267, 359, 297, 360, 268,
-	321, 320, 322, 188, 435, 0, 189, 0, 397, 436,
-	464, 208, 209, 210, 0, 245, 249, 255, 257, 263,
-	264, 271, 290, 336, 358, 356, 362, 0, 413, 430,
-	439, 446, 452, 453, 455, 456, 457, 458, 459, 324,
-	270, 393, 286, 295, 0, 0, 342, 374, 213, 433,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 481, 482, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 483,
-	0, 181, 196, 291, 0, 363, 253, 461, 441, 437,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 183, 184, 197, 206, 216,
-	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
-	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
-	335, 338, 340, 341, 344, 351, 352, 353, 354, 355,
-	357, 364, 368, 376, 377, 378, 379, 380, 382, 383,
-	387, 388, 389, 390, 398, 402, 420, 421, 432, 445,
-	450, 262, 428, 451, 0, 300, 0, 0, 302, 247,
-	265, 276, 0, 440, 399, 201, 370, 254, 190, 219,
-	204, 226, 241, 244, 280, 310, 317, 346, 350, 259,
-	238, 217, 367, 214, 385, 405, 406, 407, 409, 314,
-	233, 349, 410, 0, 298, 411, 412, 272, 0, 0,
-	0, 0, 0, 0, 333, 0, 1340, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 289, 234,
-	0, 0, 347, 0, 187, 0, 386, 222, 299, 296,
-	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
-	0, 293, 0, 0, 395, 318, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 220, 186, 330, 396, 252, 0, 0, 0,
-	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 211, 0, 218, 0, 0, 0,
-	0, 0, 232, 277, 239, 231, 414, 0, 0, 0,
-	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 242, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 260, 0, 319, 0, 0, 0,
-	0, 447, 0, 0, 0, 0, 0, 0, 0, 288,
-	0, 285, 182, 199, 0, 0, 329, 369, 375, 0,
-	0, 0, 223, 0, 373, 343, 431, 207, 250, 366,
-	348, 371, 0, 0, 372, 294, 419, 361, 429, 448,
-	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
-	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
-	292, 454, 198, 381, 215, 205, 191, 403, 427, 212,
-	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
-	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
-	463, 202, 443, 195, 0, 442, 325, 418, 426, 313,
-	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
-	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
-	397, 436, 464, 208, 209, 210, 0, 245, 249, 255,
-	257, 263, 264, 271, 290, 336, 358, 356, 362, 0,
-	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
-	459, 324, 270, 393, 286, 295, 0, 0, 342, 374,
-	213, 433, 394, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 465, 466, 467, 468, 469, 470, 471,
 	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
-	482, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 483, 0, 181, 196, 291, 0, 363, 253, 461,
-	441, 437, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 183, 184, 197,
-	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
-	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
-	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
-	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
-	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
-	432, 445, 450, 262, 428, 451, 0, 300, 0, 0,
-	302, 247, 265, 276, 0, 440, 399, 201, 370, 254,
-	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
-	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
-	409, 314, 233, 349, 410, 0, 298, 411, 412, 272,
-	0, 0, 0, 0, 0, 0, 333, 0, 1338, 0,
-	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	289, 234, 0, 0, 347, 0, 187, 0, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 0, 293, 0, 0, 395, 318, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 0,
-	0, 0, 0, 0, 232, 277, 239, 231, 414, 0,
-	0, 0, 0, 203, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 319, 0,
-	0, 0, 0, 447, 0, 0, 0, 0, 0, 0,
-	0, 288, 0, 285, 182, 199, 0, 0, 329, 369,
-	375, 0, 0, 0, 223, 0, 373, 343, 431, 207,
-	250, 366, 348, 371, 0, 0, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 0, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 0, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 0, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 324, 270, 393, 286, 295, 0, 0,
-	342, 374, 213, 433, 394, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 483, 0, 181, 196, 291, 0, 363,
-	253, 461, 441, 437, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	0, 0, 302, 247, 265, 276, 0, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 410, 0, 298, 411,
-	412, 272, 0, 0, 0, 0, 0, 0, 333, 0,
-	1334, 0, 0, 0, 0, 0, 0, 237, 0, 0,
-	0, 0, 289, 234, 0, 0, 347, 0, 187, 0,
-	386, 222, 299, 296, 417, 248, 240, 236, 221, 273,
-	305, 345, 404, 339, 0, 293, 0, 0, 395, 318,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 220, 186, 330, 396,
-	252, 0, 0, 0, 0, 178, 179, 180, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 211, 0,
-	218, 0, 0, 0, 0, 0, 232, 277, 239, 231,
-	414, 0, 0, 0, 0, 203, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	319, 0, 0, 0, 0, 447, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 182, 199, 0, 0,
-	329, 369, 375, 0, 0, 0, 223, 0, 373, 343,
-	431, 207, 250, 366, 348, 371, 0, 0, 372, 294,
-	419, 361, 429, 448, 449, 230, 323, 438, 408, 444,
-	460, 200, 227, 337, 401, 434, 392, 316, 415, 416,
-	284, 391, 258, 185, 292, 454, 198, 381, 215, 205,
-	191, 403, 427, 212, 384, 0, 0, 462, 193, 425,
-	400, 312, 281, 282, 192, 0, 365, 235, 256, 225,
-	332, 422, 423, 224, 463, 202, 443, 195, 0, 442,
-	325, 418, 426, 313, 304, 194, 424, 311, 303, 287,
-	246, 267, 359, 297, 360, 268, 321, 320, 322, 188,
-	435, 0, 189, 0, 397, 436, 464, 208, 209, 210,
-	0, 245, 249, 255, 257, 263, 264, 271, 290, 336,
-	358, 356, 362, 0, 413, 430, 439, 446, 452, 453,
-	455, 456, 457, 458, 459, 324, 270, 393, 286, 295,
-	0, 0, 342, 374, 213, 433, 394, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	478, 479, 480, 481, 482, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 483, 0, 181, 196, 291,
-	0, 363, 253, 461, 441, 437, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 184, 197, 206, 216, 228, 243, 251, 261,
-	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
-	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
-	344, 351, 352, 353, 354, 355, 357, 364, 368, 376,
-	377, 378, 379, 380, 382, 383, 387, 388, 389, 390,
-	398, 402, 420, 421, 432, 445, 450, 262, 428, 451,
-	0, 300, 0, 0, 302, 247, 265, 276, 0, 440,
-	399, 201, 370, 254, 190, 219, 204, 226, 241, 244,
-	280, 310, 317, 346, 350, 259, 238, 217, 367, 214,
-	385, 405, 406, 407, 409, 314, 233, 349, 410, 0,
-	298, 411, 412, 272, 0, 0, 0, 0, 0, 0,
-	333, 0, 1332, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 289, 234, 0, 0, 347, 0,
-	187, 0, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 0, 293, 0, 0,
-	395, 318, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 220, 186,
-	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 0, 0, 0, 0, 0, 232, 277,
-	239, 231, 414, 0, 0, 0, 0, 203, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 242, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	260, 0, 319, 0, 0, 0, 0, 447, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 182, 199,
-	0, 0, 329, 369, 375, 0, 0, 0, 223, 0,
-	373, 343, 431, 207, 250, 366, 348, 371, 0, 0,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 427, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	0, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 0, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 0, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 324, 270, 393,
-	286, 295, 0, 0, 342, 374, 213, 433, 394, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 483, 0, 181,
-	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 0, 0, 302, 247, 265, 276,
-	0, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	410, 0, 298, 411, 412, 272, 0, 0, 0, 0,
-	0, 0, 333, 0, 1330, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 289, 234, 0, 0,
-	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
-	240, 236, 221, 273, 305, 345, 404, 339, 0, 293,
-	0, 0, 395, 318, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	220, 186, 330, 396, 252, 0, 0, 0, 0, 178,
-	179, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 211, 0, 218, 0, 0, 0, 0, 0,
-	232, 277, 239, 231, 414, 0, 0, 0, 0, 203,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 260, 0, 319, 0, 0, 0, 0, 447,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	182, 199, 0, 0, 329, 369, 375, 0, 0, 0,
-	223, 0, 373, 343, 431, 207, 250, 366, 348, 371,
-	0, 0, 372, 294, 419, 361, 429, 448, 449, 230,
-	323, 438, 408, 444, 460, 200, 227, 337, 401, 434,
-	392, 316, 415, 416, 284, 391, 258, 185, 292, 454,
-	198, 381, 215, 205, 191, 403, 427, 212, 384, 0,
-	0, 462, 193, 425, 400, 312, 281, 282, 192, 0,
-	365, 235, 256, 225, 332, 422, 423, 224, 463, 202,
-	443, 195, 0, 442, 325, 418, 426, 313, 304, 194,
-	424, 311, 303, 287, 246, 267, 359, 297, 360, 268,
-	321, 320, 322, 188, 435, 0, 189, 0, 397, 436,
-	464, 208, 209, 210, 0, 245, 249, 255, 257, 263,
-	264, 271, 290, 336, 358, 356, 362, 0, 413, 430,
-	439, 446, 452, 453, 455, 456, 457, 458, 459, 324,
-	270, 393, 286, 295, 0, 0, 342, 374, 213, 433,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 481, 482, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 483,
-	0, 181, 196, 291, 0, 363, 253, 461, 441, 437,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 183, 184, 197, 206, 216,
-	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
-	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
-	335, 338, 340, 341, 344, 351, 352, 353, 354, 355,
-	357, 364, 368, 376, 377, 378, 379, 380, 382, 383,
-	387, 388, 389, 390, 398, 402, 420, 421, 432, 445,
-	450, 262, 428, 451, 0, 300, 0, 0, 302, 247,
-	265, 276, 0, 440, 399, 201, 370, 254, 190, 219,
-	204, 226, 241, 244, 280, 310, 317, 346, 350, 259,
-	238, 217, 367, 214, 385, 405, 406, 407, 409, 314,
-	233, 349, 410, 0, 298, 411, 412, 272, 0, 0,
-	0, 0, 0, 0, 333, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 289, 234,
-	0, 0, 347, 0, 187, 0, 386, 222, 299, 296,
-	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
-	0, 293, 0, 0, 395, 318, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 220, 186, 330, 396, 252, 0, 1307, 0,
-	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 211, 0, 218, 0, 0, 0,
-	0, 0, 232, 277, 239, 231, 414, 0, 0, 0,
-	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 242, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 260, 0, 319, 0, 0, 0,
-	0, 447, 0, 0, 0, 0, 0, 0, 0, 288,
-	0, 285, 182, 199, 0, 0, 329, 369, 375, 0,
-	0, 0, 223, 0, 373, 343, 431, 207, 250, 366,
-	348, 371, 0, 0, 372, 294, 419, 361, 429, 448,
-	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
-	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
-	292, 454, 198, 381, 215, 205, 191, 403, 427, 212,
-	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
-	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
-	463, 202, 443, 195, 0, 442, 325, 418, 426, 313,
-	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
-	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
-	397, 436, 464, 208, 209, 210, 0, 245, 249, 255,
-	257, 263, 264, 271, 290, 336, 358, 356, 362, 0,
-	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
-	459, 324, 270, 393, 286, 295, 0, 0, 342, 374,
-	213, 433, 394, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 465, 466, 467, 468, 469, 470, 471,
+	482, 483, 484, 485, 486, 487, 488, 489, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 490, 0,
+	183, 198, 294, 0, 367, 256, 468, 448, 444, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 185, 186, 199, 208, 218, 231,
+	246, 254, 264, 269, 272, 277, 278, 281, 286, 304,
+	309, 310, 311, 312, 330, 331, 332, 335, 338, 339,
+	342, 344, 345, 348, 355, 356, 357, 358, 359, 361,
+	368, 372, 381, 382, 383, 384, 385, 387, 388, 394,
+	395, 396, 397, 405, 409, 427, 428, 439, 452, 457,
+	224, 390, 392, 265, 435, 458, 0, 303, 0, 0,
+	305, 250, 268, 279, 0, 447, 406, 203, 375, 257,
+	192, 221, 206, 229, 244, 247, 283, 314, 321, 350,
+	354, 262, 241, 219, 371, 216, 391, 412, 413, 414,
+	416, 318, 236, 353, 417, 0, 301, 418, 419, 275,
+	0, 0, 0, 0, 0, 0, 337, 0, 0, 0,
+	0, 0, 0, 0, 0, 240, 0, 0, 0, 0,
+	292, 237, 0, 0, 351, 0, 189, 0, 393, 225,
+	302, 299, 424, 251, 243, 239, 223, 276, 308, 349,
+	411, 343, 0, 296, 0, 0, 402, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 222, 188, 334, 403, 255, 0,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 213, 0, 220, 0,
+	0, 0, 0, 0, 235, 280, 242, 234, 421, 0,
+	0, 0, 0, 205, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1113, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 263, 0, 323, 0,
+	0, 0, 0, 454, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 288, 184, 201, 0, 0, 333, 373,
+	380, 0, 0, 0, 226, 0, 378, 347, 438, 209,
+	253, 370, 352, 376, 0, 0, 377, 297, 426, 365,
+	436, 455, 456, 233, 327, 445, 415, 451, 467, 202,
+	230, 341, 408, 441, 399, 320, 422, 423, 287, 398,
+	261, 187, 295, 461, 200, 386, 217, 207, 313, 374,
+	193, 410, 434, 214, 389, 0, 0, 469, 195, 432,
+	407, 316, 284, 285, 194, 0, 369, 238, 259, 228,
+	336, 429, 430, 227, 470, 204, 450, 197, 0, 449,
+	329, 425, 433, 317, 307, 196, 431, 315, 306, 290,
+	249, 270, 363, 300, 364, 271, 325, 324, 326, 190,
+	442, 0, 191, 0, 404, 443, 471, 210, 211, 212,
+	0, 248, 252, 258, 260, 266, 267, 274, 293, 340,
+	362, 360, 366, 0, 420, 437, 446, 453, 459, 460,
+	462, 463, 464, 465, 466, 328, 273, 400, 289, 298,
+	0, 0, 346, 379, 215, 440, 401, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 472, 473, 474,
+	475, 476, 477, 478, 479, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 490, 0, 183, 198, 294,
+	0, 367, 256, 468, 448, 444, 0, 0, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	319, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 185, 186, 199, 208, 218, 231, 246, 254, 264,
+	269, 272, 277, 278, 281, 286, 304, 309, 310, 311,
+	312, 330, 331, 332, 335, 338, 339, 342, 344, 345,
+	348, 355, 356, 357, 358, 359, 361, 368, 372, 381,
+	382, 383, 384, 385, 387, 388, 394, 395, 396, 397,
+	405, 409, 427, 428, 439, 452, 457, 224, 390, 392,
+	265, 435, 458, 0, 303, 0, 0, 305, 250, 268,
+	279, 0, 447, 406, 203, 375, 257, 192, 221, 206,
+	229, 244, 247, 283, 314, 321, 350, 354, 262, 241,
+	219, 371, 216, 391, 412, 413, 414, 416, 318, 236,
+	353, 417, 0, 301, 418, 419, 275, 0, 0, 0,
+	0, 0, 0, 337, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 0, 0, 0, 0, 292, 237, 0,
+	0, 351, 0, 189, 0, 393, 225, 302, 299, 424,
+	251, 243, 239, 223, 276, 308, 349, 411, 343, 0,
+	296, 0, 0, 402, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 222, 188, 334, 403, 255, 0, 0, 0, 0,
+	180, 181, 182, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 213, 0, 220, 0, 0, 0, 0,
+	0, 235, 280, 242, 234, 421, 0, 0, 0, 0,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 263, 0, 323, 0, 0, 0, 0,
+	454, 0, 0, 0, 2526, 0, 0, 0, 291, 0,
+	288, 184, 201, 0, 0, 333, 373, 380, 0, 0,
+	0, 226, 0, 378, 347, 438, 209, 253, 370, 352,
+	376, 0, 0, 377, 297, 426, 365, 436, 455, 456,
+	233, 327, 445, 415, 451, 467, 202, 230, 341, 408,
+	441, 399, 320, 422, 423, 287, 398, 261, 187, 295,
+	461, 200, 386, 217, 207, 313, 374, 193, 410, 434,
+	214, 389, 0, 0, 469, 195, 432, 407, 316, 284,
+	285, 194, 0, 369, 238, 259, 228, 336, 429, 430,
+	227, 470, 204, 450, 197, 0, 449, 329, 425, 433,
+	317, 307, 196, 431, 315, 306, 290, 249, 270, 363,
+	300, 364, 271, 325, 324, 326, 190, 442, 0, 191,
+	0, 404, 443, 471, 210, 211, 212, 0, 248, 252,
+	258, 260, 266, 267, 274, 293, 340, 362, 360, 366,
+	0, 420, 437, 446, 453, 459, 460, 462, 463, 464,
+	465, 466, 328, 273, 400, 289, 298, 0, 0, 346,
+	379, 215, 440, 401, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 472, 473, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 490, 0, 183, 198, 294, 0, 367, 256,
+	468, 448, 444, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 185, 186,
+	199, 208, 218, 231, 246, 254, 264, 269, 272, 277,
+	278, 281, 286, 304, 309, 310, 311, 312, 330, 331,
+	332, 335, 338, 339, 342, 344, 345, 348, 355, 356,
+	357, 358, 359, 361, 368, 372, 381, 382, 383, 384,
+	385, 387, 388, 394, 395, 396, 397, 405, 409, 427,
+	428, 439, 452, 457, 224, 390, 392, 265, 435, 458,
+	0, 303, 0, 0, 305, 250, 268, 279, 0, 447,
+	406, 203, 375, 257, 192, 221, 206, 229, 244, 247,
+	283, 314, 321, 350, 354, 262, 241, 219, 371, 216,
+	391, 412, 413, 414, 416, 318, 236, 353, 417, 0,
+	301, 418, 419, 275, 0, 0, 0, 0, 0, 0,
+	337, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	0, 0, 0, 0, 292, 237, 0, 0, 351, 0,
+	189, 0, 393, 225, 302, 299, 424, 251, 243, 239,
+	223, 276, 308, 349, 411, 343, 0, 296, 0, 0,
+	402, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 222, 188,
+	334, 403, 255, 0, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 220, 0, 0, 0, 0, 0, 235, 280,
+	242, 234, 421, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 323, 0, 0, 0, 0, 454, 0, 0,
+	0, 2444, 0, 0, 0, 291, 0, 288, 184, 201,
+	0, 0, 333, 373, 380, 0, 0, 0, 226, 0,
+	378, 347, 438, 209, 253, 370, 352, 376, 0, 0,
+	377, 297, 426, 365, 436, 455, 456, 233, 327, 445,
+	415, 451, 467, 202, 230, 341, 408, 441, 399, 320,
+	422, 423, 287, 398, 261, 187, 295, 461, 200, 386,
+	217, 207, 313, 374, 193, 410, 434, 214, 389, 0,
+	0, 469, 195, 432, 407, 316, 284, 285, 194, 0,
+	369, 238, 259, 228, 336, 429, 430, 227, 470, 204,
+	450, 197, 0, 449, 329, 425, 433, 317, 307, 196,
+	431, 315, 306, 290, 249, 270, 363, 300, 364, 271,
+	325, 324, 326, 190, 442, 0, 191, 0, 404, 443,
+	471, 210, 211, 212, 0, 248, 252, 258, 260, 266,
+	267, 274, 293, 340, 362, 360, 366, 0, 420, 437,
+	446, 453, 459, 460, 462, 463, 464, 465, 466, 328,
+	273, 400, 289, 298, 0, 0, 346, 379, 215, 440,
+	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 183, 198, 294, 0, 367, 256, 468, 448, 444,
+	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 319, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 186, 199, 208, 218,
+	231, 246, 254, 264, 269, 272, 277, 278, 281, 286,
+	304, 309, 310, 311, 312, 330, 331, 332, 335, 338,
+	339, 342, 344, 345, 348, 355, 356, 357, 358, 359,
+	361, 368, 372, 381, 382, 383, 384, 385, 387, 388,
+	394, 395, 396, 397, 405, 409, 427, 428, 439, 452,
+	457, 224, 390, 392, 265, 435, 458, 0, 303, 0,
+	0, 305, 250, 268, 279, 0, 447, 406, 203, 375,
+	257, 192, 221, 206, 229, 244, 247, 283, 314, 321,
+	350, 354, 262, 241, 219, 371, 216, 391, 412, 413,
+	414, 416, 318, 236, 353, 417, 0, 301, 418, 419,
+	275, 0, 0, 0, 0, 0, 0, 337, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 292, 237, 0, 0, 351, 0, 189, 0, 393,
+	225, 302, 299, 424, 251, 243, 239, 223, 276, 308,
+	349, 411, 343, 0, 296, 0, 0, 402, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 222, 188, 334, 403, 255,
+	0, 83, 0, 0, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 0, 220,
+	0, 0, 0, 0, 0, 235, 280, 242, 234, 421,
+	0, 0, 0, 0, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 263, 0, 323,
+	0, 0, 0, 0, 454, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 288, 184, 201, 0, 0, 333,
+	373, 380, 0, 0, 0, 226, 0, 378, 347, 438,
+	209, 253, 370, 352, 376, 0, 0, 377, 297, 426,
+	365, 436, 455, 456, 233, 327, 445, 415, 451, 467,
+	202, 230, 341, 408, 441, 399, 320, 422, 423, 287,
+	398, 261, 187, 295, 461, 200, 386, 217, 207, 313,
+	374, 193, 410, 434, 214, 389, 0, 0, 469, 195,
+	432, 407, 316, 284, 285, 194, 0, 369, 238, 259,
+	228, 336, 429, 430, 227, 470, 204, 450, 197, 0,
+	449, 329, 425, 433, 317, 307, 196, 431, 315, 306,
+	290, 249, 270, 363, 300, 364, 271, 325, 324, 326,
+	190, 442, 0, 191, 0, 404, 443, 471, 210, 211,
+	212, 0, 248, 252, 258, 260, 266, 267, 274, 293,
+	340, 362, 360, 366, 0, 420, 437, 446, 453, 459,
+	460, 462, 463, 464, 465, 466, 328, 273, 400, 289,
+	298, 0, 0, 346, 379, 215, 440, 401, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 472, 473,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 490, 0, 183, 198,
+	294, 0, 367, 256, 468, 448, 444, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 186, 199, 208, 218, 231, 246, 254,
+	264, 269, 272, 277, 278, 281, 286, 304, 309, 310,
+	311, 312, 330, 331, 332, 335, 338, 339, 342, 344,
+	345, 348, 355, 356, 357, 358, 359, 361, 368, 372,
+	381, 382, 383, 384, 385, 387, 388, 394, 395, 396,
+	397, 405, 409, 427, 428, 439, 452, 457, 224, 390,
+	392, 265, 435, 458, 0, 303, 0, 0, 305, 250,
+	268, 279, 0, 447, 406, 203, 375, 257, 192, 221,
+	206, 229, 244, 247, 283, 314, 321, 350, 354, 262,
+	241, 219, 371, 216, 391, 412, 413, 414, 416, 318,
+	236, 353, 417, 0, 301, 418, 419, 275, 0, 0,
+	0, 0, 0, 0, 337, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 292, 237,
+	0, 0, 351, 0, 189, 0, 393, 225, 302, 299,
+	424, 251, 243, 239, 223, 276, 308, 349, 411, 343,
+	0, 296, 0, 0, 402, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 222, 188, 334, 403, 255, 0, 0, 0,
+	0, 180, 181, 182, 0, 1388, 0, 0, 0, 0,
+	0, 0, 0, 0, 213, 0, 220, 0, 0, 0,
+	0, 0, 235, 280, 242, 234, 421, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 263, 0, 323, 0, 0, 0,
+	0, 454, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 288, 184, 201, 0, 0, 333, 373, 380, 0,
+	0, 0, 226, 0, 378, 347, 438, 209, 253, 370,
+	352, 376, 0, 0, 377, 297, 426, 365, 436, 455,
+	456, 233, 327, 445, 415, 451, 467, 202, 230, 341,
+	408, 441, 399, 320, 422, 423, 287, 398, 261, 187,
+	295, 461, 200, 386, 217, 207, 313, 374, 193, 410,
+	434, 214, 389, 0, 0, 469, 195, 432, 407, 316,
+	284, 285, 194, 0, 369, 238, 259, 228, 336, 429,
+	430, 227, 470, 204, 450, 197, 0, 449, 329, 425,
+	433, 317, 307, 196, 431, 315, 306, 290, 249, 270,
+	363, 300, 364, 271, 325, 324, 326, 190, 442, 0,
+	191, 0, 404, 443, 471, 210, 211, 212, 0, 248,
+	252, 258, 260, 266, 267, 274, 293, 340, 362, 360,
+	366, 0, 420, 437, 446, 453, 459, 460, 462, 463,
+	464, 465, 466, 328, 273, 400, 289, 298, 0, 0,
+	346, 379, 215, 440, 401, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 472, 473, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 490, 0, 183, 198, 294, 0, 367,
+	256, 468, 448, 444, 0, 0, 232, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
+	186, 199, 208, 218, 231, 246, 254, 264, 269, 272,
+	277, 278, 281, 286, 304, 309, 310, 311, 312, 330,
+	331, 332, 335, 338, 339, 342, 344, 345, 348, 355,
+	356, 357, 358, 359, 361, 368, 372, 381, 382, 383,
+	384, 385, 387, 388, 394, 395, 396, 397, 405, 409,
+	427, 428, 439, 452, 457, 224, 390, 392, 265, 435,
+	458, 0, 303, 0, 0, 305, 250, 268, 279, 0,
+	447, 406, 203, 375, 257, 192, 221, 206, 229, 244,
+	247, 283, 314, 321, 350, 354, 262, 241, 219, 371,
+	216, 391, 412, 413, 414, 416, 318, 236, 353, 0,
+	417, 301, 418, 419, 275, 1640, 0, 0, 0, 0,
+	0, 0, 337, 0, 0, 0, 0, 0, 0, 0,
+	0, 240, 0, 0, 0, 0, 292, 237, 0, 0,
+	351, 0, 189, 0, 393, 225, 302, 299, 424, 251,
+	243, 239, 223, 276, 308, 349, 411, 343, 0, 296,
+	0, 0, 402, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	222, 188, 334, 403, 255, 0, 0, 0, 0, 180,
+	181, 182, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 213, 0, 220, 0, 0, 0, 0, 0,
+	235, 280, 242, 234, 421, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 263, 0, 323, 0, 0, 0, 0, 454,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 288,
+	184, 201, 0, 0, 333, 373, 380, 0, 0, 0,
+	226, 0, 378, 347, 438, 209, 253, 370, 352, 376,
+	0, 0, 377, 297, 426, 365, 436, 455, 456, 233,
+	327, 445, 415, 451, 467, 202, 230, 341, 408, 441,
+	399, 320, 422, 423, 287, 398, 261, 187, 295, 461,
+	200, 386, 217, 207, 313, 374, 193, 410, 434, 214,
+	389, 0, 0, 469, 195, 432, 407, 316, 284, 285,
+	194, 0, 369, 238, 259, 228, 336, 429, 430, 227,
+	470, 204, 450, 197, 0, 449, 329, 425, 433, 317,
+	307, 196, 431, 315, 306, 290, 249, 270, 363, 300,
+	364, 271, 325, 324, 326, 190, 442, 0, 191, 0,
+	404, 443, 471, 210, 211, 212, 0, 248, 252, 258,
+	260, 266, 267, 274, 293, 340, 362, 360, 366, 0,
+	420, 437, 446, 453, 459, 460, 462, 463, 464, 465,
+	466, 328, 273, 400, 289, 298, 0, 0, 346, 379,
+	215, 440, 401, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 472, 473, 474, 475, 476, 477, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 490, 0, 183, 198, 294, 0, 367, 256, 468,
+	448, 444, 0, 0, 232, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 319, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 186, 199,
+	208, 218, 231, 246, 254, 264, 269, 272, 277, 278,
+	281, 286, 304, 309, 310, 311, 312, 330, 331, 332,
+	335, 338, 339, 342, 344, 345, 348, 355, 356, 357,
+	358, 359, 361, 368, 372, 381, 382, 383, 384, 385,
+	387, 388, 394, 395, 396, 397, 405, 409, 427, 428,
+	439, 452, 457, 224, 390, 392, 265, 435, 458, 0,
+	303, 0, 0, 305, 250, 268, 279, 0, 447, 406,
+	203, 375, 257, 192, 221, 206, 229, 244, 247, 283,
+	314, 321, 350, 354, 262, 241, 219, 371, 216, 391,
+	412, 413, 414, 416, 318, 236, 353, 417, 0, 301,
+	418, 419, 275, 0, 0, 0, 0, 0, 0, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
+	0, 0, 0, 292, 237, 0, 0, 351, 0, 189,
+	0, 393, 225, 302, 299, 424, 251, 243, 239, 223,
+	276, 308, 349, 411, 343, 0, 296, 0, 0, 402,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 222, 188, 334,
+	403, 255, 0, 0, 0, 0, 180, 181, 182, 0,
+	1217, 0, 0, 0, 0, 0, 0, 0, 0, 213,
+	0, 220, 0, 0, 0, 0, 0, 235, 280, 242,
+	234, 421, 0, 0, 0, 0, 205, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
+	0, 323, 0, 0, 0, 0, 454, 0, 0, 0,
+	0, 0, 0, 0, 291, 0, 288, 184, 201, 0,
+	0, 333, 373, 380, 0, 0, 0, 226, 0, 378,
+	347, 438, 209, 253, 370, 352, 376, 0, 0, 377,
+	297, 426, 365, 436, 455, 456, 233, 327, 445, 415,
+	451, 467, 202, 230, 341, 408, 441, 399, 320, 422,
+	423, 287, 398, 261, 187, 295, 461, 200, 386, 217,
+	207, 313, 374, 193, 410, 434, 214, 389, 0, 0,
+	469, 195, 432, 407, 316, 284, 285, 194, 0, 369,
+	238, 259, 228, 336, 429, 430, 227, 470, 204, 450,
+	197, 0, 449, 329, 425, 433, 317, 307, 196, 431,
+	315, 306, 290, 249, 270, 363, 300, 364, 271, 325,
+	324, 326, 190, 442, 0, 191, 0, 404, 443, 471,
+	210, 211, 212, 0, 248, 252, 258, 260, 266, 267,
+	274, 293, 340, 362, 360, 366, 0, 420, 437, 446,
+	453, 459, 460, 462, 463, 464, 465, 466, 328, 273,
+	400, 289, 298, 0, 0, 346, 379, 215, 440, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
-	482, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 483, 0, 181, 196, 291, 0, 363, 253, 461,
-	441, 437, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 183, 184, 197,
-	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
-	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
-	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
-	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
-	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
-	432, 445, 450, 262, 428, 451, 0, 300, 0, 0,
-	302, 247, 265, 276, 0, 440, 399, 201, 370, 254,
-	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
-	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
-	409, 314, 233, 349, 410, 0, 298, 411, 412, 272,
-	0, 0, 0, 0, 0, 0, 333, 0, 0, 0,
-	0, 0, 0, 0, 1209, 237, 0, 0, 0, 0,
-	289, 234, 0, 0, 347, 0, 187, 0, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 0, 293, 0, 0, 395, 318, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 0,
-	0, 0, 0, 0, 232, 277, 239, 231, 414, 0,
-	0, 0, 0, 203, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 319, 0,
-	0, 0, 0, 447, 0, 0, 0, 0, 0, 0,
-	0, 288, 0, 285, 182, 199, 0, 0, 329, 369,
-	375, 0, 0, 0, 223, 0, 373, 343, 431, 207,
-	250, 366, 348, 371, 0, 0, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 0, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 0, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 0, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 324, 270, 393, 286, 295, 0, 0,
-	342, 374, 213, 433, 394, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 483, 0, 181, 196, 291, 0, 363,
-	253, 461, 441, 437, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	0, 0, 302, 247, 265, 276, 0, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 410, 0, 298, 411,
-	412, 272, 0, 0, 0, 0, 0, 0, 333, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 0, 0,
-	0, 0, 289, 234, 0, 0, 347, 0, 187, 0,
-	386, 222, 299, 296, 417, 248, 240, 236, 221, 273,
-	305, 345, 404, 339, 0, 293, 0, 0, 395, 318,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 220, 186, 330, 396,
-	252, 0, 0, 0, 0, 178, 179, 180, 0, 1048,
-	0, 0, 0, 0, 0, 0, 0, 0, 211, 0,
-	218, 0, 0, 0, 0, 0, 232, 277, 239, 231,
-	414, 0, 0, 0, 0, 203, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 260, 0,
-	319, 0, 0, 0, 0, 447, 0, 0, 0, 0,
-	0, 0, 0, 288, 0, 285, 182, 199, 0, 0,
-	329, 369, 375, 0, 0, 0, 223, 0, 373, 343,
-	431, 207, 250, 366, 348, 371, 0, 0, 372, 294,
-	419, 361, 429, 448, 449, 230, 323, 438, 408, 444,
-	460, 200, 227, 337, 401, 434, 392, 316, 415, 416,
-	284, 391, 258, 185, 292, 454, 198, 381, 215, 205,
-	191, 403, 427, 212, 384, 0, 0, 462, 193, 425,
-	400, 312, 281, 282, 192, 0, 365, 235, 256, 225,
-	332, 422, 423, 224, 463, 202, 443, 195, 0, 442,
-	325, 418, 426, 313, 304, 194, 424, 311, 303, 287,
-	246, 267, 359, 297, 360, 268, 321, 320, 322, 188,
-	435, 0, 189, 0, 397, 436, 464, 208, 209, 210,
-	0, 245, 249, 255, 257, 263, 264, 271, 290, 336,
-	358, 356, 362, 0, 413, 430, 439, 446, 452, 453,
-	455, 456, 457, 458, 459, 324, 270, 393, 286, 295,
-	0, 0, 342, 374, 213, 433, 394, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 465, 466, 467,
-	468, 469, 470, 471, 472, 473, 474, 475, 476, 477,
-	478, 479, 480, 481, 482, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 483, 0, 181, 196, 291,
-	0, 363, 253, 461, 441, 437, 0, 0, 229, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	315, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 183, 184, 197, 206, 216, 228, 243, 251, 261,
-	266, 269, 274, 275, 278, 283, 301, 306, 307, 308,
-	309, 326, 327, 328, 331, 334, 335, 338, 340, 341,
-	344, 351, 352, 353, 354, 355, 357, 364, 368, 376,
-	377, 378, 379, 380, 382, 383, 387, 388, 389, 390,
-	398, 402, 420, 421, 432, 445, 450, 262, 428, 451,
-	0, 300, 0, 0, 302, 247, 265, 276, 0, 440,
-	399, 201, 370, 254, 190, 219, 204, 226, 241, 244,
-	280, 310, 317, 346, 350, 259, 238, 217, 367, 214,
-	385, 405, 406, 407, 409, 314, 233, 349, 410, 0,
-	298, 411, 412, 272, 0, 0, 0, 0, 0, 0,
-	333, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 289, 234, 0, 0, 347, 0,
-	187, 0, 386, 222, 299, 296, 417, 248, 240, 236,
-	221, 273, 305, 345, 404, 339, 0, 293, 0, 0,
-	395, 318, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 220, 186,
-	330, 396, 252, 0, 0, 0, 0, 178, 179, 180,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	211, 0, 218, 0, 0, 0, 0, 0, 232, 277,
-	239, 231, 414, 0, 0, 0, 0, 203, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 242, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	260, 0, 319, 0, 0, 0, 0, 447, 0, 0,
-	0, 0, 0, 0, 0, 288, 0, 285, 182, 199,
-	0, 0, 329, 369, 375, 0, 0, 0, 223, 0,
-	373, 343, 431, 207, 250, 366, 348, 371, 0, 0,
-	372, 294, 419, 361, 429, 448, 449, 230, 323, 438,
-	408, 444, 460, 200, 227, 337, 401, 434, 392, 316,
-	415, 416, 284, 391, 258, 185, 292, 454, 198, 381,
-	215, 205, 191, 403, 427, 212, 384, 0, 0, 462,
-	193, 425, 400, 312, 281, 282, 192, 0, 365, 235,
-	256, 225, 332, 422, 423, 224, 463, 202, 443, 195,
-	0, 442, 325, 418, 426, 313, 304, 194, 424, 311,
-	303, 287, 246, 267, 359, 297, 360, 268, 321, 320,
-	322, 188, 435, 0, 189, 0, 397, 436, 464, 208,
-	209, 210, 0, 245, 249, 255, 257, 263, 264, 271,
-	290, 336, 358, 356, 362, 0, 413, 430, 439, 446,
-	452, 453, 455, 456, 457, 458, 459, 324, 270, 393,
-	286, 295, 0, 0, 342, 374, 213, 433, 394, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 465,
-	466, 467, 468, 469, 470, 471, 472, 473, 474, 475,
-	476, 477, 478, 479, 480, 481, 482, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 483, 0, 181,
-	196, 291, 0, 363, 253, 461, 441, 437, 0, 0,
-	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 315, 0, 0, 0, 0, 0, 0, 680,
-	0, 0, 0, 183, 184, 197, 206, 216, 228, 243,
-	251, 261, 266, 269, 274, 275, 278, 283, 301, 306,
-	307, 308, 309, 326, 327, 328, 331, 334, 335, 338,
-	340, 341, 344, 351, 352, 353, 354, 355, 357, 364,
-	368, 376, 377, 378, 379, 380, 382, 383, 387, 388,
-	389, 390, 398, 402, 420, 421, 432, 445, 450, 262,
-	428, 451, 0, 300, 0, 0, 302, 247, 265, 276,
-	0, 440, 399, 201, 370, 254, 190, 219, 204, 226,
-	241, 244, 280, 310, 317, 346, 350, 259, 238, 217,
-	367, 214, 385, 405, 406, 407, 409, 314, 233, 349,
-	410, 0, 298, 411, 412, 272, 0, 0, 0, 0,
-	0, 0, 333, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 289, 234, 0, 0,
-	347, 0, 187, 0, 386, 222, 299, 296, 417, 248,
-	240, 236, 221, 273, 305, 345, 404, 339, 0, 293,
-	0, 0, 395, 318, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	220, 186, 330, 396, 252, 0, 0, 0, 0, 178,
-	179, 180, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 211, 0, 218, 0, 0, 0, 0, 0,
-	232, 277, 239, 231, 414, 0, 0, 0, 0, 203,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	539, 0, 260, 0, 319, 0, 0, 0, 0, 447,
-	0, 0, 0, 0, 0, 0, 0, 288, 0, 285,
-	182, 199, 0, 0, 329, 369, 375, 0, 0, 0,
-	223, 0, 373, 343, 431, 207, 250, 366, 348, 371,
-	0, 0, 372, 294, 419, 361, 429, 448, 449, 230,
-	323, 438, 408, 444, 460, 200, 227, 337, 401, 434,
-	392, 316, 415, 416, 284, 391, 258, 185, 292, 454,
-	198, 381, 215, 205, 191, 403, 427, 212, 384, 0,
-	0, 462, 193, 425, 400, 312, 281, 282, 192, 0,
-	365, 235, 256, 225, 332, 422, 423, 224, 463, 202,
-	443, 195, 0, 442, 325, 418, 426, 313, 304, 194,
-	424, 311, 303, 287, 246, 267, 359, 297, 360, 268,
-	321, 320, 322, 188, 435, 0, 189, 0, 397, 436,
-	464, 208, 209, 210, 0, 245, 249, 255, 257, 263,
-	264, 271, 290, 336, 358, 356, 362, 0, 413, 430,
-	439, 446, 452, 453, 455, 456, 457, 458, 459, 324,
-	270, 393, 286, 295, 0, 0, 342, 374, 213, 433,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 465, 466, 467, 468, 469, 470, 471, 472, 473,
-	474, 475, 476, 477, 478, 479, 480, 481, 482, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 483,
-	0, 181, 196, 291, 0, 363, 253, 461, 441, 437,
-	0, 0, 229, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 315, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 183, 184, 197, 206, 216,
-	228, 243, 251, 261, 266, 269, 274, 275, 278, 283,
-	301, 306, 307, 308, 309, 326, 327, 328, 331, 334,
-	335, 338, 340, 341, 344, 351, 352, 353, 354, 355,
-	357, 364, 368, 376, 377, 378, 379, 380, 382, 383,
-	387, 388, 389, 390, 398, 402, 420, 421, 432, 445,
-	450, 538, 428, 451, 0, 300, 0, 0, 302, 247,
-	265, 276, 0, 440, 399, 201, 370, 254, 190, 219,
-	204, 226, 241, 244, 280, 310, 317, 346, 350, 259,
-	238, 217, 367, 214, 385, 405, 406, 407, 409, 314,
-	233, 349, 410, 0, 298, 411, 412, 272, 0, 0,
-	0, 0, 0, 0, 333, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 289, 234,
-	0, 0, 347, 0, 187, 0, 386, 222, 299, 296,
-	417, 248, 240, 236, 221, 273, 305, 345, 404, 339,
-	0, 293, 0, 0, 395, 318, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 220, 186, 330, 396, 252, 0, 0, 0,
-	0, 178, 179, 180, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 211, 0, 218, 0, 0, 0,
-	0, 0, 232, 277, 239, 231, 414, 0, 0, 0,
-	0, 203, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 242, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 260, 0, 319, 0, 0, 486,
-	0, 447, 0, 0, 0, 0, 0, 0, 0, 288,
-	0, 285, 182, 199, 0, 0, 329, 369, 375, 0,
-	0, 0, 223, 0, 373, 343, 431, 207, 250, 366,
-	348, 371, 0, 0, 372, 294, 419, 361, 429, 448,
-	449, 230, 323, 438, 408, 444, 460, 200, 227, 337,
-	401, 434, 392, 316, 415, 416, 284, 391, 258, 185,
-	292, 454, 198, 381, 215, 205, 191, 403, 427, 212,
-	384, 0, 0, 462, 193, 425, 400, 312, 281, 282,
-	192, 0, 365, 235, 256, 225, 332, 422, 423, 224,
-	463, 202, 443, 195, 0, 442, 325, 418, 426, 313,
-	304, 194, 424, 311, 303, 287, 246, 267, 359, 297,
-	360, 268, 321, 320, 322, 188, 435, 0, 189, 0,
-	397, 436, 464, 208, 209, 210, 0, 245, 249, 255,
-	257, 263, 264, 271, 290, 336, 358, 356, 362, 0,
-	413, 430, 439, 446, 452, 453, 455, 456, 457, 458,
-	459, 324, 270, 393, 286, 295, 0, 0, 342, 374,
-	213, 433, 394, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 465, 466, 467, 468, 469, 470, 471,
+	482, 483, 484, 485, 486, 487, 488, 489, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 490, 0,
+	183, 198, 294, 0, 367, 256, 468, 448, 444, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 185, 186, 199, 208, 218, 231,
+	246, 254, 264, 269, 272, 277, 278, 281, 286, 304,
+	309, 310, 311, 312, 330, 331, 332, 335, 338, 339,
+	342, 344, 345, 348, 355, 356, 357, 358, 359, 361,
+	368, 372, 381, 382, 383, 384, 385, 387, 388, 394,
+	395, 396, 397, 405, 409, 427, 428, 439, 452, 457,
+	224, 390, 392, 265, 435, 458, 0, 303, 0, 0,
+	305, 250, 268, 279, 0, 447, 406, 203, 375, 257,
+	192, 221, 206, 229, 244, 247, 283, 314, 321, 350,
+	354, 262, 241, 219, 371, 216, 391, 412, 413, 414,
+	416, 318, 236, 353, 417, 0, 301, 418, 419, 275,
+	0, 0, 0, 0, 0, 0, 337, 0, 0, 0,
+	0, 0, 0, 0, 0, 240, 0, 0, 0, 0,
+	292, 237, 0, 0, 351, 0, 189, 0, 393, 225,
+	302, 299, 424, 251, 243, 239, 223, 276, 308, 349,
+	411, 343, 0, 296, 0, 0, 402, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 222, 188, 334, 403, 255, 0,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 213, 0, 220, 0,
+	0, 0, 0, 0, 235, 280, 242, 234, 421, 0,
+	0, 0, 0, 205, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 263, 0, 323, 0,
+	0, 0, 0, 454, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 288, 184, 201, 0, 0, 333, 373,
+	380, 0, 0, 0, 226, 0, 378, 347, 438, 209,
+	253, 370, 352, 376, 0, 0, 377, 297, 426, 365,
+	436, 455, 456, 233, 327, 445, 415, 451, 467, 202,
+	230, 341, 408, 441, 399, 320, 422, 423, 287, 398,
+	261, 187, 295, 461, 200, 386, 217, 207, 313, 374,
+	193, 410, 434, 214, 389, 0, 0, 469, 195, 432,
+	407, 316, 284, 285, 194, 0, 369, 238, 259, 228,
+	336, 429, 430, 227, 470, 204, 450, 197, 0, 449,
+	329, 425, 433, 317, 307, 196, 431, 315, 306, 290,
+	249, 270, 363, 300, 364, 271, 325, 324, 326, 190,
+	442, 0, 191, 0, 404, 443, 471, 210, 211, 212,
+	0, 248, 252, 258, 260, 266, 267, 274, 293, 340,
+	362, 360, 366, 0, 420, 437, 446, 453, 459, 460,
+	462, 463, 464, 465, 466, 328, 273, 400, 289, 298,
+	0, 0, 346, 379, 215, 440, 401, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 472, 473, 474,
+	475, 476, 477, 478, 479, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 490, 0, 183, 198, 294,
+	1481, 367, 256, 468, 448, 444, 0, 0, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	319, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 185, 186, 199, 208, 218, 231, 246, 254, 264,
+	269, 272, 277, 278, 281, 286, 304, 309, 310, 311,
+	312, 330, 331, 332, 335, 338, 339, 342, 344, 345,
+	348, 355, 356, 357, 358, 359, 361, 368, 372, 381,
+	382, 383, 384, 385, 387, 388, 394, 395, 396, 397,
+	405, 409, 427, 428, 439, 452, 457, 224, 390, 392,
+	265, 435, 458, 0, 303, 0, 0, 305, 250, 268,
+	279, 0, 447, 406, 203, 375, 257, 192, 221, 206,
+	229, 244, 247, 283, 314, 321, 350, 354, 262, 241,
+	219, 371, 216, 391, 412, 413, 414, 416, 318, 236,
+	353, 417, 0, 301, 418, 419, 275, 0, 0, 0,
+	0, 0, 0, 337, 0, 1360, 0, 0, 0, 0,
+	0, 0, 240, 0, 0, 0, 0, 292, 237, 0,
+	0, 351, 0, 189, 0, 393, 225, 302, 299, 424,
+	251, 243, 239, 223, 276, 308, 349, 411, 343, 0,
+	296, 0, 0, 402, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 222, 188, 334, 403, 255, 0, 0, 0, 0,
+	180, 181, 182, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 213, 0, 220, 0, 0, 0, 0,
+	0, 235, 280, 242, 234, 421, 0, 0, 0, 0,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 263, 0, 323, 0, 0, 0, 0,
+	454, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	288, 184, 201, 0, 0, 333, 373, 380, 0, 0,
+	0, 226, 0, 378, 347, 438, 209, 253, 370, 352,
+	376, 0, 0, 377, 297, 426, 365, 436, 455, 456,
+	233, 327, 445, 415, 451, 467, 202, 230, 341, 408,
+	441, 399, 320, 422, 423, 287, 398, 261, 187, 295,
+	461, 200, 386, 217, 207, 313, 374, 193, 410, 434,
+	214, 389, 0, 0, 469, 195, 432, 407, 316, 284,
+	285, 194, 0, 369, 238, 259, 228, 336, 429, 430,
+	227, 470, 204, 450, 197, 0, 449, 329, 425, 433,
+	317, 307, 196, 431, 315, 306, 290, 249, 270, 363,
+	300, 364, 271, 325, 324, 326, 190, 442, 0, 191,
+	0, 404, 443, 471, 210, 211, 212, 0, 248, 252,
+	258, 260, 266, 267, 274, 293, 340, 362, 360, 366,
+	0, 420, 437, 446, 453, 459, 460, 462, 463, 464,
+	465, 466, 328, 273, 400, 289, 298, 0, 0, 346,
+	379, 215, 440, 401, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 472, 473, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 490, 0, 183, 198, 294, 0, 367, 256,
+	468, 448, 444, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 185, 186,
+	199, 208, 218, 231, 246, 254, 264, 269, 272, 277,
+	278, 281, 286, 304, 309, 310, 311, 312, 330, 331,
+	332, 335, 338, 339, 342, 344, 345, 348, 355, 356,
+	357, 358, 359, 361, 368, 372, 381, 382, 383, 384,
+	385, 387, 388, 394, 395, 396, 397, 405, 409, 427,
+	428, 439, 452, 457, 224, 390, 392, 265, 435, 458,
+	0, 303, 0, 0, 305, 250, 268, 279, 0, 447,
+	406, 203, 375, 257, 192, 221, 206, 229, 244, 247,
+	283, 314, 321, 350, 354, 262, 241, 219, 371, 216,
+	391, 412, 413, 414, 416, 318, 236, 353, 417, 0,
+	301, 418, 419, 275, 0, 0, 0, 0, 0, 0,
+	337, 0, 1358, 0, 0, 0, 0, 0, 0, 240,
+	0, 0, 0, 0, 292, 237, 0, 0, 351, 0,
+	189, 0, 393, 225, 302, 299, 424, 251, 243, 239,
+	223, 276, 308, 349, 411, 343, 0, 296, 0, 0,
+	402, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 222, 188,
+	334, 403, 255, 0, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 220, 0, 0, 0, 0, 0, 235, 280,
+	242, 234, 421, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 323, 0, 0, 0, 0, 454, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 288, 184, 201,
+	0, 0, 333, 373, 380, 0, 0, 0, 226, 0,
+	378, 347, 438, 209, 253, 370, 352, 376, 0, 0,
+	377, 297, 426, 365, 436, 455, 456, 233, 327, 445,
+	415, 451, 467, 202, 230, 341, 408, 441, 399, 320,
+	422, 423, 287, 398, 261, 187, 295, 461, 200, 386,
+	217, 207, 313, 374, 193, 410, 434, 214, 389, 0,
+	0, 469, 195, 432, 407, 316, 284, 285, 194, 0,
+	369, 238, 259, 228, 336, 429, 430, 227, 470, 204,
+	450, 197, 0, 449, 329, 425, 433, 317, 307, 196,
+	431, 315, 306, 290, 249, 270, 363, 300, 364, 271,
+	325, 324, 326, 190, 442, 0, 191, 0, 404, 443,
+	471, 210, 211, 212, 0, 248, 252, 258, 260, 266,
+	267, 274, 293, 340, 362, 360, 366, 0, 420, 437,
+	446, 453, 459, 460, 462, 463, 464, 465, 466, 328,
+	273, 400, 289, 298, 0, 0, 346, 379, 215, 440,
+	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 183, 198, 294, 0, 367, 256, 468, 448, 444,
+	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 319, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 185, 186, 199, 208, 218,
+	231, 246, 254, 264, 269, 272, 277, 278, 281, 286,
+	304, 309, 310, 311, 312, 330, 331, 332, 335, 338,
+	339, 342, 344, 345, 348, 355, 356, 357, 358, 359,
+	361, 368, 372, 381, 382, 383, 384, 385, 387, 388,
+	394, 395, 396, 397, 405, 409, 427, 428, 439, 452,
+	457, 224, 390, 392, 265, 435, 458, 0, 303, 0,
+	0, 305, 250, 268, 279, 0, 447, 406, 203, 375,
+	257, 192, 221, 206, 229, 244, 247, 283, 314, 321,
+	350, 354, 262, 241, 219, 371, 216, 391, 412, 413,
+	414, 416, 318, 236, 353, 417, 0, 301, 418, 419,
+	275, 0, 0, 0, 0, 0, 0, 337, 0, 1356,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 292, 237, 0, 0, 351, 0, 189, 0, 393,
+	225, 302, 299, 424, 251, 243, 239, 223, 276, 308,
+	349, 411, 343, 0, 296, 0, 0, 402, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 222, 188, 334, 403, 255,
+	0, 0, 0, 0, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 0, 220,
+	0, 0, 0, 0, 0, 235, 280, 242, 234, 421,
+	0, 0, 0, 0, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 263, 0, 323,
+	0, 0, 0, 0, 454, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 288, 184, 201, 0, 0, 333,
+	373, 380, 0, 0, 0, 226, 0, 378, 347, 438,
+	209, 253, 370, 352, 376, 0, 0, 377, 297, 426,
+	365, 436, 455, 456, 233, 327, 445, 415, 451, 467,
+	202, 230, 341, 408, 441, 399, 320, 422, 423, 287,
+	398, 261, 187, 295, 461, 200, 386, 217, 207, 313,
+	374, 193, 410, 434, 214, 389, 0, 0, 469, 195,
+	432, 407, 316, 284, 285, 194, 0, 369, 238, 259,
+	228, 336, 429, 430, 227, 470, 204, 450, 197, 0,
+	449, 329, 425, 433, 317, 307, 196, 431, 315, 306,
+	290, 249, 270, 363, 300, 364, 271, 325, 324, 326,
+	190, 442, 0, 191, 0, 404, 443, 471, 210, 211,
+	212, 0, 248, 252, 258, 260, 266, 267, 274, 293,
+	340, 362, 360, 366, 0, 420, 437, 446, 453, 459,
+	460, 462, 463, 464, 465, 466, 328, 273, 400, 289,
+	298, 0, 0, 346, 379, 215, 440, 401, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 472, 473,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 490, 0, 183, 198,
+	294, 0, 367, 256, 468, 448, 444, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 186, 199, 208, 218, 231, 246, 254,
+	264, 269, 272, 277, 278, 281, 286, 304, 309, 310,
+	311, 312, 330, 331, 332, 335, 338, 339, 342, 344,
+	345, 348, 355, 356, 357, 358, 359, 361, 368, 372,
+	381, 382, 383, 384, 385, 387, 388, 394, 395, 396,
+	397, 405, 409, 427, 428, 439, 452, 457, 224, 390,
+	392, 265, 435, 458, 0, 303, 0, 0, 305, 250,
+	268, 279, 0, 447, 406, 203, 375, 257, 192, 221,
+	206, 229, 244, 247, 283, 314, 321, 350, 354, 262,
+	241, 219, 371, 216, 391, 412, 413, 414, 416, 318,
+	236, 353, 417, 0, 301, 418, 419, 275, 0, 0,
+	0, 0, 0, 0, 337, 0, 1354, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 292, 237,
+	0, 0, 351, 0, 189, 0, 393, 225, 302, 299,
+	424, 251, 243, 239, 223, 276, 308, 349, 411, 343,
+	0, 296, 0, 0, 402, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 222, 188, 334, 403, 255, 0, 0, 0,
+	0, 180, 181, 182, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 213, 0, 220, 0, 0, 0,
+	0, 0, 235, 280, 242, 234, 421, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 263, 0, 323, 0, 0, 0,
+	0, 454, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 288, 184, 201, 0, 0, 333, 373, 380, 0,
+	0, 0, 226, 0, 378, 347, 438, 209, 253, 370,
+	352, 376, 0, 0, 377, 297, 426, 365, 436, 455,
+	456, 233, 327, 445, 415, 451, 467, 202, 230, 341,
+	408, 441, 399, 320, 422, 423, 287, 398, 261, 187,
+	295, 461, 200, 386, 217, 207, 313, 374, 193, 410,
+	434, 214, 389, 0, 0, 469, 195, 432, 407, 316,
+	284, 285, 194, 0, 369, 238, 259, 228, 336, 429,
+	430, 227, 470, 204, 450, 197, 0, 449, 329, 425,
+	433, 317, 307, 196, 431, 315, 306, 290, 249, 270,
+	363, 300, 364, 271, 325, 324, 326, 190, 442, 0,
+	191, 0, 404, 443, 471, 210, 211, 212, 0, 248,
+	252, 258, 260, 266, 267, 274, 293, 340, 362, 360,
+	366, 0, 420, 437, 446, 453, 459, 460, 462, 463,
+	464, 465, 466, 328, 273, 400, 289, 298, 0, 0,
+	346, 379, 215, 440, 401, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 472, 473, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 490, 0, 183, 198, 294, 0, 367,
+	256, 468, 448, 444, 0, 0, 232, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
+	186, 199, 208, 218, 231, 246, 254, 264, 269, 272,
+	277, 278, 281, 286, 304, 309, 310, 311, 312, 330,
+	331, 332, 335, 338, 339, 342, 344, 345, 348, 355,
+	356, 357, 358, 359, 361, 368, 372, 381, 382, 383,
+	384, 385, 387, 388, 394, 395, 396, 397, 405, 409,
+	427, 428, 439, 452, 457, 224, 390, 392, 265, 435,
+	458, 0, 303, 0, 0, 305, 250, 268, 279, 0,
+	447, 406, 203, 375, 257, 192, 221, 206, 229, 244,
+	247, 283, 314, 321, 350, 354, 262, 241, 219, 371,
+	216, 391, 412, 413, 414, 416, 318, 236, 353, 417,
+	0, 301, 418, 419, 275, 0, 0, 0, 0, 0,
+	0, 337, 0, 1352, 0, 0, 0, 0, 0, 0,
+	240, 0, 0, 0, 0, 292, 237, 0, 0, 351,
+	0, 189, 0, 393, 225, 302, 299, 424, 251, 243,
+	239, 223, 276, 308, 349, 411, 343, 0, 296, 0,
+	0, 402, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 222,
+	188, 334, 403, 255, 0, 0, 0, 0, 180, 181,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 213, 0, 220, 0, 0, 0, 0, 0, 235,
+	280, 242, 234, 421, 0, 0, 0, 0, 205, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 263, 0, 323, 0, 0, 0, 0, 454, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 288, 184,
+	201, 0, 0, 333, 373, 380, 0, 0, 0, 226,
+	0, 378, 347, 438, 209, 253, 370, 352, 376, 0,
+	0, 377, 297, 426, 365, 436, 455, 456, 233, 327,
+	445, 415, 451, 467, 202, 230, 341, 408, 441, 399,
+	320, 422, 423, 287, 398, 261, 187, 295, 461, 200,
+	386, 217, 207, 313, 374, 193, 410, 434, 214, 389,
+	0, 0, 469, 195, 432, 407, 316, 284, 285, 194,
+	0, 369, 238, 259, 228, 336, 429, 430, 227, 470,
+	204, 450, 197, 0, 449, 329, 425, 433, 317, 307,
+	196, 431, 315, 306, 290, 249, 270, 363, 300, 364,
+	271, 325, 324, 326, 190, 442, 0, 191, 0, 404,
+	443, 471, 210, 211, 212, 0, 248, 252, 258, 260,
+	266, 267, 274, 293, 340, 362, 360, 366, 0, 420,
+	437, 446, 453, 459, 460, 462, 463, 464, 465, 466,
+	328, 273, 400, 289, 298, 0, 0, 346, 379, 215,
+	440, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	490, 0, 183, 198, 294, 0, 367, 256, 468, 448,
+	444, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 185, 186, 199, 208,
+	218, 231, 246, 254, 264, 269, 272, 277, 278, 281,
+	286, 304, 309, 310, 311, 312, 330, 331, 332, 335,
+	338, 339, 342, 344, 345, 348, 355, 356, 357, 358,
+	359, 361, 368, 372, 381, 382, 383, 384, 385, 387,
+	388, 394, 395, 396, 397, 405, 409, 427, 428, 439,
+	452, 457, 224, 390, 392, 265, 435, 458, 0, 303,
+	0, 0, 305, 250, 268, 279, 0, 447, 406, 203,
+	375, 257, 192, 221, 206, 229, 244, 247, 283, 314,
+	321, 350, 354, 262, 241, 219, 371, 216, 391, 412,
+	413, 414, 416, 318, 236, 353, 417, 0, 301, 418,
+	419, 275, 0, 0, 0, 0, 0, 0, 337, 0,
+	1348, 0, 0, 0, 0, 0, 0, 240, 0, 0,
+	0, 0, 292, 237, 0, 0, 351, 0, 189, 0,
+	393, 225, 302, 299, 424, 251, 243, 239, 223, 276,
+	308, 349, 411, 343, 0, 296, 0, 0, 402, 322,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 282, 222, 188, 334, 403,
+	255, 0, 0, 0, 0, 180, 181, 182, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 213, 0,
+	220, 0, 0, 0, 0, 0, 235, 280, 242, 234,
+	421, 0, 0, 0, 0, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	245, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	323, 0, 0, 0, 0, 454, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 288, 184, 201, 0, 0,
+	333, 373, 380, 0, 0, 0, 226, 0, 378, 347,
+	438, 209, 253, 370, 352, 376, 0, 0, 377, 297,
+	426, 365, 436, 455, 456, 233, 327, 445, 415, 451,
+	467, 202, 230, 341, 408, 441, 399, 320, 422, 423,
+	287, 398, 261, 187, 295, 461, 200, 386, 217, 207,
+	313, 374, 193, 410, 434, 214, 389, 0, 0, 469,
+	195, 432, 407, 316, 284, 285, 194, 0, 369, 238,
+	259, 228, 336, 429, 430, 227, 470, 204, 450, 197,
+	0, 449, 329, 425, 433, 317, 307, 196, 431, 315,
+	306, 290, 249, 270, 363, 300, 364, 271, 325, 324,
+	326, 190, 442, 0, 191, 0, 404, 443, 471, 210,
+	211, 212, 0, 248, 252, 258, 260, 266, 267, 274,
+	293, 340, 362, 360, 366, 0, 420, 437, 446, 453,
+	459, 460, 462, 463, 464, 465, 466, 328, 273, 400,
+	289, 298, 0, 0, 346, 379, 215, 440, 401, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 472,
+	473, 474, 475, 476, 477, 478, 479, 480, 481, 482,
+	483, 484, 485, 486, 487, 488, 489, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 490, 0, 183,
+	198, 294, 0, 367, 256, 468, 448, 444, 0, 0,
+	232, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 319, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 185, 186, 199, 208, 218, 231, 246,
+	254, 264, 269, 272, 277, 278, 281, 286, 304, 309,
+	310, 311, 312, 330, 331, 332, 335, 338, 339, 342,
+	344, 345, 348, 355, 356, 357, 358, 359, 361, 368,
+	372, 381, 382, 383, 384, 385, 387, 388, 394, 395,
+	396, 397, 405, 409, 427, 428, 439, 452, 457, 224,
+	390, 392, 265, 435, 458, 0, 303, 0, 0, 305,
+	250, 268, 279, 0, 447, 406, 203, 375, 257, 192,
+	221, 206, 229, 244, 247, 283, 314, 321, 350, 354,
+	262, 241, 219, 371, 216, 391, 412, 413, 414, 416,
+	318, 236, 353, 417, 0, 301, 418, 419, 275, 0,
+	0, 0, 0, 0, 0, 337, 0, 1346, 0, 0,
+	0, 0, 0, 0, 240, 0, 0, 0, 0, 292,
+	237, 0, 0, 351, 0, 189, 0, 393, 225, 302,
+	299, 424, 251, 243, 239, 223, 276, 308, 349, 411,
+	343, 0, 296, 0, 0, 402, 322, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 282, 222, 188, 334, 403, 255, 0, 0,
+	0, 0, 180, 181, 182, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 213, 0, 220, 0, 0,
+	0, 0, 0, 235, 280, 242, 234, 421, 0, 0,
+	0, 0, 205, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 245, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 263, 0, 323, 0, 0,
+	0, 0, 454, 0, 0, 0, 0, 0, 0, 0,
+	291, 0, 288, 184, 201, 0, 0, 333, 373, 380,
+	0, 0, 0, 226, 0, 378, 347, 438, 209, 253,
+	370, 352, 376, 0, 0, 377, 297, 426, 365, 436,
+	455, 456, 233, 327, 445, 415, 451, 467, 202, 230,
+	341, 408, 441, 399, 320, 422, 423, 287, 398, 261,
+	187, 295, 461, 200, 386, 217, 207, 313, 374, 193,
+	410, 434, 214, 389, 0, 0, 469, 195, 432, 407,
+	316, 284, 285, 194, 0, 369, 238, 259, 228, 336,
+	429, 430, 227, 470, 204, 450, 197, 0, 449, 329,
+	425, 433, 317, 307, 196, 431, 315, 306, 290, 249,
+	270, 363, 300, 364, 271, 325, 324, 326, 190, 442,
+	0, 191, 0, 404, 443, 471, 210, 211, 212, 0,
+	248, 252, 258, 260, 266, 267, 274, 293, 340, 362,
+	360, 366, 0, 420, 437, 446, 453, 459, 460, 462,
+	463, 464, 465, 466, 328, 273, 400, 289, 298, 0,
+	0, 346, 379, 215, 440, 401, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 472, 473, 474, 475,
+	476, 477, 478, 479, 480, 481, 482, 483, 484, 485,
+	486, 487, 488, 489, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 490, 0, 183, 198, 294, 0,
+	367, 256, 468, 448, 444, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 319,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	185, 186, 199, 208, 218, 231, 246, 254, 264, 269,
+	272, 277, 278, 281, 286, 304, 309, 310, 311, 312,
+	330, 331, 332, 335, 338, 339, 342, 344, 345, 348,
+	355, 356, 357, 358, 359, 361, 368, 372, 381, 382,
+	383, 384, 385, 387, 388, 394, 395, 396, 397, 405,
+	409, 427, 428, 439, 452, 457, 224, 390, 392, 265,
+	435, 458, 0, 303, 0, 0, 305, 250, 268, 279,
+	0, 447, 406, 203, 375, 257, 192, 221, 206, 229,
+	244, 247, 283, 314, 321, 350, 354, 262, 241, 219,
+	371, 216, 391, 412, 413, 414, 416, 318, 236, 353,
+	417, 0, 301, 418, 419, 275, 0, 0, 0, 0,
+	0, 0, 337, 0, 1344, 0, 0, 0, 0, 0,
+	0, 240, 0, 0, 0, 0, 292, 237, 0, 0,
+	351, 0, 189, 0, 393, 225, 302, 299, 424, 251,
+	243, 239, 223, 276, 308, 349, 411, 343, 0, 296,
+	0, 0, 402, 322, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 282,
+	222, 188, 334, 403, 255, 0, 0, 0, 0, 180,
+	181, 182, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 213, 0, 220, 0, 0, 0, 0, 0,
+	235, 280, 242, 234, 421, 0, 0, 0, 0, 205,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 245, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 263, 0, 323, 0, 0, 0, 0, 454,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 288,
+	184, 201, 0, 0, 333, 373, 380, 0, 0, 0,
+	226, 0, 378, 347, 438, 209, 253, 370, 352, 376,
+	0, 0, 377, 297, 426, 365, 436, 455, 456, 233,
+	327, 445, 415, 451, 467, 202, 230, 341, 408, 441,
+	399, 320, 422, 423, 287, 398, 261, 187, 295, 461,
+	200, 386, 217, 207, 313, 374, 193, 410, 434, 214,
+	389, 0, 0, 469, 195, 432, 407, 316, 284, 285,
+	194, 0, 369, 238, 259, 228, 336, 429, 430, 227,
+	470, 204, 450, 197, 0, 449, 329, 425, 433, 317,
+	307, 196, 431, 315, 306, 290, 249, 270, 363, 300,
+	364, 271, 325, 324, 326, 190, 442, 0, 191, 0,
+	404, 443, 471, 210, 211, 212, 0, 248, 252, 258,
+	260, 266, 267, 274, 293, 340, 362, 360, 366, 0,
+	420, 437, 446, 453, 459, 460, 462, 463, 464, 465,
+	466, 328, 273, 400, 289, 298, 0, 0, 346, 379,
+	215, 440, 401, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 472, 473, 474, 475, 476, 477, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 490, 0, 183, 198, 294, 0, 367, 256, 468,
+	448, 444, 0, 0, 232, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 319, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 185, 186, 199,
+	208, 218, 231, 246, 254, 264, 269, 272, 277, 278,
+	281, 286, 304, 309, 310, 311, 312, 330, 331, 332,
+	335, 338, 339, 342, 344, 345, 348, 355, 356, 357,
+	358, 359, 361, 368, 372, 381, 382, 383, 384, 385,
+	387, 388, 394, 395, 396, 397, 405, 409, 427, 428,
+	439, 452, 457, 224, 390, 392, 265, 435, 458, 0,
+	303, 0, 0, 305, 250, 268, 279, 0, 447, 406,
+	203, 375, 257, 192, 221, 206, 229, 244, 247, 283,
+	314, 321, 350, 354, 262, 241, 219, 371, 216, 391,
+	412, 413, 414, 416, 318, 236, 353, 417, 0, 301,
+	418, 419, 275, 0, 0, 0, 0, 0, 0, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 240, 0,
+	0, 0, 0, 292, 237, 0, 0, 351, 0, 189,
+	0, 393, 225, 302, 299, 424, 251, 243, 239, 223,
+	276, 308, 349, 411, 343, 0, 296, 0, 0, 402,
+	322, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 282, 222, 188, 334,
+	403, 255, 0, 1321, 0, 0, 180, 181, 182, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 213,
+	0, 220, 0, 0, 0, 0, 0, 235, 280, 242,
+	234, 421, 0, 0, 0, 0, 205, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 245, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 263,
+	0, 323, 0, 0, 0, 0, 454, 0, 0, 0,
+	0, 0, 0, 0, 291, 0, 288, 184, 201, 0,
+	0, 333, 373, 380, 0, 0, 0, 226, 0, 378,
+	347, 438, 209, 253, 370, 352, 376, 0, 0, 377,
+	297, 426, 365, 436, 455, 456, 233, 327, 445, 415,
+	451, 467, 202, 230, 341, 408, 441, 399, 320, 422,
+	423, 287, 398, 261, 187, 295, 461, 200, 386, 217,
+	207, 313, 374, 193, 410, 434, 214, 389, 0, 0,
+	469, 195, 432, 407, 316, 284, 285, 194, 0, 369,
+	238, 259, 228, 336, 429, 430, 227, 470, 204, 450,
+	197, 0, 449, 329, 425, 433, 317, 307, 196, 431,
+	315, 306, 290, 249, 270, 363, 300, 364, 271, 325,
+	324, 326, 190, 442, 0, 191, 0, 404, 443, 471,
+	210, 211, 212, 0, 248, 252, 258, 260, 266, 267,
+	274, 293, 340, 362, 360, 366, 0, 420, 437, 446,
+	453, 459, 460, 462, 463, 464, 465, 466, 328, 273,
+	400, 289, 298, 0, 0, 346, 379, 215, 440, 401,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
-	482, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 483, 0, 181, 196, 291, 0, 363, 253, 461,
-	441, 437, 0, 0, 229, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 315, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 183, 184, 197,
-	206, 216, 228, 243, 251, 261, 266, 269, 274, 275,
-	278, 283, 301, 306, 307, 308, 309, 326, 327, 328,
-	331, 334, 335, 338, 340, 341, 344, 351, 352, 353,
-	354, 355, 357, 364, 368, 376, 377, 378, 379, 380,
-	382, 383, 387, 388, 389, 390, 398, 402, 420, 421,
-	432, 445, 450, 262, 428, 451, 0, 300, 0, 0,
-	302, 247, 265, 276, 0, 440, 399, 201, 370, 254,
-	190, 219, 204, 226, 241, 244, 280, 310, 317, 346,
-	350, 259, 238, 217, 367, 214, 385, 405, 406, 407,
-	409, 314, 233, 349, 410, 0, 298, 411, 412, 272,
-	0, 0, 0, 0, 0, 0, 333, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	289, 234, 0, 0, 347, 0, 187, 0, 386, 222,
-	299, 296, 417, 248, 240, 236, 221, 273, 305, 345,
-	404, 339, 0, 293, 0, 0, 395, 318, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 220, 186, 330, 396, 252, 0,
-	0, 0, 0, 178, 179, 180, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 211, 0, 218, 0,
-	0, 0, 0, 0, 232, 277, 239, 231, 414, 0,
-	0, 0, 0, 203, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 260, 0, 319, 0,
-	0, 0, 0, 447, 0, 0, 0, 0, 0, 0,
-	0, 288, 0, 285, 182, 199, 0, 0, 329, 369,
-	375, 0, 0, 0, 223, 0, 373, 343, 431, 207,
-	250, 366, 348, 371, 0, 0, 372, 294, 419, 361,
-	429, 448, 449, 230, 323, 438, 408, 444, 460, 200,
-	227, 337, 401, 434, 392, 316, 415, 416, 284, 391,
-	258, 185, 292, 454, 198, 381, 215, 205, 191, 403,
-	427, 212, 384, 0, 0, 462, 193, 425, 400, 312,
-	281, 282, 192, 0, 365, 235, 256, 225, 332, 422,
-	423, 224, 463, 202, 443, 195, 0, 442, 325, 418,
-	426, 313, 304, 194, 424, 311, 303, 287, 246, 267,
-	359, 297, 360, 268, 321, 320, 322, 188, 435, 0,
-	189, 0, 397, 436, 464, 208, 209, 210, 0, 245,
-	249, 255, 257, 263, 264, 271, 290, 336, 358, 356,
-	362, 0, 413, 430, 439, 446, 452, 453, 455, 456,
-	457, 458, 459, 324, 270, 393, 286, 295, 0, 0,
-	342, 374, 213, 433, 394, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 465, 466, 467, 468, 469,
-	470, 471, 472, 473, 474, 475, 476, 477, 478, 479,
-	480, 481, 482, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 483, 0, 181, 196, 291, 0, 363,
-	253, 461, 441, 437, 0, 0, 229, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 315, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 183,
-	184, 197, 206, 216, 228, 243, 251, 261, 266, 269,
-	274, 275, 278, 283, 301, 306, 307, 308, 309, 326,
-	327, 328, 331, 334, 335, 338, 340, 341, 344, 351,
-	352, 353, 354, 355, 357, 364, 368, 376, 377, 378,
-	379, 380, 382, 383, 387, 388, 389, 390, 398, 402,
-	420, 421, 432, 445, 450, 262, 428, 451, 0, 300,
-	0, 0, 302, 247, 265, 276, 0, 440, 399, 201,
-	370, 254, 190, 219, 204, 226, 241, 244, 280, 310,
-	317, 346, 350, 259, 238, 217, 367, 214, 385, 405,
-	406, 407, 409, 314, 233, 349, 0, 0, 298, 411,
-	412, 272,
+	482, 483, 484, 485, 486, 487, 488, 489, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 490, 0,
+	183, 198, 294, 0, 367, 256, 468, 448, 444, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 319, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 185, 186, 199, 208, 218, 231,
+	246, 254, 264, 269, 272, 277, 278, 281, 286, 304,
+	309, 310, 311, 312, 330, 331, 332, 335, 338, 339,
+	342, 344, 345, 348, 355, 356, 357, 358, 359, 361,
+	368, 372, 381, 382, 383, 384, 385, 387, 388, 394,
+	395, 396, 397, 405, 409, 427, 428, 439, 452, 457,
+	224, 390, 392, 265, 435, 458, 0, 303, 0, 0,
+	305, 250, 268, 279, 0, 447, 406, 203, 375, 257,
+	192, 221, 206, 229, 244, 247, 283, 314, 321, 350,
+	354, 262, 241, 219, 371, 216, 391, 412, 413, 414,
+	416, 318, 236, 353, 417, 0, 301, 418, 419, 275,
+	0, 0, 0, 0, 0, 0, 337, 0, 0, 0,
+	0, 0, 0, 0, 1222, 240, 0, 0, 0, 0,
+	292, 237, 0, 0, 351, 0, 189, 0, 393, 225,
+	302, 299, 424, 251, 243, 239, 223, 276, 308, 349,
+	411, 343, 0, 296, 0, 0, 402, 322, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 282, 222, 188, 334, 403, 255, 0,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 213, 0, 220, 0,
+	0, 0, 0, 0, 235, 280, 242, 234, 421, 0,
+	0, 0, 0, 205, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 245, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 263, 0, 323, 0,
+	0, 0, 0, 454, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 288, 184, 201, 0, 0, 333, 373,
+	380, 0, 0, 0, 226, 0, 378, 347, 438, 209,
+	253, 370, 352, 376, 0, 0, 377, 297, 426, 365,
+	436, 455, 456, 233, 327, 445, 415, 451, 467, 202,
+	230, 341, 408, 441, 399, 320, 422, 423, 287, 398,
+	261, 187, 295, 461, 200, 386, 217, 207, 313, 374,
+	193, 410, 434, 214, 389, 0, 0, 469, 195, 432,
+	407, 316, 284, 285, 194, 0, 369, 238, 259, 228,
+	336, 429, 430, 227, 470, 204, 450, 197, 0, 449,
+	329, 425, 433, 317, 307, 196, 431, 315, 306, 290,
+	249, 270, 363, 300, 364, 271, 325, 324, 326, 190,
+	442, 0, 191, 0, 404, 443, 471, 210, 211, 212,
+	0, 248, 252, 258, 260, 266, 267, 274, 293, 340,
+	362, 360, 366, 0, 420, 437, 446, 453, 459, 460,
+	462, 463, 464, 465, 466, 328, 273, 400, 289, 298,
+	0, 0, 346, 379, 215, 440, 401, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 472, 473, 474,
+	475, 476, 477, 478, 479, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 490, 0, 183, 198, 294,
+	0, 367, 256, 468, 448, 444, 0, 0, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	319, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 185, 186, 199, 208, 218, 231, 246, 254, 264,
+	269, 272, 277, 278, 281, 286, 304, 309, 310, 311,
+	312, 330, 331, 332, 335, 338, 339, 342, 344, 345,
+	348, 355, 356, 357, 358, 359, 361, 368, 372, 381,
+	382, 383, 384, 385, 387, 388, 394, 395, 396, 397,
+	405, 409, 427, 428, 439, 452, 457, 224, 390, 392,
+	265, 435, 458, 0, 303, 0, 0, 305, 250, 268,
+	279, 0, 447, 406, 203, 375, 257, 192, 221, 206,
+	229, 244, 247, 283, 314, 321, 350, 354, 262, 241,
+	219, 371, 216, 391, 412, 413, 414, 416, 318, 236,
+	353, 417, 0, 301, 418, 419, 275, 0, 0, 0,
+	0, 0, 0, 337, 0, 0, 0, 0, 0, 0,
+	0, 0, 240, 0, 0, 0, 0, 292, 237, 0,
+	0, 351, 0, 189, 0, 393, 225, 302, 299, 424,
+	251, 243, 239, 223, 276, 308, 349, 411, 343, 0,
+	296, 0, 0, 402, 322, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	282, 222, 188, 334, 403, 255, 0, 0, 0, 0,
+	180, 181, 182, 0, 1060, 0, 0, 0, 0, 0,
+	0, 0, 0, 213, 0, 220, 0, 0, 0, 0,
+	0, 235, 280, 242, 234, 421, 0, 0, 0, 0,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 245, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 263, 0, 323, 0, 0, 0, 0,
+	454, 0, 0, 0, 0, 0, 0, 0, 291, 0,
+	288, 184, 201, 0, 0, 333, 373, 380, 0, 0,
+	0, 226, 0, 378, 347, 438, 209, 253, 370, 352,
+	376, 0, 0, 377, 297, 426, 365, 436, 455, 456,
+	233, 327, 445, 415, 451, 467, 202, 230, 341, 408,
+	441, 399, 320, 422, 423, 287, 398, 261, 187, 295,
+	461, 200, 386, 217, 207, 313, 374, 193, 410, 434,
+	214, 389, 0, 0, 469, 195, 432, 407, 316, 284,
+	285, 194, 0, 369, 238, 259, 228, 336, 429, 430,
+	227, 470, 204, 450, 197, 0, 449, 329, 425, 433,
+	317, 307, 196, 431, 315, 306, 290, 249, 270, 363,
+	300, 364, 271, 325, 324, 326, 190, 442, 0, 191,
+	0, 404, 443, 471, 210, 211, 212, 0, 248, 252,
+	258, 260, 266, 267, 274, 293, 340, 362, 360, 366,
+	0, 420, 437, 446, 453, 459, 460, 462, 463, 464,
+	465, 466, 328, 273, 400, 289, 298, 0, 0, 346,
+	379, 215, 440, 401, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 472, 473, 474, 475, 476, 477,
+	478, 479, 480, 481, 482, 483, 484, 485, 486, 487,
+	488, 489, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 490, 0, 183, 198, 294, 0, 367, 256,
+	468, 448, 444, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 319, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 185, 186,
+	199, 208, 218, 231, 246, 254, 264, 269, 272, 277,
+	278, 281, 286, 304, 309, 310, 311, 312, 330, 331,
+	332, 335, 338, 339, 342, 344, 345, 348, 355, 356,
+	357, 358, 359, 361, 368, 372, 381, 382, 383, 384,
+	385, 387, 388, 394, 395, 396, 397, 405, 409, 427,
+	428, 439, 452, 457, 224, 390, 392, 265, 435, 458,
+	0, 303, 0, 0, 305, 250, 268, 279, 0, 447,
+	406, 203, 375, 257, 192, 221, 206, 229, 244, 247,
+	283, 314, 321, 350, 354, 262, 241, 219, 371, 216,
+	391, 412, 413, 414, 416, 318, 236, 353, 417, 0,
+	301, 418, 419, 275, 0, 0, 0, 0, 0, 0,
+	337, 0, 0, 0, 0, 0, 0, 0, 0, 240,
+	0, 0, 0, 0, 292, 237, 0, 0, 351, 0,
+	189, 0, 393, 225, 302, 299, 424, 251, 243, 239,
+	223, 276, 308, 349, 411, 343, 0, 296, 0, 0,
+	402, 322, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 282, 222, 188,
+	334, 403, 255, 0, 0, 0, 0, 180, 181, 182,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	213, 0, 220, 0, 0, 0, 0, 0, 235, 280,
+	242, 234, 421, 0, 0, 0, 0, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 245, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	263, 0, 323, 0, 0, 0, 0, 454, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 288, 184, 201,
+	0, 0, 333, 373, 380, 0, 0, 0, 226, 0,
+	378, 347, 438, 209, 253, 370, 352, 376, 0, 0,
+	377, 297, 426, 365, 436, 455, 456, 233, 327, 445,
+	415, 451, 467, 202, 230, 341, 408, 441, 399, 320,
+	422, 423, 287, 398, 261, 187, 295, 461, 200, 386,
+	217, 207, 313, 374, 193, 410, 434, 214, 389, 0,
+	0, 469, 195, 432, 407, 316, 284, 285, 194, 0,
+	369, 238, 259, 228, 336, 429, 430, 227, 470, 204,
+	450, 197, 0, 449, 329, 425, 433, 317, 307, 196,
+	431, 315, 306, 290, 249, 270, 363, 300, 364, 271,
+	325, 324, 326, 190, 442, 0, 191, 0, 404, 443,
+	471, 210, 211, 212, 0, 248, 252, 258, 260, 266,
+	267, 274, 293, 340, 362, 360, 366, 0, 420, 437,
+	446, 453, 459, 460, 462, 463, 464, 465, 466, 328,
+	273, 400, 289, 298, 0, 0, 346, 379, 215, 440,
+	401, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 472, 473, 474, 475, 476, 477, 478, 479, 480,
+	481, 482, 483, 484, 485, 486, 487, 488, 489, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	0, 183, 198, 294, 0, 367, 256, 468, 448, 444,
+	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 319, 0, 0, 0, 0, 0,
+	0, 689, 0, 0, 0, 185, 186, 199, 208, 218,
+	231, 246, 254, 264, 269, 272, 277, 278, 281, 286,
+	304, 309, 310, 311, 312, 330, 331, 332, 335, 338,
+	339, 342, 344, 345, 348, 355, 356, 357, 358, 359,
+	361, 368, 372, 381, 382, 383, 384, 385, 387, 388,
+	394, 395, 396, 397, 405, 409, 427, 428, 439, 452,
+	457, 224, 390, 392, 265, 435, 458, 0, 303, 0,
+	0, 305, 250, 268, 279, 0, 447, 406, 203, 375,
+	257, 192, 221, 206, 229, 244, 247, 283, 314, 321,
+	350, 354, 262, 241, 219, 371, 216, 391, 412, 413,
+	414, 416, 318, 236, 353, 417, 0, 301, 418, 419,
+	275, 0, 0, 0, 0, 0, 0, 337, 0, 0,
+	0, 0, 0, 0, 0, 0, 240, 0, 0, 0,
+	0, 292, 237, 0, 0, 351, 0, 189, 0, 393,
+	225, 302, 299, 424, 251, 243, 239, 223, 276, 308,
+	349, 411, 343, 0, 296, 0, 0, 402, 322, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 282, 222, 188, 334, 403, 255,
+	0, 0, 0, 0, 180, 181, 182, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 213, 0, 220,
+	0, 0, 0, 0, 0, 235, 280, 242, 234, 421,
+	0, 0, 0, 0, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 245,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 547, 0, 263, 0, 323,
+	0, 0, 0, 0, 454, 0, 0, 0, 0, 0,
+	0, 0, 291, 0, 288, 184, 201, 0, 0, 333,
+	373, 380, 0, 0, 0, 226, 0, 378, 347, 438,
+	209, 253, 370, 352, 376, 0, 0, 377, 297, 426,
+	365, 436, 455, 456, 233, 327, 445, 415, 451, 467,
+	202, 230, 341, 408, 441, 399, 320, 422, 423, 287,
+	398, 261, 187, 295, 461, 200, 386, 217, 207, 313,
+	374, 193, 410, 434, 214, 389, 0, 0, 469, 195,
+	432, 407, 316, 284, 285, 194, 0, 369, 238, 259,
+	228, 336, 429, 430, 227, 470, 204, 450, 197, 0,
+	449, 329, 425, 433, 317, 307, 196, 431, 315, 306,
+	290, 249, 270, 363, 300, 364, 271, 325, 324, 326,
+	190, 442, 0, 191, 0, 404, 443, 471, 210, 211,
+	212, 0, 248, 252, 258, 260, 266, 267, 274, 293,
+	340, 362, 360, 366, 0, 420, 437, 446, 453, 459,
+	460, 462, 463, 464, 465, 466, 328, 273, 400, 289,
+	298, 0, 0, 346, 379, 215, 440, 401, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 472, 473,
+	474, 475, 476, 477, 478, 479, 480, 481, 482, 483,
+	484, 485, 486, 487, 488, 489, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 490, 0, 183, 198,
+	294, 0, 367, 256, 468, 448, 444, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 319, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 185, 186, 199, 208, 218, 231, 246, 254,
+	264, 269, 272, 277, 278, 281, 286, 304, 309, 310,
+	311, 312, 330, 331, 332, 335, 338, 339, 342, 344,
+	345, 348, 355, 356, 357, 358, 359, 361, 368, 372,
+	381, 382, 383, 384, 385, 387, 388, 394, 395, 396,
+	397, 405, 409, 427, 428, 439, 452, 457, 224, 390,
+	392, 546, 435, 458, 0, 303, 0, 0, 305, 250,
+	268, 279, 0, 447, 406, 203, 375, 257, 192, 221,
+	206, 229, 244, 247, 283, 314, 321, 350, 354, 262,
+	241, 219, 371, 216, 391, 412, 413, 414, 416, 318,
+	236, 353, 417, 0, 301, 418, 419, 275, 0, 0,
+	0, 0, 0, 0, 337, 0, 0, 0, 0, 0,
+	0, 0, 0, 240, 0, 0, 0, 0, 292, 237,
+	0, 0, 351, 0, 189, 0, 393, 225, 302, 299,
+	424, 251, 243, 239, 223, 276, 308, 349, 411, 343,
+	0, 296, 0, 0, 402, 322, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 282, 222, 188, 334, 403, 255, 0, 0, 0,
+	0, 180, 181, 182, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 213, 0, 220, 0, 0, 0,
+	0, 0, 235, 280, 242, 234, 421, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 245, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 263, 0, 323, 0, 0, 493,
+	0, 454, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 288, 184, 201, 0, 0, 333, 373, 380, 0,
+	0, 0, 226, 0, 378, 347, 438, 209, 253, 370,
+	352, 376, 0, 0, 377, 297, 426, 365, 436, 455,
+	456, 233, 327, 445, 415, 451, 467, 202, 230, 341,
+	408, 441, 399, 320, 422, 423, 287, 398, 261, 187,
+	295, 461, 200, 386, 217, 207, 313, 374, 193, 410,
+	434, 214, 389, 0, 0, 469, 195, 432, 407, 316,
+	284, 285, 194, 0, 369, 238, 259, 228, 336, 429,
+	430, 227, 470, 204, 450, 197, 0, 449, 329, 425,
+	433, 317, 307, 196, 431, 315, 306, 290, 249, 270,
+	363, 300, 364, 271, 325, 324, 326, 190, 442, 0,
+	191, 0, 404, 443, 471, 210, 211, 212, 0, 248,
+	252, 258, 260, 266, 267, 274, 293, 340, 362, 360,
+	366, 0, 420, 437, 446, 453, 459, 460, 462, 463,
+	464, 465, 466, 328, 273, 400, 289, 298, 0, 0,
+	346, 379, 215, 440, 401, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 472, 473, 474, 475, 476,
+	477, 478, 479, 480, 481, 482, 483, 484, 485, 486,
+	487, 488, 489, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 490, 0, 183, 198, 294, 0, 367,
+	256, 468, 448, 444, 0, 0, 232, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 319, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
+	186, 199, 208, 218, 231, 246, 254, 264, 269, 272,
+	277, 278, 281, 286, 304, 309, 310, 311, 312, 330,
+	331, 332, 335, 338, 339, 342, 344, 345, 348, 355,
+	356, 357, 358, 359, 361, 368, 372, 381, 382, 383,
+	384, 385, 387, 388, 394, 395, 396, 397, 405, 409,
+	427, 428, 439, 452, 457, 224, 390, 392, 265, 435,
+	458, 0, 303, 0, 0, 305, 250, 268, 279, 0,
+	447, 406, 203, 375, 257, 192, 221, 206, 229, 244,
+	247, 283, 314, 321, 350, 354, 262, 241, 219, 371,
+	216, 391, 412, 413, 414, 416, 318, 236, 353, 417,
+	0, 301, 418, 419, 275, 0, 0, 0, 0, 0,
+	0, 337, 0, 0, 0, 0, 0, 0, 0, 0,
+	240, 0, 0, 0, 0, 292, 237, 0, 0, 351,
+	0, 189, 0, 393, 225, 302, 299, 424, 251, 243,
+	239, 223, 276, 308, 349, 411, 343, 0, 296, 0,
+	0, 402, 322, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 282, 222,
+	188, 334, 403, 255, 0, 0, 0, 0, 180, 181,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 213, 0, 220, 0, 0, 0, 0, 0, 235,
+	280, 242, 234, 421, 0, 0, 0, 0, 205, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 245, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 263, 0, 323, 0, 0, 0, 0, 454, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 288, 184,
+	201, 0, 0, 333, 373, 380, 0, 0, 0, 226,
+	0, 378, 347, 438, 209, 253, 370, 352, 376, 0,
+	0, 377, 297, 426, 365, 436, 455, 456, 233, 327,
+	445, 415, 451, 467, 202, 230, 341, 408, 441, 399,
+	320, 422, 423, 287, 398, 261, 187, 295, 461, 200,
+	386, 217, 207, 313, 374, 193, 410, 434, 214, 389,
+	0, 0, 469, 195, 432, 407, 316, 284, 285, 194,
+	0, 369, 238, 259, 228, 336, 429, 430, 227, 470,
+	204, 450, 197, 0, 449, 329, 425, 433, 317, 307,
+	196, 431, 315, 306, 290, 249, 270, 363, 300, 364,
+	271, 325, 324, 326, 190, 442, 0, 191, 0, 404,
+	443, 471, 210, 211, 212, 0, 248, 252, 258, 260,
+	266, 267, 274, 293, 340, 362, 360, 366, 0, 420,
+	437, 446, 453, 459, 460, 462, 463, 464, 465, 466,
+	328, 273, 400, 289, 298, 0, 0, 346, 379, 215,
+	440, 401, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 472, 473, 474, 475, 476, 477, 478, 479,
+	480, 481, 482, 483, 484, 485, 486, 487, 488, 489,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	490, 0, 183, 198, 294, 0, 367, 256, 468, 448,
+	444, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 319, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 185, 186, 199, 208,
+	218, 231, 246, 254, 264, 269, 272, 277, 278, 281,
+	286, 304, 309, 310, 311, 312, 330, 331, 332, 335,
+	338, 339, 342, 344, 345, 348, 355, 356, 357, 358,
+	359, 361, 368, 372, 381, 382, 383, 384, 385, 387,
+	388, 394, 395, 396, 397, 405, 409, 427, 428, 439,
+	452, 457, 224, 390, 392, 265, 435, 458, 0, 303,
+	0, 0, 305, 250, 268, 279, 0, 447, 406, 203,
+	375, 257, 192, 221, 206, 229, 244, 247, 283, 314,
+	321, 350, 354, 262, 241, 219, 371, 216, 391, 412,
+	413, 414, 416, 318, 236, 353, 83, 0, 301, 418,
+	419, 275, 615, 622, 623, 624, 625, 626, 616, 618,
+	0, 0, 0, 617, 0, 0, 620, 627, 628, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2191,
+	2192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 629, 630, 631, 632, 633, 634, 635, 636, 637,
+	638, 639, 640, 641, 642, 643, 644, 645, 646, 647,
+	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
+	658, 659, 660, 661, 662, 663, 664, 665, 666, 667,
+	668, 669,
 }
 
 var yyPact = [...]int{
-	3083, -1000, -388, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	5109, -1000, -390, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1731, 1773, -1000, -1000, -1000, -1000,
+	1851, -1000, 717, 1531, -1000, 1745, 3207, -1000, 33862, 452,
+	-1000, 33315, 448, 94, 33862, -1000, 136, -1000, 119, 33862,
+	132, 32768, -1000, -1000, -294, 14167, 1675, -3, -5, 33862,
+	-1000, 141, 1842, 1525, -1000, 304, -1000, -1000, -1000, -1000,
+	-1000, -1000, 32221, -1000, -1000, -1000, 1752, 1732, 1857, 583,
+	1679, -1000, 1802, 1525, -1000, 14167, 1826, 1774, 13620, -1000,
+	13620, 362, -1000, -1000, 10331, -1000, -1000, 18545, 33862, 33862,
+	268, -1000, 1745, -1000, -1000, 352, -1000, 264, 1448, -1000,
+	1445, -1000, 556, 625, 285, 395, 391, 284, 283, 282,
+	277, 276, 274, 273, 271, 289, -1000, 631, 631, -145,
+	-147, 366, 336, 336, 336, 385, 1710, 1709, -1000, 654,
+	-1000, 631, 631, 337, 631, 631, 631, 631, 232, 226,
+	631, 631, 631, 631, 631, 631, 631, 631, 631, 631,
+	631, 631, 631, 631, 631, 329, 1745, 211, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1633, 1662, -1000, -1000, -1000, -1000, 1761,
-	-1000, 618, 1410, -1000, 1640, 2661, -1000, 33497, 410, -1000,
-	32955, 409, 3869, 33497, -1000, 136, -1000, 109, 33497, 124,
-	32413, -1000, -1000, -300, 13982, 1601, -14, -20, 33497, -1000,
-	1746, 1388, -1000, 298, -1000, -1000, -1000, -1000, -1000, -1000,
-	31871, -1000, -1000, -1000, 1647, 1634, 1766, 534, 1593, -1000,
-	1687, 1388, -1000, 13982, 1727, 1679, 13440, -1000, 13440, 366,
-	-1000, -1000, 10181, -1000, -1000, 18320, 33497, 33497, 180, -1000,
-	1640, -1000, -1000, 292, -1000, 264, 1311, -1000, 1309, -1000,
-	549, 417, 275, 396, 395, 274, 273, 269, 268, 267,
-	266, 265, 261, 280, -1000, 593, 593, -188, -195, 2521,
-	348, 348, 348, 377, 1618, 1616, -1000, 633, -1000, 593,
-	593, 288, 593, 593, 593, 593, 224, 223, 593, 593,
-	593, 593, 593, 593, 593, 593, 593, 593, 593, 593,
-	593, 593, 593, 262, 1640, 208, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4724,32 +4783,32 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 33497, 102, 33497, -1000, 463, 33497,
-	684, 684, 56, 684, 684, 684, 684, 116, 508, -29,
-	-1000, 113, 218, 95, 203, 683, 117, 119, -1000, -1000,
-	198, 683, 1096, 542, 84, -1000, 684, 7981, 7981, 7981,
-	-1000, 1629, -1000, -1000, -1000, -1000, -1000, -1000, 1110, -1000,
-	376, -1000, -1000, -1000, -1000, 33497, 31329, 299, 621, -1000,
-	-1000, -1000, 28, -1000, -1000, 1273, 720, 13982, 816, -1000,
-	1418, 510, -1000, -1000, -1000, -1000, -1000, 448, 14524, 14524,
-	14524, 14524, -1000, -1000, 1318, 1318, 1318, 1318, 14524, 1318,
-	14524, 1318, 1318, 1318, 1318, 13982, 1318, 1318, 1318, -1000,
-	1318, 1318, 1318, 1318, 1318, 1318, 1318, 1318, 460, 1318,
-	1318, 1318, 1318, 1318, -1000, -1000, -1000, -1000, 1318, 1318,
-	1318, 1318, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 16150, -1000, 11814, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 33862, 203, 33862, -1000, 508, 33862, 718, 718, 39,
+	718, 718, 718, 718, 133, 529, -6, -1000, 131, 227,
+	120, 207, 714, 158, 116, -1000, -1000, 202, 714, 33862,
+	1170, 596, 92, -1000, 718, 8111, 8111, 8111, -1000, 1719,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1142, -1000, 384, -1000,
+	-1000, -1000, -1000, 33862, 31674, 306, 648, -1000, -1000, -1000,
+	43, -1000, -1000, 1371, 875, 14167, 998, -1000, 1398, 496,
+	-1000, -1000, -1000, -1000, -1000, 467, 14714, 14714, 14714, 14714,
+	-1000, -1000, 1451, 1451, 1451, 1451, 14714, 1451, 14714, 1451,
+	1451, 1451, 1451, 14167, 1451, 1451, 1451, -1000, 1451, 1451,
+	1451, 1451, 1451, 1451, 1451, 1451, 505, 1451, 1451, 1451,
+	1451, 1451, -1000, -1000, -1000, -1000, 1451, 1451, 1451, 1451,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 16355,
+	-1000, 11979, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 33497, -1000, 1318, 166, 1687, 1388, -1000,
-	1746, 1706, 298, -1000, 1694, 1237, 1174, 1079, 1388, 1303,
-	33497, -1000, 1320, -1000, -1000, -1000, 1474, 1005, 1095, -1000,
-	-1000, -1000, -1000, 997, 13982, -1000, -1000, 1758, -1000, 15608,
-	459, 820, 1757, 30787, -1000, 366, 366, 1308, 9631, -73,
-	-1000, -1000, -1000, 610, 21030, -1000, -1000, -1000, -1000, -1000,
+	-1000, 33862, -1000, 1451, 148, 414, 1802, 1525, -1000, 1842,
+	1814, 304, -1000, 1751, 1337, 1242, 1187, 1525, 1437, 33862,
+	-1000, 1453, -1000, -1000, -1000, 1628, 1127, 1164, -1000, -1000,
+	-1000, -1000, 1082, 14167, -1000, -1000, 1848, -1000, 15808, 497,
+	828, 1847, 31127, -1000, 362, 362, 1443, 9776, -53, -1000,
+	-1000, -1000, 638, 21280, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1719,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1629, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4762,212 +4821,218 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1281, 33497, -1000, -1000, 4617, 1026, -1000, 1409, -1000,
-	1270, -1000, 1349, 1423, 404, 1026, 397, 392, 387, -1000,
-	-119, -1000, -1000, -1000, -1000, -1000, 593, 593, -1000, 279,
-	1726, 2661, 4681, -1000, -1000, -1000, 30245, 1407, 1026, -1000,
-	1405, -1000, 734, 454, 481, 481, 1026, -1000, -1000, 33497,
-	1026, 731, 721, 33497, 33497, -1000, 29703, -1000, 29161, 28619,
-	953, 33497, 28077, 27535, 26993, 26451, 25909, -1000, 1500, -1000,
-	1473, -1000, -1000, -1000, 33497, 33497, 33497, 231, -1000, -1000,
-	33497, 1026, -1000, -1000, 950, 949, 593, 593, 948, 1084,
-	1083, 1081, 593, 593, 945, 1077, 23198, 219, 943, 910,
-	906, 1000, 1072, 154, 980, 892, 904, 33497, 1403, 33497,
-	-1000, 196, 552, 351, 599, 1640, 1600, 1307, 375, 403,
-	1026, 355, 355, -1000, 8531, -1000, -1000, 1067, 13982, -1000,
-	686, 683, 683, -1000, -1000, -1000, -1000, -1000, -1000, 684,
-	33497, 686, -1000, -1000, -1000, 683, 684, 33497, 684, 684,
-	684, 684, 683, 683, 683, 684, 33497, 33497, 33497, 33497,
-	33497, 33497, 33497, 33497, 33497, 7981, 7981, 7981, 542, 684,
-	-306, -1000, 1066, -1000, 1457, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 114, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -104, 1306, 25367, -1000, -307, -312,
-	-313, -315, -1000, -1000, -1000, -316, -321, -1000, -1000, -1000,
-	13982, 13982, 13982, 13982, -1000, 886, 14524, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 883, 595, 14524, 14524, 14524, 14524,
-	14524, 14524, 14524, 14524, 14524, 14524, 14524, 14524, 14524, 14524,
-	14524, 728, 1065, 1064, 510, 510, 510, 510, -1000, 13440,
-	13982, 13982, 510, -1000, 1026, 24825, 13440, 13440, 13982, 1626,
-	634, 720, 33497, -1000, 1079, -1000, -1000, -1000, 873, -1000,
-	33497, 33497, 32, 13982, 10729, 8531, 13440, 13440, 13440, 13440,
-	13440, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 458, 1193, 1184, 1268, -1000, 1305, -1000, -175,
-	17778, 13982, 1039, -1000, -1000, -1000, 1687, -1000, 1687, 1193,
-	1614, 1517, 13440, -1000, -1000, 1614, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1277, -1000, 33497, 1303, 1674, 33497,
-	1512, 1038, 324, -1000, 13982, 13982, 1302, -1000, 957, 33497,
-	-1000, -1000, 24283, -1000, -1000, 7431, -1000, 33497, 252, 33497,
-	-1000, 22656, 23741, 9081, -73, -1000, 9081, 1292, -1000, -36,
-	-44, 11271, 514, -1000, -1000, -1000, 2521, 15066, 1165, 514,
-	77, -1000, -1000, -1000, 1349, -1000, 1349, 1349, 1349, 1349,
-	324, 324, 324, 324, -1000, -1000, -1000, -1000, -1000, 1380,
-	1378, -1000, 1349, 1349, 1349, 1349, -1000, -1000, -1000, -1000,
+	1363, 33862, -1000, -1000, 4044, 1132, -1000, 1529, -1000, 1334,
+	-1000, 1490, 1538, 409, 439, 1132, 425, 420, 417, -1000,
+	-92, -1000, -1000, -1000, -1000, -1000, 631, 631, -1000, 278,
+	1825, 3207, 4943, -1000, -1000, -1000, 30580, 1527, 1132, -1000,
+	1524, -1000, 752, 438, 513, 513, 1132, -1000, -1000, 33862,
+	1132, 742, 741, 33862, 33862, -1000, 30033, -1000, 29486, 28939,
+	1073, 33862, 28392, 27845, 27298, 26751, 26204, -1000, 1593, -1000,
+	1487, -1000, -1000, -1000, 33862, 33862, 33862, 287, -1000, -1000,
+	33862, 1132, -1000, -1000, 1054, 1048, 631, 631, 1025, 1162,
+	1154, 1153, 631, 631, 1020, 1150, 24015, 201, 1017, 1000,
+	999, 1047, 1149, 178, 991, 935, 990, 33862, 1522, 33862,
+	-1000, 191, 629, 333, 635, 1745, 1672, 1442, 372, 436,
+	1132, 335, 400, 335, -1000, 8666, -1000, -1000, 1146, 14167,
+	-1000, 737, 714, 714, -1000, -1000, -1000, -1000, -1000, -1000,
+	718, 33862, 737, -1000, -1000, -1000, 714, 718, 33862, 718,
+	718, 718, 718, 714, 714, 714, 718, 33862, 33862, 33862,
+	33862, 33862, 33862, 33862, 33862, 33862, 8111, 8111, 8111, 596,
+	718, 714, -302, -1000, 1138, -1000, 1594, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 129, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -102, 1441, 25657, -1000,
+	-303, -304, -307, -308, -1000, -1000, -1000, -309, -311, -1000,
+	-1000, -1000, 14167, 14167, 14167, 14167, -1000, 838, 14714, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 970, 712, 14714, 14714,
+	14714, 14714, 14714, 14714, 14714, 14714, 14714, 14714, 14714, 14714,
+	14714, 14714, 14714, 721, 1134, 1125, 496, 496, 496, 496,
+	-1000, 13620, 14167, 14167, 496, -1000, 1132, 21827, 13620, 13620,
+	14167, 1718, 641, 875, 33862, -1000, 1187, -1000, -1000, -1000,
+	853, -1000, 33862, 33862, 423, 14167, 10884, 8666, 13620, 13620,
+	13620, 13620, 13620, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 495, 1319, 1299, 1326, -1000, 1439,
+	-1000, -168, 17998, 14167, 1124, 33862, -1000, -1000, -1000, 1802,
+	-1000, 1802, 1319, 1659, 1632, 13620, -1000, -1000, 1659, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1238, -1000, 33862,
+	1437, 1771, 33862, 1626, 1121, 275, -1000, 14167, 14167, 1436,
+	-1000, 988, 33862, -1000, -1000, 25110, -1000, -1000, 7556, -1000,
+	33862, 266, 33862, -1000, 23468, 24563, 9221, -53, -1000, 9221,
+	1420, -1000, -28, -46, 11431, 543, -1000, -1000, -1000, 366,
+	15261, 1274, 543, 46, -1000, -1000, -1000, 1490, -1000, 1490,
+	1490, 1490, 1490, 275, 275, 275, 275, -1000, -1000, -1000,
+	-1000, -1000, 1521, 1517, -1000, 1490, 1490, 1490, 1490, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1377, 1377, 1377, 1351, 1351, 317, -1000,
-	13982, 167, 33497, 1645, 895, 196, 360, 1437, 1026, 1026,
-	1026, 360, -1000, 1159, 1093, -1000, -372, 1299, -1000, -1000,
-	1721, -1000, -1000, 657, 770, 757, 569, 33497, 173, 251,
-	-1000, 309, -1000, 33497, 1026, 714, 481, 1026, -1000, 1026,
-	-1000, -1000, -1000, -1000, -1000, 1026, 1298, -1000, 1334, 793,
-	755, 778, 680, 1298, -1000, -1000, -144, 1298, -1000, 1298,
-	-1000, 1298, -1000, 1298, -1000, 1298, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 580, 33497, 173, 728, -1000, 374,
-	-1000, -1000, 728, 728, -1000, -1000, -1000, -1000, 1035, 1032,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1508, 1508, 1508, 1491,
+	1491, 322, -1000, 14167, 210, 33862, 1750, 971, 191, 33862,
+	358, 1558, 1132, 1132, 1132, 358, -1000, 1171, 1163, -1000,
+	-378, 1434, -1000, -1000, 1821, -1000, -1000, 567, 776, 766,
+	461, 33862, 160, 257, -1000, 321, -1000, 33862, 1132, 732,
+	513, 1132, -1000, 1132, -1000, -1000, -1000, -1000, -1000, 1132,
+	1430, -1000, 1425, 897, 763, 866, 728, 1430, -1000, -1000,
+	-120, 1430, -1000, 1430, -1000, 1430, -1000, 1430, -1000, 1430,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 637, 33862,
+	160, 721, -1000, 371, -1000, -1000, 721, 721, -1000, -1000,
+	-1000, -1000, 1120, 1117, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -363, 33497, -1000,
-	191, 597, 241, 257, 238, 33497, 137, 1682, 202, 217,
-	33497, 33497, 355, 1456, 33497, 1657, 33497, -1000, -1000, -1000,
-	-1000, 720, 33497, -1000, -1000, 684, 684, -1000, -1000, 33497,
-	684, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 684,
+	-1000, -363, 33862, -1000, 176, 632, 245, 281, 237, 33862,
+	149, 1777, 230, 222, 33862, 33862, 335, 1589, 33862, 1756,
+	335, 33862, -1000, -1000, -1000, -1000, 875, 33862, -1000, -1000,
+	718, 718, -1000, -1000, 33862, 718, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 718, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 33497, 33497, -1000,
-	-1000, -1000, -1000, -1000, 47, -41, 248, -1000, -1000, -1000,
-	-1000, -1000, 1684, -1000, 720, 724, 663, -1000, -1000, -1000,
-	840, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 883, 14524,
-	14524, 14524, 1660, 371, 1883, 1330, 1074, 708, 708, 743,
-	743, 518, 518, 518, 518, 518, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1227, -1000, 1055, 855, 1079, -1000, 1227,
-	1227, 786, 13440, -1000, -1000, 701, -1000, 13982, 1079, -1000,
-	-1000, 1079, 1297, 1296, 1756, -1000, -1000, -1000, -1000, -1000,
+	718, -1000, -1000, 33862, 33862, -1000, -1000, -1000, -1000, -1000,
+	71, -33, 269, -1000, -1000, -1000, -1000, -1000, 1785, -1000,
+	875, 683, 676, -1000, -1000, -1000, 906, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 970, 14714, 14714, 14714, 1432, 382,
+	1500, 1344, 1139, 822, 822, 715, 715, 561, 561, 561,
+	561, 561, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1301,
+	-1000, 1049, 800, 1187, -1000, 1301, 1301, 982, 13620, -1000,
+	-1000, 705, -1000, 14167, 1187, -1000, -1000, 1187, 1427, 1426,
+	1841, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 939, 1079, 13440, 13440,
-	1295, 1318, 457, -1000, 1227, 1079, 1079, 1227, 1227, 8531,
-	1079, -1000, 33497, -1000, -294, -1000, -80, 500, 1318, -1000,
-	23198, 1079, 1273, -1000, -1000, -1000, -1000, -1000, 20488, 1294,
-	1614, -1000, -1000, 1318, 1263, -1000, -1000, 324, 4, 19946,
-	-1000, -1000, 720, 720, 13982, -1000, -1000, -1000, -1000, -1000,
-	-1000, 451, 1745, 293, 1318, -1000, 1313, 1561, -1000, -1000,
-	-1000, 1668, 17236, 33497, 1276, 1265, -1000, 446, -1000, 1292,
-	-73, -50, -1000, -1000, -1000, -1000, 720, -1000, 1013, 255,
-	2011, -1000, 331, -1000, -1000, -1000, -1000, 1607, 9, -1000,
-	-1000, -1000, 324, 324, -1000, -1000, -1000, -1000, -1000, -1000,
-	1019, 1019, -1000, -1000, -1000, -1000, -1000, 894, -1000, -1000,
-	-1000, 884, -1000, -1000, 1073, 1468, 167, -1000, -1000, 593,
-	1006, 1610, 33497, -1000, -1000, 1158, 191, 33497, 639, 1454,
-	-1000, 1437, 1437, 1437, 33497, -1000, -1000, -1000, -1000, -373,
-	51, 260, -1000, -1000, -1000, 278, 33497, 1254, -1000, 171,
-	-1000, 1150, 1549, 33497, -1000, 1251, 1371, 1026, 1026, -1000,
-	-1000, -1000, 33497, 1318, -1000, -1000, -1000, -1000, 399, 1639,
-	1637, 173, 171, 514, 1026, -1000, -1000, -1000, -1000, -1000,
-	-366, 1239, 380, 183, 205, 33497, 33497, 33497, 33497, 33497,
-	434, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 212,
-	373, -1000, 33497, 33497, 447, -1000, -1000, -1000, 683, -1000,
-	-1000, 683, -1000, -1000, -1000, -1000, -1000, 1627, 33497, -57,
-	-332, -1000, -329, -1000, -1000, -1000, -1000, 1469, 370, 1883,
-	14524, 14524, 13440, -134, 441, 441, 728, -1000, -1000, -1000,
-	13982, 13982, 1329, 628, -1000, 13982, 673, -1000, -1000, 13982,
-	13982, 13982, 1079, 195, -1000, 1227, 1227, 13440, 8531, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 500,
-	-1000, 481, 481, 481, 33497, -1000, -1000, 1690, -1000, 1533,
-	1524, 1754, 1745, -1000, 22656, 1614, -1000, -1000, 33497, -289,
-	-1000, 1567, 1539, -1000, -1000, -1000, -1000, 6881, 1687, 13982,
-	1449, 33497, 1318, -1000, 16693, 33497, 33497, 22656, 22656, 22656,
-	22656, 22656, -1000, 1492, 1491, -1000, 1481, 1471, 1516, 33497,
-	-1000, 1225, 1079, 1706, 17236, 19404, 1241, 22656, -1000, -1000,
-	22656, 33497, 6331, -1000, -1000, -68, -86, -1000, -1000, -1000,
-	-1000, 2521, -1000, -1000, 727, 1664, 1605, -1000, -1000, -1000,
-	-1000, 1218, -1000, 1215, 1291, 1212, 99, -1000, 1422, 1625,
-	593, 593, -1000, 879, -1000, 1026, -1000, -1000, 379, -1000,
-	1648, 33497, 1447, 1446, 1445, -1000, -381, 877, 1370, 1419,
-	13982, 1357, 1719, 1288, 33497, -1000, -1000, 33497, -1000, -1000,
-	356, -1000, 167, 33497, -1000, -1000, -1000, 251, 33497, -1000,
-	5495, 171, -1000, -1000, -1000, -1000, -1000, -1000, 33497, 194,
-	-1000, 1352, 1101, -1000, -1000, 1321, -1000, -1000, -1000, -1000,
-	130, 237, -1000, 33497, 444, 1468, 33497, -1000, -1000, -1000,
-	684, 684, -1000, -1000, 1624, -1000, 1026, 14524, 14524, -1000,
-	510, -1000, 1318, 1079, 1349, 1349, -1000, 1349, 1351, -1000,
-	1349, 100, 1349, 98, 1349, -1000, -1000, 1079, 1079, 751,
-	813, -127, -1000, 720, 13982, 1069, 1043, 971, -1000, 1348,
-	1337, -1000, -1000, 1079, -1000, -1000, 1665, 1665, 1665, 1202,
-	33497, -1000, -1000, -1000, -1000, 1745, 1740, 1289, -1000, -1000,
-	4, 341, -1000, 1585, 1539, -1000, 1717, 1562, 1716, -1000,
-	-1000, -1000, 720, -1000, 1635, 1285, -1000, 576, 1179, -1000,
-	-1000, 12898, 1208, 1523, 445, 1202, 1300, 1561, 1430, 1442,
-	1531, -1000, -1000, -1000, -1000, 1483, -1000, 1470, -1000, -1000,
-	1320, -1000, -1000, 1184, 252, 22656, 1257, 1257, -1000, 435,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 800, 5232, 1765,
-	-1000, 995, -1000, 925, -1000, 699, 682, -1000, 33497, 1327,
-	-1000, 324, 992, 324, 869, -1000, 868, -1000, -1000, -237,
-	-1000, -1000, 1387, 1441, -1000, -1000, 33497, -1000, -1000, 33497,
-	33497, 33497, 1326, 1714, -1000, 13982, 1325, 573, 1001, 33497,
-	33497, -1000, -1000, 246, 1318, -1000, 1200, 1197, -1000, -192,
-	-1000, 13982, -1000, -1000, -1000, 900, 900, -1000, 1320, -1000,
-	-1000, -1000, 1146, -1000, -1000, -146, 33497, 33497, 33497, 33497,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 510,
-	14524, -1000, -1000, 324, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 13982, -1000, 13982, -1000, 1687, 986, 720,
-	13982, 13982, -1000, 864, 848, -1000, 1318, 1527, 1318, 1318,
-	19404, -1000, 1740, 1738, 1712, 1556, 1558, 1558, 1585, -1000,
-	1710, 1709, -1000, 981, 1700, 976, 662, -1000, 33497, 13982,
-	1318, -1000, 295, 33497, 1318, 33497, -1000, 1729, -1000, -1000,
-	13982, 1324, -1000, 13982, -1000, -1000, -1000, -1000, -1000, 1745,
-	1257, -1000, -1000, 525, -1000, 13982, -1000, -1000, -1000, 200,
-	-1000, -1000, -1000, -1000, -1000, 1322, 13982, -1000, -1000, -1000,
-	1144, 1131, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1320, -1000, -1000, -1000, -1000, 251, -376, 988, 33497, 842,
-	-1000, 1195, 1288, 421, -1000, 13982, 300, -1000, 251, -1000,
-	-197, -200, 971, -1000, -1000, 1663, -1000, -1000, 8531, -1000,
-	-1000, 1319, 1432, -1000, 215, -1000, 971, 971, 1079, -1000,
-	971, 971, 1122, 1117, 18862, -1000, 1696, 1692, 22114, 22114,
-	500, 1738, -1000, 13982, 13982, 1534, 826, -1000, -1000, -1000,
-	-1000, 974, 973, -1000, 964, -1000, 1764, -1000, 720, -1000,
-	1318, -1000, 425, 1179, -1000, 1687, 720, 33497, 720, 1729,
-	-1000, 971, -1000, 1318, 33497, 967, -1000, -1000, 1659, 1188,
-	50, -1000, 1183, -1000, -1000, -1000, -1000, 12356, -1000, -1000,
-	-1000, -1000, -1000, -1000, 298, 1286, -1000, 571, 33497, 33497,
-	1079, 211, -152, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1181, -1000, -1000, -1000, -1000, -1000, 1181, 1181, -1000, -1000,
-	720, 1273, -1000, 810, -1000, -1000, -1000, -1000, -1000, 33497,
-	1179, 33497, -1000, 1176, 1687, -1000, 1172, -1000, 298, -1000,
-	1317, 1419, -1000, 1079, -145, 8531, 5781, 1155, -1000, -1000,
-	1497, -139, -180, 21572, -1000, -1000, -1000, -1000, -1000, 1075,
-	-1000, -1000, -1000, 1440, 670, -145, 13982, 1315, -1000, -1000,
-	-45, -1000, -1000, -1000, -1000, -1000, 1321, -1000, 1472, -1000,
-	-1000, -1000, 1439, -1000, 1750, -1000, -1000, -1000, 785, 959,
-	-1000, -1000, -1000, -1000, 790, 33497, 308, -1000, -1000, -146,
-	-148, -1000, 1763, 499, 499, -1000, -1000, -383, 1149, 197,
-	-1000, -153, -1000, -1000, -1000, 303, 876, -1000, -1000, 839,
-	-383, -1000, -190, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 771, 1187, 13620, 13620, 1423, 1451, 493, -1000,
+	1301, 1187, 1187, 1301, 1301, 8666, 1187, -1000, 33862, -1000,
+	-291, -1000, -67, 592, 1451, -1000, 24015, 1187, 1371, -1000,
+	-1000, -1000, -1000, -1000, -1000, 20733, 1446, 1659, -1000, -1000,
+	1451, 1323, -1000, -1000, 275, 51, 20186, -1000, -1000, 875,
+	875, 14167, -1000, -1000, -1000, -1000, -1000, -1000, 487, 1837,
+	293, 1451, -1000, 1366, 1561, -1000, -1000, -1000, 1770, 17451,
+	33862, 1431, 1367, -1000, 486, -1000, 1420, -53, -40, -1000,
+	-1000, -1000, -1000, 875, -1000, 1145, 270, 2837, -1000, 323,
+	-1000, -1000, -1000, -1000, 1690, 29, -1000, -1000, -1000, 275,
+	275, -1000, -1000, -1000, -1000, -1000, -1000, 1111, 1111, -1000,
+	-1000, -1000, -1000, -1000, 964, -1000, -1000, -1000, 959, -1000,
+	-1000, 1003, 1584, 210, -1000, -1000, 631, 1108, 1693, 33862,
+	-1000, -1000, 1266, 176, 1769, 33862, 667, 1587, -1000, 1558,
+	1558, 1558, 33862, -1000, -1000, -1000, -1000, -365, 54, 299,
+	-1000, -1000, -1000, 4403, 33862, 1317, -1000, 156, -1000, 1251,
+	1639, 33862, -1000, 1315, 1507, 1132, 1132, -1000, -1000, -1000,
+	33862, 1451, -1000, -1000, -1000, -1000, 433, 1741, 1721, 160,
+	156, 543, 1132, -1000, -1000, -1000, -1000, -1000, -366, 1303,
+	393, 164, 252, 33862, 33862, 33862, 33862, 33862, 499, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 218, 370, -1000,
+	33862, 33862, 456, -1000, -1000, 33862, -1000, 714, -1000, -1000,
+	714, -1000, -1000, -1000, -1000, -1000, -1000, 1715, 33862, -36,
+	-331, -1000, -328, -1000, -1000, -1000, -1000, 1333, 380, 1500,
+	14714, 14714, 13620, -115, 446, 446, 721, -1000, -1000, -1000,
+	14167, 14167, 1433, 656, -1000, 14167, 760, -1000, -1000, 14167,
+	14167, 14167, 1187, 242, -202, 1301, 1301, 13620, 8666, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 592,
+	-1000, 513, 513, 513, 33862, -1000, -1000, 1791, -1000, 1638,
+	1637, 1840, 1837, -1000, 23468, 1659, -1000, -1000, 33862, -281,
+	-1000, 1668, 1653, -1000, -1000, -1000, -1000, 7001, 1802, 14167,
+	1580, 33862, 1451, -1000, 16903, 33862, 33862, 23468, 23468, 23468,
+	23468, 23468, -1000, 1618, 1610, -1000, 1607, 1603, 1611, 33862,
+	-1000, 1298, 1187, 1814, 17451, 19639, 1369, 23468, -1000, -1000,
+	23468, 33862, 6446, -1000, -1000, -42, -51, -1000, -1000, -1000,
+	-1000, 366, -1000, -1000, 947, 1767, 1684, -1000, -1000, -1000,
+	-1000, 1283, -1000, 1281, 1418, 1277, 154, -1000, 1492, 1714,
+	631, 631, -1000, 953, -1000, 1132, -1000, -1000, 388, 304,
+	-1000, 1753, 33862, 1568, 1565, 1564, -1000, -374, 948, 1505,
+	1484, 14167, 1504, 1820, 1416, 33862, -1000, -1000, 33862, -1000,
+	-1000, 367, -1000, 210, 33862, -1000, -1000, -1000, 257, 33862,
+	-1000, 34333, 156, -1000, -1000, -1000, -1000, -1000, -1000, 33862,
+	168, -1000, 1503, 1096, -1000, -1000, 1548, -1000, -1000, -1000,
+	-1000, 150, 235, -1000, 33862, 445, 1584, 33862, -1000, -1000,
+	-1000, -1000, 718, 718, -1000, -1000, 1713, -1000, 1132, 14714,
+	14714, -1000, 496, -1000, 1451, 1187, 1490, 1490, -1000, 1490,
+	1491, -1000, 1490, 110, 1490, 106, 1490, -1000, -1000, 1187,
+	1187, 826, 870, -109, -1000, 875, 14167, 1086, 895, 902,
+	-1000, 1489, 1488, -1000, -1000, 1451, -1000, -1000, 1187, -1000,
+	-1000, 1782, 1782, 1782, 1254, 33862, -1000, -1000, -1000, -1000,
+	1837, 1835, 1417, -1000, -1000, 51, 320, -1000, 1694, 1653,
+	-1000, 1817, 1661, 1816, -1000, -1000, -1000, 875, -1000, 1720,
+	1343, -1000, 621, 1385, -1000, -1000, 13073, 1272, 1636, 480,
+	1254, 1350, 1561, 1544, 1563, 1528, -1000, -1000, -1000, -1000,
+	1609, -1000, 1571, -1000, -1000, 1453, -1000, -1000, 1299, 266,
+	23468, 1328, 1328, -1000, 479, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 792, 5655, 1856, -1000, 1106, -1000, 1102, -1000,
+	729, 723, -1000, 33862, 1486, -1000, 275, 1105, 275, 915,
+	-1000, 892, -1000, -1000, -218, -1000, -1000, 1479, 1582, -1000,
+	-1000, 33862, -1000, -1000, -1000, 33862, 33862, 33862, 1482, 1812,
+	-1000, 14167, 1481, 619, 996, 33862, 33862, -1000, -1000, 250,
+	1451, -1000, 1236, 1230, -1000, -142, -1000, 14167, -1000, -1000,
+	-1000, 1093, 1093, -1000, 1453, -1000, -1000, -1000, 1248, -1000,
+	-1000, -127, 33862, 33862, 33862, 33862, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 496, 14714, -1000, -1000, 275,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 14167,
+	-1000, 14167, -1000, 1802, 1103, 875, 14167, 14167, -1000, 869,
+	864, 265, -1000, 1451, 1674, 1451, 1451, 19639, -1000, 1835,
+	1829, 1811, 1652, 1654, 1654, 1694, -1000, 1810, 1808, -1000,
+	1101, 1807, 1088, 684, -1000, 33862, 14167, 1451, -1000, 318,
+	33862, 1451, 33862, -1000, 1815, -1000, -1000, 14167, 1480, -1000,
+	14167, -1000, -1000, -1000, -1000, -1000, 1837, 1328, -1000, -1000,
+	569, -1000, 14167, -1000, -1000, -1000, 239, -1000, -1000, -1000,
+	-1000, -1000, 1473, 14167, -1000, -1000, -1000, 1241, 1231, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1453, -1000, -1000,
+	-1000, -1000, 257, -370, 921, 33862, 847, -1000, 1226, 1416,
+	428, -1000, 14167, 309, -1000, 257, -1000, -148, -150, 902,
+	-1000, -1000, 1762, -1000, -1000, 8666, -1000, -1000, 1452, 1549,
+	-1000, 214, -1000, 902, 902, 1187, -1000, 902, 902, 1214,
+	1189, 1815, 1806, 19092, -1000, 1805, 1804, 22921, 22921, 592,
+	1829, -1000, 14167, 14167, 1645, 952, -1000, -1000, -1000, -1000,
+	1087, 1084, -1000, 984, -1000, 1854, -1000, 875, -1000, 1451,
+	-1000, 459, 1385, -1000, 1802, 875, 33862, 875, 1815, -1000,
+	902, -1000, 1451, 33862, 881, -1000, -1000, 1761, 1222, 53,
+	-1000, 1217, -1000, -1000, -1000, -1000, 12526, -1000, -1000, -1000,
+	-1000, -1000, -1000, 304, 1388, -1000, 607, 33862, 33862, 1187,
+	240, -130, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -333,
+	14167, 1213, -1000, -1000, -1000, -1000, -1000, 1213, 1213, -1000,
+	-1000, 875, 1371, -1000, 857, -1000, -1000, -1000, -1000, -1000,
+	33862, 1385, 33862, -1000, 1210, 1802, -1000, 1196, -1000, 304,
+	-1000, 1450, 1484, -1000, 1187, -125, 8666, 5891, 1186, -1000,
+	-1000, 1624, -118, -137, 1187, 144, -1000, -1000, 1371, 22374,
+	-1000, -1000, -1000, -1000, -1000, 1309, -1000, -1000, -1000, 1562,
+	841, -125, 14167, 1338, -1000, -1000, -38, -1000, -1000, -1000,
+	-1000, -1000, 1548, -1000, 1623, -1000, -1000, -1000, 135, -278,
+	-186, -187, -1000, -1000, 14714, -1000, -1000, 1560, -1000, 1846,
+	-1000, -1000, -1000, 789, 972, -1000, -1000, -1000, -1000, 796,
+	33862, 291, -1000, -1000, -127, -128, 672, -1000, -1000, -1000,
+	-1000, -1000, 21827, -1000, 1853, 570, 570, -1000, -1000, -383,
+	1183, 180, -1000, -131, 135, -1000, -1000, -1000, -1000, 326,
+	879, -1000, -1000, 836, -383, -1000, -139, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 2094, 2093, 10, 1, 2089, 38, 75, 159, 23,
-	172, 74, 2087, 2086, 2085, 2084, 188, 186, 184, 2083,
-	2082, 2081, 2080, 2079, 2078, 2077, 2076, 2075, 2074, 190,
-	146, 156, 2073, 2072, 2071, 95, 152, 68, 67, 161,
-	2070, 2068, 62, 2067, 2066, 2064, 174, 154, 648, 2063,
-	150, 94, 26, 2062, 2061, 2060, 2058, 2057, 2056, 2054,
-	2047, 2046, 2045, 2044, 2043, 2042, 2039, 272, 2038, 2037,
-	6, 2024, 66, 2023, 2021, 2020, 102, 2019, 2015, 2014,
-	2013, 2012, 2011, 122, 2010, 2009, 2008, 2005, 163, 2003,
-	2002, 134, 98, 90, 2000, 1999, 82, 162, 1996, 99,
-	1995, 1994, 1993, 133, 1992, 353, 1991, 44, 40, 1990,
-	46, 60, 37, 35, 1989, 1985, 1983, 43, 57, 1982,
-	87, 42, 1981, 76, 96, 1980, 45, 1975, 1974, 104,
-	1971, 1970, 1967, 73, 1961, 1957, 3610, 1956, 72, 117,
-	83, 52, 1955, 16, 93, 1953, 1951, 1950, 1949, 1948,
-	27, 1943, 1942, 1941, 121, 14, 1938, 11, 33, 30,
-	114, 1937, 28, 63, 1935, 120, 1934, 1931, 36, 19,
-	17, 1922, 13, 115, 138, 15, 78, 116, 1921, 1920,
-	32, 55, 1919, 1918, 1917, 1914, 1912, 1911, 50, 1910,
-	34, 1909, 176, 1905, 18, 29, 25, 49, 80, 47,
-	12, 1904, 160, 1902, 31, 157, 101, 135, 1901, 1900,
-	1899, 158, 220, 1898, 1897, 58, 143, 124, 129, 1894,
-	226, 1877, 1876, 70, 1388, 1721, 24, 141, 1864, 1862,
-	2729, 126, 118, 41, 1861, 91, 1859, 1857, 1856, 164,
-	142, 92, 928, 88, 1855, 1854, 1853, 1851, 1850, 1847,
-	1846, 1844, 271, 166, 86, 89, 153, 56, 1840, 1839,
-	1838, 105, 77, 1837, 140, 139, 109, 145, 1836, 147,
-	128, 123, 1834, 85, 1833, 1832, 1831, 1830, 71, 1828,
-	1825, 1822, 1821, 131, 132, 100, 64, 1818, 65, 97,
-	136, 125, 22, 1817, 21, 1815, 1814, 155, 7, 1802,
-	3, 0, 1800, 5, 144, 224, 137, 1799, 1798, 2,
-	1795, 4, 1794, 1793, 119, 1792, 1788, 1787, 9, 20,
-	8, 1785, 1784, 1782, 2880, 2429, 111, 1778, 165,
-}
-
-//line sql.y:6155
+	0, 2154, 2152, 13, 1, 2148, 24, 78, 161, 26,
+	173, 75, 2147, 2145, 2142, 2141, 196, 194, 191, 2140,
+	2137, 2136, 2135, 2134, 2131, 2130, 2129, 2128, 2127, 189,
+	150, 153, 2126, 2125, 2124, 102, 145, 67, 71, 158,
+	2120, 2119, 61, 2118, 2117, 2116, 174, 156, 711, 2115,
+	155, 95, 16, 2114, 2113, 2111, 2110, 2109, 2108, 2107,
+	2106, 2105, 2104, 2103, 2102, 2101, 2100, 2099, 274, 2098,
+	2097, 9, 2096, 63, 2095, 2094, 2093, 112, 2091, 2090,
+	2088, 2087, 2086, 2085, 128, 2084, 2083, 2082, 2081, 164,
+	2080, 2079, 126, 87, 94, 2077, 2076, 77, 159, 2075,
+	106, 2074, 2073, 2065, 137, 2064, 1613, 2062, 45, 48,
+	2061, 46, 6, 39, 38, 2060, 2059, 2058, 44, 72,
+	2057, 85, 51, 2056, 82, 79, 2055, 43, 2054, 2053,
+	92, 2052, 2051, 2050, 68, 2049, 2048, 3620, 2045, 69,
+	121, 89, 59, 2043, 33, 41, 2041, 2038, 2037, 2036,
+	2035, 28, 2034, 2031, 2029, 2028, 2025, 2024, 2023, 3,
+	2022, 2020, 125, 20, 2018, 23, 32, 29, 115, 2017,
+	40, 64, 2016, 124, 2014, 2013, 35, 27, 18, 2012,
+	81, 113, 138, 17, 80, 127, 2011, 2006, 37, 56,
+	2004, 2003, 2002, 2001, 2000, 1999, 53, 1998, 42, 1997,
+	162, 1996, 4, 30, 31, 50, 160, 47, 14, 1994,
+	167, 1993, 36, 168, 107, 141, 1992, 1991, 1989, 146,
+	238, 1988, 1986, 57, 144, 130, 133, 1982, 241, 1978,
+	1977, 74, 1354, 1696, 15, 142, 1972, 1965, 3106, 129,
+	119, 34, 1964, 96, 1961, 1958, 1957, 165, 143, 93,
+	1002, 101, 1956, 1955, 1954, 1952, 1951, 1950, 1949, 1948,
+	123, 166, 90, 88, 152, 58, 1945, 1944, 1942, 104,
+	76, 1941, 140, 139, 111, 84, 1935, 149, 136, 99,
+	1934, 91, 1933, 1932, 1931, 1930, 73, 1928, 1926, 1924,
+	1923, 134, 132, 103, 65, 1921, 66, 97, 135, 131,
+	21, 1920, 19, 1919, 1918, 157, 10, 1914, 5, 0,
+	1903, 8, 148, 239, 147, 1902, 1897, 2, 1895, 7,
+	1894, 1879, 122, 1877, 1876, 1875, 12, 22, 11, 1872,
+	1871, 1870, 3622, 2857, 114, 1868, 169,
+}
+
+//line sql.y:6283
 type yySymType struct {
 	union             interface{}
 	empty             struct{}
@@ -5149,6 +5214,21 @@ func (st *yySymType) exprsUnion() Exprs {
 	return v
 }
 
+func (st *yySymType) frameClauseUnion() *FrameClause {
+	v, _ := st.union.(*FrameClause)
+	return v
+}
+
+func (st *yySymType) framePointUnion() *FramePoint {
+	v, _ := st.union.(*FramePoint)
+	return v
+}
+
+func (st *yySymType) frameUnitUnion() FrameUnitType {
+	v, _ := st.union.(FrameUnitType)
+	return v
+}
+
 func (st *yySymType) ignoreUnion() Ignore {
 	v, _ := st.union.(Ignore)
 	return v
@@ -5284,6 +5364,11 @@ func (st *yySymType) orderDirectionUnion() OrderDirection {
 	return v
 }
 
+func (st *yySymType) overClauseUnion() *OverClause {
+	v, _ := st.union.(*OverClause)
+	return v
+}
+
 func (st *yySymType) partDefUnion() *PartitionDefinition {
 	v, _ := st.union.(*PartitionDefinition)
 	return v
@@ -5475,282 +5560,287 @@ func (st *yySymType) withUnion() *With {
 }
 
 var yyR1 = [...]int{
-	0, 322, 323, 323, 5, 5, 5, 5, 5, 5,
+	0, 330, 331, 331, 5, 5, 5, 5, 5, 5,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
 	5, 5, 5, 5, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 5, 5, 301, 301, 301, 304, 304,
-	27, 62, 29, 29, 28, 28, 31, 31, 30, 6,
-	6, 6, 7, 7, 7, 7, 7, 7, 7, 7,
-	8, 8, 8, 8, 8, 9, 9, 9, 9, 11,
-	11, 11, 11, 11, 14, 15, 10, 10, 16, 16,
-	74, 74, 17, 18, 18, 18, 18, 326, 326, 131,
-	131, 129, 129, 130, 130, 197, 197, 19, 20, 20,
-	207, 207, 206, 206, 206, 208, 208, 208, 208, 242,
-	242, 21, 21, 21, 21, 21, 89, 89, 303, 303,
-	302, 300, 300, 299, 299, 298, 33, 34, 43, 43,
-	43, 43, 44, 45, 305, 305, 274, 49, 49, 48,
-	48, 48, 48, 50, 50, 47, 47, 46, 46, 276,
-	276, 263, 263, 275, 275, 275, 275, 275, 275, 275,
-	262, 262, 100, 100, 178, 178, 178, 178, 178, 178,
-	178, 178, 178, 178, 178, 317, 317, 179, 179, 179,
-	179, 179, 179, 109, 109, 117, 117, 117, 117, 107,
-	107, 108, 106, 106, 106, 112, 112, 112, 112, 112,
-	112, 112, 112, 112, 112, 112, 112, 321, 321, 321,
-	321, 321, 321, 321, 321, 321, 321, 321, 321, 321,
-	321, 321, 321, 321, 321, 321, 321, 321, 321, 321,
-	321, 321, 321, 321, 321, 321, 321, 321, 321, 321,
-	321, 321, 321, 321, 321, 321, 321, 321, 116, 116,
-	113, 113, 113, 114, 114, 114, 318, 318, 318, 318,
-	245, 245, 245, 245, 248, 248, 246, 246, 246, 246,
-	246, 246, 246, 246, 246, 247, 247, 247, 247, 247,
-	249, 249, 249, 249, 249, 250, 250, 250, 250, 250,
-	250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
-	251, 251, 251, 251, 251, 251, 251, 251, 261, 261,
-	252, 252, 256, 256, 257, 257, 257, 258, 258, 258,
-	259, 259, 254, 254, 254, 254, 254, 254, 255, 255,
-	255, 264, 288, 288, 287, 287, 285, 285, 285, 285,
-	273, 273, 282, 282, 282, 282, 282, 272, 272, 268,
-	268, 268, 269, 269, 270, 270, 267, 267, 271, 271,
-	284, 284, 283, 265, 265, 266, 266, 290, 319, 319,
-	319, 319, 320, 320, 291, 310, 311, 309, 309, 309,
-	309, 309, 72, 72, 72, 219, 219, 219, 280, 280,
-	279, 279, 279, 281, 281, 278, 278, 278, 278, 278,
-	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
-	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
-	278, 278, 278, 214, 214, 214, 308, 308, 308, 308,
-	308, 308, 307, 307, 307, 277, 277, 277, 306, 306,
-	51, 51, 163, 163, 40, 40, 40, 40, 40, 40,
-	39, 39, 39, 35, 35, 35, 35, 35, 35, 35,
+	5, 5, 5, 5, 5, 5, 309, 309, 309, 312,
+	312, 27, 62, 29, 29, 28, 28, 31, 31, 30,
+	6, 6, 6, 7, 7, 7, 7, 7, 7, 7,
+	7, 8, 8, 8, 8, 8, 9, 9, 9, 9,
+	11, 11, 11, 11, 11, 14, 15, 10, 10, 16,
+	16, 75, 75, 17, 18, 18, 18, 18, 334, 334,
+	132, 132, 130, 130, 131, 131, 205, 205, 19, 20,
+	20, 215, 215, 214, 214, 214, 216, 216, 216, 216,
+	250, 250, 21, 21, 21, 21, 21, 21, 90, 90,
+	311, 311, 310, 308, 308, 307, 307, 306, 33, 34,
+	43, 43, 43, 43, 44, 45, 313, 313, 282, 49,
+	49, 48, 48, 48, 48, 50, 50, 47, 47, 46,
+	46, 284, 284, 271, 271, 283, 283, 283, 283, 283,
+	283, 283, 270, 270, 101, 101, 186, 186, 186, 186,
+	186, 186, 186, 186, 186, 186, 186, 325, 325, 187,
+	187, 187, 187, 187, 187, 110, 110, 118, 118, 118,
+	118, 108, 108, 109, 107, 107, 107, 113, 113, 113,
+	113, 113, 113, 113, 113, 113, 113, 113, 113, 329,
+	329, 329, 329, 329, 329, 329, 329, 329, 329, 329,
+	329, 329, 329, 329, 329, 329, 329, 329, 329, 329,
+	329, 329, 329, 329, 329, 329, 329, 329, 329, 329,
+	329, 329, 329, 329, 329, 329, 329, 329, 329, 329,
+	117, 117, 114, 114, 114, 115, 115, 115, 326, 326,
+	326, 326, 253, 253, 253, 253, 256, 256, 254, 254,
+	254, 254, 254, 254, 254, 254, 254, 255, 255, 255,
+	255, 255, 257, 257, 257, 257, 257, 258, 258, 258,
+	258, 258, 258, 258, 258, 258, 258, 258, 258, 258,
+	258, 258, 259, 259, 259, 259, 259, 259, 259, 259,
+	269, 269, 260, 260, 264, 264, 265, 265, 265, 266,
+	266, 266, 267, 267, 262, 262, 262, 262, 262, 262,
+	263, 263, 263, 272, 296, 296, 295, 295, 293, 293,
+	293, 293, 281, 281, 290, 290, 290, 290, 290, 280,
+	280, 276, 276, 276, 277, 277, 278, 278, 275, 275,
+	279, 279, 292, 292, 291, 273, 273, 274, 274, 298,
+	327, 327, 327, 327, 328, 328, 299, 318, 319, 317,
+	317, 317, 317, 317, 73, 73, 73, 227, 227, 227,
+	288, 288, 287, 287, 287, 289, 289, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 286, 286, 286, 286, 286,
+	286, 286, 286, 286, 286, 222, 222, 222, 316, 316,
+	316, 316, 316, 316, 315, 315, 315, 285, 285, 285,
+	314, 314, 51, 51, 171, 171, 40, 40, 40, 40,
+	40, 40, 39, 39, 39, 35, 35, 35, 35, 35,
 	35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
-	35, 35, 35, 35, 35, 35, 41, 41, 36, 36,
-	36, 36, 36, 36, 36, 36, 36, 22, 22, 22,
+	35, 35, 35, 35, 35, 35, 35, 35, 41, 41,
+	36, 36, 36, 36, 36, 36, 36, 36, 36, 22,
 	22, 22, 22, 22, 22, 22, 22, 22, 22, 22,
-	22, 22, 22, 22, 22, 22, 22, 76, 76, 77,
-	77, 77, 77, 78, 78, 78, 293, 293, 52, 52,
-	3, 3, 1, 1, 2, 2, 4, 4, 297, 297,
-	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
-	297, 297, 297, 297, 297, 297, 297, 297, 297, 297,
-	260, 260, 260, 292, 292, 294, 296, 296, 296, 296,
-	295, 85, 85, 23, 32, 32, 24, 24, 24, 24,
-	25, 25, 53, 54, 54, 54, 54, 54, 54, 54,
+	22, 22, 22, 22, 22, 22, 22, 22, 22, 77,
+	77, 78, 78, 78, 78, 79, 79, 79, 301, 301,
+	52, 52, 3, 3, 1, 1, 2, 2, 4, 4,
+	305, 305, 305, 305, 305, 305, 305, 305, 305, 305,
+	305, 305, 305, 305, 305, 305, 305, 305, 305, 305,
+	305, 305, 268, 268, 268, 300, 300, 302, 304, 304,
+	304, 304, 303, 86, 86, 23, 32, 32, 24, 24,
+	24, 24, 24, 25, 25, 53, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
-	54, 312, 312, 213, 213, 221, 221, 212, 212, 235,
-	235, 235, 215, 215, 215, 216, 216, 316, 316, 316,
-	55, 55, 55, 57, 57, 58, 59, 59, 237, 237,
-	238, 238, 60, 61, 73, 73, 73, 73, 73, 73,
-	75, 75, 75, 13, 13, 13, 13, 69, 69, 69,
-	12, 12, 56, 56, 63, 313, 313, 314, 315, 315,
-	315, 315, 64, 66, 26, 26, 26, 26, 26, 26,
-	95, 95, 83, 83, 83, 83, 83, 83, 83, 83,
-	83, 83, 83, 83, 90, 90, 90, 84, 84, 327,
-	67, 68, 68, 88, 88, 88, 81, 81, 81, 87,
-	87, 87, 92, 92, 94, 94, 94, 94, 94, 96,
-	96, 96, 96, 96, 96, 96, 91, 91, 93, 93,
-	93, 93, 228, 228, 228, 227, 227, 120, 120, 122,
-	121, 121, 123, 123, 124, 124, 124, 161, 139, 139,
-	195, 195, 194, 194, 196, 196, 196, 196, 198, 198,
-	125, 125, 125, 125, 126, 126, 127, 127, 128, 128,
-	236, 236, 233, 233, 233, 232, 232, 132, 132, 132,
-	134, 133, 133, 133, 133, 135, 135, 137, 137, 136,
-	136, 138, 143, 143, 142, 142, 140, 140, 140, 140,
-	141, 141, 141, 141, 144, 144, 105, 105, 105, 105,
-	105, 105, 115, 115, 115, 115, 118, 118, 118, 118,
-	118, 118, 118, 118, 118, 118, 118, 110, 110, 110,
-	110, 110, 110, 110, 110, 110, 110, 110, 110, 110,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 210, 210, 145, 145, 153, 153, 153,
-	153, 146, 146, 146, 146, 146, 146, 146, 154, 154,
-	154, 160, 155, 155, 151, 151, 151, 151, 149, 149,
-	149, 149, 149, 149, 149, 149, 149, 149, 150, 150,
-	150, 150, 150, 150, 150, 150, 150, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 80, 80, 80, 79,
-	79, 79, 79, 79, 79, 79, 79, 79, 328, 328,
-	253, 253, 253, 152, 152, 152, 152, 152, 86, 86,
-	86, 86, 86, 241, 241, 241, 244, 244, 244, 243,
-	243, 243, 243, 243, 243, 243, 243, 243, 243, 243,
-	243, 243, 243, 243, 166, 166, 82, 82, 164, 164,
-	165, 167, 167, 162, 162, 162, 148, 148, 148, 168,
-	168, 169, 169, 170, 170, 172, 171, 171, 173, 174,
-	174, 174, 175, 175, 176, 176, 176, 42, 42, 42,
-	42, 42, 37, 37, 37, 37, 38, 38, 38, 97,
-	97, 97, 97, 99, 99, 98, 98, 70, 70, 71,
-	71, 71, 103, 103, 104, 104, 104, 101, 101, 102,
-	102, 192, 192, 177, 177, 177, 184, 184, 184, 180,
-	180, 182, 182, 182, 183, 183, 183, 181, 189, 189,
-	191, 191, 190, 190, 186, 186, 187, 187, 188, 188,
-	188, 185, 185, 147, 147, 147, 147, 147, 193, 193,
-	193, 193, 199, 199, 157, 157, 159, 159, 158, 119,
-	200, 200, 204, 201, 201, 205, 205, 205, 205, 205,
-	202, 202, 203, 203, 229, 229, 229, 209, 209, 220,
-	220, 217, 217, 218, 218, 211, 211, 222, 222, 222,
-	65, 156, 156, 289, 289, 286, 225, 225, 226, 226,
-	230, 230, 234, 234, 231, 231, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	324, 325, 239, 240, 240, 240,
+	54, 54, 54, 54, 54, 320, 320, 221, 221, 229,
+	229, 220, 220, 243, 243, 243, 223, 223, 223, 224,
+	224, 324, 324, 324, 55, 55, 55, 57, 57, 58,
+	59, 59, 245, 245, 246, 246, 60, 61, 74, 74,
+	74, 74, 74, 74, 76, 76, 76, 13, 13, 13,
+	13, 70, 70, 70, 12, 12, 56, 56, 63, 321,
+	321, 322, 323, 323, 323, 323, 64, 66, 67, 26,
+	26, 26, 26, 26, 26, 96, 96, 84, 84, 84,
+	84, 84, 84, 84, 84, 84, 84, 84, 84, 91,
+	91, 91, 85, 85, 335, 68, 69, 69, 89, 89,
+	89, 82, 82, 82, 88, 88, 88, 93, 93, 95,
+	95, 95, 95, 95, 97, 97, 97, 97, 97, 97,
+	97, 92, 92, 94, 94, 94, 94, 236, 236, 236,
+	235, 235, 121, 121, 123, 122, 122, 124, 124, 125,
+	125, 125, 169, 140, 140, 203, 203, 202, 202, 204,
+	204, 204, 204, 206, 206, 126, 126, 126, 126, 127,
+	127, 128, 128, 129, 129, 244, 244, 241, 241, 241,
+	240, 240, 133, 133, 133, 135, 134, 134, 134, 134,
+	136, 136, 138, 138, 137, 137, 139, 144, 144, 143,
+	143, 141, 141, 141, 141, 142, 142, 142, 142, 145,
+	145, 106, 106, 106, 106, 106, 106, 116, 116, 116,
+	116, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 111, 111, 111, 111, 111, 111, 111, 111,
+	111, 111, 111, 111, 111, 112, 112, 112, 112, 112,
+	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
+	112, 112, 112, 112, 112, 112, 112, 112, 218, 218,
+	146, 146, 161, 161, 161, 161, 147, 147, 147, 147,
+	147, 147, 147, 162, 162, 162, 168, 163, 163, 152,
+	152, 152, 152, 155, 155, 154, 156, 156, 157, 157,
+	157, 158, 158, 159, 159, 159, 159, 159, 160, 160,
+	160, 150, 150, 150, 150, 150, 150, 150, 150, 150,
+	150, 151, 151, 151, 151, 151, 151, 151, 151, 151,
+	81, 81, 81, 81, 81, 81, 81, 81, 81, 81,
+	81, 81, 80, 80, 80, 80, 80, 80, 80, 80,
+	80, 336, 336, 261, 261, 261, 153, 153, 153, 153,
+	153, 87, 87, 87, 87, 87, 249, 249, 249, 252,
+	252, 252, 251, 251, 251, 251, 251, 251, 251, 251,
+	251, 251, 251, 251, 251, 251, 251, 174, 174, 83,
+	83, 172, 172, 173, 175, 175, 170, 170, 170, 149,
+	149, 149, 176, 176, 177, 177, 178, 178, 180, 179,
+	179, 181, 182, 182, 182, 183, 183, 184, 184, 184,
+	42, 42, 42, 42, 42, 37, 37, 37, 37, 38,
+	38, 38, 98, 98, 98, 98, 100, 100, 99, 99,
+	71, 71, 72, 72, 72, 104, 104, 105, 105, 105,
+	102, 102, 103, 103, 200, 200, 185, 185, 185, 192,
+	192, 192, 188, 188, 190, 190, 190, 191, 191, 191,
+	189, 197, 197, 199, 199, 198, 198, 194, 194, 195,
+	195, 196, 196, 196, 193, 193, 148, 148, 148, 148,
+	148, 201, 201, 201, 201, 207, 207, 165, 165, 167,
+	167, 166, 120, 208, 208, 212, 209, 209, 213, 213,
+	213, 213, 213, 210, 210, 211, 211, 237, 237, 237,
+	217, 217, 228, 228, 225, 225, 226, 226, 219, 219,
+	230, 230, 230, 65, 164, 164, 297, 297, 294, 233,
+	233, 234, 234, 238, 238, 242, 242, 239, 239, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
+	232, 232, 232, 232, 232, 232, 232, 232, 332, 333,
+	247, 248, 248, 248,
 }
 
 var yyR2 = [...]int{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 0, 1, 1, 1, 0, 1,
-	2, 3, 2, 3, 0, 1, 3, 1, 4, 3,
-	3, 4, 3, 2, 3, 4, 3, 4, 2, 7,
-	1, 3, 3, 3, 3, 1, 2, 1, 1, 3,
-	2, 3, 3, 2, 5, 7, 9, 8, 7, 8,
-	1, 1, 10, 11, 9, 8, 8, 1, 1, 1,
-	3, 1, 3, 1, 3, 0, 4, 3, 5, 4,
-	1, 3, 3, 2, 2, 2, 2, 2, 1, 1,
-	1, 2, 2, 6, 12, 2, 0, 2, 0, 2,
-	1, 0, 2, 1, 3, 3, 6, 4, 7, 8,
-	8, 8, 6, 3, 1, 1, 5, 0, 1, 1,
-	1, 2, 2, 0, 1, 4, 4, 4, 4, 2,
-	4, 1, 3, 1, 1, 3, 4, 3, 3, 3,
-	5, 10, 0, 2, 0, 2, 3, 5, 3, 4,
-	2, 3, 2, 3, 3, 1, 1, 0, 2, 2,
-	3, 3, 2, 1, 1, 2, 2, 2, 2, 1,
-	1, 1, 1, 2, 2, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 2, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 1, 1, 1, 0,
+	1, 2, 3, 2, 3, 0, 1, 3, 1, 4,
+	3, 3, 4, 3, 2, 3, 4, 3, 4, 2,
+	7, 1, 3, 3, 3, 3, 1, 2, 1, 1,
+	3, 2, 3, 3, 2, 5, 7, 9, 8, 7,
+	8, 1, 1, 10, 11, 9, 8, 8, 1, 1,
+	1, 3, 1, 3, 1, 3, 0, 4, 3, 5,
+	4, 1, 3, 3, 2, 2, 2, 2, 2, 1,
+	1, 1, 2, 2, 6, 12, 7, 2, 0, 2,
+	0, 2, 1, 0, 2, 1, 3, 3, 6, 4,
+	7, 8, 8, 8, 6, 3, 1, 1, 5, 0,
+	1, 1, 1, 2, 2, 0, 1, 4, 4, 4,
+	4, 2, 4, 1, 3, 1, 1, 3, 4, 3,
+	3, 3, 5, 10, 0, 2, 0, 2, 3, 5,
+	3, 4, 2, 3, 2, 3, 3, 1, 1, 0,
+	2, 2, 3, 3, 2, 1, 1, 2, 2, 2,
+	2, 1, 1, 1, 1, 2, 2, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 1, 2, 1,
-	3, 1, 1, 1, 2, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 2, 2, 2,
-	1, 2, 2, 2, 2, 3, 3, 2, 2, 2,
-	2, 2, 2, 1, 1, 1, 1, 1, 5, 5,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	0, 3, 0, 5, 0, 3, 5, 0, 1, 1,
-	0, 1, 0, 2, 2, 2, 1, 1, 0, 2,
-	2, 5, 0, 1, 1, 2, 1, 3, 2, 3,
-	0, 1, 4, 3, 3, 4, 2, 0, 2, 1,
-	1, 1, 1, 1, 0, 1, 1, 1, 0, 1,
-	1, 3, 3, 3, 1, 3, 1, 7, 5, 6,
-	6, 7, 0, 1, 5, 3, 3, 1, 1, 2,
-	2, 2, 0, 1, 1, 0, 1, 2, 0, 1,
-	1, 3, 2, 1, 2, 3, 3, 4, 4, 3,
-	3, 3, 3, 4, 4, 3, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 1,
+	2, 1, 3, 1, 1, 1, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
+	2, 2, 1, 2, 2, 2, 2, 3, 3, 2,
+	2, 2, 2, 2, 2, 1, 1, 1, 1, 1,
+	5, 5, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 3, 0, 3, 0, 5, 0, 3, 5, 0,
+	1, 1, 0, 1, 0, 2, 2, 2, 1, 1,
+	0, 2, 2, 5, 0, 1, 1, 2, 1, 3,
+	2, 3, 0, 1, 4, 3, 3, 4, 2, 0,
+	2, 1, 1, 1, 1, 1, 0, 1, 1, 1,
+	0, 1, 1, 3, 3, 3, 1, 3, 1, 7,
+	5, 6, 6, 7, 0, 1, 5, 3, 3, 1,
+	1, 2, 2, 2, 0, 1, 1, 0, 1, 2,
+	0, 1, 1, 3, 2, 1, 2, 3, 3, 4,
+	4, 3, 3, 3, 3, 4, 4, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 4, 5, 0, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
-	0, 1, 0, 2, 0, 1, 5, 1, 3, 7,
-	1, 3, 3, 1, 2, 2, 2, 5, 5, 5,
-	6, 8, 6, 5, 5, 2, 2, 2, 2, 3,
-	3, 3, 4, 1, 3, 5, 1, 3, 3, 3,
-	3, 3, 3, 3, 3, 2, 2, 3, 4, 4,
-	2, 11, 3, 6, 8, 6, 6, 6, 13, 8,
-	6, 10, 5, 5, 5, 5, 5, 0, 6, 5,
-	6, 4, 5, 0, 8, 9, 0, 3, 0, 1,
-	0, 3, 1, 1, 0, 2, 0, 2, 5, 3,
-	7, 4, 4, 4, 4, 3, 3, 3, 7, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
-	0, 2, 2, 1, 3, 2, 0, 4, 4, 3,
-	2, 1, 3, 3, 3, 5, 7, 7, 6, 5,
-	3, 2, 3, 3, 3, 7, 3, 3, 3, 3,
-	4, 7, 5, 2, 4, 4, 4, 4, 4, 5,
-	5, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 2, 4, 2, 4, 5, 4, 4, 4,
-	4, 4, 3, 3, 3, 5, 2, 3, 3, 3,
-	3, 1, 1, 0, 1, 0, 1, 1, 1, 0,
-	2, 2, 0, 2, 2, 0, 2, 0, 1, 1,
-	2, 1, 3, 1, 2, 1, 1, 5, 0, 1,
-	0, 1, 2, 3, 0, 3, 3, 3, 3, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
-	3, 3, 2, 2, 3, 1, 3, 2, 1, 2,
-	1, 2, 2, 4, 3, 3, 6, 4, 7, 6,
-	1, 3, 2, 2, 2, 2, 1, 1, 1, 3,
-	2, 1, 1, 1, 0, 1, 1, 0, 3, 0,
-	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
-	1, 1, 0, 1, 0, 1, 2, 3, 4, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 1, 2,
-	3, 5, 0, 1, 2, 1, 1, 0, 1, 2,
-	1, 3, 1, 1, 1, 4, 3, 3, 3, 7,
-	0, 3, 1, 3, 1, 1, 3, 3, 1, 3,
-	4, 4, 4, 3, 2, 4, 0, 1, 0, 2,
-	0, 1, 0, 1, 2, 1, 1, 1, 2, 2,
-	1, 2, 3, 2, 3, 2, 2, 2, 1, 1,
-	3, 3, 0, 1, 1, 2, 6, 5, 6, 6,
-	0, 2, 3, 3, 0, 2, 3, 3, 3, 2,
-	3, 1, 3, 4, 3, 1, 3, 4, 5, 6,
-	3, 4, 5, 6, 3, 4, 1, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 1,
-	1, 1, 1, 1, 3, 1, 1, 2, 2, 2,
-	2, 1, 1, 2, 9, 6, 6, 6, 2, 2,
-	3, 3, 3, 0, 3, 1, 1, 1, 2, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 3, 4, 5, 5, 6, 4, 4,
-	8, 6, 8, 6, 8, 5, 4, 2, 2, 1,
-	2, 2, 2, 8, 8, 6, 5, 1, 1, 1,
+	3, 3, 3, 4, 5, 0, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 0, 1, 0, 2, 0, 1, 5, 1,
+	3, 7, 1, 3, 3, 1, 2, 2, 2, 5,
+	5, 5, 6, 8, 6, 5, 5, 2, 2, 2,
+	2, 3, 3, 3, 4, 1, 3, 5, 1, 3,
+	3, 3, 3, 3, 3, 3, 3, 2, 2, 3,
+	4, 4, 2, 11, 3, 6, 8, 6, 6, 6,
+	13, 8, 6, 10, 5, 5, 5, 5, 5, 0,
+	6, 5, 6, 4, 5, 0, 8, 9, 0, 3,
+	0, 1, 0, 3, 1, 1, 0, 2, 0, 2,
+	5, 3, 7, 4, 4, 4, 4, 3, 3, 3,
+	7, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 2, 0, 2, 2, 1, 3, 2, 0, 4,
+	4, 3, 2, 1, 3, 3, 3, 5, 7, 7,
+	6, 6, 5, 3, 2, 3, 3, 3, 7, 3,
+	3, 3, 3, 4, 7, 5, 2, 4, 4, 4,
+	4, 4, 5, 5, 4, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 2, 4, 2, 4, 5,
+	4, 4, 4, 4, 5, 4, 3, 3, 3, 5,
+	2, 3, 3, 3, 3, 1, 1, 0, 1, 0,
+	1, 1, 1, 0, 2, 2, 0, 2, 2, 0,
+	2, 0, 1, 1, 2, 1, 3, 1, 2, 1,
+	1, 5, 0, 1, 0, 1, 2, 3, 0, 3,
+	3, 3, 3, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 1, 1, 3, 3, 2, 2, 3, 1,
+	3, 2, 1, 2, 1, 2, 2, 4, 4, 3,
+	3, 6, 4, 7, 6, 1, 3, 2, 2, 2,
+	2, 1, 1, 1, 3, 2, 1, 1, 1, 0,
+	1, 1, 0, 3, 0, 2, 0, 2, 1, 2,
+	2, 0, 1, 1, 0, 1, 1, 0, 1, 0,
+	1, 2, 3, 4, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 1, 2, 3, 5, 0, 1, 2,
+	1, 1, 0, 1, 2, 1, 3, 1, 1, 1,
+	4, 3, 3, 3, 7, 0, 3, 1, 3, 1,
+	1, 3, 3, 1, 3, 4, 4, 4, 3, 2,
+	4, 0, 1, 0, 2, 0, 1, 0, 1, 2,
+	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
+	2, 2, 2, 1, 1, 3, 3, 0, 1, 1,
+	2, 6, 5, 6, 6, 0, 2, 3, 3, 0,
+	2, 3, 3, 3, 2, 3, 1, 3, 4, 3,
+	1, 3, 4, 5, 6, 3, 4, 5, 6, 3,
+	4, 1, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 1, 1, 1, 1, 1, 3,
+	1, 1, 2, 2, 2, 2, 1, 1, 2, 9,
+	6, 6, 6, 2, 2, 3, 3, 3, 0, 3,
+	1, 1, 1, 2, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 5,
+	5, 5, 6, 0, 1, 6, 0, 3, 0, 2,
+	5, 1, 1, 2, 2, 2, 2, 2, 1, 1,
+	3, 4, 4, 8, 6, 8, 6, 8, 5, 4,
+	2, 2, 1, 2, 2, 2, 8, 8, 6, 5,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 0, 2,
-	0, 2, 3, 4, 4, 4, 4, 4, 0, 3,
-	4, 7, 3, 1, 1, 1, 0, 5, 5, 2,
-	3, 1, 2, 2, 1, 2, 1, 2, 2, 1,
-	2, 2, 1, 1, 0, 1, 0, 2, 1, 2,
-	4, 0, 2, 1, 3, 5, 1, 2, 2, 0,
-	3, 0, 2, 0, 1, 3, 1, 3, 2, 0,
-	1, 1, 0, 1, 2, 4, 4, 0, 2, 2,
-	1, 1, 3, 3, 3, 3, 3, 3, 3, 0,
-	3, 3, 3, 0, 3, 1, 1, 0, 4, 0,
-	1, 1, 0, 3, 1, 3, 2, 1, 1, 0,
-	1, 2, 4, 9, 3, 5, 0, 3, 3, 0,
-	1, 0, 2, 2, 0, 2, 2, 2, 0, 2,
-	1, 2, 3, 3, 0, 2, 1, 2, 3, 4,
-	3, 0, 1, 2, 1, 5, 4, 4, 1, 3,
-	3, 5, 0, 5, 1, 3, 1, 2, 3, 1,
-	1, 3, 3, 1, 3, 3, 3, 3, 3, 2,
-	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
-	1, 0, 2, 0, 3, 0, 1, 0, 1, 1,
-	5, 0, 1, 0, 1, 2, 1, 1, 1, 1,
-	1, 1, 0, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 2, 0, 2, 3, 4, 4, 4, 4,
+	4, 0, 3, 4, 7, 3, 1, 1, 1, 0,
+	5, 5, 2, 3, 1, 2, 2, 1, 2, 1,
+	2, 2, 1, 2, 2, 1, 1, 0, 1, 0,
+	2, 1, 2, 4, 0, 2, 1, 3, 5, 1,
+	2, 2, 0, 3, 0, 2, 0, 1, 3, 1,
+	3, 2, 0, 1, 1, 0, 1, 2, 4, 4,
+	0, 2, 2, 1, 1, 3, 3, 3, 3, 3,
+	3, 3, 0, 3, 3, 3, 0, 3, 1, 1,
+	0, 4, 0, 1, 1, 0, 3, 1, 3, 2,
+	1, 1, 0, 1, 2, 4, 9, 3, 5, 0,
+	3, 3, 0, 1, 0, 2, 2, 0, 2, 2,
+	2, 0, 2, 1, 2, 3, 3, 0, 2, 1,
+	2, 3, 4, 3, 0, 1, 2, 1, 5, 4,
+	4, 1, 3, 3, 5, 0, 5, 1, 3, 1,
+	2, 3, 1, 1, 3, 3, 1, 3, 3, 3,
+	3, 3, 2, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 0, 1, 0, 2, 0, 3, 0, 1,
+	0, 1, 1, 5, 0, 1, 0, 1, 2, 1,
+	1, 1, 1, 1, 1, 0, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -5795,519 +5885,532 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -322, -5, -9, -14, -15, -16, -17, -18, -19,
+	-1000, -330, -5, -9, -14, -15, -16, -17, -18, -19,
 	-20, -21, -22, -23, -24, -25, -53, -54, -55, -57,
 	-58, -59, -60, -61, -12, -56, -26, -27, -62, -63,
-	-64, -65, -66, -7, -6, -11, 7, 8, -74, -28,
-	30, -33, -43, 203, -44, -34, 204, -45, 206, 205,
-	241, 207, 234, 72, 281, 282, 284, 285, 286, 287,
-	-75, 239, 240, 209, 34, 43, 31, 32, 35, 212,
-	-8, -29, 6, -324, 9, 407, 236, 235, 26, -10,
-	421, 83, -323, 555, -192, -177, 20, 31, 27, -176,
-	-172, -88, -177, 18, 16, 5, -67, -327, -67, -67,
-	10, 11, -67, -274, -276, 83, 140, 83, -67, -49,
-	-48, -47, -46, -50, 29, -40, -41, -297, -39, -36,
-	208, 205, 249, 107, 108, 241, 242, 243, 207, 225,
-	240, 244, 239, 258, -35, 78, 31, 421, 424, -281,
-	204, 210, 211, 408, 110, 109, 73, 206, -278, 333,
-	525, -50, 527, 99, 101, 526, 42, 215, 528, 529,
-	530, 531, 224, 532, 533, 534, 535, 541, 542, 543,
-	544, 111, 5, -67, -234, -230, -301, -224, 86, 87,
-	88, 418, 227, 452, 453, 274, 78, 39, 330, 333,
-	525, 281, 295, 289, 316, 308, 419, 454, 277, 228,
-	262, 522, 306, 116, 527, 280, 455, 242, 338, 339,
-	340, 99, 284, 375, 540, 279, 456, 538, 101, 526,
-	77, 49, 42, 237, 304, 300, 528, 263, 457, 429,
-	256, 110, 107, 547, 34, 298, 48, 28, 537, 109,
-	47, 529, 131, 458, 530, 342, 321, 516, 46, 343,
-	243, 459, 81, 423, 524, 344, 299, 345, 273, 536,
-	209, 460, 508, 346, 347, 517, 461, 322, 326, 462,
-	367, 348, 554, 50, 463, 464, 518, 108, 465, 76,
-	531, 293, 294, 466, 271, 226, 369, 320, 224, 33,
-	349, 420, 275, 56, 250, 370, 44, 324, 551, 43,
-	512, 467, 515, 319, 315, 51, 468, 469, 470, 471,
-	532, 318, 292, 314, 546, 441, 268, 533, 60, 211,
-	328, 327, 329, 257, 366, 311, 472, 473, 474, 231,
-	79, 475, 301, 19, 476, 477, 350, 264, 478, 54,
-	479, 480, 373, 240, 481, 52, 534, 37, 245, 548,
-	535, 482, 483, 484, 485, 486, 352, 487, 351, 323,
-	325, 252, 353, 422, 488, 297, 244, 539, 489, 232,
-	523, 246, 249, 239, 374, 233, 490, 491, 492, 493,
-	494, 278, 495, 496, 285, 541, 41, 497, 498, 499,
-	500, 272, 267, 368, 377, 59, 80, 335, 501, 521,
-	291, 265, 502, 282, 53, 542, 543, 544, 259, 545,
-	7, 552, 553, 355, 111, 269, 270, 45, 312, 251,
-	503, 504, 302, 303, 317, 290, 313, 283, 509, 253,
-	356, 241, 505, 376, 266, 331, 336, 426, 258, 357,
-	520, 425, 310, 307, 260, 506, 358, 216, 254, 255,
-	507, 510, 359, 360, 276, 361, 362, 363, 364, 365,
-	261, 424, 288, 305, 337, 388, 389, 390, 391, 392,
-	393, 394, 395, 396, 397, 398, 399, 400, 401, 402,
-	403, 404, 405, 416, 214, -67, 214, -136, -230, 214,
-	-202, 339, -221, 341, 354, 349, 359, 347, -213, 350,
-	352, 252, -316, 367, 214, 356, 203, 157, 342, 351,
-	360, 361, 276, 362, 365, 261, -312, -301, 530, 545,
-	116, 309, 346, 344, 368, 512, 364, 363, -230, 283,
-	-237, 288, -225, -301, -224, 286, -136, -73, 508, 207,
-	-239, -239, -90, 512, 514, -155, -105, 124, -115, -118,
-	-110, -111, -149, -150, -151, -152, -116, -162, 146, 147,
-	154, 125, -160, -119, 24, 417, 409, 408, 157, 29,
-	199, 66, 67, 411, 412, 127, 55, 384, 385, -117,
-	380, 386, 381, 414, 415, 102, 416, -225, -230, 219,
-	379, 213, 152, 407, -112, -108, -6, -158, 378, 382,
-	383, 387, -301, -224, -114, -113, -145, 89, 95, 100,
-	96, -321, 103, -324, 90, 91, 92, 93, 94, 104,
-	105, 158, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 169, 170, 171, 172, 173, 174, 175, 176,
-	177, 178, 179, 180, 181, 182, 183, 184, 185, 186,
-	187, 188, 189, 190, 191, 192, 193, 194, 195, 196,
-	197, 198, 42, 355, 355, -136, -67, -170, -88, -172,
-	-8, -6, -324, 6, -67, -6, -7, -11, -29, -31,
-	448, -30, -230, -177, -192, 10, 142, 40, 48, -175,
-	-176, -10, -6, -105, 17, 21, 22, -93, 148, -105,
-	-230, -68, -93, -211, 218, -67, -67, -201, -242, 283,
-	-205, 368, 367, -226, -203, -225, -223, -202, 366, 208,
-	427, 123, 23, 25, 126, 157, 17, 127, 35, 210,
-	309, 225, 156, 221, 408, 203, 70, 428, 380, 381,
-	378, 384, 410, 411, 379, 341, 29, 11, 430, 26,
-	235, 22, 36, 150, 205, 130, 238, 24, 236, 102,
-	105, 433, 20, 73, 230, 12, 223, 38, 14, 434,
-	435, 15, 219, 218, 142, 215, 68, 9, 199, 27,
-	139, 64, 436, 118, 437, 438, 439, 440, 66, 140,
-	18, 549, 382, 383, 31, 513, 417, 247, 152, 71,
-	57, 514, 124, 442, 443, 103, 444, 106, 74, 519,
-	120, 16, 69, 40, 445, 248, 446, 220, 550, 447,
-	371, 448, 141, 206, 407, 67, 449, 213, 354, 6,
-	413, 30, 234, 222, 113, 65, 450, 214, 129, 414,
-	415, 217, 104, 5, 117, 32, 10, 72, 75, 385,
-	386, 387, 55, 112, 421, 128, 13, 451, 372, 122,
-	116, -275, 140, -262, -266, -225, 229, -291, 225, -136,
-	-284, -283, -225, -89, -220, 215, 223, 222, 117, -305,
-	120, 269, 379, 213, -46, -47, -202, 156, -76, 246,
-	248, 84, 84, -266, -265, -264, -306, 248, 229, -290,
-	-282, 221, 230, -272, 222, 223, -267, 215, 118, -306,
-	-267, 220, 230, 248, 248, 111, 248, 111, 248, 248,
-	248, 248, 248, 248, 248, 248, 248, 245, -273, 132,
-	-273, 425, 425, -278, -306, -306, -306, 217, 33, 33,
-	-222, -267, 217, 23, -273, -273, -202, 156, -273, -273,
-	-273, -273, 256, 256, -273, -273, -273, -273, -273, -273,
-	-273, -273, -273, -273, -273, -273, -273, -273, -273, 214,
-	-305, -97, 365, 276, 78, -48, 258, -32, -136, -220,
-	215, 216, -305, -136, 200, -136, -215, 140, 13, -215,
-	-212, 355, 353, 340, 345, -215, -215, -215, -215, 259,
-	338, -268, 215, 33, 226, 355, 259, 338, 259, 260,
-	259, 260, 348, 358, 259, -235, 12, 142, 379, 343,
-	347, 252, 214, 253, 216, 357, -301, 515, 260, -235,
-	90, -216, 140, 355, 255, -215, -240, -324, -226, 309,
-	-240, -240, 30, 87, 217, -225, -69, -225, 90, -13,
-	-9, -17, -16, -18, 132, -95, 355, -83, 157, 530,
-	516, 517, 518, 515, 352, 523, 521, 519, 259, 520,
-	84, 120, 122, 123, -105, 139, -146, 132, 133, 134,
-	135, 136, 137, 138, 142, 124, 126, 140, 141, 121,
-	143, 144, 145, 146, 147, 148, 149, 151, 150, 152,
-	153, 156, 201, 202, -111, -111, -111, -111, -160, -324,
-	-324, -324, -111, -210, -324, -111, -324, -324, -324, -324,
-	-166, -105, -324, -328, -324, -328, -328, -253, -324, -253,
-	-324, -324, -324, -324, -324, 200, -324, -324, -324, -324,
-	-324, -253, -253, -253, -253, 100, 95, 89, -162, 96,
-	90, -225, -230, -6, -7, -155, -239, -313, -314, -139,
-	-136, -324, 276, -175, -10, -6, -170, -176, -172, -6,
-	-67, -81, -94, 61, 62, -96, 22, 36, 65, 63,
-	21, -325, 85, -325, -192, -325, 84, -31, -195, 83,
-	59, 41, 90, 90, 84, 19, -171, -173, -105, 12,
-	-228, -227, 23, -225, 90, 200, 98, 12, -137, 27,
-	-136, -211, -211, 84, 283, -205, -242, -207, -206, 369,
-	371, 132, -229, -225, 90, 29, 85, 84, -136, -245,
-	-248, -250, -249, -251, -246, -247, 306, 307, 157, 310,
-	312, 313, 314, 315, 316, 317, 318, 319, 320, 321,
-	30, 237, 302, 303, 304, 305, 322, 323, 324, 325,
-	326, 327, 328, 329, 289, 308, 419, 290, 291, 292,
-	293, 294, 295, 297, 298, 299, 300, 301, -304, -301,
-	83, 85, 84, -252, 83, -97, 214, -301, 215, 215,
-	215, -67, 407, -273, -273, 245, 17, -39, -36, -297,
-	16, -35, -36, 208, 107, 108, 205, 83, -262, 83,
-	-271, -304, -301, 83, 118, 220, 117, -270, -267, -270,
-	-271, -301, -162, -301, 118, 118, -198, -225, -198, -198,
-	21, -198, 21, -198, 21, 92, -225, -198, 21, -198,
-	21, -198, 21, -198, 21, -198, 21, 29, 76, 77,
-	29, 79, 80, 81, -162, -162, -262, -202, -136, -301,
-	92, 92, -273, -273, 92, 90, 90, 90, -273, -273,
-	92, 90, -232, -230, 90, -307, 231, 273, 275, 92,
-	92, 92, 92, 29, 90, -308, 29, 537, 536, 538,
-	539, 540, 92, 29, 92, 29, 92, -225, 83, -136,
-	-103, 263, 203, 205, 208, 74, 90, 277, 132, 42,
-	84, 217, 214, -301, -217, 219, -217, -231, -230, -223,
-	90, -105, -269, 12, 142, -235, -235, -215, -136, -269,
-	-235, -215, -136, -215, -215, -215, -215, -235, -235, -235,
-	-215, -230, -230, -136, -136, -136, -136, -136, -136, -136,
-	-240, -240, -240, -216, -215, 515, 90, 74, -238, 286,
-	320, 509, 510, 511, 84, 421, -129, -136, 515, 515,
-	515, 515, 515, 515, -105, -105, -105, -105, -153, 103,
-	124, 104, 105, -118, -154, -158, -160, 97, 142, 126,
-	140, 141, -110, -111, -110, -110, -110, -110, -110, -110,
-	-110, -110, -110, -110, -110, -110, -110, -241, -301, 90,
-	157, 90, 90, -91, -93, -105, -105, -301, -225, -91,
-	-91, -105, -87, 22, 36, -164, -165, 128, -162, -325,
-	-325, 92, -225, -225, -80, -79, 389, 390, 391, 392,
-	394, 395, 396, 399, 400, 404, 405, 388, 406, 393,
-	398, 401, 402, 403, 397, 305, -105, -92, 22, 36,
-	-91, -226, -231, -223, -91, -92, -92, -91, -91, 200,
-	-192, -325, 84, -315, 371, 372, 513, -233, 248, -232,
-	23, -156, -155, 90, -175, -175, 61, 62, 57, -91,
-	-96, -325, -30, 23, -194, -225, 60, 90, -254, -202,
-	330, 331, -105, -105, 84, -174, 25, 26, -136, -227,
-	148, -231, -136, -197, 248, -136, -121, -123, -124, -125,
-	-139, -161, -324, 12, -129, -130, -138, -230, -205, -207,
-	84, 370, 372, 373, 74, 106, -105, -255, 156, -280,
-	-279, -278, -262, -264, -265, -266, 85, -255, -258, 336,
-	335, -252, -252, -252, -252, -252, -254, -254, -254, -254,
-	83, 83, -252, -252, -252, -252, -256, 83, -256, -256,
-	-257, 83, -257, -291, -105, -288, -287, -285, -286, 224,
-	99, 421, 75, -283, -174, 92, -103, -218, 219, -289,
-	-286, -301, -301, -301, -218, -301, 90, -301, 90, -77,
-	-52, -1, 549, 550, 551, 84, 17, -263, -262, -51,
-	273, -294, -295, 248, -290, -284, -271, 118, -270, -271,
-	-271, -301, 84, 27, 111, 111, 111, 111, 421, 205,
-	30, -262, -51, -241, 217, -241, -241, 90, 90, -214,
-	545, -129, -99, 265, 132, 254, 254, 214, 214, 267,
-	-136, 278, 280, 279, 277, 21, 266, 268, 270, 256,
-	-136, -136, -217, 74, -131, -136, 24, -230, -136, -215,
-	-215, -136, -215, -215, -136, -225, -83, 371, 84, 421,
-	20, -84, 20, 103, 104, 105, -154, -110, -111, -110,
-	123, 238, 84, -325, 23, 84, 75, -325, -325, -325,
-	84, 12, -91, -167, -165, 130, -105, -325, -325, 84,
-	84, 12, -244, 23, -325, -91, -91, -324, 200, -325,
-	-325, -325, -325, -325, -226, -314, 512, 372, -143, -142,
-	-140, 72, 218, 73, -324, -232, -325, -148, -225, 92,
-	96, -177, -120, -122, 12, -96, -160, 85, 84, -254,
-	-181, -186, -212, -225, 90, 157, -173, 200, -144, 13,
-	-147, 30, 55, -9, -324, -324, 30, 84, -132, -134,
-	-133, -135, 64, 68, 70, 65, 66, 67, 71, -236,
-	23, -121, -7, -6, -324, -136, -129, -326, 12, 75,
-	-326, 84, 200, -206, -208, 374, 371, 377, -301, 90,
-	-76, 84, -278, -266, -178, -100, 38, -259, 337, -254,
-	-254, -261, 90, -261, 92, 92, 85, -42, -37, -38,
-	31, 78, -285, -273, 90, 37, -225, 85, -99, -136,
-	124, 74, -289, -289, -289, -230, -2, 548, 554, 118,
-	83, 340, 16, -194, 84, 85, -163, 274, 85, -296,
-	55, -225, 85, 83, -271, -271, -225, -324, 214, 29,
-	29, -51, -163, -255, -301, 547, 546, 85, 216, 272,
-	-104, 384, -101, 90, 86, -136, -136, -136, -136, -136,
-	208, 205, 257, 217, -129, -136, 84, -72, 233, 228,
-	-235, -235, 31, -136, 371, 524, 522, 123, 238, -118,
-	-111, -93, 418, -243, 157, 306, 237, 304, 300, 320,
-	311, 335, 302, 336, 299, 298, 297, -243, -241, -105,
-	-105, -170, 131, -105, 129, -105, -105, -105, -325, 157,
-	306, -325, -325, -92, -226, -140, -267, -267, -267, -198,
-	-209, 20, 12, 55, 55, -120, -144, -121, -96, -225,
-	-184, 508, -189, 44, -187, -188, 45, -185, 46, 54,
-	148, -175, -105, -199, 74, -200, -204, -162, -157, -159,
-	-158, -324, -193, -325, -225, -198, -200, -123, -124, -124,
-	-123, -124, 64, 64, 64, 69, 64, 69, 64, -133,
-	-230, -325, -325, -7, -233, 75, -121, -121, -138, -230,
-	148, 371, 375, 376, -278, -320, 103, 124, 29, 74,
-	333, 99, -318, 156, -319, 220, 117, 118, 232, 23,
-	39, 85, 84, 85, 84, 85, 84, -219, 458, 124,
-	-38, -37, -273, -273, 92, -301, 216, 24, -136, 74,
-	74, 74, -78, 552, 92, 83, -3, 78, -105, 83,
-	17, -262, -162, 250, 142, -288, -194, -292, -294, -136,
-	-107, -324, -106, -108, -112, 146, 147, -163, -136, -98,
-	263, 271, 83, -102, 87, -303, 75, 254, 333, 254,
-	-136, -72, -42, -136, -215, -215, 31, -301, -118, -111,
-	-324, -325, -252, -252, -252, -257, -252, 294, -252, 294,
-	-252, -325, -325, 84, -325, 20, -325, -82, 413, -105,
-	84, 84, -325, 83, 83, -325, -141, 20, -141, -141,
-	-325, -136, -144, -168, 14, -181, 49, 312, -191, -190,
-	53, 45, -188, 17, 47, 17, 28, -199, 84, 132,
-	84, -325, -325, 84, 55, 200, -325, -144, -127, -126,
-	74, 75, -128, 74, -126, 64, 64, -195, -325, -197,
-	-121, -144, -144, 200, 103, -324, -109, -117, -107, 10,
-	90, 90, -301, 118, 118, -136, 83, -254, 90, -254,
-	92, 92, 458, 29, 79, 80, 81, 29, 76, 77,
-	-136, -136, -136, -136, -293, 83, 17, -105, 83, 132,
-	85, -194, -194, 251, -158, -324, 85, -325, 84, -260,
-	421, 424, -105, -113, -113, -195, 85, -300, 421, -302,
-	-225, -225, -225, -225, -110, -254, -105, -105, -175, 90,
-	-105, -105, 92, 92, -324, 64, 16, 14, -324, -324,
-	-233, -168, -169, 15, 17, -182, 51, -180, 50, -180,
-	-190, 17, 17, 90, 17, 90, 118, -204, -105, -159,
-	55, -9, -225, -157, -225, -170, -105, 83, -105, -144,
-	-144, -105, -150, 416, 83, -105, 85, 85, -195, -292,
-	-52, 85, -194, 92, 85, -158, -85, -324, 247, -319,
-	-294, 425, 425, -325, 23, -299, -298, -226, 83, 75,
-	-86, 142, 421, -325, -325, -325, -325, -325, 85, 85,
-	-196, -325, -225, 220, 17, 17, -196, -196, -143, -169,
-	-105, -155, -183, 52, 74, 106, 90, 90, 90, 10,
-	-157, 200, -175, -194, -170, -325, -194, 85, 23, 85,
-	554, 118, 85, 247, -9, 84, 132, -194, -136, -325,
-	419, 71, 422, 84, -325, -325, -325, 74, 106, -200,
-	-225, 85, -175, 85, -179, -9, 83, -3, -325, -70,
-	421, -298, -277, -226, 90, 92, 85, 60, 420, 423,
-	-225, 220, -310, -311, 74, -320, -317, 103, 124, 99,
-	-318, 112, 113, -70, -105, 83, -71, 262, 512, -303,
-	60, -311, 74, 11, 10, 103, 90, 85, -194, 225,
-	-300, 421, -309, 233, 228, 231, 30, -309, -4, 553,
-	85, 264, 422, 227, 29, 103, 92, -4, 423,
+	-64, -65, -66, -67, -7, -6, -11, 7, 8, -75,
+	-28, 30, -33, -43, 203, -44, -34, 204, -45, 206,
+	205, 241, 207, 234, 72, 283, 284, 286, 287, 288,
+	289, -76, 239, 240, 209, 34, 43, 31, 32, 35,
+	212, 282, -8, -29, 6, -332, 9, 409, 236, 235,
+	26, -10, 423, 83, -331, 560, -200, -185, 20, 31,
+	27, -184, -180, -89, -185, 18, 16, 5, -68, -335,
+	-68, -68, 10, 11, -68, -282, -284, 83, 140, 83,
+	-68, -49, -48, -47, -46, -50, 29, -40, -41, -305,
+	-39, -36, 208, 205, 249, 107, 108, 241, 242, 243,
+	207, 225, 240, 244, 239, 258, -35, 78, 31, 423,
+	426, -289, 204, 210, 211, 410, 110, 109, 73, 206,
+	-286, 335, 530, -50, 532, 99, 101, 531, 42, 215,
+	533, 534, 535, 536, 224, 537, 538, 539, 540, 546,
+	547, 548, 549, 111, 5, -68, -242, -238, -309, -232,
+	86, 87, 88, 420, 227, 454, 455, 274, 78, 39,
+	332, 335, 530, 283, 297, 291, 318, 310, 421, 456,
+	277, 228, 262, 527, 308, 116, 532, 280, 457, 242,
+	340, 341, 342, 99, 286, 377, 545, 279, 458, 543,
+	101, 531, 77, 49, 510, 42, 237, 306, 302, 533,
+	263, 459, 431, 256, 110, 107, 552, 34, 300, 48,
+	28, 542, 109, 47, 534, 131, 460, 535, 344, 323,
+	521, 46, 345, 243, 461, 81, 425, 529, 346, 301,
+	347, 273, 541, 209, 462, 513, 348, 349, 522, 463,
+	324, 328, 464, 369, 350, 559, 50, 465, 466, 523,
+	108, 467, 76, 536, 295, 296, 468, 271, 226, 371,
+	322, 224, 33, 351, 422, 275, 56, 250, 372, 44,
+	326, 556, 43, 517, 469, 520, 321, 317, 51, 470,
+	471, 472, 473, 281, 537, 320, 294, 316, 551, 443,
+	268, 538, 60, 211, 330, 329, 331, 257, 368, 313,
+	474, 475, 476, 231, 79, 477, 303, 19, 478, 479,
+	352, 264, 480, 54, 481, 482, 375, 240, 483, 52,
+	539, 37, 245, 553, 540, 484, 485, 486, 487, 488,
+	354, 489, 353, 325, 327, 252, 355, 424, 490, 299,
+	244, 544, 491, 232, 282, 528, 246, 249, 239, 376,
+	233, 492, 493, 494, 495, 496, 278, 497, 498, 287,
+	511, 546, 512, 41, 499, 500, 501, 502, 272, 267,
+	370, 379, 59, 80, 337, 503, 526, 293, 265, 504,
+	284, 53, 547, 548, 549, 259, 550, 7, 557, 558,
+	357, 111, 269, 270, 45, 314, 251, 505, 506, 304,
+	305, 319, 292, 315, 285, 514, 253, 358, 241, 507,
+	378, 266, 333, 338, 428, 258, 359, 525, 427, 312,
+	309, 260, 508, 360, 216, 254, 255, 509, 515, 361,
+	362, 276, 363, 364, 365, 366, 367, 261, 426, 290,
+	307, 339, 390, 391, 392, 393, 394, 395, 396, 397,
+	398, 399, 400, 401, 402, 403, 404, 405, 406, 407,
+	418, 214, -68, 214, -137, -238, 214, -210, 341, -229,
+	343, 356, 351, 361, 349, -221, 352, 354, 252, -324,
+	369, 214, 358, 203, 157, 344, 353, 362, 363, 281,
+	276, 364, 367, 261, -320, -309, 535, 550, 116, 311,
+	348, 346, 370, 517, 366, 365, -238, 285, -245, 290,
+	-233, -309, -232, 288, -137, -74, 513, 207, -247, -247,
+	-91, 517, 519, -163, -106, 124, -116, -119, -111, -112,
+	-150, -151, -152, -153, -117, -170, 146, 147, 154, 125,
+	-168, -120, 24, 419, 411, 410, 157, 29, 199, 66,
+	67, 413, 414, 127, 55, 386, 387, -118, 382, 388,
+	383, 416, 417, 102, 418, -233, -238, 219, 381, 213,
+	152, 409, -113, -109, -6, -166, 380, 384, 385, 389,
+	-309, -232, -115, -114, -146, 89, 95, 100, 96, -329,
+	103, -332, 90, 91, 92, 93, 94, 104, 105, 158,
+	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
+	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
+	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
+	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
+	42, 357, 357, -137, -68, 281, -178, -89, -180, -8,
+	-6, -332, 6, -68, -6, -7, -11, -29, -31, 450,
+	-30, -238, -185, -200, 10, 142, 40, 48, -183, -184,
+	-10, -6, -106, 17, 21, 22, -94, 148, -106, -238,
+	-69, -94, -219, 218, -68, -68, -209, -250, 285, -213,
+	370, 369, -234, -211, -233, -231, -210, 368, 208, 429,
+	123, 23, 25, 126, 157, 17, 127, 35, 210, 311,
+	225, 156, 221, 410, 203, 70, 430, 382, 383, 380,
+	386, 412, 413, 381, 343, 29, 11, 432, 26, 235,
+	22, 36, 150, 205, 130, 238, 24, 236, 102, 105,
+	435, 20, 73, 230, 12, 223, 38, 14, 436, 437,
+	15, 219, 218, 142, 215, 68, 9, 199, 27, 139,
+	64, 438, 118, 439, 440, 441, 442, 66, 140, 18,
+	554, 384, 385, 31, 518, 419, 247, 152, 71, 57,
+	519, 124, 444, 445, 103, 446, 106, 74, 524, 120,
+	16, 69, 40, 447, 248, 448, 220, 555, 449, 373,
+	450, 141, 206, 409, 67, 451, 213, 356, 6, 415,
+	30, 234, 222, 113, 65, 452, 214, 129, 416, 417,
+	217, 104, 5, 117, 32, 10, 72, 75, 387, 388,
+	389, 55, 112, 423, 128, 13, 453, 374, 122, 116,
+	-283, 140, -270, -274, -233, 229, -299, 225, -137, -292,
+	-291, -233, -90, 281, -228, 215, 223, 222, 117, -313,
+	120, 269, 381, 213, -46, -47, -210, 156, -77, 246,
+	248, 84, 84, -274, -273, -272, -314, 248, 229, -298,
+	-290, 221, 230, -280, 222, 223, -275, 215, 118, -314,
+	-275, 220, 230, 248, 248, 111, 248, 111, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 245, -281, 132,
+	-281, 427, 427, -286, -314, -314, -314, 217, 33, 33,
+	-230, -275, 217, 23, -281, -281, -210, 156, -281, -281,
+	-281, -281, 256, 256, -281, -281, -281, -281, -281, -281,
+	-281, -281, -281, -281, -281, -281, -281, -281, -281, 214,
+	-313, -98, 367, 276, 78, -48, 258, -32, -137, -228,
+	215, 216, 281, -313, -137, 200, -137, -223, 140, 13,
+	-223, -220, 357, 355, 342, 347, -223, -223, -223, -223,
+	259, 340, -276, 215, 33, 226, 357, 259, 340, 259,
+	260, 259, 260, 350, 360, 259, -243, 12, 142, 381,
+	345, 349, 252, 214, 253, 216, 359, -309, 520, 260,
+	-243, -233, 90, -224, 140, 357, 255, -223, -248, -332,
+	-234, 311, -248, -248, 30, 87, 217, -233, -70, -233,
+	90, -13, -9, -17, -16, -18, 132, -96, 357, -84,
+	157, 535, 521, 522, 523, 520, 354, 528, 526, 524,
+	259, 525, 84, 120, 122, 123, -106, 139, -147, 132,
+	133, 134, 135, 136, 137, 138, 142, 124, 126, 140,
+	141, 121, 143, 144, 145, 146, 147, 148, 149, 151,
+	150, 152, 153, 156, 201, 202, -112, -112, -112, -112,
+	-168, -332, -332, -332, -112, -218, -332, -112, -332, -332,
+	-332, -332, -174, -106, -332, -336, -332, -336, -336, -261,
+	-332, -261, -332, -332, -332, -332, -332, 200, -332, -332,
+	-332, -332, -332, -261, -261, -261, -261, 100, 95, 89,
+	-170, 96, 90, -233, -238, -6, -7, -163, -247, -321,
+	-322, -140, -137, -332, 276, 216, -183, -10, -6, -178,
+	-184, -180, -6, -68, -82, -95, 61, 62, -97, 22,
+	36, 65, 63, 21, -333, 85, -333, -200, -333, 84,
+	-31, -203, 83, 59, 41, 90, 90, 84, 19, -179,
+	-181, -106, 12, -236, -235, 23, -233, 90, 200, 98,
+	12, -138, 27, -137, -219, -219, 84, 285, -213, -250,
+	-215, -214, 371, 373, 132, -237, -233, 90, 29, 85,
+	84, -137, -253, -256, -258, -257, -259, -254, -255, 308,
+	309, 157, 312, 314, 315, 316, 317, 318, 319, 320,
+	321, 322, 323, 30, 237, 304, 305, 306, 307, 324,
+	325, 326, 327, 328, 329, 330, 331, 291, 310, 421,
+	292, 293, 294, 295, 296, 297, 299, 300, 301, 302,
+	303, -312, -309, 83, 85, 84, -260, 83, -98, 216,
+	214, -309, 215, 215, 215, -68, 409, -281, -281, 245,
+	17, -39, -36, -305, 16, -35, -36, 208, 107, 108,
+	205, 83, -270, 83, -279, -312, -309, 83, 118, 220,
+	117, -278, -275, -278, -279, -309, -170, -309, 118, 118,
+	-206, -233, -206, -206, 21, -206, 21, -206, 21, 92,
+	-233, -206, 21, -206, 21, -206, 21, -206, 21, -206,
+	21, 29, 76, 77, 29, 79, 80, 81, -170, -170,
+	-270, -210, -137, -309, 92, 92, -281, -281, 92, 90,
+	90, 90, -281, -281, 92, 90, -240, -238, 90, -315,
+	231, 273, 275, 92, 92, 92, 92, 29, 90, -316,
+	29, 542, 541, 543, 544, 545, 92, 29, 92, 29,
+	92, -233, 83, -137, -104, 263, 203, 205, 208, 74,
+	90, 277, 132, 42, 84, 217, 214, -309, -225, 219,
+	216, -225, -239, -238, -231, 90, -106, -277, 12, 142,
+	-243, -243, -223, -137, -277, -243, -223, -137, -223, -223,
+	-223, -223, -243, -243, -243, -223, -238, -238, -137, -137,
+	-137, -137, -137, -137, -137, -248, -248, -248, -224, -223,
+	-243, 520, 90, 74, -246, 288, 322, 514, 515, 516,
+	84, 423, -130, -137, 520, 520, 520, 520, 520, 520,
+	-106, -106, -106, -106, -161, 103, 124, 104, 105, -119,
+	-162, -166, -168, 97, 142, 126, 140, 141, -111, -112,
+	-111, -111, -111, -111, -111, -111, -111, -111, -111, -111,
+	-111, -111, -111, -249, -309, 90, 157, 90, 90, -92,
+	-94, -106, -106, -309, -233, -92, -92, -106, -88, 22,
+	36, -172, -173, 128, -170, -333, -333, 92, -233, -233,
+	-81, -80, 391, 392, 393, 394, 396, 397, 398, 401,
+	402, 406, 407, 390, 408, 395, 400, 403, 404, 405,
+	399, 307, -106, -93, 22, 36, -92, -234, -239, -231,
+	-92, -93, -93, -92, -92, 200, -200, -333, 84, -323,
+	373, 374, 518, -241, 248, -240, 23, -164, -163, 90,
+	-137, -183, -183, 61, 62, 57, -92, -97, -333, -30,
+	23, -202, -233, 60, 90, -262, -210, 332, 333, -106,
+	-106, 84, -182, 25, 26, -137, -235, 148, -239, -137,
+	-205, 248, -137, -122, -124, -125, -126, -140, -169, -332,
+	12, -130, -131, -139, -238, -213, -215, 84, 372, 374,
+	375, 74, 106, -106, -263, 156, -288, -287, -286, -270,
+	-272, -273, -274, 85, -263, -266, 338, 337, -260, -260,
+	-260, -260, -260, -262, -262, -262, -262, 83, 83, -260,
+	-260, -260, -260, -264, 83, -264, -264, -265, 83, -265,
+	-299, -106, -296, -295, -293, -294, 224, 99, 423, 75,
+	-291, -182, 92, -104, -137, -226, 219, -297, -294, -309,
+	-309, -309, -226, -309, 90, -309, 90, -78, -52, -1,
+	554, 555, 556, 84, 17, -271, -270, -51, 273, -302,
+	-303, 248, -298, -292, -279, 118, -278, -279, -279, -309,
+	84, 27, 111, 111, 111, 111, 423, 205, 30, -270,
+	-51, -249, 217, -249, -249, 90, 90, -222, 550, -130,
+	-100, 265, 132, 254, 254, 214, 214, 267, -137, 278,
+	280, 279, 277, 21, 266, 268, 270, 256, -137, -137,
+	-225, 74, -132, -137, 24, -225, -238, -137, -223, -223,
+	-137, -223, -223, -223, -137, -233, -84, 373, 84, 423,
+	20, -85, 20, 103, 104, 105, -162, -111, -112, -111,
+	123, 238, 84, -333, 23, 84, 75, -333, -333, -333,
+	84, 12, -92, -175, -173, 130, -106, -333, -333, 84,
+	84, 12, -252, 23, -333, -92, -92, -332, 200, -333,
+	-333, -333, -333, -333, -234, -322, 517, 374, -144, -143,
+	-141, 72, 218, 73, -332, -240, -333, -149, -233, 92,
+	96, -185, -121, -123, 12, -97, -168, 85, 84, -262,
+	-189, -194, -220, -233, 90, 157, -181, 200, -145, 13,
+	-148, 30, 55, -9, -332, -332, 30, 84, -133, -135,
+	-134, -136, 64, 68, 70, 65, 66, 67, 71, -244,
+	23, -122, -7, -6, -332, -137, -130, -334, 12, 75,
+	-334, 84, 200, -214, -216, 376, 373, 379, -309, 90,
+	-77, 84, -286, -274, -186, -101, 38, -267, 339, -262,
+	-262, -269, 90, -269, 92, 92, 85, -42, -37, -38,
+	31, 78, -293, -281, 90, 37, -233, 85, -100, 23,
+	-137, 124, 74, -297, -297, -297, -238, -2, 553, 559,
+	118, 83, 342, 16, -202, 84, 85, -171, 274, 85,
+	-304, 55, -233, 85, 83, -279, -279, -233, -332, 214,
+	29, 29, -51, -171, -263, -309, 552, 551, 85, 216,
+	272, -105, 386, -102, 90, 86, -137, -137, -137, -137,
+	-137, 208, 205, 257, 217, -130, -137, 84, -73, 233,
+	228, -137, -243, -243, 31, -137, 373, 529, 527, 123,
+	238, -119, -112, -94, 420, -251, 157, 308, 237, 306,
+	302, 322, 313, 337, 304, 338, 301, 300, 299, -251,
+	-249, -106, -106, -178, 131, -106, 129, -106, -106, -106,
+	-333, 157, 308, -155, -154, 447, -333, -333, -93, -234,
+	-141, -275, -275, -275, -206, -217, 20, 12, 55, 55,
+	-121, -145, -122, -97, -233, -192, 513, -197, 44, -195,
+	-196, 45, -193, 46, 54, 148, -183, -106, -207, 74,
+	-208, -212, -170, -165, -167, -166, -332, -201, -333, -233,
+	-206, -208, -124, -125, -125, -124, -125, 64, 64, 64,
+	69, 64, 69, 64, -134, -238, -333, -333, -7, -241,
+	75, -122, -122, -139, -238, 148, 373, 377, 378, -286,
+	-328, 103, 124, 29, 74, 335, 99, -326, 156, -327,
+	220, 117, 118, 232, 23, 39, 85, 84, 85, 84,
+	85, 84, -227, 460, 124, -38, -37, -281, -281, 92,
+	-309, 216, -9, 24, -137, 74, 74, 74, -79, 557,
+	92, 83, -3, 78, -106, 83, 17, -270, -170, 250,
+	142, -296, -202, -300, -302, -137, -108, -332, -107, -109,
+	-113, 146, 147, -171, -137, -99, 263, 271, 83, -103,
+	87, -311, 75, 254, 335, 254, -137, -73, -42, -137,
+	-223, -223, 31, -309, -119, -112, -332, -333, -260, -260,
+	-260, -265, -260, 296, -260, 296, -260, -333, -333, 84,
+	-333, 20, -333, -83, 415, -106, 84, 84, -333, 83,
+	83, -332, -333, -142, 20, -142, -142, -333, -137, -145,
+	-176, 14, -189, 49, 314, -199, -198, 53, 45, -196,
+	17, 47, 17, 28, -207, 84, 132, 84, -333, -333,
+	84, 55, 200, -333, -145, -128, -127, 74, 75, -129,
+	74, -127, 64, 64, -203, -333, -205, -122, -145, -145,
+	200, 103, -332, -110, -118, -108, 10, 90, 90, -309,
+	118, 118, -137, 83, -262, 90, -262, 92, 92, 460,
+	29, 79, 80, 81, 29, 76, 77, -137, -137, -137,
+	-137, -301, 83, 17, -106, 83, 132, 85, -202, -202,
+	251, -166, -332, 85, -333, 84, -268, 423, 426, -106,
+	-114, -114, -203, 85, -308, 423, -310, -233, -233, -233,
+	-233, -111, -262, -106, -106, -183, 90, -106, -106, 92,
+	92, -156, 248, -332, 64, 16, 14, -332, -332, -241,
+	-176, -177, 15, 17, -190, 51, -188, 50, -188, -198,
+	17, 17, 90, 17, 90, 118, -212, -106, -167, 55,
+	-9, -233, -165, -233, -178, -106, 83, -106, -145, -145,
+	-106, -151, 418, 83, -106, 85, 85, -203, -300, -52,
+	85, -202, 92, 85, -166, -86, -332, 247, -327, -302,
+	427, 427, -333, 23, -307, -306, -234, 83, 75, -87,
+	142, 423, -333, -333, -333, -333, -333, 85, 85, -178,
+	17, -204, -333, -233, 220, 17, 17, -204, -204, -144,
+	-177, -106, -163, -191, 52, 74, 106, 90, 90, 90,
+	10, -165, 200, -183, -202, -178, -333, -202, 85, 23,
+	85, 559, 118, 85, 247, -9, 84, 132, -202, -137,
+	-333, 421, 71, 424, -157, -158, 512, 555, -163, 84,
+	-333, -333, -333, 74, 106, -208, -233, 85, -183, 85,
+	-187, -9, 83, -3, -333, -71, 423, -306, -285, -234,
+	90, 92, 85, 60, 422, 425, -333, -159, 126, 510,
+	507, -160, 92, 96, 199, -233, 220, -318, -319, 74,
+	-328, -325, 103, 124, 99, -326, 112, 113, -71, -106,
+	83, -72, 262, 517, -311, 60, -159, 511, 487, 462,
+	487, 462, -112, -319, 74, 11, 10, 103, 90, 85,
+	-202, 225, -308, 423, 123, -233, -317, 233, 228, 231,
+	30, -317, -4, 558, 85, 264, 424, -159, 227, 29,
+	103, 92, -4, 425,
 }
 
 var yyDef = [...]int{
 	-2, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 65, 67, 68, 709, 709, 709, 0,
-	709, 0, 0, 709, -2, -2, 709, 1132, 0, 709,
-	0, 0, -2, 641, 643, 0, 645, -2, 0, 0,
-	654, 1582, 1582, 704, 0, 0, 0, 0, 0, 709,
-	993, 45, 709, 0, 80, 81, 660, 661, 662, 60,
-	0, 1580, 1, 3, 66, 70, 0, 0, 0, 53,
-	1002, 0, 73, 0, 0, 713, 0, 711, 0, 1115,
-	709, 709, 0, 111, 112, 0, 0, 0, -2, 115,
-	-2, 139, 140, 0, 144, 507, 447, 490, 445, 476,
-	-2, 438, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 450, 340, 340, 0, 0, -2,
-	438, 438, 438, 0, 0, 0, 473, 1117, 393, 340,
-	340, 0, 340, 340, 340, 340, 0, 0, 340, 340,
-	340, 340, 340, 340, 340, 340, 340, 340, 340, 340,
-	340, 340, 340, 1019, 143, 1133, 1130, 1131, 35, 36,
-	37, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285,
-	1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295,
-	1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305,
-	1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315,
-	1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325,
-	1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335,
-	1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345,
-	1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355,
-	1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365,
-	1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375,
-	1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385,
-	1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395,
-	1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405,
-	1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413, 1414, 1415,
-	1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424, 1425,
-	1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433, 1434, 1435,
-	1436, 1437, 1438, 1439, 1440, 1441, 1442, 1443, 1444, 1445,
-	1446, 1447, 1448, 1449, 1450, 1451, 1452, 1453, 1454, 1455,
-	1456, 1457, 1458, 1459, 1460, 1461, 1462, 1463, 1464, 1465,
-	1466, 1467, 1468, 1469, 1470, 1471, 1472, 1473, 1474, 1475,
-	1476, 1477, 1478, 1479, 1480, 1481, 1482, 1483, 1484, 1485,
-	1486, 1487, 1488, 1489, 1490, 1491, 1492, 1493, 1494, 1495,
-	1496, 1497, 1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505,
-	1506, 1507, 1508, 1509, 1510, 1511, 1512, 1513, 1514, 1515,
-	1516, 1517, 1518, 1519, 1520, 1521, 1522, 1523, 1524, 1525,
-	1526, 1527, 1528, 1529, 1530, 1531, 1532, 1533, 1534, 1535,
-	1536, 1537, 1538, 1539, 1540, 1541, 1542, 1543, 1544, 1545,
-	1546, 1547, 1548, 1549, 1550, 1551, 1552, 1553, 1554, 1555,
-	1556, 1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564, 1565,
-	1566, 1567, 1568, 1569, 1570, 1571, 1572, 1573, 1574, 1575,
-	1576, 1577, 1578, 1579, 0, 1109, 0, 571, 799, 0,
-	632, 632, 0, 632, 632, 632, 632, 0, 0, 0,
-	583, 0, 0, 0, 0, 629, 0, 0, 602, 604,
-	0, 629, 0, 635, 0, 616, 632, 1583, 1583, 1583,
-	1100, 0, 626, 624, 638, 639, 621, 622, 640, 644,
-	0, 649, 652, 1126, 1127, 0, 667, 44, 1358, 659,
-	672, 673, 0, 705, 706, 40, 892, 0, 821, 825,
-	836, 849, 850, 851, 852, 853, 855, 856, 0, 0,
-	0, 0, 861, 862, 0, 0, 0, 0, 0, 873,
-	0, 0, 0, 0, 0, 974, 0, 938, 938, 909,
-	938, 940, 940, 0, 0, 0, 1579, 983, 0, 0,
-	0, 0, 0, 0, 248, 249, 891, 1089, 940, 940,
-	940, 940, -2, -2, 195, 196, 197, 198, 199, 200,
-	201, 0, 191, 0, 253, 254, 250, 251, 252, 875,
-	876, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
-	236, 237, 238, 239, 240, 241, 242, 243, 244, 245,
-	246, 247, 1582, 0, 682, 0, 0, 1002, 0, 994,
-	993, 58, 0, 709, -2, 0, 0, 0, 0, 42,
-	0, 47, 760, 72, 71, 1041, 0, 0, 0, 54,
-	1003, 62, 64, 1004, 0, 714, 715, 0, 738, 742,
-	0, 710, 0, 0, 1116, 1115, 1115, 97, 0, 1523,
-	1093, -2, -2, 0, 0, 1128, 1129, 1102, -2, 1136,
-	1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 1146,
-	1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155, 1156,
-	1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165, 1166,
-	1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175, 1176,
-	1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185, 1186,
-	1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195, 1196,
-	1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206,
-	1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216,
-	1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226,
-	1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
-	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
-	1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256,
-	1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266,
-	1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276,
-	-2, 0, 0, 153, 154, 0, 38, 366, 0, 149,
-	0, 360, 310, 1019, 0, 0, 0, 0, 0, 709,
-	0, 1110, 134, 135, 141, 142, 340, 340, 487, 0,
-	0, 143, 143, 454, 455, 456, 0, 0, -2, 364,
-	0, 439, 0, 0, 354, 354, 358, 356, 357, 0,
-	0, 0, 0, 0, 0, 467, 0, 468, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 549, 0, 341,
-	0, 485, 486, 394, 0, 0, 0, 0, 465, 466,
-	0, 0, 1118, 1119, 0, 0, 340, 340, 0, 0,
-	0, 0, 340, 340, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	133, 1032, 0, 0, 0, -2, 0, 563, 0, 0,
-	0, 1111, 1111, 570, 0, 572, 573, 0, 0, 574,
-	0, 629, 629, 627, 628, 576, 577, 578, 579, 632,
-	0, 0, 349, 350, 351, 629, 632, 0, 632, 632,
-	632, 632, 629, 629, 629, 632, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1583, 1583, 1583, 635, 632,
-	0, 612, 0, 613, 614, 617, 618, 1584, 1585, 1147,
-	619, 620, 1101, 642, 650, 653, 670, 668, 669, 671,
-	663, 664, 665, 666, 0, 684, 685, 690, 0, 0,
-	0, 0, 696, 697, 698, 0, 0, 701, 702, 703,
-	0, 0, 0, 0, 819, 0, 0, 881, 882, 883,
-	884, 885, 886, 887, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 857, 858, 859, 860, 863, 0,
-	0, 0, 868, 869, 0, 0, 0, 0, 0, 719,
-	0, 975, 0, 907, 0, 908, 910, 911, 0, 912,
-	0, 0, 0, 0, 722, 0, 0, 722, 722, 0,
-	0, 185, 186, 187, 188, 202, 203, 204, 205, 206,
-	255, 983, 0, 891, 0, 0, 41, 674, 675, 0,
-	782, 1121, 0, 52, 61, 63, 1002, 56, 1002, 0,
-	724, 0, 0, -2, -2, 725, 731, 732, 733, 734,
-	735, 49, 1581, 50, 0, 69, 0, 43, 0, 0,
-	0, 0, 322, 1044, 0, 0, 995, 996, 999, 0,
-	739, 743, 0, 745, 746, 0, 712, 0, 95, 0,
-	798, 0, 0, 0, 1523, 1099, 0, 99, 100, 0,
-	0, 0, 328, 1104, 1105, 1106, -2, 347, 0, 328,
-	317, 261, 262, 263, 310, 265, 310, 310, 310, 310,
-	322, 322, 322, 322, 293, 294, 295, 296, 297, 0,
-	0, 280, 310, 310, 310, 310, 300, 301, 302, 303,
-	304, 305, 306, 307, 266, 267, 268, 269, 270, 271,
-	272, 273, 274, 312, 312, 312, 314, 314, 0, 39,
-	0, 332, 0, 999, 0, 1032, 1113, 1123, 0, 0,
-	0, 1113, 117, 0, 0, 488, 518, 448, 477, 489,
-	0, 451, 452, -2, 0, 0, 438, 0, 440, 0,
-	348, 0, -2, 0, 358, 0, 354, 358, 355, 358,
-	346, 359, 469, 470, 471, 0, 529, 768, 0, 0,
-	0, 0, 0, 535, 536, 537, 0, 539, 540, 541,
-	542, 543, 544, 545, 546, 547, 548, 478, 479, 480,
-	481, 482, 483, 484, 0, 0, 440, 0, 474, 0,
-	395, 396, 0, 0, 399, 400, 401, 402, 0, 0,
-	405, 406, 407, 785, 786, 408, 432, 433, 434, 409,
-	410, 411, 412, 413, 414, 415, 426, 427, 428, 429,
-	430, 431, 416, 417, 418, 419, 420, 423, 0, 127,
-	1023, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1111, 0, 0, 0, 0, 800, 1134, 1135,
-	633, 634, 0, 352, 353, 632, 632, 580, 603, 0,
-	632, 584, 605, 585, 587, 586, 588, 607, 608, 632,
-	591, 630, 631, 592, 593, 594, 595, 596, 597, 598,
-	599, 600, 601, 609, 610, 611, 636, 0, 0, 651,
-	655, 656, 657, 658, 0, 0, 687, 91, 692, 693,
-	694, 695, 707, 700, 893, 816, 817, 818, 820, 822,
-	0, 877, 879, 824, 826, 888, 889, 890, 0, 0,
-	0, 0, 0, 830, 834, 837, 838, 839, 840, 841,
-	842, 843, 844, 845, 846, 847, 848, 854, 953, 954,
-	955, 871, 872, 0, 736, 0, 0, 0, 870, 0,
-	0, 0, 0, 720, 721, 981, 978, 0, 0, 939,
-	941, 0, 0, 0, 0, 917, 918, 919, 920, 921,
-	922, 923, 924, 925, 926, 927, 928, 929, 930, 931,
-	932, 933, 934, 935, 936, 937, 956, 0, 0, 0,
-	723, 984, 0, -2, 0, 0, 0, 0, 0, 0,
-	0, 1088, 0, 677, 678, 680, 0, 802, 0, 783,
-	0, 0, 1122, 683, 55, 57, 729, 730, 0, 747,
-	726, 51, 46, 0, 0, 762, 1042, 322, 1064, 0,
-	326, 327, 1005, 1006, 0, 998, 1000, 1001, 74, 744,
-	740, 0, 814, 0, 0, 797, 0, 750, 752, 753,
-	754, 780, 0, 0, 0, 0, 93, 799, 1094, 98,
-	0, 0, 103, 104, 1095, 1096, 1097, 1098, 0, 507,
-	-2, 390, 155, 157, 158, 159, 150, -2, 320, 318,
-	319, 264, 322, 322, 287, 288, 289, 290, 291, 292,
-	0, 0, 281, 282, 283, 284, 275, 0, 276, 277,
-	278, 0, 279, 365, 0, 1007, 333, 334, 336, 340,
-	0, 0, 0, 361, 362, 0, 1023, 0, 0, 0,
-	1124, 1123, 1123, 1123, 0, 145, 146, 147, 148, 524,
-	0, 0, 519, 522, 523, 143, 0, 0, 151, 442,
-	441, 0, 556, 0, 363, 0, 0, 358, 358, 343,
-	344, 472, 0, 0, 531, 532, 533, 534, 0, 0,
-	0, 440, 442, 328, 0, 397, 398, 403, 404, 421,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 502, 503, 504, 505, 506, 1020, 1021, 1022, 0,
-	0, 564, 0, 0, 382, 89, 1112, 569, 629, 590,
-	606, 629, 582, 589, 615, 647, 691, 0, 0, 0,
-	0, 699, 0, 823, 878, 880, 827, 0, 831, 835,
-	0, 0, 0, 0, 0, 0, 0, 874, 898, 899,
-	0, 0, 993, 0, 979, 0, 0, 906, 942, 0,
-	0, 0, 0, 0, 894, 0, 0, 722, 0, 943,
-	944, 945, 946, 947, 984, 676, 679, 681, 758, 803,
-	804, 0, 0, 0, 0, 784, 1120, 0, 986, 0,
-	0, 747, 814, 748, 0, 727, 48, 761, 0, 1046,
-	1045, 1058, 1071, 323, 324, 325, 997, 0, 1002, 0,
-	1082, 0, 0, 1074, 0, 0, 0, 0, 0, 0,
-	0, 0, 787, 0, 0, 790, 0, 0, 0, 0,
-	781, 0, 0, 0, 0, -2, 0, 0, 87, 88,
-	0, 0, 0, 101, 102, 0, 0, 108, 329, 330,
-	136, 143, 392, 156, 372, 0, 0, 260, 321, 285,
-	286, 0, 308, 0, 0, 0, 385, 113, 1011, 1010,
-	340, 340, 335, 0, 338, 0, 1125, 311, 0, 126,
-	0, 0, 0, 0, 0, 132, 513, 0, 0, 520,
-	0, 0, 0, 446, 0, 457, 458, 0, 528, 555,
-	0, 560, 332, 0, 342, 345, 769, 0, 0, 459,
-	0, 442, 463, 464, 475, 424, 425, 422, 0, 0,
-	1033, 1034, 1039, 1037, 1038, 118, 495, 497, 496, 500,
-	0, 0, 493, 0, 382, 1007, 0, 568, 383, 384,
-	632, 632, 686, 92, 0, 689, 0, 0, 0, 828,
-	832, 737, 0, 0, 310, 310, 961, 310, 314, 964,
-	310, 966, 310, 969, 310, 972, 973, 0, 0, 0,
-	0, 976, 905, 982, 0, 0, 0, 0, 916, 0,
-	0, 895, 896, 0, 985, 805, 810, 810, 810, 0,
-	0, 1107, 1108, 987, 988, 814, 989, 749, 728, 763,
-	1064, 0, 1057, 0, -2, 1066, 0, 0, 0, 1072,
-	741, 75, 815, 78, 0, 1082, 1090, 0, 1073, 1084,
-	1086, 0, 0, 0, 1078, 0, 814, 751, 776, 778,
-	0, 773, 788, 789, 791, 0, 793, 0, 795, 796,
-	760, 756, 757, 0, 95, 0, 814, 814, 94, 0,
-	801, 105, 106, 107, 391, 160, 165, 0, 0, 0,
-	170, 0, 172, 0, 373, 0, 257, 259, 0, 0,
-	163, 322, 0, 322, 0, 315, 0, 374, 386, 0,
-	1008, 1009, 0, 0, 337, 339, 0, 1114, 128, 0,
-	0, 0, 516, 0, 525, 0, 0, 0, 0, 0,
-	0, 152, 443, 0, 0, 331, 0, 0, 553, 550,
-	460, 0, 189, 190, 192, 0, 0, 462, 760, 1024,
-	1025, 1026, 0, 1036, 1040, 121, 0, 0, 0, 0,
-	565, 566, 567, 90, 575, 581, 688, 708, 829, 833,
-	0, 865, 959, 322, 962, 963, 965, 967, 968, 970,
-	971, 866, 867, 0, 901, 0, 903, 1002, 0, 980,
-	0, 0, 915, 0, 0, 897, 0, 0, 0, 0,
-	782, 59, 989, 991, 0, 1051, 1049, 1049, 1059, 1060,
-	0, 0, 1067, 0, 0, 0, 0, 79, 0, 0,
-	0, 1087, 0, 0, 0, 0, 96, 993, 770, 777,
-	0, 0, 771, 0, 772, 792, 794, 755, -2, 814,
-	814, 85, 86, 0, 166, 0, 168, 183, 184, 0,
-	171, 173, 174, 256, 258, 0, 0, 298, 309, 299,
-	0, 0, 387, 1012, 1013, 1014, 1015, 1016, 1017, 1018,
-	760, 129, 130, 131, 508, 0, 518, 0, 0, 0,
-	511, 0, 449, 0, 559, 0, 0, 530, 0, 538,
-	0, 0, 0, 193, 194, 0, 1035, 494, 0, 119,
-	120, 0, 0, 499, 948, 960, 0, 0, 0, 977,
-	0, 0, 0, 0, 0, 811, 0, 0, 0, 0,
-	802, 991, 77, 0, 0, 1054, 0, 1047, 1050, 1048,
-	1061, 0, 0, 1068, 0, 1070, 0, 1091, 1092, 1085,
-	0, 1077, 1080, 1076, 1079, 1002, 774, 0, 779, 993,
-	84, 0, 169, 0, 0, 0, 313, 316, 0, 0,
-	0, 509, 0, 521, 512, 557, 558, 0, 561, 367,
-	554, 551, 552, 461, 0, 122, 123, 0, 0, 0,
-	0, 0, 0, 900, 902, 904, 913, 914, 957, 958,
-	0, 807, 764, 765, 812, 813, 0, 0, 759, 76,
-	992, 990, 1043, 0, 1052, 1053, 1062, 1063, 1069, 0,
-	1075, 0, 82, 0, 1002, 167, 0, 177, 0, 517,
-	0, 520, 510, 0, 1027, 0, 0, 0, 501, 864,
-	0, 0, 0, 0, 806, 808, 809, 1055, 1056, 1083,
-	1081, 775, 83, 368, 372, 1027, 0, 0, 562, 491,
-	1029, 124, 125, 435, 436, 437, 118, 949, 0, 952,
-	766, 767, 369, 370, 0, 161, 178, 179, 0, 0,
-	182, 175, 176, 114, 0, 0, 0, 1030, 1031, 121,
-	950, 371, 0, 0, 0, 180, 181, 526, 0, 0,
-	498, 0, 375, 377, 378, 0, 0, 376, 514, 0,
-	526, 1028, 0, 379, 380, 381, 527, 515, 951,
+	31, 32, 33, 34, 66, 68, 69, 714, 714, 714,
+	0, 714, 0, 0, 714, -2, -2, 714, 1155, 0,
+	714, 0, 0, -2, 645, 647, 0, 649, -2, 0,
+	0, 658, 1610, 1610, 709, 0, 0, 0, 0, 0,
+	714, 0, 1016, 46, 714, 0, 81, 82, 664, 665,
+	666, 61, 0, 1608, 1, 3, 67, 71, 0, 0,
+	0, 54, 1025, 0, 74, 0, 0, 718, 0, 716,
+	0, 1138, 714, 714, 0, 112, 113, 0, 0, 0,
+	-2, 117, -2, 141, 142, 0, 146, 509, 449, 492,
+	447, 478, -2, 440, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 452, 342, 342, 0,
+	0, -2, 440, 440, 440, 0, 0, 0, 475, 1140,
+	395, 342, 342, 0, 342, 342, 342, 342, 0, 0,
+	342, 342, 342, 342, 342, 342, 342, 342, 342, 342,
+	342, 342, 342, 342, 342, 1042, 145, 1156, 1153, 1154,
+	36, 37, 38, 1300, 1301, 1302, 1303, 1304, 1305, 1306,
+	1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316,
+	1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326,
+	1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336,
+	1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346,
+	1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356,
+	1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366,
+	1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376,
+	1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386,
+	1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395, 1396,
+	1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405, 1406,
+	1407, 1408, 1409, 1410, 1411, 1412, 1413, 1414, 1415, 1416,
+	1417, 1418, 1419, 1420, 1421, 1422, 1423, 1424, 1425, 1426,
+	1427, 1428, 1429, 1430, 1431, 1432, 1433, 1434, 1435, 1436,
+	1437, 1438, 1439, 1440, 1441, 1442, 1443, 1444, 1445, 1446,
+	1447, 1448, 1449, 1450, 1451, 1452, 1453, 1454, 1455, 1456,
+	1457, 1458, 1459, 1460, 1461, 1462, 1463, 1464, 1465, 1466,
+	1467, 1468, 1469, 1470, 1471, 1472, 1473, 1474, 1475, 1476,
+	1477, 1478, 1479, 1480, 1481, 1482, 1483, 1484, 1485, 1486,
+	1487, 1488, 1489, 1490, 1491, 1492, 1493, 1494, 1495, 1496,
+	1497, 1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505, 1506,
+	1507, 1508, 1509, 1510, 1511, 1512, 1513, 1514, 1515, 1516,
+	1517, 1518, 1519, 1520, 1521, 1522, 1523, 1524, 1525, 1526,
+	1527, 1528, 1529, 1530, 1531, 1532, 1533, 1534, 1535, 1536,
+	1537, 1538, 1539, 1540, 1541, 1542, 1543, 1544, 1545, 1546,
+	1547, 1548, 1549, 1550, 1551, 1552, 1553, 1554, 1555, 1556,
+	1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564, 1565, 1566,
+	1567, 1568, 1569, 1570, 1571, 1572, 1573, 1574, 1575, 1576,
+	1577, 1578, 1579, 1580, 1581, 1582, 1583, 1584, 1585, 1586,
+	1587, 1588, 1589, 1590, 1591, 1592, 1593, 1594, 1595, 1596,
+	1597, 1598, 1599, 1600, 1601, 1602, 1603, 1604, 1605, 1606,
+	1607, 0, 1132, 0, 574, 804, 0, 636, 636, 0,
+	636, 636, 636, 636, 0, 0, 0, 586, 0, 0,
+	0, 0, 633, 0, 0, 605, 607, 0, 633, 0,
+	0, 639, 0, 620, 636, 1611, 1611, 1611, 1123, 0,
+	630, 628, 642, 643, 625, 626, 644, 648, 0, 653,
+	656, 1149, 1150, 0, 671, 45, 1382, 663, 676, 677,
+	0, 710, 711, 41, 897, 0, 826, 830, 841, 854,
+	855, 856, 857, 858, 860, 861, 0, 0, 0, 0,
+	866, 867, 0, 0, 0, 0, 0, 878, 0, 0,
+	0, 0, 0, 997, 0, 961, 961, 932, 961, 963,
+	963, 0, 0, 0, 1607, 1006, 0, 0, 0, 0,
+	0, 0, 250, 251, 896, 1112, 963, 963, 963, 963,
+	-2, -2, 197, 198, 199, 200, 201, 202, 203, 0,
+	193, 0, 255, 256, 252, 253, 254, 880, 881, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 239,
+	240, 241, 242, 243, 244, 245, 246, 247, 248, 249,
+	1610, 0, 686, 0, 0, 0, 1025, 0, 1017, 1016,
+	59, 0, 714, -2, 0, 0, 0, 0, 43, 0,
+	48, 765, 73, 72, 1064, 0, 0, 0, 55, 1026,
+	63, 65, 1027, 0, 719, 720, 0, 743, 747, 0,
+	715, 0, 0, 1139, 1138, 1138, 98, 0, 1551, 1116,
+	-2, -2, 0, 0, 1151, 1152, 1125, -2, 1159, 1160,
+	1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170,
+	1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180,
+	1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190,
+	1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200,
+	1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210,
+	1211, 1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220,
+	1221, 1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230,
+	1231, 1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240,
+	1241, 1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250,
+	1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260,
+	1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270,
+	1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280,
+	1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290,
+	1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, -2,
+	0, 0, 155, 156, 0, 39, 368, 0, 151, 0,
+	362, 312, 1042, 0, 0, 0, 0, 0, 0, 714,
+	0, 1133, 136, 137, 143, 144, 342, 342, 489, 0,
+	0, 145, 145, 456, 457, 458, 0, 0, -2, 366,
+	0, 441, 0, 0, 356, 356, 360, 358, 359, 0,
+	0, 0, 0, 0, 0, 469, 0, 470, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 551, 0, 343,
+	0, 487, 488, 396, 0, 0, 0, 0, 467, 468,
+	0, 0, 1141, 1142, 0, 0, 342, 342, 0, 0,
+	0, 0, 342, 342, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	135, 1055, 0, 0, 0, -2, 0, 565, 0, 0,
+	0, 1134, 0, 1134, 573, 0, 575, 576, 0, 0,
+	577, 0, 633, 633, 631, 632, 579, 580, 581, 582,
+	636, 0, 0, 351, 352, 353, 633, 636, 0, 636,
+	636, 636, 636, 633, 633, 633, 636, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1611, 1611, 1611, 639,
+	636, 633, 0, 616, 0, 617, 618, 621, 622, 1612,
+	1613, 1170, 623, 624, 1124, 646, 654, 657, 674, 672,
+	673, 675, 667, 668, 669, 670, 0, 689, 690, 695,
+	0, 0, 0, 0, 701, 702, 703, 0, 0, 706,
+	707, 708, 0, 0, 0, 0, 824, 0, 0, 886,
+	887, 888, 889, 890, 891, 892, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 862, 863, 864, 865,
+	868, 0, 0, 0, 873, 874, 0, 0, 0, 0,
+	0, 724, 0, 998, 0, 930, 0, 931, 933, 934,
+	0, 935, 0, 0, 0, 0, 727, 0, 0, 727,
+	727, 0, 0, 187, 188, 189, 190, 204, 205, 206,
+	207, 208, 257, 1006, 0, 896, 0, 0, 42, 678,
+	679, 0, 787, 1144, 0, 0, 53, 62, 64, 1025,
+	57, 1025, 0, 729, 0, 0, -2, -2, 730, 736,
+	737, 738, 739, 740, 50, 1609, 51, 0, 70, 0,
+	44, 0, 0, 0, 0, 324, 1067, 0, 0, 1018,
+	1019, 1022, 0, 744, 748, 0, 750, 751, 0, 717,
+	0, 96, 0, 803, 0, 0, 0, 1551, 1122, 0,
+	100, 101, 0, 0, 0, 330, 1127, 1128, 1129, -2,
+	349, 0, 330, 319, 263, 264, 265, 312, 267, 312,
+	312, 312, 312, 324, 324, 324, 324, 295, 296, 297,
+	298, 299, 0, 0, 282, 312, 312, 312, 312, 302,
+	303, 304, 305, 306, 307, 308, 309, 268, 269, 270,
+	271, 272, 273, 274, 275, 276, 314, 314, 314, 316,
+	316, 0, 40, 0, 334, 0, 1022, 0, 1055, 0,
+	1136, 1146, 0, 0, 0, 1136, 119, 0, 0, 490,
+	520, 450, 479, 491, 0, 453, 454, -2, 0, 0,
+	440, 0, 442, 0, 350, 0, -2, 0, 360, 0,
+	356, 360, 357, 360, 348, 361, 471, 472, 473, 0,
+	531, 773, 0, 0, 0, 0, 0, 537, 538, 539,
+	0, 541, 542, 543, 544, 545, 546, 547, 548, 549,
+	550, 480, 481, 482, 483, 484, 485, 486, 0, 0,
+	442, 0, 476, 0, 397, 398, 0, 0, 401, 402,
+	403, 404, 0, 0, 407, 408, 409, 790, 791, 410,
+	434, 435, 436, 411, 412, 413, 414, 415, 416, 417,
+	428, 429, 430, 431, 432, 433, 418, 419, 420, 421,
+	422, 425, 0, 129, 1046, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1134, 0, 0, 0,
+	1134, 0, 805, 1157, 1158, 637, 638, 0, 354, 355,
+	636, 636, 583, 606, 0, 636, 587, 608, 588, 590,
+	589, 591, 610, 611, 636, 594, 634, 635, 595, 596,
+	597, 598, 599, 600, 601, 602, 603, 604, 612, 613,
+	636, 615, 640, 0, 0, 655, 659, 660, 661, 662,
+	0, 0, 692, 92, 697, 698, 699, 700, 712, 705,
+	898, 821, 822, 823, 825, 827, 0, 882, 884, 829,
+	831, 893, 894, 895, 0, 0, 0, 0, 0, 835,
+	839, 842, 843, 844, 845, 846, 847, 848, 849, 850,
+	851, 852, 853, 859, 976, 977, 978, 876, 877, 0,
+	741, 0, 0, 0, 875, 0, 0, 0, 0, 725,
+	726, 1004, 1001, 0, 0, 962, 964, 0, 0, 0,
+	0, 940, 941, 942, 943, 944, 945, 946, 947, 948,
+	949, 950, 951, 952, 953, 954, 955, 956, 957, 958,
+	959, 960, 979, 0, 0, 0, 728, 1007, 0, -2,
+	0, 0, 0, 0, 0, 0, 0, 1111, 0, 681,
+	682, 684, 0, 807, 0, 788, 0, 0, 1145, 687,
+	688, 56, 58, 734, 735, 0, 752, 731, 52, 47,
+	0, 0, 767, 1065, 324, 1087, 0, 328, 329, 1028,
+	1029, 0, 1021, 1023, 1024, 75, 749, 745, 0, 819,
+	0, 0, 802, 0, 755, 757, 758, 759, 785, 0,
+	0, 0, 0, 94, 804, 1117, 99, 0, 0, 104,
+	105, 1118, 1119, 1120, 1121, 0, 509, -2, 392, 157,
+	159, 160, 161, 152, -2, 322, 320, 321, 266, 324,
+	324, 289, 290, 291, 292, 293, 294, 0, 0, 283,
+	284, 285, 286, 277, 0, 278, 279, 280, 0, 281,
+	367, 0, 1030, 335, 336, 338, 342, 0, 0, 0,
+	363, 364, 0, 1046, 0, 0, 0, 0, 1147, 1146,
+	1146, 1146, 0, 147, 148, 149, 150, 526, 0, 0,
+	521, 524, 525, 145, 0, 0, 153, 444, 443, 0,
+	558, 0, 365, 0, 0, 360, 360, 345, 346, 474,
+	0, 0, 533, 534, 535, 536, 0, 0, 0, 442,
+	444, 330, 0, 399, 400, 405, 406, 423, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 504,
+	505, 506, 507, 508, 1043, 1044, 1045, 0, 0, 566,
+	0, 0, 384, 90, 1135, 0, 572, 633, 593, 609,
+	633, 585, 592, 614, 619, 651, 696, 0, 0, 0,
+	0, 704, 0, 828, 883, 885, 832, 0, 836, 840,
+	0, 0, 0, 0, 0, 0, 0, 879, 921, 922,
+	0, 0, 1016, 0, 1002, 0, 0, 929, 965, 0,
+	0, 0, 0, 0, 903, 0, 0, 727, 0, 966,
+	967, 968, 969, 970, 1007, 680, 683, 685, 763, 808,
+	809, 0, 0, 0, 0, 789, 1143, 0, 1009, 0,
+	0, 752, 819, 753, 0, 732, 49, 766, 0, 1069,
+	1068, 1081, 1094, 325, 326, 327, 1020, 0, 1025, 0,
+	1105, 0, 0, 1097, 0, 0, 0, 0, 0, 0,
+	0, 0, 792, 0, 0, 795, 0, 0, 0, 0,
+	786, 0, 0, 0, 0, -2, 0, 0, 88, 89,
+	0, 0, 0, 102, 103, 0, 0, 109, 331, 332,
+	138, 145, 394, 158, 374, 0, 0, 262, 323, 287,
+	288, 0, 310, 0, 0, 0, 387, 114, 1034, 1033,
+	342, 342, 337, 0, 340, 0, 1148, 313, 0, 0,
+	128, 0, 0, 0, 0, 0, 134, 515, 0, 0,
+	522, 0, 0, 0, 448, 0, 459, 460, 0, 530,
+	557, 0, 562, 334, 0, 344, 347, 774, 0, 0,
+	461, 0, 444, 465, 466, 477, 426, 427, 424, 0,
+	0, 1056, 1057, 1062, 1060, 1061, 120, 497, 499, 498,
+	502, 0, 0, 495, 0, 384, 1030, 0, 570, 385,
+	386, 571, 636, 636, 691, 93, 0, 694, 0, 0,
+	0, 833, 837, 742, 0, 0, 312, 312, 984, 312,
+	316, 987, 312, 989, 312, 992, 312, 995, 996, 0,
+	0, 0, 0, 999, 928, 1005, 0, 0, 0, 0,
+	939, 0, 0, 899, 904, 0, 900, 901, 0, 1008,
+	810, 815, 815, 815, 0, 0, 1130, 1131, 1010, 1011,
+	819, 1012, 754, 733, 768, 1087, 0, 1080, 0, -2,
+	1089, 0, 0, 0, 1095, 746, 76, 820, 79, 0,
+	1105, 1113, 0, 1096, 1107, 1109, 0, 0, 0, 1101,
+	0, 819, 756, 781, 783, 0, 778, 793, 794, 796,
+	0, 798, 0, 800, 801, 765, 761, 762, 0, 96,
+	0, 819, 819, 95, 0, 806, 106, 107, 108, 393,
+	162, 167, 0, 0, 0, 172, 0, 174, 0, 375,
+	0, 259, 261, 0, 0, 165, 324, 0, 324, 0,
+	317, 0, 376, 388, 0, 1031, 1032, 0, 0, 339,
+	341, 0, 116, 1137, 130, 0, 0, 0, 518, 0,
+	527, 0, 0, 0, 0, 0, 0, 154, 445, 0,
+	0, 333, 0, 0, 555, 552, 462, 0, 191, 192,
+	194, 0, 0, 464, 765, 1047, 1048, 1049, 0, 1059,
+	1063, 123, 0, 0, 0, 0, 567, 568, 569, 91,
+	578, 584, 693, 713, 834, 838, 0, 870, 982, 324,
+	985, 986, 988, 990, 991, 993, 994, 871, 872, 0,
+	924, 0, 926, 1025, 0, 1003, 0, 0, 938, 0,
+	0, 906, 902, 0, 0, 0, 0, 787, 60, 1012,
+	1014, 0, 1074, 1072, 1072, 1082, 1083, 0, 0, 1090,
+	0, 0, 0, 0, 80, 0, 0, 0, 1110, 0,
+	0, 0, 0, 97, 1016, 775, 782, 0, 0, 776,
+	0, 777, 797, 799, 760, -2, 819, 819, 86, 87,
+	0, 168, 0, 170, 185, 186, 0, 173, 175, 176,
+	258, 260, 0, 0, 300, 311, 301, 0, 0, 389,
+	1035, 1036, 1037, 1038, 1039, 1040, 1041, 765, 131, 132,
+	133, 510, 0, 520, 0, 0, 0, 513, 0, 451,
+	0, 561, 0, 0, 532, 0, 540, 0, 0, 0,
+	195, 196, 0, 1058, 496, 0, 121, 122, 0, 0,
+	501, 971, 983, 0, 0, 0, 1000, 0, 0, 0,
+	0, 1016, 0, 0, 816, 0, 0, 0, 0, 807,
+	1014, 78, 0, 0, 1077, 0, 1070, 1073, 1071, 1084,
+	0, 0, 1091, 0, 1093, 0, 1114, 1115, 1108, 0,
+	1100, 1103, 1099, 1102, 1025, 779, 0, 784, 1016, 85,
+	0, 171, 0, 0, 0, 315, 318, 0, 0, 0,
+	511, 0, 523, 514, 559, 560, 0, 563, 369, 556,
+	553, 554, 463, 0, 124, 125, 0, 0, 0, 0,
+	0, 0, 923, 925, 927, 936, 937, 980, 981, 908,
+	0, 0, 812, 769, 770, 817, 818, 0, 0, 764,
+	77, 1015, 1013, 1066, 0, 1075, 1076, 1085, 1086, 1092,
+	0, 1098, 0, 83, 0, 1025, 169, 0, 179, 0,
+	519, 0, 522, 512, 0, 1050, 0, 0, 0, 503,
+	869, 0, 0, 0, 0, 0, 911, 912, 907, 0,
+	811, 813, 814, 1078, 1079, 1106, 1104, 780, 84, 370,
+	374, 1050, 0, 0, 564, 493, 1052, 126, 127, 437,
+	438, 439, 120, 972, 0, 975, 905, 909, 0, 0,
+	0, 0, 918, 919, 0, 771, 772, 371, 372, 0,
+	163, 180, 181, 0, 0, 184, 177, 178, 115, 0,
+	0, 0, 1053, 1054, 123, 973, 0, 913, 914, 915,
+	916, 917, 0, 373, 0, 0, 0, 182, 183, 528,
+	0, 0, 500, 0, 0, 920, 377, 379, 380, 0,
+	0, 378, 516, 0, 528, 1051, 0, 910, 381, 382,
+	383, 529, 517, 974,
 }
 
 var yyTok1 = [...]int{
@@ -6316,7 +6419,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 125, 3, 3, 3, 151, 143, 3,
 	83, 85, 148, 146, 84, 147, 200, 149, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 555,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 560,
 	133, 132, 134, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -6412,6 +6515,7 @@ var yyTok3 = [...]int{
 	57865, 540, 57866, 541, 57867, 542, 57868, 543, 57869, 544,
 	57870, 545, 57871, 546, 57872, 547, 57873, 548, 57874, 549,
 	57875, 550, 57876, 551, 57877, 552, 57878, 553, 57879, 554,
+	57880, 555, 57881, 556, 57882, 557, 57883, 558, 57884, 559,
 	0,
 }
 
@@ -6762,193 +6866,193 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:473
+//line sql.y:489
 		{
 			setParseTree(yylex, yyDollar[1].statementUnion())
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:478
+//line sql.y:494
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:479
+//line sql.y:495
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:483
+//line sql.y:499
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:516
+//line sql.y:533
 		{
 			setParseTree(yylex, nil)
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:522
+//line sql.y:539
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), NoAt)
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:526
+//line sql.y:543
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), SingleAt)
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:530
+//line sql.y:547
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), DoubleAt)
 		}
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:535
+//line sql.y:552
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:539
+//line sql.y:556
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:545
+//line sql.y:562
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:551
+//line sql.y:568
 		{
 			yyLOCAL = &Load{}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *With
-//line sql.y:557
+//line sql.y:574
 		{
-			yyLOCAL = &With{ctes: yyDollar[2].ctesUnion(), Recursive: false}
+			yyLOCAL = &With{CTEs: yyDollar[2].ctesUnion(), Recursive: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *With
-//line sql.y:561
+//line sql.y:578
 		{
-			yyLOCAL = &With{ctes: yyDollar[3].ctesUnion(), Recursive: true}
+			yyLOCAL = &With{CTEs: yyDollar[3].ctesUnion(), Recursive: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *With
-//line sql.y:566
+//line sql.y:583
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *With
-//line sql.y:570
+//line sql.y:587
 		{
 			yyLOCAL = yyDollar[1].withUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:576
+//line sql.y:593
 		{
 			yySLICE := (*[]*CommonTableExpr)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].cteUnion())
 		}
-	case 47:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*CommonTableExpr
-//line sql.y:580
+//line sql.y:597
 		{
 			yyLOCAL = []*CommonTableExpr{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 48:
+	case 49:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *CommonTableExpr
-//line sql.y:586
+//line sql.y:603
 		{
 			yyLOCAL = &CommonTableExpr{TableID: yyDollar[1].tableIdent, Columns: yyDollar[2].columnsUnion(), Subquery: yyDollar[4].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 49:
+	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:592
+//line sql.y:609
 		{
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 50:
+	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:596
+//line sql.y:613
 		{
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 52:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:600
+//line sql.y:617
 		{
 			setLockInSelect(yyDollar[2].selStmtUnion(), yyDollar[3].lockUnion())
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:623
+//line sql.y:640
 		{
 			yyDollar[1].selStmtUnion().SetOrderBy(yyDollar[2].orderByUnion())
 			yyDollar[1].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 54:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:629
+//line sql.y:646
 		{
 			yyDollar[1].selStmtUnion().SetLimit(yyDollar[2].limitUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:634
+//line sql.y:651
 		{
 			yyDollar[1].selStmtUnion().SetOrderBy(yyDollar[2].orderByUnion())
 			yyDollar[1].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 56:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:640
+//line sql.y:657
 		{
 			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 			yyDollar[2].selStmtUnion().SetOrderBy(yyDollar[3].orderByUnion())
@@ -6956,20 +7060,20 @@ yydefault:
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 57:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:647
+//line sql.y:664
 		{
 			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 			yyDollar[2].selStmtUnion().SetLimit(yyDollar[3].limitUnion())
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 58:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:653
+//line sql.y:670
 		{
 			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 			yyDollar[2].selStmtUnion().SetOrderBy(yyDollar[3].orderByUnion())
@@ -6977,175 +7081,175 @@ yydefault:
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 59:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:660
+//line sql.y:677
 		{
 			yyDollar[2].selStmtUnion().SetWith(yyDollar[1].withUnion())
 		}
-	case 59:
+	case 60:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:664
+//line sql.y:681
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, nil, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:670
+//line sql.y:687
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:674
+//line sql.y:691
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:678
+//line sql.y:695
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:682
+//line sql.y:699
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:686
+//line sql.y:703
 		{
 			yyLOCAL = &Union{Left: yyDollar[1].selStmtUnion(), Distinct: yyDollar[2].booleanUnion(), Right: yyDollar[3].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:692
+//line sql.y:709
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:696
+//line sql.y:713
 		{
 			setLockInSelect(yyDollar[1].selStmtUnion(), yyDollar[2].lockUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:701
+//line sql.y:718
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:705
+//line sql.y:722
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 70:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:711
+//line sql.y:728
 		{
 			yyLOCAL = yyDollar[2].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 71:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:715
+//line sql.y:732
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:720
+//line sql.y:737
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
 			yyDollar[1].selStmtUnion().SetLock(yyDollar[3].lockUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:726
+//line sql.y:743
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[3].selectIntoUnion())
 			yyDollar[1].selStmtUnion().SetLock(yyDollar[2].lockUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 74:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:732
+//line sql.y:749
 		{
 			yyDollar[1].selStmtUnion().SetInto(yyDollar[2].selectIntoUnion())
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 75:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:739
+//line sql.y:756
 		{
 			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 76:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:745
+//line sql.y:762
 		{
 			yyLOCAL = &VStream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].exprUnion()), Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 77:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:753
+//line sql.y:770
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].selectIntoUnion() /*into*/, yyDollar[6].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[7].exprUnion()), GroupBy(yyDollar[8].exprsUnion()), NewWhere(HavingClause, yyDollar[9].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 78:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:757
+//line sql.y:774
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, nil, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 79:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:765
+//line sql.y:782
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].insUnion()
@@ -7158,10 +7262,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 80:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:777
+//line sql.y:794
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
@@ -7172,258 +7276,258 @@ yydefault:
 			yyLOCAL = &Insert{Action: yyDollar[1].insertActionUnion(), Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitionsUnion(), Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprsUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 81:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:789
+//line sql.y:806
 		{
 			yyLOCAL = InsertAct
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:793
+//line sql.y:810
 		{
 			yyLOCAL = ReplaceAct
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 83:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:799
+//line sql.y:816
 		{
 			yyLOCAL = &Update{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), TableExprs: yyDollar[5].tableExprsUnion(), Exprs: yyDollar[7].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion()), OrderBy: yyDollar[9].orderByUnion(), Limit: yyDollar[10].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 84:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:805
+//line sql.y:822
 		{
 			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[6].tableName, As: yyDollar[7].tableIdent}}, Partitions: yyDollar[8].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[9].exprUnion()), OrderBy: yyDollar[10].orderByUnion(), Limit: yyDollar[11].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 85:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:809
+//line sql.y:826
 		{
 			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[6].tableNamesUnion(), TableExprs: yyDollar[8].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[9].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 86:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:813
+//line sql.y:830
 		{
 			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 87:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:817
+//line sql.y:834
 		{
 			yyLOCAL = &Delete{With: yyDollar[1].withUnion(), Comments: Comments(yyDollar[3].strs), Ignore: yyDollar[4].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:822
+//line sql.y:839
 		{
 		}
-	case 88:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:823
+//line sql.y:840
 		{
 		}
-	case 89:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:827
+//line sql.y:844
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:831
+//line sql.y:848
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
 		}
-	case 91:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:837
+//line sql.y:854
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:841
+//line sql.y:858
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-	case 93:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:847
+//line sql.y:864
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:851
+//line sql.y:868
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-	case 95:
+	case 96:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:856
+//line sql.y:873
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 97:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:860
+//line sql.y:877
 		{
 			yyLOCAL = yyDollar[3].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:866
+//line sql.y:883
 		{
 			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 99:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:872
+//line sql.y:889
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 100:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:876
+//line sql.y:893
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []Characteristic
-//line sql.y:882
+//line sql.y:899
 		{
 			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:886
+//line sql.y:903
 		{
 			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
 		}
-	case 102:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:892
+//line sql.y:909
 		{
 			yyLOCAL = yyDollar[3].isolationLevelUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 104:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:896
+//line sql.y:913
 		{
 			yyLOCAL = ReadWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 105:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:900
+//line sql.y:917
 		{
 			yyLOCAL = ReadOnly
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 106:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:906
+//line sql.y:923
 		{
 			yyLOCAL = RepeatableRead
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:910
+//line sql.y:927
 		{
 			yyLOCAL = ReadCommitted
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 108:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:914
+//line sql.y:931
 		{
 			yyLOCAL = ReadUncommitted
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:918
+//line sql.y:935
 		{
 			yyLOCAL = Serializable
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:924
+//line sql.y:941
 		{
 			yyLOCAL = SessionScope
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:928
+//line sql.y:945
 		{
 			yyLOCAL = GlobalScope
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:934
+//line sql.y:951
 		{
 			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
 			yyDollar[1].createTableUnion().FullyParsed = true
 			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:940
+//line sql.y:957
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
@@ -7431,10 +7535,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 114:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:947
+//line sql.y:964
 		{
 			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumnsUnion()
@@ -7444,349 +7548,357 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 115:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:956
+//line sql.y:973
 		{
 			yyLOCAL = &CreateView{ViewName: yyDollar[8].tableName.ToViewName(), Comments: Comments(yyDollar[2].strs), IsReplace: yyDollar[3].booleanUnion(), Algorithm: yyDollar[4].str, Definer: yyDollar[5].definerUnion(), Security: yyDollar[6].str, Columns: yyDollar[9].columnsUnion(), Select: yyDollar[11].selStmtUnion(), CheckOption: yyDollar[12].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 116:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:977
+		{
+			yyLOCAL = &MaterializedViewDDL{Action: CreateDDLAction, ViewName: yyDollar[5].tableName.ToViewName(), Select: yyDollar[7].selStmtUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:960
+//line sql.y:981
 		{
 			yyDollar[1].createDatabaseUnion().FullyParsed = true
 			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
 			yyLOCAL = yyDollar[1].createDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 118:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:967
+//line sql.y:988
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:971
+//line sql.y:992
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 120:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:976
+//line sql.y:997
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 119:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:980
+//line sql.y:1001
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 120:
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:986
+//line sql.y:1007
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 121:
+	case 123:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:991
+//line sql.y:1012
 		{
 			var v []VindexParam
 			yyLOCAL = v
 		}
 		yyVAL.union = yyLOCAL
-	case 122:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:996
+//line sql.y:1017
 		{
 			yyLOCAL = yyDollar[2].vindexParamsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:1002
+//line sql.y:1023
 		{
 			yyLOCAL = make([]VindexParam, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1007
+//line sql.y:1028
 		{
 			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
 		}
-	case 125:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1013
+//line sql.y:1034
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 126:
+	case 128:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateTable
-//line sql.y:1019
+//line sql.y:1040
 		{
 			yyLOCAL = &CreateTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[6].tableName, IfNotExists: yyDollar[5].booleanUnion(), Temp: yyDollar[3].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 129:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1026
+//line sql.y:1047
 		{
 			yyLOCAL = &AlterTable{Comments: Comments(yyDollar[2].strs), Table: yyDollar[4].tableName}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 130:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1033
+//line sql.y:1054
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[3].str)}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 131:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1038
+//line sql.y:1059
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Fulltext: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 132:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1043
+//line sql.y:1064
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Spatial: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 133:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:1048
+//line sql.y:1069
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[8].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[5].colIdent, Type: string(yyDollar[3].str) + " " + string(yyDollar[4].str), Unique: true}, Options: yyDollar[6].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 134:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *CreateDatabase
-//line sql.y:1055
+//line sql.y:1076
 		{
 			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[4].strs), DBName: yyDollar[6].tableIdent, IfNotExists: yyDollar[5].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AlterDatabase
-//line sql.y:1062
+//line sql.y:1083
 		{
 			yyLOCAL = &AlterDatabase{}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 138:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1073
+//line sql.y:1094
 		{
 			yyLOCAL = yyDollar[2].tableSpecUnion()
 			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
 			yyLOCAL.PartitionOption = yyDollar[5].partitionOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 139:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:1080
+//line sql.y:1101
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 138:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:1084
+//line sql.y:1105
 		{
 			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:1090
+//line sql.y:1111
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:1094
+//line sql.y:1115
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1098
+//line sql.y:1119
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-	case 142:
+	case 144:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1102
+//line sql.y:1123
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-	case 143:
+	case 145:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1108
+//line sql.y:1129
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1112
+//line sql.y:1133
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1118
+//line sql.y:1139
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 146:
+	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1122
+//line sql.y:1143
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 147:
+	case 149:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1128
+//line sql.y:1149
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 148:
+	case 150:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1132
+//line sql.y:1153
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 149:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:1139
+//line sql.y:1160
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 152:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:1143
+//line sql.y:1164
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*ColumnDefinition
-//line sql.y:1149
+//line sql.y:1170
 		{
 			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 152:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1153
+//line sql.y:1174
 		{
 			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
 		}
-	case 153:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1159
+//line sql.y:1180
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:1164
+//line sql.y:1185
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1169
+//line sql.y:1190
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 		}
-	case 156:
+	case 158:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1173
+//line sql.y:1194
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
 		}
-	case 157:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1178
+//line sql.y:1199
 		{
 			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
 		}
-	case 158:
+	case 160:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1182
+//line sql.y:1203
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-	case 159:
+	case 161:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1186
+//line sql.y:1207
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-	case 160:
+	case 162:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1197
+//line sql.y:1218
 		{
 			yyDollar[2].columnType.Options = yyDollar[4].columnTypeOptionsUnion()
 			if yyDollar[2].columnType.Options.Collate == "" {
@@ -7796,10 +7908,10 @@ yydefault:
 			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 		yyVAL.union = yyLOCAL
-	case 161:
+	case 163:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1206
+//line sql.y:1227
 		{
 			yyDollar[2].columnType.Options = yyDollar[9].columnTypeOptionsUnion()
 			yyDollar[2].columnType.Options.As = yyDollar[7].exprUnion()
@@ -7808,768 +7920,752 @@ yydefault:
 			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 		yyVAL.union = yyLOCAL
-	case 162:
+	case 164:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1215
+//line sql.y:1236
 		{
 			yyVAL.str = ""
 		}
-	case 163:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1219
+//line sql.y:1240
 		{
 			yyVAL.str = ""
 		}
-	case 164:
+	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1228
+//line sql.y:1249
 		{
 			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil, As: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1232
+//line sql.y:1253
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1238
+//line sql.y:1259
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 169:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1244
+//line sql.y:1265
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 170:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1249
+//line sql.y:1270
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 171:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1254
+//line sql.y:1275
 		{
 			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1259
+//line sql.y:1280
 		{
 			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1264
+//line sql.y:1285
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1269
+//line sql.y:1290
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 175:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1274
+//line sql.y:1295
 		{
 			yyDollar[1].columnTypeOptionsUnion().Collate = encodeSQLString(yyDollar[3].str)
 		}
-	case 174:
+	case 176:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1278
+//line sql.y:1299
 		{
 			yyDollar[1].columnTypeOptionsUnion().Collate = string(yyDollar[3].colIdent.String())
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1285
+//line sql.y:1306
 		{
 			yyLOCAL = VirtualStorage
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnStorage
-//line sql.y:1289
+//line sql.y:1310
 		{
 			yyLOCAL = StoredStorage
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 179:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1294
+//line sql.y:1315
 		{
 			yyLOCAL = &ColumnTypeOptions{}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1298
+//line sql.y:1319
 		{
 			yyDollar[1].columnTypeOptionsUnion().Storage = yyDollar[2].columnStorageUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1303
+//line sql.y:1324
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 182:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1309
+//line sql.y:1330
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 183:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1315
+//line sql.y:1336
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1320
+//line sql.y:1341
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = yyDollar[2].colKeyOptUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1327
+//line sql.y:1348
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 185:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1334
+//line sql.y:1355
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 186:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1338
+//line sql.y:1359
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 187:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1342
+//line sql.y:1363
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1346
+//line sql.y:1367
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1357
+//line sql.y:1378
 		{
 			yyLOCAL = &NullVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1364
+//line sql.y:1385
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1368
+//line sql.y:1389
 		{
 			yyLOCAL = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1374
+//line sql.y:1395
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1378
+//line sql.y:1399
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1382
+//line sql.y:1403
 		{
 			yyLOCAL = yyDollar[1].boolValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1386
+//line sql.y:1407
 		{
 			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1390
+//line sql.y:1411
 		{
 			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 200:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1394
+//line sql.y:1415
 		{
 			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1398
+//line sql.y:1419
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1403
+//line sql.y:1424
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewBitLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1407
+//line sql.y:1428
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewHexNumLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1411
+//line sql.y:1432
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewHexLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1415
+//line sql.y:1436
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: yyDollar[2].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 206:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1419
+//line sql.y:1440
 		{
 			bindVariable(yylex, yyDollar[2].str[1:])
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewArgument(yyDollar[2].str[1:])}
 		}
 		yyVAL.union = yyLOCAL
-	case 207:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1426
+//line sql.y:1447
 		{
 			yyVAL.str = Armscii8Str
 		}
-	case 208:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1430
+//line sql.y:1451
 		{
 			yyVAL.str = ASCIIStr
 		}
-	case 209:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1434
+//line sql.y:1455
 		{
 			yyVAL.str = Big5Str
 		}
-	case 210:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1438
+//line sql.y:1459
 		{
 			yyVAL.str = UBinaryStr
 		}
-	case 211:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1442
+//line sql.y:1463
 		{
 			yyVAL.str = Cp1250Str
 		}
-	case 212:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1446
+//line sql.y:1467
 		{
 			yyVAL.str = Cp1251Str
 		}
-	case 213:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1450
+//line sql.y:1471
 		{
 			yyVAL.str = Cp1256Str
 		}
-	case 214:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1454
+//line sql.y:1475
 		{
 			yyVAL.str = Cp1257Str
 		}
-	case 215:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1458
+//line sql.y:1479
 		{
 			yyVAL.str = Cp850Str
 		}
-	case 216:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1462
+//line sql.y:1483
 		{
 			yyVAL.str = Cp852Str
 		}
-	case 217:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1466
+//line sql.y:1487
 		{
 			yyVAL.str = Cp866Str
 		}
-	case 218:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1470
+//line sql.y:1491
 		{
 			yyVAL.str = Cp932Str
 		}
-	case 219:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1474
+//line sql.y:1495
 		{
 			yyVAL.str = Dec8Str
 		}
-	case 220:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1478
+//line sql.y:1499
 		{
 			yyVAL.str = EucjpmsStr
 		}
-	case 221:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1482
+//line sql.y:1503
 		{
 			yyVAL.str = EuckrStr
 		}
-	case 222:
+	case 224:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1486
+//line sql.y:1507
 		{
 			yyVAL.str = Gb18030Str
 		}
-	case 223:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1490
+//line sql.y:1511
 		{
 			yyVAL.str = Gb2312Str
 		}
-	case 224:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1494
+//line sql.y:1515
 		{
 			yyVAL.str = GbkStr
 		}
-	case 225:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1498
+//line sql.y:1519
 		{
 			yyVAL.str = Geostd8Str
 		}
-	case 226:
+	case 228:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1502
+//line sql.y:1523
 		{
 			yyVAL.str = GreekStr
 		}
-	case 227:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1506
+//line sql.y:1527
 		{
 			yyVAL.str = HebrewStr
 		}
-	case 228:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1510
+//line sql.y:1531
 		{
 			yyVAL.str = Hp8Str
 		}
-	case 229:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1514
+//line sql.y:1535
 		{
 			yyVAL.str = Keybcs2Str
 		}
-	case 230:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1518
+//line sql.y:1539
 		{
 			yyVAL.str = Koi8rStr
 		}
-	case 231:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1522
+//line sql.y:1543
 		{
 			yyVAL.str = Koi8uStr
 		}
-	case 232:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1526
+//line sql.y:1547
 		{
 			yyVAL.str = Latin1Str
 		}
-	case 233:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1530
+//line sql.y:1551
 		{
 			yyVAL.str = Latin2Str
 		}
-	case 234:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1534
+//line sql.y:1555
 		{
 			yyVAL.str = Latin5Str
 		}
-	case 235:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1538
+//line sql.y:1559
 		{
 			yyVAL.str = Latin7Str
 		}
-	case 236:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1542
+//line sql.y:1563
 		{
 			yyVAL.str = MacceStr
 		}
-	case 237:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1546
+//line sql.y:1567
 		{
 			yyVAL.str = MacromanStr
 		}
-	case 238:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1550
+//line sql.y:1571
 		{
 			yyVAL.str = SjisStr
 		}
-	case 239:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1554
+//line sql.y:1575
 		{
 			yyVAL.str = Swe7Str
 		}
-	case 240:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1558
+//line sql.y:1579
 		{
 			yyVAL.str = Tis620Str
 		}
-	case 241:
+	case 243:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1562
+//line sql.y:1583
 		{
 			yyVAL.str = Ucs2Str
 		}
-	case 242:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1566
+//line sql.y:1587
 		{
 			yyVAL.str = UjisStr
 		}
-	case 243:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1570
+//line sql.y:1591
 		{
 			yyVAL.str = Utf16Str
 		}
-	case 244:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1574
+//line sql.y:1595
 		{
 			yyVAL.str = Utf16leStr
 		}
-	case 245:
+	case 247:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1578
+//line sql.y:1599
 		{
 			yyVAL.str = Utf32Str
 		}
-	case 246:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1582
+//line sql.y:1603
 		{
 			yyVAL.str = Utf8Str
 		}
-	case 247:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1586
+//line sql.y:1607
 		{
 			yyVAL.str = Utf8mb4Str
 		}
-	case 250:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1596
+//line sql.y:1617
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1600
+//line sql.y:1621
 		{
 			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 254:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1604
+//line sql.y:1625
 		{
 			yyLOCAL = NewDecimalLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1611
+//line sql.y:1632
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 256:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1615
+//line sql.y:1636
 		{
 			yyLOCAL = &UnaryExpr{Operator: NStringOp, Expr: NewStrLiteral(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:1619
+//line sql.y:1640
 		{
 			yyLOCAL = &IntroducerExpr{CharacterSet: yyDollar[1].str, Expr: NewStrLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 258:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1625
+//line sql.y:1646
 		{
 			yyLOCAL = colKeyPrimary
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1629
+//line sql.y:1650
 		{
 			yyLOCAL = colKeyUnique
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 260:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1633
+//line sql.y:1654
 		{
 			yyLOCAL = colKeyUniqueKey
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColumnKeyOption
-//line sql.y:1637
+//line sql.y:1658
 		{
 			yyLOCAL = colKey
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1643
+//line sql.y:1664
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
 			yyVAL.columnType.Zerofill = yyDollar[3].booleanUnion()
 		}
-	case 264:
+	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1654
+//line sql.y:1675
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literalUnion()
 		}
-	case 265:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1659
-		{
-			yyVAL.columnType = yyDollar[1].columnType
-		}
-	case 266:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1665
-		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
-		}
 	case 267:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1669
+//line sql.y:1680
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1673
+//line sql.y:1686
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1677
+//line sql.y:1690
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1681
+//line sql.y:1694
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1685
+//line sql.y:1698
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1689
+//line sql.y:1702
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 273:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1693
+//line sql.y:1706
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 274:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1697
+//line sql.y:1710
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 275:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1703
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1714
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 276:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1709
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1718
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 277:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1715
+//line sql.y:1724
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -8577,7 +8673,7 @@ yydefault:
 		}
 	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1721
+//line sql.y:1730
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -8585,1456 +8681,1472 @@ yydefault:
 		}
 	case 279:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1727
+//line sql.y:1736
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 280:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1735
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1742
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 281:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1739
+//line sql.y:1748
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 282:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1743
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1756
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1747
+//line sql.y:1760
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 284:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1751
+//line sql.y:1764
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 285:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1757
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1768
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 286:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1761
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1772
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 287:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1765
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1778
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
 	case 288:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1769
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1782
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
 	case 289:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1773
+//line sql.y:1786
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 290:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1777
+//line sql.y:1790
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 291:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1781
+//line sql.y:1794
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
 	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1785
+//line sql.y:1798
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
 	case 293:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1789
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1802
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
 	case 294:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1793
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1806
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str}
 		}
 	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1797
+//line sql.y:1810
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1801
+//line sql.y:1814
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1805
+//line sql.y:1818
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 298:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1809
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1822
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 299:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1814
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1826
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 300:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1820
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1830
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str}
 		}
 	case 301:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1824
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1835
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str}
 		}
 	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1828
+//line sql.y:1841
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1832
+//line sql.y:1845
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1836
+//line sql.y:1849
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1840
+//line sql.y:1853
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1844
+//line sql.y:1857
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 307:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1848
+//line sql.y:1861
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 308:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1854
+//line sql.y:1865
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+		}
+	case 309:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1869
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+		}
+	case 310:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1875
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
-	case 309:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1859
+//line sql.y:1880
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
-	case 310:
+	case 312:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1864
+//line sql.y:1885
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1868
+//line sql.y:1889
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 314:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1873
+//line sql.y:1894
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 313:
+	case 315:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1877
+//line sql.y:1898
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 314:
+	case 316:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1885
+//line sql.y:1906
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 315:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1889
+//line sql.y:1910
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 			}
 		}
-	case 316:
+	case 318:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1895
+//line sql.y:1916
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 317:
+	case 319:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1903
+//line sql.y:1924
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 320:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1907
+//line sql.y:1928
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1911
+//line sql.y:1932
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 322:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1916
+//line sql.y:1937
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1920
+//line sql.y:1941
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 324:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1925
+//line sql.y:1946
 		{
 			yyVAL.str = ""
 		}
-	case 323:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1929
+//line sql.y:1950
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 324:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1933
+//line sql.y:1954
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 325:
+	case 327:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1937
+//line sql.y:1958
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
-	case 326:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1941
+//line sql.y:1962
 		{
 			// ASCII: Shorthand for CHARACTER SET latin1.
 			yyVAL.str = "latin1"
 		}
-	case 327:
+	case 329:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1946
+//line sql.y:1967
 		{
 			// UNICODE: Shorthand for CHARACTER SET ucs2.
 			yyVAL.str = "ucs2"
 		}
-	case 328:
+	case 330:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1952
+//line sql.y:1973
 		{
 			yyVAL.str = ""
 		}
-	case 329:
+	case 331:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1956
+//line sql.y:1977
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 330:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1960
+//line sql.y:1981
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 331:
+	case 333:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1967
+//line sql.y:1988
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 334:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1972
+//line sql.y:1993
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 335:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1976
+//line sql.y:1997
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1982
+//line sql.y:2003
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 337:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1986
+//line sql.y:2007
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
 		}
-	case 336:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1992
+//line sql.y:2013
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1996
+//line sql.y:2017
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:2001
+//line sql.y:2022
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 341:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:2005
+//line sql.y:2026
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2011
+//line sql.y:2032
 		{
 			yyVAL.str = ""
 		}
-	case 341:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2015
+//line sql.y:2036
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 342:
+	case 344:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2021
+//line sql.y:2042
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 345:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2025
+//line sql.y:2046
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 346:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2029
+//line sql.y:2050
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 347:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2033
+//line sql.y:2054
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:2037
+//line sql.y:2058
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 349:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2042
+//line sql.y:2063
 		{
 			yyVAL.str = ""
 		}
-	case 348:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2046
+//line sql.y:2067
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 349:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2052
+//line sql.y:2073
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 350:
+	case 352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2056
+//line sql.y:2077
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 351:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2060
+//line sql.y:2081
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 352:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2067
+//line sql.y:2088
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 353:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2071
+//line sql.y:2092
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 354:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2076
+//line sql.y:2097
 		{
 			yyVAL.str = "key"
 		}
-	case 355:
+	case 357:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2080
+//line sql.y:2101
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 356:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2086
+//line sql.y:2107
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 357:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2090
+//line sql.y:2111
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 358:
+	case 360:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2095
+//line sql.y:2116
 		{
 			yyVAL.str = ""
 		}
-	case 359:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2099
+//line sql.y:2120
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 360:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:2105
+//line sql.y:2126
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2109
+//line sql.y:2130
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
 		}
-	case 362:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:2115
+//line sql.y:2136
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2121
+//line sql.y:2142
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2125
+//line sql.y:2146
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2131
+//line sql.y:2152
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:2135
+//line sql.y:2156
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 369:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:2141
+//line sql.y:2162
 		{
 			yyLOCAL = &ForeignKeyDefinition{IndexName: NewColIdent(yyDollar[3].str), Source: yyDollar[5].columnsUnion(), ReferenceDefinition: yyDollar[7].referenceDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 370:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2147
+//line sql.y:2168
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 371:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2151
+//line sql.y:2172
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 372:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2155
+//line sql.y:2176
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnUpdate: yyDollar[6].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 373:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2159
+//line sql.y:2180
 		{
 			yyLOCAL = &ReferenceDefinition{ReferencedTable: yyDollar[2].tableName, ReferencedColumns: yyDollar[4].columnsUnion(), OnDelete: yyDollar[6].ReferenceActionUnion(), OnUpdate: yyDollar[7].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 374:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2164
+//line sql.y:2185
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 375:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ReferenceDefinition
-//line sql.y:2168
+//line sql.y:2189
 		{
 			yyLOCAL = yyDollar[1].referenceDefinitionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 376:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:2174
+//line sql.y:2195
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2180
+//line sql.y:2201
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2186
+//line sql.y:2207
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2192
+//line sql.y:2213
 		{
 			yyLOCAL = Restrict
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 380:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2196
+//line sql.y:2217
 		{
 			yyLOCAL = Cascade
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2200
+//line sql.y:2221
 		{
 			yyLOCAL = NoAction
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 382:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2204
+//line sql.y:2225
 		{
 			yyLOCAL = SetDefault
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:2208
+//line sql.y:2229
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 384:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2213
+//line sql.y:2234
 		{
 			yyVAL.str = ""
 		}
-	case 383:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2217
+//line sql.y:2238
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 384:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2221
+//line sql.y:2242
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 385:
+	case 387:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2226
+//line sql.y:2247
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 388:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2230
+//line sql.y:2251
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 389:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2234
+//line sql.y:2255
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 390:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2239
+//line sql.y:2260
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2243
+//line sql.y:2264
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 392:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2249
+//line sql.y:2270
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2253
+//line sql.y:2274
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
-	case 392:
+	case 394:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2257
+//line sql.y:2278
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 393:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:2263
+//line sql.y:2284
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2267
+//line sql.y:2288
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 395:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2273
+//line sql.y:2294
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 398:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2277
+//line sql.y:2298
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 399:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2281
+//line sql.y:2302
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 400:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2285
+//line sql.y:2306
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2289
+//line sql.y:2310
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2293
+//line sql.y:2314
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 403:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2297
+//line sql.y:2318
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2301
+//line sql.y:2322
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 405:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2305
+//line sql.y:2326
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 406:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2309
+//line sql.y:2330
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2313
+//line sql.y:2334
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2317
+//line sql.y:2338
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2321
+//line sql.y:2342
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].tableIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2325
+//line sql.y:2346
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 411:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2329
+//line sql.y:2350
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2333
+//line sql.y:2354
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2337
+//line sql.y:2358
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2341
+//line sql.y:2362
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2345
+//line sql.y:2366
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 416:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2349
+//line sql.y:2370
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2353
+//line sql.y:2374
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2357
+//line sql.y:2378
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2361
+//line sql.y:2382
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2365
+//line sql.y:2386
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2369
+//line sql.y:2390
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2373
+//line sql.y:2394
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 423:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2377
+//line sql.y:2398
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 424:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:2381
+//line sql.y:2402
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 425:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2386
+//line sql.y:2407
 		{
 			yyVAL.str = ""
 		}
-	case 424:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2390
+//line sql.y:2411
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 425:
+	case 427:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2394
+//line sql.y:2415
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 435:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2413
+//line sql.y:2434
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 436:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2417
+//line sql.y:2438
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 437:
+	case 439:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2421
+//line sql.y:2442
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 438:
+	case 440:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2426
+//line sql.y:2447
 		{
 			yyVAL.str = ""
 		}
-	case 440:
+	case 442:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2432
+//line sql.y:2453
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 443:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2436
+//line sql.y:2457
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 444:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:2441
+//line sql.y:2462
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 445:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:2445
+//line sql.y:2466
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 446:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2450
+//line sql.y:2471
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 447:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2454
+//line sql.y:2475
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 448:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2458
+//line sql.y:2479
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
 		}
-	case 447:
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2462
+//line sql.y:2483
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 450:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2466
+//line sql.y:2487
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
 		}
-	case 449:
+	case 451:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2470
+//line sql.y:2491
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2476
+//line sql.y:2497
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 453:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2480
+//line sql.y:2501
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 452:
+	case 454:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2484
+//line sql.y:2505
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 453:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2490
+//line sql.y:2511
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 456:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2494
+//line sql.y:2515
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2498
+//line sql.y:2519
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2502
+//line sql.y:2523
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 459:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2506
+//line sql.y:2527
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 460:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2510
+//line sql.y:2531
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 461:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2514
+//line sql.y:2535
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 462:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2518
+//line sql.y:2539
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 463:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2522
+//line sql.y:2543
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 464:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2526
+//line sql.y:2547
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].booleanUnion(), After: yyDollar[6].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 465:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2530
+//line sql.y:2551
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].booleanUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 466:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2534
+//line sql.y:2555
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 467:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2538
+//line sql.y:2559
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 468:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2542
+//line sql.y:2563
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 469:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2546
+//line sql.y:2567
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 470:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2550
+//line sql.y:2571
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 471:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2554
+//line sql.y:2575
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 472:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2558
+//line sql.y:2579
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 473:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2562
+//line sql.y:2583
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 474:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2566
+//line sql.y:2587
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2570
+//line sql.y:2591
 		{
 			yyLOCAL = &Force{}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 476:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2574
+//line sql.y:2595
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 477:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2578
+//line sql.y:2599
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:2584
+//line sql.y:2605
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 479:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2588
+//line sql.y:2609
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 478:
+	case 480:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2594
+//line sql.y:2615
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 481:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2598
+//line sql.y:2619
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 482:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2602
+//line sql.y:2623
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2606
+//line sql.y:2627
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2610
+//line sql.y:2631
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2614
+//line sql.y:2635
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2618
+//line sql.y:2639
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 487:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2622
+//line sql.y:2643
 		{
 			yyLOCAL = &Validation{With: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2626
+//line sql.y:2647
 		{
 			yyLOCAL = &Validation{With: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 489:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2632
+//line sql.y:2653
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -10042,10 +10154,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 490:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2639
+//line sql.y:2660
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -10053,10 +10165,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 491:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2646
+//line sql.y:2667
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -10064,28 +10176,28 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2653
+//line sql.y:2674
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 493:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2659
+//line sql.y:2680
 		{
 			yyLOCAL = &AlterView{ViewName: yyDollar[7].tableName.ToViewName(), Comments: Comments(yyDollar[2].strs), Algorithm: yyDollar[3].str, Definer: yyDollar[4].definerUnion(), Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2663
+//line sql.y:2684
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -10093,10 +10205,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 495:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2670
+//line sql.y:2691
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -10104,10 +10216,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 496:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2677
+//line sql.y:2698
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -10120,10 +10232,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 497:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2689
+//line sql.y:2710
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -10134,26 +10246,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 498:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2699
+//line sql.y:2720
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 499:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2703
+//line sql.y:2724
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 500:
 		yyDollar = yyS[yypt-13 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2707
+//line sql.y:2728
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -10167,10 +10279,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 501:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2720
+//line sql.y:2741
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -10181,18 +10293,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 502:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2730
+//line sql.y:2751
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[6].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 503:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2734
+//line sql.y:2755
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -10204,10 +10316,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 504:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2745
+//line sql.y:2766
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -10215,10 +10327,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 505:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2752
+//line sql.y:2773
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CleanupMigrationType,
@@ -10226,10 +10338,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 506:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2759
+//line sql.y:2780
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -10237,10 +10349,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 507:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2766
+//line sql.y:2787
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -10248,28 +10360,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 508:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2773
+//line sql.y:2794
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 509:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2780
+//line sql.y:2801
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 510:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2784
+//line sql.y:2805
 		{
 			yyDollar[3].partitionOptionUnion().Partitions = yyDollar[4].integerUnion()
 			yyDollar[3].partitionOptionUnion().SubPartition = yyDollar[5].subPartitionUnion()
@@ -10277,10 +10389,10 @@ yydefault:
 			yyLOCAL = yyDollar[3].partitionOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 511:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2793
+//line sql.y:2814
 		{
 			yyLOCAL = &PartitionOption{
 				IsLinear: yyDollar[1].booleanUnion(),
@@ -10289,10 +10401,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 512:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2801
+//line sql.y:2822
 		{
 			yyLOCAL = &PartitionOption{
 				IsLinear:     yyDollar[1].booleanUnion(),
@@ -10302,10 +10414,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 513:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2810
+//line sql.y:2831
 		{
 			yyLOCAL = &PartitionOption{
 				Type: yyDollar[1].partitionByTypeUnion(),
@@ -10313,10 +10425,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 514:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionOption
-//line sql.y:2817
+//line sql.y:2838
 		{
 			yyLOCAL = &PartitionOption{
 				Type:    yyDollar[1].partitionByTypeUnion(),
@@ -10324,18 +10436,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 515:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2825
+//line sql.y:2846
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 516:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2829
+//line sql.y:2850
 		{
 			yyLOCAL = &SubPartition{
 				IsLinear:      yyDollar[3].booleanUnion(),
@@ -10345,10 +10457,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 517:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SubPartition
-//line sql.y:2838
+//line sql.y:2859
 		{
 			yyLOCAL = &SubPartition{
 				IsLinear:      yyDollar[3].booleanUnion(),
@@ -10359,335 +10471,335 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 518:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2849
+//line sql.y:2870
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2853
+//line sql.y:2874
 		{
 			yyLOCAL = yyDollar[2].partDefsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 520:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2858
+//line sql.y:2879
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 521:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2862
+//line sql.y:2883
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 522:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2867
+//line sql.y:2888
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 523:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2871
+//line sql.y:2892
 		{
 			yyLOCAL = convertStringToInt(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL PartitionByType
-//line sql.y:2877
+//line sql.y:2898
 		{
 			yyLOCAL = RangeType
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL PartitionByType
-//line sql.y:2881
+//line sql.y:2902
 		{
 			yyLOCAL = ListType
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 526:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2886
+//line sql.y:2907
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2890
+//line sql.y:2911
 		{
 			yyLOCAL = convertStringToInt(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 528:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2895
+//line sql.y:2916
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 529:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int
-//line sql.y:2899
+//line sql.y:2920
 		{
 			yyLOCAL = convertStringToInt(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 530:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2905
+//line sql.y:2926
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 531:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2909
+//line sql.y:2930
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 532:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2913
+//line sql.y:2934
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 533:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2917
+//line sql.y:2938
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 534:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2921
+//line sql.y:2942
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 535:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2925
+//line sql.y:2946
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 536:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2929
+//line sql.y:2950
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2933
+//line sql.y:2954
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 538:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2937
+//line sql.y:2958
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 539:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2941
+//line sql.y:2962
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 540:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2945
+//line sql.y:2966
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2949
+//line sql.y:2970
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 542:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2953
+//line sql.y:2974
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2957
+//line sql.y:2978
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2961
+//line sql.y:2982
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 545:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2965
+//line sql.y:2986
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 546:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2969
+//line sql.y:2990
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 547:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2973
+//line sql.y:2994
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 548:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2977
+//line sql.y:2998
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2981
+//line sql.y:3002
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 550:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2985
+//line sql.y:3006
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 551:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2989
+//line sql.y:3010
 		{
 			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 552:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2994
+//line sql.y:3015
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 553:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2998
+//line sql.y:3019
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 554:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3002
+//line sql.y:3023
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:3009
+//line sql.y:3030
 		{
 			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 556:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3013
+//line sql.y:3034
 		{
 			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
 		}
-	case 555:
+	case 557:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3019
+//line sql.y:3040
 		{
 			yyVAL.partDefUnion().ValueRange = yyDollar[2].partitionValueRangeUnion()
 		}
-	case 556:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3024
+//line sql.y:3045
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 559:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3028
+//line sql.y:3049
 		{
 			yyLOCAL = &PartitionValueRange{
 				Type:  LessThanType,
@@ -10695,10 +10807,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 560:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3035
+//line sql.y:3056
 		{
 			yyLOCAL = &PartitionValueRange{
 				Type:     LessThanType,
@@ -10706,10 +10818,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 561:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionValueRange
-//line sql.y:3042
+//line sql.y:3063
 		{
 			yyLOCAL = &PartitionValueRange{
 				Type:  InType,
@@ -10717,61 +10829,61 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 562:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:3051
+//line sql.y:3072
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3057
+//line sql.y:3078
 		{
 			yyVAL.str = ""
 		}
-	case 562:
+	case 564:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3061
+//line sql.y:3082
 		{
 			yyVAL.str = ""
 		}
-	case 563:
+	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3067
+//line sql.y:3088
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 566:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:3073
+//line sql.y:3094
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 567:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3077
+//line sql.y:3098
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 566:
+	case 568:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3083
+//line sql.y:3104
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[6].tableNamesUnion(), IfExists: yyDollar[5].booleanUnion(), Comments: Comments(yyDollar[2].strs), Temp: yyDollar[3].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 569:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3087
+//line sql.y:3108
 		{
 			// Change this to an alter statement
 			if yyDollar[4].colIdent.Lowered() == "primary" {
@@ -10781,404 +10893,424 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 570:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3096
+//line sql.y:3117
 		{
 			yyLOCAL = &DropView{FromTables: yyDollar[5].tableNamesUnion(), Comments: Comments(yyDollar[2].strs), IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 571:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:3121
+		{
+			yyLOCAL = &MaterializedViewDDL{Action: DropDDLAction, ViewName: yyDollar[6].tableName.ToViewName(), IfExists: yyDollar[5].booleanUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 572:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3100
+//line sql.y:3125
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[2].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 573:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3106
+//line sql.y:3131
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 574:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3110
+//line sql.y:3135
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 575:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3115
+//line sql.y:3140
 		{
 			yyLOCAL = &OtherRead{}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 576:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3121
+//line sql.y:3146
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3125
+//line sql.y:3150
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 578:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3129
+//line sql.y:3154
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 579:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3133
+//line sql.y:3158
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3137
+//line sql.y:3162
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 581:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3141
+//line sql.y:3166
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 582:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3145
+//line sql.y:3170
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 583:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3149
+//line sql.y:3174
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 584:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3153
+//line sql.y:3178
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 585:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3157
+//line sql.y:3182
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3161
+//line sql.y:3186
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 587:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3165
+//line sql.y:3190
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 588:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3169
+//line sql.y:3194
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 589:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3173
+//line sql.y:3198
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 590:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3177
+//line sql.y:3202
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 591:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3181
+//line sql.y:3206
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 592:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3185
+//line sql.y:3210
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 593:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3189
+//line sql.y:3214
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 594:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3193
+//line sql.y:3218
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 595:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3197
+//line sql.y:3222
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3201
+//line sql.y:3226
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3205
+//line sql.y:3230
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 598:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3209
+//line sql.y:3234
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3213
+//line sql.y:3238
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 600:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3217
+//line sql.y:3242
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 601:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3221
+//line sql.y:3246
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 602:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3225
+//line sql.y:3250
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 603:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3229
+//line sql.y:3254
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 604:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3233
+//line sql.y:3258
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 605:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3237
+//line sql.y:3262
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 606:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3241
+//line sql.y:3266
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 607:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3245
+//line sql.y:3270
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 608:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3249
+//line sql.y:3274
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 609:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3253
+//line sql.y:3278
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 610:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3257
+//line sql.y:3282
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: GtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 611:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3261
+//line sql.y:3286
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VGtidExecGlobal, DbName: yyDollar[4].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 612:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3265
+//line sql.y:3290
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 613:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3270
+//line sql.y:3295
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 614:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:3299
+		{
+			if yyDollar[3].colIdent.Lowered() != "views" {
+				yylex.Error("expecting views after materialized")
+				return 1
+			}
+			yyLOCAL = &Show{&ShowBasic{Command: MaterializedViews, Filter: yyDollar[5].showFilterUnion(), DbName: yyDollar[4].tableIdent}}
+		}
+		yyVAL.union = yyLOCAL
+	case 615:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3274
+//line sql.y:3307
 		{
 			yyLOCAL = &ShowMigrationLogs{UUID: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3278
+//line sql.y:3311
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3283
+//line sql.y:3316
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3287
+//line sql.y:3320
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 619:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3291
+//line sql.y:3324
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 620:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3295
+//line sql.y:3328
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Warnings}}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 621:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3300
+//line sql.y:3333
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -11186,1250 +11318,1258 @@ yydefault:
 			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3314
+//line sql.y:3347
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 623:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3318
+//line sql.y:3351
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3322
+//line sql.y:3355
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3328
+//line sql.y:3361
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 622:
+	case 626:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3332
+//line sql.y:3365
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 623:
+	case 627:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3338
+//line sql.y:3371
 		{
 			yyVAL.str = ""
 		}
-	case 624:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3342
+//line sql.y:3375
 		{
 			yyVAL.str = "extended "
 		}
-	case 625:
+	case 629:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3348
+//line sql.y:3381
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3352
+//line sql.y:3385
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3358
+//line sql.y:3391
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 628:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3362
+//line sql.y:3395
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 629:
+	case 633:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3368
+//line sql.y:3401
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 630:
+	case 634:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3372
+//line sql.y:3405
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 631:
+	case 635:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3376
+//line sql.y:3409
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 632:
+	case 636:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3382
+//line sql.y:3415
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 637:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3386
+//line sql.y:3419
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 638:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3390
+//line sql.y:3423
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 639:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3396
+//line sql.y:3429
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 640:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:3400
+//line sql.y:3433
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 641:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3406
+//line sql.y:3439
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 638:
+	case 642:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3410
+//line sql.y:3443
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 639:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3414
+//line sql.y:3447
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 640:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3420
+//line sql.y:3453
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3424
+//line sql.y:3457
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 646:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3428
+//line sql.y:3461
 		{
 			yyLOCAL = &Use{DBName: NewTableIdent(yyDollar[2].tableIdent.String() + "@" + string(yyDollar[3].str))}
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3434
+//line sql.y:3467
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 648:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3438
+//line sql.y:3471
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3444
+//line sql.y:3477
 		{
 			yyLOCAL = &Commit{}
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3450
+//line sql.y:3483
 		{
 			yyLOCAL = &Rollback{}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 651:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3454
+//line sql.y:3487
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 652:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3459
+//line sql.y:3492
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 649:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3461
+//line sql.y:3494
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 650:
+	case 654:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3464
+//line sql.y:3497
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 651:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3466
+//line sql.y:3499
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 652:
+	case 656:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3471
+//line sql.y:3504
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3477
+//line sql.y:3510
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 658:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3482
+//line sql.y:3515
 		{
 			yyLOCAL = EmptyType
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 659:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3486
+//line sql.y:3519
 		{
 			yyLOCAL = JSONType
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3490
+//line sql.y:3523
 		{
 			yyLOCAL = TreeType
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 661:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3494
+//line sql.y:3527
 		{
 			yyLOCAL = VitessType
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3498
+//line sql.y:3531
 		{
 			yyLOCAL = TraditionalType
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:3502
+//line sql.y:3535
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3508
+//line sql.y:3541
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 661:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3512
+//line sql.y:3545
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 662:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3516
+//line sql.y:3549
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 663:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3522
+//line sql.y:3555
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3526
+//line sql.y:3559
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3530
+//line sql.y:3563
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3534
+//line sql.y:3567
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 671:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3539
+//line sql.y:3572
 		{
 			yyVAL.str = ""
 		}
-	case 668:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3543
+//line sql.y:3576
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 669:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3547
+//line sql.y:3580
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 670:
+	case 674:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3553
+//line sql.y:3586
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 675:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3557
+//line sql.y:3590
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 676:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3563
+//line sql.y:3596
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 677:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3567
+//line sql.y:3600
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 678:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3573
+//line sql.y:3606
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:3579
+//line sql.y:3612
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 680:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3583
+//line sql.y:3616
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
 		}
-	case 677:
+	case 681:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:3589
+//line sql.y:3622
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3595
+//line sql.y:3628
 		{
 			yyLOCAL = Read
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 683:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3599
+//line sql.y:3632
 		{
 			yyLOCAL = ReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3603
+//line sql.y:3636
 		{
 			yyLOCAL = Write
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 685:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:3607
+//line sql.y:3640
 		{
 			yyLOCAL = LowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3613
+//line sql.y:3646
 		{
 			yyLOCAL = &UnlockTables{}
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 687:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3619
+//line sql.y:3652
 		{
 			yyLOCAL = &RevertMigration{Comments: Comments(yyDollar[2].strs), UUID: string(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 688:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:3658
+		{
+			yyLOCAL = &MaterializedViewDDL{Action: RefreshDDLAction, ViewName: yyDollar[4].tableName.ToViewName()}
+		}
+		yyVAL.union = yyLOCAL
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3625
+//line sql.y:3664
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 690:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3629
+//line sql.y:3668
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 691:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3633
+//line sql.y:3672
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 692:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3637
+//line sql.y:3676
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 693:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3641
+//line sql.y:3680
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 694:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:3645
+//line sql.y:3684
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3651
+//line sql.y:3690
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 691:
+	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3655
+//line sql.y:3694
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 692:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3661
+//line sql.y:3700
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 693:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3665
+//line sql.y:3704
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 694:
+	case 699:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3669
+//line sql.y:3708
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 695:
+	case 700:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3673
+//line sql.y:3712
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 696:
+	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3677
+//line sql.y:3716
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 697:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3681
+//line sql.y:3720
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 698:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3685
+//line sql.y:3724
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 699:
+	case 704:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3689
+//line sql.y:3728
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
-	case 700:
+	case 705:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3693
+//line sql.y:3732
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 701:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3697
+//line sql.y:3736
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 702:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3701
+//line sql.y:3740
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 703:
+	case 708:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3705
+//line sql.y:3744
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 704:
+	case 709:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3710
+//line sql.y:3749
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 710:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3714
+//line sql.y:3753
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3718
+//line sql.y:3757
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 712:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3723
+//line sql.y:3762
 		{
 			yyVAL.str = ""
 		}
-	case 708:
+	case 713:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3727
+//line sql.y:3766
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
-	case 709:
+	case 714:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3732
+//line sql.y:3771
 		{
 			setAllowComments(yylex, true)
 		}
-	case 710:
+	case 715:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3736
+//line sql.y:3775
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
-	case 711:
+	case 716:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3742
+//line sql.y:3781
 		{
 			yyVAL.strs = nil
 		}
-	case 712:
+	case 717:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3746
+//line sql.y:3785
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 713:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3752
+//line sql.y:3791
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 719:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3756
+//line sql.y:3795
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 720:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3760
+//line sql.y:3799
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 721:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3765
+//line sql.y:3804
 		{
 			yyVAL.str = ""
 		}
-	case 717:
+	case 722:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3769
+//line sql.y:3808
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 718:
+	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3773
+//line sql.y:3812
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 719:
+	case 724:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3778
+//line sql.y:3817
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3782
+//line sql.y:3821
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3786
+//line sql.y:3825
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 727:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3791
+//line sql.y:3830
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 728:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3795
+//line sql.y:3834
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 729:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3800
+//line sql.y:3839
 		{
 			yyVAL.strs = nil
 		}
-	case 725:
+	case 730:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3804
+//line sql.y:3843
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 726:
+	case 731:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3808
+//line sql.y:3847
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 727:
+	case 732:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3812
+//line sql.y:3851
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 728:
+	case 733:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3816
+//line sql.y:3855
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 729:
+	case 734:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3822
+//line sql.y:3861
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 730:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3826
+//line sql.y:3865
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 731:
+	case 736:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3830
+//line sql.y:3869
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 732:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3834
+//line sql.y:3873
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 733:
+	case 738:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3838
+//line sql.y:3877
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 734:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3842
+//line sql.y:3881
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 735:
+	case 740:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3846
+//line sql.y:3885
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 736:
+	case 741:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3852
+//line sql.y:3891
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3856
+//line sql.y:3895
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
 		}
-	case 738:
+	case 743:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3862
+//line sql.y:3901
 		{
 			yyLOCAL = &StarExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 744:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3866
+//line sql.y:3905
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3870
+//line sql.y:3909
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 746:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3874
+//line sql.y:3913
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 747:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3879
+//line sql.y:3918
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 743:
+	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3883
+//line sql.y:3922
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 744:
+	case 749:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3887
+//line sql.y:3926
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 746:
+	case 751:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3894
+//line sql.y:3933
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 747:
+	case 752:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3899
+//line sql.y:3938
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 753:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3903
+//line sql.y:3942
 		{
 			yyLOCAL = yyDollar[1].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 754:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3909
+//line sql.y:3948
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 755:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3915
+//line sql.y:3954
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3919
+//line sql.y:3958
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
 		}
-	case 754:
+	case 759:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3929
+//line sql.y:3968
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 760:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3933
+//line sql.y:3972
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent, Columns: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3937
+//line sql.y:3976
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 762:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3943
+//line sql.y:3982
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 763:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3949
+//line sql.y:3988
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 764:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3953
+//line sql.y:3992
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 765:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3958
+//line sql.y:3997
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 766:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3962
+//line sql.y:4001
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 767:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3968
+//line sql.y:4007
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 768:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3972
+//line sql.y:4011
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 764:
+	case 769:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3978
+//line sql.y:4017
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 770:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3982
+//line sql.y:4021
 		{
 			yyLOCAL = Columns{NewColIdent(string(yyDollar[1].str))}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 771:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3986
+//line sql.y:4025
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 767:
+	case 772:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3990
+//line sql.y:4029
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, NewColIdent(string(yyDollar[3].str)))
 		}
-	case 768:
+	case 773:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:3996
+//line sql.y:4035
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 774:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4000
+//line sql.y:4039
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 770:
+	case 775:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4013
+//line sql.y:4052
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 776:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4017
+//line sql.y:4056
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 777:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4021
+//line sql.y:4060
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 778:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:4025
+//line sql.y:4064
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 779:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4031
+//line sql.y:4070
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 775:
+	case 780:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4033
+//line sql.y:4072
 		{
 			yyVAL.joinCondition = &JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
-	case 776:
+	case 781:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4037
+//line sql.y:4076
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
-	case 777:
+	case 782:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4039
+//line sql.y:4078
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 778:
+	case 783:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4043
+//line sql.y:4082
 		{
 			yyVAL.joinCondition = &JoinCondition{}
 		}
-	case 779:
+	case 784:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4045
+//line sql.y:4084
 		{
 			yyVAL.joinCondition = &JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 780:
+	case 785:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4048
+//line sql.y:4087
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 781:
+	case 786:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4050
+//line sql.y:4089
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 782:
+	case 787:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4053
+//line sql.y:4092
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 783:
+	case 788:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4057
+//line sql.y:4096
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 784:
+	case 789:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4061
+//line sql.y:4100
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 786:
+	case 791:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4068
+//line sql.y:4107
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 787:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4074
+//line sql.y:4113
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 793:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4078
+//line sql.y:4117
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 794:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4082
+//line sql.y:4121
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 795:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4088
+//line sql.y:4127
 		{
 			yyLOCAL = StraightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4094
+//line sql.y:4133
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 797:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4098
+//line sql.y:4137
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4102
+//line sql.y:4141
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 799:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4106
+//line sql.y:4145
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 800:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4112
+//line sql.y:4151
 		{
 			yyLOCAL = NaturalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 801:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:4116
+//line sql.y:4155
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -12438,567 +12578,567 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 802:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4126
+//line sql.y:4165
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 798:
+	case 803:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4130
+//line sql.y:4169
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 799:
+	case 804:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4136
+//line sql.y:4175
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 800:
+	case 805:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4140
+//line sql.y:4179
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 801:
+	case 806:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4146
+//line sql.y:4185
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 802:
+	case 807:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL IndexHints
-//line sql.y:4151
+//line sql.y:4190
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 808:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IndexHints
-//line sql.y:4155
+//line sql.y:4194
 		{
 			yyLOCAL = yyDollar[1].indexHintsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 809:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IndexHints
-//line sql.y:4161
+//line sql.y:4200
 		{
 			yyLOCAL = IndexHints{yyDollar[1].indexHintUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4165
+//line sql.y:4204
 		{
 			yySLICE := (*IndexHints)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexHintUnion())
 		}
-	case 806:
+	case 811:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *IndexHint
-//line sql.y:4171
+//line sql.y:4210
 		{
 			yyLOCAL = &IndexHint{Type: UseOp, ForType: yyDollar[3].indexHintForTypeUnion(), Indexes: yyDollar[5].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 812:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHint
-//line sql.y:4175
+//line sql.y:4214
 		{
 			yyLOCAL = &IndexHint{Type: UseOp, ForType: yyDollar[3].indexHintForTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 813:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *IndexHint
-//line sql.y:4179
+//line sql.y:4218
 		{
 			yyLOCAL = &IndexHint{Type: IgnoreOp, ForType: yyDollar[3].indexHintForTypeUnion(), Indexes: yyDollar[5].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 814:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *IndexHint
-//line sql.y:4183
+//line sql.y:4222
 		{
 			yyLOCAL = &IndexHint{Type: ForceOp, ForType: yyDollar[3].indexHintForTypeUnion(), Indexes: yyDollar[5].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 815:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL IndexHintForType
-//line sql.y:4188
+//line sql.y:4227
 		{
 			yyLOCAL = NoForType
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 816:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IndexHintForType
-//line sql.y:4192
+//line sql.y:4231
 		{
 			yyLOCAL = JoinForType
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 817:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL IndexHintForType
-//line sql.y:4196
+//line sql.y:4235
 		{
 			yyLOCAL = OrderByForType
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 818:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL IndexHintForType
-//line sql.y:4200
+//line sql.y:4239
 		{
 			yyLOCAL = GroupByForType
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 819:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4206
+//line sql.y:4245
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 820:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4210
+//line sql.y:4249
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 821:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4217
+//line sql.y:4256
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 822:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4221
+//line sql.y:4260
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 823:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4225
+//line sql.y:4264
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 824:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4229
+//line sql.y:4268
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 825:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4233
+//line sql.y:4272
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].isExprOperatorUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 826:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4237
+//line sql.y:4276
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 827:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4244
+//line sql.y:4283
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNullOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 828:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4248
+//line sql.y:4287
 		{
 			yyLOCAL = &IsExpr{Left: yyDollar[1].exprUnion(), Right: IsNotNullOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 829:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4252
+//line sql.y:4291
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 830:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4256
+//line sql.y:4295
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 831:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4262
+//line sql.y:4301
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 832:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4266
+//line sql.y:4305
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 833:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4270
+//line sql.y:4309
 		{
 			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: true, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 834:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4274
+//line sql.y:4313
 		{
 			yyLOCAL = &BetweenExpr{Left: yyDollar[1].exprUnion(), IsBetween: false, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 835:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4278
+//line sql.y:4317
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 836:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4282
+//line sql.y:4321
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 837:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4286
+//line sql.y:4325
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 838:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4290
+//line sql.y:4329
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 839:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4294
+//line sql.y:4333
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 840:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4298
+//line sql.y:4337
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 841:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4302
+//line sql.y:4341
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 842:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4308
+//line sql.y:4347
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 843:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4312
+//line sql.y:4351
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 844:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4316
+//line sql.y:4355
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 845:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4320
+//line sql.y:4359
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 846:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4324
+//line sql.y:4363
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 847:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4328
+//line sql.y:4367
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 848:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4332
+//line sql.y:4371
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 849:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4336
+//line sql.y:4375
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 850:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4340
+//line sql.y:4379
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
+	case 851:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4344
+//line sql.y:4383
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 852:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4348
+//line sql.y:4387
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 853:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4352
+//line sql.y:4391
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4356
+//line sql.y:4395
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 855:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4362
+//line sql.y:4401
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 856:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4366
+//line sql.y:4405
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 857:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4370
+//line sql.y:4409
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 858:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4374
+//line sql.y:4413
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 859:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4378
+//line sql.y:4417
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Collation: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 860:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4382
+//line sql.y:4421
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 861:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4386
+//line sql.y:4425
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 862:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4390
+//line sql.y:4429
 		{
 			yyLOCAL = yyDollar[2].exprUnion() // TODO: do we really want to ignore unary '+' before any kind of literals?
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 863:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4394
+//line sql.y:4433
 		{
 			yyLOCAL = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 864:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4398
+//line sql.y:4437
 		{
 			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 865:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4402
+//line sql.y:4441
 		{
 			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4406
+//line sql.y:4445
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 867:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4410
+//line sql.y:4449
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 868:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4414
+//line sql.y:4453
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 869:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4418
+//line sql.y:4457
 		{
 			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 870:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4422
+//line sql.y:4461
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 871:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4426
+//line sql.y:4465
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 872:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4430
+//line sql.y:4469
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 873:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4434
+//line sql.y:4473
 		{
 			// From: https://dev.mysql.com/doc/refman/8.0/en/cast-functions.html#operator_binary
 			// To convert a string expression to a binary string, these constructs are equivalent:
@@ -13007,18 +13147,18 @@ yydefault:
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[2].exprUnion(), Type: &ConvertType{Type: yyDollar[1].str}}
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 874:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4442
+//line sql.y:4481
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 875:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4446
+//line sql.y:4485
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -13027,898 +13167,1043 @@ yydefault:
 			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 876:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4454
+//line sql.y:4493
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 872:
+	case 877:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4458
+//line sql.y:4497
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 873:
+	case 878:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4465
+//line sql.y:4504
 		{
 			yyVAL.str = ""
 		}
-	case 874:
+	case 879:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4469
+//line sql.y:4508
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 875:
+	case 880:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:4475
+//line sql.y:4514
 		{
 			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 876:
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:4479
+//line sql.y:4518
 		{
 			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4486
+//line sql.y:4525
 		{
 			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 883:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4490
+//line sql.y:4529
 		{
 			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 884:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4494
+//line sql.y:4533
 		{
 			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 885:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:4498
+//line sql.y:4537
 		{
 			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 886:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4504
+//line sql.y:4543
 		{
 			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 887:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4508
+//line sql.y:4547
 		{
 			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4512
+//line sql.y:4551
 		{
 			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 889:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4516
+//line sql.y:4555
 		{
 			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 890:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4520
+//line sql.y:4559
 		{
 			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 891:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4524
+//line sql.y:4563
 		{
 			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 892:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:4528
+//line sql.y:4567
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 893:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4534
+//line sql.y:4573
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 894:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4538
+//line sql.y:4577
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 895:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:4542
+//line sql.y:4581
 		{
 			yyLOCAL = ListArg(yyDollar[1].str[2:])
 			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 896:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:4549
+//line sql.y:4588
+		{
+			yyLOCAL = &Subquery{yyDollar[1].selStmtUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 897:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4594
+		{
+			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 898:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4598
+		{
+			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
+			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
+		}
+	case 899:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4608
+		{
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion(), Over: yyDollar[5].overClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 900:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4612
+		{
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 901:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4616
+		{
+			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 902:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL Expr
+//line sql.y:4620
+		{
+			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 903:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4625
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 904:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4629
+		{
+			yyLOCAL = yyDollar[1].overClauseUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 905:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *OverClause
+//line sql.y:4635
+		{
+			yyLOCAL = &OverClause{PartitionBy: yyDollar[3].exprsUnion(), OrderBy: yyDollar[4].orderByUnion(), Frame: yyDollar[5].frameClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 906:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4640
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 907:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Exprs
+//line sql.y:4644
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 908:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4649
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 909:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4653
+		{
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitUnion(), Start: yyDollar[2].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 910:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *FrameClause
+//line sql.y:4657
+		{
+			yyLOCAL = &FrameClause{Unit: yyDollar[1].frameUnitUnion(), Start: yyDollar[3].framePointUnion(), End: yyDollar[5].framePointUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 911:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL FrameUnitType
+//line sql.y:4663
+		{
+			yyLOCAL = RowsUnit
+		}
+		yyVAL.union = yyLOCAL
+	case 912:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL FrameUnitType
+//line sql.y:4667
+		{
+			yyLOCAL = RangeUnit
+		}
+		yyVAL.union = yyLOCAL
+	case 913:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4673
+		{
+			yyLOCAL = &FramePoint{Type: CurrentRowType}
+		}
+		yyVAL.union = yyLOCAL
+	case 914:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4677
 		{
-			yyLOCAL = &Subquery{yyDollar[1].selStmtUnion()}
+			yyLOCAL = &FramePoint{Type: UnboundedPrecedingType}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL Exprs
-//line sql.y:4555
+	case 915:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4681
 		{
-			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
+			yyLOCAL = &FramePoint{Type: UnboundedFollowingType}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4559
+	case 916:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4685
 		{
-			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
-			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
+			yyLOCAL = &FramePoint{Type: ExprPrecedingType, Expr: yyDollar[1].exprUnion()}
 		}
-	case 894:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		var yyLOCAL Expr
-//line sql.y:4569
+		yyVAL.union = yyLOCAL
+	case 917:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *FramePoint
+//line sql.y:4689
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
+			yyLOCAL = &FramePoint{Type: ExprFollowingType, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 918:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4573
+//line sql.y:4695
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 919:
+		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4577
+//line sql.y:4699
 		{
-			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
+			yyLOCAL = NewArgument(yyDollar[1].str[1:])
+			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 920:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4581
+//line sql.y:4704
 		{
-			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
+			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 921:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4591
+//line sql.y:4714
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 922:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4595
+//line sql.y:4718
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 923:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4599
+//line sql.y:4722
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 924:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4603
+//line sql.y:4726
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 925:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4607
+//line sql.y:4730
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 926:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4611
+//line sql.y:4734
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].exprUnion(), From: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 927:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4615
+//line sql.y:4738
 		{
 			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 928:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4619
+//line sql.y:4742
 		{
 			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 929:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4623
+//line sql.y:4746
 		{
 			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 930:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4627
+//line sql.y:4750
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 931:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4638
+//line sql.y:4761
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 932:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4642
+//line sql.y:4765
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 933:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4648
+//line sql.y:4771
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 934:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4652
+//line sql.y:4775
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 935:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4657
+//line sql.y:4780
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 936:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4661
+//line sql.y:4784
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 937:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4665
+//line sql.y:4788
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 938:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4669
+//line sql.y:4792
 		{
 			yyLOCAL = &ExtractFuncExpr{IntervalTypes: yyDollar[3].intervalTypeUnion(), Expr: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 939:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4673
+//line sql.y:4796
 		{
 			yyLOCAL = &WeightStringFuncExpr{Expr: yyDollar[3].exprUnion(), As: yyDollar[4].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4679
+//line sql.y:4802
 		{
 		}
-	case 918:
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4681
+//line sql.y:4804
 		{
 			yyLOCAL = IntervalDayHour
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 942:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4685
+//line sql.y:4808
 		{
 			yyLOCAL = IntervalDayMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4689
+//line sql.y:4812
 		{
 			yyLOCAL = IntervalDayMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 944:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4693
+//line sql.y:4816
 		{
 			yyLOCAL = IntervalDaySecond
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4697
+//line sql.y:4820
 		{
 			yyLOCAL = IntervalHourMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4701
+//line sql.y:4824
 		{
 			yyLOCAL = IntervalHourMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 947:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4705
+//line sql.y:4828
 		{
 			yyLOCAL = IntervalHourSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 948:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4709
+//line sql.y:4832
 		{
 			yyLOCAL = IntervalMinuteMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4713
+//line sql.y:4836
 		{
 			yyLOCAL = IntervalMinuteSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 950:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4717
+//line sql.y:4840
 		{
 			yyLOCAL = IntervalSecondMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 951:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4721
+//line sql.y:4844
 		{
 			yyLOCAL = IntervalYearMonth
 		}
 		yyVAL.union = yyLOCAL
-	case 929:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4727
+//line sql.y:4850
 		{
 			yyLOCAL = IntervalDay
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 953:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4731
+//line sql.y:4854
 		{
 			yyLOCAL = IntervalWeek
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4735
+//line sql.y:4858
 		{
 			yyLOCAL = IntervalHour
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4739
+//line sql.y:4862
 		{
 			yyLOCAL = IntervalMinute
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 956:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4743
+//line sql.y:4866
 		{
 			yyLOCAL = IntervalMonth
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4747
+//line sql.y:4870
 		{
 			yyLOCAL = IntervalQuarter
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4751
+//line sql.y:4874
 		{
 			yyLOCAL = IntervalSecond
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4755
+//line sql.y:4878
 		{
 			yyLOCAL = IntervalMicrosecond
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 960:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IntervalTypes
-//line sql.y:4759
+//line sql.y:4882
 		{
 			yyLOCAL = IntervalYear
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 963:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4769
+//line sql.y:4892
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 964:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4773
+//line sql.y:4896
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 965:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:4777
+//line sql.y:4900
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 966:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4787
+//line sql.y:4910
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 967:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4791
+//line sql.y:4914
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 968:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4795
+//line sql.y:4918
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 969:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4799
+//line sql.y:4922
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 970:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4803
+//line sql.y:4926
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 971:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4809
+//line sql.y:4932
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 972:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4813
+//line sql.y:4936
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 973:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4817
+//line sql.y:4940
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 974:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4821
+//line sql.y:4944
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 975:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:4825
+//line sql.y:4948
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4831
+//line sql.y:4954
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 954:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4835
+//line sql.y:4958
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 955:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4839
+//line sql.y:4962
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 956:
+	case 979:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4845
+//line sql.y:4968
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 980:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4849
+//line sql.y:4972
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[2].str), Length: NewIntLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 981:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4853
+//line sql.y:4976
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[2].str), Length: NewIntLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 982:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4859
+//line sql.y:4982
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 983:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4863
+//line sql.y:4986
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4867
+//line sql.y:4990
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 985:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4871
+//line sql.y:4994
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 986:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4875
+//line sql.y:4998
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 987:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4881
+//line sql.y:5004
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 988:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4885
+//line sql.y:5008
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 989:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4889
+//line sql.y:5012
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 990:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4893
+//line sql.y:5016
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 991:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4897
+//line sql.y:5020
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4901
+//line sql.y:5024
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 993:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4905
+//line sql.y:5028
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 994:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4909
+//line sql.y:5032
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4913
+//line sql.y:5036
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:4917
+//line sql.y:5040
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 997:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4923
+//line sql.y:5046
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 998:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4927
+//line sql.y:5050
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 999:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4932
+//line sql.y:5055
 		{
 			yyVAL.str = string("")
 		}
-	case 977:
+	case 1000:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4936
+//line sql.y:5059
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 978:
+	case 1001:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:4942
+//line sql.y:5065
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1002:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4946
+//line sql.y:5069
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 980:
+	case 1003:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:4952
+//line sql.y:5075
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1004:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4957
+//line sql.y:5080
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1005:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4961
+//line sql.y:5084
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4967
+//line sql.y:5090
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1007:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4971
+//line sql.y:5094
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1008:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4975
+//line sql.y:5098
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1009:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4981
+//line sql.y:5104
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -13928,380 +14213,380 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1010:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4990
+//line sql.y:5113
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1011:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4994
+//line sql.y:5117
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str[1:])
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1012:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5000
+//line sql.y:5123
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1013:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5004
+//line sql.y:5127
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1014:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5009
+//line sql.y:5132
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1015:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5013
+//line sql.y:5136
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1016:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:5018
+//line sql.y:5141
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1017:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:5022
+//line sql.y:5145
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 1018:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:5028
+//line sql.y:5151
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 996:
+	case 1019:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:5034
+//line sql.y:5157
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 997:
+	case 1020:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5038
+//line sql.y:5161
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 998:
+	case 1021:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:5044
+//line sql.y:5167
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1022:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:5049
+//line sql.y:5172
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:5053
+//line sql.y:5176
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1024:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:5057
+//line sql.y:5180
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1025:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5062
+//line sql.y:5185
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1026:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5066
+//line sql.y:5189
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1027:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5072
+//line sql.y:5195
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1005:
+	case 1028:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5076
+//line sql.y:5199
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1006:
+	case 1029:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:5080
+//line sql.y:5203
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1030:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5085
+//line sql.y:5208
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1031:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5089
+//line sql.y:5212
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1032:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5093
+//line sql.y:5216
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1010:
+	case 1033:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5097
+//line sql.y:5220
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1034:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:5101
+//line sql.y:5224
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1035:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5108
+//line sql.y:5231
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1036:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5112
+//line sql.y:5235
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1037:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5116
+//line sql.y:5239
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1038:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5120
+//line sql.y:5243
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1039:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5126
+//line sql.y:5249
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1040:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5130
+//line sql.y:5253
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1041:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:5134
+//line sql.y:5257
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1042:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5139
+//line sql.y:5262
 		{
 			yyVAL.str = ""
 		}
-	case 1020:
+	case 1043:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5143
+//line sql.y:5266
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 1021:
+	case 1044:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5147
+//line sql.y:5270
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 1022:
+	case 1045:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5151
+//line sql.y:5274
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 1023:
+	case 1046:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5156
+//line sql.y:5279
 		{
 			yyVAL.str = ""
 		}
-	case 1024:
+	case 1047:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5160
+//line sql.y:5283
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 1025:
+	case 1048:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5166
+//line sql.y:5289
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1026:
+	case 1049:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5170
+//line sql.y:5293
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1027:
+	case 1050:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5175
+//line sql.y:5298
 		{
 			yyVAL.str = ""
 		}
-	case 1028:
+	case 1051:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:5179
+//line sql.y:5302
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 1029:
+	case 1052:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5184
+//line sql.y:5307
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 1030:
+	case 1053:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5188
+//line sql.y:5311
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1031:
+	case 1054:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5192
+//line sql.y:5315
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 1032:
+	case 1055:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5197
+//line sql.y:5320
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1033:
+	case 1056:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5201
+//line sql.y:5324
 		{
 			yyLOCAL = yyDollar[3].definerUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1034:
+	case 1057:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5207
+//line sql.y:5330
 		{
 			yyLOCAL = &Definer{
 				Name: string(yyDollar[1].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1035:
+	case 1058:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5213
+//line sql.y:5336
 		{
 			yyLOCAL = &Definer{
 				Name: string(yyDollar[1].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1036:
+	case 1059:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Definer
-//line sql.y:5219
+//line sql.y:5342
 		{
 			yyLOCAL = &Definer{
 				Name:    yyDollar[1].str,
@@ -14309,361 +14594,361 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1037:
+	case 1060:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5228
+//line sql.y:5351
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 1038:
+	case 1061:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5232
+//line sql.y:5355
 		{
 			yyVAL.str = formatIdentifier(yyDollar[1].str)
 		}
-	case 1039:
+	case 1062:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5237
+//line sql.y:5360
 		{
 			yyVAL.str = ""
 		}
-	case 1040:
+	case 1063:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5241
+//line sql.y:5364
 		{
 			yyVAL.str = formatAddress(yyDollar[1].str)
 		}
-	case 1041:
+	case 1064:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:5247
+//line sql.y:5370
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 1042:
+	case 1065:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:5251
+//line sql.y:5374
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 1043:
+	case 1066:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5257
+//line sql.y:5380
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 1044:
+	case 1067:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5261
+//line sql.y:5384
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 1045:
+	case 1068:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:5265
+//line sql.y:5388
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1069:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5270
+//line sql.y:5393
 		{
 			yyVAL.str = ""
 		}
-	case 1047:
+	case 1070:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5274
+//line sql.y:5397
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 1048:
+	case 1071:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5278
+//line sql.y:5401
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 1049:
+	case 1072:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5283
+//line sql.y:5406
 		{
 			yyVAL.str = ""
 		}
-	case 1050:
+	case 1073:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5287
+//line sql.y:5410
 		{
 			yyVAL.str = " header"
 		}
-	case 1051:
+	case 1074:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5292
+//line sql.y:5415
 		{
 			yyVAL.str = ""
 		}
-	case 1052:
+	case 1075:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5296
+//line sql.y:5419
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 1053:
+	case 1076:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5300
+//line sql.y:5423
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 1054:
+	case 1077:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5305
+//line sql.y:5428
 		{
 			yyVAL.str = ""
 		}
-	case 1055:
+	case 1078:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5309
+//line sql.y:5432
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 1056:
+	case 1079:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5313
+//line sql.y:5436
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 1057:
+	case 1080:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5319
+//line sql.y:5442
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1058:
+	case 1081:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5324
+//line sql.y:5447
 		{
 			yyVAL.str = ""
 		}
-	case 1059:
+	case 1082:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5328
+//line sql.y:5451
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 1060:
+	case 1083:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5334
+//line sql.y:5457
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1061:
+	case 1084:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5338
+//line sql.y:5461
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1062:
+	case 1085:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5344
+//line sql.y:5467
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1063:
+	case 1086:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5348
+//line sql.y:5471
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1064:
+	case 1087:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5353
+//line sql.y:5476
 		{
 			yyVAL.str = ""
 		}
-	case 1065:
+	case 1088:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5357
+//line sql.y:5480
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 1066:
+	case 1089:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5363
+//line sql.y:5486
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1067:
+	case 1090:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5367
+//line sql.y:5490
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 1068:
+	case 1091:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5373
+//line sql.y:5496
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1069:
+	case 1092:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:5377
+//line sql.y:5500
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 1070:
+	case 1093:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5381
+//line sql.y:5504
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 1071:
+	case 1094:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5386
+//line sql.y:5509
 		{
 			yyVAL.str = ""
 		}
-	case 1072:
+	case 1095:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5390
+//line sql.y:5513
 		{
 			yyVAL.str = " optionally"
 		}
-	case 1073:
+	case 1096:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5403
+//line sql.y:5526
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1074:
+	case 1097:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5407
+//line sql.y:5530
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1075:
+	case 1098:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5411
+//line sql.y:5534
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1076:
+	case 1099:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5415
+//line sql.y:5538
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1077:
+	case 1100:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:5419
+//line sql.y:5542
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1078:
+	case 1101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:5425
+//line sql.y:5548
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1079:
+	case 1102:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:5429
+//line sql.y:5552
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 1080:
+	case 1103:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5433
+//line sql.y:5556
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 1081:
+	case 1104:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:5437
+//line sql.y:5560
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 1082:
+	case 1105:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5442
+//line sql.y:5565
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1083:
+	case 1106:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5446
+//line sql.y:5569
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1084:
+	case 1107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:5452
+//line sql.y:5575
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1085:
+	case 1108:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5456
+//line sql.y:5579
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 1086:
+	case 1109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5462
+//line sql.y:5585
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1087:
+	case 1110:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5466
+//line sql.y:5589
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1088:
+	case 1111:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:5472
+//line sql.y:5595
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1089:
+	case 1112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5477
+//line sql.y:5600
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -14672,329 +14957,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1090:
+	case 1113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:5487
+//line sql.y:5610
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1091:
+	case 1114:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5491
+//line sql.y:5614
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 1092:
+	case 1115:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:5497
+//line sql.y:5620
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1093:
+	case 1116:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:5503
+//line sql.y:5626
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1094:
+	case 1117:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:5507
+//line sql.y:5630
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 1095:
+	case 1118:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5513
+//line sql.y:5636
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 1096:
+	case 1119:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5517
+//line sql.y:5640
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1120:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5521
+//line sql.y:5644
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1121:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5525
+//line sql.y:5648
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1099:
+	case 1122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:5529
+//line sql.y:5652
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1101:
+	case 1124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:5537
+//line sql.y:5660
 		{
 			yyVAL.str = "charset"
 		}
-	case 1104:
+	case 1127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5547
+//line sql.y:5670
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 1105:
+	case 1128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5551
+//line sql.y:5674
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1106:
+	case 1129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:5555
+//line sql.y:5678
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1132:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5564
+//line sql.y:5687
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1110:
+	case 1133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5566
+//line sql.y:5689
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1111:
+	case 1134:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5569
+//line sql.y:5692
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1112:
+	case 1135:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5571
+//line sql.y:5694
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1113:
+	case 1136:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5574
+//line sql.y:5697
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1114:
+	case 1137:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:5576
+//line sql.y:5699
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1115:
+	case 1138:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:5579
+//line sql.y:5702
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1116:
+	case 1139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:5581
+//line sql.y:5704
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1140:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5584
+//line sql.y:5707
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1118:
+	case 1141:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5586
+//line sql.y:5709
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1119:
+	case 1142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5588
+//line sql.y:5711
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 1120:
+	case 1143:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:5592
+//line sql.y:5715
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1121:
+	case 1144:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5597
+//line sql.y:5720
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1122:
+	case 1145:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:5601
+//line sql.y:5724
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1123:
+	case 1146:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:5606
+//line sql.y:5729
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1124:
+	case 1147:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:5608
+//line sql.y:5731
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1125:
+	case 1148:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:5612
+//line sql.y:5735
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 1126:
+	case 1149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5618
+//line sql.y:5741
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 1127:
+	case 1150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5622
+//line sql.y:5745
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 1129:
+	case 1152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5629
+//line sql.y:5752
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 1130:
+	case 1153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5635
+//line sql.y:5758
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 1131:
+	case 1154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5639
+//line sql.y:5762
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1132:
+	case 1155:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5645
+//line sql.y:5768
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 1133:
+	case 1156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5649
+//line sql.y:5772
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 1135:
+	case 1158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5656
+//line sql.y:5779
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1580:
+	case 1608:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6125
+//line sql.y:6253
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1581:
+	case 1609:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6134
+//line sql.y:6262
 		{
 			decNesting(yylex)
 		}
-	case 1582:
+	case 1610:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:6139
+//line sql.y:6267
 		{
 			skipToEnd(yylex)
 		}
-	case 1583:
+	case 1611:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:6144
+//line sql.y:6272
 		{
 			skipToEnd(yylex)
 		}
-	case 1584:
+	case 1612:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6148
+//line sql.y:6276
 		{
 			skipToEnd(yylex)
 		}
-	case 1585:
+	case 1613:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:6152
+//line sql.y:6280
 		{
 			skipToEnd(yylex)
 		}
@@ -173,6 +173,10 @@ func bindVariable(yylex yyLexer, bvar string) {
   partitionByType PartitionByType
   definer 	*Definer
   integer 	int
+  overClause	*OverClause
+  frameClause	*FrameClause
+  framePoint	*FramePoint
+  frameUnit	FrameUnitType
 }
 
 %token LEX_ERROR
@@ -249,6 +253,9 @@ func bindVariable(yylex yyLexer, bvar string) {
 // Migration tokens
 %token <str> VITESS_MIGRATION CANCEL RETRY COMPLETE CLEANUP
 
+// Materialized view tokens
+%token <str> MATERIALIZED REFRESH
+
 // Transaction Tokens
 %token <str> BEGIN START TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE WORK
 
@@ -297,6 +304,9 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token <str> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
 %token <str> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
 
+// Window functions
+%token <str> CURRENT ROW ROWS
+
 // Explain tokens
 %token <str> FORMAT TREE VITESS TRADITIONAL
 
@@ -337,7 +347,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <statement> analyze_statement show_statement use_statement other_statement
 %type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement load_statement
 %type <statement> lock_statement unlock_statement call_statement
-%type <statement> revert_statement
+%type <statement> revert_statement refresh_statement
 %type <strs> comment_opt comment_list
 %type <str> wild_opt check_option_opt cascade_or_local_opt restrict_or_cascade_opt
 %type <explainType> explain_format_opt
@@ -372,6 +382,12 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <ins> insert_data
 %type <expr> num_val
 %type <expr> function_call_keyword function_call_nonkeyword function_call_generic function_call_conflict
+%type <overClause> over_clause over_clause_opt
+%type <exprs> partition_by_opt
+%type <frameClause> frame_clause_opt
+%type <frameUnit> frame_unit
+%type <framePoint> frame_point
+%type <expr> frame_expression
 %type <isExprOperator> is_suffix
 %type <colTuple> col_tuple
 %type <exprs> expression_list expression_list_opt
@@ -512,6 +528,7 @@ command:
 | unlock_statement
 | call_statement
 | revert_statement
+| refresh_statement
 | /*empty*/
 {
   setParseTree(yylex, nil)
@@ -555,11 +572,11 @@ load_statement:
 with_clause:
   WITH with_list
   {
-	$$ = &With{ctes: $2, Recursive: false}
+	$$ = &With{CTEs: $2, Recursive: false}
   }
 | WITH RECURSIVE with_list
   {
-	$$ = &With{ctes: $3, Recursive: true}
+	$$ = &With{CTEs: $3, Recursive: true}
   }
 
 with_clause_opt:
@@ -956,6 +973,10 @@ create_statement:
   {
     $$ = &CreateView{ViewName: $8.ToViewName(), Comments: Comments($2), IsReplace:$3, Algorithm:$4, Definer: $5 ,Security:$6, Columns:$9, Select: $11, CheckOption: $12 }
   }
+| CREATE comment_opt MATERIALIZED VIEW table_name AS select_statement
+  {
+    $$ = &MaterializedViewDDL{Action: CreateDDLAction, ViewName: $5.ToViewName(), Select: $7}
+  }
 | create_database_prefix create_options_opt
   {
     $1.FullyParsed = true
@@ -3096,6 +3117,10 @@ drop_statement:
   {
     $$ = &DropView{FromTables: $5, Comments: Comments($2), IfExists: $4}
   }
+| DROP comment_opt MATERIALIZED VIEW exists_opt table_name
+  {
+    $$ = &MaterializedViewDDL{Action: DropDDLAction, ViewName: $6.ToViewName(), IfExists: $5}
+  }
 | DROP comment_opt database_or_schema exists_opt table_id
   {
     $$ = &DropDatabase{Comments: Comments($2), DBName: $5, IfExists: $4}
@@ -3270,6 +3295,14 @@ show_statement:
   {
     $$ = &Show{&ShowBasic{Command: VitessMigrations, Filter: $4, DbName: $3}}
   }
+| SHOW MATERIALIZED sql_id from_database_opt like_or_where_opt
+  {
+    if $3.Lowered() != "views" {
+      yylex.Error("expecting views after materialized")
+      return 1
+    }
+    $$ = &Show{&ShowBasic{Command: MaterializedViews, Filter: $5, DbName: $4}}
+  }
 | SHOW VITESS_MIGRATION STRING LOGS
   {
     $$ = &ShowMigrationLogs{UUID: string($3)}
@@ -3620,6 +3653,12 @@ revert_statement:
     $$ = &RevertMigration{Comments: Comments($2), UUID: string($4)}
   }
 
+refresh_statement:
+  REFRESH MATERIALIZED VIEW table_name
+  {
+    $$ = &MaterializedViewDDL{Action: RefreshDDLAction, ViewName: $4.ToViewName()}
+  }
+
 flush_statement:
   FLUSH local_opt flush_option_list
   {
@@ -4565,9 +4604,9 @@ expression_list:
   introduce side effects due to being a simple identifier
 */
 function_call_generic:
-  sql_id openb select_expression_list_opt closeb
+  sql_id openb select_expression_list_opt closeb over_clause_opt
   {
-    $$ = &FuncExpr{Name: $1, Exprs: $3}
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
   }
 | sql_id openb DISTINCT select_expression_list closeb
   {
@@ -4582,6 +4621,90 @@ function_call_generic:
     $$ = &FuncExpr{Qualifier: $1, Name: $3, Exprs: $5}
   }
 
+over_clause_opt:
+  {
+    $$ = nil
+  }
+| over_clause
+  {
+    $$ = $1
+  }
+
+over_clause:
+  OVER openb partition_by_opt order_by_opt frame_clause_opt closeb
+  {
+    $$ = &OverClause{PartitionBy: $3, OrderBy: $4, Frame: $5}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsUnit
+  }
+| RANGE
+  {
+    $$ = RangeUnit
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowType}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingType}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingType}
+  }
+| frame_expression PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: $1}
+  }
+| frame_expression FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: $1}
+  }
+
+frame_expression:
+  INTEGRAL
+  {
+    $$ = NewIntLiteral($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewArgument($1[1:])
+    bindVariable(yylex, $1[1:])
+  }
+| INTERVAL simple_expr sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
 /*
   Function calls using reserved keywords, with dedicated grammar rules
   as a result
@@ -5857,6 +5980,7 @@ non_reserved_keyword:
 | CONNECTION
 | COPY
 | CSV
+| CURRENT
 | DATA
 | DATE
 | DATETIME
@@ -5945,6 +6069,7 @@ non_reserved_keyword:
 | MASTER_PUBLIC_KEY_PATH
 | MASTER_TLS_CIPHERSUITES
 | MASTER_ZSTD_COMPRESSION_LEVEL
+| MATERIALIZED
 | MAX_ROWS
 | MEDIUMBLOB
 | MEDIUMINT
@@ -6005,6 +6130,7 @@ non_reserved_keyword:
 | REDUNDANT
 | REFERENCE
 | REFERENCES
+| REFRESH
 | RELAY
 | REMOVE
 | REORGANIZE
@@ -6020,7 +6146,9 @@ non_reserved_keyword:
 | REUSE
 | ROLE
 | ROLLBACK
+| ROW
 | ROW_FORMAT
+| ROWS
 | S3
 | SECONDARY
 | SECONDARY_ENGINE
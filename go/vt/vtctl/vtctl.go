@@ -483,6 +483,12 @@ var commands = []commandGroup{
 				params: "<keyspace>.<vindex>",
 				help:   `Externalize a backfilled vindex.`,
 			},
+			{
+				name:   "LookupVindexDiff",
+				method: commandLookupVindexDiff,
+				params: "[-cell=<cell>] [-tablet_types=<tablet_types>] [-format=json] [-repair] <keyspace>.<vindex>",
+				help:   `Compare a lookup vindex with the table that owns it, and report the owner rows missing from the lookup table, the orphan lookup rows, and the lookup rows pointing to the wrong keyspace id. With -repair, the lookup table is fixed.`,
+			},
 			{
 				name:   "Materialize",
 				method: commandMaterialize,
@@ -2653,6 +2659,21 @@ func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFla
 	return wr.ExternalizeVindex(ctx, subFlags.Arg(0))
 }
 
+func commandLookupVindexDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cell := subFlags.String("cell", "", "The cell to stream the owner and lookup tables from; default is any available cell")
+	tabletTypes := subFlags.String("tablet_types", "primary,replica,rdonly", "Tablet types to stream the owner and lookup tables from")
+	format := subFlags.String("format", "", "Format of report") //"json" or ""
+	repair := subFlags.Bool("repair", false, "Insert the missing lookup rows, and delete or update the orphan and mismatched ones")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("one argument is required: keyspace.vindex")
+	}
+	_, err := wr.LookupVindexDiff(ctx, subFlags.Arg(0), *cell, *tabletTypes, *format, *repair)
+	return err
+}
+
 func commandMaterialize(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	cells := subFlags.String("cells", "", "Source cells to replicate from.")
 	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
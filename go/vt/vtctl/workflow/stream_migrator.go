@@ -684,11 +684,13 @@ func (sm *StreamMigrator) templatizeKeyRange(ctx context.Context, rule *binlogda
 		}
 
 		var krExpr sqlparser.SelectExpr
-		switch len(funcExpr.Exprs) {
-		case 1:
+		switch {
+		case len(funcExpr.Exprs) == 1:
 			krExpr = funcExpr.Exprs[0]
-		case 3:
-			krExpr = funcExpr.Exprs[2]
+		case len(funcExpr.Exprs) >= 3:
+			// The vindex columns, of which a multi-column vindex has several,
+			// are followed by the vindex and the key range.
+			krExpr = funcExpr.Exprs[len(funcExpr.Exprs)-1]
 		default:
 			return fmt.Errorf("unexpected in_keyrange parameters: %v", sqlparser.String(funcExpr))
 		}
@@ -714,13 +716,24 @@ func (sm *StreamMigrator) templatizeKeyRange(ctx context.Context, rule *binlogda
 
 	// There was no in_keyrange expression. Create a new one.
 	vtable := sm.ts.SourceKeyspaceSchema().Tables[rule.Match]
+	cv := vtable.ColumnVindexes[0]
+	inkrExprs := make(sqlparser.SelectExprs, 0, len(cv.Columns)+2)
+	for _, col := range cv.Columns {
+		inkrExprs = append(inkrExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: col}})
+	}
+	vindexName := cv.Type
+	if len(cv.Columns) > 1 {
+		// A multi-column vindex can't be created from its type alone:
+		// refer to the one of the source keyspace instead.
+		vindexName = fmt.Sprintf("%s.%s", sm.ts.SourceKeyspaceName(), cv.Name)
+	}
+	inkrExprs = append(inkrExprs,
+		&sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral(vindexName)},
+		&sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral("{{.}}")},
+	)
 	inkr := &sqlparser.FuncExpr{
-		Name: sqlparser.NewColIdent("in_keyrange"),
-		Exprs: sqlparser.SelectExprs{
-			&sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: vtable.ColumnVindexes[0].Columns[0]}},
-			&sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral(vtable.ColumnVindexes[0].Type)},
-			&sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral("{{.}}")},
-		},
+		Name:  sqlparser.NewColIdent("in_keyrange"),
+		Exprs: inkrExprs,
 	}
 	sel.AddWhere(inkr)
 	rule.Filter = sqlparser.String(statement)
@@ -201,6 +201,32 @@ func TestTemplatize(t *testing.T) {
 			},
 		}},
 		out: `[{"ID":0,"Workflow":"","BinlogSource":{"filter":{"rules":[{"match":"t1","filter":"select * from t1 where in_keyrange(col, vdx, '{{.}}')"}]}}}]`,
+	}, {
+		// Select expression with no keyrange value on a multi-column vindex
+		in: []*VReplicationStream{{
+			BinlogSource: &binlogdatapb.BinlogSource{
+				Filter: &binlogdatapb.Filter{
+					Rules: []*binlogdatapb.Rule{{
+						Match:  "tmc",
+						Filter: "select * from tmc",
+					}},
+				},
+			},
+		}},
+		out: `[{"ID":0,"Workflow":"","BinlogSource":{"filter":{"rules":[{"match":"tmc","filter":"select * from tmc where in_keyrange(c1, c2, 'ks.mc', '{{.}}')"}]}}}]`,
+	}, {
+		// Select expression with the keyrange of a multi-column vindex
+		in: []*VReplicationStream{{
+			BinlogSource: &binlogdatapb.BinlogSource{
+				Filter: &binlogdatapb.Filter{
+					Rules: []*binlogdatapb.Rule{{
+						Match:  "tmc",
+						Filter: "select * from tmc where in_keyrange(c1, c2, 'ks.mc', '-80')",
+					}},
+				},
+			},
+		}},
+		out: `[{"ID":0,"Workflow":"","BinlogSource":{"filter":{"rules":[{"match":"tmc","filter":"select * from tmc where in_keyrange(c1, c2, 'ks.mc', '{{.}}')"}]}}}]`,
 	}, {
 		// syntax error
 		in: []*VReplicationStream{{
@@ -286,6 +312,10 @@ func TestTemplatize(t *testing.T) {
 			"thash": {
 				Type: "hash",
 			},
+			"mc": {
+				Type:   "multicol",
+				Params: map[string]string{"column_count": "2"},
+			},
 		},
 		Tables: map[string]*vschema.Table{
 			"t1": {
@@ -300,6 +330,12 @@ func TestTemplatize(t *testing.T) {
 					Name:    "thash",
 				}},
 			},
+			"tmc": {
+				ColumnVindexes: []*vschema.ColumnVindex{{
+					Columns: []string{"c1", "c2"},
+					Name:    "mc",
+				}},
+			},
 			"ref": {
 				Type: vindexes.TypeReference,
 			},
@@ -33,6 +33,10 @@ func (tts *testTrafficSwitcher) SourceKeyspaceSchema() *vindexes.KeyspaceSchema
 	return tts.sourceKeyspaceSchema
 }
 
+func (tts *testTrafficSwitcher) SourceKeyspaceName() string {
+	return tts.sourceKeyspaceSchema.Keyspace.Name
+}
+
 func TestReverseWorkflowName(t *testing.T) {
 	tests := []struct {
 		in  string
@@ -0,0 +1,141 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var (
+	materializedViewCheckTicks *timer.Timer
+	materializedViewOnce       sync.Once
+)
+
+var (
+	materializedViewCheckInterval = flag.Duration("materialized_view_check_interval", time.Minute, "interval polling for new materialized view requests")
+)
+
+func initMaterializedViewManager(ts *topo.Server) {
+	tmClient := tmclient.NewTabletManagerClient()
+	materializedViewCheckTicks = timer.NewTimer(*materializedViewCheckInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	materializedViewCheckTicks.Start(func() { onMaterializedViewCheckTick(ctx, ts, tmClient) })
+
+	go func() {
+		<-ctx.Done()
+		materializedViewCheckTicks.Stop()
+	}()
+
+	servenv.OnTermSync(cancel)
+}
+
+func reviewMaterializedViewRequest(ctx context.Context, ts *topo.Server, tmClient tmclient.TabletManagerClient, conn topo.Conn, uuid string) error {
+	if !schema.IsOnlineDDLUUID(uuid) {
+		// Just some other entry in this path, e.g. a sentry or a placeholder.
+		return nil
+	}
+	entryPath := fmt.Sprintf("%s/%s", schema.MaterializedViewRequestsPath(), uuid)
+	mv, err := schema.ReadMaterializedViewTopo(ctx, conn, entryPath)
+	if err != nil {
+		return err
+	}
+	log.Infof("Found materialized view request: %+v", mv)
+
+	// The request is removed whether it succeeds or not: a failed request would
+	// fail the same way on the next tick, or leave a half created workflow behind
+	// that would then make it fail.
+	defer func() {
+		if err := conn.Delete(ctx, entryPath, nil); err != nil {
+			log.Errorf("vtctld.reviewMaterializedViewRequest unable to delete %+v, error: %s", entryPath, err)
+		}
+	}()
+
+	stmt, err := mv.Statement()
+	if err != nil {
+		return err
+	}
+	logstream := logutil.NewMemoryLogger()
+	wr := wrangler.New(logstream, ts, tmClient)
+	switch stmt.Action {
+	case sqlparser.CreateDDLAction:
+		err = wr.CreateMaterializedView(ctx, mv)
+	case sqlparser.DropDDLAction:
+		err = wr.DropMaterializedView(ctx, mv)
+	case sqlparser.RefreshDDLAction:
+		err = wr.RefreshMaterializedView(ctx, mv)
+	default:
+		err = fmt.Errorf("unsupported materialized view action: %s", mv.SQL)
+	}
+	if err != nil {
+		// The failure is kept in topo so that SHOW MATERIALIZED VIEWS reports it.
+		if recordErr := mv.WriteFailureTopo(ctx, conn, err); recordErr != nil {
+			log.Errorf("vtctld.reviewMaterializedViewRequest unable to record failure of %s: %s", mv.ToString(), recordErr)
+		}
+		return fmt.Errorf("%s: %v", mv.ToString(), err)
+	}
+	return mv.DeleteFailureTopo(ctx, conn)
+}
+
+func onMaterializedViewCheckTick(ctx context.Context, ts *topo.Server, tmClient tmclient.TabletManagerClient) {
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		log.Errorf("vtctld.onMaterializedViewCheckTick ConnForCell error: %s", err.Error())
+		return
+	}
+
+	materializedViewOnce.Do(func() {
+		// This creates the directory schema.MaterializedViewRequestsPath(), once, so that it can be listed
+		// without errors even if no materialized view has ever been requested.
+		_, err := conn.Create(ctx, fmt.Sprintf("%s/sentry", schema.MaterializedViewRequestsPath()), []byte{})
+		if err != nil && !topo.IsErrType(err, topo.NodeExists) {
+			log.Errorf("vtctld.onMaterializedViewCheckTick Create sentry error: %s", err.Error())
+		}
+	})
+
+	lockDescriptor, err := conn.Lock(ctx, schema.MaterializedViewRequestsPath(), "vtctld.onMaterializedViewCheckTick")
+	if err != nil {
+		log.Errorf("vtctld.onMaterializedViewCheckTick Lock error: %s", err.Error())
+		return
+	}
+	defer lockDescriptor.Unlock(ctx)
+
+	entries, err := conn.ListDir(ctx, schema.MaterializedViewRequestsPath(), true)
+	if err != nil {
+		log.Errorf("vtctld.onMaterializedViewCheckTick listDir error: %s", err.Error())
+		return
+	}
+	for _, entry := range entries {
+		if err := reviewMaterializedViewRequest(ctx, ts, tmClient, conn, entry.Name); err != nil {
+			log.Errorf("vtctld.reviewMaterializedViewRequest %s error: %s", entry.Name, err.Error())
+		}
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctld
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+var (
+	referenceTableCheckTicks *timer.Timer
+	referenceTableOnce       sync.Once
+)
+
+var (
+	referenceTableCheckInterval = flag.Duration("reference_table_request_check_interval", time.Minute, "interval polling for requests to copy global reference tables")
+)
+
+func initReferenceTableManager(ts *topo.Server) {
+	tmClient := tmclient.NewTabletManagerClient()
+	referenceTableCheckTicks = timer.NewTimer(*referenceTableCheckInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	referenceTableCheckTicks.Start(func() { onReferenceTableCheckTick(ctx, ts, tmClient) })
+
+	go func() {
+		<-ctx.Done()
+		referenceTableCheckTicks.Stop()
+	}()
+
+	servenv.OnTermSync(cancel)
+}
+
+func reviewReferenceTableRequest(ctx context.Context, ts *topo.Server, tmClient tmclient.TabletManagerClient, conn topo.Conn, name string) error {
+	if !strings.Contains(name, ".") {
+		// Just some other entry in this path, e.g. a sentry or a placeholder.
+		return nil
+	}
+	entryPath := fmt.Sprintf("%s/%s", schema.ReferenceTableRequestsPath(), name)
+	rt, err := schema.ReadReferenceTableTopo(ctx, conn, entryPath)
+	if err != nil {
+		return err
+	}
+	log.Infof("Found reference table request: %+v", rt)
+
+	// The request is removed whether it succeeds or not: vtgate requests the copy
+	// again if it is still missing later on.
+	defer func() {
+		if err := conn.Delete(ctx, entryPath, nil); err != nil {
+			log.Errorf("vtctld.reviewReferenceTableRequest unable to delete %+v, error: %s", entryPath, err)
+		}
+	}()
+
+	logstream := logutil.NewMemoryLogger()
+	wr := wrangler.New(logstream, ts, tmClient)
+	if err := wr.CreateReferenceTableCopy(ctx, rt); err != nil {
+		return fmt.Errorf("%s: %v", rt.ToString(), err)
+	}
+	return nil
+}
+
+func onReferenceTableCheckTick(ctx context.Context, ts *topo.Server, tmClient tmclient.TabletManagerClient) {
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		log.Errorf("vtctld.onReferenceTableCheckTick ConnForCell error: %s", err.Error())
+		return
+	}
+
+	referenceTableOnce.Do(func() {
+		// This creates the directory schema.ReferenceTableRequestsPath(), once, so that it can be listed
+		// without errors even if no reference table copy has ever been requested.
+		_, err := conn.Create(ctx, fmt.Sprintf("%s/sentry", schema.ReferenceTableRequestsPath()), []byte{})
+		if err != nil && !topo.IsErrType(err, topo.NodeExists) {
+			log.Errorf("vtctld.onReferenceTableCheckTick Create sentry error: %s", err.Error())
+		}
+	})
+
+	lockDescriptor, err := conn.Lock(ctx, schema.ReferenceTableRequestsPath(), "vtctld.onReferenceTableCheckTick")
+	if err != nil {
+		log.Errorf("vtctld.onReferenceTableCheckTick Lock error: %s", err.Error())
+		return
+	}
+	defer lockDescriptor.Unlock(ctx)
+
+	entries, err := conn.ListDir(ctx, schema.ReferenceTableRequestsPath(), true)
+	if err != nil {
+		log.Errorf("vtctld.onReferenceTableCheckTick listDir error: %s", err.Error())
+		return
+	}
+	for _, entry := range entries {
+		if err := reviewReferenceTableRequest(ctx, ts, tmClient, conn, entry.Name); err != nil {
+			log.Errorf("vtctld.reviewReferenceTableRequest %s error: %s", entry.Name, err.Error())
+		}
+	}
+}
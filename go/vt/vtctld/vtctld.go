@@ -165,6 +165,12 @@ func InitVtctld(ts *topo.Server) error {
 	// Init online DDL schema manager
 	initSchemaManager(ts)
 
+	// Init materialized view manager
+	initMaterializedViewManager(ts)
+
+	// Init the manager of the copies of global reference tables
+	initReferenceTableManager(ts)
+
 	// Setup reverse proxy for all vttablets through /vttablet/.
 	initVTTabletRedirection(ts)
 
@@ -27,6 +27,23 @@ const (
 // RxOp regex for operation not allowed error
 var RxOp = regexp.MustCompile("operation not allowed in state (NOT_SERVING|SHUTTING_DOWN)")
 
+// Errors caused by a cutover of a whole keyspace
+const (
+	// DeniedTables is the name of the query rule which vttablet installs for
+	// the tables that a MoveTables workflow has switched writes away from.
+	DeniedTables = "enforce denied tables"
+	// KeyspaceResharding is returned by vtgate while the primary of a shard is
+	// not available because its keyspace is being resharded.
+	KeyspaceResharding = "current keyspace is being resharded"
+)
+
+// RxCutover regex for errors caused by a resharding or MoveTables cutover
+var RxCutover = regexp.MustCompile("(enforce denied tables|current keyspace is being resharded)")
+
+// ReadAfterWriteTimeout is returned by a replica that has not applied the
+// writes of a session in time, vtgate then reads from the primary instead.
+const ReadAfterWriteTimeout = "replica has not caught up with the session's writes"
+
 // WrongTablet for invalid tablet type error
 const WrongTablet = "wrong tablet type"
 
@@ -20,6 +20,11 @@ limitations under the License.
 // becomes unavailable), the buffer will automatically retry buffered requests
 // after the end of the failover was detected.
 //
+// The same mechanism is used for the cutover of a resharding or MoveTables
+// workflow. In that case only the requests which ran into the cutover are
+// held, until the keyspace is consistent again (resharding) or until the
+// routing rules point to the new keyspace (MoveTables).
+//
 // Buffering (stalling) requests will increase the number of requests in flight
 // within vtgate and at upstream layers. Therefore, it is important to limit
 // the size of the buffer and the buffering duration (window) per request.
@@ -29,6 +34,7 @@ package buffer
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
 	"vitess.io/vitess/go/sync2"
@@ -43,6 +49,7 @@ import (
 
 var (
 	ShardMissingError    = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "destination shard is missing after a resharding operation")
+	RoutingChangedError  = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "routing rules changed during the cutover, the query must be planned again")
 	bufferFullError      = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "primary buffer is full")
 	entryEvictedError    = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "buffer full: request evicted for newer request")
 	contextCanceledError = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "context was canceled before failover finished")
@@ -74,6 +81,20 @@ func CausedByFailover(err error) bool {
 	return vterrors.Code(err) == vtrpcpb.Code_CLUSTER_EVENT
 }
 
+// CausedByCutover returns true if "err" was returned because the keyspace is
+// in the middle of a cutover: either its shards are being resharded or the
+// tables were denied on the source keyspace of a MoveTables workflow.
+func CausedByCutover(err error) bool {
+	if err == nil {
+		return false
+	}
+	code := vterrors.Code(err)
+	if code != vtrpcpb.Code_CLUSTER_EVENT && code != vtrpcpb.Code_FAILED_PRECONDITION {
+		return false
+	}
+	return vterrors.RxCutover.MatchString(err.Error())
+}
+
 // Buffer is used to track ongoing PRIMARY tablet failovers and buffer
 // requests while the PRIMARY tablet is unavailable.
 // Once the new PRIMARY starts accepting requests, buffering stops and requests
@@ -100,6 +121,9 @@ type Buffer struct {
 	// progress.
 	// Key Format: "<keyspace>/<shard>"
 	buffers map[string]*shardBuffer
+	// routedAway holds the keyspaces whose tables the routing rules have moved
+	// to another keyspace, i.e. the sources of switched MoveTables workflows.
+	routedAway map[string]bool
 	// stopped is true after Shutdown() was run.
 	stopped bool
 }
@@ -110,6 +134,7 @@ func New(cfg *Config) *Buffer {
 		config:         cfg,
 		bufferSizeSema: sync2.NewSemaphore(cfg.Size, 0),
 		buffers:        make(map[string]*shardBuffer),
+		routedAway:     make(map[string]bool),
 	}
 }
 
@@ -123,7 +148,7 @@ func New(cfg *Config) *Buffer {
 func (b *Buffer) WaitForFailoverEnd(ctx context.Context, keyspace, shard string, err error) (RetryDoneFunc, error) {
 	// If an err is given, it must be related to a failover.
 	// We never buffer requests with other errors.
-	if err != nil && !CausedByFailover(err) {
+	if err != nil && !CausedByFailover(err) && !CausedByCutover(err) {
 		return nil, nil
 	}
 
@@ -138,7 +163,15 @@ func (b *Buffer) WaitForFailoverEnd(ctx context.Context, keyspace, shard string,
 		return nil, nil
 	}
 
-	return sb.waitForFailoverEnd(ctx, keyspace, shard, err)
+	cutover := CausedByCutover(err)
+	if cutover && strings.Contains(err.Error(), vterrors.DeniedTables) && b.isRoutedAway(keyspace) {
+		// The request was planned before the routing rules were switched to the
+		// target keyspace. No routing change is left to end the buffering, so the
+		// request has to be planned again right away.
+		return nil, RoutingChangedError
+	}
+
+	return sb.waitForFailoverEnd(ctx, keyspace, shard, err, cutover)
 }
 
 // ProcessPrimaryHealth notifies the buffer to record a new primary
@@ -162,6 +195,9 @@ func (b *Buffer) ProcessPrimaryHealth(th *discovery.TabletHealth) {
 	sb.recordExternallyReparentedTimestamp(timestamp, th.Tablet.Alias)
 }
 
+// HandleKeyspaceEvent notifies the buffer that the keyspace is consistent
+// again. Buffering stops for all shards listed in the event, and a cutover
+// of the keyspace (e.g. a resharding) is considered to be over.
 func (b *Buffer) HandleKeyspaceEvent(ksevent *discovery.KeyspaceEvent) {
 	for _, shard := range ksevent.Shards {
 		sb := b.getOrCreateBuffer(shard.Target.Keyspace, shard.Target.Shard)
@@ -169,6 +205,47 @@ func (b *Buffer) HandleKeyspaceEvent(ksevent *discovery.KeyspaceEvent) {
 			sb.recordKeyspaceEvent(shard.Tablet, shard.Serving)
 		}
 	}
+	b.endCutover(ksevent.Keyspace, stopCutoverComplete, "the keyspace is consistent again")
+}
+
+// HandleRoutingRulesChange notifies the buffer that the routing rules for
+// tables of the "changed" keyspaces have changed, which completes the cutover
+// of a MoveTables workflow. Buffered requests of these keyspaces fail with
+// RoutingChangedError such that they are planned again with the new rules.
+// "routedAway" lists all keyspaces whose tables are now routed to another
+// keyspace.
+func (b *Buffer) HandleRoutingRulesChange(changed, routedAway []string) {
+	b.mu.Lock()
+	b.routedAway = make(map[string]bool, len(routedAway))
+	for _, keyspace := range routedAway {
+		b.routedAway[keyspace] = true
+	}
+	b.mu.Unlock()
+
+	for _, keyspace := range changed {
+		b.endCutover(keyspace, stopRoutingChanged, "the routing rules have changed")
+	}
+}
+
+// isRoutedAway returns true if the routing rules point the tables of
+// "keyspace" to another keyspace.
+func (b *Buffer) isRoutedAway(keyspace string) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.routedAway[keyspace]
+}
+
+// endCutover stops the buffering of all shards of "keyspace" which were
+// buffering because of a cutover.
+func (b *Buffer) endCutover(keyspace string, reason stopReason, details string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, sb := range b.buffers {
+		if sb.keyspace == keyspace {
+			sb.recordCutoverEnd(reason, details)
+		}
+	}
 }
 
 // StatsUpdate keeps track of the "tablet_externally_reparented_timestamp" of
@@ -89,9 +89,34 @@ func issueRequestAndBlockRetry(ctx context.Context, t *testing.T, b *Buffer, err
 	return bufferingStopped
 }
 
+// issueShardRequest is the same as issueRequest() but the request goes to the
+// given keyspace and shard and passes the given error to the buffer.
+func issueShardRequest(ctx context.Context, b *Buffer, keyspace, shard string, err error) chan error {
+	bufferingStopped := make(chan error)
+
+	go func() {
+		retryDone, err := b.WaitForFailoverEnd(ctx, keyspace, shard, err)
+		if err != nil {
+			bufferingStopped <- err
+		}
+		if retryDone != nil {
+			defer retryDone()
+		}
+		defer close(bufferingStopped)
+	}()
+
+	return bufferingStopped
+}
+
 // waitForRequestsInFlight blocks until the buffer queue has reached "count".
 // This check is potentially racy and therefore retried up to a timeout of 10s.
 func waitForRequestsInFlight(b *Buffer, count int) error {
+	return waitForShardRequestsInFlight(b, keyspace, shard, count)
+}
+
+// waitForShardRequestsInFlight is the same as waitForRequestsInFlight() for
+// the buffer of the given keyspace and shard.
+func waitForShardRequestsInFlight(b *Buffer, keyspace, shard string, count int) error {
 	start := time.Now()
 	sb := b.getOrCreateBuffer(keyspace, shard)
 	for {
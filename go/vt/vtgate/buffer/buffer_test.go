@@ -23,6 +23,8 @@ import (
 	"testing"
 	"time"
 
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
@@ -42,6 +44,9 @@ var (
 		"vttablet: rpc error: code = 17 desc = gRPCServerError: retry: operation not allowed in state SHUTTING_DOWN")
 	nonFailoverErr = vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION,
 		"vttablet: rpc error: code = 9 desc = gRPCServerError: retry: TODO(mberlin): Insert here any realistic error not caused by a failover")
+	deniedTablesErr = vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION,
+		"vttablet: rpc error: code = FailedPrecondition desc = disallowed due to rule: enforce denied tables")
+	reshardingErr = vterrors.New(vtrpcpb.Code_CLUSTER_EVENT, vterrors.KeyspaceResharding)
 
 	statsKeyJoined = fmt.Sprintf("%s.%s", keyspace, shard)
 
@@ -782,3 +787,131 @@ func testShutdown1(t *testing.T, fail failover) {
 		t.Fatal(err)
 	}
 }
+
+func TestCausedByCutover(t *testing.T) {
+	testcases := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{failoverErr, false},
+		{nonFailoverErr, false},
+		{deniedTablesErr, true},
+		{reshardingErr, true},
+		{vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: enforce denied tables"), false},
+	}
+	for _, tc := range testcases {
+		if got := CausedByCutover(tc.err); got != tc.want {
+			t.Errorf("CausedByCutover(%v) = %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+// TestMoveTablesCutover tests that the requests which hit a denied table are
+// held until the routing rules changed and that the buffered requests are
+// asked to plan their query again.
+func TestMoveTablesCutover(t *testing.T) {
+	resetVariables()
+	defer checkVariables(t)
+
+	cfg := NewDefaultConfig()
+	cfg.Enabled = true
+	b := New(cfg)
+
+	// The first request which hits a denied table starts the cutover.
+	stopped1 := issueShardRequest(context.Background(), b, keyspace, shard, deniedTablesErr)
+	if err := waitForShardRequestsInFlight(b, keyspace, shard, 1); err != nil {
+		t.Fatal(err)
+	}
+	stopped2 := issueShardRequest(context.Background(), b, keyspace, shard2, deniedTablesErr)
+	if err := waitForShardRequestsInFlight(b, keyspace, shard2, 1); err != nil {
+		t.Fatal(err)
+	}
+	// Requests which did not run into the cutover pass through, whether they
+	// are for the same shard, for another shard or for another keyspace.
+	for _, target := range []struct{ keyspace, shard string }{{keyspace, shard}, {keyspace, "80-"}, {"ks2", shard}} {
+		if retryDone, err := b.WaitForFailoverEnd(context.Background(), target.keyspace, target.shard, nil); err != nil || retryDone != nil {
+			t.Fatalf("requests without a cutover error must not be buffered. err: %v retryDone: %v", err, retryDone)
+		}
+	}
+
+	// Routing rules of other keyspaces do not end the cutover.
+	b.HandleRoutingRulesChange([]string{"ks2"}, []string{"ks2"})
+	if err := waitForShardRequestsInFlight(b, keyspace, shard, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	b.HandleRoutingRulesChange([]string{keyspace, "target"}, []string{keyspace})
+	for _, stopped := range []chan error{stopped1, stopped2} {
+		if err := <-stopped; vterrors.RootCause(err) != RoutingChangedError {
+			t.Fatalf("buffered request should have been told to plan again: %v", err)
+		}
+	}
+	if got, want := stops.Counts()[statsKeyJoined+"."+string(stopRoutingChanged)], int64(1); got != want {
+		t.Fatalf("buffering stop was not tracked: got = %v, want = %v", got, want)
+	}
+	if err := waitForState(b, stateIdle); err != nil {
+		t.Fatal(err)
+	}
+	if err := waitForPoolSlots(b, cfg.Size); err != nil {
+		t.Fatal(err)
+	}
+
+	// The routing rules already point away from the keyspace: a request which
+	// was planned with the old rules is not buffered but planned again.
+	if retryDone, err := b.WaitForFailoverEnd(context.Background(), keyspace, shard, deniedTablesErr); err != RoutingChangedError || retryDone != nil {
+		t.Fatalf("request should have been told to plan again. err: %v retryDone: %v", err, retryDone)
+	}
+	if err := waitForState(b, stateIdle); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestReshardingCutover tests that the requests of a keyspace which is being
+// resharded are held until the keyspace is consistent again.
+func TestReshardingCutover(t *testing.T) {
+	resetVariables()
+	defer checkVariables(t)
+
+	cfg := NewDefaultConfig()
+	cfg.Enabled = true
+	b := New(cfg)
+
+	stopped1 := issueShardRequest(context.Background(), b, keyspace, shard, reshardingErr)
+	if err := waitForShardRequestsInFlight(b, keyspace, shard, 1); err != nil {
+		t.Fatal(err)
+	}
+	stopped2 := issueShardRequest(context.Background(), b, keyspace, shard2, reshardingErr)
+	if err := waitForShardRequestsInFlight(b, keyspace, shard2, 1); err != nil {
+		t.Fatal(err)
+	}
+	// Requests which did not run into the cutover pass through.
+	if retryDone, err := b.WaitForFailoverEnd(context.Background(), keyspace, shard2, nil); err != nil || retryDone != nil {
+		t.Fatalf("requests without a cutover error must not be buffered. err: %v retryDone: %v", err, retryDone)
+	}
+
+	// The resharded shard is gone, the other shard is still serving.
+	b.HandleKeyspaceEvent(&discovery.KeyspaceEvent{
+		Keyspace: keyspace,
+		Shards: []discovery.ShardEvent{
+			{
+				Tablet:  oldPrimary.Alias,
+				Target:  &query.Target{Keyspace: keyspace, Shard: shard, TabletType: topodatapb.TabletType_PRIMARY},
+				Serving: false,
+			},
+		},
+	})
+
+	if err := <-stopped1; vterrors.RootCause(err) != ShardMissingError {
+		t.Fatalf("request for the resharded shard should have been told that the shard is missing: %v", err)
+	}
+	if err := <-stopped2; err != nil {
+		t.Fatalf("request should have been buffered and not returned an error: %v", err)
+	}
+	if got, want := stops.Counts()[fmt.Sprintf("%s.%s.%s", keyspace, shard2, stopCutoverComplete)], int64(1); got != want {
+		t.Fatalf("buffering stop was not tracked: got = %v, want = %v", got, want)
+	}
+	if err := waitForPoolSlots(b, cfg.Size); err != nil {
+		t.Fatal(err)
+	}
+}
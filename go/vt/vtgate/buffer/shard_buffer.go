@@ -108,6 +108,9 @@ type shardBuffer struct {
 	lastReparent time.Time
 	// currentPrimary is tracked to determine when to update "lastReparent".
 	currentPrimary *topodatapb.TabletAlias
+	// cutover is true if the current buffering was started because of a
+	// resharding or MoveTables cutover and not because of a failover.
+	cutover bool
 	// timeoutThread will be set while a failover is in progress and the object is
 	// in the BUFFERING state.
 	timeoutThread *timeoutThread
@@ -141,8 +144,8 @@ func (sb *shardBuffer) disabled() bool {
 	return sb.mode == bufferModeDisabled
 }
 
-func (sb *shardBuffer) waitForFailoverEnd(ctx context.Context, keyspace, shard string, err error) (RetryDoneFunc, error) {
-	// We assume if err != nil then it's always caused by a failover.
+func (sb *shardBuffer) waitForFailoverEnd(ctx context.Context, keyspace, shard string, err error, cutover bool) (RetryDoneFunc, error) {
+	// We assume if err != nil then it's always caused by a failover or cutover.
 	// Other errors must be filtered at higher layers.
 	failoverDetected := err != nil
 
@@ -215,7 +218,7 @@ func (sb *shardBuffer) waitForFailoverEnd(ctx context.Context, keyspace, shard s
 			return nil, nil
 		}
 
-		sb.startBufferingLocked(err)
+		sb.startBufferingLocked(err, cutover)
 	}
 
 	if sb.mode == bufferModeDryRun {
@@ -246,8 +249,9 @@ func (sb *shardBuffer) shouldBufferLocked(failoverDetected bool) bool {
 		// Not buffering yet, but new failover detected.
 		return true
 	case s == stateBuffering:
-		// Failover in progress.
-		return true
+		// Failover in progress. A cutover only holds the requests which ran
+		// into it: the requests for tables which are not part of it can pass.
+		return failoverDetected || !sb.cutover
 	case s == stateDraining && !failoverDetected:
 		// Draining. Non-failover related requests can pass through.
 		return false
@@ -259,7 +263,7 @@ func (sb *shardBuffer) shouldBufferLocked(failoverDetected bool) bool {
 	panic("BUG: All possible states must be covered by the switch expression above.")
 }
 
-func (sb *shardBuffer) startBufferingLocked(err error) {
+func (sb *shardBuffer) startBufferingLocked(err error, cutover bool) {
 	// Reset monitoring data from previous failover.
 	lastRequestsInFlightMax.Set(sb.statsKey, 0)
 	lastRequestsDryRunMax.Set(sb.statsKey, 0)
@@ -268,6 +272,7 @@ func (sb *shardBuffer) startBufferingLocked(err error) {
 	sb.lastStart = sb.timeNow()
 	sb.logErrorIfStateNotLocked(stateIdle)
 	sb.state = stateBuffering
+	sb.cutover = cutover
 	sb.queue = make([]*entry, 0)
 
 	sb.timeoutThread = newTimeoutThread(sb, sb.buf.config.MaxFailoverDuration)
@@ -493,6 +498,17 @@ func (sb *shardBuffer) recordKeyspaceEvent(alias *topodatapb.TabletAlias, stillS
 	}
 }
 
+// recordCutoverEnd stops buffering if it was started because of a cutover.
+func (sb *shardBuffer) recordCutoverEnd(reason stopReason, details string) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+
+	if !sb.cutover {
+		return
+	}
+	sb.stopBufferingLocked(reason, details)
+}
+
 func (sb *shardBuffer) recordExternallyReparentedTimestamp(timestamp int64, alias *topodatapb.TabletAlias) {
 	// Fast path (read lock): Check if new timestamp is higher.
 	sb.mu.RLock()
@@ -558,6 +574,7 @@ func (sb *shardBuffer) stopBufferingLocked(reason stopReason, details string) {
 
 	sb.logErrorIfStateNotLocked(stateBuffering)
 	sb.state = stateDraining
+	sb.cutover = false
 	q := sb.queue
 	// Clear the queue such that remove(), oldestEntry() and evictOldestEntry()
 	// will not work on obsolete data.
@@ -570,8 +587,11 @@ func (sb *shardBuffer) stopBufferingLocked(reason stopReason, details string) {
 	log.Infof("%v for shard: %s after: %.1f seconds due to: %v. Draining %d buffered requests now.", msg, topoproto.KeyspaceShardString(sb.keyspace, sb.shard), d.Seconds(), details, len(q))
 
 	var clientEntryError error
-	if reason == stopShardMissing {
+	switch reason {
+	case stopShardMissing:
 		clientEntryError = ShardMissingError
+	case stopRoutingChanged:
+		clientEntryError = RoutingChangedError
 	}
 
 	// Start the drain. (Use a new Go routine to release the lock.)
@@ -105,11 +105,13 @@ var (
 // stopReason is used in "stopsByReason" as "Reason" label.
 type stopReason string
 
-var stopReasons = []stopReason{stopShardMissing, stopFailoverEndDetected, stopMaxFailoverDurationExceeded, stopShutdown}
+var stopReasons = []stopReason{stopShardMissing, stopFailoverEndDetected, stopCutoverComplete, stopRoutingChanged, stopMaxFailoverDurationExceeded, stopShutdown}
 
 const (
 	stopShardMissing                stopReason = "ReshardingComplete"
 	stopFailoverEndDetected         stopReason = "NewPrimarySeen"
+	stopCutoverComplete             stopReason = "CutoverComplete"
+	stopRoutingChanged              stopReason = "RoutingRulesChanged"
 	stopMaxFailoverDurationExceeded stopReason = "MaxDurationExceeded"
 	stopShutdown                    stopReason = "Shutdown"
 )
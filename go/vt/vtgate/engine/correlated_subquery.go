@@ -0,0 +1,235 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*CorrelatedSubquery)(nil)
+
+// CorrelatedSubquery is a primitive that evaluates a predicate using a correlated subquery
+// for every row returned by the outer query. The subquery is executed with the values of
+// the outer row it depends on, its result is stored in bind variables, the same way
+// PulloutSubquery does, and the predicate is evaluated on vtgate with these bind variables.
+// Only the outer rows for which the predicate is true are returned.
+type CorrelatedSubquery struct {
+	Opcode PulloutOpcode
+
+	// SubqueryResult and HasValues are the bind variables holding the result of the subquery
+	SubqueryResult string
+	HasValues      string
+
+	Outer, Subquery Primitive
+
+	// Vars defines the bind variables that have to be
+	// built from the outer row before executing the subquery
+	Vars map[string]int `json:",omitempty"`
+
+	// Predicate is evaluated for every outer row, once the result of the subquery has been bound
+	Predicate    evalengine.Expr
+	ASTPredicate sqlparser.Expr
+
+	// Cols defines which columns of the outer rows are returned
+	Cols []int `json:",omitempty"`
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (cs *CorrelatedSubquery) RouteType() string {
+	return cs.Opcode.String()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (cs *CorrelatedSubquery) GetKeyspaceName() string {
+	if cs.Outer.GetKeyspaceName() == cs.Subquery.GetKeyspaceName() {
+		return cs.Outer.GetKeyspaceName()
+	}
+	return cs.Outer.GetKeyspaceName() + "_" + cs.Subquery.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (cs *CorrelatedSubquery) GetTableName() string {
+	return cs.Outer.GetTableName() + "_" + cs.Subquery.GetTableName()
+}
+
+// TryExecute performs a non-streaming exec.
+func (cs *CorrelatedSubquery) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	outerResult, err := vcursor.ExecutePrimitive(cs.Outer, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	return cs.filter(vcursor, bindVars, outerResult)
+}
+
+// TryStreamExecute performs a streaming exec.
+func (cs *CorrelatedSubquery) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return vcursor.StreamExecutePrimitive(cs.Outer, bindVars, wantfields, func(outerResult *sqltypes.Result) error {
+		result, err := cs.filter(vcursor, bindVars, outerResult)
+		if err != nil {
+			return err
+		}
+		return callback(result)
+	})
+}
+
+// GetFields fetches the field info.
+func (cs *CorrelatedSubquery) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	result, err := cs.Outer.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: cs.projectFields(result.Fields)}, nil
+}
+
+// Inputs returns the input primitives for this CorrelatedSubquery
+func (cs *CorrelatedSubquery) Inputs() []Primitive {
+	return []Primitive{cs.Outer, cs.Subquery}
+}
+
+// NeedsTransaction implements the Primitive interface
+func (cs *CorrelatedSubquery) NeedsTransaction() bool {
+	return cs.Outer.NeedsTransaction() || cs.Subquery.NeedsTransaction()
+}
+
+// filter returns the rows of the outer result for which the predicate is true.
+// Within a batch of outer rows, the subquery is executed only once for every
+// distinct set of values it depends on.
+func (cs *CorrelatedSubquery) filter(vcursor VCursor, bindVars map[string]*querypb.BindVariable, outerResult *sqltypes.Result) (*sqltypes.Result, error) {
+	varNames := make([]string, 0, len(cs.Vars))
+	for k := range cs.Vars {
+		varNames = append(varNames, k)
+	}
+	sort.Strings(varNames)
+
+	result := &sqltypes.Result{Fields: cs.projectFields(outerResult.Fields)}
+	subqueryVars := map[string]map[string]*querypb.BindVariable{}
+	env := evalengine.EnvWithBindVars(nil, vcursor.ConnCollation())
+	env.Fields = outerResult.Fields
+	for _, row := range outerResult.Rows {
+		key := correlationKey(row, varNames, cs.Vars)
+		combinedVars, found := subqueryVars[key]
+		if !found {
+			var err error
+			combinedVars, err = cs.execSubquery(vcursor, bindVars, row)
+			if err != nil {
+				return nil, err
+			}
+			subqueryVars[key] = combinedVars
+		}
+
+		env.BindVars = combinedVars
+		env.Row = row
+		evalResult, err := env.Evaluate(cs.Predicate)
+		if err != nil {
+			return nil, err
+		}
+		value := evalResult.Value()
+		if value.IsNull() {
+			continue
+		}
+		intEvalResult, err := value.ToInt64()
+		if err != nil {
+			return nil, err
+		}
+		if intEvalResult == 1 {
+			result.Rows = append(result.Rows, cs.projectRow(row))
+		}
+	}
+	return result, nil
+}
+
+// execSubquery executes the subquery for the given outer row, and returns
+// the bind variables needed to evaluate the predicate for that row
+func (cs *CorrelatedSubquery) execSubquery(vcursor VCursor, bindVars map[string]*querypb.BindVariable, row []sqltypes.Value) (map[string]*querypb.BindVariable, error) {
+	joinVars := make(map[string]*querypb.BindVariable, len(cs.Vars))
+	for k, col := range cs.Vars {
+		joinVars[k] = sqltypes.ValueBindVariable(row[col])
+	}
+	subqueryResult, err := vcursor.ExecutePrimitive(cs.Subquery, combineVars(bindVars, joinVars), false)
+	if err != nil {
+		return nil, err
+	}
+	combinedVars := combineVars(bindVars, nil)
+	err = bindSubqueryResult(cs.Opcode, cs.SubqueryResult, cs.HasValues, subqueryResult, combinedVars)
+	if err != nil {
+		return nil, err
+	}
+	return combinedVars, nil
+}
+
+// correlationKey returns a key identifying the values of the outer row that are used by the subquery
+func correlationKey(row []sqltypes.Value, varNames []string, vars map[string]int) string {
+	var key strings.Builder
+	for _, name := range varNames {
+		key.WriteString(row[vars[name]].String())
+		key.WriteByte(0)
+	}
+	return key.String()
+}
+
+func (cs *CorrelatedSubquery) projectFields(fields []*querypb.Field) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	result := make([]*querypb.Field, len(cs.Cols))
+	for i, col := range cs.Cols {
+		result[i] = fields[col]
+	}
+	return result
+}
+
+func (cs *CorrelatedSubquery) projectRow(row []sqltypes.Value) []sqltypes.Value {
+	result := make([]sqltypes.Value, len(cs.Cols))
+	for i, col := range cs.Cols {
+		result[i] = row[col]
+	}
+	return result
+}
+
+func (cs *CorrelatedSubquery) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Predicate":        sqlparser.String(cs.ASTPredicate),
+		"ProjectedIndexes": strings.Trim(strings.Join(strings.Fields(fmt.Sprint(cs.Cols)), ","), "[]"),
+		"TableName":        cs.GetTableName(),
+	}
+	var pulloutVars []string
+	if cs.HasValues != "" {
+		pulloutVars = append(pulloutVars, cs.HasValues)
+	}
+	if cs.SubqueryResult != "" {
+		pulloutVars = append(pulloutVars, cs.SubqueryResult)
+	}
+	if len(pulloutVars) > 0 {
+		other["PulloutVars"] = pulloutVars
+	}
+	if len(cs.Vars) > 0 {
+		other["JoinVars"] = orderedStringIntMap(cs.Vars)
+	}
+	return PrimitiveDescription{
+		OperatorType: "CorrelatedSubquery",
+		Variant:      cs.Opcode.String(),
+		Other:        other,
+	}
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestCorrelatedSubqueryExists(t *testing.T) {
+	outerFields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|varchar",
+	)
+	outer := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				outerFields,
+				"1|a",
+				"2|b",
+				"3|a",
+			),
+		},
+	}
+	subqueryFields := sqltypes.MakeTestFields(
+		"1",
+		"int64",
+	)
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				subqueryFields,
+				"1",
+			),
+			sqltypes.MakeTestResult(
+				subqueryFields,
+			),
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	cs := &CorrelatedSubquery{
+		Opcode:    PulloutExists,
+		HasValues: "__sq_has_values1",
+		Outer:     outer,
+		Subquery:  subquery,
+		Vars: map[string]int{
+			"col": 1,
+		},
+		Predicate: evalengine.NewBindVar("__sq_has_values1", collations.TypedCollation{}),
+		Cols:      []int{0},
+	}
+	r, err := cs.TryExecute(&noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	outer.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" true`,
+	})
+	// the subquery is only executed once for every distinct value of col
+	subquery.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" col: type:VARCHAR value:"a" false`,
+		`Execute a: type:INT64 value:"10" col: type:VARCHAR value:"b" false`,
+	})
+	utils.MustMatch(t, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id",
+			"int64",
+		),
+		"1",
+		"3",
+	), r)
+}
+
+func TestCorrelatedSubqueryValue(t *testing.T) {
+	outerFields := sqltypes.MakeTestFields(
+		"id|col",
+		"int64|varchar",
+	)
+	outer := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				outerFields,
+				"1|a",
+				"2|b",
+				"3|c",
+			),
+		},
+	}
+	subqueryFields := sqltypes.MakeTestFields(
+		"val",
+		"int64",
+	)
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				subqueryFields,
+				"1",
+			),
+			// no rows means a NULL value, which filters out the row
+			sqltypes.MakeTestResult(
+				subqueryFields,
+			),
+			sqltypes.MakeTestResult(
+				subqueryFields,
+				"0",
+			),
+		},
+	}
+
+	cs := &CorrelatedSubquery{
+		Opcode:         PulloutValue,
+		SubqueryResult: "__sq1",
+		Outer:          outer,
+		Subquery:       subquery,
+		Vars: map[string]int{
+			"col": 1,
+		},
+		Predicate: evalengine.NewBindVar("__sq1", collations.TypedCollation{}),
+		Cols:      []int{1, 0},
+	}
+	r, err := wrapStreamExecute(cs, &noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	outer.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	subquery.ExpectLog(t, []string{
+		`Execute col: type:VARCHAR value:"a" false`,
+		`Execute col: type:VARCHAR value:"b" false`,
+		`Execute col: type:VARCHAR value:"c" false`,
+	})
+	utils.MustMatch(t, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col|id",
+			"varchar|int64",
+		),
+		"a|1",
+	), r)
+}
+
+func TestCorrelatedSubqueryTooManyRows(t *testing.T) {
+	outer := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|col",
+					"int64|varchar",
+				),
+				"1|a",
+			),
+		},
+	}
+	subquery := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"val",
+					"int64",
+				),
+				"1",
+				"2",
+			),
+		},
+	}
+
+	cs := &CorrelatedSubquery{
+		Opcode:         PulloutValue,
+		SubqueryResult: "__sq1",
+		Outer:          outer,
+		Subquery:       subquery,
+		Vars: map[string]int{
+			"col": 1,
+		},
+		Predicate: evalengine.NewBindVar("__sq1", collations.TypedCollation{}),
+		Cols:      []int{0},
+	}
+	_, err := cs.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.EqualError(t, err, "subquery returned more than one row")
+}
@@ -66,6 +66,9 @@ func (del *Delete) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 		defer cancel()
 	}
 
+	if err := del.checkOpcode("delete"); err != nil {
+		return nil, err
+	}
 	rss, _, err := del.findRoute(vcursor, bindVars)
 	if err != nil {
 		return nil, err
@@ -158,6 +158,29 @@ func TestDeleteEqualNoRoute(t *testing.T) {
 	})
 }
 
+func TestDeleteRange(t *testing.T) {
+	vindex, _ := vindexes.NewNumeric("", nil)
+	del := &Delete{
+		DML: &DML{
+			RoutingParameters: &RoutingParameters{
+				Opcode: Range,
+				Keyspace: &vindexes.Keyspace{
+					Name:    "ks",
+					Sharded: true,
+				},
+				Vindex: vindex,
+				Values: []evalengine.Expr{evalengine.NewLiteralInt(1), evalengine.NullExpr},
+			},
+			Query: "dummy_delete",
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	_, err := del.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "unsupported: range routing in delete statement")
+	vc.ExpectLog(t, nil)
+}
+
 func TestDeleteEqualNoScatter(t *testing.T) {
 	t.Skip("planner does not produces this plan anymore")
 	vindex, _ := vindexes.NewLookupUnique("", map[string]string{
@@ -40,19 +40,29 @@ type probeTable struct {
 }
 
 func (pt *probeTable) exists(inputRow row) (bool, error) {
+	code, found, err := pt.find(inputRow)
+	if err != nil || found {
+		return found, err
+	}
+	pt.seenRows[code] = append(pt.seenRows[code], inputRow)
+	return false, nil
+}
+
+// find looks for the row in the probe table without adding it, and returns the
+// hash code of the row as well.
+func (pt *probeTable) find(inputRow row) (evalengine.HashCode, bool, error) {
 	// the two prime numbers used here (17 and 31) are used to
 
 	// calculate hashcode from all column values in the input row
 	code, err := pt.hashCodeForRow(inputRow)
 	if err != nil {
-		return false, err
+		return 0, false, err
 	}
 
 	existingRows, found := pt.seenRows[code]
 	if !found {
 		// nothing with this hash code found, we can be sure it's a not seen row
-		pt.seenRows[code] = []row{inputRow}
-		return false, nil
+		return code, false, nil
 	}
 
 	// we found something in the map - still need to check all individual values
@@ -60,16 +70,14 @@ func (pt *probeTable) exists(inputRow row) (bool, error) {
 	for _, existingRow := range existingRows {
 		exists, err := equal(existingRow, inputRow, pt.colCollations)
 		if err != nil {
-			return false, err
+			return 0, false, err
 		}
 		if exists {
-			return true, nil
+			return code, true, nil
 		}
 	}
 
-	pt.seenRows[code] = append(existingRows, inputRow)
-
-	return false, nil
+	return code, false, nil
 }
 
 func (pt *probeTable) hashCodeForRow(inputRow row) (evalengine.HashCode, error) {
@@ -148,6 +156,12 @@ func newProbeTable(colCollations []collations.ID) *probeTable {
 
 // TryExecute implements the Primitive interface
 func (d *Distinct) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	if vcursor.MemoryBudget().enabled() {
+		return collectStreamed(func(callback func(*sqltypes.Result) error) error {
+			return d.TryStreamExecute(vcursor, bindVars, wantfields, callback)
+		})
+	}
+
 	input, err := vcursor.ExecutePrimitive(d.Source, bindVars, wantfields)
 	if err != nil {
 		return nil, err
@@ -177,24 +191,71 @@ func (d *Distinct) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 func (d *Distinct) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	pt := newProbeTable(d.ColCollations)
 
+	// Once the rows seen so far grow past the memory budget of the query, new rows
+	// are hash partitioned to disk and deduplicated one partition at a time at the end.
+	budget := vcursor.MemoryBudget()
+	var held int64
+	var spilled *spillPartitionSet
+	defer func() {
+		budget.shrink(held)
+		if spilled != nil {
+			spilled.close()
+		}
+	}()
+
 	err := vcursor.StreamExecutePrimitive(d.Source, bindVars, wantfields, func(input *sqltypes.Result) error {
 		result := &sqltypes.Result{
 			Fields:   input.Fields,
 			InsertID: input.InsertID,
 		}
 		for _, row := range input.Rows {
+			if spilled != nil {
+				code, found, err := pt.find(row)
+				if err != nil {
+					return err
+				}
+				if !found {
+					if err := spilled.write(budget, code, row); err != nil {
+						return err
+					}
+				}
+				continue
+			}
 			exists, err := pt.exists(row)
 			if err != nil {
 				return err
 			}
 			if !exists {
 				result.Rows = append(result.Rows, row)
+				size := rowSize(row)
+				budget.grow(size)
+				held += size
+				if budget.mustSpill(held) {
+					spilled = &spillPartitionSet{}
+				}
 			}
 		}
 		return callback(result)
 	})
+	if err != nil || spilled == nil {
+		return err
+	}
 
-	return err
+	batcher := &spillBatcher{callback: callback}
+	for i := 0; i < spillPartitions; i++ {
+		pt := newProbeTable(d.ColCollations)
+		err := spilled.each(i, func(row row) error {
+			exists, err := pt.exists(row)
+			if err != nil || exists {
+				return err
+			}
+			return batcher.add(row)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return batcher.flush()
 }
 
 // RouteType implements the Primitive interface
@@ -97,6 +97,16 @@ func allowOnlyPrimary(rss ...*srvtopo.ResolvedShard) error {
 	return nil
 }
 
+// checkOpcode returns an error for the opcodes a DML statement cannot be routed with.
+// Range routing is only planned by Gen4 for select statements, while DML statements
+// are planned by V3, which sends their range predicates to all the shards.
+func (dml *DML) checkOpcode(dmlType string) error {
+	if dml.Opcode == Range {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: range routing in %s statement", dmlType)
+	}
+	return nil
+}
+
 func execMultiShard(vcursor VCursor, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, multiShardAutoCommit bool) (*sqltypes.Result, error) {
 	autocommit := (len(rss) == 1 || multiShardAutoCommit) && vcursor.AutocommitApproval()
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, autocommit)
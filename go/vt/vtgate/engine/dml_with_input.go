@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const (
+	// DMLVals is the name of the list bind variable holding the primary vindex values returned by the input of a DMLWithInput
+	DMLVals = "dml_vals"
+	// DMLPrimaryKeys is the name of the list bind variable holding the primary key values returned by the input of a DMLWithInput
+	DMLPrimaryKeys = "dml_pks"
+)
+
+var _ Primitive = (*DMLWithInput)(nil)
+
+// DMLWithInput executes an Update or a Delete on the rows selected by its input.
+// The input returns the values of the primary vindex column of the rows to modify, followed
+// by the values of their primary key when it is not the primary vindex column. The DML is then
+// executed with these values bound to the DMLVals and DMLPrimaryKeys list bind variables.
+// A primary key is only unique within a shard, so when the input returns primary keys, the DML
+// is executed once per shard, with the values of the rows selected from that shard only.
+type DMLWithInput struct {
+	Input Primitive
+	DML   Primitive
+
+	txNeeded
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (dml *DMLWithInput) RouteType() string {
+	return "DMLWithInput"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (dml *DMLWithInput) GetKeyspaceName() string {
+	return dml.DML.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (dml *DMLWithInput) GetTableName() string {
+	return dml.DML.GetTableName()
+}
+
+// TryExecute performs a non-streaming exec.
+func (dml *DMLWithInput) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, _ bool) (*sqltypes.Result, error) {
+	inputRes, err := vcursor.ExecutePrimitive(dml.Input, bindVars, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(inputRes.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	for _, row := range inputRes.Rows {
+		if len(row) != 1 && len(row) != 2 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] the input of a DML returned %d columns instead of 1 or 2", len(row))
+		}
+		if len(row) != len(inputRes.Rows[0]) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] the input of a DML returned rows of different lengths")
+		}
+	}
+	if len(inputRes.Rows[0]) == 2 {
+		return dml.executeByShard(vcursor, bindVars, inputRes.Rows)
+	}
+	return vcursor.ExecutePrimitive(dml.DML, combineVars(bindVars, dmlVars(inputRes.Rows)), false)
+}
+
+// executeByShard executes the DML once per shard of the rows returned by the input, so that the
+// primary keys of the rows selected from a shard cannot match other rows of another shard.
+func (dml *DMLWithInput) executeByShard(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) (*sqltypes.Result, error) {
+	var rp *RoutingParameters
+	switch prim := dml.DML.(type) {
+	case *Delete:
+		rp = prim.RoutingParameters
+	case *Update:
+		rp = prim.RoutingParameters
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected DML %T in DMLWithInput", dml.DML)
+	}
+	vindex, ok := rp.Vindex.(vindexes.SingleColumn)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] the primary vindex of a DMLWithInput is not a single column vindex")
+	}
+
+	// the rows are resolved by their index, to know which rows were selected from which shard
+	pvs := make([]sqltypes.Value, 0, len(rows))
+	ids := make([]*querypb.Value, 0, len(rows))
+	for i, row := range rows {
+		pvs = append(pvs, row[0])
+		ids = append(ids, sqltypes.ValueToProto(sqltypes.NewInt64(int64(i))))
+	}
+	destinations, err := vindex.Map(vcursor, pvs)
+	if err != nil {
+		return nil, err
+	}
+	_, shardIds, err := vcursor.ResolveDestinations(rp.Keyspace.Name, ids, destinations)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &sqltypes.Result{}
+	for _, shardIds := range shardIds {
+		shardRows := make([][]sqltypes.Value, 0, len(shardIds))
+		for _, id := range shardIds {
+			i, err := sqltypes.ProtoToValue(id).ToInt64()
+			if err != nil {
+				return nil, err
+			}
+			shardRows = append(shardRows, rows[i])
+		}
+		qr, err := vcursor.ExecutePrimitive(dml.DML, combineVars(bindVars, dmlVars(shardRows)), false)
+		if err != nil {
+			return nil, err
+		}
+		result.RowsAffected += qr.RowsAffected
+	}
+	return result, nil
+}
+
+// dmlVars returns the DMLVals and DMLPrimaryKeys bind variables of the rows returned by the input.
+func dmlVars(rows [][]sqltypes.Value) map[string]*querypb.BindVariable {
+	values := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, 0, len(rows)),
+	}
+	vars := map[string]*querypb.BindVariable{DMLVals: values}
+	var pks *querypb.BindVariable
+	if len(rows[0]) == 2 {
+		pks = &querypb.BindVariable{
+			Type:   querypb.Type_TUPLE,
+			Values: make([]*querypb.Value, 0, len(rows)),
+		}
+		vars[DMLPrimaryKeys] = pks
+	}
+	for _, row := range rows {
+		values.Values = append(values.Values, sqltypes.ValueToProto(row[0]))
+		if pks != nil {
+			pks.Values = append(pks.Values, sqltypes.ValueToProto(row[1]))
+		}
+	}
+	return vars
+}
+
+// TryStreamExecute performs a streaming exec.
+func (dml *DMLWithInput) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	res, err := dml.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(res)
+}
+
+// GetFields fetches the field info.
+func (dml *DMLWithInput) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unreachable code for DMLWithInput")
+}
+
+// Inputs returns the input primitives for this DMLWithInput
+func (dml *DMLWithInput) Inputs() []Primitive {
+	return []Primitive{dml.Input, dml.DML}
+}
+
+func (dml *DMLWithInput) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType:     "DMLWithInput",
+		TargetTabletType: topodatapb.TabletType_PRIMARY,
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestDMLWithInput(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id",
+					"int64",
+				),
+				"1",
+				"2",
+			),
+		},
+	}
+	dml := &fakePrimitive{
+		results: []*sqltypes.Result{{RowsAffected: 2}},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"a": sqltypes.Int64BindVariable(10),
+	}
+
+	dwi := &DMLWithInput{
+		Input: input,
+		DML:   dml,
+	}
+	r, err := dwi.TryExecute(&noopVCursor{}, bv, false)
+	require.NoError(t, err)
+	input.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" false`,
+	})
+	dml.ExpectLog(t, []string{
+		`Execute a: type:INT64 value:"10" dml_vals: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"2"} false`,
+	})
+	utils.MustMatch(t, &sqltypes.Result{RowsAffected: 2}, r)
+}
+
+func TestDMLWithInputNoRows(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id",
+					"int64",
+				),
+			),
+		},
+	}
+	dml := &fakePrimitive{}
+
+	dwi := &DMLWithInput{
+		Input: input,
+		DML:   dml,
+	}
+	r, err := dwi.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	// the DML is not executed when the input selects no rows
+	dml.ExpectLog(t, nil)
+	utils.MustMatch(t, &sqltypes.Result{}, r)
+}
+
+func TestDMLWithInputPrimaryKey(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"user_id|id",
+					"int64|int64",
+				),
+				"1|10",
+				"2|10",
+				"1|11",
+			),
+		},
+	}
+	vindex, _ := vindexes.NewHash("", nil)
+	del := &Delete{
+		DML: &DML{
+			RoutingParameters: &RoutingParameters{
+				Opcode: IN,
+				Keyspace: &vindexes.Keyspace{
+					Name:    "ks",
+					Sharded: true,
+				},
+				Vindex: vindex,
+				Values: []evalengine.Expr{evalengine.NewBindVar(DMLVals, collations.TypedCollation{})},
+			},
+			Query: "dummy_delete",
+		},
+	}
+
+	dwi := &DMLWithInput{
+		Input: input,
+		DML:   del,
+	}
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"-20", "20-", "-20", "-20", "-20", "20-"}
+	vc.results = []*sqltypes.Result{{RowsAffected: 2}, {RowsAffected: 1}}
+	r, err := dwi.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	// a primary key is only unique within a shard, so each shard only gets the primary keys selected from it
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"0" type:INT64 value:"1" type:INT64 value:"2"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ResolveDestinations ks [type:INT64 value:"1" type:INT64 value:"1"] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard ks.-20: dummy_delete {dml_pks: type:TUPLE values:{type:INT64 value:"10"} values:{type:INT64 value:"11"} dml_vals: type:TUPLE values:{type:INT64 value:"1"} values:{type:INT64 value:"1"}} true true`,
+		`ResolveDestinations ks [type:INT64 value:"2"] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ks.20-: dummy_delete {dml_pks: type:TUPLE values:{type:INT64 value:"10"} dml_vals: type:TUPLE values:{type:INT64 value:"2"}} true true`,
+	})
+	utils.MustMatch(t, &sqltypes.Result{RowsAffected: 3}, r)
+}
+
+func TestDMLWithInputTooManyColumns(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|col|col2",
+					"int64|int64|int64",
+				),
+				"1|2|3",
+			),
+		},
+	}
+
+	dwi := &DMLWithInput{
+		Input: input,
+		DML:   &fakePrimitive{},
+	}
+	_, err := dwi.TryExecute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "[BUG] the input of a DML returned 3 columns instead of 1 or 2")
+}
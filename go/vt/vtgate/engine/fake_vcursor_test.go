@@ -225,6 +225,10 @@ func (t *noopVCursor) ExceedsMaxMemoryRows(numRows int) bool {
 	return !testIgnoreMaxMemoryRows && numRows > testMaxMemoryRows
 }
 
+func (t *noopVCursor) MemoryBudget() *MemoryBudget {
+	return nil
+}
+
 func (t *noopVCursor) GetKeyspace() string {
 	return ""
 }
@@ -280,6 +284,14 @@ func (t *noopVCursor) SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error {
 	panic("unimplemented")
 }
 
+func (t *noopVCursor) SubmitMaterializedView(mv *schema.MaterializedView) error {
+	panic("unimplemented")
+}
+
+func (t *noopVCursor) FailedMaterializedViews(keyspace string) ([]*schema.MaterializedView, error) {
+	panic("unimplemented")
+}
+
 func (t *noopVCursor) GetDBDDLPluginName() string {
 	panic("unimplemented")
 }
@@ -321,6 +333,8 @@ type loggingVCursor struct {
 
 	// map different shards to keyspaces in the test.
 	ksShardMap map[string][]string
+
+	failedMaterializedViews []*schema.MaterializedView
 }
 
 type tableRoutes struct {
@@ -454,6 +468,16 @@ func (f *loggingVCursor) SubmitOnlineDDL(onlineDDL *schema.OnlineDDL) error {
 	return nil
 }
 
+func (f *loggingVCursor) SubmitMaterializedView(mv *schema.MaterializedView) error {
+	f.log = append(f.log, fmt.Sprintf("SubmitMaterializedView: %s", mv.ToString()))
+	return nil
+}
+
+func (f *loggingVCursor) FailedMaterializedViews(keyspace string) ([]*schema.MaterializedView, error) {
+	f.log = append(f.log, fmt.Sprintf("FailedMaterializedViews %s", keyspace))
+	return f.failedMaterializedViews, nil
+}
+
 func (f *loggingVCursor) ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
 	f.log = append(f.log, fmt.Sprintf("ExecuteStandalone %s %v %s %s", query, printBindVars(bindvars), rs.Target.Keyspace, rs.Target.Shard))
 	return f.nextResult()
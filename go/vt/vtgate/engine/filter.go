@@ -60,17 +60,14 @@ func (f *Filter) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
 	var rows [][]sqltypes.Value
 	env.Fields = result.Fields
+	predicate := env.Compile(f.Predicate)
 	for _, row := range result.Rows {
 		env.Row = row
-		evalResult, err := env.Evaluate(f.Predicate)
+		match, err := predicate.IsTrue(env)
 		if err != nil {
 			return nil, err
 		}
-		intEvalResult, err := evalResult.Value().ToInt64()
-		if err != nil {
-			return nil, err
-		}
-		if intEvalResult == 1 {
+		if match {
 			rows = append(rows, row)
 		}
 	}
@@ -81,20 +78,21 @@ func (f *Filter) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 // TryStreamExecute satisfies the Primitive interface.
 func (f *Filter) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	var predicate *evalengine.Program
 	filter := func(results *sqltypes.Result) error {
 		var rows [][]sqltypes.Value
-		env.Fields = results.Fields
+		// only the first result of the stream has fields, so the predicate is compiled for them
+		if predicate == nil || results.Fields != nil {
+			env.Fields = results.Fields
+			predicate = env.Compile(f.Predicate)
+		}
 		for _, row := range results.Rows {
 			env.Row = row
-			evalResult, err := env.Evaluate(f.Predicate)
-			if err != nil {
-				return err
-			}
-			intEvalResult, err := evalResult.Value().ToInt64()
+			match, err := predicate.IsTrue(env)
 			if err != nil {
 				return err
 			}
-			if intEvalResult == 1 {
+			if match {
 				rows = append(rows, row)
 			}
 		}
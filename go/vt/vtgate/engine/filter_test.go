@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+type filterTestLookup struct {
+	evalengine.LookupDefaultCollation
+	columns []string
+}
+
+func (l filterTestLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	for i, name := range l.columns {
+		if col.Name.EqualString(name) {
+			return i, nil
+		}
+	}
+	return 0, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "unknown column %s", sqlparser.String(col))
+}
+
+func translateFilterPredicate(t testing.TB, predicate string, columns ...string) (evalengine.Expr, sqlparser.Expr) {
+	t.Helper()
+	ast, err := sqlparser.ParseExpr(predicate)
+	require.NoError(t, err)
+	lookup := filterTestLookup{
+		LookupDefaultCollation: evalengine.LookupDefaultCollation(collations.Default()),
+		columns:                columns,
+	}
+	expr, err := evalengine.Translate(ast, lookup)
+	require.NoError(t, err)
+	return expr, ast
+}
+
+func TestFilterPass(t *testing.T) {
+	predicate, ast := translateFilterPredicate(t, "a > b and name != 'b'", "a", "b", "name")
+	fields := sqltypes.MakeTestFields("a|b|name", "int64|float64|varchar")
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(fields,
+			"1|0.5|a",
+			"1|1.5|a",
+			"2|1|b",
+			"3|1|c",
+			"4|5|d",
+		)},
+		allResultsInOneCall: true,
+	}
+	filter := &Filter{
+		Predicate:    predicate,
+		ASTPredicate: ast,
+		Input:        input,
+	}
+
+	qr, err := filter.TryExecute(&noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, `[[INT64(1) FLOAT64(0.5) VARCHAR("a")] [INT64(3) FLOAT64(1) VARCHAR("c")]]`, fmt.Sprintf("%v", qr.Rows))
+
+	input.rewind()
+	qr, err = wrapStreamExecute(filter, &noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, `[[INT64(1) FLOAT64(0.5) VARCHAR("a")] [INT64(3) FLOAT64(1) VARCHAR("c")]]`, fmt.Sprintf("%v", qr.Rows))
+}
+
+func BenchmarkFilterJoin(b *testing.B) {
+	const rowCount = 1024
+
+	leftFields := sqltypes.MakeTestFields("id|price|name", "int64|float64|varchar")
+	var leftRows []string
+	for i := 0; i < rowCount; i++ {
+		leftRows = append(leftRows, fmt.Sprintf("%d|%d.5|name%d", i, i%100, i%10))
+	}
+	rightFields := sqltypes.MakeTestFields("quantity", "int64")
+	var rightResults []*sqltypes.Result
+	for i := 0; i < rowCount; i++ {
+		rightResults = append(rightResults, sqltypes.MakeTestResult(rightFields, fmt.Sprintf("%d", i%7)))
+	}
+
+	left := &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(leftFields, leftRows...)}}
+	right := &fakePrimitive{results: rightResults}
+	predicate, ast := translateFilterPredicate(b,
+		"price * quantity > 100 and id - quantity >= 10 and name != 'name3' or id is null",
+		"id", "price", "name", "quantity")
+	filter := &Filter{
+		Predicate:    predicate,
+		ASTPredicate: ast,
+		Input: &Join{
+			Opcode: InnerJoin,
+			Left:   left,
+			Right:  right,
+			Cols:   []int{-1, -2, -3, 1},
+			Vars:   map[string]int{"id": 0},
+		},
+	}
+
+	testIgnoreMaxMemoryRows = true
+	defer func() { testIgnoreMaxMemoryRows = false }()
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		left.rewind()
+		right.rewind()
+		if _, err := filter.TryExecute(&noopVCursor{}, nil, false); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -64,6 +64,12 @@ type HashJoin struct {
 
 // TryExecute implements the Primitive interface
 func (hj *HashJoin) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	if vcursor.MemoryBudget().enabled() {
+		return collectStreamed(func(callback func(*sqltypes.Result) error) error {
+			return hj.TryStreamExecute(vcursor, bindVars, wantfields, callback)
+		})
+	}
+
 	lresult, err := vcursor.ExecutePrimitive(hj.Left, bindVars, wantfields)
 	if err != nil {
 		return nil, err
@@ -130,6 +136,18 @@ func (hj *HashJoin) buildProbeTable(lresult *sqltypes.Result) (map[evalengine.Ha
 
 // TryStreamExecute implements the Primitive interface
 func (hj *HashJoin) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	// When the probe table grows past the memory budget of the query, both sides
+	// of the join are hash partitioned to disk and then joined one partition at a time.
+	budget := vcursor.MemoryBudget()
+	var held int64
+	var lhsPartitions *spillPartitionSet
+	defer func() {
+		budget.shrink(held)
+		if lhsPartitions != nil {
+			lhsPartitions.close()
+		}
+	}()
+
 	// build the probe table from the LHS result
 	probeTable := map[evalengine.HashCode][]row{}
 	var lfields []*querypb.Field
@@ -146,13 +164,38 @@ func (hj *HashJoin) TryStreamExecute(vcursor VCursor, bindVars map[string]*query
 			if err != nil {
 				return err
 			}
+			if lhsPartitions != nil {
+				if err := lhsPartitions.write(budget, hashcode, current); err != nil {
+					return err
+				}
+				continue
+			}
 			probeTable[hashcode] = append(probeTable[hashcode], current)
+			size := rowSize(current)
+			budget.grow(size)
+			held += size
+			if budget.mustSpill(held) {
+				lhsPartitions = &spillPartitionSet{}
+				for code, rows := range probeTable {
+					for _, r := range rows {
+						if err := lhsPartitions.write(budget, code, r); err != nil {
+							return err
+						}
+					}
+				}
+				probeTable = nil
+				budget.shrink(held)
+				held = 0
+			}
 		}
 		return nil
 	})
 	if err != nil {
 		return err
 	}
+	if lhsPartitions != nil {
+		return hj.streamPartitioned(vcursor, bindVars, wantfields, lfields, lhsPartitions, callback)
+	}
 
 	return vcursor.StreamExecutePrimitive(hj.Right, bindVars, wantfields, func(result *sqltypes.Result) error {
 		// compare the results coming from the RHS with the probe-table
@@ -171,19 +214,9 @@ func (hj *HashJoin) TryStreamExecute(vcursor VCursor, bindVars map[string]*query
 			if err != nil {
 				return err
 			}
-			lftRows := probeTable[hashcode]
-			for _, currentLHSRow := range lftRows {
-				lhsVal := currentLHSRow[hj.LHSKey]
-				// hash codes can give false positives, so we need to check with a real comparison as well
-				cmp, err := evalengine.NullsafeCompare(joinVal, lhsVal, hj.Collation)
-				if err != nil {
-					return err
-				}
-
-				if cmp == 0 {
-					// we have a match!
-					res.Rows = append(res.Rows, joinRows(currentLHSRow, currentRHSRow, hj.Cols))
-				}
+			res.Rows, err = hj.probe(probeTable[hashcode], currentRHSRow, res.Rows)
+			if err != nil {
+				return err
 			}
 		}
 		if len(res.Rows) != 0 || len(res.Fields) != 0 {
@@ -193,6 +226,95 @@ func (hj *HashJoin) TryStreamExecute(vcursor VCursor, bindVars map[string]*query
 	})
 }
 
+// streamPartitioned partitions the RHS to disk the same way the LHS was, and then
+// joins every LHS partition with the matching RHS partition.
+func (hj *HashJoin) streamPartitioned(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, lfields []*querypb.Field, lhsPartitions *spillPartitionSet, callback func(*sqltypes.Result) error) error {
+	budget := vcursor.MemoryBudget()
+	rhsPartitions := &spillPartitionSet{}
+	defer rhsPartitions.close()
+
+	err := vcursor.StreamExecutePrimitive(hj.Right, bindVars, wantfields, func(result *sqltypes.Result) error {
+		if len(result.Fields) != 0 {
+			if err := callback(&sqltypes.Result{Fields: joinFields(lfields, result.Fields, hj.Cols)}); err != nil {
+				return err
+			}
+		}
+		for _, current := range result.Rows {
+			joinVal := current[hj.RHSKey]
+			if joinVal.IsNull() {
+				continue
+			}
+			hashcode, err := evalengine.NullsafeHashcode(joinVal, hj.Collation, hj.ComparisonType)
+			if err != nil {
+				return err
+			}
+			if err := rhsPartitions.write(budget, hashcode, current); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	batcher := &spillBatcher{callback: callback}
+	for i := 0; i < spillPartitions; i++ {
+		// the rows of a single partition are expected to fit in memory
+		probeTable := map[evalengine.HashCode][]row{}
+		err := lhsPartitions.each(i, func(current row) error {
+			hashcode, err := evalengine.NullsafeHashcode(current[hj.LHSKey], hj.Collation, hj.ComparisonType)
+			if err != nil {
+				return err
+			}
+			probeTable[hashcode] = append(probeTable[hashcode], current)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		err = rhsPartitions.each(i, func(current row) error {
+			hashcode, err := evalengine.NullsafeHashcode(current[hj.RHSKey], hj.Collation, hj.ComparisonType)
+			if err != nil {
+				return err
+			}
+			joined, err := hj.probe(probeTable[hashcode], current, nil)
+			if err != nil {
+				return err
+			}
+			for _, r := range joined {
+				if err := batcher.add(r); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return batcher.flush()
+}
+
+// probe appends to rows the RHS row joined with each of the LHS rows that matches it.
+func (hj *HashJoin) probe(lftRows []row, currentRHSRow row, rows []row) ([]row, error) {
+	joinVal := currentRHSRow[hj.RHSKey]
+	for _, currentLHSRow := range lftRows {
+		lhsVal := currentLHSRow[hj.LHSKey]
+		// hash codes can give false positives, so we need to check with a real comparison as well
+		cmp, err := evalengine.NullsafeCompare(joinVal, lhsVal, hj.Collation)
+		if err != nil {
+			return nil, err
+		}
+
+		if cmp == 0 {
+			// we have a match!
+			rows = append(rows, joinRows(currentLHSRow, currentRHSRow, hj.Cols))
+		}
+	}
+	return rows, nil
+}
+
 // RouteType implements the Primitive interface
 func (hj *HashJoin) RouteType() string {
 	return "HashJoin"
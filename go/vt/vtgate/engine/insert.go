@@ -19,6 +19,7 @@ package engine
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"strconv"
 	"strings"
 	"time"
@@ -507,22 +508,9 @@ func (ins *Insert) processGenerateFromValues(vcursor VCursor, bindVars map[strin
 	}
 
 	// If generation is needed, generate the requested number of values (as one call).
+	increment := int64(1)
 	if count != 0 {
-		rss, _, err := vcursor.ResolveDestinations(ins.Generate.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
-		if err != nil {
-			return 0, err
-		}
-		if len(rss) != 1 {
-			return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "auto sequence generation can happen through single shard only, it is getting routed to %d shards", len(rss))
-		}
-		bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}
-		qr, err := vcursor.ExecuteStandalone(ins.Generate.Query, bindVars, rss[0])
-		if err != nil {
-			return 0, err
-		}
-		// If no rows are returned, it's an internal error, and the code
-		// must panic, which will be caught and reported.
-		insertID, err = evalengine.ToInt64(qr.Rows[0][0])
+		insertID, increment, err = ins.execGenerate(vcursor, count)
 		if err != nil {
 			return 0, err
 		}
@@ -533,7 +521,7 @@ func (ins *Insert) processGenerateFromValues(vcursor VCursor, bindVars map[strin
 	for i, v := range values {
 		if shouldGenerate(v) {
 			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.Int64BindVariable(cur)
-			cur++
+			cur += increment
 		} else {
 			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.ValueBindVariable(v)
 		}
@@ -566,21 +554,7 @@ func (ins *Insert) processGenerateFromRows(vcursor VCursor, rows []sqltypes.Row)
 	}
 
 	// If generation is needed, generate the requested number of values (as one call).
-	rss, _, err := vcursor.ResolveDestinations(ins.Generate.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
-	if err != nil {
-		return 0, err
-	}
-	if len(rss) != 1 {
-		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "auto sequence generation can happen through single shard only, it is getting routed to %d shards", len(rss))
-	}
-	bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}
-	qr, err := vcursor.ExecuteStandalone(ins.Generate.Query, bindVars, rss[0])
-	if err != nil {
-		return 0, err
-	}
-	// If no rows are returned, it's an internal error, and the code
-	// must panic, which will be caught and reported.
-	insertID, err = evalengine.ToInt64(qr.Rows[0][0])
+	insertID, increment, err := ins.execGenerate(vcursor, count)
 	if err != nil {
 		return 0, err
 	}
@@ -590,17 +564,69 @@ func (ins *Insert) processGenerateFromRows(vcursor VCursor, rows []sqltypes.Row)
 		if genColPresent {
 			if val[offset].IsNull() {
 				val[offset] = sqltypes.NewInt64(used)
-				used++
+				used += increment
 			}
 		} else {
 			rows[idx] = append(val, sqltypes.NewInt64(used))
-			used++
+			used += increment
 		}
 	}
 
 	return insertID, nil
 }
 
+// execGenerate gets count values from the sequence, and returns the first one
+// and the increment between two consecutive values.
+// A sequence in a sharded keyspace interleaves the values handed out by its shards,
+// so any shard can serve the request: they're tried in a random order until one
+// succeeds, which keeps a single primary from being a point of failure.
+func (ins *Insert) execGenerate(vcursor VCursor, count int64) (insertID int64, increment int64, err error) {
+	var dest key.Destination = key.DestinationAnyShard{}
+	if ins.Generate.Keyspace.Sharded {
+		dest = key.DestinationAllShards{}
+	}
+	rss, _, err := vcursor.ResolveDestinations(ins.Generate.Keyspace.Name, nil, []key.Destination{dest})
+	if err != nil {
+		return 0, 0, err
+	}
+	if !ins.Generate.Keyspace.Sharded && len(rss) != 1 {
+		return 0, 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "auto sequence generation can happen through single shard only, it is getting routed to %d shards", len(rss))
+	}
+	rand.Shuffle(len(rss), func(i, j int) {
+		rss[i], rss[j] = rss[j], rss[i]
+	})
+	bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}
+	var qr *sqltypes.Result
+	for _, rs := range rss {
+		qr, err = vcursor.ExecuteStandalone(ins.Generate.Query, bindVars, rs)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	// If no rows are returned, it's an internal error, and the code
+	// must panic, which will be caught and reported.
+	insertID, err = evalengine.ToInt64(qr.Rows[0][0])
+	if err != nil {
+		return 0, 0, err
+	}
+	// Sequences that interleave their values return the increment next to the first value.
+	increment = 1
+	if len(qr.Fields) > 1 && qr.Fields[1].Name == "increment" {
+		increment, err = evalengine.ToInt64(qr.Rows[0][1])
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	// The values of the shards only stay apart if each of them skips the values of the others.
+	if ins.Generate.Keyspace.Sharded && increment < int64(len(rss)) {
+		return 0, 0, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "sequence in sharded keyspace %s has an increment of %d for %d shards: its table must have an increment column set to at least the number of shards", ins.Generate.Keyspace.Name, increment, len(rss))
+	}
+	return insertID, increment, nil
+}
+
 // getInsertShardedRoute performs all the vindex related work
 // and returns a map of shard to queries.
 // Using the primary vindex, it computes the target keyspace ids.
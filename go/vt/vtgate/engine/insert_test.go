@@ -24,6 +24,7 @@ import (
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -452,6 +453,169 @@ func TestInsertShardedGenerate(t *testing.T) {
 	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 2})
 }
 
+func TestInsertShardedGenerateFromShardedSequence(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs := vindexes.BuildVSchema(invschema)
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertSharded,
+		false,
+		ks.Keyspace,
+		[][][]evalengine.Expr{{
+			// colVindex columns: id
+			{
+				// 3 rows.
+				evalengine.NewLiteralInt(1),
+				evalengine.NewLiteralInt(2),
+				evalengine.NewLiteralInt(3),
+			},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2", " mid3"},
+		" suffix",
+	)
+	ins.Generate = &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: true,
+		},
+		Query: "dummy_generate",
+		Values: evalengine.NewTupleExpr(
+			evalengine.NullExpr,
+			evalengine.NewLiteralInt(1),
+			evalengine.NullExpr,
+		),
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.ksShardMap = map[string][]string{"ks2": {"-80", "80-"}}
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+	// The first shard of the sequence fails, and the other one hands
+	// out 3 and 7, as its values are interleaved with the ones of the first.
+	vc.resultErr = errors.New("primary not serving")
+	vc.results = []*sqltypes.Result{
+		nil,
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"nextval|increment",
+				"int64|int64",
+			),
+			"3|4",
+		),
+		{InsertID: 1},
+	}
+
+	result, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	require.Len(t, vc.log, 5)
+	assert.Equal(t, `ResolveDestinations ks2 [] Destinations:DestinationAllShards()`, vc.log[0])
+	assert.Regexp(t, `^ExecuteStandalone dummy_generate n: type:INT64 value:"2" ks2 (-80|80-)$`, vc.log[1])
+	assert.Regexp(t, `^ExecuteStandalone dummy_generate n: type:INT64 value:"2" ks2 (-80|80-)$`, vc.log[2])
+	assert.NotEqual(t, vc.log[1], vc.log[2])
+	assert.Contains(t, vc.log[4],
+		`{__seq0: type:INT64 value:"3" __seq1: type:INT64 value:"1" __seq2: type:INT64 value:"7" `)
+	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 3})
+}
+
+func TestBadShardedSequence(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs := vindexes.BuildVSchema(invschema)
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertSharded,
+		false,
+		ks.Keyspace,
+		[][][]evalengine.Expr{{
+			// colVindex columns: id
+			{
+				// 2 rows.
+				evalengine.NewLiteralInt(1),
+				evalengine.NewLiteralInt(2),
+			},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	ins.Generate = &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: true,
+		},
+		Query: "dummy_generate",
+		Values: evalengine.NewTupleExpr(
+			evalengine.NullExpr,
+			evalengine.NullExpr,
+		),
+	}
+
+	for _, tcase := range []struct {
+		name   string
+		result *sqltypes.Result
+		err    string
+	}{{
+		// the sequence table has no increment column, so its shards would hand out the same values
+		name:   "no increment",
+		result: sqltypes.MakeTestResult(sqltypes.MakeTestFields("nextval", "int64"), "1"),
+		err:    "sequence in sharded keyspace ks2 has an increment of 1 for 2 shards: its table must have an increment column set to at least the number of shards",
+	}, {
+		name:   "increment below the number of shards",
+		result: sqltypes.MakeTestResult(sqltypes.MakeTestFields("nextval|increment", "int64|int64"), "1|1"),
+		err:    "sequence in sharded keyspace ks2 has an increment of 1 for 2 shards: its table must have an increment column set to at least the number of shards",
+	}} {
+		t.Run(tcase.name, func(t *testing.T) {
+			vc := newDMLTestVCursor("-20", "20-")
+			vc.ksShardMap = map[string][]string{"ks2": {"-80", "80-"}}
+			vc.results = []*sqltypes.Result{tcase.result}
+
+			_, err := ins.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+			require.EqualError(t, err, tcase.err)
+		})
+	}
+}
+
 func TestInsertShardedOwned(t *testing.T) {
 	invschema := &vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -46,6 +46,14 @@ type Join struct {
 	// be built from the LHS result before invoking
 	// the RHS subqquery.
 	Vars map[string]int `json:",omitempty"`
+
+	// BatchSize is set when the join sends the LHS rows to the RHS in batches
+	// instead of one at a time. The only join variable is then bound to the
+	// tuple of the values of a whole batch, and BatchColumn is the offset of
+	// the RHS column these values are compared with, which is used to pair
+	// the RHS rows with the LHS rows of the batch.
+	BatchSize   int `json:",omitempty"`
+	BatchColumn int `json:",omitempty"`
 }
 
 // TryExecute performs a non-streaming exec.
@@ -55,6 +63,9 @@ func (jn *Join) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 	if err != nil {
 		return nil, err
 	}
+	if jn.BatchSize > 0 {
+		return jn.executeBatched(vcursor, bindVars, wantfields, lresult)
+	}
 	result := &sqltypes.Result{}
 	if len(lresult.Rows) == 0 && wantfields {
 		for k := range jn.Vars {
@@ -94,6 +105,9 @@ func (jn *Join) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 
 // TryStreamExecute performs a streaming exec.
 func (jn *Join) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	if jn.BatchSize > 0 {
+		return jn.streamExecuteBatched(vcursor, bindVars, wantfields, callback)
+	}
 	joinVars := make(map[string]*querypb.BindVariable)
 	err := vcursor.StreamExecutePrimitive(jn.Left, bindVars, wantfields, func(lresult *sqltypes.Result) error {
 		for _, lrow := range lresult.Rows {
@@ -265,6 +279,10 @@ func (jn *Join) description() PrimitiveDescription {
 	if len(jn.Vars) > 0 {
 		other["JoinVars"] = orderedStringIntMap(jn.Vars)
 	}
+	if jn.BatchSize > 0 {
+		other["BatchSize"] = jn.BatchSize
+		other["BatchColumn"] = jn.BatchColumn
+	}
 	return PrimitiveDescription{
 		OperatorType: "Join",
 		Variant:      jn.Opcode.String(),
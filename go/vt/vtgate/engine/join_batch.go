@@ -0,0 +1,223 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// joinBatchParallelism is the maximum number of batches of a join
+// that are sent to the RHS at the same time.
+const joinBatchParallelism = 8
+
+// executeBatched runs the RHS for the LHS result in batches of BatchSize rows.
+func (jn *Join) executeBatched(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, lresult *sqltypes.Result) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	rfields, err := jn.executeBatches(vcursor, bindVars, lresult.Rows, func(rows [][]sqltypes.Value) error {
+		result.Rows = append(result.Rows, rows...)
+		if vcursor.ExceedsMaxMemoryRows(len(result.Rows)) {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if wantfields {
+		if rfields == nil {
+			rresult, err := jn.Right.GetFields(vcursor, jn.nullJoinVars(bindVars))
+			if err != nil {
+				return nil, err
+			}
+			rfields = rresult.Fields
+		}
+		result.Fields = joinFields(lresult.Fields, rfields, jn.Cols)
+	}
+	return result, nil
+}
+
+// streamExecuteBatched runs the RHS for the streamed LHS rows in batches of BatchSize rows.
+// The LHS rows are buffered until enough of them are available to run a full set of batches.
+func (jn *Join) streamExecuteBatched(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var pending [][]sqltypes.Value
+	flush := func() error {
+		rows := pending
+		pending = nil
+		_, err := jn.executeBatches(vcursor, bindVars, rows, func(rows [][]sqltypes.Value) error {
+			return callback(&sqltypes.Result{Rows: rows})
+		})
+		return err
+	}
+	err := vcursor.StreamExecutePrimitive(jn.Left, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		if wantfields && len(lresult.Fields) != 0 {
+			wantfields = false
+			rresult, err := jn.Right.GetFields(vcursor, jn.nullJoinVars(bindVars))
+			if err != nil {
+				return err
+			}
+			if err := callback(&sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, jn.Cols)}); err != nil {
+				return err
+			}
+		}
+		pending = append(pending, lresult.Rows...)
+		if len(pending) >= jn.BatchSize*joinBatchParallelism {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+// executeBatches splits the LHS rows into batches and runs the RHS for up to
+// joinBatchParallelism of them at the same time. The joined rows are sent to
+// the callback one batch at a time, in the order of the LHS rows.
+// It returns the fields of the RHS, if it was executed at all.
+func (jn *Join) executeBatches(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lrows [][]sqltypes.Value, callback func([][]sqltypes.Value) error) ([]*querypb.Field, error) {
+	name, col, err := jn.batchVar()
+	if err != nil {
+		return nil, err
+	}
+
+	var rfields []*querypb.Field
+	for len(lrows) > 0 {
+		var batches [][][]sqltypes.Value
+		for len(batches) < joinBatchParallelism && len(lrows) > 0 {
+			size := jn.BatchSize
+			if size > len(lrows) {
+				size = len(lrows)
+			}
+			batches = append(batches, lrows[:size])
+			lrows = lrows[size:]
+		}
+
+		results := make([]*sqltypes.Result, len(batches))
+		g, restoreCtx := vcursor.ErrorGroupCancellableContext()
+		for i, batch := range batches {
+			values := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+			for _, lrow := range batch {
+				// NULL never matches the join predicate, so there is no need to send it
+				if !lrow[col].IsNull() {
+					values.Values = append(values.Values, sqltypes.ValueToProto(lrow[col]))
+				}
+			}
+			if len(values.Values) == 0 {
+				continue
+			}
+			idx := i
+			vars := combineVars(bindVars, map[string]*querypb.BindVariable{name: values})
+			g.Go(func() error {
+				// the fields are always needed to compare the values with the right collation
+				result, err := vcursor.ExecutePrimitive(jn.Right, vars, true)
+				if err != nil {
+					return err
+				}
+				results[idx] = result
+				return nil
+			})
+		}
+		err := g.Wait()
+		restoreCtx()
+		if err != nil {
+			return nil, err
+		}
+
+		for i, batch := range batches {
+			if rfields == nil && results[i] != nil {
+				rfields = results[i].Fields
+			}
+			rows, err := jn.joinBatch(batch, col, results[i])
+			if err != nil {
+				return nil, err
+			}
+			if len(rows) == 0 {
+				continue
+			}
+			if err := callback(rows); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rfields, nil
+}
+
+// joinBatch pairs the rows of the RHS result with the LHS rows of the batch they were
+// fetched for. The RHS result is nil if the RHS did not need to run for the batch.
+func (jn *Join) joinBatch(batch [][]sqltypes.Value, col int, rresult *sqltypes.Result) ([][]sqltypes.Value, error) {
+	matches := make([][][]sqltypes.Value, len(batch))
+	if rresult != nil {
+		collation := collations.Unknown
+		if jn.BatchColumn < len(rresult.Fields) {
+			collation = collations.ID(rresult.Fields[jn.BatchColumn].Charset)
+		}
+		for _, rrow := range rresult.Rows {
+			rval := rrow[jn.BatchColumn]
+			for i, lrow := range batch {
+				if lrow[col].IsNull() {
+					continue
+				}
+				cmp, err := evalengine.NullsafeCompare(lrow[col], rval, collation)
+				if err != nil {
+					return nil, err
+				}
+				if cmp == 0 {
+					matches[i] = append(matches[i], rrow)
+				}
+			}
+		}
+	}
+
+	var rows [][]sqltypes.Value
+	for i, lrow := range batch {
+		for _, rrow := range matches[i] {
+			rows = append(rows, joinRows(lrow, rrow, jn.Cols))
+		}
+		if jn.Opcode == LeftJoin && len(matches[i]) == 0 {
+			rows = append(rows, joinRows(lrow, nil, jn.Cols))
+		}
+	}
+	return rows, nil
+}
+
+// batchVar returns the name of the join variable bound to the values of a batch,
+// and the offset of these values in the LHS rows.
+func (jn *Join) batchVar() (string, int, error) {
+	if len(jn.Vars) != 1 {
+		return "", 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] batched join needs exactly one join variable, got %d", len(jn.Vars))
+	}
+	for name, col := range jn.Vars {
+		return name, col, nil
+	}
+	return "", 0, nil
+}
+
+func (jn *Join) nullJoinVars(bindVars map[string]*querypb.BindVariable) map[string]*querypb.BindVariable {
+	joinVars := make(map[string]*querypb.BindVariable, len(jn.Vars))
+	for k := range jn.Vars {
+		joinVars[k] = sqltypes.NullBindVariable
+	}
+	return combineVars(bindVars, joinVars)
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// tableLookup is a fake RHS that returns the rows of a table whose column matches
+// the join variable, which is either a single value or the tuple of a batch.
+type tableLookup struct {
+	fakePrimitive
+
+	result *sqltypes.Result
+	name   string
+	col    int
+	calls  int64
+}
+
+func (tl *tableLookup) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	atomic.AddInt64(&tl.calls, 1)
+	bv := bindVars[tl.name]
+	values := bv.Values
+	if bv.Type != querypb.Type_TUPLE {
+		values = []*querypb.Value{{Type: bv.Type, Value: bv.Value}}
+	}
+	collation := collations.ID(tl.result.Fields[tl.col].Charset)
+	result := &sqltypes.Result{Fields: tl.result.Fields}
+	for _, row := range tl.result.Rows {
+		for _, value := range values {
+			cmp, err := evalengine.NullsafeCompare(row[tl.col], sqltypes.ProtoToValue(value), collation)
+			if err != nil {
+				return nil, err
+			}
+			if cmp == 0 && !row[tl.col].IsNull() {
+				result.Rows = append(result.Rows, row)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+func (tl *tableLookup) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := tl.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+func (tl *tableLookup) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: tl.result.Fields}, nil
+}
+
+func TestJoinBatched(t *testing.T) {
+	leftFields := sqltypes.MakeTestFields("id|col", "int64|varchar")
+	var leftRows []string
+	for i := 0; i < 25; i++ {
+		switch {
+		case i%7 == 3:
+			leftRows = append(leftRows, fmt.Sprintf("%d|null", i))
+		case i%2 == 0:
+			leftRows = append(leftRows, fmt.Sprintf("%d|V%d", i, i%5))
+		default:
+			leftRows = append(leftRows, fmt.Sprintf("%d|v%d", i, i%5))
+		}
+	}
+	rightFields := sqltypes.MakeTestFields("rid|col", "int64|varchar")
+	rightFields[1].Charset = uint32(collations.Local().LookupByName("utf8mb4_general_ci").ID())
+	right := &tableLookup{
+		result: sqltypes.MakeTestResult(rightFields, "100|v0", "101|v1", "102|V1", "103|v3", "104|null"),
+		name:   "col",
+		col:    1,
+	}
+
+	for _, opcode := range []JoinOpcode{InnerJoin, LeftJoin} {
+		t.Run(opcode.String(), func(t *testing.T) {
+			left := &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(leftFields, leftRows...)}}
+			jn := &Join{
+				Opcode: opcode,
+				Left:   left,
+				Right:  right,
+				Cols:   []int{-1, -2, 1},
+				Vars:   map[string]int{"col": 1},
+			}
+
+			testIgnoreMaxMemoryRows = true
+			defer func() { testIgnoreMaxMemoryRows = false }()
+
+			right.calls = 0
+			want, err := jn.TryExecute(&noopVCursor{}, nil, true)
+			require.NoError(t, err)
+			require.EqualValues(t, 25, right.calls)
+
+			jn.BatchSize = 3
+			jn.BatchColumn = 1
+			right.calls = 0
+			left.rewind()
+			got, err := jn.TryExecute(&noopVCursor{ctx: context.Background()}, nil, true)
+			require.NoError(t, err)
+			assert.Equal(t, want.Fields, got.Fields)
+			assert.Equal(t, fmt.Sprintf("%v", want.Rows), fmt.Sprintf("%v", got.Rows))
+			// the last batch only holds a NULL and does not need to run
+			assert.EqualValues(t, 8, right.calls)
+
+			left.rewind()
+			got, err = wrapStreamExecute(jn, &noopVCursor{ctx: context.Background()}, nil, true)
+			require.NoError(t, err)
+			assert.Equal(t, want.Fields, got.Fields)
+			assert.Equal(t, fmt.Sprintf("%v", want.Rows), fmt.Sprintf("%v", got.Rows))
+		})
+	}
+}
+
+func TestJoinBatchedDescription(t *testing.T) {
+	jn := &Join{
+		Opcode:      InnerJoin,
+		Left:        &fakePrimitive{},
+		Right:       &fakePrimitive{},
+		Cols:        []int{-1, 1},
+		Vars:        map[string]int{"col": 0},
+		BatchSize:   100,
+		BatchColumn: 1,
+	}
+	other := jn.description().Other
+	assert.Equal(t, 100, other["BatchSize"])
+	assert.Equal(t, 1, other["BatchColumn"])
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/key"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+var _ Primitive = (*MaterializedView)(nil)
+
+// MaterializedView represents the instructions to create, drop or refresh
+// a materialized view. The request is handed to vtctld, which manages the
+// vreplication workflow that maintains the view.
+type MaterializedView struct {
+	// Keyspace is the keyspace the view is materialized in.
+	Keyspace *vindexes.Keyspace
+	// SourceKeyspace is the keyspace of the table the view selects from.
+	// It is only set for CREATE.
+	SourceKeyspace string
+	Stmt           *sqlparser.MaterializedViewDDL
+	Query          string
+
+	noTxNeeded
+
+	noInputs
+}
+
+func (v *MaterializedView) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"query": v.Query,
+	}
+	if v.SourceKeyspace != "" {
+		other["SourceKeyspace"] = v.SourceKeyspace
+	}
+	return PrimitiveDescription{
+		OperatorType: "MaterializedView",
+		Keyspace:     v.Keyspace,
+		Other:        other,
+	}
+}
+
+// RouteType implements the Primitive interface
+func (v *MaterializedView) RouteType() string {
+	return "MaterializedView"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (v *MaterializedView) GetKeyspaceName() string {
+	return v.Keyspace.Name
+}
+
+// GetTableName implements the Primitive interface
+func (v *MaterializedView) GetTableName() string {
+	return v.Stmt.ViewName.Name.String()
+}
+
+// TryExecute implements the Primitive interface
+func (v *MaterializedView) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{
+				Name: "uuid",
+				Type: sqltypes.VarChar,
+			},
+		},
+		Rows: [][]sqltypes.Value{},
+	}
+	mv, err := schema.NewMaterializedView(v.GetKeyspaceName(), v.SourceKeyspace, v.Stmt)
+	if err != nil {
+		return nil, err
+	}
+	// Submit a request entry in topo. vtctld will take it from there
+	if err := vcursor.SubmitMaterializedView(mv); err != nil {
+		return nil, err
+	}
+	result.Rows = append(result.Rows, []sqltypes.Value{
+		sqltypes.NewVarChar(mv.UUID),
+	})
+	return result, nil
+}
+
+// TryStreamExecute implements the Primitive interface
+func (v *MaterializedView) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := v.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (v *MaterializedView) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] GetFields is not reachable")
+}
+
+var _ Primitive = (*ShowMaterializedViews)(nil)
+
+// ShowMaterializedViews lists the materialized views of a keyspace. Input reads the
+// streams of their workflows from _vt.vreplication. The last failed request of each
+// view is read from topo, and reported in the Error state: Select is run on a single
+// shard against a derived table of the failed requests, so that it filters them like
+// it filters the streams.
+type ShowMaterializedViews struct {
+	Keyspace *vindexes.Keyspace
+	Select   *sqlparser.Select
+	Input    Primitive
+
+	noTxNeeded
+}
+
+func (v *ShowMaterializedViews) description() PrimitiveDescription {
+	return PrimitiveDescription{
+		OperatorType: "ShowMaterializedViews",
+		Keyspace:     v.Keyspace,
+	}
+}
+
+// RouteType implements the Primitive interface
+func (v *ShowMaterializedViews) RouteType() string {
+	return "ShowMaterializedViews"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (v *ShowMaterializedViews) GetKeyspaceName() string {
+	return v.Keyspace.Name
+}
+
+// GetTableName implements the Primitive interface
+func (v *ShowMaterializedViews) GetTableName() string {
+	return ""
+}
+
+// Inputs implements the Primitive interface
+func (v *ShowMaterializedViews) Inputs() []Primitive {
+	return []Primitive{v.Input}
+}
+
+// TryExecute implements the Primitive interface
+func (v *ShowMaterializedViews) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := vcursor.ExecutePrimitive(v.Input, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	failures, err := vcursor.FailedMaterializedViews(v.GetKeyspaceName())
+	if err != nil {
+		return nil, err
+	}
+	if len(failures) == 0 {
+		return result, nil
+	}
+	failed := &Send{
+		Keyspace:          v.Keyspace,
+		TargetDestination: key.DestinationAnyShard{},
+		Query:             sqlparser.String(failedMaterializedViewsSelect(v.Select, failures)),
+	}
+	qr, err := vcursor.ExecutePrimitive(failed, bindVars, false)
+	if err != nil {
+		return nil, err
+	}
+	result.Rows = append(result.Rows, qr.Rows...)
+	return result, nil
+}
+
+// TryStreamExecute implements the Primitive interface
+func (v *ShowMaterializedViews) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := v.TryExecute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (v *ShowMaterializedViews) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return v.Input.GetFields(vcursor, bindVars)
+}
+
+// failedMaterializedViewsSelect replaces the _vt.vreplication table of sel with a derived
+// table that has a row for each failed request, with the columns sel reads.
+func failedMaterializedViewsSelect(sel *sqlparser.Select, failures []*schema.MaterializedView) *sqlparser.Select {
+	var rows sqlparser.SelectStatement
+	for _, mv := range failures {
+		row := &sqlparser.Select{
+			SelectExprs: sqlparser.SelectExprs{
+				&sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral(mv.Name), As: sqlparser.NewColIdent("workflow")},
+				&sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral(binlogplayer.BlpError), As: sqlparser.NewColIdent("state")},
+				&sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral(fmt.Sprintf("%s: %s", mv.SQL, mv.Message)), As: sqlparser.NewColIdent("message")},
+				&sqlparser.AliasedExpr{Expr: sqlparser.NewIntLiteral("0"), As: sqlparser.NewColIdent("rows_copied")},
+				&sqlparser.AliasedExpr{Expr: sqlparser.NewIntLiteral(strconv.FormatInt(mv.RequestTime/int64(time.Second), 10)), As: sqlparser.NewColIdent("time_updated")},
+				&sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral(""), As: sqlparser.NewColIdent("source")},
+				&sqlparser.AliasedExpr{Expr: sqlparser.NewStrLiteral(schema.MaterializedViewTag), As: sqlparser.NewColIdent("tags")},
+			},
+			From: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent("dual")}}},
+		}
+		if rows == nil {
+			rows = row
+			continue
+		}
+		rows = &sqlparser.Union{Left: rows, Right: row}
+	}
+	failed := sqlparser.CloneRefOfSelect(sel)
+	failed.From = sqlparser.TableExprs{&sqlparser.AliasedTableExpr{
+		Expr: &sqlparser.DerivedTable{Select: rows},
+		As:   sqlparser.NewTableIdent("failures"),
+	}}
+	return failed
+}
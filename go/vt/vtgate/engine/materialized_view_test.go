@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+func TestShowMaterializedViews(t *testing.T) {
+	query := "select workflow as name, state, message, rows_copied, time_updated, source from _vt.vreplication where tags = 'materialized_view' and workflow like 'm%'"
+	stmt, err := sqlparser.Parse(query)
+	require.NoError(t, err)
+	ks := &vindexes.Keyspace{Name: "ks", Sharded: true}
+	show := &ShowMaterializedViews{
+		Keyspace: ks,
+		Select:   stmt.(*sqlparser.Select),
+		Input: &Send{
+			Keyspace:          ks,
+			TargetDestination: key.DestinationAllShards{},
+			Query:             query,
+		},
+	}
+
+	fields := sqltypes.MakeTestFields("name|state|message|rows_copied|time_updated|source", "varbinary|varbinary|varbinary|int64|int64|varbinary")
+	vc := &loggingVCursor{
+		shards: []string{"-80", "80-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(fields, "mv1|Running||10|1650000000|keyspace:\"src\""),
+			sqltypes.MakeTestResult(fields, "mv2|Error|refresh materialized view mv2: no primary|0|1650000001|"),
+		},
+		failedMaterializedViews: []*schema.MaterializedView{{
+			Keyspace:    "ks",
+			Name:        "mv2",
+			SQL:         "refresh materialized view mv2",
+			RequestTime: 1650000001000000000,
+			Message:     "no primary",
+		}},
+	}
+	result, err := show.TryExecute(vc, nil, true)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.-80: ` + query + ` {} ks.80-: ` + query + ` {} false false`,
+		`FailedMaterializedViews ks`,
+		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+		"ExecuteMultiShard ks.-80: select workflow as `name`, state, message, rows_copied, time_updated, source from " +
+			`(select 'mv2' as workflow, 'Error' as state, 'refresh materialized view mv2: no primary' as message, 0 as rows_copied, 1650000001 as time_updated, '' as source, 'materialized_view' as tags from dual) as failures ` +
+			`where tags = 'materialized_view' and workflow like 'm%' {} false false`,
+	})
+	expectResult(t, "show", result, sqltypes.MakeTestResult(fields,
+		"mv1|Running||10|1650000000|keyspace:\"src\"",
+		"mv2|Error|refresh materialized view mv2: no primary|0|1650000001|",
+	))
+}
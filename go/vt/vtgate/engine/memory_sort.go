@@ -66,6 +66,12 @@ func (ms *MemorySort) SetTruncateColumnCount(count int) {
 
 // TryExecute satisfies the Primitive interface.
 func (ms *MemorySort) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	if vcursor.MemoryBudget().enabled() {
+		return collectStreamed(func(callback func(*sqltypes.Result) error) error {
+			return ms.TryStreamExecute(vcursor, bindVars, wantfields, callback)
+		})
+	}
+
 	count, err := ms.fetchCount(vcursor, bindVars)
 	if err != nil {
 		return nil, err
@@ -107,6 +113,19 @@ func (ms *MemorySort) TryStreamExecute(vcursor VCursor, bindVars map[string]*que
 		comparers: extractSlices(ms.OrderBy),
 		reverse:   true,
 	}
+
+	// When the query has a memory budget, the rows that do not fit in it are
+	// sorted and written to disk as runs, which are merged back at the end.
+	budget := vcursor.MemoryBudget()
+	var runs []*spillFile
+	var held int64
+	defer func() {
+		budget.shrink(held)
+		for _, run := range runs {
+			run.close()
+		}
+	}()
+
 	err = vcursor.StreamExecutePrimitive(ms.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
 			if err := cb(&sqltypes.Result{Fields: qr.Fields}); err != nil {
@@ -115,10 +134,24 @@ func (ms *MemorySort) TryStreamExecute(vcursor VCursor, bindVars map[string]*que
 		}
 		for _, row := range qr.Rows {
 			heap.Push(sh, row)
+			size := rowSize(row)
+			budget.grow(size)
+			held += size
 			// Remove the highest element from the heap if the size is more than the count
 			// This optimization means that the maximum size of the heap is going to be (count + 1)
 			for len(sh.rows) > count {
-				_ = heap.Pop(sh)
+				size := rowSize(heap.Pop(sh).([]sqltypes.Value))
+				budget.shrink(size)
+				held -= size
+			}
+			if budget.mustSpill(held) {
+				run, err := ms.spillRun(budget, sh, count)
+				if err != nil {
+					return err
+				}
+				runs = append(runs, run)
+				budget.shrink(held)
+				held = 0
 			}
 		}
 		if vcursor.ExceedsMaxMemoryRows(len(sh.rows)) {
@@ -139,7 +172,45 @@ func (ms *MemorySort) TryStreamExecute(vcursor VCursor, bindVars map[string]*que
 		// Unreachable.
 		return sh.err
 	}
-	return cb(&sqltypes.Result{Rows: sh.rows})
+	if len(runs) == 0 {
+		return cb(&sqltypes.Result{Rows: sh.rows})
+	}
+
+	sortedRuns := []*sortedRun{{rows: sh.rows}}
+	for _, run := range runs {
+		reader, err := run.reader()
+		if err != nil {
+			return err
+		}
+		sortedRuns = append(sortedRuns, &sortedRun{reader: reader})
+	}
+	return mergeSortedRuns(sortedRuns, sh.comparers, count, cb)
+}
+
+// spillRun sorts the rows held in the heap and writes the first count of them
+// to a new spill file. The heap is left empty.
+func (ms *MemorySort) spillRun(budget *MemoryBudget, sh *sortHeap, count int) (*spillFile, error) {
+	sh.reverse = false
+	sort.Sort(sh)
+	sh.reverse = true
+	if sh.err != nil {
+		return nil, sh.err
+	}
+	run, err := budget.newSpillFile()
+	if err != nil {
+		return nil, err
+	}
+	for i, row := range sh.rows {
+		if i == count {
+			break
+		}
+		if err := run.write(row); err != nil {
+			run.close()
+			return nil, err
+		}
+	}
+	sh.rows = nil
+	return run, nil
 }
 
 // GetFields satisfies the Primitive interface.
@@ -219,6 +219,24 @@ func (oa *OrderedAggregate) TryExecute(vcursor VCursor, bindVars map[string]*que
 }
 
 func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	// The input is sorted on the grouping keys, so when the query has a memory budget
+	// it is streamed to hold only the current group instead of all the input rows.
+	if vcursor.MemoryBudget().enabled() {
+		out, err := collectStreamed(func(callback func(*sqltypes.Result) error) error {
+			return oa.streamExecute(vcursor, bindVars, wantfields, callback)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if last := len(out.Rows) - 1; last >= 0 {
+			out.Rows[last], err = convertFinal(out.Rows[last], oa.Aggregates)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return out, nil
+	}
+
 	result, err := vcursor.ExecutePrimitive(oa.Input, bindVars, wantfields)
 	if err != nil {
 		return nil, err
@@ -263,14 +281,16 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 
 // TryStreamExecute is a Primitive function.
 func (oa *OrderedAggregate) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return oa.streamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		return callback(qr.Truncate(oa.TruncateColumnCount))
+	})
+}
+
+func (oa *OrderedAggregate) streamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, cb func(*sqltypes.Result) error) error {
 	var current []sqltypes.Value
 	var curDistincts []sqltypes.Value
 	var fields []*querypb.Field
 
-	cb := func(qr *sqltypes.Result) error {
-		return callback(qr.Truncate(oa.TruncateColumnCount))
-	}
-
 	err := vcursor.StreamExecutePrimitive(oa.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
 			fields = convertFields(qr.Fields, oa.PreProcess, oa.Aggregates)
@@ -62,6 +62,10 @@ type (
 		// if the max memory rows override directive is set to true
 		ExceedsMaxMemoryRows(numRows int) bool
 
+		// MemoryBudget returns the memory budget of the query, past which
+		// primitives spill rows to disk. Returns nil if spilling is disabled.
+		MemoryBudget() *MemoryBudget
+
 		// SetContextTimeout updates the context and sets a timeout.
 		SetContextTimeout(timeout time.Duration) context.CancelFunc
 
@@ -94,6 +98,11 @@ type (
 
 		SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error
 
+		SubmitMaterializedView(mv *schema.MaterializedView) error
+
+		// FailedMaterializedViews returns the last failed request of each materialized view of the keyspace.
+		FailedMaterializedViews(keyspace string) ([]*schema.MaterializedView, error)
+
 		Session() SessionActions
 
 		ConnCollation() collations.ID
@@ -180,12 +189,16 @@ type (
 		BindVarNeeds *sqlparser.BindVarNeeds // Stores BindVars needed to be provided as part of expression rewriting
 		Warnings     []*querypb.QueryWarning // Warnings that need to be yielded every time this query runs
 
+		ResultCacheTTL    time.Duration // How long the results of this plan may be cached by vtgate, 0 if they may not
+		ResultCacheTables []string      // Tables, as keyspace.table, whose changes invalidate the cached results
+
 		ExecCount    uint64 // Count of times this plan was executed
 		ExecTime     uint64 // Total execution time
 		ShardQueries uint64 // Total number of shard queries
 		RowsReturned uint64 // Total number of rows
 		RowsAffected uint64 // Total number of rows
 		Errors       uint64 // Total number of errors
+		SpilledBytes uint64 // Total number of bytes spilled to disk
 	}
 
 	// Match is used to check if a Primitive matches
@@ -230,13 +243,14 @@ type (
 )
 
 // AddStats updates the plan execution statistics
-func (p *Plan) AddStats(execCount uint64, execTime time.Duration, shardQueries, rowsAffected, rowsReturned, errors uint64) {
+func (p *Plan) AddStats(execCount uint64, execTime time.Duration, shardQueries, rowsAffected, rowsReturned, errors, spilledBytes uint64) {
 	atomic.AddUint64(&p.ExecCount, execCount)
 	atomic.AddUint64(&p.ExecTime, uint64(execTime))
 	atomic.AddUint64(&p.ShardQueries, shardQueries)
 	atomic.AddUint64(&p.RowsAffected, rowsAffected)
 	atomic.AddUint64(&p.RowsReturned, rowsReturned)
 	atomic.AddUint64(&p.Errors, errors)
+	atomic.AddUint64(&p.SpilledBytes, spilledBytes)
 }
 
 // Stats returns a copy of the plan execution statistics
@@ -287,6 +301,9 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		RowsAffected uint64                `json:",omitempty"`
 		RowsReturned uint64                `json:",omitempty"`
 		Errors       uint64                `json:",omitempty"`
+		SpilledBytes uint64                `json:",omitempty"`
+
+		ResultCacheTTL time.Duration `json:",omitempty"`
 	}{
 		QueryType:    p.Type.String(),
 		Original:     p.Original,
@@ -297,6 +314,9 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		RowsAffected: atomic.LoadUint64(&p.RowsAffected),
 		RowsReturned: atomic.LoadUint64(&p.RowsReturned),
 		Errors:       atomic.LoadUint64(&p.Errors),
+		SpilledBytes: atomic.LoadUint64(&p.SpilledBytes),
+
+		ResultCacheTTL: p.ResultCacheTTL,
 	}
 	return json.Marshal(marshalPlan)
 }
@@ -40,12 +40,13 @@ func (p *Projection) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bi
 
 	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
 	env.Fields = result.Fields
+	exprs := p.compile(env)
 	var rows [][]sqltypes.Value
 	for _, row := range result.Rows {
 		env.Row = row
-		resRow := make([]sqltypes.Value, 0, len(p.Exprs))
-		for _, exp := range p.Exprs {
-			result, err := env.Evaluate(exp)
+		resRow := make([]sqltypes.Value, 0, len(exprs))
+		for _, exp := range exprs {
+			result, err := exp.Evaluate(env)
 			if err != nil {
 				return nil, err
 			}
@@ -71,29 +72,40 @@ func (p *Projection) TryStreamExecute(vcursor VCursor, bindVars map[string]*quer
 	}
 
 	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
-	if wantields {
-		err = p.addFields(env, result)
-		if err != nil {
-			return err
-		}
-	}
-	var rows [][]sqltypes.Value
 	env.Fields = result.Fields
+	exprs := p.compile(env)
+	var rows [][]sqltypes.Value
 	for _, row := range result.Rows {
 		env.Row = row
-		for _, exp := range p.Exprs {
-			result, err := env.Evaluate(exp)
+		resRow := make([]sqltypes.Value, 0, len(exprs))
+		for _, exp := range exprs {
+			result, err := exp.Evaluate(env)
 			if err != nil {
 				return err
 			}
-			row = append(row, result.Value())
+			resRow = append(resRow, result.Value())
+		}
+		rows = append(rows, resRow)
+	}
+	if wantields {
+		err = p.addFields(env, result)
+		if err != nil {
+			return err
 		}
-		rows = append(rows, row)
 	}
 	result.Rows = rows
 	return callback(result)
 }
 
+// compile compiles the expressions of the projection for the fields of the environment
+func (p *Projection) compile(env *evalengine.ExpressionEnv) []*evalengine.Program {
+	exprs := make([]*evalengine.Program, 0, len(p.Exprs))
+	for _, exp := range p.Exprs {
+		exprs = append(exprs, env.Compile(exp))
+	}
+	return exprs
+}
+
 // GetFields implements the Primitive interface
 func (p *Projection) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	qr, err := p.Input.GetFields(vcursor, bindVars)
@@ -101,6 +113,7 @@ func (p *Projection) GetFields(vcursor VCursor, bindVars map[string]*querypb.Bin
 		return nil, err
 	}
 	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	env.Fields = qr.Fields
 	err = p.addFields(env, qr)
 	if err != nil {
 		return nil, err
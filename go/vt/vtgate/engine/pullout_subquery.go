@@ -123,33 +123,43 @@ func (ps *PulloutSubquery) execSubquery(vcursor VCursor, bindVars map[string]*qu
 	for k, v := range bindVars {
 		combinedVars[k] = v
 	}
-	switch ps.Opcode {
+	err = bindSubqueryResult(ps.Opcode, ps.SubqueryResult, ps.HasValues, result, combinedVars)
+	if err != nil {
+		return nil, err
+	}
+	return combinedVars, nil
+}
+
+// bindSubqueryResult adds the bind variables holding the result of a subquery to the given map.
+// The bind variables that are set depend on the opcode of the subquery.
+func bindSubqueryResult(opcode PulloutOpcode, subqueryResult, hasValues string, result *sqltypes.Result, combinedVars map[string]*querypb.BindVariable) error {
+	switch opcode {
 	case PulloutValue:
 		switch len(result.Rows) {
 		case 0:
-			combinedVars[ps.SubqueryResult] = sqltypes.NullBindVariable
+			combinedVars[subqueryResult] = sqltypes.NullBindVariable
 		case 1:
 			if len(result.Rows[0]) != 1 {
-				return nil, errSqColumn
+				return errSqColumn
 			}
-			combinedVars[ps.SubqueryResult] = sqltypes.ValueBindVariable(result.Rows[0][0])
+			combinedVars[subqueryResult] = sqltypes.ValueBindVariable(result.Rows[0][0])
 		default:
-			return nil, errSqRow
+			return errSqRow
 		}
 	case PulloutIn, PulloutNotIn:
 		switch len(result.Rows) {
 		case 0:
-			combinedVars[ps.HasValues] = sqltypes.Int64BindVariable(0)
+			combinedVars[hasValues] = sqltypes.Int64BindVariable(0)
 			// Add a bogus value. It will not be checked.
-			combinedVars[ps.SubqueryResult] = &querypb.BindVariable{
+			combinedVars[subqueryResult] = &querypb.BindVariable{
 				Type:   querypb.Type_TUPLE,
 				Values: []*querypb.Value{sqltypes.ValueToProto(sqltypes.NewInt64(0))},
 			}
 		default:
 			if len(result.Rows[0]) != 1 {
-				return nil, errSqColumn
+				return errSqColumn
 			}
-			combinedVars[ps.HasValues] = sqltypes.Int64BindVariable(1)
+			combinedVars[hasValues] = sqltypes.Int64BindVariable(1)
 			values := &querypb.BindVariable{
 				Type:   querypb.Type_TUPLE,
 				Values: make([]*querypb.Value, len(result.Rows)),
@@ -157,17 +167,17 @@ func (ps *PulloutSubquery) execSubquery(vcursor VCursor, bindVars map[string]*qu
 			for i, v := range result.Rows {
 				values.Values[i] = sqltypes.ValueToProto(v[0])
 			}
-			combinedVars[ps.SubqueryResult] = values
+			combinedVars[subqueryResult] = values
 		}
 	case PulloutExists:
 		switch len(result.Rows) {
 		case 0:
-			combinedVars[ps.HasValues] = sqltypes.Int64BindVariable(0)
+			combinedVars[hasValues] = sqltypes.Int64BindVariable(0)
 		default:
-			combinedVars[ps.HasValues] = sqltypes.Int64BindVariable(1)
+			combinedVars[hasValues] = sqltypes.Int64BindVariable(1)
 		}
 	}
-	return combinedVars, nil
+	return nil
 }
 
 func (ps *PulloutSubquery) description() PrimitiveDescription {
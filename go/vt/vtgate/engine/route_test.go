@@ -264,6 +264,57 @@ func TestSelectEqualUnique(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectRange(t *testing.T) {
+	vindex, _ := vindexes.NewNumeric("", nil)
+	sel := NewRoute(
+		Range,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex.(vindexes.SingleColumn)
+	sel.Values = []evalengine.Expr{
+		evalengine.NewLiteralInt(1),
+		evalengine.NewBindVar("high", collations.TypedCollation{}),
+	}
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"-20", "20-"},
+		results:      []*sqltypes.Result{defaultSelectResult},
+	}
+	bv := map[string]*querypb.BindVariable{"high": sqltypes.Int64BindVariable(10)}
+	result, err := sel.TryExecute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(0000000000000001-000000000000000a00)`,
+		`ExecuteMultiShard ks.-20: dummy_select {high: type:INT64 value:"10"} ks.20-: dummy_select {high: type:INT64 value:"10"} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	// a NULL bound leaves the range open
+	vc.Rewind()
+	bv["high"] = sqltypes.NullBindVariable
+	_, err = sel.TryExecute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(0000000000000001-)`,
+		`ExecuteMultiShard ks.-20: dummy_select {high: } ks.20-: dummy_select {high: } false false`,
+	})
+
+	// an empty range goes nowhere
+	vc.Rewind()
+	bv["high"] = sqltypes.Int64BindVariable(0)
+	result, err = sel.TryExecute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationNone()`,
+	})
+	expectResult(t, "sel.Execute", result, &sqltypes.Result{})
+}
+
 func TestSelectNone(t *testing.T) {
 	vindex, _ := vindexes.NewHash("", nil)
 	sel := NewRoute(
@@ -696,6 +747,30 @@ func TestSelectNext(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectNextSharded(t *testing.T) {
+	sel := NewRoute(
+		Next,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard ks.-20: dummy_select {} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+}
+
 func TestSelectDBA(t *testing.T) {
 	sel := NewRoute(
 		DBA,
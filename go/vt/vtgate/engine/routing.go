@@ -68,6 +68,11 @@ const (
 	// Is used when the query explicitly sets a target destination:
 	// in the clause e.g: UPDATE `keyspace[-]`.x1 SET foo=1
 	ByDestination
+	// Range is for routing a statement to the shards overlapping a range of values.
+	// Requires: An Ordered Vindex, and two Values for the lower and upper bounds,
+	// where a NULL bound leaves that side of the range open.
+	// Only the Gen4 planner uses it, and only for select statements.
+	Range
 	// NumOpcodes is the number of opcodes
 	NumOpcodes
 )
@@ -84,6 +89,7 @@ var opName = map[Opcode]string{
 	Reference:     "Reference",
 	None:          "None",
 	ByDestination: "ByDestination",
+	Range:         "Range",
 }
 
 // MarshalJSON serializes the Opcode as a JSON string.
@@ -125,7 +131,13 @@ func (rp *RoutingParameters) findRoute(vcursor VCursor, bindVars map[string]*que
 		return nil, nil, nil
 	case DBA:
 		return rp.systemQuery(vcursor, bindVars)
-	case Unsharded, Next:
+	case Next:
+		// A sequence of a sharded keyspace can hand out values from any shard.
+		if rp.Keyspace.Sharded {
+			return rp.anyShard(vcursor, bindVars)
+		}
+		return rp.unsharded(vcursor, bindVars)
+	case Unsharded:
 		return rp.unsharded(vcursor, bindVars)
 	case Reference:
 		return rp.anyShard(vcursor, bindVars)
@@ -154,6 +166,8 @@ func (rp *RoutingParameters) findRoute(vcursor VCursor, bindVars map[string]*que
 		default:
 			return rp.multiEqual(vcursor, bindVars)
 		}
+	case Range:
+		return rp.valueRange(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unsupported opcode: %v", rp.Opcode)
@@ -339,6 +353,27 @@ func (rp *RoutingParameters) equal(vcursor VCursor, bindVars map[string]*querypb
 	return rss, multiBindVars, nil
 }
 
+func (rp *RoutingParameters) valueRange(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	vindex, ok := rp.Vindex.(vindexes.Ordered)
+	if !ok || len(rp.Values) != 2 {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] range routing needs an ordered vindex and two values, got %T and %d values", rp.Vindex, len(rp.Values))
+	}
+	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
+	low, err := env.Evaluate(rp.Values[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	high, err := env.Evaluate(rp.Values[1])
+	if err != nil {
+		return nil, nil, err
+	}
+	destination, err := vindex.MapRange(vcursor, low.Value(), high.Value())
+	if err != nil {
+		return nil, nil, err
+	}
+	return rp.byDestination(vcursor, bindVars, destination)
+}
+
 func (rp *RoutingParameters) equalMultiCol(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
 	env := evalengine.EnvWithBindVars(bindVars, vcursor.ConnCollation())
 	var rowValue []sqltypes.Value
@@ -0,0 +1,404 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"io"
+	"os"
+	"sync/atomic"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+const (
+	// spillPartitions is the number of hash partitions used when a hash join
+	// or a distinct has to move its rows to disk.
+	spillPartitions = 16
+
+	// spillBatchRows is the number of rows sent per callback when rows are
+	// streamed back from disk.
+	spillBatchRows = 1000
+)
+
+// MemoryBudget tracks the memory held by the blocking primitives of a single query.
+// Once a primitive grows past the budget, it moves its rows to temporary files in
+// the spill directory and keeps going from there.
+// A nil MemoryBudget disables spilling altogether.
+type MemoryBudget struct {
+	limit int64
+	dir   string
+
+	used    int64
+	spilled uint64
+}
+
+// NewMemoryBudget returns a budget of limit bytes that spills to files in dir.
+// An empty dir uses the default directory for temporary files.
+func NewMemoryBudget(limit int64, dir string) *MemoryBudget {
+	return &MemoryBudget{limit: limit, dir: dir}
+}
+
+// SpilledBytes returns the number of bytes written to disk under this budget.
+func (mb *MemoryBudget) SpilledBytes() uint64 {
+	if mb == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&mb.spilled)
+}
+
+// grow accounts for size more bytes being held in memory.
+func (mb *MemoryBudget) grow(size int64) {
+	if !mb.enabled() {
+		return
+	}
+	atomic.AddInt64(&mb.used, size)
+}
+
+// shrink gives back size bytes to the budget.
+func (mb *MemoryBudget) shrink(size int64) {
+	if !mb.enabled() {
+		return
+	}
+	atomic.AddInt64(&mb.used, -size)
+}
+
+// enabled returns true if the primitives should spill to disk instead
+// of holding an unbounded amount of rows.
+func (mb *MemoryBudget) enabled() bool {
+	return mb != nil && mb.limit > 0
+}
+
+// mustSpill returns true if a primitive holding held bytes should move them to disk.
+// Primitives holding only a small share of the budget keep their rows in memory,
+// so that they don't write a new spill file for every row they get while another
+// primitive of the same query holds most of the budget.
+func (mb *MemoryBudget) mustSpill(held int64) bool {
+	if !mb.enabled() || held < mb.limit/spillPartitions {
+		return false
+	}
+	return atomic.LoadInt64(&mb.used) > mb.limit
+}
+
+// rowSize estimates the memory held by a row.
+func rowSize(r row) int64 {
+	size := int64(24)
+	for _, v := range r {
+		size += 32 + int64(len(v.Raw()))
+	}
+	return size
+}
+
+// spillFile is a temporary file holding a sequence of rows.
+type spillFile struct {
+	budget *MemoryBudget
+	file   *os.File
+	w      *bufio.Writer
+	buf    []byte
+
+	scratch [binary.MaxVarintLen64]byte
+}
+
+func (mb *MemoryBudget) newSpillFile() (*spillFile, error) {
+	file, err := os.CreateTemp(mb.dir, "vtgate-spill-*")
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot create spill file")
+	}
+	return &spillFile{
+		budget: mb,
+		file:   file,
+		w:      bufio.NewWriter(file),
+	}, nil
+}
+
+// write appends a row to the file. Every value is stored as its type and
+// length followed by its raw bytes.
+func (sf *spillFile) write(r row) error {
+	sf.buf = sf.appendUvarint(sf.buf[:0], uint64(len(r)))
+	for _, v := range r {
+		sf.buf = sf.appendUvarint(sf.buf, uint64(v.Type()))
+		sf.buf = sf.appendUvarint(sf.buf, uint64(len(v.Raw())))
+		sf.buf = append(sf.buf, v.Raw()...)
+	}
+	if _, err := sf.w.Write(sf.buf); err != nil {
+		return vterrors.Wrapf(err, "cannot write to spill file")
+	}
+	atomic.AddUint64(&sf.budget.spilled, uint64(len(sf.buf)))
+	return nil
+}
+
+func (sf *spillFile) appendUvarint(buf []byte, x uint64) []byte {
+	n := binary.PutUvarint(sf.scratch[:], x)
+	return append(buf, sf.scratch[:n]...)
+}
+
+// reader flushes the file and returns a reader for the rows written so far.
+func (sf *spillFile) reader() (*spillReader, error) {
+	if err := sf.w.Flush(); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot write to spill file")
+	}
+	if _, err := sf.file.Seek(0, io.SeekStart); err != nil {
+		return nil, vterrors.Wrapf(err, "cannot read spill file")
+	}
+	return &spillReader{r: bufio.NewReader(sf.file)}, nil
+}
+
+// close closes and removes the file.
+func (sf *spillFile) close() {
+	_ = sf.file.Close()
+	_ = os.Remove(sf.file.Name())
+}
+
+type spillReader struct {
+	r *bufio.Reader
+}
+
+// next returns the next row in the file, or io.EOF once all of them have been read.
+func (sr *spillReader) next() (row, error) {
+	cols, err := binary.ReadUvarint(sr.r)
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot read spill file")
+	}
+	r := make(row, cols)
+	for i := range r {
+		typ, err := binary.ReadUvarint(sr.r)
+		if err != nil {
+			return nil, spillCorrupted(err)
+		}
+		length, err := binary.ReadUvarint(sr.r)
+		if err != nil {
+			return nil, spillCorrupted(err)
+		}
+		raw := make([]byte, length)
+		if _, err := io.ReadFull(sr.r, raw); err != nil {
+			return nil, spillCorrupted(err)
+		}
+		r[i] = sqltypes.MakeTrusted(querypb.Type(typ), raw)
+	}
+	return r, nil
+}
+
+func spillCorrupted(err error) error {
+	return vterrors.Wrapf(err, "spill file is truncated")
+}
+
+// spillPartitionSet hashes rows into a fixed set of spill files, so that every
+// partition can later be processed on its own.
+type spillPartitionSet struct {
+	files [spillPartitions]*spillFile
+}
+
+func (ps *spillPartitionSet) write(mb *MemoryBudget, code evalengine.HashCode, r row) error {
+	idx := uint64(code) % spillPartitions
+	if ps.files[idx] == nil {
+		sf, err := mb.newSpillFile()
+		if err != nil {
+			return err
+		}
+		ps.files[idx] = sf
+	}
+	return ps.files[idx].write(r)
+}
+
+// each calls f with every row of the given partition.
+func (ps *spillPartitionSet) each(idx int, f func(row) error) error {
+	if ps.files[idx] == nil {
+		return nil
+	}
+	sr, err := ps.files[idx].reader()
+	if err != nil {
+		return err
+	}
+	for {
+		r, err := sr.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := f(r); err != nil {
+			return err
+		}
+	}
+}
+
+func (ps *spillPartitionSet) close() {
+	for _, sf := range ps.files {
+		if sf != nil {
+			sf.close()
+		}
+	}
+}
+
+// spillBatcher groups rows coming back from disk into results of spillBatchRows rows.
+type spillBatcher struct {
+	rows     []row
+	callback func(*sqltypes.Result) error
+}
+
+func (sb *spillBatcher) add(r row) error {
+	sb.rows = append(sb.rows, r)
+	if len(sb.rows) >= spillBatchRows {
+		return sb.flush()
+	}
+	return nil
+}
+
+func (sb *spillBatcher) flush() error {
+	if len(sb.rows) == 0 {
+		return nil
+	}
+	rows := sb.rows
+	sb.rows = nil
+	return sb.callback(&sqltypes.Result{Rows: rows})
+}
+
+// sortedRun is one of the sorted inputs of a k-way merge: either a run on disk
+// or the rows still in memory.
+type sortedRun struct {
+	current row
+	reader  *spillReader
+	rows    []row
+}
+
+func (sr *sortedRun) advance() (bool, error) {
+	if sr.reader == nil {
+		if len(sr.rows) == 0 {
+			return false, nil
+		}
+		sr.current, sr.rows = sr.rows[0], sr.rows[1:]
+		return true, nil
+	}
+	r, err := sr.reader.next()
+	if err == io.EOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	sr.current = r
+	return true, nil
+}
+
+// mergeHeap merges sorted runs by the ordering of the comparers.
+type mergeHeap struct {
+	runs      []*sortedRun
+	comparers []*comparer
+	err       error
+}
+
+func (mh *mergeHeap) Len() int {
+	return len(mh.runs)
+}
+
+func (mh *mergeHeap) Less(i, j int) bool {
+	for _, c := range mh.comparers {
+		if mh.err != nil {
+			return true
+		}
+		cmp, err := c.compare(mh.runs[i].current, mh.runs[j].current)
+		if err != nil {
+			mh.err = err
+			return true
+		}
+		if cmp != 0 {
+			return cmp < 0
+		}
+	}
+	return false
+}
+
+func (mh *mergeHeap) Swap(i, j int) {
+	mh.runs[i], mh.runs[j] = mh.runs[j], mh.runs[i]
+}
+
+func (mh *mergeHeap) Push(x interface{}) {
+	mh.runs = append(mh.runs, x.(*sortedRun))
+}
+
+func (mh *mergeHeap) Pop() interface{} {
+	n := len(mh.runs)
+	x := mh.runs[n-1]
+	mh.runs = mh.runs[:n-1]
+	return x
+}
+
+// mergeSortedRuns streams at most count rows out of the sorted runs, in order.
+func mergeSortedRuns(runs []*sortedRun, comparers []*comparer, count int, callback func(*sqltypes.Result) error) error {
+	mh := &mergeHeap{comparers: comparers}
+	for _, run := range runs {
+		ok, err := run.advance()
+		if err != nil {
+			return err
+		}
+		if ok {
+			mh.runs = append(mh.runs, run)
+		}
+	}
+	heap.Init(mh)
+
+	batcher := &spillBatcher{callback: callback}
+	for emitted := 0; mh.Len() > 0 && emitted < count; emitted++ {
+		if mh.err != nil {
+			return mh.err
+		}
+		run := mh.runs[0]
+		if err := batcher.add(run.current); err != nil {
+			return err
+		}
+		ok, err := run.advance()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(mh, 0)
+		} else {
+			heap.Pop(mh)
+		}
+	}
+	if mh.err != nil {
+		return mh.err
+	}
+	return batcher.flush()
+}
+
+// collectStreamed runs a blocking primitive through its streaming implementation
+// and gathers the streamed rows into a single result. TryExecute uses it when the
+// query has a memory budget, so that the primitive spills to disk in both paths.
+func collectStreamed(stream func(callback func(*sqltypes.Result) error) error) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	err := stream(func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 && result.Fields == nil {
+			result.Fields = qr.Fields
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// spillVCursor gives a memory budget to the primitive it executes.
+type spillVCursor struct {
+	noopVCursor
+	budget *MemoryBudget
+}
+
+func (vc *spillVCursor) MemoryBudget() *MemoryBudget {
+	return vc.budget
+}
+
+func newSpillVCursor(t *testing.T, limit int64) *spillVCursor {
+	dir := t.TempDir()
+	t.Cleanup(func() {
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, files, "spill files must be removed")
+	})
+	return &spillVCursor{budget: NewMemoryBudget(limit, dir)}
+}
+
+// streamRows runs the primitive with and without a memory budget,
+// and returns the rows of both runs.
+func streamRows(t *testing.T, prim Primitive, bindVars map[string]*querypb.BindVariable, rewind func()) (inMemory, spilled []string, vc *spillVCursor) {
+	t.Helper()
+	collect := func(vcursor VCursor) []string {
+		rewind()
+		var rows []string
+		err := prim.TryStreamExecute(vcursor, bindVars, true, func(qr *sqltypes.Result) error {
+			for _, row := range qr.Rows {
+				rows = append(rows, fmt.Sprintf("%v", row))
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		return rows
+	}
+	vc = newSpillVCursor(t, 1000)
+	return collect(&noopVCursor{}), collect(vc), vc
+}
+
+// executeRows runs the primitive with TryExecute under a memory budget, and returns its rows.
+func executeRows(t *testing.T, prim Primitive, bindVars map[string]*querypb.BindVariable, rewind func()) (rows []string, vc *spillVCursor) {
+	t.Helper()
+	rewind()
+	vc = newSpillVCursor(t, 1000)
+	qr, err := prim.TryExecute(vc, bindVars, true)
+	require.NoError(t, err)
+	for _, row := range qr.Rows {
+		rows = append(rows, fmt.Sprintf("%v", row))
+	}
+	return rows, vc
+}
+
+func TestSpillFileRoundTrip(t *testing.T) {
+	budget := NewMemoryBudget(1, t.TempDir())
+	sf, err := budget.newSpillFile()
+	require.NoError(t, err)
+	defer sf.close()
+
+	rows := []row{
+		{sqltypes.NewInt64(-1), sqltypes.NewVarChar("abc"), sqltypes.NULL},
+		{sqltypes.NewFloat64(1.5), sqltypes.NewVarBinary(""), sqltypes.NewDecimal("1.25")},
+		{},
+	}
+	for _, r := range rows {
+		require.NoError(t, sf.write(r))
+	}
+	assert.NotZero(t, budget.SpilledBytes())
+
+	sr, err := sf.reader()
+	require.NoError(t, err)
+	for _, want := range rows {
+		got, err := sr.next()
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+	_, err = sr.next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestMemorySortSpill(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|name", "int64|varbinary")
+	var results []*sqltypes.Result
+	for i := 0; i < 5; i++ {
+		var rows []string
+		for j := 0; j < 18; j++ {
+			rows = append(rows, fmt.Sprintf("%d|name%d", (i*37+j*11)%97, i))
+		}
+		results = append(results, sqltypes.MakeTestResult(fields, rows...))
+	}
+	fp := &fakePrimitive{results: results, allResultsInOneCall: true}
+	ms := &MemorySort{
+		OrderBy: []OrderByParams{{Col: 0, WeightStringCol: -1}, {Col: 1, WeightStringCol: -1, Desc: true}},
+		Input:   fp,
+	}
+
+	inMemory, spilled, vc := streamRows(t, ms, nil, fp.rewind)
+	require.Len(t, inMemory, 90)
+	assert.Equal(t, inMemory, spilled)
+	assert.NotZero(t, vc.budget.SpilledBytes())
+
+	ms.UpperLimit = evalengine.NewBindVar("__upper_limit", collations.TypedCollation{})
+	bv := map[string]*querypb.BindVariable{"__upper_limit": sqltypes.Int64BindVariable(60)}
+	inMemory, spilled, vc = streamRows(t, ms, bv, fp.rewind)
+	require.Len(t, inMemory, 60)
+	assert.Equal(t, inMemory, spilled)
+	assert.NotZero(t, vc.budget.SpilledBytes())
+
+	spilled, vc = executeRows(t, ms, bv, fp.rewind)
+	assert.Equal(t, inMemory, spilled)
+	assert.NotZero(t, vc.budget.SpilledBytes())
+}
+
+func TestHashJoinSpill(t *testing.T) {
+	leftFields := sqltypes.MakeTestFields("id|col", "int64|varchar")
+	var leftRows []string
+	for i := 0; i < 100; i++ {
+		leftRows = append(leftRows, fmt.Sprintf("%d|left%d", i%30, i))
+	}
+	leftRows = append(leftRows, "null|left_null")
+	rightFields := sqltypes.MakeTestFields("user_id|other", "int64|varchar")
+	var rightRows []string
+	for i := 0; i < 50; i++ {
+		rightRows = append(rightRows, fmt.Sprintf("%d|right%d", i%40, i))
+	}
+	rightRows = append(rightRows, "null|right_null")
+
+	left := &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(leftFields, leftRows...)}}
+	right := &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(rightFields, rightRows...)}}
+	hj := &HashJoin{
+		Opcode:         InnerJoin,
+		Left:           left,
+		Right:          right,
+		Cols:           []int{-1, -2, 2},
+		LHSKey:         0,
+		RHSKey:         0,
+		ComparisonType: querypb.Type_INT64,
+	}
+
+	rewind := func() {
+		left.rewind()
+		right.rewind()
+	}
+	inMemory, spilled, vc := streamRows(t, hj, nil, rewind)
+	require.NotEmpty(t, inMemory)
+	sort.Strings(inMemory)
+	sort.Strings(spilled)
+	assert.Equal(t, inMemory, spilled)
+	assert.NotZero(t, vc.budget.SpilledBytes())
+
+	spilled, vc = executeRows(t, hj, nil, rewind)
+	sort.Strings(spilled)
+	assert.Equal(t, inMemory, spilled)
+	assert.NotZero(t, vc.budget.SpilledBytes())
+}
+
+func TestDistinctSpill(t *testing.T) {
+	fields := sqltypes.MakeTestFields("id|parity", "int64|int64")
+	var results []*sqltypes.Result
+	for i := 0; i < 10; i++ {
+		var rows []string
+		for j := 0; j < 30; j++ {
+			rows = append(rows, fmt.Sprintf("%d|%d", (i*7+j)%60, j%2))
+		}
+		results = append(results, sqltypes.MakeTestResult(fields, rows...))
+	}
+	fp := &fakePrimitive{results: results, allResultsInOneCall: true}
+	distinct := &Distinct{Source: fp}
+
+	inMemory, spilled, vc := streamRows(t, distinct, nil, fp.rewind)
+	require.Len(t, inMemory, 120)
+	sort.Strings(inMemory)
+	sort.Strings(spilled)
+	assert.Equal(t, inMemory, spilled)
+	assert.NotZero(t, vc.budget.SpilledBytes())
+
+	spilled, vc = executeRows(t, distinct, nil, fp.rewind)
+	sort.Strings(spilled)
+	assert.Equal(t, inMemory, spilled)
+	assert.NotZero(t, vc.budget.SpilledBytes())
+}
+
+func TestOrderedAggregateMemoryBudget(t *testing.T) {
+	fields := sqltypes.MakeTestFields("col|count(*)|weight_string(col)", "varchar|decimal|varbinary")
+	var rows []string
+	for i := 0; i < 100; i++ {
+		rows = append(rows, fmt.Sprintf("k%02d|%d|K%02d", i/4, i%3, i/4))
+	}
+	fp := &fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, rows...)}}
+	oa := &OrderedAggregate{
+		Aggregates:          []*AggregateParams{{Opcode: AggregateSum, Col: 1}},
+		GroupByKeys:         []*GroupByParams{{KeyCol: 0, WeightStringCol: 2}},
+		TruncateColumnCount: 2,
+		Input:               fp,
+	}
+
+	want, err := oa.TryExecute(&noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	require.Len(t, want.Rows, 25)
+	var inMemory []string
+	for _, row := range want.Rows {
+		inMemory = append(inMemory, fmt.Sprintf("%v", row))
+	}
+
+	streamed, vc := executeRows(t, oa, nil, fp.rewind)
+	assert.Equal(t, inMemory, streamed)
+	assert.Zero(t, vc.budget.SpilledBytes())
+}
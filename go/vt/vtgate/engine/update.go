@@ -76,6 +76,9 @@ func (upd *Update) TryExecute(vcursor VCursor, bindVars map[string]*querypb.Bind
 		defer cancel()
 	}
 
+	if err := upd.checkOpcode("update"); err != nil {
+		return nil, err
+	}
 	rss, _, err := upd.findRoute(vcursor, bindVars)
 	if err != nil {
 		return nil, err
@@ -208,6 +208,29 @@ func TestUpdateEqualNoRoute(t *testing.T) {
 	})
 }
 
+func TestUpdateRange(t *testing.T) {
+	vindex, _ := vindexes.NewNumeric("", nil)
+	upd := &Update{
+		DML: &DML{
+			RoutingParameters: &RoutingParameters{
+				Opcode: Range,
+				Keyspace: &vindexes.Keyspace{
+					Name:    "ks",
+					Sharded: true,
+				},
+				Vindex: vindex,
+				Values: []evalengine.Expr{evalengine.NewLiteralInt(1), evalengine.NullExpr},
+			},
+			Query: "dummy_update",
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	_, err := upd.TryExecute(vc, map[string]*querypb.BindVariable{}, false)
+	require.EqualError(t, err, "unsupported: range routing in update statement")
+	vc.ExpectLog(t, nil)
+}
+
 func TestUpdateEqualNoScatter(t *testing.T) {
 	t.Skip("planner does not produces this plan anymore")
 	vindex, _ := vindexes.NewLookupUnique("", map[string]string{
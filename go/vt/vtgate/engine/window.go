@@ -0,0 +1,278 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that evaluates window functions at the vtgate level.
+// The rows coming from Input must already be sorted by the PARTITION BY columns,
+// followed by the ORDER BY columns of the window. This is usually done by
+// merge-sorting the results of a scatter route.
+// The computed values are appended to every row, in the order of Functions.
+type Window struct {
+	// PartitionBy holds the columns that define the partitions of the window.
+	PartitionBy []OrderByParams
+	// OrderBy holds the columns that define the order of rows inside a partition.
+	// Rows that compare equal on these columns are peers.
+	OrderBy   []OrderByParams
+	Functions []*WindowParams
+	Input     Primitive
+}
+
+// WindowParams specifies the parameters for a window function.
+type WindowParams struct {
+	Opcode WindowOpcode
+	Alias  string `json:",omitempty"`
+}
+
+func (wp *WindowParams) String() string {
+	if wp.Alias != "" {
+		return fmt.Sprintf("%s() AS %s", wp.Opcode.String(), wp.Alias)
+	}
+	return fmt.Sprintf("%s()", wp.Opcode.String())
+}
+
+// WindowOpcode is the window function Opcode.
+type WindowOpcode int
+
+// These constants list the possible window function opcodes.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+)
+
+// SupportedWindowFunctions maps the list of window functions
+// that can be evaluated at the vtgate level to their opcodes.
+var SupportedWindowFunctions = map[string]WindowOpcode{
+	"row_number": WindowRowNumber,
+	"rank":       WindowRank,
+	"dense_rank": WindowDenseRank,
+}
+
+func (code WindowOpcode) String() string {
+	for k, v := range SupportedWindowFunctions {
+		if v == code {
+			return k
+		}
+	}
+	panic("unreachable")
+}
+
+// MarshalJSON serializes the WindowOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code WindowOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// windowState keeps track of the current partition while rows are processed.
+// It is kept across calls of the streaming callback.
+type windowState struct {
+	prev      []sqltypes.Value
+	rowNumber int64
+	rank      int64
+	denseRank int64
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// TryExecute is a Primitive function.
+func (w *Window) TryExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	input, err := vcursor.ExecutePrimitive(w.Input, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	out := &sqltypes.Result{
+		Fields: w.convertFields(input.Fields),
+		Rows:   make([][]sqltypes.Value, 0, len(input.Rows)),
+	}
+	state := &windowState{}
+	for _, row := range input.Rows {
+		newRow, err := w.evaluate(state, row)
+		if err != nil {
+			return nil, err
+		}
+		out.Rows = append(out.Rows, newRow)
+	}
+	return out, nil
+}
+
+// TryStreamExecute is a Primitive function.
+func (w *Window) TryStreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	state := &windowState{}
+	return vcursor.StreamExecutePrimitive(w.Input, bindVars, wantfields, func(qr *sqltypes.Result) error {
+		out := &sqltypes.Result{}
+		if len(qr.Fields) != 0 {
+			out.Fields = w.convertFields(qr.Fields)
+		}
+		for _, row := range qr.Rows {
+			newRow, err := w.evaluate(state, row)
+			if err != nil {
+				return err
+			}
+			out.Rows = append(out.Rows, newRow)
+		}
+		return callback(out)
+	})
+}
+
+// GetFields is a Primitive function.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: w.convertFields(qr.Fields)}, nil
+}
+
+// NeedsTransaction implements the Primitive interface
+func (w *Window) NeedsTransaction() bool {
+	return w.Input.NeedsTransaction()
+}
+
+// Inputs returns the Primitive input for this window
+func (w *Window) Inputs() []Primitive {
+	return []Primitive{w.Input}
+}
+
+func (w *Window) convertFields(fields []*querypb.Field) []*querypb.Field {
+	if fields == nil {
+		return nil
+	}
+	newFields := make([]*querypb.Field, 0, len(fields)+len(w.Functions))
+	newFields = append(newFields, fields...)
+	for _, fn := range w.Functions {
+		name := fn.Alias
+		if name == "" {
+			name = fn.Opcode.String() + "()"
+		}
+		newFields = append(newFields, &querypb.Field{
+			Name: name,
+			Type: sqltypes.Uint64,
+		})
+	}
+	return newFields
+}
+
+// evaluate computes the window functions for the given row and returns
+// the row with the computed values appended to it.
+func (w *Window) evaluate(state *windowState, row []sqltypes.Value) ([]sqltypes.Value, error) {
+	if state.prev == nil {
+		state.rowNumber, state.rank, state.denseRank = 1, 1, 1
+	} else {
+		samePartition, err := w.equal(w.PartitionBy, state.prev, row)
+		if err != nil {
+			return nil, err
+		}
+		if !samePartition {
+			state.rowNumber, state.rank, state.denseRank = 1, 1, 1
+		} else {
+			state.rowNumber++
+			peers, err := w.equal(w.OrderBy, state.prev, row)
+			if err != nil {
+				return nil, err
+			}
+			if !peers {
+				state.rank = state.rowNumber
+				state.denseRank++
+			}
+		}
+	}
+	state.prev = row
+
+	newRow := make([]sqltypes.Value, 0, len(row)+len(w.Functions))
+	newRow = append(newRow, row...)
+	for _, fn := range w.Functions {
+		var val int64
+		switch fn.Opcode {
+		case WindowRowNumber:
+			val = state.rowNumber
+		case WindowRank:
+			val = state.rank
+		case WindowDenseRank:
+			val = state.denseRank
+		default:
+			return nil, fmt.Errorf("BUG: Unexpected opcode: %v", fn.Opcode)
+		}
+		newRow = append(newRow, sqltypes.NewUint64(uint64(val)))
+	}
+	return newRow, nil
+}
+
+// equal returns true if the two rows have the same values for all the given columns.
+func (w *Window) equal(columns []OrderByParams, r1, r2 []sqltypes.Value) (bool, error) {
+	for _, c := range columns {
+		cmp, err := evalengine.NullsafeCompare(r1[c.Col], r2[c.Col], c.CollationID)
+		if err != nil {
+			_, isComparisonErr := err.(evalengine.UnsupportedComparisonError)
+			_, isCollationErr := err.(evalengine.UnsupportedCollationError)
+			if !isComparisonErr && !isCollationErr || c.WeightStringCol == -1 {
+				return false, err
+			}
+			// fall back to the weight string column, just like the merge-sort does
+			cmp, err = evalengine.NullsafeCompare(r1[c.WeightStringCol], r2[c.WeightStringCol], c.CollationID)
+			if err != nil {
+				return false, err
+			}
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (w *Window) description() PrimitiveDescription {
+	other := map[string]interface{}{}
+	if len(w.PartitionBy) > 0 {
+		other["PartitionBy"] = GenericJoin(w.PartitionBy, orderByParamsToString)
+	}
+	if len(w.OrderBy) > 0 {
+		other["OrderBy"] = GenericJoin(w.OrderBy, orderByParamsToString)
+	}
+	var functions []string
+	for _, fn := range w.Functions {
+		functions = append(functions, fn.String())
+	}
+	other["Functions"] = strings.Join(functions, ", ")
+	return PrimitiveDescription{
+		OperatorType: "Window",
+		Other:        other,
+	}
+}
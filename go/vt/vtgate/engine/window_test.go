@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+)
+
+func TestWindow(t *testing.T) {
+	type testCase struct {
+		testName       string
+		inputs         *sqltypes.Result
+		partitionBy    []OrderByParams
+		orderBy        []OrderByParams
+		expectedResult *sqltypes.Result
+		expectedError  string
+	}
+
+	functions := []*WindowParams{
+		{Opcode: WindowRowNumber, Alias: "rn"},
+		{Opcode: WindowRank, Alias: "rk"},
+		{Opcode: WindowDenseRank, Alias: "drk"},
+	}
+	col := func(offset int) OrderByParams {
+		return OrderByParams{Col: offset, WeightStringCol: -1, CollationID: collations.Unknown}
+	}
+
+	testCases := []*testCase{{
+		testName:       "empty",
+		inputs:         r("a|b", "int64|int64"),
+		partitionBy:    []OrderByParams{col(0)},
+		orderBy:        []OrderByParams{col(1)},
+		expectedResult: r("a|b|rn|rk|drk", "int64|int64|uint64|uint64|uint64"),
+	}, {
+		testName:       "no partition",
+		inputs:         r("a|b", "int64|int64", "1|1", "1|2", "2|2", "2|3"),
+		orderBy:        []OrderByParams{col(1)},
+		expectedResult: r("a|b|rn|rk|drk", "int64|int64|uint64|uint64|uint64", "1|1|1|1|1", "1|2|2|2|2", "2|2|3|2|2", "2|3|4|4|3"),
+	}, {
+		testName:       "partitions with peers",
+		inputs:         r("a|b", "int64|int64", "1|1", "1|1", "1|5", "2|2", "2|2", "2|2", "2|7", "null|1"),
+		partitionBy:    []OrderByParams{col(0)},
+		orderBy:        []OrderByParams{col(1)},
+		expectedResult: r("a|b|rn|rk|drk", "int64|int64|uint64|uint64|uint64", "1|1|1|1|1", "1|1|2|1|1", "1|5|3|3|2", "2|2|1|1|1", "2|2|2|1|1", "2|2|3|1|1", "2|7|4|4|2", "null|1|1|1|1"),
+	}, {
+		testName:       "no order by makes all rows in a partition peers",
+		inputs:         r("a|b", "int64|int64", "1|1", "1|2", "2|3"),
+		partitionBy:    []OrderByParams{col(0)},
+		expectedResult: r("a|b|rn|rk|drk", "int64|int64|uint64|uint64|uint64", "1|1|1|1|1", "1|2|2|1|1", "2|3|1|1|1"),
+	}, {
+		testName:      "varchar partition without collation",
+		inputs:        r("a|b", "varchar|int64", "x|1", "y|2"),
+		partitionBy:   []OrderByParams{col(0)},
+		expectedError: "cannot compare strings, collation is unknown or unsupported (collation ID: 0)",
+	}, {
+		testName:       "varchar partition falls back to weight string",
+		inputs:         r("a|b|ws", "varchar|int64|varbinary", "x|1|X", "X|2|X", "y|3|Y"),
+		partitionBy:    []OrderByParams{{Col: 0, WeightStringCol: 2, CollationID: collations.Unknown}},
+		expectedResult: r("a|b|ws|rn|rk|drk", "varchar|int64|varbinary|uint64|uint64|uint64", "x|1|X|1|1|1", "X|2|X|2|1|1", "y|3|Y|1|1|1"),
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName+"-Execute", func(t *testing.T) {
+			window := &Window{
+				PartitionBy: tc.partitionBy,
+				OrderBy:     tc.orderBy,
+				Functions:   functions,
+				Input:       &fakePrimitive{results: []*sqltypes.Result{tc.inputs}},
+			}
+
+			qr, err := window.TryExecute(&noopVCursor{ctx: context.Background()}, nil, true)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				got := fmt.Sprintf("%v", qr.Rows)
+				expected := fmt.Sprintf("%v", tc.expectedResult.Rows)
+				utils.MustMatch(t, expected, got, "result not what correct")
+				require.Equal(t, len(tc.expectedResult.Fields), len(qr.Fields))
+			} else {
+				require.EqualError(t, err, tc.expectedError)
+			}
+		})
+		t.Run(tc.testName+"-StreamExecute", func(t *testing.T) {
+			window := &Window{
+				PartitionBy: tc.partitionBy,
+				OrderBy:     tc.orderBy,
+				Functions:   functions,
+				Input:       &fakePrimitive{results: []*sqltypes.Result{tc.inputs}},
+			}
+
+			result, err := wrapStreamExecute(window, &noopVCursor{ctx: context.Background()}, nil, true)
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				got := fmt.Sprintf("%v", result.Rows)
+				expected := fmt.Sprintf("%v", tc.expectedResult.Rows)
+				utils.MustMatch(t, expected, got, "result not what correct")
+			} else {
+				require.EqualError(t, err, tc.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,528 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strconv"
+
+	"vitess.io/vitess/go/hack"
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+type (
+	// Program is an expression compiled into a tree of closures that are specialized for
+	// the types of the fields of the rows it is evaluated on. The closures pass integers,
+	// floats and strings to each other as native Go values, so evaluating a Program does not
+	// allocate an EvalResult for every node of the expression like the interpreter does.
+	// The parts of the expression that cannot be compiled are evaluated by the interpreter,
+	// and the whole expression is evaluated by the interpreter when a row does not have
+	// the types the Program has been compiled for.
+	Program struct {
+		expr Expr
+		root *compiledExpr
+	}
+
+	// compiledExpr is a compiled node of an expression. Depending on its type, the value
+	// of the node is computed by one of its closures, whose second result is true for NULL.
+	// Like in the interpreter, all the operands of a node are always evaluated, so that
+	// the errors they return do not depend on the values of the other operands.
+	// A node with an interp expression has not been compiled and is evaluated by the interpreter.
+	compiledExpr struct {
+		typ  sqltypes.Type
+		coll collations.TypedCollation
+
+		i64 func(env *ExpressionEnv) (int64, bool)
+		f64 func(env *ExpressionEnv) (float64, bool)
+		raw func(env *ExpressionEnv) ([]byte, bool)
+
+		interp Expr
+	}
+
+	// deoptimize is the panic used by compiled code when the values of a row do
+	// not have the type that was expected at compile time
+	deoptimize struct{}
+)
+
+// Compile compiles an expression for the fields of the environment. Compilation never
+// fails: expressions that do not typecheck are evaluated by the interpreter, which
+// returns the same errors as ExpressionEnv.Evaluate for every row.
+func (env *ExpressionEnv) Compile(expr Expr) *Program {
+	prog := &Program{expr: expr}
+	if env.typechecks(expr) {
+		if root := compileExpr(expr, env.Fields); root.interp == nil {
+			prog.root = root
+		}
+	}
+	return prog
+}
+
+// typechecks returns whether the expression typechecks; the expressions that do not
+// are left to the interpreter, which fails in the same way when evaluating them
+func (env *ExpressionEnv) typechecks(expr Expr) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+	env.typecheck(expr)
+	return true
+}
+
+// Evaluate evaluates the program for the current row of the environment. The result
+// is always the same as the one of evaluating the expression with ExpressionEnv.Evaluate.
+func (p *Program) Evaluate(env *ExpressionEnv) (result EvalResult, err error) {
+	if p.root == nil {
+		return env.Evaluate(p.expr)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case evalError:
+				err = r.error
+			case deoptimize:
+				result, err = env.Evaluate(p.expr)
+			default:
+				panic(r)
+			}
+		}
+	}()
+	p.root.eval(env, &result)
+	return
+}
+
+// IsTrue returns whether the program evaluates to the integer 1 for the current row of
+// the environment, which is how the rows matching a predicate are selected.
+func (p *Program) IsTrue(env *ExpressionEnv) (bool, error) {
+	if p.root != nil && p.root.typ == sqltypes.Int64 {
+		if i, null, err := p.evalInt64(env); err != nil || !null {
+			return i == 1, err
+		}
+	}
+	result, err := p.Evaluate(env)
+	if err != nil {
+		return false, err
+	}
+	i, err := result.Value().ToInt64()
+	if err != nil {
+		return false, err
+	}
+	return i == 1, nil
+}
+
+func (p *Program) evalInt64(env *ExpressionEnv) (i int64, null bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case evalError:
+				err = r.error
+			case deoptimize:
+				// let the caller evaluate the expression with the interpreter
+				i, null, err = 0, true, nil
+			default:
+				panic(r)
+			}
+		}
+	}()
+	i, null = p.root.i64(env)
+	return
+}
+
+func (c *compiledExpr) eval(env *ExpressionEnv, result *EvalResult) {
+	var null bool
+	switch c.typ {
+	case sqltypes.Int64:
+		var i int64
+		if i, null = c.i64(env); !null {
+			result.setInt64(i)
+		}
+	case sqltypes.Float64:
+		var f float64
+		if f, null = c.f64(env); !null {
+			result.setFloat(f)
+		}
+	default:
+		var raw []byte
+		if raw, null = c.raw(env); !null {
+			result.setRaw(c.typ, raw, c.coll)
+		}
+	}
+	if null {
+		result.setNull()
+	}
+}
+
+// truth returns the boolean value of the node, or boolNULL if it is NULL
+func (c *compiledExpr) truth(env *ExpressionEnv) boolean {
+	switch {
+	case c.interp != nil:
+		var result EvalResult
+		result.init(env, c.interp)
+		return result.isTruthy()
+	case c.typ == sqltypes.Int64:
+		i, null := c.i64(env)
+		return makeboolean2(i != 0, null)
+	case c.typ == sqltypes.Float64:
+		f, null := c.f64(env)
+		return makeboolean2(f != 0, null)
+	default:
+		raw, null := c.raw(env)
+		return makeboolean2(!null && parseStringToFloat(hack.String(raw)) != 0, null)
+	}
+}
+
+// float returns a closure computing the value of a numeric node as a float
+func (c *compiledExpr) float() func(env *ExpressionEnv) (float64, bool) {
+	if c.typ == sqltypes.Float64 {
+		return c.f64
+	}
+	i64 := c.i64
+	return func(env *ExpressionEnv) (float64, bool) {
+		i, null := i64(env)
+		return float64(i), null
+	}
+}
+
+func (c *compiledExpr) isNumeric() bool {
+	return c.interp == nil && (c.typ == sqltypes.Int64 || c.typ == sqltypes.Float64)
+}
+
+func (c *compiledExpr) isTextual() bool {
+	return c.interp == nil && (c.typ == sqltypes.VarChar || c.typ == sqltypes.VarBinary)
+}
+
+func isTextualColumn(tt sqltypes.Type) bool {
+	return sqltypes.IsText(tt) && tt != sqltypes.HexNum && tt != sqltypes.HexVal
+}
+
+func compileExpr(expr Expr, fields []*querypb.Field) *compiledExpr {
+	var c *compiledExpr
+	switch expr := expr.(type) {
+	case *Literal:
+		c = compileLiteral(expr)
+	case *Column:
+		c = compileColumn(expr, fields)
+	case *ArithmeticExpr:
+		c = compileArithmetic(expr, fields)
+	case *ComparisonExpr:
+		c = compileComparison(expr, fields)
+	case *LogicalExpr:
+		left, right := compileExpr(expr.Left, fields), compileExpr(expr.Right, fields)
+		c = &compiledExpr{typ: sqltypes.Int64, i64: func(env *ExpressionEnv) (int64, bool) {
+			return booleanInt64(expr.op(left.truth(env), right.truth(env)))
+		}}
+	case *IsExpr:
+		c = compileIs(expr, fields)
+	}
+	if c == nil {
+		return &compiledExpr{interp: expr}
+	}
+	return c
+}
+
+func booleanInt64(b boolean) (int64, bool) {
+	switch b {
+	case boolTrue:
+		return 1, false
+	case boolFalse:
+		return 0, false
+	default:
+		return 0, true
+	}
+}
+
+func compileLiteral(lit *Literal) *compiledExpr {
+	val := &lit.Val
+	switch val.typeof() {
+	case sqltypes.Int64:
+		i := val.int64()
+		return &compiledExpr{typ: sqltypes.Int64, i64: func(*ExpressionEnv) (int64, bool) {
+			return i, false
+		}}
+	case sqltypes.Float64:
+		f := val.float64()
+		return &compiledExpr{typ: sqltypes.Float64, f64: func(*ExpressionEnv) (float64, bool) {
+			return f, false
+		}}
+	case sqltypes.VarChar, sqltypes.VarBinary:
+		if val.hasFlag(flagHex | flagBit) {
+			// hex and bit literals are numbers in numeric contexts
+			return nil
+		}
+		raw := val.bytes()
+		return &compiledExpr{typ: val.typeof(), coll: val.collation(), raw: func(*ExpressionEnv) ([]byte, bool) {
+			return raw, false
+		}}
+	}
+	return nil
+}
+
+func compileColumn(col *Column, fields []*querypb.Field) *compiledExpr {
+	if col.Offset >= len(fields) {
+		return nil
+	}
+	offset := col.Offset
+	switch tt := fields[offset].Type; {
+	// only the numeric columns that the interpreter evaluates with their own type are compiled
+	case tt == sqltypes.Int64:
+		return &compiledExpr{typ: sqltypes.Int64, i64: func(env *ExpressionEnv) (int64, bool) {
+			v := env.Row[offset]
+			if v.IsNull() {
+				return 0, true
+			}
+			if v.Type() != sqltypes.Int64 {
+				panic(deoptimize{})
+			}
+			i, err := strconv.ParseInt(hack.String(v.Raw()), 10, 64)
+			if err != nil {
+				panic(deoptimize{})
+			}
+			return i, false
+		}}
+	case tt == sqltypes.Float64:
+		return &compiledExpr{typ: sqltypes.Float64, f64: func(env *ExpressionEnv) (float64, bool) {
+			v := env.Row[offset]
+			if v.IsNull() {
+				return 0, true
+			}
+			if v.Type() != sqltypes.Float64 {
+				panic(deoptimize{})
+			}
+			f, err := strconv.ParseFloat(hack.String(v.Raw()), 64)
+			if err != nil {
+				panic(deoptimize{})
+			}
+			return f, false
+		}}
+	case isTextualColumn(tt), sqltypes.IsBinary(tt):
+		typ, coll, check := sqltypes.VarChar, col.coll, isTextualColumn
+		if sqltypes.IsBinary(tt) {
+			typ, coll, check = sqltypes.VarBinary, collationBinary, sqltypes.IsBinary
+		}
+		return &compiledExpr{typ: typ, coll: coll, raw: func(env *ExpressionEnv) ([]byte, bool) {
+			v := env.Row[offset]
+			if v.IsNull() {
+				return nil, true
+			}
+			if !check(v.Type()) {
+				panic(deoptimize{})
+			}
+			return v.Raw(), false
+		}}
+	}
+	return nil
+}
+
+func compileArithmetic(expr *ArithmeticExpr, fields []*querypb.Field) *compiledExpr {
+	left, right := compileExpr(expr.Left, fields), compileExpr(expr.Right, fields)
+	if !left.isNumeric() || !right.isNumeric() {
+		return nil
+	}
+
+	if left.typ == sqltypes.Int64 && right.typ == sqltypes.Int64 {
+		var op func(l, r int64) int64
+		switch expr.Op.(type) {
+		case *OpAddition:
+			op = func(l, r int64) int64 {
+				result := l + r
+				if (result > l) != (r > 0) {
+					throwEvalError(dataOutOfRangeError(l, r, "BIGINT", "+"))
+				}
+				return result
+			}
+		case *OpSubstraction:
+			op = func(l, r int64) int64 {
+				result := l - r
+				if (result < l) != (r > 0) {
+					throwEvalError(dataOutOfRangeError(l, r, "BIGINT", "-"))
+				}
+				return result
+			}
+		case *OpMultiplication:
+			op = func(l, r int64) int64 {
+				result := l * r
+				if l != 0 && result/l != r {
+					throwEvalError(dataOutOfRangeError(l, r, "BIGINT", "*"))
+				}
+				return result
+			}
+		default:
+			// the division of integers is a decimal
+			return nil
+		}
+		li, ri := left.i64, right.i64
+		return &compiledExpr{typ: sqltypes.Int64, i64: func(env *ExpressionEnv) (int64, bool) {
+			l, lnull := li(env)
+			r, rnull := ri(env)
+			if lnull || rnull {
+				return 0, true
+			}
+			return op(l, r), false
+		}}
+	}
+
+	var op func(l, r float64) (float64, bool)
+	switch expr.Op.(type) {
+	case *OpAddition:
+		op = func(l, r float64) (float64, bool) { return l + r, false }
+	case *OpSubstraction:
+		op = func(l, r float64) (float64, bool) { return l - r, false }
+	case *OpMultiplication:
+		op = func(l, r float64) (float64, bool) { return l * r, false }
+	case *OpDivision:
+		op = func(l, r float64) (float64, bool) {
+			if r == 0.0 {
+				return 0, true
+			}
+			result := l / r
+			if r < 1 && r*result != l {
+				throwEvalError(dataOutOfRangeError(l, r, "BIGINT", "/"))
+			}
+			return result, false
+		}
+	default:
+		return nil
+	}
+	lf, rf := left.float(), right.float()
+	return &compiledExpr{typ: sqltypes.Float64, f64: func(env *ExpressionEnv) (float64, bool) {
+		l, lnull := lf(env)
+		r, rnull := rf(env)
+		if lnull || rnull {
+			return 0, true
+		}
+		return op(l, r)
+	}}
+}
+
+func compileComparison(expr *ComparisonExpr, fields []*querypb.Field) *compiledExpr {
+	var test func(cmp int) bool
+	nullsafe := false
+	switch expr.Op.(type) {
+	case compareEQ:
+		test = func(cmp int) bool { return cmp == 0 }
+	case compareNE:
+		test = func(cmp int) bool { return cmp != 0 }
+	case compareLT:
+		test = func(cmp int) bool { return cmp < 0 }
+	case compareLE:
+		test = func(cmp int) bool { return cmp <= 0 }
+	case compareGT:
+		test = func(cmp int) bool { return cmp > 0 }
+	case compareGE:
+		test = func(cmp int) bool { return cmp >= 0 }
+	case compareNullSafeEQ:
+		test = func(cmp int) bool { return cmp == 0 }
+		nullsafe = true
+	default:
+		return nil
+	}
+
+	left, right := compileExpr(expr.Left, fields), compileExpr(expr.Right, fields)
+	var compare func(env *ExpressionEnv) (int, bool, bool)
+	switch {
+	case left.isNumeric() && right.isNumeric() && left.typ == sqltypes.Int64 && right.typ == sqltypes.Int64:
+		li, ri := left.i64, right.i64
+		compare = func(env *ExpressionEnv) (int, bool, bool) {
+			l, lnull := li(env)
+			r, rnull := ri(env)
+			if lnull || rnull {
+				return 0, lnull, rnull
+			}
+			switch {
+			case l == r:
+				return 0, false, false
+			case l < r:
+				return -1, false, false
+			default:
+				return 1, false, false
+			}
+		}
+	case left.isNumeric() && right.isNumeric():
+		lf, rf := left.float(), right.float()
+		compare = func(env *ExpressionEnv) (int, bool, bool) {
+			l, lnull := lf(env)
+			r, rnull := rf(env)
+			if lnull || rnull {
+				return 0, lnull, rnull
+			}
+			switch {
+			case l == r:
+				return 0, false, false
+			case l < r:
+				return -1, false, false
+			default:
+				return 1, false, false
+			}
+		}
+	case left.isTextual() && right.isTextual() && left.coll.Collation == right.coll.Collation:
+		collation := collations.Local().LookupByID(left.coll.Collation)
+		if collation == nil {
+			return nil
+		}
+		lr, rr := left.raw, right.raw
+		compare = func(env *ExpressionEnv) (int, bool, bool) {
+			l, lnull := lr(env)
+			r, rnull := rr(env)
+			if lnull || rnull {
+				return 0, lnull, rnull
+			}
+			return collation.Collate(l, r, false), false, false
+		}
+	default:
+		return nil
+	}
+
+	return &compiledExpr{typ: sqltypes.Int64, i64: func(env *ExpressionEnv) (int64, bool) {
+		cmp, lnull, rnull := compare(env)
+		if nullsafe {
+			if lnull || rnull {
+				return booleanInt64(makeboolean(lnull == rnull))
+			}
+			return booleanInt64(makeboolean(test(cmp)))
+		}
+		if lnull || rnull {
+			return 0, true
+		}
+		return booleanInt64(makeboolean(test(cmp)))
+	}}
+}
+
+func compileIs(expr *IsExpr, fields []*querypb.Field) *compiledExpr {
+	var check func(b boolean) bool
+	switch expr.Op {
+	case sqlparser.IsNullOp:
+		check = func(b boolean) bool { return b == boolNULL }
+	case sqlparser.IsNotNullOp:
+		check = func(b boolean) bool { return b != boolNULL }
+	case sqlparser.IsTrueOp:
+		check = func(b boolean) bool { return b == boolTrue }
+	case sqlparser.IsNotTrueOp:
+		check = func(b boolean) bool { return b != boolTrue }
+	case sqlparser.IsFalseOp:
+		check = func(b boolean) bool { return b == boolFalse }
+	case sqlparser.IsNotFalseOp:
+		check = func(b boolean) bool { return b != boolFalse }
+	default:
+		return nil
+	}
+	inner := compileExpr(expr.Inner, fields)
+	return &compiledExpr{typ: sqltypes.Int64, i64: func(env *ExpressionEnv) (int64, bool) {
+		return booleanInt64(makeboolean(check(inner.truth(env))))
+	}}
+}
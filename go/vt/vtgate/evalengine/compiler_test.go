@@ -0,0 +1,226 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type compilerTestLookup struct {
+	LookupDefaultCollation
+	columns []string
+}
+
+func (l compilerTestLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	for i, name := range l.columns {
+		if col.Name.EqualString(name) {
+			return i, nil
+		}
+	}
+	return 0, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "unknown column %s", sqlparser.String(col))
+}
+
+var compilerTestFields = []*querypb.Field{
+	{Name: "i", Type: sqltypes.Int64},
+	{Name: "j", Type: sqltypes.Int32},
+	{Name: "f", Type: sqltypes.Float64},
+	{Name: "s", Type: sqltypes.VarChar},
+	{Name: "b", Type: sqltypes.VarBinary},
+	{Name: "u", Type: sqltypes.Uint64},
+	{Name: "d", Type: sqltypes.Decimal},
+}
+
+func compileTestExpr(t testing.TB, expression string) Expr {
+	t.Helper()
+	stmt, err := sqlparser.Parse("select " + expression)
+	require.NoError(t, err)
+	astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+
+	lookup := compilerTestLookup{LookupDefaultCollation: LookupDefaultCollation(collations.Default())}
+	for _, field := range compilerTestFields {
+		lookup.columns = append(lookup.columns, field.Name)
+	}
+	expr, err := Translate(astExpr, lookup)
+	require.NoError(t, err)
+	return expr
+}
+
+func TestCompilerDifferential(t *testing.T) {
+	row := func(i, j, f, s, b, u, d sqltypes.Value) []sqltypes.Value {
+		return []sqltypes.Value{i, j, f, s, b, u, d}
+	}
+	rows := [][]sqltypes.Value{
+		row(sqltypes.NewInt64(1), sqltypes.NewInt32(2), sqltypes.NewFloat64(1.5), sqltypes.NewVarChar("abc"), sqltypes.NewVarBinary("abc"), sqltypes.NewUint64(3), sqltypes.NewDecimal("1.25")),
+		row(sqltypes.NewInt64(0), sqltypes.NewInt32(-7), sqltypes.NewFloat64(0), sqltypes.NewVarChar("ABC"), sqltypes.NewVarBinary("ABC"), sqltypes.NewUint64(0), sqltypes.NewDecimal("0")),
+		row(sqltypes.NewInt64(math.MaxInt64), sqltypes.NewInt32(math.MinInt32), sqltypes.NewFloat64(0.25), sqltypes.NewVarChar("12.5xyz"), sqltypes.NewVarBinary(""), sqltypes.NewUint64(math.MaxUint64), sqltypes.NewDecimal("-3.5")),
+		row(sqltypes.NewInt64(math.MinInt64), sqltypes.NewInt32(1), sqltypes.NewFloat64(-2.75), sqltypes.NewVarChar(""), sqltypes.NewVarBinary("\x00\xff"), sqltypes.NewUint64(1), sqltypes.NewDecimal("10")),
+		row(sqltypes.NULL, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL),
+		row(sqltypes.NewInt64(-1), sqltypes.NULL, sqltypes.NewFloat64(3), sqltypes.NULL, sqltypes.NewVarBinary("1"), sqltypes.NULL, sqltypes.NewDecimal("1")),
+		// values that do not have the type of their field are evaluated by the interpreter
+		row(sqltypes.NewVarChar("12"), sqltypes.NewFloat64(2.5), sqltypes.NewInt64(4), sqltypes.NewInt64(5), sqltypes.NewVarChar("x"), sqltypes.NewUint64(2), sqltypes.NewDecimal("2")),
+	}
+
+	tests := []struct {
+		expression string
+		compiled   bool
+	}{
+		{"i", true},
+		{"j", false},
+		{"f", true},
+		{"s", true},
+		{"b", true},
+		{"u", false},
+		{"d", false},
+		{"1", true},
+		{"1.5e0", true},
+		{"'foo'", true},
+		{"0x41", false},
+		{"null", false},
+		{"i + j", false},
+		{"i - j", false},
+		{"i * j", false},
+		{"i + 1", true},
+		{"i - 1", true},
+		{"j * 2", false},
+		{"i / j", false},
+		{"i + f", true},
+		{"f - i", true},
+		{"f * j", false},
+		{"f / i", true},
+		{"i / f", true},
+		{"j / 1.5e0", false},
+		{"f / 0.25e0", true},
+		{"i + u", false},
+		{"i + d", false},
+		{"i = j", false},
+		{"i <> 1", true},
+		{"i < j", false},
+		{"i <= j", false},
+		{"i > f", true},
+		{"f >= j", false},
+		{"i <=> j", false},
+		{"f <=> f", true},
+		{"s = 'abc'", true},
+		{"s < 'b'", true},
+		{"s <=> s", true},
+		{"b = 'abc'", false},
+		{"b = b", true},
+		{"s = i", false},
+		{"i = 1 and j = 2", true},
+		{"i = 1 or j < 0", true},
+		{"i = 1 xor s = 'abc'", true},
+		{"i is null", true},
+		{"i is not null", true},
+		{"f is true", true},
+		{"s is not true", true},
+		{"j is false", true},
+		{"b is not false", true},
+		{"i > 0 and u > 0", true},
+		{"(i + j) * 2 > f and s = 'abc'", true},
+		{"i + 1 > 0 or i - 1 < 0", true},
+		{"i * 2 < 0", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			expr := compileTestExpr(t, test.expression)
+			env := &ExpressionEnv{Fields: compilerTestFields, DefaultCollation: collations.Default()}
+			prog := env.Compile(expr)
+			assert.Equal(t, test.compiled, prog.root != nil, "whether the expression is compiled")
+
+			for _, r := range rows {
+				env.Row = r
+				expected, expectedErr := env.Evaluate(expr)
+				got, gotErr := prog.Evaluate(env)
+				if expectedErr != nil {
+					require.EqualError(t, gotErr, expectedErr.Error(), "row %v", r)
+					continue
+				}
+				require.NoError(t, gotErr, "row %v", r)
+				require.Equal(t, expected.Value(), got.Value(), "row %v", r)
+
+				isTrue, err := prog.IsTrue(env)
+				if i, expectedErr := expected.Value().ToInt64(); expectedErr != nil {
+					require.EqualError(t, err, expectedErr.Error(), "row %v", r)
+				} else {
+					require.NoError(t, err, "row %v", r)
+					require.Equal(t, i == 1, isTrue, "row %v", r)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkCompilerFilter(b *testing.B) {
+	rows := make([][]sqltypes.Value, 1024)
+	for i := range rows {
+		rows[i] = []sqltypes.Value{
+			sqltypes.NewInt64(int64(i)),
+			sqltypes.NewInt32(int32(i % 7)),
+			sqltypes.NewFloat64(float64(i) / 3),
+			sqltypes.NewVarChar(strconv.Itoa(i % 13)),
+			sqltypes.NewVarBinary(fmt.Sprintf("row%d", i)),
+			sqltypes.NewUint64(uint64(i)),
+			sqltypes.NewDecimal("1.5"),
+		}
+	}
+	expr := compileTestExpr(b, "(i + 7) * 2 > f and s <> '3' and i - 5 < 1000 or i is null")
+
+	b.Run("interpreted", func(b *testing.B) {
+		env := &ExpressionEnv{Fields: compilerTestFields, DefaultCollation: collations.Default()}
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			for _, row := range rows {
+				env.Row = row
+				result, err := env.Evaluate(expr)
+				if err != nil {
+					b.Fatal(err)
+				}
+				if _, err := result.Value().ToInt64(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("compiled", func(b *testing.B) {
+		env := &ExpressionEnv{Fields: compilerTestFields, DefaultCollation: collations.Default()}
+		prog := env.Compile(expr)
+		b.ReportAllocs()
+		for n := 0; n < b.N; n++ {
+			for _, row := range rows {
+				env.Row = row
+				if _, err := prog.IsTrue(env); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
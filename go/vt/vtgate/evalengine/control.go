@@ -0,0 +1,183 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+)
+
+type (
+	// WhenThen is a single branch of a CaseExpr
+	WhenThen struct {
+		When Expr
+		Then Expr
+	}
+
+	// CaseExpr is a searched CASE expression; simple CASE expressions
+	// are translated into searched ones by comparing their value in each branch
+	CaseExpr struct {
+		Cases []WhenThen
+		Else  Expr
+	}
+)
+
+// coerceResult converts the value chosen by a control flow function
+// into the type that has been aggregated from all its possible values
+func coerceResult(env *ExpressionEnv, result *EvalResult, tt sqltypes.Type) {
+	result.resolve()
+	if result.isNull() {
+		return
+	}
+	switch {
+	case tt == result.typeof():
+	case sqltypes.IsSigned(tt):
+		result.makeSignedIntegral()
+	case sqltypes.IsUnsigned(tt):
+		result.makeUnsignedIntegral()
+	case sqltypes.IsFloat(tt):
+		result.makeFloat()
+	case tt == sqltypes.Decimal:
+		result.makeDecimal(65, 0)
+	case sqltypes.IsBinary(tt):
+		result.makeBinary()
+	case sqltypes.IsText(tt):
+		if !sqltypes.IsText(result.typeof()) {
+			result.makeTextual(env.DefaultCollation)
+		}
+	}
+}
+
+// branchesType returns the type and the flags of a function that returns one of the given branches
+func branchesType(env *ExpressionEnv, branches []Expr) (sqltypes.Type, flag) {
+	var f flag
+	for _, branch := range branches {
+		_, bf := branch.typeof(env)
+		f |= bf & flagNullable
+		if bf&flagNull != 0 {
+			f |= flagNullable
+		}
+	}
+	return aggregatedType(env, branches), f
+}
+
+type builtinIf struct{}
+
+func (builtinIf) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	tt, _ := builtinIf{}.typeof(env, []Expr{args[0].expr, args[1].expr, args[2].expr})
+	if args[0].isTruthy() == boolTrue {
+		*result = args[1]
+	} else {
+		*result = args[2]
+	}
+	coerceResult(env, result, tt)
+}
+
+func (builtinIf) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 3 {
+		throwArgError("IF")
+	}
+	return branchesType(env, args[1:])
+}
+
+type builtinIfNull struct{}
+
+func (builtinIfNull) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	tt, _ := builtinIfNull{}.typeof(env, []Expr{args[0].expr, args[1].expr})
+	if args[0].isNull() {
+		*result = args[1]
+	} else {
+		*result = args[0]
+	}
+	coerceResult(env, result, tt)
+}
+
+func (builtinIfNull) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 {
+		throwArgError("IFNULL")
+	}
+	tt, _ := branchesType(env, args)
+	_, f := args[1].typeof(env)
+	return tt, f & (flagNull | flagNullable)
+}
+
+type builtinNullIf struct{}
+
+func (builtinNullIf) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	left, right := &args[0], &args[1]
+	if left.isNull() {
+		result.setNull()
+		return
+	}
+	if !right.isNull() {
+		cmp, isNull, err := evalCompareAll(left, right, true)
+		if err != nil {
+			throwEvalError(err)
+		}
+		if !isNull && cmp == 0 {
+			result.setNull()
+			return
+		}
+	}
+	*result = *left
+	result.resolve()
+}
+
+func (builtinNullIf) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 {
+		throwArgError("NULLIF")
+	}
+	tt, f := args[0].typeof(env)
+	return tt, f | flagNullable
+}
+
+func (c *CaseExpr) branches() []Expr {
+	branches := make([]Expr, 0, len(c.Cases)+1)
+	for _, wt := range c.Cases {
+		branches = append(branches, wt.Then)
+	}
+	if c.Else != nil {
+		branches = append(branches, c.Else)
+	}
+	return branches
+}
+
+func (c *CaseExpr) eval(env *ExpressionEnv, result *EvalResult) {
+	tt, _ := c.typeof(env)
+	for _, wt := range c.Cases {
+		var when EvalResult
+		when.init(env, wt.When)
+		if when.isTruthy() == boolTrue {
+			result.init(env, wt.Then)
+			coerceResult(env, result, tt)
+			return
+		}
+	}
+	if c.Else == nil {
+		result.setNull()
+		return
+	}
+	result.init(env, c.Else)
+	coerceResult(env, result, tt)
+}
+
+func (c *CaseExpr) typeof(env *ExpressionEnv) (sqltypes.Type, flag) {
+	tt, f := branchesType(env, c.branches())
+	if c.Else == nil {
+		f |= flagNullable
+	}
+	return tt, f
+}
@@ -234,6 +234,16 @@ func (env *ExpressionEnv) typecheck(expr Expr) {
 		}
 	case *CallExpr:
 		env.typecheck(expr.Arguments)
+		// the builtins check the number of their arguments when typing them
+		expr.F.typeof(env, expr.Arguments)
+	case *CaseExpr:
+		for _, wt := range expr.Cases {
+			env.typecheckUnary(wt.When)
+			env.typecheckUnary(wt.Then)
+		}
+		if expr.Else != nil {
+			env.typecheckUnary(expr.Else)
+		}
 	case *Literal, *Column, *BindVariable: // noop
 	default:
 		panic(fmt.Sprintf("unhandled cardinality: %T", expr))
@@ -162,6 +162,21 @@ func (c *CallExpr) format(w *formatter, depth int) {
 	w.WriteByte(')')
 }
 
+func (c *CaseExpr) format(w *formatter, depth int) {
+	w.WriteString("CASE")
+	for _, wt := range c.Cases {
+		w.WriteString(" WHEN ")
+		wt.When.format(w, depth+1)
+		w.WriteString(" THEN ")
+		wt.Then.format(w, depth+1)
+	}
+	if c.Else != nil {
+		w.WriteString(" ELSE ")
+		c.Else.format(w, depth+1)
+	}
+	w.WriteString(" END")
+}
+
 func (c *WeightStringCallExpr) format(w *formatter, depth int) {
 	w.WriteString("WEIGHT_STRING(")
 	c.String.format(w, depth)
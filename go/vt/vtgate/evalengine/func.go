@@ -30,12 +30,76 @@ import (
 )
 
 var builtinFunctions = map[string]builtin{
-	"coalesce":  builtinCoalesce{},
-	"greatest":  &builtinMultiComparison{name: "GREATEST", cmp: 1},
-	"least":     &builtinMultiComparison{name: "LEAST", cmp: -1},
-	"collation": builtinCollation{},
-	"bit_count": builtinBitCount{},
-	"hex":       builtinHex{},
+	"coalesce":         builtinCoalesce{},
+	"greatest":         &builtinMultiComparison{name: "GREATEST", cmp: 1},
+	"least":            &builtinMultiComparison{name: "LEAST", cmp: -1},
+	"collation":        builtinCollation{},
+	"bit_count":        builtinBitCount{},
+	"hex":              builtinHex{},
+	"concat":           builtinConcat{},
+	"concat_ws":        builtinConcatWs{},
+	"length":           &builtinLength{name: "LENGTH"},
+	"octet_length":     &builtinLength{name: "OCTET_LENGTH"},
+	"char_length":      &builtinLength{name: "CHAR_LENGTH", chars: true},
+	"character_length": &builtinLength{name: "CHARACTER_LENGTH", chars: true},
+	"upper":            &builtinChangeCase{name: "UPPER", upper: true},
+	"ucase":            &builtinChangeCase{name: "UCASE", upper: true},
+	"lower":            &builtinChangeCase{name: "LOWER"},
+	"lcase":            &builtinChangeCase{name: "LCASE"},
+	"left":             &builtinLeftRight{name: "LEFT"},
+	"right":            &builtinLeftRight{name: "RIGHT", right: true},
+	"substring":        builtinSubstring{},
+	"substr":           builtinSubstring{},
+	"mid":              builtinSubstring{},
+	"replace":          builtinReplace{},
+	"reverse":          builtinReverse{},
+	"repeat":           builtinRepeat{},
+	"lpad":             &builtinPad{name: "LPAD"},
+	"rpad":             &builtinPad{name: "RPAD", right: true},
+	"trim":             &builtinTrim{name: "TRIM", left: true, right: true},
+	"ltrim":            &builtinTrim{name: "LTRIM", left: true},
+	"rtrim":            &builtinTrim{name: "RTRIM", right: true},
+	"ascii":            builtinASCII{},
+	"locate":           &builtinLocate{name: "LOCATE"},
+	"instr":            &builtinLocate{name: "INSTR", instr: true},
+	"abs":              builtinAbs{},
+	"ceil":             &builtinCeilFloor{name: "CEIL", ceil: true},
+	"ceiling":          &builtinCeilFloor{name: "CEILING", ceil: true},
+	"floor":            &builtinCeilFloor{name: "FLOOR"},
+	"round":            &builtinRound{name: "ROUND"},
+	"truncate":         &builtinRound{name: "TRUNCATE", truncate: true},
+	"sign":             builtinSign{},
+	"sqrt":             builtinSqrt,
+	"pow":              builtinPow,
+	"power":            builtinPow,
+	"exp":              builtinExp,
+	"ln":               builtinLn,
+	"log":              builtinLog,
+	"log2":             builtinLog2,
+	"log10":            builtinLog10,
+	"if":               builtinIf{},
+	"ifnull":           builtinIfNull{},
+	"nullif":           builtinNullIf{},
+	"year":             builtinYear,
+	"month":            builtinMonth,
+	"day":              builtinDay,
+	"dayofmonth":       builtinDay,
+	"hour":             builtinHour,
+	"minute":           builtinMinute,
+	"second":           builtinSecond,
+	"json_extract":     builtinJSONExtract{},
+	"json_unquote":     builtinJSONUnquote{},
+}
+
+// builtinDateFunctions are the functions that take an INTERVAL expression as their second argument
+var builtinDateFunctions = map[string]struct {
+	name string
+	sub  bool
+}{
+	"date_add": {name: "DATE_ADD"},
+	"adddate":  {name: "ADDDATE"},
+	"date_sub": {name: "DATE_SUB", sub: true},
+	"subdate":  {name: "SUBDATE", sub: true},
 }
 
 var builtinFunctionsRewrite = map[string]builtinRewrite{
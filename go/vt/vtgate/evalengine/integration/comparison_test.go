@@ -572,3 +572,116 @@ func TestCharsetConversionOperators(t *testing.T) {
 		}
 	}
 }
+
+func TestStringFunctions(t *testing.T) {
+	var cases = []string{
+		`CONCAT('foo', 'bar')`, `CONCAT('foo', NULL)`, `CONCAT('foo', 1, 2.5)`, `CONCAT(_latin1 'foo', 'bår')`,
+		`CONCAT_WS(',', 'a', NULL, 'b')`, `CONCAT_WS(NULL, 'a', 'b')`,
+		`LENGTH('fóó')`, `CHAR_LENGTH('fóó')`, `LENGTH(_binary 'fóó')`, `CHAR_LENGTH(1234)`,
+		`UPPER('fóóbar')`, `LOWER('FÓÓBAR')`, `UPPER(_binary 'foobar')`,
+		`LEFT('foobar', 3)`, `RIGHT('foobar', 3)`, `LEFT('fóóbar', 0)`, `RIGHT('fóó', 10)`,
+		`SUBSTRING('foobar', 2)`, `SUBSTRING('foobar', -2)`, `SUBSTRING('foobar', 2, 3)`, `SUBSTRING('fóóbar', 0)`,
+		`SUBSTRING('foobar' FROM 3)`, `SUBSTRING('foobar' FROM 2 FOR 2)`, `MID('foobar', 3, 10)`,
+		`REPLACE('foobarfoo', 'foo', 'x')`, `REPLACE('foobar', '', 'x')`,
+		`REVERSE('fóóbar')`, `REPEAT('ab', 3)`, `REPEAT('ab', -1)`,
+		`LPAD('hi', 5, '?')`, `RPAD('hi', 5, 'ab')`, `LPAD('hello', 2, '?')`, `RPAD('hi', 5, '')`,
+		`TRIM('  foo  ')`, `LTRIM('  foo  ')`, `RTRIM('  foo  ')`,
+		`ASCII('a')`, `ASCII('')`, `ASCII(NULL)`,
+		`LOCATE('bar', 'foobarbar')`, `LOCATE('bar', 'foobarbar', 5)`, `LOCATE('BAR', 'foobar')`, `INSTR('foobar', 'ob')`,
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, expr := range cases {
+		compareRemoteQuery(t, conn, "SELECT "+expr)
+	}
+}
+
+func TestNumericFunctions(t *testing.T) {
+	var inputs = []string{
+		`0`, `1`, `-1`, `2.5`, `-2.5`, `1.2345`, `-1.2345`, `1.5e0`, `-1.5e0`, `'2.5'`,
+		strconv.FormatUint(math.MaxUint64, 10),
+		strconv.FormatInt(math.MaxInt64, 10),
+	}
+	var functions = []string{
+		"ABS(%s)", "CEIL(%s)", "FLOOR(%s)", "ROUND(%s)", "ROUND(%s, 2)", "ROUND(%s, -1)",
+		"TRUNCATE(%s, 1)", "TRUNCATE(%s, -1)", "SIGN(%s)",
+		"SQRT(%s)", "EXP(%s)", "LN(%s)", "LOG2(%s)", "LOG10(%s)", "LOG(%s)", "LOG(2, %s)", "LOG(%s, 8)", "POW(%s, 2)",
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, fn := range functions {
+		for _, input := range inputs {
+			compareRemoteQuery(t, conn, "SELECT "+fmt.Sprintf(fn, input))
+		}
+	}
+}
+
+func TestControlFlowFunctions(t *testing.T) {
+	var cases = []string{
+		`IF(1, 'a', 'b')`, `IF(0, 'a', 'b')`, `IF(NULL, 1, 2)`, `IF(1, 1, 2.5e0)`, `IF(0, 1, 'b')`, `IF(1, NULL, 2)`,
+		`IFNULL(NULL, 2)`, `IFNULL(1, 2)`, `IFNULL(NULL, NULL)`, `IFNULL(1, 'a')`,
+		`NULLIF(1, 1)`, `NULLIF(1, 2)`, `NULLIF('a', 'A')`, `NULLIF(NULL, 1)`,
+		`CASE WHEN 1 = 1 THEN 'a' ELSE 'b' END`, `CASE WHEN 1 = 2 THEN 'a' END`,
+		`CASE 2 WHEN 1 THEN 'a' WHEN 2 THEN 'b' ELSE 'c' END`, `CASE 'x' WHEN 'X' THEN 1 ELSE 2.5e0 END`,
+		`CASE NULL WHEN NULL THEN 1 ELSE 0 END`,
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, expr := range cases {
+		compareRemoteQuery(t, conn, "SELECT "+expr)
+	}
+}
+
+func TestDateFunctions(t *testing.T) {
+	var dates = []string{
+		`'2020-01-31'`, `'2020-02-29 12:30:00'`, `'1999-12-31 23:59:59'`, `20200131`, `'not a date'`, `NULL`,
+	}
+	var intervals = []string{
+		"1 MICROSECOND", "30 SECOND", "-1 MINUTE", "25 HOUR", "1 DAY", "2 WEEK", "1 MONTH", "-1 QUARTER", "1 YEAR",
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, date := range dates {
+		for _, interval := range intervals {
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT DATE_ADD(%s, INTERVAL %s)", date, interval))
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT DATE_SUB(%s, INTERVAL %s)", date, interval))
+		}
+		compareRemoteQuery(t, conn, fmt.Sprintf("SELECT ADDDATE(%s, 3)", date))
+		compareRemoteQuery(t, conn, fmt.Sprintf("SELECT SUBDATE(%s, 3)", date))
+		for _, fn := range []string{"YEAR", "MONTH", "DAY", "HOUR", "MINUTE", "SECOND"} {
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT %s(%s)", fn, date))
+		}
+	}
+}
+
+func TestJSONFunctions(t *testing.T) {
+	var docs = []string{
+		`'{"a": 1, "bb": [1, 2, {"c": "x"}], "b": null}'`,
+		`'[1, "two", true, {"k": [3]}]'`,
+		`'"scalar"'`,
+	}
+	var paths = []string{
+		`'$'`, `'$.a'`, `'$.bb[2].c'`, `'$.bb[*]'`, `'$.*'`, `'$[1]'`, `'$[0]'`, `'$[*].k'`, `'$."a"'`, `'$.missing'`,
+		`'$.a', '$.b'`,
+	}
+
+	var conn = mysqlconn(t)
+	defer conn.Close()
+
+	for _, doc := range docs {
+		for _, path := range paths {
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT JSON_EXTRACT(%s, %s)", doc, path))
+			compareRemoteQuery(t, conn, fmt.Sprintf("SELECT JSON_UNQUOTE(JSON_EXTRACT(%s, %s))", doc, path))
+		}
+	}
+	compareRemoteQuery(t, conn, `SELECT JSON_UNQUOTE('"a\\tb"')`)
+	compareRemoteQuery(t, conn, `SELECT JSON_UNQUOTE('abc')`)
+}
@@ -277,6 +277,43 @@ func (d Decimal) abs() Decimal {
 	}
 }
 
+// Abs returns the absolute value of the decimal.
+func (d Decimal) Abs() Decimal {
+	return d.abs()
+}
+
+// Floor returns the nearest integer value less than or equal to d.
+func (d Decimal) Floor() Decimal {
+	if d.isInteger() {
+		return d.rescale(0)
+	}
+	// big.Int.Div performs an Euclidean division, which rounds towards
+	// negative infinity when the divisor is positive
+	value := new(big.Int).Div(d.value, bigPow10(uint64(-d.exp)))
+	return Decimal{value: value, exp: 0}
+}
+
+// Ceil returns the nearest integer value greater than or equal to d.
+func (d Decimal) Ceil() Decimal {
+	return d.Neg().Floor().Neg()
+}
+
+// Truncate truncates the decimal to the given number of decimal places.
+// If places < 0, the integer part is truncated to a multiple of 10^(-places).
+//
+// Example:
+//
+// 	   NewFromFloat(5.45).Truncate(1).String() // output: "5.4"
+// 	   NewFromFloat(545).Truncate(-1).String() // output: "540"
+//
+func (d Decimal) Truncate(places int32) Decimal {
+	d.ensureInitialized()
+	if places >= 0 {
+		return d.truncate(places)
+	}
+	return d.rescale(-places).rescale(0)
+}
+
 // Add returns d + d2.
 func (d Decimal) Add(d2 Decimal) Decimal {
 	rd, rd2 := RescalePair(d, d2)
@@ -0,0 +1,354 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// jsonPathLeg is a single step of a JSON path: a member of an object or an element of an array
+type jsonPathLeg struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+type jsonPath []jsonPathLeg
+
+func (p jsonPath) hasWildcard() bool {
+	for _, leg := range p {
+		if leg.wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+func invalidJSONPath(pos int) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON path expression. The error is around character position %d.", pos)
+}
+
+func isJSONPathIdentifier(b byte) bool {
+	return b == '_' || b == '$' || (b >= '0' && b <= '9') || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || b >= utf8.RuneSelf
+}
+
+// parseJSONPath parses the subset of the MySQL JSON path syntax made of member
+// and array cell legs, with wildcards; the '**' recursive wildcard is not supported.
+func parseJSONPath(path []byte) (jsonPath, error) {
+	pos := 0
+	skipSpaces := func() {
+		for pos < len(path) && (path[pos] == ' ' || path[pos] == '\t' || path[pos] == '\n') {
+			pos++
+		}
+	}
+
+	skipSpaces()
+	if pos >= len(path) || path[pos] != '$' {
+		return nil, invalidJSONPath(pos)
+	}
+	pos++
+
+	var legs jsonPath
+	for {
+		skipSpaces()
+		if pos >= len(path) {
+			return legs, nil
+		}
+		switch path[pos] {
+		case '.':
+			pos++
+			skipSpaces()
+			switch {
+			case pos >= len(path):
+				return nil, invalidJSONPath(pos)
+			case path[pos] == '*':
+				pos++
+				legs = append(legs, jsonPathLeg{wildcard: true})
+			case path[pos] == '"':
+				end := pos + 1
+				for end < len(path) && path[end] != '"' {
+					if path[end] == '\\' {
+						end++
+					}
+					end++
+				}
+				if end >= len(path) {
+					return nil, invalidJSONPath(end)
+				}
+				var key string
+				if err := json.Unmarshal(path[pos:end+1], &key); err != nil {
+					return nil, invalidJSONPath(pos)
+				}
+				pos = end + 1
+				legs = append(legs, jsonPathLeg{key: key})
+			default:
+				start := pos
+				for pos < len(path) && isJSONPathIdentifier(path[pos]) {
+					pos++
+				}
+				if pos == start {
+					return nil, invalidJSONPath(pos)
+				}
+				legs = append(legs, jsonPathLeg{key: string(path[start:pos])})
+			}
+		case '[':
+			pos++
+			skipSpaces()
+			if pos < len(path) && path[pos] == '*' {
+				pos++
+				legs = append(legs, jsonPathLeg{isIndex: true, wildcard: true})
+			} else {
+				start := pos
+				for pos < len(path) && path[pos] >= '0' && path[pos] <= '9' {
+					pos++
+				}
+				index, err := strconv.Atoi(string(path[start:pos]))
+				if err != nil {
+					return nil, invalidJSONPath(pos)
+				}
+				legs = append(legs, jsonPathLeg{isIndex: true, index: index})
+			}
+			skipSpaces()
+			if pos >= len(path) || path[pos] != ']' {
+				return nil, invalidJSONPath(pos)
+			}
+			pos++
+		default:
+			return nil, invalidJSONPath(pos)
+		}
+	}
+}
+
+// match appends to matches all the values of doc that are selected by the path
+func (p jsonPath) match(doc interface{}, matches []interface{}) []interface{} {
+	if len(p) == 0 {
+		return append(matches, doc)
+	}
+	leg, rest := p[0], p[1:]
+	switch doc := doc.(type) {
+	case map[string]interface{}:
+		if leg.isIndex {
+			// MySQL auto-wraps non-array values when they are accessed with [0]
+			if !leg.wildcard && leg.index == 0 {
+				return rest.match(doc, matches)
+			}
+			return matches
+		}
+		if leg.wildcard {
+			for _, key := range sortedJSONKeys(doc) {
+				matches = rest.match(doc[key], matches)
+			}
+			return matches
+		}
+		if value, ok := doc[leg.key]; ok {
+			return rest.match(value, matches)
+		}
+	case []interface{}:
+		if !leg.isIndex {
+			return matches
+		}
+		if leg.wildcard {
+			for _, value := range doc {
+				matches = rest.match(value, matches)
+			}
+			return matches
+		}
+		if leg.index < len(doc) {
+			return rest.match(doc[leg.index], matches)
+		}
+	default:
+		if leg.isIndex && !leg.wildcard && leg.index == 0 {
+			return rest.match(doc, matches)
+		}
+	}
+	return matches
+}
+
+// sortedJSONKeys returns the keys of a JSON object in the order MySQL
+// stores them: shorter keys first, then keys of the same length by their bytes
+func sortedJSONKeys(obj map[string]interface{}) []string {
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+	return keys
+}
+
+func appendJSONString(buf []byte, str string) []byte {
+	buf = append(buf, '"')
+	for _, r := range str {
+		switch r {
+		case '"':
+			buf = append(buf, '\\', '"')
+		case '\\':
+			buf = append(buf, '\\', '\\')
+		case '\b':
+			buf = append(buf, '\\', 'b')
+		case '\f':
+			buf = append(buf, '\\', 'f')
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		case '\t':
+			buf = append(buf, '\\', 't')
+		default:
+			if r < 0x20 {
+				buf = append(buf, fmt.Sprintf("\\u%04x", r)...)
+			} else {
+				buf = append(buf, string(r)...)
+			}
+		}
+	}
+	return append(buf, '"')
+}
+
+// appendJSON serializes a JSON value the way MySQL prints them
+func appendJSON(buf []byte, value interface{}) []byte {
+	switch value := value.(type) {
+	case nil:
+		return append(buf, "null"...)
+	case bool:
+		return strconv.AppendBool(buf, value)
+	case json.Number:
+		return append(buf, value...)
+	case string:
+		return appendJSONString(buf, value)
+	case []interface{}:
+		buf = append(buf, '[')
+		for i, elem := range value {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = appendJSON(buf, elem)
+		}
+		return append(buf, ']')
+	case map[string]interface{}:
+		buf = append(buf, '{')
+		for i, key := range sortedJSONKeys(value) {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = appendJSONString(buf, key)
+			buf = append(buf, ": "...)
+			buf = appendJSON(buf, value[key])
+		}
+		return append(buf, '}')
+	default:
+		panic(fmt.Sprintf("unexpected JSON value %T", value))
+	}
+}
+
+func parseJSON(fname string, raw []byte) interface{} {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil || dec.More() {
+		throwEvalError(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text in argument 1 to function %s.", fname))
+	}
+	return doc
+}
+
+var collationJSON = collations.TypedCollation{
+	Collation:    collations.Local().LookupByName("utf8mb4_bin").ID(),
+	Coercibility: collations.CoerceImplicit,
+	Repertoire:   collations.RepertoireUnicode,
+}
+
+type builtinJSONExtract struct{}
+
+func (builtinJSONExtract) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	doc := parseJSON("json_extract", args[0].toRawBytes())
+
+	var matches []interface{}
+	wrap := len(args) > 2
+	for i := range args[1:] {
+		path, err := parseJSONPath(args[i+1].toRawBytes())
+		if err != nil {
+			throwEvalError(err)
+		}
+		wrap = wrap || path.hasWildcard()
+		matches = path.match(doc, matches)
+	}
+
+	switch {
+	case len(matches) == 0:
+		result.setNull()
+	case !wrap:
+		result.setRaw(sqltypes.TypeJSON, appendJSON(nil, matches[0]), collationJSON)
+	default:
+		result.setRaw(sqltypes.TypeJSON, appendJSON(nil, matches), collationJSON)
+	}
+}
+
+func (builtinJSONExtract) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) < 2 {
+		throwArgError("JSON_EXTRACT")
+	}
+	return sqltypes.TypeJSON, flagNullable
+}
+
+type builtinJSONUnquote struct{}
+
+func (builtinJSONUnquote) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	raw := arg.toRawBytes()
+	if len(raw) >= 2 && raw[0] == '"' && raw[len(raw)-1] == '"' {
+		var str string
+		if err := json.Unmarshal(raw, &str); err != nil {
+			throwEvalError(vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Invalid JSON text in argument 1 to function json_unquote."))
+		}
+		raw = []byte(str)
+	}
+	result.setRaw(sqltypes.VarChar, raw, collations.TypedCollation{
+		Collation:    env.DefaultCollation,
+		Coercibility: collations.CoerceImplicit,
+		Repertoire:   collations.RepertoireUnicode,
+	})
+}
+
+func (builtinJSONUnquote) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("JSON_UNQUOTE")
+	}
+	return sqltypes.VarChar, nullFlags(env, args)
+}
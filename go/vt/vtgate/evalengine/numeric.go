@@ -0,0 +1,366 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine/internal/decimal"
+)
+
+// numericType returns the type of the result of a numeric function that keeps the type
+// of its argument: strings and other non-numeric types are evaluated as floats
+func numericType(tt sqltypes.Type) sqltypes.Type {
+	switch {
+	case sqltypes.IsSigned(tt):
+		return sqltypes.Int64
+	case sqltypes.IsUnsigned(tt):
+		return sqltypes.Uint64
+	case tt == sqltypes.Decimal:
+		return sqltypes.Decimal
+	case tt == sqltypes.Null:
+		return sqltypes.Null
+	default:
+		return sqltypes.Float64
+	}
+}
+
+type builtinAbs struct{}
+
+func (builtinAbs) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	arg.makeNumeric()
+	switch tt := arg.typeof(); {
+	case sqltypes.IsSigned(tt):
+		i := arg.int64()
+		if i == math.MinInt64 {
+			throwEvalError(vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT value is out of range in 'abs(%d)'", i))
+		}
+		if i < 0 {
+			i = -i
+		}
+		result.setInt64(i)
+	case sqltypes.IsUnsigned(tt):
+		result.setUint64(arg.uint64())
+	case tt == sqltypes.Decimal:
+		result.setDecimal(arg.decimal().Abs(), arg.length_)
+	default:
+		result.setFloat(math.Abs(arg.float64()))
+	}
+}
+
+func (builtinAbs) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("ABS")
+	}
+	tt, f := args[0].typeof(env)
+	return numericType(tt), f & (flagNull | flagNullable)
+}
+
+type builtinCeilFloor struct {
+	name string
+	ceil bool
+}
+
+func (cf *builtinCeilFloor) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	arg.makeNumeric()
+	switch tt := arg.typeof(); {
+	case sqltypes.IsSigned(tt):
+		result.setInt64(arg.int64())
+	case sqltypes.IsUnsigned(tt):
+		result.setUint64(arg.uint64())
+	case tt == sqltypes.Decimal:
+		if cf.ceil {
+			result.setDecimal(arg.decimal().Ceil(), 0)
+		} else {
+			result.setDecimal(arg.decimal().Floor(), 0)
+		}
+	default:
+		if cf.ceil {
+			result.setFloat(math.Ceil(arg.float64()))
+		} else {
+			result.setFloat(math.Floor(arg.float64()))
+		}
+	}
+}
+
+func (cf *builtinCeilFloor) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError(cf.name)
+	}
+	tt, f := args[0].typeof(env)
+	return numericType(tt), f & (flagNull | flagNullable)
+}
+
+// roundInteger rounds or truncates an integer to a multiple of 10^(-places).
+// It returns false if the result does not fit in an int64.
+func roundInteger(i int64, places int64, truncate bool) (int64, bool) {
+	if places >= 0 {
+		return i, true
+	}
+	if places < -18 {
+		return 0, true
+	}
+	pow := int64(1)
+	for p := places; p < 0; p++ {
+		pow *= 10
+	}
+	rem := i % pow
+	i -= rem
+	if !truncate {
+		switch {
+		case rem > 0 && rem >= pow/2:
+			if i > math.MaxInt64-pow {
+				return 0, false
+			}
+			i += pow
+		case rem < 0 && rem <= -pow/2:
+			if i < math.MinInt64+pow {
+				return 0, false
+			}
+			i -= pow
+		}
+	}
+	return i, true
+}
+
+// roundUnsigned rounds or truncates an unsigned integer to a multiple of 10^(-places).
+// It returns false if the result does not fit in an uint64.
+func roundUnsigned(u uint64, places int64, truncate bool) (uint64, bool) {
+	if places >= 0 {
+		return u, true
+	}
+	if places < -19 {
+		return 0, true
+	}
+	pow := uint64(1)
+	for p := places; p < 0; p++ {
+		pow *= 10
+	}
+	rem := u % pow
+	u -= rem
+	if !truncate && rem >= pow/2 {
+		if u > math.MaxUint64-pow {
+			return 0, false
+		}
+		u += pow
+	}
+	return u, true
+}
+
+type builtinRound struct {
+	name     string
+	truncate bool
+}
+
+func (r *builtinRound) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	var places int64
+	if len(args) == 2 {
+		places = intArg(&args[1])
+	} else if r.truncate {
+		throwArgError(r.name)
+	}
+	if places > 30 {
+		places = 30
+	}
+	if places < -65 {
+		places = -65
+	}
+
+	arg := &args[0]
+	arg.makeNumeric()
+	switch tt := arg.typeof(); {
+	case sqltypes.IsSigned(tt):
+		i, ok := roundInteger(arg.int64(), places, r.truncate)
+		if !ok {
+			throwEvalError(vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT value is out of range in '%s(%d,%d)'", strings.ToLower(r.name), arg.int64(), places))
+		}
+		result.setInt64(i)
+	case sqltypes.IsUnsigned(tt):
+		u, ok := roundUnsigned(arg.uint64(), places, r.truncate)
+		if !ok {
+			throwEvalError(vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.DataOutOfRange, "BIGINT UNSIGNED value is out of range in '%s(%d,%d)'", strings.ToLower(r.name), arg.uint64(), places))
+		}
+		result.setUint64(u)
+	case tt == sqltypes.Decimal:
+		var dec decimal.Decimal
+		if r.truncate {
+			dec = arg.decimal().Truncate(int32(places))
+		} else {
+			dec = arg.decimal().Round(int32(places))
+		}
+		if places < 0 {
+			// rounding to the left of the decimal point leaves a positive exponent
+			// in the result; truncating it again brings it back to an integer
+			dec = dec.Truncate(int32(places))
+			places = 0
+		}
+		result.setDecimal(dec, int32(places))
+	default:
+		f := arg.float64()
+		shift := math.Pow10(int(places))
+		if r.truncate {
+			f = math.Trunc(f*shift) / shift
+		} else {
+			// approximate values are rounded to the nearest even number, like MySQL does
+			f = math.RoundToEven(f*shift) / shift
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			f = arg.float64()
+		}
+		result.setFloat(f)
+	}
+}
+
+func (r *builtinRound) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 && (r.truncate || len(args) != 1) {
+		throwArgError(r.name)
+	}
+	tt, _ := args[0].typeof(env)
+	return numericType(tt), nullFlags(env, args)
+}
+
+type builtinSign struct{}
+
+func (builtinSign) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	arg.makeNumeric()
+	var sign int64
+	switch tt := arg.typeof(); {
+	case sqltypes.IsSigned(tt):
+		i := arg.int64()
+		if i > 0 {
+			sign = 1
+		} else if i < 0 {
+			sign = -1
+		}
+	case sqltypes.IsUnsigned(tt):
+		if arg.uint64() > 0 {
+			sign = 1
+		}
+	case tt == sqltypes.Decimal:
+		sign = int64(arg.decimal().Sign())
+	default:
+		f := arg.float64()
+		if f > 0 {
+			sign = 1
+		} else if f < 0 {
+			sign = -1
+		}
+	}
+	result.setInt64(sign)
+}
+
+func (builtinSign) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("SIGN")
+	}
+	_, f := args[0].typeof(env)
+	return sqltypes.Int64, f & (flagNull | flagNullable)
+}
+
+// builtinMath is a function that evaluates all its arguments as floats, and
+// returns a float. The result of the function is NULL when it is not a number.
+// Functions with optional arguments accept from argc to argc+optional arguments.
+type builtinMath struct {
+	name     string
+	argc     int
+	optional int
+	apply    func(args []float64) float64
+}
+
+func (m *builtinMath) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	floats := make([]float64, len(args))
+	for i := range args {
+		args[i].makeFloat()
+		floats[i] = args[i].float64()
+	}
+	f := m.apply(floats)
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		result.setNull()
+		return
+	}
+	result.setFloat(f)
+}
+
+func (m *builtinMath) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) < m.argc || len(args) > m.argc+m.optional {
+		throwArgError(m.name)
+	}
+	return sqltypes.Float64, nullFlags(env, args) | flagNullable
+}
+
+// logarithm returns a function computing a logarithm, which is NULL for non-positive values
+func logarithm(log func(float64) float64) func(args []float64) float64 {
+	return func(args []float64) float64 {
+		if args[0] <= 0 {
+			return math.NaN()
+		}
+		return log(args[0])
+	}
+}
+
+var (
+	builtinSqrt = &builtinMath{name: "SQRT", argc: 1, apply: func(args []float64) float64 {
+		return math.Sqrt(args[0])
+	}}
+	builtinPow = &builtinMath{name: "POW", argc: 2, apply: func(args []float64) float64 {
+		return math.Pow(args[0], args[1])
+	}}
+	builtinExp = &builtinMath{name: "EXP", argc: 1, apply: func(args []float64) float64 {
+		return math.Exp(args[0])
+	}}
+	builtinLn    = &builtinMath{name: "LN", argc: 1, apply: logarithm(math.Log)}
+	builtinLog2  = &builtinMath{name: "LOG2", argc: 1, apply: logarithm(math.Log2)}
+	builtinLog10 = &builtinMath{name: "LOG10", argc: 1, apply: logarithm(math.Log10)}
+	// LOG(X) is the natural logarithm of X, and LOG(B, X) the logarithm of X in base B,
+	// which is NULL if B is not greater than 1.
+	builtinLog = &builtinMath{name: "LOG", argc: 1, optional: 1, apply: func(args []float64) float64 {
+		if len(args) == 1 {
+			return logarithm(math.Log)(args)
+		}
+		if args[0] <= 1 || args[1] <= 0 {
+			return math.NaN()
+		}
+		return math.Log(args[1]) / math.Log(args[0])
+	}}
+)
@@ -180,6 +180,34 @@ func (c *WeightStringCallExpr) simplify(env *ExpressionEnv) error {
 	return err
 }
 
+func (c *CaseExpr) constant() bool {
+	for _, wt := range c.Cases {
+		if !wt.When.constant() || !wt.Then.constant() {
+			return false
+		}
+	}
+	return c.Else == nil || c.Else.constant()
+}
+
+func (c *CaseExpr) simplify(env *ExpressionEnv) error {
+	var err error
+	for i := range c.Cases {
+		wt := &c.Cases[i]
+		wt.When, err = simplifyExpr(env, wt.When)
+		if err != nil {
+			return err
+		}
+		wt.Then, err = simplifyExpr(env, wt.Then)
+		if err != nil {
+			return err
+		}
+	}
+	if c.Else != nil {
+		c.Else, err = simplifyExpr(env, c.Else)
+	}
+	return err
+}
+
 func simplifyExpr(env *ExpressionEnv, e Expr) (Expr, error) {
 	if e.constant() {
 		res, err := env.Evaluate(e)
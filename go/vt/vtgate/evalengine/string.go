@@ -0,0 +1,629 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"unicode"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// maxStringLength is the largest string that the string functions will build;
+// MySQL returns NULL when the result would be larger than max_allowed_packet
+const maxStringLength = 64 * 1024 * 1024
+
+// textOf returns the contents of a string argument and its collation. Non-textual
+// arguments are converted to their string representation in the default collation.
+func textOf(env *ExpressionEnv, arg *EvalResult) ([]byte, collations.TypedCollation) {
+	if arg.isTextual() {
+		return arg.bytes(), arg.collation()
+	}
+	coercibility := collations.CoerceImplicit
+	if arg.isNumeric() {
+		coercibility = collations.CoerceNumeric
+	}
+	return arg.toRawBytes(), collations.TypedCollation{
+		Collation:    env.DefaultCollation,
+		Coercibility: coercibility,
+		Repertoire:   collations.RepertoireASCII,
+	}
+}
+
+// stringType returns the type of the result of a string function
+// that operates on an argument of the given type
+func stringType(tt sqltypes.Type) sqltypes.Type {
+	if sqltypes.IsBinary(tt) {
+		return sqltypes.VarBinary
+	}
+	return sqltypes.VarChar
+}
+
+// setText sets the result of a string function that operates on the given argument
+func setText(result *EvalResult, arg *EvalResult, text []byte, tc collations.TypedCollation) {
+	if len(text) > maxStringLength {
+		result.setNull()
+		return
+	}
+	if sqltypes.IsBinary(arg.typeof()) {
+		result.setRaw(sqltypes.VarBinary, text, collationBinary)
+		return
+	}
+	result.setRaw(sqltypes.VarChar, text, tc)
+}
+
+// nullFlags returns the flags of the arguments that make the result of a function NULL
+func nullFlags(env *ExpressionEnv, args []Expr) flag {
+	var f flag
+	for _, arg := range args {
+		_, af := arg.typeof(env)
+		f |= af & (flagNull | flagNullable)
+	}
+	return f
+}
+
+// anyNull returns true if any of the given arguments is NULL
+func anyNull(args []EvalResult) bool {
+	for i := range args {
+		if args[i].isNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeTextArguments returns the collation of the result of a function that combines
+// several strings, and the contents of these strings converted to that collation.
+// NULL arguments are ignored.
+func mergeTextArguments(env *ExpressionEnv, args []EvalResult) (collations.TypedCollation, [][]byte) {
+	var (
+		texts   = make([][]byte, len(args))
+		tcs     = make([]collations.TypedCollation, len(args))
+		merged  collations.TypedCollation
+		binary  bool
+		started bool
+	)
+	for i := range args {
+		arg := &args[i]
+		if arg.isNull() {
+			continue
+		}
+		if sqltypes.IsBinary(arg.typeof()) {
+			binary = true
+		}
+		texts[i], tcs[i] = textOf(env, arg)
+		if !started {
+			merged = tcs[i]
+			started = true
+			continue
+		}
+		if merged.Collation == tcs[i].Collation {
+			if tcs[i].Coercibility < merged.Coercibility {
+				merged.Coercibility = tcs[i].Coercibility
+			}
+			continue
+		}
+		var err error
+		merged, _, _, err = collations.Local().MergeCollations(merged, tcs[i], collations.CoercionOptions{
+			ConvertToSuperset:   true,
+			ConvertWithCoercion: true,
+		})
+		if err != nil {
+			throwEvalError(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error()))
+		}
+	}
+	if binary {
+		return collationBinary, texts
+	}
+
+	env2 := collations.Local()
+	target := env2.LookupByID(merged.Collation)
+	for i := range texts {
+		if texts[i] == nil || tcs[i].Collation == merged.Collation {
+			continue
+		}
+		source := env2.LookupByID(tcs[i].Collation)
+		if source.Charset().Name() == target.Charset().Name() {
+			continue
+		}
+		converted, err := collations.Convert(nil, target, texts[i], source)
+		if err != nil {
+			throwEvalError(vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, err.Error()))
+		}
+		texts[i] = converted
+	}
+	return merged, texts
+}
+
+// concatType returns the type of a function that concatenates all its arguments
+func concatType(env *ExpressionEnv, args []Expr) sqltypes.Type {
+	for _, arg := range args {
+		if tt, _ := arg.typeof(env); sqltypes.IsBinary(tt) {
+			return sqltypes.VarBinary
+		}
+	}
+	return sqltypes.VarChar
+}
+
+func setConcatenated(result *EvalResult, tc collations.TypedCollation, text []byte) {
+	switch {
+	case len(text) > maxStringLength:
+		result.setNull()
+	case tc.Collation == collations.CollationBinaryID:
+		result.setRaw(sqltypes.VarBinary, text, collationBinary)
+	default:
+		result.setRaw(sqltypes.VarChar, text, tc)
+	}
+}
+
+type builtinConcat struct{}
+
+func (builtinConcat) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	tc, texts := mergeTextArguments(env, args)
+	setConcatenated(result, tc, bytes.Join(texts, nil))
+}
+
+func (builtinConcat) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) < 1 {
+		throwArgError("CONCAT")
+	}
+	return concatType(env, args), nullFlags(env, args)
+}
+
+type builtinConcatWs struct{}
+
+func (builtinConcatWs) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if args[0].isNull() {
+		result.setNull()
+		return
+	}
+	tc, texts := mergeTextArguments(env, args)
+	var parts [][]byte
+	for i := 1; i < len(texts); i++ {
+		if args[i].isNull() {
+			continue
+		}
+		parts = append(parts, texts[i])
+	}
+	setConcatenated(result, tc, bytes.Join(parts, texts[0]))
+}
+
+func (builtinConcatWs) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) < 2 {
+		throwArgError("CONCAT_WS")
+	}
+	return concatType(env, args), nullFlags(env, args[:1])
+}
+
+type builtinLength struct {
+	name  string
+	chars bool
+}
+
+func (l *builtinLength) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	text, tc := textOf(env, arg)
+	if !l.chars {
+		result.setInt64(int64(len(text)))
+		return
+	}
+	result.setInt64(int64(collations.Length(collations.Local().LookupByID(tc.Collation), text)))
+}
+
+func (l *builtinLength) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError(l.name)
+	}
+	return sqltypes.Int64, nullFlags(env, args)
+}
+
+type builtinChangeCase struct {
+	name  string
+	upper bool
+}
+
+func (c *builtinChangeCase) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	text, tc := textOf(env, arg)
+	coll := collations.Local().LookupByID(tc.Collation)
+	if coll.IsBinary() {
+		setText(result, arg, text, tc)
+		return
+	}
+	mapping := unicode.ToLower
+	if c.upper {
+		mapping = unicode.ToUpper
+	}
+
+	cs := coll.Charset()
+	var buf [utf8.UTFMax]byte
+	converted := make([]byte, 0, len(text))
+	for len(text) > 0 {
+		r, size := cs.DecodeRune(text)
+		if size == 0 {
+			break
+		}
+		if r != utf8.RuneError {
+			if n := cs.EncodeRune(buf[:], mapping(r)); n > 0 {
+				converted = append(converted, buf[:n]...)
+				text = text[size:]
+				continue
+			}
+		}
+		converted = append(converted, text[:size]...)
+		text = text[size:]
+	}
+	setText(result, arg, converted, tc)
+}
+
+func (c *builtinChangeCase) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError(c.name)
+	}
+	tt, f := args[0].typeof(env)
+	return stringType(tt), f
+}
+
+// intArg returns the value of an integer argument of a string function
+func intArg(arg *EvalResult) int64 {
+	arg.makeSignedIntegral()
+	return arg.int64()
+}
+
+// clampLength converts a length to an int, capping it to the maximum length of a string
+func clampLength(n int64) int {
+	if n > maxStringLength {
+		return maxStringLength + 1
+	}
+	return int(n)
+}
+
+type builtinLeftRight struct {
+	name  string
+	right bool
+}
+
+func (lr *builtinLeftRight) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	text, tc := textOf(env, &args[0])
+	n := intArg(&args[1])
+	if n <= 0 {
+		setText(result, &args[0], nil, tc)
+		return
+	}
+
+	coll := collations.Local().LookupByID(tc.Collation)
+	if !lr.right {
+		setText(result, &args[0], collations.Slice(coll, text, 0, clampLength(n)), tc)
+		return
+	}
+	length := int64(collations.Length(coll, text))
+	if n >= length {
+		setText(result, &args[0], text, tc)
+		return
+	}
+	setText(result, &args[0], collations.Slice(coll, text, int(length-n), int(length)), tc)
+}
+
+func (lr *builtinLeftRight) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 {
+		throwArgError(lr.name)
+	}
+	tt, _ := args[0].typeof(env)
+	return stringType(tt), nullFlags(env, args)
+}
+
+type builtinSubstring struct{}
+
+func (builtinSubstring) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	text, tc := textOf(env, &args[0])
+	coll := collations.Local().LookupByID(tc.Collation)
+	length := int64(collations.Length(coll, text))
+
+	pos := intArg(&args[1])
+	if pos < 0 {
+		pos = length + pos + 1
+	}
+	if pos <= 0 || pos > length {
+		setText(result, &args[0], nil, tc)
+		return
+	}
+
+	end := length + 1
+	if len(args) == 3 {
+		n := intArg(&args[2])
+		if n <= 0 {
+			setText(result, &args[0], nil, tc)
+			return
+		}
+		if pos+n < end {
+			end = pos + n
+		}
+	}
+	setText(result, &args[0], collations.Slice(coll, text, int(pos-1), int(end-1)), tc)
+}
+
+func (builtinSubstring) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 && len(args) != 3 {
+		throwArgError("SUBSTRING")
+	}
+	tt, _ := args[0].typeof(env)
+	return stringType(tt), nullFlags(env, args)
+}
+
+type builtinReplace struct{}
+
+func (builtinReplace) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	tc, texts := mergeTextArguments(env, args)
+	// REPLACE always performs a case-sensitive match, regardless of the collation
+	if len(texts[1]) == 0 {
+		setConcatenated(result, tc, texts[0])
+		return
+	}
+	setConcatenated(result, tc, bytes.ReplaceAll(texts[0], texts[1], texts[2]))
+}
+
+func (builtinReplace) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 3 {
+		throwArgError("REPLACE")
+	}
+	return concatType(env, args), nullFlags(env, args)
+}
+
+type builtinReverse struct{}
+
+func (builtinReverse) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	text, tc := textOf(env, arg)
+	cs := collations.Local().LookupByID(tc.Collation).Charset()
+	reversed := make([]byte, len(text))
+	end := len(reversed)
+	for len(text) > 0 {
+		_, size := cs.DecodeRune(text)
+		if size == 0 {
+			break
+		}
+		copy(reversed[end-size:end], text[:size])
+		end -= size
+		text = text[size:]
+	}
+	setText(result, arg, reversed[end:], tc)
+}
+
+func (builtinReverse) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("REVERSE")
+	}
+	tt, f := args[0].typeof(env)
+	return stringType(tt), f
+}
+
+type builtinRepeat struct{}
+
+func (builtinRepeat) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	text, tc := textOf(env, &args[0])
+	n := intArg(&args[1])
+	if n <= 0 || len(text) == 0 {
+		setText(result, &args[0], nil, tc)
+		return
+	}
+	if int64(len(text))*n > maxStringLength {
+		result.setNull()
+		return
+	}
+	setText(result, &args[0], bytes.Repeat(text, int(n)), tc)
+}
+
+func (builtinRepeat) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 {
+		throwArgError("REPEAT")
+	}
+	tt, _ := args[0].typeof(env)
+	return stringType(tt), nullFlags(env, args) | flagNullable
+}
+
+type builtinPad struct {
+	name  string
+	right bool
+}
+
+func (p *builtinPad) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	n := intArg(&args[1])
+	if n < 0 || n > maxStringLength {
+		result.setNull()
+		return
+	}
+	tc, texts := mergeTextArguments(env, []EvalResult{args[0], args[2]})
+	text, pad := texts[0], texts[1]
+	coll := collations.Local().LookupByID(tc.Collation)
+
+	length := int64(collations.Length(coll, text))
+	if n <= length {
+		setConcatenated(result, tc, collations.Slice(coll, text, 0, int(n)))
+		return
+	}
+	padLength := int64(collations.Length(coll, pad))
+	if padLength == 0 {
+		result.setNull()
+		return
+	}
+
+	var padding []byte
+	missing := n - length
+	for missing >= padLength {
+		padding = append(padding, pad...)
+		missing -= padLength
+	}
+	padding = append(padding, collations.Slice(coll, pad, 0, int(missing))...)
+
+	if p.right {
+		setConcatenated(result, tc, append(append([]byte{}, text...), padding...))
+	} else {
+		setConcatenated(result, tc, append(padding, text...))
+	}
+}
+
+func (p *builtinPad) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 3 {
+		throwArgError(p.name)
+	}
+	return concatType(env, []Expr{args[0], args[2]}), nullFlags(env, args) | flagNullable
+}
+
+type builtinTrim struct {
+	name        string
+	left, right bool
+}
+
+func (t *builtinTrim) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	text, tc := textOf(env, arg)
+	if t.left {
+		text = bytes.TrimLeft(text, " ")
+	}
+	if t.right {
+		text = bytes.TrimRight(text, " ")
+	}
+	setText(result, arg, text, tc)
+}
+
+func (t *builtinTrim) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError(t.name)
+	}
+	tt, f := args[0].typeof(env)
+	return stringType(tt), f
+}
+
+type builtinASCII struct{}
+
+func (builtinASCII) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	text, _ := textOf(env, arg)
+	if len(text) == 0 {
+		result.setInt64(0)
+		return
+	}
+	result.setInt64(int64(text[0]))
+}
+
+func (builtinASCII) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError("ASCII")
+	}
+	_, f := args[0].typeof(env)
+	return sqltypes.Int64, f
+}
+
+type builtinLocate struct {
+	name string
+	// instr is set for INSTR(str, substr), which takes its arguments
+	// in the opposite order of LOCATE(substr, str)
+	instr bool
+}
+
+func (l *builtinLocate) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	var needle, haystack = &args[0], &args[1]
+	if l.instr {
+		needle, haystack = haystack, needle
+	}
+	tc, texts := mergeTextArguments(env, []EvalResult{*needle, *haystack})
+	coll := collations.Local().LookupByID(tc.Collation)
+	sub, str := texts[0], texts[1]
+
+	start := int64(1)
+	if len(args) == 3 {
+		start = intArg(&args[2])
+		if start <= 0 {
+			result.setInt64(0)
+			return
+		}
+	}
+
+	length := int64(collations.Length(coll, str))
+	subLength := int64(collations.Length(coll, sub))
+	if start > length+1 {
+		result.setInt64(0)
+		return
+	}
+	for pos := start; pos+subLength <= length+1; pos++ {
+		candidate := collations.Slice(coll, str, int(pos-1), int(pos-1+subLength))
+		if coll.Collate(candidate, sub, false) == 0 {
+			result.setInt64(pos)
+			return
+		}
+	}
+	result.setInt64(0)
+}
+
+func (l *builtinLocate) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 && !(len(args) == 3 && !l.instr) {
+		throwArgError(l.name)
+	}
+	return sqltypes.Int64, nullFlags(env, args)
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	layoutDate     = "2006-01-02"
+	layoutDatetime = "2006-01-02 15:04:05"
+)
+
+// parseDatetime parses the value of a temporal argument. It returns whether the
+// value contains a time part, and false as its last result if the value is not a valid date.
+func parseDatetime(arg *EvalResult) (t time.Time, hasTime bool, ok bool) {
+	var str string
+	switch tt := arg.typeof(); {
+	case sqltypes.IsIntegral(tt):
+		// integers are interpreted as YYYYMMDD or YYYYMMDDhhmmss
+		str = strconv.FormatUint(arg.uint64(), 10)
+		switch len(str) {
+		case 8:
+			t, err := time.Parse("20060102", str)
+			return t, false, err == nil
+		case 14:
+			t, err := time.Parse("20060102150405", str)
+			return t, true, err == nil
+		}
+		return time.Time{}, false, false
+	case tt == sqltypes.Date, tt == sqltypes.Datetime, tt == sqltypes.Timestamp, arg.isTextual():
+		str = strings.TrimSpace(arg.string())
+	default:
+		return time.Time{}, false, false
+	}
+
+	if t, err := time.Parse(layoutDatetime, str); err == nil {
+		return t, true, true
+	}
+	if t, err := time.Parse("2006-01-02T15:04:05", str); err == nil {
+		return t, true, true
+	}
+	if t, err := time.Parse(layoutDate, str); err == nil {
+		return t, false, true
+	}
+	return time.Time{}, false, false
+}
+
+// formatDatetime formats a date the way MySQL does, with microseconds only if they are set
+func formatDatetime(t time.Time, hasTime bool) []byte {
+	if !hasTime {
+		return []byte(t.Format(layoutDate))
+	}
+	if t.Nanosecond() != 0 {
+		return []byte(t.Format("2006-01-02 15:04:05.000000"))
+	}
+	return []byte(t.Format(layoutDatetime))
+}
+
+// intervalUnit is a unit supported in the INTERVAL expressions of date arithmetic
+type intervalUnit int
+
+const (
+	intervalMicrosecond intervalUnit = iota
+	intervalSecond
+	intervalMinute
+	intervalHour
+	intervalDay
+	intervalWeek
+	intervalMonth
+	intervalQuarter
+	intervalYear
+)
+
+var intervalUnits = map[string]intervalUnit{
+	"microsecond": intervalMicrosecond,
+	"second":      intervalSecond,
+	"minute":      intervalMinute,
+	"hour":        intervalHour,
+	"day":         intervalDay,
+	"week":        intervalWeek,
+	"month":       intervalMonth,
+	"quarter":     intervalQuarter,
+	"year":        intervalYear,
+}
+
+func parseIntervalUnit(unit string) (intervalUnit, error) {
+	if u, ok := intervalUnits[strings.ToLower(unit)]; ok {
+		return u, nil
+	}
+	return 0, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported interval unit: %s", unit)
+}
+
+// addMonths adds months to a date; if the day of the month does not exist in
+// the resulting month, the date is clamped to the last day of that month
+func addMonths(t time.Time, months int64) time.Time {
+	total := int64(t.Year())*12 + int64(t.Month()) - 1 + months
+	year, month := int(total/12), time.Month(total%12+1)
+	day := t.Day()
+	if last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+		day = last
+	}
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+func (u intervalUnit) add(t time.Time, n int64) time.Time {
+	switch u {
+	case intervalMicrosecond:
+		return t.Add(time.Duration(n) * time.Microsecond)
+	case intervalSecond:
+		return t.Add(time.Duration(n) * time.Second)
+	case intervalMinute:
+		return t.Add(time.Duration(n) * time.Minute)
+	case intervalHour:
+		return t.Add(time.Duration(n) * time.Hour)
+	case intervalDay:
+		return t.AddDate(0, 0, int(n))
+	case intervalWeek:
+		return t.AddDate(0, 0, int(n)*7)
+	case intervalMonth:
+		return addMonths(t, n)
+	case intervalQuarter:
+		return addMonths(t, n*3)
+	default:
+		return addMonths(t, n*12)
+	}
+}
+
+// builtinDateMath implements DATE_ADD, DATE_SUB and their ADDDATE and SUBDATE synonyms
+type builtinDateMath struct {
+	name string
+	sub  bool
+	unit intervalUnit
+}
+
+func (d *builtinDateMath) call(env *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	if anyNull(args) {
+		result.setNull()
+		return
+	}
+	date, interval := &args[0], &args[1]
+	t, hasTime, ok := parseDatetime(date)
+	if !ok {
+		result.setNull()
+		return
+	}
+	n := intArg(interval)
+	if d.sub {
+		n = -n
+	}
+	t = d.unit.add(t, n)
+	if t.Year() < 0 || t.Year() > 9999 {
+		result.setNull()
+		return
+	}
+
+	tt := d.resultType(date.typeof())
+	if d.unit < intervalDay || tt == sqltypes.Datetime {
+		hasTime = true
+	}
+	if tt == sqltypes.VarChar {
+		result.setRaw(tt, formatDatetime(t, hasTime), collations.TypedCollation{
+			Collation:    env.DefaultCollation,
+			Coercibility: collations.CoerceCoercible,
+			Repertoire:   collations.RepertoireASCII,
+		})
+		return
+	}
+	result.setRaw(tt, formatDatetime(t, hasTime), collationNumeric)
+}
+
+func (d *builtinDateMath) resultType(tt sqltypes.Type) sqltypes.Type {
+	switch tt {
+	case sqltypes.Date:
+		if d.unit < intervalDay {
+			return sqltypes.Datetime
+		}
+		return sqltypes.Date
+	case sqltypes.Datetime, sqltypes.Timestamp:
+		return sqltypes.Datetime
+	default:
+		return sqltypes.VarChar
+	}
+}
+
+func (d *builtinDateMath) typeof(env *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 2 {
+		throwArgError(d.name)
+	}
+	tt, _ := args[0].typeof(env)
+	return d.resultType(tt), flagNullable
+}
+
+// builtinDatePart implements the functions that extract a single field from a date
+type builtinDatePart struct {
+	name    string
+	extract func(t time.Time) int
+}
+
+func (d *builtinDatePart) call(_ *ExpressionEnv, args []EvalResult, result *EvalResult) {
+	arg := &args[0]
+	if arg.isNull() {
+		result.setNull()
+		return
+	}
+	t, _, ok := parseDatetime(arg)
+	if !ok {
+		result.setNull()
+		return
+	}
+	result.setInt64(int64(d.extract(t)))
+}
+
+func (d *builtinDatePart) typeof(_ *ExpressionEnv, args []Expr) (sqltypes.Type, flag) {
+	if len(args) != 1 {
+		throwArgError(d.name)
+	}
+	return sqltypes.Int64, flagNullable
+}
+
+var (
+	builtinYear = &builtinDatePart{name: "YEAR", extract: func(t time.Time) int {
+		return t.Year()
+	}}
+	builtinMonth = &builtinDatePart{name: "MONTH", extract: func(t time.Time) int {
+		return int(t.Month())
+	}}
+	builtinDay = &builtinDatePart{name: "DAY", extract: func(t time.Time) int {
+		return t.Day()
+	}}
+	builtinHour = &builtinDatePart{name: "HOUR", extract: func(t time.Time) int {
+		return t.Hour()
+	}}
+	builtinMinute = &builtinDatePart{name: "MINUTE", extract: func(t time.Time) int {
+		return t.Minute()
+	}}
+	builtinSecond = &builtinDatePart{name: "SECOND", extract: func(t time.Time) int {
+		return t.Second()
+	}}
+)
@@ -241,6 +241,22 @@ func translateBinaryExpr(binary *sqlparser.BinaryExpr, lookup TranslationLookup)
 		return &BitwiseExpr{BinaryExpr: binaryExpr, Op: &OpBitShiftLeft{}}, nil
 	case sqlparser.ShiftRightOp:
 		return &BitwiseExpr{BinaryExpr: binaryExpr, Op: &OpBitShiftRight{}}, nil
+	case sqlparser.JSONExtractOp, sqlparser.JSONUnquoteExtractOp:
+		var expr Expr = &CallExpr{
+			Arguments: TupleExpr{left, right},
+			Aliases:   make([]sqlparser.ColIdent, 2),
+			Method:    "json_extract",
+			F:         builtinJSONExtract{},
+		}
+		if binary.Operator == sqlparser.JSONUnquoteExtractOp {
+			expr = &CallExpr{
+				Arguments: TupleExpr{expr},
+				Aliases:   make([]sqlparser.ColIdent, 1),
+				Method:    "json_unquote",
+				F:         builtinJSONUnquote{},
+			}
+		}
+		return expr, nil
 	default:
 		return nil, translateExprNotSupported(binary)
 	}
@@ -318,6 +334,13 @@ func translateIntroducerExpr(introduced *sqlparser.IntroducerExpr, lookup Transl
 }
 
 func translateFuncExpr(fn *sqlparser.FuncExpr, lookup TranslationLookup) (Expr, error) {
+	if fn.Over != nil {
+		// window functions can only be evaluated by MySQL or by the Window primitive
+		return nil, translateExprNotSupported(fn)
+	}
+	if date, ok := builtinDateFunctions[fn.Name.Lowered()]; ok {
+		return translateDateMathExpr(fn, date.name, date.sub, lookup)
+	}
 	var args TupleExpr
 	var aliases []sqlparser.ColIdent
 	for _, expr := range fn.Exprs {
@@ -351,6 +374,102 @@ func translateFuncExpr(fn *sqlparser.FuncExpr, lookup TranslationLookup) (Expr,
 	return nil, translateExprNotSupported(fn)
 }
 
+// translateDateMathExpr translates the functions that add an INTERVAL to a date. The unit of
+// the interval is known when translating, so it is part of the builtin instead of its arguments.
+func translateDateMathExpr(fn *sqlparser.FuncExpr, name string, sub bool, lookup TranslationLookup) (Expr, error) {
+	if len(fn.Exprs) != 2 {
+		return nil, translateExprNotSupported(fn)
+	}
+	var args TupleExpr
+	var aliases []sqlparser.ColIdent
+	var unit = intervalDay
+	var hasInterval bool
+	for i, expr := range fn.Exprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, translateExprNotSupported(fn)
+		}
+		arg := aliased.Expr
+		if interval, ok := arg.(*sqlparser.IntervalExpr); ok && i == 1 {
+			var err error
+			unit, err = parseIntervalUnit(interval.Unit)
+			if err != nil {
+				return nil, err
+			}
+			arg = interval.Expr
+			hasInterval = true
+		}
+		convertedExpr, err := translateExpr(arg, lookup)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, convertedExpr)
+		aliases = append(aliases, aliased.As)
+	}
+
+	method := fn.Name.Lowered()
+	// only ADDDATE and SUBDATE accept a number of days instead of an INTERVAL
+	if !hasInterval && method != "adddate" && method != "subdate" {
+		return nil, translateExprNotSupported(fn)
+	}
+	return &CallExpr{
+		Arguments: args,
+		Aliases:   aliases,
+		Method:    method,
+		F:         &builtinDateMath{name: name, sub: sub, unit: unit},
+	}, nil
+}
+
+func translateSubstrExpr(substr *sqlparser.SubstrExpr, lookup TranslationLookup) (Expr, error) {
+	var args TupleExpr
+	for _, expr := range []sqlparser.Expr{substr.Name, substr.From, substr.To} {
+		if expr == nil {
+			continue
+		}
+		convertedExpr, err := translateExpr(expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, convertedExpr)
+	}
+	return &CallExpr{
+		Arguments: args,
+		Aliases:   make([]sqlparser.ColIdent, len(args)),
+		Method:    "substring",
+		F:         builtinSubstring{},
+	}, nil
+}
+
+func translateCaseExpr(node *sqlparser.CaseExpr, lookup TranslationLookup) (Expr, error) {
+	var result CaseExpr
+	for _, when := range node.Whens {
+		var cond Expr
+		var err error
+		if node.Expr != nil {
+			// a simple CASE compares its value with the value of each branch
+			cond, err = translateComparisonExpr(sqlparser.EqualOp, node.Expr, when.Cond, lookup)
+		} else {
+			cond, err = translateExpr(when.Cond, lookup)
+		}
+		if err != nil {
+			return nil, err
+		}
+		val, err := translateExpr(when.Val, lookup)
+		if err != nil {
+			return nil, err
+		}
+		result.Cases = append(result.Cases, WhenThen{When: cond, Then: val})
+	}
+	if node.Else != nil {
+		var err error
+		result.Else, err = translateExpr(node.Else, lookup)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &result, nil
+}
+
 func translateIntegral(lit *sqlparser.Literal, lookup TranslationLookup) (int, bool, error) {
 	if lit == nil {
 		return 0, false, nil
@@ -542,6 +661,10 @@ func translateExpr(e sqlparser.Expr, lookup TranslationLookup) (Expr, error) {
 		return translateConvertExpr(node, lookup)
 	case *sqlparser.ConvertUsingExpr:
 		return translateConvertUsingExpr(node, lookup)
+	case *sqlparser.SubstrExpr:
+		return translateSubstrExpr(node, lookup)
+	case *sqlparser.CaseExpr:
+		return translateCaseExpr(node, lookup)
 	default:
 		return nil, translateExprNotSupported(e)
 	}
@@ -263,6 +263,93 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: "false is not false",
 		expected:   False,
+	}, {
+		expression: "concat('a', 'b', 1)",
+		expected:   sqltypes.NewVarChar("ab1"),
+	}, {
+		expression: "concat('a', null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "concat_ws(',', 'a', null, 'b')",
+		expected:   sqltypes.NewVarChar("a,b"),
+	}, {
+		expression: "length('ñ')",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "char_length('ñ')",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "upper('abc')",
+		expected:   sqltypes.NewVarChar("ABC"),
+	}, {
+		expression: "substring('foobar', 2, 3)",
+		expected:   sqltypes.NewVarChar("oob"),
+	}, {
+		expression: "substring('foobar', -3)",
+		expected:   sqltypes.NewVarChar("bar"),
+	}, {
+		expression: "replace('aXbX', 'X', 'y')",
+		expected:   sqltypes.NewVarChar("ayby"),
+	}, {
+		expression: "lpad('5', 3, '0')",
+		expected:   sqltypes.NewVarChar("005"),
+	}, {
+		expression: "trim('  a  ')",
+		expected:   sqltypes.NewVarChar("a"),
+	}, {
+		expression: "locate('b', 'abc')",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "abs(-3)",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "round(2.5)",
+		expected:   sqltypes.NewDecimal("3"),
+	}, {
+		expression: "round(1.2345, 2)",
+		expected:   sqltypes.NewDecimal("1.23"),
+	}, {
+		expression: "truncate(1.299, 1)",
+		expected:   sqltypes.NewDecimal("1.2"),
+	}, {
+		expression: "log(2, 8)",
+		expected:   sqltypes.NewFloat64(3),
+	}, {
+		expression: "log(1, 8)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "log(2, -8)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "if(1 > 0, 'yes', 'no')",
+		expected:   sqltypes.NewVarChar("yes"),
+	}, {
+		expression: "ifnull(null, 2)",
+		expected:   sqltypes.NewInt64(2),
+	}, {
+		expression: "nullif(1, 1)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "case when 1 = 2 then 'a' else 'b' end",
+		expected:   sqltypes.NewVarChar("b"),
+	}, {
+		expression: "case 2 when 1 then 'a' when 2 then 'c' end",
+		expected:   sqltypes.NewVarChar("c"),
+	}, {
+		expression: "date_add('2020-01-31', interval 1 month)",
+		expected:   sqltypes.NewVarChar("2020-02-29"),
+	}, {
+		expression: "date_sub('2020-01-01 00:00:00', interval 1 second)",
+		expected:   sqltypes.NewVarChar("2019-12-31 23:59:59"),
+	}, {
+		expression: "year('2021-03-04')",
+		expected:   sqltypes.NewInt64(2021),
+	}, {
+		expression: `json_extract('{"a": [1, 2]}', '$.a[1]')`,
+		expected:   sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte("2")),
+	}, {
+		expression: `json_unquote('"abc"')`,
+		expected:   sqltypes.NewVarChar("abc"),
 	}}
 
 	for _, test := range tests {
@@ -294,6 +381,66 @@ func TestEvaluate(t *testing.T) {
 	}
 }
 
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		err        string
+	}{{
+		expression: "concat()",
+		err:        "Incorrect parameter count in the call to native function 'CONCAT'",
+	}, {
+		expression: "concat_ws(',')",
+		err:        "Incorrect parameter count in the call to native function 'CONCAT_WS'",
+	}, {
+		expression: "if(1 > 0, 'yes')",
+		err:        "Incorrect parameter count in the call to native function 'IF'",
+	}, {
+		expression: "ifnull(null)",
+		err:        "Incorrect parameter count in the call to native function 'IFNULL'",
+	}, {
+		expression: "round()",
+		err:        "Incorrect parameter count in the call to native function 'ROUND'",
+	}, {
+		expression: "lpad('5', 3)",
+		err:        "Incorrect parameter count in the call to native function 'LPAD'",
+	}, {
+		expression: "abs(-9223372036854775808)",
+		err:        "BIGINT value is out of range in 'abs(-9223372036854775808)'",
+	}, {
+		expression: "round(9223372036854775807, -1)",
+		err:        "BIGINT value is out of range in 'round(9223372036854775807,-1)'",
+	}, {
+		expression: `json_extract('{"a": 1}', 'a')`,
+		err:        "Invalid JSON path expression. The error is around character position 0.",
+	}, {
+		expression: `json_extract('{"a": 1}', '$.')`,
+		err:        "Invalid JSON path expression. The error is around character position 2.",
+	}, {
+		expression: "json_extract('{', '$.a')",
+		err:        "Invalid JSON text in argument 1 to function json_extract.",
+	}, {
+		expression: "date_add('2020-01-01', interval 1 day_hour)",
+		err:        "unsupported interval unit: day_hour",
+	}, {
+		expression: "md5('abc')",
+		err:        "expr cannot be translated, not supported: md5('abc')",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.expression, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + test.expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr).Expr
+			// translate without simplifying, so that the errors of the builtins are raised when evaluating them
+			sqltypesExpr, err := TranslateEx(astExpr, LookupDefaultCollation(45), false)
+			if err == nil {
+				_, err = EmptyExpressionEnv().Evaluate(sqltypesExpr)
+			}
+			require.EqualError(t, err, test.err)
+		})
+	}
+}
+
 func TestEvaluateTuple(t *testing.T) {
 	type testCase struct {
 		expression string
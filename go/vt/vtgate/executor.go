@@ -51,8 +51,10 @@ import (
 	"vitess.io/vitess/go/vt/sysvars"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/buffer"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+	"vitess.io/vitess/go/vt/vtgate/resultcache"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
 
@@ -97,6 +99,10 @@ type Executor struct {
 	streamSize   int
 	plans        cache.Cache
 	vschemaStats *VSchemaStats
+	// routingRulesChanged is called after each vschema update with the
+	// keyspaces whose tables were moved to another keyspace by the update and
+	// with all keyspaces whose tables are routed to another keyspace.
+	routingRulesChanged func(changed, routedAway []string)
 
 	normalize       bool
 	warnShardedOnly bool
@@ -106,6 +112,9 @@ type Executor struct {
 
 	// allowScatter will fail planning if set to false and a plan contains any scatter queries
 	allowScatter bool
+
+	// resultCache caches the results of SELECTs, nil if disabled
+	resultCache *resultcache.Cache
 }
 
 var executorOnce sync.Once
@@ -113,6 +122,7 @@ var executorOnce sync.Once
 const pathQueryPlans = "/debug/query_plans"
 const pathScatterStats = "/debug/scatter_stats"
 const pathVSchema = "/debug/vschema"
+const pathResultCache = "/debug/result_cache"
 
 // NewExecutor creates a new Executor.
 func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver *Resolver, normalize, warnOnShardedOnly bool, streamSize int, cacheCfg *cache.Config, schemaTracker SchemaInfo, noScatter bool) *Executor {
@@ -162,6 +172,7 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 		http.Handle(pathQueryPlans, e)
 		http.Handle(pathScatterStats, e)
 		http.Handle(pathVSchema, e)
+		http.Handle(pathResultCache, e)
 	})
 	return e
 }
@@ -175,6 +186,9 @@ func (e *Executor) Execute(ctx context.Context, method string, safeSession *Safe
 
 	logStats := NewLogStats(ctx, method, sql, bindVars)
 	stmtType, result, err := e.execute(ctx, safeSession, sql, bindVars, logStats)
+	if err == nil {
+		e.trackWrittenGTIDs(ctx, safeSession, result)
+	}
 	logStats.Error = err
 	if result == nil {
 		saveSessionStats(safeSession, stmtType, 0, 0, 0, err)
@@ -279,11 +293,26 @@ func (e *Executor) StreamExecute(
 			return srr.storeResultStats(plan.Type, qr)
 		})
 
+		// Streaming queries also go over the memory budget, so the bytes they spilled
+		// are added to the plan statistics along with the execution.
+		var errCount uint64
+		if err != nil {
+			errCount = 1
+		}
+		srr.mu.Lock()
+		plan.AddStats(1, time.Since(logStats.StartTime), logStats.ShardQueries, srr.rowsAffected, uint64(srr.rowsReturned), errCount, vc.MemoryBudget().SpilledBytes())
+		srr.mu.Unlock()
+
 		// Check if there was partial DML execution. If so, rollback the effect of the partially executed query.
 		if err != nil {
 			if !canReturnRows(plan.Type) {
 				return e.rollbackExecIfNeeded(ctx, safeSession, bindVars, logStats, err)
 			}
+			// A query which already streamed rows to the client cannot be planned
+			// and run again after the routing rules changed during a cutover.
+			if seenResults.Get() && vterrors.RootCause(err) == buffer.RoutingChangedError {
+				return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "routing rules changed while streaming the results: %v", err)
+			}
 			return err
 		}
 
@@ -1382,17 +1411,116 @@ func (e *Executor) VSchema() *vindexes.VSchema {
 // SaveVSchema updates the vschema and stats
 func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 	e.mu.Lock()
-	defer e.mu.Unlock()
+	var moved, routedAway []string
 	if vschema != nil {
+		moved = changedRoutingKeyspaces(e.vschema, vschema)
+		routedAway = routedAwayKeyspaces(vschema)
 		e.vschema = vschema
 	}
 	e.vschemaStats = stats
 	e.plans.Clear()
+	routingRulesChanged := e.routingRulesChanged
+	e.mu.Unlock()
 
 	if vschemaCounters != nil {
 		vschemaCounters.Add("Reload", 1)
 	}
 
+	// Only notify after the new vschema is in place, so that requests which
+	// are released by the listener are planned with the new routing rules.
+	if vschema != nil && routingRulesChanged != nil {
+		routingRulesChanged(moved, routedAway)
+	}
+}
+
+// onRoutingRulesChange registers the listener which is notified of the
+// routing rules of each vschema update.
+func (e *Executor) onRoutingRulesChange(listener func(changed, routedAway []string)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.routingRulesChanged = listener
+}
+
+// changedRoutingKeyspaces returns the keyspaces whose tables are routed
+// differently by "newVSchema" than by "oldVSchema", i.e. both the source and
+// the target keyspaces of a MoveTables cutover.
+func changedRoutingKeyspaces(oldVSchema, newVSchema *vindexes.VSchema) []string {
+	if oldVSchema == nil {
+		return nil
+	}
+	changed := make(map[string]bool)
+	addKeyspaces := func(rule *vindexes.RoutingRule) {
+		if rule == nil {
+			return
+		}
+		for _, table := range rule.Tables {
+			if table.Keyspace != nil {
+				changed[table.Keyspace.Name] = true
+			}
+		}
+	}
+	for name, oldRule := range oldVSchema.RoutingRules {
+		if newRule := newVSchema.RoutingRules[name]; !sameRoutingTargets(oldRule, newRule) {
+			addKeyspaces(oldRule)
+			addKeyspaces(newRule)
+		}
+	}
+	for name, newRule := range newVSchema.RoutingRules {
+		if _, ok := oldVSchema.RoutingRules[name]; !ok {
+			addKeyspaces(newRule)
+		}
+	}
+
+	keyspaces := make([]string, 0, len(changed))
+	for keyspace := range changed {
+		keyspaces = append(keyspaces, keyspace)
+	}
+	sort.Strings(keyspaces)
+	return keyspaces
+}
+
+// routedAwayKeyspaces returns the keyspaces whose tables are routed to another
+// keyspace by the PRIMARY routing rules of "vschema", i.e. the source
+// keyspaces of the MoveTables workflows which switched their writes.
+func routedAwayKeyspaces(vschema *vindexes.VSchema) []string {
+	routedAway := make(map[string]bool)
+	for name, rule := range vschema.RoutingRules {
+		if rule.Error != nil || len(rule.Tables) == 0 || strings.Contains(name, "@") {
+			continue
+		}
+		dot := strings.Index(name, ".")
+		if dot < 0 {
+			continue
+		}
+		keyspace := name[:dot]
+		if rule.Tables[0].Keyspace.Name != keyspace {
+			routedAway[keyspace] = true
+		}
+	}
+
+	keyspaces := make([]string, 0, len(routedAway))
+	for keyspace := range routedAway {
+		keyspaces = append(keyspaces, keyspace)
+	}
+	sort.Strings(keyspaces)
+	return keyspaces
+}
+
+// sameRoutingTargets returns true if both routing rules point to the same tables.
+func sameRoutingTargets(a, b *vindexes.RoutingRule) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Tables) != len(b.Tables) {
+		return false
+	}
+	for i, table := range a.Tables {
+		other := b.Tables[i]
+		if table.Keyspace.Name != other.Keyspace.Name || table.Name.String() != other.Name.String() {
+			return false
+		}
+	}
+	return true
 }
 
 // ParseDestinationTarget parses destination target string and sets default keyspace if possible.
@@ -1486,6 +1614,14 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 	plan.Warnings = vcursor.warnings
 	vcursor.warnings = nil
 
+	if e.resultCache != nil {
+		plan.ResultCacheTables = cacheableTables(statement, plan)
+		if plan.ResultCacheTables != nil {
+			optedIn := resultCacheOptedIn(vcursor.vschema, plan.ResultCacheTables)
+			plan.ResultCacheTTL = e.resultCache.TTL(sqlparser.ResultCacheTTLDirective(statement), optedIn)
+		}
+	}
+
 	if qo.cachePlan() && sqlparser.CachePlan(statement) {
 		e.plans.Set(planKey, plan)
 	}
@@ -1566,6 +1702,13 @@ func (e *Executor) ServeHTTP(response http.ResponseWriter, request *http.Request
 		returnAsJSON(response, e.VSchema())
 	case pathScatterStats:
 		e.WriteScatterStats(response)
+	case pathResultCache:
+		if e.resultCache == nil {
+			response.WriteHeader(http.StatusNotFound)
+			_, _ = response.Write([]byte("result cache is disabled, see -enable_result_cache"))
+			return
+		}
+		returnAsJSON(response, e.resultCache.Status())
 	default:
 		response.WriteHeader(http.StatusNotFound)
 	}
@@ -1752,7 +1895,7 @@ func (e *Executor) handlePrepare(ctx context.Context, safeSession *SafeSession,
 	}
 	logStats.RowsAffected = qr.RowsAffected
 
-	plan.AddStats(1, time.Since(logStats.StartTime), logStats.ShardQueries, qr.RowsAffected, uint64(len(qr.Rows)), errCount)
+	plan.AddStats(1, time.Since(logStats.StartTime), logStats.ShardQueries, qr.RowsAffected, uint64(len(qr.Rows)), errCount, 0)
 
 	return qr.Fields, err
 }
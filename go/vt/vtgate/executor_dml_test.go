@@ -2118,3 +2118,48 @@ func TestTestPartialVindexInsertQueryFailure(t *testing.T) {
 	testQueryLogWithSavepoint(t, logChan, "VindexCreate", "SAVEPOINT_ROLLBACK", "rollback to x", 0, true)
 	testQueryLogWithSavepoint(t, logChan, "TestExecute", "INSERT", "insert into t1(id, unq_col) values (1, 1), (2, 3)", 0, true)
 }
+
+func TestDMLTracksGTIDs(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	gtidResult := func(gtid string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("@@global.gtid_executed", "varchar"), gtid)
+	}
+	gtidQuery := &querypb.BoundQuery{Sql: "select @@global.gtid_executed", BindVariables: map[string]*querypb.BindVariable{}}
+
+	// autocommit write.
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+	session.SetSessionTrackGtids(true)
+	sbc1.SetResults([]*sqltypes.Result{{RowsAffected: 1}, gtidResult("3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5")})
+	qr, err := executor.Execute(context.Background(), "TestExecute", session, "update user set a=2 where id = 1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5", qr.SessionStateChanges)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql:           "update `user` set a = 2 where id = 1",
+		BindVariables: map[string]*querypb.BindVariable{},
+	}, gtidQuery}
+	assertQueries(t, sbc1, wantQueries)
+
+	// writes of a transaction are tracked once it commits.
+	sbc1.Queries = nil
+	session.Autocommit = false
+	sbc2.SetResults([]*sqltypes.Result{{RowsAffected: 1}, gtidResult("4e11fa47-71ca-11e1-9e33-c80aa9429562:1-3")})
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "begin", nil)
+	require.NoError(t, err)
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "update user set a=2 where id = 3", nil)
+	require.NoError(t, err)
+	assert.Empty(t, qr.SessionStateChanges)
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "commit", nil)
+	require.NoError(t, err)
+	want := "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5,4e11fa47-71ca-11e1-9e33-c80aa9429562:1-3"
+	assert.Equal(t, want, qr.SessionStateChanges)
+	got, _ := session.readAfterWrite()
+	assert.Equal(t, want, got)
+	assertQueries(t, sbc1, nil)
+
+	// reads do not track anything.
+	sbc2.Queries = nil
+	qr, err = executor.Execute(context.Background(), "TestExecute", session, "select id from user where id = 3", nil)
+	require.NoError(t, err)
+	assert.Empty(t, qr.SessionStateChanges)
+	assert.Equal(t, 1, len(sbc2.Queries))
+}
@@ -38,9 +38,11 @@ import (
 	"vitess.io/vitess/go/test/utils"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/resultcache"
 	_ "vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
@@ -3370,3 +3372,139 @@ func TestMultiColPartial(t *testing.T) {
 		})
 	}
 }
+
+// enableTestResultCache gives the executor a result cache whose stream of
+// the TestExecutor keyspace is fed through the returned channel. The given
+// tables of the keyspace opt in to the result cache in the vschema.
+func enableTestResultCache(t *testing.T, executor *Executor, tables ...string) chan []*binlogdatapb.VEvent {
+	for _, table := range tables {
+		executor.vschema.Keyspaces["TestExecutor"].Tables[table].ResultCache = true
+	}
+	events := make(chan []*binlogdatapb.VEvent)
+	executor.resultCache = resultcache.New(resultcache.Config{MaxMemoryUsage: 1 << 20, TTL: time.Minute},
+		func(ctx context.Context, keyspace string, send func([]*binlogdatapb.VEvent) error) error {
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case ev := <-events:
+					if err := send(ev); err != nil {
+						return err
+					}
+				}
+			}
+		})
+	t.Cleanup(func() {
+		executor.resultCache.Close()
+		executor.resultCache = nil
+	})
+	return events
+}
+
+// feed hands ev to the stream, and waits until it was processed.
+func feed(events chan []*binlogdatapb.VEvent, ev ...*binlogdatapb.VEvent) {
+	events <- ev
+	events <- nil
+}
+
+func TestResultCache(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	events := enableTestResultCache(t, executor, "user")
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+	query := "select id from user where id = 1"
+
+	// Nothing is cached until the stream of the keyspace is up.
+	_, err := exec(executor, session, query)
+	require.NoError(t, err)
+	feed(events, &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_HEARTBEAT})
+
+	_, err = exec(executor, session, query)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+	_, err = exec(executor, session, query)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get(), "result should have been served from the cache")
+
+	// Other bind variables are cached under another key.
+	_, err = exec(executor, session, "select id from user where id = 2")
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, sbc1.ExecCount.Get())
+
+	// A write to the table invalidates the cached result.
+	feed(events, &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "user"}})
+	_, err = exec(executor, session, query)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, sbc1.ExecCount.Get())
+
+	// Transactions always read from the tablets.
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "@primary", InTransaction: true})
+	_, err = exec(executor, session, query)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, sbc1.ExecCount.Get())
+
+	status := executor.resultCache.Status()
+	assert.EqualValues(t, 1, status.Hits)
+	assert.EqualValues(t, 4, status.Misses)
+}
+
+func TestResultCacheOptIn(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	events := enableTestResultCache(t, executor)
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+
+	_, err := exec(executor, session, "select /*vt+ RESULT_CACHE_TTL_MS=60000 */ id from user where id = 1")
+	require.NoError(t, err)
+	feed(events, &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_HEARTBEAT})
+
+	// Tables that did not opt in are only cached when the query asks for it.
+	for _, query := range []string{
+		"select id from user where id = 1",
+		"select id from user where id = 1",
+		"select /*vt+ RESULT_CACHE_TTL_MS=60000 */ id from user where id = 1",
+		"select /*vt+ RESULT_CACHE_TTL_MS=60000 */ id from user where id = 1",
+	} {
+		_, err = exec(executor, session, query)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 4, sbc1.ExecCount.Get())
+
+	// Nondeterministic queries are never cached.
+	for i := 0; i < 2; i++ {
+		_, err = exec(executor, session, "select /*vt+ RESULT_CACHE_TTL_MS=60000 */ id, rand() from user where id = 1")
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 6, sbc1.ExecCount.Get())
+}
+
+func TestResultCacheReplicationLag(t *testing.T) {
+	executor, _, _, _ := createExecutorEnv()
+	events := enableTestResultCache(t, executor, "user")
+	hc := executor.scatterConn.gateway.(*TabletGateway).hc.(*discovery.FakeHealthCheck)
+	replica := hc.AddTestTablet("aa", "-20-replica", 1, "TestExecutor", "-20", topodatapb.TabletType_REPLICA, true, 1, nil)
+	for _, status := range hc.CacheStatus() {
+		if status.Target.TabletType == topodatapb.TabletType_REPLICA {
+			status.TabletsStats[0].Stats.ReplicationLagSeconds = 10
+		}
+	}
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@replica", Autocommit: true})
+	query := "select id from user where id = 1"
+
+	_, err := exec(executor, session, query)
+	require.NoError(t, err)
+	feed(events, &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_HEARTBEAT})
+
+	// Nothing was invalidated yet, so the result of the replica can be cached.
+	for i := 0; i < 2; i++ {
+		_, err = exec(executor, session, query)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 2, replica.ExecCount.Get())
+
+	// The replica lags behind a write to the table, its results are not cached until it caught up.
+	feed(events, &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "user"}})
+	for i := 0; i < 2; i++ {
+		_, err = exec(executor, session, query)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 4, replica.ExecCount.Get())
+}
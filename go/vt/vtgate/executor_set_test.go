@@ -438,14 +438,21 @@ func TestPlanExecutorSetUDV(t *testing.T) {
 	}, {
 		in:  "set @foo = 2.1, @bar = 'baz'",
 		out: &vtgatepb.Session{UserDefinedVariables: createMap([]string{"foo", "bar"}, []interface{}{sqltypes.DecimalFloat(2.1), "baz"}), Autocommit: true},
+	}, {
+		in:  "set @foo = concat('a', 'b', 1)",
+		out: &vtgatepb.Session{UserDefinedVariables: createMap([]string{"foo"}, []interface{}{"ab1"}), Autocommit: true},
+	}, {
+		in:  "set @foo = concat_ws(',')",
+		err: "Incorrect parameter count in the call to native function 'CONCAT_WS'",
 	}}
 	for _, tcase := range testcases {
 		t.Run(tcase.in, func(t *testing.T) {
 			session := NewSafeSession(&vtgatepb.Session{Autocommit: true})
 			_, err := executor.Execute(context.Background(), "TestExecute", session, tcase.in, nil)
-			if err != nil {
+			if tcase.err != "" {
 				require.EqualError(t, err, tcase.err)
 			} else {
+				require.NoError(t, err)
 				utils.MustMatch(t, tcase.out, session.Session, "session output was not as expected")
 			}
 		})
@@ -467,8 +474,10 @@ func TestSetUDVFromTabletInput(t *testing.T) {
 	defer func() {
 		primarySession.TargetString = ""
 	}()
-	_, err := executorExec(executor, "set @foo = concat('a','b','c')", nil)
+	_, err := executorExec(executor, "set @foo = md5('abc')", nil)
 	require.NoError(t, err)
+	require.Len(t, sbc1.Queries, 1)
+	assert.Equal(t, "select md5('abc') from dual", sbc1.Queries[0].Sql)
 
 	want := map[string]*querypb.BindVariable{"foo": sqltypes.StringBindVariable("abc")}
 	utils.MustMatch(t, want, primarySession.UserDefinedVariables, "")
@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/engine"
 	_ "vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 )
@@ -83,6 +84,33 @@ func TestStreamSQLSharded(t *testing.T) {
 	}
 }
 
+func TestStreamExecuteSpilledBytes(t *testing.T) {
+	defer func(budget int64) {
+		*queryMemoryBudget = budget
+	}(*queryMemoryBudget)
+	*queryMemoryBudget = 1
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("a|b|k|weight_string(a)", "int64|int64|int64|varbinary"), "1|1|3|1", "2|2|1|2")
+	sbc1.SetResults([]*sqltypes.Result{result})
+	sbc2.SetResults([]*sqltypes.Result{result})
+
+	sql := "select a, b, count(*) k from user where id in (1, 3) group by a order by k"
+	_, err := executorStream(executor, sql)
+	require.NoError(t, err)
+
+	executor.plans.Wait()
+	var plan *engine.Plan
+	executor.plans.ForEach(func(value interface{}) bool {
+		if p := value.(*engine.Plan); p.Original == sql {
+			plan = p
+		}
+		return true
+	})
+	require.NotNil(t, plan)
+	require.EqualValues(t, 1, plan.ExecCount)
+	require.NotZero(t, plan.SpilledBytes)
+}
+
 func executorStreamMessages(executor *Executor, sql string) (qr *sqltypes.Result, err error) {
 	results := make(chan *sqltypes.Result, 100)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
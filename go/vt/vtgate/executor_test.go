@@ -32,6 +32,7 @@ import (
 
 	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/test/utils"
+	"vitess.io/vitess/go/vt/vtgate/buffer"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 
 	"vitess.io/vitess/go/vt/topo"
@@ -2489,6 +2490,83 @@ func TestExecutorShowVitessMigrations(t *testing.T) {
 	assert.Contains(t, sbc2.StringQueries(), "SELECT * FROM _vt.schema_migrations")
 }
 
+func TestExecutorReplanAfterRoutingChange(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+
+	// The query was buffered during a MoveTables cutover and must be planned again.
+	sbc1.EphemeralShardErr = buffer.RoutingChangedError
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "select id from user where id = 1", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+
+	// Inside of a transaction the error is returned to the client.
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "@primary", InTransaction: true})
+	sbc1.EphemeralShardErr = buffer.RoutingChangedError
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from user where id = 1", nil)
+	require.Error(t, err)
+}
+
+func TestStreamExecutorReplanAfterRoutingChange(t *testing.T) {
+	executor, sbc1, _, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@primary", Autocommit: true})
+
+	// The streaming query was buffered during a MoveTables cutover and must be planned again.
+	sbc1.EphemeralShardErr = buffer.RoutingChangedError
+	err := executor.StreamExecute(context.Background(), "TestExecuteStream", session, "select id from user where id = 1", nil, func(*sqltypes.Result) error {
+		return nil
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, sbc1.ExecCount.Get())
+}
+
+func TestChangedRoutingKeyspaces(t *testing.T) {
+	keyspaces := map[string]*vschemapb.Keyspace{
+		"source": {Tables: map[string]*vschemapb.Table{"t1": {}, "t2": {}}},
+		"target": {Tables: map[string]*vschemapb.Table{"t1": {}, "t2": {}}},
+		"other":  {Tables: map[string]*vschemapb.Table{"t3": {}}},
+	}
+	build := func(rules ...*vschemapb.RoutingRule) *vindexes.VSchema {
+		return vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+			Keyspaces:    keyspaces,
+			RoutingRules: &vschemapb.RoutingRules{Rules: rules},
+		})
+	}
+	toSource := &vschemapb.RoutingRule{FromTable: "t1", ToTables: []string{"source.t1"}}
+	toTarget := &vschemapb.RoutingRule{FromTable: "t1", ToTables: []string{"target.t1"}}
+	toOther := &vschemapb.RoutingRule{FromTable: "t4", ToTables: []string{"other.t3"}}
+
+	assert.Empty(t, changedRoutingKeyspaces(nil, build(toSource)))
+	assert.Empty(t, changedRoutingKeyspaces(build(toSource), build(toSource)))
+	assert.Equal(t, []string{"source", "target"}, changedRoutingKeyspaces(build(toSource), build(toTarget)))
+	assert.Equal(t, []string{"other"}, changedRoutingKeyspaces(build(toSource), build(toSource, toOther)))
+	assert.Equal(t, []string{"source"}, changedRoutingKeyspaces(build(toSource), build()))
+}
+
+func TestRoutedAwayKeyspaces(t *testing.T) {
+	keyspaces := map[string]*vschemapb.Keyspace{
+		"source": {Tables: map[string]*vschemapb.Table{"t1": {}}},
+		"target": {Tables: map[string]*vschemapb.Table{"t1": {}}},
+	}
+	build := func(rules ...*vschemapb.RoutingRule) *vindexes.VSchema {
+		return vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+			Keyspaces:    keyspaces,
+			RoutingRules: &vschemapb.RoutingRules{Rules: rules},
+		})
+	}
+	rule := func(from, to string) *vschemapb.RoutingRule {
+		return &vschemapb.RoutingRule{FromTable: from, ToTables: []string{to}}
+	}
+
+	assert.Empty(t, routedAwayKeyspaces(build(rule("t1", "source.t1"), rule("source.t1", "source.t1"))))
+	// Before the cutover, the tables of the target keyspace are routed to the source keyspace.
+	assert.Equal(t, []string{"target"}, routedAwayKeyspaces(build(rule("t1", "source.t1"), rule("target.t1", "source.t1"))))
+	// Only the reads were switched.
+	assert.Empty(t, routedAwayKeyspaces(build(rule("t1", "source.t1"), rule("source.t1@replica", "target.t1"))))
+	// The writes were switched.
+	assert.Equal(t, []string{"source"}, routedAwayKeyspaces(build(rule("t1", "target.t1"), rule("source.t1", "target.t1"))))
+}
+
 func exec(executor *Executor, session *SafeSession, sql string) (*sqltypes.Result, error) {
 	return executor.Execute(context.Background(), "TestExecute", session, sql, nil)
 }
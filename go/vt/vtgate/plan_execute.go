@@ -25,6 +25,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/buffer"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
 )
@@ -53,6 +54,26 @@ func (e *Executor) newExecute(
 		bindVars = make(map[string]*querypb.BindVariable)
 	}
 
+	for try := 0; ; try++ {
+		err = e.planAndExecute(ctx, safeSession, sql, bindVars, logStats, execPlan, recResult)
+		// The routing rules changed while the query was buffered during a MoveTables
+		// cutover. The query has to be planned again to reach the tables in their new keyspace.
+		if try < MaxBufferingRetries && vterrors.RootCause(err) == buffer.RoutingChangedError && !safeSession.InTransaction() {
+			continue
+		}
+		return err
+	}
+}
+
+func (e *Executor) planAndExecute(
+	ctx context.Context,
+	safeSession *SafeSession,
+	sql string,
+	bindVars map[string]*querypb.BindVariable,
+	logStats *LogStats,
+	execPlan planExec,
+	recResult txResult,
+) error {
 	query, comments := sqlparser.SplitMarginComments(sql)
 	vcursor, err := newVCursorImpl(ctx, safeSession, comments, e, logStats, e.vm, e.VSchema(), e.resolver.resolver, e.serv, e.warnShardedOnly)
 	if err != nil {
@@ -210,7 +231,7 @@ func (e *Executor) executePlan(
 ) (*sqltypes.Result, error) {
 
 	// 4: Execute!
-	qr, err := vcursor.ExecutePrimitive(plan.Instructions, bindVars, true)
+	qr, err := e.executeWithResultCache(safeSession, plan, vcursor, bindVars)
 
 	// 5: Log and add statistics
 	e.setLogStats(logStats, plan, vcursor, execStart, err, qr)
@@ -263,7 +284,7 @@ func (e *Executor) setLogStats(logStats *LogStats, plan *engine.Plan, vcursor *v
 	logStats.Table = plan.Instructions.GetTableName()
 	logStats.TabletType = vcursor.TabletType().String()
 	errCount := e.logExecutionEnd(logStats, execStart, plan, err, qr)
-	plan.AddStats(1, time.Since(logStats.StartTime), logStats.ShardQueries, logStats.RowsAffected, logStats.RowsReturned, errCount)
+	plan.AddStats(1, time.Since(logStats.StartTime), logStats.ShardQueries, logStats.RowsAffected, logStats.RowsReturned, errCount, vcursor.MemoryBudget().SpilledBytes())
 }
 
 func (e *Executor) logExecutionEnd(logStats *LogStats, execStart time.Time, plan *engine.Plan, err error, qr *sqltypes.Result) uint64 {
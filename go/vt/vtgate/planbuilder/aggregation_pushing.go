@@ -0,0 +1,379 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/mysql/collations"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+)
+
+type (
+	// aggregationProjection computes the columns of the select list that can't be computed by a single
+	// aggregate function, like `sum(a) / count(b)` or `avg(x)`. The aggregate functions used by these
+	// expressions are pushed down as partial aggregates, merged by the ordered aggregate, and the
+	// expressions are evaluated on top of it by an engine.Projection.
+	aggregationProjection struct {
+		oa *orderedAggregate
+
+		// offsets holds, for every column of the select list, its offset in the output
+		// of the ordered aggregate, or -1 if the column is computed by the projection
+		offsets []int
+	}
+
+	// aggregationLookup is used to translate the computed columns of an aggregationProjection.
+	// The aggregate functions and the columns that are already projected by the ordered aggregate
+	// are replaced by placeholders that point to their offset in the output of the ordered aggregate.
+	aggregationLookup struct {
+		ctx          *plancontext.PlanningContext
+		oa           *orderedAggregate
+		offsets      map[*sqlparser.ColName]int
+		placeholders map[*sqlparser.ColName]sqlparser.Expr
+	}
+
+	// unionAggregation is used to aggregate the rows of a UNION ALL derived table.
+	// The query of every source of the concatenate is wrapped in a derived table, and the
+	// aggregates are computed on top of it, so only partial aggregates are returned to the vtgate.
+	unionAggregation struct {
+		concat *concatenateGen4
+
+		// columns maps the columns of the union to their offsets in the output of the concatenate
+		columns []unionColumn
+	}
+
+	unionColumn struct {
+		expr   sqlparser.Expr
+		offset int
+	}
+)
+
+var _ evalengine.TranslationLookup = (*aggregationLookup)(nil)
+
+// unionAggregationAlias is the alias of the derived table used to wrap the sources of a unionAggregation
+const unionAggregationAlias = "dt"
+
+// needsProjection returns true if some columns of the select list have to be computed by the projection
+func (ap *aggregationProjection) needsProjection() bool {
+	for _, offset := range ap.offsets {
+		if offset == -1 {
+			return true
+		}
+	}
+	return false
+}
+
+// planComplexAggregate pushes the aggregate functions used by the given column of the select list,
+// so that the column can be computed by the projection once the aggregation is done.
+func (ap *aggregationProjection) planComplexAggregate(idx int, expr *sqlparser.AliasedExpr, pushAggregate func(*sqlparser.AliasedExpr) (int, error)) error {
+	var aggregates []*sqlparser.FuncExpr
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.GroupConcatExpr:
+			return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: aggregation function 'group_concat'")
+		case *sqlparser.FuncExpr:
+			if !node.IsAggregate() {
+				return true, nil
+			}
+			if node.Name.Lowered() == "avg" {
+				sum, count := splitAverage(node)
+				aggregates = append(aggregates, sum, count)
+			} else {
+				aggregates = append(aggregates, node)
+			}
+			return false, nil
+		}
+		return true, nil
+	}, expr.Expr)
+	if err != nil {
+		return err
+	}
+
+	for _, aggr := range aggregates {
+		if ap.findAggregate(aggr) != -1 {
+			continue
+		}
+		funcName := aggr.Name.Lowered()
+		opcode, found := engine.SupportedAggregates[funcName]
+		if !found {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: aggregation function '%s'", funcName)
+		}
+		if aggr.Distinct {
+			return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: distinct aggregation in complex aggregate expression: %s", sqlparser.String(expr.Expr))
+		}
+		offset, err := pushAggregate(&sqlparser.AliasedExpr{Expr: aggr})
+		if err != nil {
+			return err
+		}
+		param := &engine.AggregateParams{
+			Opcode:      opcode,
+			Col:         offset,
+			Alias:       sqlparser.String(aggr),
+			Expr:        aggr,
+			CollationID: collations.Unknown,
+		}
+		ap.oa.aggregates = append(ap.oa.aggregates, param)
+	}
+	ap.offsets[idx] = -1
+	return nil
+}
+
+// findAggregate returns the offset of the aggregate in the output of the ordered aggregate,
+// or -1 if it has not been pushed yet
+func (ap *aggregationProjection) findAggregate(aggr sqlparser.Expr) int {
+	for _, param := range ap.oa.aggregates {
+		if sqlparser.EqualsExpr(param.Expr, aggr) {
+			return param.Col
+		}
+	}
+	return -1
+}
+
+// plan builds the projection that computes the select list on top of the ordered aggregate.
+func (ap *aggregationProjection) plan(ctx *plancontext.PlanningContext, selectExprs []abstract.SelectExpr, input logicalPlan) (logicalPlan, error) {
+	lookup := &aggregationLookup{
+		ctx:          ctx,
+		oa:           ap.oa,
+		offsets:      map[*sqlparser.ColName]int{},
+		placeholders: map[*sqlparser.ColName]sqlparser.Expr{},
+	}
+	var columns []sqlparser.SelectExpr
+	var names []string
+	var exprs []evalengine.Expr
+	for i, e := range selectExprs {
+		aliasExpr, err := e.GetAliasedExpr()
+		if err != nil {
+			return nil, err
+		}
+		name := aliasExpr.As.String()
+		if name == "" {
+			name = sqlparser.String(aliasExpr.Expr)
+		}
+
+		var expr sqlparser.Expr
+		if ap.offsets[i] != -1 {
+			expr = lookup.placeholder(aliasExpr.Expr, ap.offsets[i])
+		} else {
+			expr, err = ap.replaceAggregates(lookup, aliasExpr.Expr)
+			if err != nil {
+				return nil, err
+			}
+		}
+		evalExpr, err := evalengine.Translate(expr, lookup)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, aliasExpr)
+		names = append(names, name)
+		exprs = append(exprs, evalExpr)
+	}
+	return newProjection(input, columns, names, exprs), nil
+}
+
+// replaceAggregates returns a copy of the expression where the aggregate functions are replaced by placeholders
+func (ap *aggregationProjection) replaceAggregates(lookup *aggregationLookup, expr sqlparser.Expr) (sqlparser.Expr, error) {
+	var err error
+	result := sqlparser.Rewrite(sqlparser.CloneExpr(expr), func(cursor *sqlparser.Cursor) bool {
+		aggr, isFunc := cursor.Node().(*sqlparser.FuncExpr)
+		if !isFunc || !aggr.IsAggregate() {
+			return true
+		}
+		if aggr.Name.Lowered() != "avg" {
+			offset := ap.findAggregate(aggr)
+			if offset == -1 {
+				err = vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] aggregate function not pushed down: %s", sqlparser.String(aggr))
+				return false
+			}
+			cursor.Replace(lookup.placeholder(aggr, offset))
+			return false
+		}
+		sum, count := splitAverage(aggr)
+		sumOffset, countOffset := ap.findAggregate(sum), ap.findAggregate(count)
+		if sumOffset == -1 || countOffset == -1 {
+			err = vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] aggregate function not pushed down: %s", sqlparser.String(aggr))
+			return false
+		}
+		cursor.Replace(&sqlparser.BinaryExpr{
+			Operator: sqlparser.DivOp,
+			Left:     lookup.placeholder(sum, sumOffset),
+			Right:    lookup.placeholder(count, countOffset),
+		})
+		return false
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result.(sqlparser.Expr), nil
+}
+
+// splitAverage returns the aggregates needed to compute an AVG function: its sum and its count
+func splitAverage(avg *sqlparser.FuncExpr) (sum, count *sqlparser.FuncExpr) {
+	sum = &sqlparser.FuncExpr{
+		Name:     sqlparser.NewColIdent("sum"),
+		Distinct: avg.Distinct,
+		Exprs:    sqlparser.CloneSelectExprs(avg.Exprs),
+	}
+	count = &sqlparser.FuncExpr{
+		Name:     sqlparser.NewColIdent("count"),
+		Distinct: avg.Distinct,
+		Exprs:    sqlparser.CloneSelectExprs(avg.Exprs),
+	}
+	return sum, count
+}
+
+// placeholder returns a column that stands for the given expression, found at the given offset
+func (a *aggregationLookup) placeholder(expr sqlparser.Expr, offset int) *sqlparser.ColName {
+	col := sqlparser.NewColName(sqlparser.String(expr))
+	a.offsets[col] = offset
+	a.placeholders[col] = expr
+	return col
+}
+
+// ColumnLookup implements the evalengine.TranslationLookup interface
+func (a *aggregationLookup) ColumnLookup(col *sqlparser.ColName) (int, error) {
+	if offset, found := a.offsets[col]; found {
+		return offset, nil
+	}
+	// the column is not an aggregate: it has to be one of the grouping keys
+	offset, _, err := pushProjection(a.ctx, &sqlparser.AliasedExpr{Expr: col}, a.oa, true, true, false)
+	return offset, err
+}
+
+// CollationForExpr implements the evalengine.TranslationLookup interface
+func (a *aggregationLookup) CollationForExpr(expr sqlparser.Expr) collations.ID {
+	if col, isCol := expr.(*sqlparser.ColName); isCol {
+		if original, found := a.placeholders[col]; found {
+			expr = original
+		}
+	}
+	return a.ctx.SemTable.CollationForExpr(expr)
+}
+
+// DefaultCollation implements the evalengine.TranslationLookup interface
+func (a *aggregationLookup) DefaultCollation() collations.ID {
+	return a.ctx.SemTable.Collation
+}
+
+// newUnionAggregation returns a unionAggregation if the aggregation is done on top of a UNION ALL derived table.
+// It returns nil if the input of the aggregation is not a concatenate.
+func newUnionAggregation(ctx *plancontext.PlanningContext, plan logicalPlan, qp *abstract.QueryProjection) (*unionAggregation, error) {
+	sp, isSimpleProj := plan.(*simpleProjection)
+	if !isSimpleProj {
+		return nil, nil
+	}
+	concat, isConcat := sp.input.(*concatenateGen4)
+	if !isConcat {
+		return nil, nil
+	}
+	errUnsupported := vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: aggregation on unions")
+	if len(qp.GroupByExprs) > 0 {
+		return nil, errUnsupported
+	}
+
+	ua := &unionAggregation{concat: concat}
+
+	// before wrapping the sources, we find where the columns used by the aggregates come from
+	for _, e := range qp.SelectExprs {
+		aliasExpr, err := e.GetAliasedExpr()
+		if err != nil {
+			return nil, err
+		}
+		if !e.Aggr {
+			return nil, errUnsupported
+		}
+		err = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+			col, isCol := node.(*sqlparser.ColName)
+			if !isCol {
+				return true, nil
+			}
+			offset, _, err := pushProjection(ctx, &sqlparser.AliasedExpr{Expr: col}, concat, true, true, false)
+			if err != nil {
+				return false, err
+			}
+			ua.columns = append(ua.columns, unionColumn{expr: col, offset: offset})
+			return false, nil
+		}, aliasExpr.Expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, source := range concat.sources {
+		rb, isRoute := source.(*routeGen4)
+		if !isRoute || len(rb.eroute.OrderBy) > 0 {
+			return nil, errUnsupported
+		}
+		// The columns are aliased inside the derived table, as a column list after
+		// its alias needs MySQL 8.0.19 or later.
+		for i, expr := range sqlparser.GetFirstSelect(rb.Select).SelectExprs {
+			aliasedExpr, isAliased := expr.(*sqlparser.AliasedExpr)
+			if !isAliased {
+				return nil, errUnsupported
+			}
+			aliasedExpr.As = sqlparser.NewColIdent(fmt.Sprintf("c%d", i))
+		}
+		rb.Select = &sqlparser.Select{
+			From: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{
+				Expr: &sqlparser.DerivedTable{Select: rb.Select},
+				As:   sqlparser.NewTableIdent(unionAggregationAlias),
+			}},
+		}
+	}
+	return ua, nil
+}
+
+// pushAggregate pushes the aggregate to all the sources of the concatenate, and returns its offset
+func (ua *unionAggregation) pushAggregate(expr *sqlparser.AliasedExpr) (int, error) {
+	alias := expr.As
+	if alias.IsEmpty() {
+		alias = sqlparser.NewColIdent(sqlparser.String(expr.Expr))
+	}
+	var err error
+	aggr := sqlparser.Rewrite(sqlparser.CloneExpr(expr.Expr), func(cursor *sqlparser.Cursor) bool {
+		col, isCol := cursor.Node().(*sqlparser.ColName)
+		if !isCol {
+			return true
+		}
+		for _, column := range ua.columns {
+			if sqlparser.EqualsExpr(column.expr, col) {
+				cursor.Replace(sqlparser.NewColNameWithQualifier(fmt.Sprintf("c%d", column.offset), sqlparser.TableName{Name: sqlparser.NewTableIdent(unionAggregationAlias)}))
+				return false
+			}
+		}
+		err = vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] column not found in the union: %s", sqlparser.String(col))
+		return false
+	}, nil).(sqlparser.Expr)
+	if err != nil {
+		return 0, err
+	}
+
+	offset := -1
+	for _, source := range ua.concat.sources {
+		sel := source.(*routeGen4).Select.(*sqlparser.Select)
+		if offset == -1 {
+			offset = len(sel.SelectExprs)
+		}
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: sqlparser.CloneExpr(aggr), As: alias})
+	}
+	return offset, nil
+}
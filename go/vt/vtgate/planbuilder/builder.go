@@ -205,6 +205,8 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars *
 		return buildShowMigrationLogsPlan(query, vschema, enableOnlineDDL)
 	case *sqlparser.AlterVschema:
 		return buildVSchemaDDLPlan(stmt, vschema)
+	case *sqlparser.MaterializedViewDDL:
+		return buildMaterializedViewPlan(query, stmt, vschema)
 	case *sqlparser.Use:
 		return buildUsePlan(stmt, vschema)
 	case sqlparser.Explain:
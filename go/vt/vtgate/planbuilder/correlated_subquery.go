@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/physical"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+var _ logicalPlan = (*correlatedSubquery)(nil)
+
+// correlatedSubquery is the logicalPlan for engine.CorrelatedSubquery.
+// This gets built if a correlated subquery cannot be merged with the outer query,
+// and is used in a predicate that cannot be planned as a semi-join.
+type correlatedSubquery struct {
+	gen4Plan
+	outer     logicalPlan
+	inner     logicalPlan
+	eSubquery *engine.CorrelatedSubquery
+}
+
+// newCorrelatedSubquery builds a new correlatedSubquery.
+func newCorrelatedSubquery(ctx *plancontext.PlanningContext, op *physical.CorrelatedSubQueryOp, outer, inner logicalPlan) (*correlatedSubquery, error) {
+	opcode := engine.PulloutOpcode(op.Extracted.OpCode)
+	if opcode != engine.PulloutExists {
+		var err error
+		inner, err = planHorizon(ctx, inner, op.Extracted.Subquery.Select)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the subqueries used by the predicate are replaced by the bind variables holding their results
+	var rewriteErr error
+	ast := sqlparser.Rewrite(op.Predicate, func(cursor *sqlparser.Cursor) bool {
+		sq, ok := cursor.Node().(*sqlparser.ExtractedSubquery)
+		if !ok {
+			return true
+		}
+		if sq.NeedsRewrite {
+			rewriteErr = vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+			return false
+		}
+		cursor.Replace(sq.GetAlternative())
+		return false
+	}, nil).(sqlparser.Expr)
+	if rewriteErr != nil {
+		return nil, rewriteErr
+	}
+
+	scl := &simpleConverterLookup{
+		canPushProjection: true,
+		ctx:               ctx,
+		plan:              outer,
+	}
+	predicate, err := evalengine.Translate(ast, scl)
+	if err != nil {
+		return nil, err
+	}
+
+	eSubquery := &engine.CorrelatedSubquery{
+		Opcode:       opcode,
+		Vars:         op.Vars,
+		Predicate:    predicate,
+		ASTPredicate: ast,
+	}
+	if opcode == engine.PulloutExists {
+		eSubquery.HasValues = op.Extracted.GetArgName()
+	} else {
+		eSubquery.SubqueryResult = op.Extracted.GetArgName()
+		eSubquery.HasValues = op.Extracted.GetHasValuesArg()
+	}
+	return &correlatedSubquery{
+		outer:     outer,
+		inner:     inner,
+		eSubquery: eSubquery,
+	}, nil
+}
+
+// Primitive implements the logicalPlan interface
+func (cs *correlatedSubquery) Primitive() engine.Primitive {
+	cs.eSubquery.Outer = cs.outer.Primitive()
+	cs.eSubquery.Subquery = cs.inner.Primitive()
+	return cs.eSubquery
+}
+
+// WireupGen4 implements the logicalPlan interface
+func (cs *correlatedSubquery) WireupGen4(semTable *semantics.SemTable) error {
+	if err := cs.outer.WireupGen4(semTable); err != nil {
+		return err
+	}
+	return cs.inner.WireupGen4(semTable)
+}
+
+// Rewrite implements the logicalPlan interface
+func (cs *correlatedSubquery) Rewrite(inputs ...logicalPlan) error {
+	if len(inputs) != 2 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "correlatedSubquery: wrong number of inputs")
+	}
+	cs.outer = inputs[0]
+	cs.inner = inputs[1]
+	return nil
+}
+
+// ContainsTables implements the logicalPlan interface
+func (cs *correlatedSubquery) ContainsTables() semantics.TableSet {
+	return cs.outer.ContainsTables().Merge(cs.inner.ContainsTables())
+}
+
+// Inputs implements the logicalPlan interface
+func (cs *correlatedSubquery) Inputs() []logicalPlan {
+	return []logicalPlan{cs.outer, cs.inner}
+}
+
+// OutputColumns implements the logicalPlan interface
+func (cs *correlatedSubquery) OutputColumns() []sqlparser.SelectExpr {
+	return cs.outer.OutputColumns()
+}
@@ -22,21 +22,29 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
 )
 
 // buildDeletePlan builds the instructions for a DELETE statement.
 func buildDeletePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
-	del := stmt.(*sqlparser.Delete)
-	if del.With != nil {
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in delete statement")
+	stmt, err := semantics.ExpandCTEs(stmt)
+	if err != nil {
+		return nil, err
 	}
-	var err error
+	del := stmt.(*sqlparser.Delete)
 	if len(del.TableExprs) == 1 && len(del.Targets) == 1 {
 		del, err = rewriteSingleTbl(del)
 		if err != nil {
 			return nil, err
 		}
 	}
+	if err := checkUpdatableTarget("DELETE", del.TableExprs); err != nil {
+		return nil, err
+	}
+	withInput, err := buildDeleteWithInput(del, reservedVars, vschema)
+	if err != nil || withInput != nil {
+		return withInput, err
+	}
 	dml, ksidVindex, err := buildDMLPlan(vschema, "delete", del, reservedVars, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
 	if err != nil {
 		return nil, err
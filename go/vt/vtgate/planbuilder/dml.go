@@ -277,6 +277,22 @@ func costForDML(foundVindex *vindexes.ColumnVindex, opcode engine.Opcode) costDM
 	}
 }
 
+// checkUpdatableTarget returns an error when the single table of a DML statement is a derived table.
+// This happens when the statement targets a common table expression.
+func checkUpdatableTarget(dmlType string, tableExprs sqlparser.TableExprs) error {
+	if len(tableExprs) != 1 {
+		return nil
+	}
+	aliasedTable, ok := tableExprs[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil
+	}
+	if _, isDerived := aliasedTable.Expr.(*sqlparser.DerivedTable); isDerived {
+		return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.NonUpdateableTable, "The target table %s of the %s is not updatable", aliasedTable.As.String(), dmlType)
+	}
+	return nil
+}
+
 func buildDMLPlan(vschema plancontext.VSchema, dmlType string, stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, *vindexes.ColumnVindex, error) {
 	edml := engine.NewDML()
 	pb := newPrimitiveBuilder(vschema, newJointab(reservedVars))
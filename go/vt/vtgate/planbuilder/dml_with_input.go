@@ -0,0 +1,299 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// dmlTarget is the table modified by a DML statement that has to be planned using a DMLWithInput
+type dmlTarget struct {
+	tblExpr   *sqlparser.AliasedTableExpr
+	tableName sqlparser.TableName
+	vtable    *vindexes.Table
+}
+
+// qualifier returns the name used to refer to the target table in the statement
+func (t *dmlTarget) qualifier() sqlparser.TableName {
+	if !t.tblExpr.As.IsEmpty() {
+		return sqlparser.TableName{Name: t.tblExpr.As}
+	}
+	return t.tableName
+}
+
+// isSingleTable returns true if the FROM clause of a DML statement is made of a single table
+func isSingleTable(tableExprs sqlparser.TableExprs) bool {
+	if len(tableExprs) != 1 {
+		return false
+	}
+	aliasedTable, ok := tableExprs[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return false
+	}
+	_, isTable := aliasedTable.Expr.(sqlparser.TableName)
+	return isTable
+}
+
+// needsDMLWithInput returns true if a DML statement on a sharded table cannot be sent
+// as is to the shards: it either uses a subquery or modifies a table joined with others.
+func needsDMLWithInput(tableExprs sqlparser.TableExprs, where *sqlparser.Where) bool {
+	return !isSingleTable(tableExprs) || (where != nil && hasSubquery(where))
+}
+
+// hasShardedTable returns true if any of the tables of a DML statement is in a sharded keyspace
+func hasShardedTable(vschema plancontext.VSchema, tableExprs sqlparser.TableExprs) (bool, error) {
+	sharded := false
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.AliasedTableExpr:
+			tbl, isTable := node.Expr.(sqlparser.TableName)
+			if !isTable {
+				return false, nil
+			}
+			vtable, _, _, _, err := vschema.FindTable(tbl)
+			if err != nil {
+				return false, err
+			}
+			sharded = sharded || (vtable != nil && vtable.Keyspace.Sharded)
+			return false, nil
+		case *sqlparser.DerivedTable, *sqlparser.Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, tableExprs)
+	return sharded, err
+}
+
+// findDMLTarget returns the table of the statement that matches the given name.
+// An empty name is only valid for single table statements.
+// A nil target is returned when the table is not in a sharded keyspace,
+// in which case the statement is planned the usual way.
+func findDMLTarget(vschema plancontext.VSchema, tableExprs sqlparser.TableExprs, name sqlparser.TableIdent) (*dmlTarget, error) {
+	var found *sqlparser.AliasedTableExpr
+	var tableName sqlparser.TableName
+	var count int
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.AliasedTableExpr:
+			tbl, isTable := node.Expr.(sqlparser.TableName)
+			if !isTable {
+				return false, nil
+			}
+			count++
+			if name.IsEmpty() || sqlparser.EqualsTableIdent(node.As, name) || (node.As.IsEmpty() && sqlparser.EqualsTableIdent(tbl.Name, name)) {
+				found = node
+				tableName = tbl
+			}
+			return false, nil
+		case *sqlparser.DerivedTable, *sqlparser.Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, tableExprs)
+	if found == nil || (name.IsEmpty() && count != 1) {
+		return nil, nil
+	}
+
+	vtable, _, _, destination, err := vschema.FindTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	if vtable != nil && vtable.Source != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries or joins in DML on the copy of global reference table %s", vtable.Name)
+	}
+	if vtable == nil || !vtable.Keyspace.Sharded || destination != nil {
+		return nil, nil
+	}
+	return &dmlTarget{
+		tblExpr:   found,
+		tableName: tableName,
+		vtable:    vtable,
+	}, nil
+}
+
+// buildDMLWithInput plans the input of a DMLWithInput, which selects the primary vindex column
+// and the primary key of the target rows, and returns the predicate the DML uses to modify these rows.
+// The rows are matched on their primary key, the primary vindex column is only used to route the DML,
+// which the DMLWithInput executes once per shard with the primary keys selected from that shard.
+func buildDMLWithInput(
+	vschema plancontext.VSchema,
+	reservedVars *sqlparser.ReservedVars,
+	target *dmlTarget,
+	tableExprs sqlparser.TableExprs,
+	where *sqlparser.Where,
+	orderBy sqlparser.OrderBy,
+	limit *sqlparser.Limit,
+) (engine.Primitive, *sqlparser.Where, error) {
+	vtable := target.vtable
+	if len(vtable.ColumnVindexes) == 0 || !vtable.ColumnVindexes[0].IsUnique() {
+		return nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RequiresPrimaryKey, vterrors.PrimaryVindexNotSet, vtable.Name)
+	}
+	if len(vtable.ColumnVindexes[0].Columns) != 1 {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries or joins in DML on a table with a multi-column primary vindex")
+	}
+	switch len(vtable.PrimaryKey) {
+	case 0:
+		return nil, nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.RequiresPrimaryKey, "the primary key of table %s is unknown: subqueries or joins in DML on a sharded table require schema tracking", vtable.Name)
+	case 1:
+	default:
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries or joins in DML on table %s with a multi-column primary key", vtable.Name)
+	}
+	pvCol := &sqlparser.ColName{
+		Name:      vtable.ColumnVindexes[0].Columns[0],
+		Qualifier: target.qualifier(),
+	}
+	selectExprs := sqlparser.SelectExprs{&sqlparser.AliasedExpr{Expr: pvCol}}
+	dmlExpr := sqlparser.Expr(&sqlparser.ComparisonExpr{
+		Operator: sqlparser.InOp,
+		Left:     sqlparser.CloneRefOfColName(pvCol),
+		Right:    sqlparser.NewListArg(engine.DMLVals),
+	})
+
+	// the primary vindex column does not identify a row when several rows map to the same value,
+	// in which case the primary key is selected as well and the DML is restricted to it
+	if !vtable.PrimaryKey[0].Equal(pvCol.Name) {
+		pkCol := &sqlparser.ColName{
+			Name:      vtable.PrimaryKey[0],
+			Qualifier: target.qualifier(),
+		}
+		selectExprs = append(selectExprs, &sqlparser.AliasedExpr{Expr: pkCol})
+		dmlExpr = &sqlparser.AndExpr{
+			Left: dmlExpr,
+			Right: &sqlparser.ComparisonExpr{
+				Operator: sqlparser.InOp,
+				Left:     sqlparser.CloneRefOfColName(pkCol),
+				Right:    sqlparser.NewListArg(engine.DMLPrimaryKeys),
+			},
+		}
+	}
+
+	// the selected rows are locked until the DML is done, the input and the DML running in the same transaction
+	sel := &sqlparser.Select{
+		SelectExprs: selectExprs,
+		From:        sqlparser.CloneTableExprs(tableExprs),
+		Where:       sqlparser.CloneRefOfWhere(where),
+		OrderBy:     sqlparser.CloneOrderBy(orderBy),
+		Limit:       sqlparser.CloneRefOfLimit(limit),
+		Lock:        sqlparser.ForUpdateLock,
+	}
+	input, err := gen4Planner(sqlparser.String(sel), querypb.ExecuteOptions_Gen4)(sel, reservedVars, vschema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return input, sqlparser.NewWhere(sqlparser.WhereClause, dmlExpr), nil
+}
+
+// buildDeleteWithInput plans a DELETE on a sharded table that uses subqueries or joins.
+// It returns a nil primitive if the statement can be planned without an input.
+func buildDeleteWithInput(del *sqlparser.Delete, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
+	if !needsDMLWithInput(del.TableExprs, del.Where) || len(del.Targets) > 1 {
+		return nil, nil
+	}
+	var name sqlparser.TableIdent
+	if len(del.Targets) == 1 {
+		name = del.Targets[0].Name
+	}
+	target, err := findDMLTarget(vschema, del.TableExprs, name)
+	if err != nil || target == nil {
+		return nil, err
+	}
+
+	input, where, err := buildDMLWithInput(vschema, reservedVars, target, del.TableExprs, del.Where, del.OrderBy, del.Limit)
+	if err != nil {
+		return nil, err
+	}
+	dmlDel := &sqlparser.Delete{
+		Comments:   del.Comments,
+		TableExprs: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: target.tableName, As: target.tblExpr.As}},
+		Where:      where,
+	}
+	dml, err := buildDeletePlan(dmlDel, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.DMLWithInput{Input: input, DML: dml}, nil
+}
+
+// buildUpdateWithInput plans an UPDATE on a sharded table that uses subqueries or joins.
+// It returns a nil primitive if the statement can be planned without an input.
+func buildUpdateWithInput(upd *sqlparser.Update, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
+	if !needsDMLWithInput(upd.TableExprs, upd.Where) {
+		return nil, nil
+	}
+
+	// the target of a multi-table update is the table of the updated columns
+	var name sqlparser.TableIdent
+	if !isSingleTable(upd.TableExprs) {
+		for _, expr := range upd.Exprs {
+			qualifier := expr.Name.Qualifier.Name
+			if qualifier.IsEmpty() {
+				sharded, err := hasShardedTable(vschema, upd.TableExprs)
+				if err != nil || !sharded {
+					return nil, err
+				}
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: unqualified column '%s' in multi-table update", expr.Name.Name.String())
+			}
+			if !name.IsEmpty() && !sqlparser.EqualsTableIdent(name, qualifier) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "multi-table update statement is not supported in sharded database")
+			}
+			name = qualifier
+		}
+	}
+	target, err := findDMLTarget(vschema, upd.TableExprs, name)
+	if err != nil || target == nil {
+		return nil, err
+	}
+	if hasSubquery(upd.Exprs) {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+	}
+	qualifier := target.qualifier()
+	for _, expr := range upd.Exprs {
+		err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+			col, isCol := node.(*sqlparser.ColName)
+			if isCol && !col.Qualifier.IsEmpty() && !sqlparser.EqualsTableIdent(col.Qualifier.Name, qualifier.Name) {
+				return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: update expression referencing table '%s' in sharded database", col.Qualifier.Name.String())
+			}
+			return true, nil
+		}, expr.Expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	input, where, err := buildDMLWithInput(vschema, reservedVars, target, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	dmlUpd := &sqlparser.Update{
+		Comments:   upd.Comments,
+		TableExprs: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{Expr: target.tableName, As: target.tblExpr.As}},
+		Exprs:      sqlparser.CloneUpdateExprs(upd.Exprs),
+		Where:      where,
+	}
+	dml, err := buildUpdatePlan(dmlUpd, reservedVars, vschema)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.DMLWithInput{Input: input, DML: dml}, nil
+}
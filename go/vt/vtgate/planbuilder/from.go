@@ -46,6 +46,20 @@ func (pb *primitiveBuilder) processDMLTable(tableExprs sqlparser.TableExprs, res
 	for _, sub := range rb.substitutions {
 		*sub.oldExpr = *sub.newExpr
 	}
+	for _, tval := range pb.st.tables {
+		// Writes to the copy of a global reference table go to the global reference
+		// table, VReplication copies them to the other keyspaces.
+		source := tval.vschemaTable.Source
+		if source == nil {
+			continue
+		}
+		if len(pb.st.tables) != 1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries or joins in DML on the copy of global reference table %s", sqlparser.String(source.Name))
+		}
+		tval.vschemaTable = source
+		rb.eroute = engine.NewSimpleRoute(engine.Unsharded, source.Keyspace)
+		rb.eroute.TableName = sqlparser.String(source.Name)
+	}
 	return rb, nil
 }
 
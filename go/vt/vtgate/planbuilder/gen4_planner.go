@@ -17,15 +17,20 @@ limitations under the License.
 package planbuilder
 
 import (
+	"sort"
+	"strings"
+
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/physical"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
 var _ selectPlanner = gen4Planner("apa", 0)
@@ -36,16 +41,22 @@ func gen4Planner(query string, plannerVersion querypb.ExecuteOptions_PlannerVers
 		if !ok {
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "%T not yet supported", stmt)
 		}
+		var with *sqlparser.With
 		switch node := selStatement.(type) {
 		case *sqlparser.Select:
-			if node.With != nil {
-				return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in select statement")
-			}
+			with = node.With
 		case *sqlparser.Union:
-			if node.With != nil {
-				return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in union statement")
-			}
+			with = node.With
+		}
+		if with != nil && with.Recursive {
+			return gen4PlanRecursiveCTE(selStatement, with, vschema)
 		}
+		// non-recursive CTEs are turned into derived tables, on a copy of the statement
+		expanded, err := semantics.ExpandCTEs(selStatement)
+		if err != nil {
+			return nil, err
+		}
+		selStatement = expanded.(sqlparser.SelectStatement)
 
 		sel, isSel := selStatement.(*sqlparser.Select)
 		if isSel {
@@ -73,7 +84,7 @@ func gen4Planner(query string, plannerVersion querypb.ExecuteOptions_PlannerVers
 
 		if shouldRetryWithCNFRewriting(plan) {
 			// by transforming the predicates to CNF, the planner will sometimes find better plans
-			primitive := gen4CNFRewrite(stmt, getPlan)
+			primitive := gen4CNFRewrite(selStatement, getPlan)
 			if primitive != nil {
 				return primitive, nil
 			}
@@ -82,6 +93,184 @@ func gen4Planner(query string, plannerVersion querypb.ExecuteOptions_PlannerVers
 	}
 }
 
+// gen4PlanRecursiveCTE plans a query that uses a recursive common table expression.
+// A recursive CTE can't be expressed as a derived table, so the whole query has to be sent to a single route.
+// This is possible when all the tables of the query live in the same unsharded keyspace, or in the same
+// sharded keyspace when all its sharded tables are filtered on the same value of their primary vindex,
+// or are reference tables of the same keyspace.
+func gen4PlanRecursiveCTE(stmt sqlparser.SelectStatement, with *sqlparser.With, vschema plancontext.VSchema) (engine.Primitive, error) {
+	// the keyspace qualifiers are removed from the query sent to the keyspace
+	stmt = sqlparser.CloneSelectStatement(stmt)
+	cteNames := map[string]bool{}
+	for _, cte := range with.CTEs {
+		cteNames[cte.TableID.String()] = true
+	}
+
+	var keyspace *vindexes.Keyspace
+	var vindex *vindexes.ColumnVindex
+	var vindexValue sqlparser.Expr
+	tableNames := map[string]interface{}{}
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		sel, ok := node.(*sqlparser.Select)
+		if !ok {
+			return true, nil
+		}
+		tables, predicates := recursiveCTETablesAndPredicates(sel)
+		for _, aliasedTable := range tables {
+			tableName, ok := aliasedTable.Expr.(sqlparser.TableName)
+			if !ok || (tableName.Qualifier.IsEmpty() && cteNames[tableName.Name.String()]) {
+				continue
+			}
+			vschemaTable, _, _, _, err := vschema.FindTable(tableName)
+			if err != nil {
+				return false, err
+			}
+			if keyspace == nil {
+				keyspace = vschemaTable.Keyspace
+			}
+			if keyspace.Name != vschemaTable.Keyspace.Name {
+				return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression on tables of keyspaces %s and %s", keyspace.Name, vschemaTable.Keyspace.Name)
+			}
+			tableNames[sqlparser.String(vschemaTable.Name)] = nil
+			if !vschemaTable.Keyspace.Sharded || vschemaTable.Type == vindexes.TypeReference {
+				continue
+			}
+			cv, value := recursiveCTEVindexValue(aliasedTable, tableName, vschemaTable, predicates)
+			if cv == nil {
+				return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression on sharded table %s that is not filtered on a single value of its primary vindex", vschemaTable.Name.String())
+			}
+			if vindex == nil {
+				vindex, vindexValue = cv, value
+				continue
+			}
+			if vindex.Name != cv.Name || !sqlparser.EqualsExpr(vindexValue, value) {
+				return false, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression on tables of different shards")
+			}
+		}
+		return true, nil
+	}, stmt)
+	if err != nil {
+		return nil, err
+	}
+	if keyspace == nil {
+		// the query does not use any table, any keyspace can evaluate it
+		keyspace, err = vschema.AnyKeyspace()
+		if err != nil {
+			return nil, err
+		}
+	}
+	routing := &engine.RoutingParameters{
+		Opcode:   engine.Unsharded,
+		Keyspace: keyspace,
+	}
+	switch {
+	case vindex != nil:
+		value, err := evalengine.Translate(vindexValue, semantics.EmptySemTable())
+		if err != nil {
+			return nil, err
+		}
+		routing.Opcode = engine.EqualUnique
+		routing.Vindex = vindex.Vindex
+		routing.Values = []evalengine.Expr{value}
+	case keyspace.Sharded:
+		routing.Opcode = engine.Reference
+	}
+
+	sqlparser.Rewrite(stmt, func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case sqlparser.SelectExpr:
+			removeKeyspaceFromSelectExpr(node)
+		case sqlparser.TableName:
+			cursor.Replace(sqlparser.TableName{
+				Name: node.Name,
+			})
+		}
+		return true
+	}, nil)
+
+	var names []string
+	for name := range tableNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	plan := &routeGen4{
+		eroute: &engine.Route{
+			RoutingParameters: routing,
+			TableName:         strings.Join(names, ", "),
+		},
+		Select: stmt,
+	}
+	if err := plan.WireupGen4(semantics.EmptySemTable()); err != nil {
+		return nil, err
+	}
+	return plan.Primitive(), nil
+}
+
+// recursiveCTETablesAndPredicates returns the tables of the FROM clause of the select, and the
+// predicates that filter their rows: the WHERE clause and the conditions of the inner joins.
+func recursiveCTETablesAndPredicates(sel *sqlparser.Select) ([]*sqlparser.AliasedTableExpr, []sqlparser.Expr) {
+	var predicates []sqlparser.Expr
+	if sel.Where != nil {
+		predicates = sqlparser.SplitAndExpression(nil, sel.Where.Expr)
+	}
+	var tables []*sqlparser.AliasedTableExpr
+	var visit func(expr sqlparser.TableExpr)
+	visit = func(expr sqlparser.TableExpr) {
+		switch expr := expr.(type) {
+		case *sqlparser.AliasedTableExpr:
+			tables = append(tables, expr)
+		case *sqlparser.ParenTableExpr:
+			for _, inner := range expr.Exprs {
+				visit(inner)
+			}
+		case *sqlparser.JoinTableExpr:
+			visit(expr.LeftExpr)
+			visit(expr.RightExpr)
+			// the condition of an outer join does not filter the rows of its outer table
+			if expr.Condition != nil && expr.Condition.On != nil && (expr.Join == sqlparser.NormalJoinType || expr.Join == sqlparser.StraightJoinType) {
+				predicates = sqlparser.SplitAndExpression(predicates, expr.Condition.On)
+			}
+		}
+	}
+	for _, expr := range sel.From {
+		visit(expr)
+	}
+	return tables, predicates
+}
+
+// recursiveCTEVindexValue returns the primary vindex of the table and the value its column is
+// compared to by one of the predicates, or nil if the predicates don't route the table to a single shard.
+func recursiveCTEVindexValue(aliasedTable *sqlparser.AliasedTableExpr, tableName sqlparser.TableName, table *vindexes.Table, predicates []sqlparser.Expr) (*vindexes.ColumnVindex, sqlparser.Expr) {
+	if len(table.ColumnVindexes) == 0 || len(table.ColumnVindexes[0].Columns) != 1 {
+		return nil, nil
+	}
+	cv := table.ColumnVindexes[0]
+	qualifier := tableName.Name
+	if !aliasedTable.As.IsEmpty() {
+		qualifier = aliasedTable.As
+	}
+	for _, predicate := range predicates {
+		cmp, ok := predicate.(*sqlparser.ComparisonExpr)
+		if !ok || cmp.Operator != sqlparser.EqualOp {
+			continue
+		}
+		for _, sides := range [][2]sqlparser.Expr{{cmp.Left, cmp.Right}, {cmp.Right, cmp.Left}} {
+			col, ok := sides[0].(*sqlparser.ColName)
+			if !ok || !col.Name.Equal(cv.Columns[0]) {
+				continue
+			}
+			if !col.Qualifier.IsEmpty() && col.Qualifier.Name.String() != qualifier.String() {
+				continue
+			}
+			switch sides[1].(type) {
+			case *sqlparser.Literal, sqlparser.Argument:
+				return cv, sides[1]
+			}
+		}
+	}
+	return nil, nil
+}
+
 func gen4planSQLCalcFoundRows(vschema plancontext.VSchema, sel *sqlparser.Select, query string, reservedVars *sqlparser.ReservedVars) (engine.Primitive, error) {
 	ksName := ""
 	if ks, _ := vschema.DefaultKeyspace(); ks != nil {
@@ -190,6 +379,10 @@ func newBuildSelectPlan(
 		}
 	}
 
+	if err := planJoinBatches(plan, selStmt); err != nil {
+		return nil, err
+	}
+
 	if err := plan.WireupGen4(semTable); err != nil {
 		return nil, err
 	}
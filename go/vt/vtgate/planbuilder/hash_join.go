@@ -43,6 +43,9 @@ type hashJoin struct {
 	// the join columns can be found
 	LHSKey, RHSKey int
 
+	// The join condition. Used for plan descriptions
+	Predicate sqlparser.Expr
+
 	ComparisonType querypb.Type
 
 	Collation collations.ID
@@ -66,6 +69,7 @@ func (hj *hashJoin) Primitive() engine.Primitive {
 		Opcode:         hj.Opcode,
 		LHSKey:         hj.LHSKey,
 		RHSKey:         hj.RHSKey,
+		ASTPred:        hj.Predicate,
 		ComparisonType: hj.ComparisonType,
 		Collation:      hj.Collation,
 	}
@@ -37,6 +37,9 @@ type horizonPlanning struct {
 	sel            *sqlparser.Select
 	qp             *abstract.QueryProjection
 	vtgateGrouping bool
+	// aggrProjection is set when some columns of the select list have to be
+	// computed on top of the aggregation, from partial aggregates
+	aggrProjection *aggregationProjection
 }
 
 func (hp *horizonPlanning) planHorizon(ctx *plancontext.PlanningContext, plan logicalPlan) (logicalPlan, error) {
@@ -61,6 +64,14 @@ func (hp *horizonPlanning) planHorizon(ctx *plancontext.PlanningContext, plan lo
 
 	hp.qp = qp
 
+	needsWindowPlanning, err := hp.needsWindowPlanning(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+	if needsWindowPlanning {
+		return hp.planWindowFunctions(ctx, rb)
+	}
+
 	needAggrOrHaving := hp.qp.NeedsAggregation() || hp.sel.Having != nil
 	canShortcut := isRoute && !needAggrOrHaving && len(hp.qp.OrderExprs) == 0
 
@@ -114,6 +125,13 @@ func (hp *horizonPlanning) planHorizon(ctx *plancontext.PlanningContext, plan lo
 		return nil, err
 	}
 
+	if hp.aggrProjection != nil {
+		plan, err = hp.aggrProjection.plan(ctx, hp.qp.SelectExprs, plan)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	plan, err = hp.truncateColumnsIfNeeded(ctx, plan)
 	if err != nil {
 		return nil, err
@@ -142,7 +160,7 @@ func (hp *horizonPlanning) truncateColumnsIfNeeded(ctx *plancontext.PlanningCont
 	switch p := plan.(type) {
 	case *routeGen4:
 		p.eroute.SetTruncateColumnCount(hp.sel.GetColumnCount())
-	case *joinGen4, *semiJoin, *hashJoin:
+	case *joinGen4, *semiJoin, *hashJoin, *correlatedSubquery:
 		// since this is a join, we can safely add extra columns and not need to truncate them
 	case *orderedAggregate:
 		p.truncateColumnCount = hp.sel.GetColumnCount()
@@ -382,6 +400,27 @@ func pushProjection(ctx *plancontext.PlanningContext, expr *sqlparser.AliasedExp
 		}
 		node.cols = append(node.cols, column)
 		return len(node.cols) - 1, true, nil
+	case *correlatedSubquery:
+		if hasAggregation {
+			return 0, false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard query with aggregates")
+		}
+		passDownReuseCol := reuseCol
+		if !reuseCol {
+			passDownReuseCol = expr.As.IsEmpty()
+		}
+		offset, added, err := pushProjection(ctx, expr, node.outer, inner, passDownReuseCol, hasAggregation)
+		if err != nil {
+			return 0, false, err
+		}
+		if reuseCol && !added {
+			for idx, col := range node.eSubquery.Cols {
+				if offset == col {
+					return idx, false, nil
+				}
+			}
+		}
+		node.eSubquery.Cols = append(node.eSubquery.Cols, offset)
+		return len(node.eSubquery.Cols) - 1, true, nil
 	case *concatenateGen4:
 		if hasAggregation {
 			return 0, false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: aggregation on unions")
@@ -487,7 +526,31 @@ func (hp *horizonPlanning) planAggregations(ctx *plancontext.PlanningContext, pl
 		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard query with aggregates")
 	}
 
-	for _, e := range hp.qp.SelectExprs {
+	var union *unionAggregation
+	var aggrProjection *aggregationProjection
+	if oa != nil && !joinPlan {
+		var err error
+		union, err = newUnionAggregation(ctx, plan, hp.qp)
+		if err != nil {
+			return nil, err
+		}
+		if union != nil {
+			oa.input = union.concat
+		}
+		aggrProjection = &aggregationProjection{
+			oa:      oa,
+			offsets: make([]int, len(hp.qp.SelectExprs)),
+		}
+	}
+	pushAggregate := func(expr *sqlparser.AliasedExpr) (int, error) {
+		if union != nil {
+			return union.pushAggregate(expr)
+		}
+		offset, _, err := pushProjection(ctx, expr, plan, true, false, true)
+		return offset, err
+	}
+
+	for i, e := range hp.qp.SelectExprs {
 		aliasExpr, err := e.GetAliasedExpr()
 		if err != nil {
 			return nil, err
@@ -495,14 +558,25 @@ func (hp *horizonPlanning) planAggregations(ctx *plancontext.PlanningContext, pl
 
 		// push all expression if they are non-aggregating or the plan is not ordered aggregated plan.
 		if !e.Aggr || oa == nil {
-			_, _, err := pushProjection(ctx, aliasExpr, plan, true, false, false)
+			offset, _, err := pushProjection(ctx, aliasExpr, plan, true, false, false)
 			if err != nil {
 				return nil, err
 			}
+			if aggrProjection != nil {
+				aggrProjection.offsets[i] = offset
+			}
 			continue
 		}
 
 		fExpr, isFunc := aliasExpr.Expr.(*sqlparser.FuncExpr)
+		if aggrProjection != nil && (!isFunc || fExpr.Name.Lowered() == "avg") {
+			// this expression is computed on top of the aggregation, using the partial aggregates it needs
+			err := aggrProjection.planComplexAggregate(i, aliasExpr, pushAggregate)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
 		if !isFunc {
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex aggregate expression")
 		}
@@ -515,15 +589,28 @@ func (hp *horizonPlanning) planAggregations(ctx *plancontext.PlanningContext, pl
 		if err != nil {
 			return nil, err
 		}
+		if handleDistinct && union != nil {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: aggregation on unions")
+		}
 
 		pushExpr, param := hp.createPushExprAndAlias(ctx, e, handleDistinct, innerAliased, opcode, oa)
-		offset, _, err := pushProjection(ctx, pushExpr, plan, true, false, true)
+		offset, err := pushAggregate(pushExpr)
 		if err != nil {
 			return nil, err
 		}
 		param.Col = offset
 		param.Expr = fExpr
 		oa.aggregates = append(oa.aggregates, param)
+		if aggrProjection != nil {
+			aggrProjection.offsets[i] = offset
+		}
+	}
+
+	if aggrProjection != nil && aggrProjection.needsProjection() {
+		if hp.qp.NeedsDistinct() {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: distinct on complex aggregate expression")
+		}
+		hp.aggrProjection = aggrProjection
 	}
 
 	for _, groupExpr := range hp.qp.GroupByExprs {
@@ -560,7 +647,7 @@ func (hp *horizonPlanning) planAggregations(ctx *plancontext.PlanningContext, pl
 	}
 
 	// done with aggregation planning. let's check if we should fail the query
-	if _, planIsRoute := plan.(*routeGen4); !planIsRoute {
+	if _, planIsRoute := plan.(*routeGen4); !planIsRoute && hp.aggrProjection == nil {
 		// if we had to build up additional operators around the route, we have to fail this query
 		for _, expr := range hp.qp.SelectExprs {
 			colExpr, err := expr.GetExpr()
@@ -671,7 +758,7 @@ func planGroupByGen4(ctx *plancontext.PlanningContext, groupExpr abstract.GroupB
 		return nil
 	case *pulloutSubquery:
 		return planGroupByGen4(ctx, groupExpr, node.underlying, wsAdded)
-	case *semiJoin:
+	case *semiJoin, *correlatedSubquery:
 		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: group by in a query having a correlated subquery")
 	default:
 		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: group by on: %T", plan)
@@ -756,7 +843,7 @@ func (hp *horizonPlanning) planOrderBy(ctx *plancontext.PlanningContext, orderEx
 	case *vindexFunc:
 		// This is evaluated at VTGate only, so weight_string function cannot be used.
 		return hp.createMemorySortPlan(ctx, plan, orderExprs /* useWeightStr */, false)
-	case *limit, *semiJoin, *filter, *pulloutSubquery:
+	case *limit, *semiJoin, *correlatedSubquery, *filter, *pulloutSubquery:
 		inputs := plan.Inputs()
 		if len(inputs) == 0 {
 			break
@@ -35,6 +35,9 @@ type joinGen4 struct {
 	Cols        []int
 	Vars        map[string]int
 
+	// BatchSize and BatchColumn are set when the join sends its LHS rows to the RHS in batches
+	BatchSize, BatchColumn int
+
 	gen4Plan
 }
 
@@ -55,6 +58,9 @@ func (j *joinGen4) Primitive() engine.Primitive {
 		Cols:   j.Cols,
 		Vars:   j.Vars,
 		Opcode: j.Opcode,
+
+		BatchSize:   j.BatchSize,
+		BatchColumn: j.BatchColumn,
 	}
 }
 
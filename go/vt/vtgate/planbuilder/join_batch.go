@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// planJoinBatches turns the nested loop joins of the plan into batched joins
+// when the query asks for it using the JOIN_BATCH_SIZE comment directive.
+// It has to run before the routes produce their queries.
+func planJoinBatches(plan logicalPlan, stmt sqlparser.SelectStatement) error {
+	directives := sqlparser.ExtractCommentDirectives(sqlparser.GetFirstSelect(stmt).Comments)
+	size, ok := directives[sqlparser.DirectiveJoinBatchSize].(int)
+	if !ok || size <= 0 {
+		return nil
+	}
+	_, err := visit(plan, func(plan logicalPlan) (bool, logicalPlan, error) {
+		if join, ok := plan.(*joinGen4); ok {
+			join.batch(size)
+		}
+		return true, plan, nil
+	})
+	return err
+}
+
+// batch makes the join send its LHS rows to the RHS in batches of the given size.
+// This is only done when the RHS is a route that uses the single join variable of the
+// join in an equality with one of its columns, and nowhere else. The equality is
+// rewritten into an IN over the values of the batch, and the column is added to the
+// output of the route so that the RHS rows can be paired with their LHS rows.
+func (j *joinGen4) batch(size int) {
+	if len(j.Vars) != 1 {
+		return
+	}
+	var name string
+	for k := range j.Vars {
+		name = k
+	}
+	rb, ok := j.Right.(*routeGen4)
+	if !ok || rb.eroute.Opcode == engine.DBA || rb.eroute.TruncateColumnCount > 0 {
+		return
+	}
+	sel, ok := rb.Select.(*sqlparser.Select)
+	if !ok || sel.Distinct || sel.GroupBy != nil || sel.Having != nil || sel.Limit != nil || sqlparser.ContainsAggregation(sel.SelectExprs) {
+		// these would be evaluated over the whole batch instead of for each LHS row
+		return
+	}
+	if sel.Where == nil || countArguments(sel, name) != 1 {
+		return
+	}
+
+	var cmp *sqlparser.ComparisonExpr
+	var col *sqlparser.ColName
+	for _, predicate := range sqlparser.SplitAndExpression(nil, sel.Where.Expr) {
+		cmp, col = batchComparison(predicate, name)
+		if cmp != nil {
+			break
+		}
+	}
+	if cmp == nil {
+		return
+	}
+
+	rp := rb.eroute.RoutingParameters
+	routedByVar := false
+	for _, value := range rp.Values {
+		if bv, ok := value.(*evalengine.BindVariable); ok && bv.Key == name {
+			routedByVar = true
+		}
+	}
+	_, singleColumn := rp.Vindex.(vindexes.SingleColumn)
+	switch {
+	case !routedByVar:
+		cmp.Right = sqlparser.ListArg(name)
+	case (rp.Opcode == engine.Equal || rp.Opcode == engine.EqualUnique) && singleColumn:
+		// the batch is routed to the shards of its values, and every shard only gets its own values
+		rp.Opcode = engine.IN
+		cmp.Right = sqlparser.ListArg(engine.ListVarName)
+	default:
+		return
+	}
+	cmp.Operator = sqlparser.InOp
+	cmp.Left = col
+
+	j.BatchSize = size
+	j.BatchColumn = len(sel.SelectExprs)
+	sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: sqlparser.CloneRefOfColName(col)})
+}
+
+// batchComparison returns the predicate if it compares the join variable with a column.
+func batchComparison(predicate sqlparser.Expr, name string) (*sqlparser.ComparisonExpr, *sqlparser.ColName) {
+	cmp, ok := predicate.(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualOp {
+		return nil, nil
+	}
+	if arg, ok := cmp.Left.(sqlparser.Argument); ok && string(arg) == name {
+		col, ok := cmp.Right.(*sqlparser.ColName)
+		if ok {
+			return cmp, col
+		}
+	}
+	if arg, ok := cmp.Right.(sqlparser.Argument); ok && string(arg) == name {
+		col, ok := cmp.Left.(*sqlparser.ColName)
+		if ok {
+			return cmp, col
+		}
+	}
+	return nil, nil
+}
+
+func countArguments(node sqlparser.SQLNode, name string) int {
+	count := 0
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if arg, ok := node.(sqlparser.Argument); ok && string(arg) == name {
+			count++
+		}
+		return true, nil
+	}, node)
+	return count
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/vt/key"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+)
+
+func buildMaterializedViewPlan(query string, stmt *sqlparser.MaterializedViewDDL, vschema plancontext.VSchema) (engine.Primitive, error) {
+	_, ks, tabletType, err := vschema.TargetDestination(stmt.ViewName.Qualifier.String())
+	if err != nil {
+		return nil, err
+	}
+	if ks == nil {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.NoDB, "No database selected: use keyspace<:shard><@type> or keyspace<[range]><@type> (<> are optional)")
+	}
+	if tabletType != topodatapb.TabletType_PRIMARY {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s MATERIALIZED VIEW works only on primary tablet", stmt.Action.ToString())
+	}
+
+	plan := &engine.MaterializedView{
+		Keyspace: ks,
+		Stmt:     stmt,
+		Query:    query,
+	}
+	if stmt.Action != sqlparser.CreateDDLAction {
+		return plan, nil
+	}
+
+	sel, ok := stmt.Select.(*sqlparser.Select)
+	if !ok || len(sel.From) != 1 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: materialized view must select from a single table")
+	}
+	tableExpr, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: materialized view must select from a single table")
+	}
+	tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: materialized view must select from a single table")
+	}
+	table, _, _, _, err := vschema.FindTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+	if table == nil {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.UnknownTable, "table %s not found", sqlparser.String(tableName))
+	}
+	plan.SourceKeyspace = table.Keyspace.Name
+	return plan, nil
+}
+
+func buildShowMaterializedViewsPlan(show *sqlparser.ShowBasic, vschema plancontext.VSchema) (engine.Primitive, error) {
+	dest, ks, tabletType, err := vschema.TargetDestination(show.DbName.String())
+	if err != nil {
+		return nil, err
+	}
+	if ks == nil {
+		return nil, vterrors.NewErrorf(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.NoDB, "No database selected: use keyspace<:shard><@type> or keyspace<[range]><@type> (<> are optional)")
+	}
+
+	if tabletType != topodatapb.TabletType_PRIMARY {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "show materialized views works only on primary tablet")
+	}
+
+	if dest == nil {
+		dest = key.DestinationAllShards{}
+	}
+
+	sql := fmt.Sprintf("select workflow as name, state, message, rows_copied, time_updated, source from _vt.vreplication where tags = %s",
+		sqlparser.String(sqlparser.NewStrLiteral(schema.MaterializedViewTag)))
+
+	if show.Filter != nil {
+		if show.Filter.Filter != nil {
+			sql += fmt.Sprintf(" and (%s)", sqlparser.String(show.Filter.Filter))
+		} else if show.Filter.Like != "" {
+			sql += fmt.Sprintf(" and workflow like %s", sqlparser.String(sqlparser.NewStrLiteral(show.Filter.Like)))
+		}
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	return &engine.ShowMaterializedViews{
+		Keyspace: ks,
+		Select:   stmt.(*sqlparser.Select),
+		Input: &engine.Send{
+			Keyspace:          ks,
+			TargetDestination: dest,
+			Query:             sql,
+		},
+	}, nil
+}
@@ -45,6 +45,8 @@ func transformToLogicalPlan(ctx *plancontext.PlanningContext, op abstract.Physic
 		return transformRoutePlan(ctx, op)
 	case *physical.ApplyJoin:
 		return transformApplyJoinPlan(ctx, op)
+	case *physical.HashJoin:
+		return transformHashJoinPlan(ctx, op)
 	case *physical.Union:
 		return transformUnionPlan(ctx, op)
 	case *physical.Vindex:
@@ -84,13 +86,6 @@ func transformToLogicalPlan(ctx *plancontext.PlanningContext, op abstract.Physic
 }
 
 func transformApplyJoinPlan(ctx *plancontext.PlanningContext, n *physical.ApplyJoin) (logicalPlan, error) {
-	// TODO systay we should move the decision of which join to use to the greedy algorithm,
-	// and thus represented as a queryTree
-	// canHashJoin, lhsInfo, rhsInfo, err := canHashJoin(ctx, n)
-	// if err != nil {
-	//	return nil, err
-	// }
-
 	lhs, err := transformToLogicalPlan(ctx, n.LHS)
 	if err != nil {
 		return nil, err
@@ -104,23 +99,6 @@ func transformApplyJoinPlan(ctx *plancontext.PlanningContext, n *physical.ApplyJ
 		opCode = engine.LeftJoin
 	}
 
-	// if canHashJoin {
-	//	coercedType, err := evalengine.CoerceTo(lhsInfo.typ.Type, rhsInfo.typ.Type)
-	//	if err != nil {
-	//		return nil, err
-	//	}
-	//	return &hashJoin{
-	//		Left:           lhs,
-	//		Right:          rhs,
-	//		Cols:           n.columns,
-	//		Opcode:         OpCode,
-	//		LHSKey:         lhsInfo.offset,
-	//		RHSKey:         rhsInfo.offset,
-	//		Predicate:      sqlparser.AndExpressions(n.predicates...),
-	//		ComparisonType: coercedType,
-	//		Collation:      lhsInfo.typ.Collation,
-	//	}, nil
-	// }
 	return &joinGen4{
 		Left:   lhs,
 		Right:  rhs,
@@ -130,6 +108,28 @@ func transformApplyJoinPlan(ctx *plancontext.PlanningContext, n *physical.ApplyJ
 	}, nil
 }
 
+func transformHashJoinPlan(ctx *plancontext.PlanningContext, n *physical.HashJoin) (logicalPlan, error) {
+	lhs, err := transformToLogicalPlan(ctx, n.LHS)
+	if err != nil {
+		return nil, err
+	}
+	rhs, err := transformToLogicalPlan(ctx, n.RHS)
+	if err != nil {
+		return nil, err
+	}
+	return &hashJoin{
+		Left:           lhs,
+		Right:          rhs,
+		Cols:           n.Columns,
+		Opcode:         engine.InnerJoin,
+		LHSKey:         n.LHSKey,
+		RHSKey:         n.RHSKey,
+		Predicate:      n.Predicate,
+		ComparisonType: n.ComparisonType,
+		Collation:      n.Collation,
+	}, nil
+}
+
 func transformRoutePlan(ctx *plancontext.PlanningContext, op *physical.Route) (*routeGen4, error) {
 	tableNames, err := getAllTableNames(op)
 	if err != nil {
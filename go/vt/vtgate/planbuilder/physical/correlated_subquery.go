@@ -28,6 +28,10 @@ type (
 		Extracted    *sqlparser.ExtractedSubquery
 		// arguments that need to be copied from the outer to inner
 		Vars map[string]int
+		// Predicate is the predicate of the outer query that uses the subquery, it has to be
+		// evaluated by vtgate for every outer row. It is nil when the subquery is an EXISTS
+		// that only filters the outer rows, and can be planned as a semi-join.
+		Predicate sqlparser.Expr
 	}
 
 	SubQueryOp struct {
@@ -106,10 +110,16 @@ func (c *CorrelatedSubQueryOp) Cost() int {
 }
 
 func (c *CorrelatedSubQueryOp) Clone() abstract.PhysicalOperator {
+	varsClone := map[string]int{}
+	for key, value := range c.Vars {
+		varsClone[key] = value
+	}
 	result := &CorrelatedSubQueryOp{
 		Outer:     c.Outer.Clone(),
 		Inner:     c.Inner.Clone(),
 		Extracted: c.Extracted,
+		Vars:      varsClone,
+		Predicate: c.Predicate,
 	}
 	return result
 }
@@ -0,0 +1,220 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package physical
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+)
+
+// The cost model estimates how many rows an operator produces and how much work is needed to produce them.
+// The estimates are based on the table statistics gathered by the schema tracker,
+// so they can only be made when all the tables used by a plan have statistics.
+const (
+	// queryCost is the cost of sending a query to a shard, counted in rows
+	queryCost = 100
+
+	// scatterFanout is the number of shards a scatter route is expected to reach
+	scatterFanout = 8
+
+	// multiShardFanout is the number of shards an IN, MultiEqual or Range route is expected to reach
+	multiShardFanout = 2
+
+	// unknownEqualSelectivity is used for an equality on a column without cardinality
+	unknownEqualSelectivity = 0.1
+
+	rangeSelectivity   = 1.0 / 3
+	defaultSelectivity = 0.5
+)
+
+type estimate struct {
+	// rows is the number of rows produced by the operator
+	rows float64
+
+	// cost is the work needed to produce the rows: the number of rows handled
+	// by the vtgate plus a fixed cost for every query sent to a shard
+	cost float64
+}
+
+// cheaper returns true if the plan a is cheaper than the plan b.
+// When the tables have statistics, the estimated costs of the plans are compared,
+// otherwise the plan with the cheapest routes wins.
+func cheaper(ctx *plancontext.PlanningContext, a, b abstract.PhysicalOperator) bool {
+	aEst, aOK := estimateOp(ctx, a)
+	bEst, bOK := estimateOp(ctx, b)
+	if aOK && bOK && aEst.cost != bEst.cost {
+		return aEst.cost < bEst.cost
+	}
+	return a.Cost() < b.Cost()
+}
+
+// estimateOp estimates the rows and cost of an operator evaluated at the vtgate level.
+// It returns false when the statistics of one of the tables are unknown.
+func estimateOp(ctx *plancontext.PlanningContext, op abstract.PhysicalOperator) (estimate, bool) {
+	switch op := op.(type) {
+	case *Route:
+		rows, ok := estimateRows(ctx, op.Source)
+		if !ok {
+			return estimate{}, false
+		}
+		fanout := routeFanout(op)
+		rows *= fanout
+		return estimate{rows: rows, cost: fanout*queryCost + rows}, true
+	case *ApplyJoin:
+		lhs, lok := estimateOp(ctx, op.LHS)
+		rhs, rok := estimateOp(ctx, op.RHS)
+		if !lok || !rok {
+			return estimate{}, false
+		}
+		// the join predicates are pushed to the RHS, so it returns the matching rows of a single LHS row
+		rows := lhs.rows * rhs.rows
+		if op.LeftJoin && rows < lhs.rows {
+			rows = lhs.rows
+		}
+		return estimate{rows: rows, cost: lhs.cost + atLeastOne(lhs.rows)*rhs.cost}, true
+	case *HashJoin:
+		lhs, lok := estimateOp(ctx, op.LHS)
+		rhs, rok := estimateOp(ctx, op.RHS)
+		if !lok || !rok {
+			return estimate{}, false
+		}
+		// the rows of the LHS are kept in memory, so building the hash table costs more than probing it
+		rows := lhs.rows * rhs.rows * selectivity(ctx, op.Predicate)
+		return estimate{rows: rows, cost: lhs.cost + rhs.cost + 2*lhs.rows + rhs.rows}, true
+	case *Filter:
+		src, ok := estimateOp(ctx, op.Source)
+		if !ok {
+			return estimate{}, false
+		}
+		return estimate{rows: src.rows * selectivity(ctx, sqlparser.AndExpressions(op.Predicates...)), cost: src.cost + src.rows}, true
+	case *Derived:
+		return estimateOp(ctx, op.Source)
+	}
+	return estimate{}, false
+}
+
+// estimateRows estimates the rows returned by a shard for the operators inside a route
+func estimateRows(ctx *plancontext.PlanningContext, op abstract.PhysicalOperator) (float64, bool) {
+	switch op := op.(type) {
+	case *Table:
+		stats := op.VTable.Statistics
+		if stats == nil {
+			return 0, false
+		}
+		rows := float64(stats.RowCount) * selectivity(ctx, sqlparser.AndExpressions(op.QTable.Predicates...))
+		return atLeastOne(rows), true
+	case *Filter:
+		rows, ok := estimateRows(ctx, op.Source)
+		return rows * selectivity(ctx, sqlparser.AndExpressions(op.Predicates...)), ok
+	case *ApplyJoin:
+		lhs, lok := estimateRows(ctx, op.LHS)
+		rhs, rok := estimateRows(ctx, op.RHS)
+		if !lok || !rok {
+			return 0, false
+		}
+		rows := lhs * rhs * selectivity(ctx, op.Predicate)
+		if op.LeftJoin && rows < lhs {
+			rows = lhs
+		}
+		return rows, true
+	case *Derived:
+		return estimateRows(ctx, op.Source)
+	}
+	return 0, false
+}
+
+func routeFanout(r *Route) float64 {
+	switch r.RouteOpCode {
+	case engine.Scatter:
+		return scatterFanout
+	case engine.IN, engine.MultiEqual, engine.Range:
+		return multiShardFanout
+	}
+	return 1
+}
+
+// selectivity estimates the fraction of rows that a predicate keeps
+func selectivity(ctx *plancontext.PlanningContext, expr sqlparser.Expr) float64 {
+	switch expr := expr.(type) {
+	case nil:
+		return 1
+	case *sqlparser.AndExpr:
+		return selectivity(ctx, expr.Left) * selectivity(ctx, expr.Right)
+	case *sqlparser.OrExpr:
+		l, r := selectivity(ctx, expr.Left), selectivity(ctx, expr.Right)
+		return l + r - l*r
+	case *sqlparser.BetweenExpr:
+		return rangeSelectivity
+	case *sqlparser.ComparisonExpr:
+		switch expr.Operator {
+		case sqlparser.EqualOp, sqlparser.NullSafeEqualOp:
+			return equalSelectivity(ctx, expr.Left, expr.Right)
+		case sqlparser.NotEqualOp:
+			return 1 - equalSelectivity(ctx, expr.Left, expr.Right)
+		case sqlparser.InOp:
+			if tuple, ok := expr.Right.(sqlparser.ValTuple); ok {
+				sel := float64(len(tuple)) * equalSelectivity(ctx, expr.Left, nil)
+				if sel > 1 {
+					return 1
+				}
+				return sel
+			}
+		case sqlparser.LessThanOp, sqlparser.LessEqualOp, sqlparser.GreaterThanOp, sqlparser.GreaterEqualOp:
+			return rangeSelectivity
+		}
+	}
+	return defaultSelectivity
+}
+
+// equalSelectivity uses the column with the most distinct values to estimate the selectivity of an equality
+func equalSelectivity(ctx *plancontext.PlanningContext, left, right sqlparser.Expr) float64 {
+	var cardinality uint64
+	for _, expr := range []sqlparser.Expr{left, right} {
+		if c := columnCardinality(ctx, expr); c > cardinality {
+			cardinality = c
+		}
+	}
+	if cardinality == 0 {
+		return unknownEqualSelectivity
+	}
+	return 1 / float64(cardinality)
+}
+
+func columnCardinality(ctx *plancontext.PlanningContext, expr sqlparser.Expr) uint64 {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return 0
+	}
+	tableInfo, err := ctx.SemTable.TableInfoForExpr(col)
+	if err != nil {
+		return 0
+	}
+	vtable := tableInfo.GetVindexTable()
+	if vtable == nil {
+		return 0
+	}
+	return vtable.Statistics.Cardinality(col.Name)
+}
+
+func atLeastOne(rows float64) float64 {
+	if rows < 1 {
+		return 1
+	}
+	return rows
+}
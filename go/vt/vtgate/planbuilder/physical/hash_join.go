@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package physical
+
+import (
+	"vitess.io/vitess/go/mysql/collations"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+)
+
+// HashJoin is a join that fetches the rows of both sides once.
+// The rows of the LHS are used to build a hash table on the join column,
+// and the rows of the RHS are matched by probing this table
+type HashJoin struct {
+	LHS, RHS abstract.PhysicalOperator
+
+	// Columns stores the column indexes of the columns coming from the left and right side
+	// negative value comes from LHS and positive from RHS
+	Columns []int
+
+	// LHSKey and RHSKey are the offsets of the join columns in the results of the LHS and RHS
+	LHSKey, RHSKey int
+
+	Predicate sqlparser.Expr
+
+	// ComparisonType and Collation are used to hash and compare the values of the join columns
+	ComparisonType querypb.Type
+	Collation      collations.ID
+}
+
+var _ abstract.PhysicalOperator = (*HashJoin)(nil)
+
+// IPhysical implements the PhysicalOperator interface
+func (h *HashJoin) IPhysical() {}
+
+// TableID implements the PhysicalOperator interface
+func (h *HashJoin) TableID() semantics.TableSet {
+	return h.LHS.TableID().Merge(h.RHS.TableID())
+}
+
+// UnsolvedPredicates implements the PhysicalOperator interface
+func (h *HashJoin) UnsolvedPredicates(semTable *semantics.SemTable) []sqlparser.Expr {
+	panic("implement me")
+}
+
+// CheckValid implements the PhysicalOperator interface
+func (h *HashJoin) CheckValid() error {
+	err := h.LHS.CheckValid()
+	if err != nil {
+		return err
+	}
+	return h.RHS.CheckValid()
+}
+
+// Compact implements the PhysicalOperator interface
+func (h *HashJoin) Compact(semTable *semantics.SemTable) (abstract.Operator, error) {
+	return h, nil
+}
+
+// Cost implements the PhysicalOperator interface
+func (h *HashJoin) Cost() int {
+	return h.LHS.Cost() + h.RHS.Cost()
+}
+
+// Clone implements the PhysicalOperator interface
+func (h *HashJoin) Clone() abstract.PhysicalOperator {
+	columnsClone := make([]int, len(h.Columns))
+	copy(columnsClone, h.Columns)
+	return &HashJoin{
+		LHS:            h.LHS.Clone(),
+		RHS:            h.RHS.Clone(),
+		Columns:        columnsClone,
+		LHSKey:         h.LHSKey,
+		RHSKey:         h.RHSKey,
+		Predicate:      sqlparser.CloneExpr(h.Predicate),
+		ComparisonType: h.ComparisonType,
+		Collation:      h.Collation,
+	}
+}
+
+// createHashJoin returns a hash join between lhs and rhs, or nil if the join predicates
+// can't be evaluated by a hash join. Only inner joins on a single equality between
+// a column of each side, with known types, can be turned into a hash join.
+func createHashJoin(ctx *plancontext.PlanningContext, lhs, rhs abstract.PhysicalOperator, joinPredicates []sqlparser.Expr) (*HashJoin, error) {
+	if len(joinPredicates) != 1 {
+		return nil, nil
+	}
+	cmp, ok := joinPredicates[0].(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualOp {
+		return nil, nil
+	}
+	lhsCol, lok := cmp.Left.(*sqlparser.ColName)
+	rhsCol, rok := cmp.Right.(*sqlparser.ColName)
+	if !lok || !rok {
+		return nil, nil
+	}
+	if ctx.SemTable.RecursiveDeps(lhsCol).IsSolvedBy(rhs.TableID()) {
+		lhsCol, rhsCol = rhsCol, lhsCol
+	}
+	if !ctx.SemTable.RecursiveDeps(lhsCol).IsSolvedBy(lhs.TableID()) ||
+		!ctx.SemTable.RecursiveDeps(rhsCol).IsSolvedBy(rhs.TableID()) {
+		return nil, nil
+	}
+
+	lhsType := ctx.SemTable.TypeFor(lhsCol)
+	rhsType := ctx.SemTable.TypeFor(rhsCol)
+	if lhsType == nil || rhsType == nil {
+		return nil, nil
+	}
+	comparisonType, err := evalengine.CoerceTo(*lhsType, *rhsType)
+	if err != nil {
+		// the values of the two columns can't be compared using a hash
+		return nil, nil
+	}
+
+	join := &HashJoin{
+		LHS:            lhs.Clone(),
+		RHS:            rhs.Clone(),
+		Predicate:      sqlparser.CloneExpr(joinPredicates[0]),
+		ComparisonType: comparisonType,
+		Collation:      ctx.SemTable.CollationForExpr(lhsCol),
+	}
+
+	newLHS, lhsOffsets, err := PushOutputColumns(ctx, join.LHS, copyColName(ctx, lhsCol))
+	if err != nil {
+		return nil, err
+	}
+	newRHS, rhsOffsets, err := PushOutputColumns(ctx, join.RHS, copyColName(ctx, rhsCol))
+	if err != nil {
+		return nil, err
+	}
+	join.LHS, join.LHSKey = newLHS, lhsOffsets[0]
+	join.RHS, join.RHSKey = newRHS, rhsOffsets[0]
+	return join, nil
+}
+
+// copyColName clones a column and its semantic information, so it can be pushed as an output column
+// without changing the original expression.
+func copyColName(ctx *plancontext.PlanningContext, col *sqlparser.ColName) *sqlparser.ColName {
+	newCol := sqlparser.CloneRefOfColName(col)
+	ctx.SemTable.CopyDependencies(col, newCol)
+	ctx.SemTable.CopyExprInfo(col, newCol)
+	return newCol
+}
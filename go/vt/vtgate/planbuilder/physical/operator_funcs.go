@@ -112,6 +112,30 @@ func PushPredicate(ctx *plancontext.PlanningContext, expr sqlparser.Expr, op abs
 			return op, err
 		}
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "Cannot push predicate: %s", sqlparser.String(expr))
+	case *HashJoin:
+		deps := ctx.SemTable.RecursiveDeps(expr)
+		switch {
+		case deps.IsSolvedBy(op.LHS.TableID()):
+			newSrc, err := PushPredicate(ctx, expr, op.LHS)
+			if err != nil {
+				return nil, err
+			}
+			op.LHS = newSrc
+			return op, nil
+		case deps.IsSolvedBy(op.RHS.TableID()):
+			newSrc, err := PushPredicate(ctx, expr, op.RHS)
+			if err != nil {
+				return nil, err
+			}
+			op.RHS = newSrc
+			return op, nil
+		}
+		// the rows of the two sides only meet at the vtgate,
+		// so predicates using both sides are evaluated after the join
+		return &Filter{
+			Source:     op,
+			Predicates: []sqlparser.Expr{expr},
+		}, nil
 	case *Table:
 		// We do not add the predicate to op.qtable because that is an immutable struct that should not be
 		// changed by physical operators.
@@ -154,42 +178,19 @@ func PushOutputColumns(ctx *plancontext.PlanningContext, op abstract.PhysicalOpe
 		op.Source = retOp
 		return op, offsets, err
 	case *ApplyJoin:
-		var toTheLeft []bool
-		var lhs, rhs []*sqlparser.ColName
-		for _, col := range columns {
-			col.Qualifier.Qualifier = sqlparser.NewTableIdent("")
-			if ctx.SemTable.RecursiveDeps(col).IsSolvedBy(op.LHS.TableID()) {
-				lhs = append(lhs, col)
-				toTheLeft = append(toTheLeft, true)
-			} else {
-				rhs = append(rhs, col)
-				toTheLeft = append(toTheLeft, false)
-			}
-		}
-		out, lhsOffset, err := PushOutputColumns(ctx, op.LHS, lhs...)
+		lhs, rhs, offsets, err := pushOutputColumnsToJoinSides(ctx, op.LHS, op.RHS, &op.Columns, columns)
 		if err != nil {
 			return nil, nil, err
 		}
-		op.LHS = out
-		out, rhsOffset, err := PushOutputColumns(ctx, op.RHS, rhs...)
+		op.LHS, op.RHS = lhs, rhs
+		return op, offsets, nil
+	case *HashJoin:
+		lhs, rhs, offsets, err := pushOutputColumnsToJoinSides(ctx, op.LHS, op.RHS, &op.Columns, columns)
 		if err != nil {
 			return nil, nil, err
 		}
-		op.RHS = out
-
-		outputColumns := make([]int, len(toTheLeft))
-		var l, r int
-		for i, isLeft := range toTheLeft {
-			outputColumns[i] = len(op.Columns)
-			if isLeft {
-				op.Columns = append(op.Columns, -lhsOffset[l]-1)
-				l++
-			} else {
-				op.Columns = append(op.Columns, rhsOffset[r]+1)
-				r++
-			}
-		}
-		return op, outputColumns, nil
+		op.LHS, op.RHS = lhs, rhs
+		return op, offsets, nil
 	case *Table:
 		var offsets []int
 		for _, col := range columns {
@@ -248,6 +249,50 @@ func PushOutputColumns(ctx *plancontext.PlanningContext, op abstract.PhysicalOpe
 	}
 }
 
+// pushOutputColumnsToJoinSides pushes each column to the side of the join that it comes from,
+// and adds the columns to the output columns of the join
+func pushOutputColumnsToJoinSides(
+	ctx *plancontext.PlanningContext,
+	lhsOp, rhsOp abstract.PhysicalOperator,
+	joinColumns *[]int,
+	columns []*sqlparser.ColName,
+) (abstract.PhysicalOperator, abstract.PhysicalOperator, []int, error) {
+	var toTheLeft []bool
+	var lhs, rhs []*sqlparser.ColName
+	for _, col := range columns {
+		col.Qualifier.Qualifier = sqlparser.NewTableIdent("")
+		if ctx.SemTable.RecursiveDeps(col).IsSolvedBy(lhsOp.TableID()) {
+			lhs = append(lhs, col)
+			toTheLeft = append(toTheLeft, true)
+		} else {
+			rhs = append(rhs, col)
+			toTheLeft = append(toTheLeft, false)
+		}
+	}
+	lhsOp, lhsOffset, err := PushOutputColumns(ctx, lhsOp, lhs...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	rhsOp, rhsOffset, err := PushOutputColumns(ctx, rhsOp, rhs...)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	outputColumns := make([]int, len(toTheLeft))
+	var l, r int
+	for i, isLeft := range toTheLeft {
+		outputColumns[i] = len(*joinColumns)
+		if isLeft {
+			*joinColumns = append(*joinColumns, -lhsOffset[l]-1)
+			l++
+		} else {
+			*joinColumns = append(*joinColumns, rhsOffset[r]+1)
+			r++
+		}
+	}
+	return lhsOp, rhsOp, outputColumns, nil
+}
+
 func addToIntSlice(columnOffset []int, valToAdd int) ([]int, int) {
 	for idx, val := range columnOffset {
 		if val == valToAdd {
@@ -303,6 +348,25 @@ func RemovePredicate(ctx *plancontext.PlanningContext, expr sqlparser.Expr, op a
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "remove '%s' predicate not supported on cross-shard join query", sqlparser.String(expr))
 		}
 		return op, nil
+	case *HashJoin:
+		deps := ctx.SemTable.RecursiveDeps(expr)
+		switch {
+		case deps.IsSolvedBy(op.LHS.TableID()):
+			newSrc, err := RemovePredicate(ctx, expr, op.LHS)
+			if err != nil {
+				return nil, err
+			}
+			op.LHS = newSrc
+			return op, nil
+		case deps.IsSolvedBy(op.RHS.TableID()):
+			newSrc, err := RemovePredicate(ctx, expr, op.RHS)
+			if err != nil {
+				return nil, err
+			}
+			op.RHS = newSrc
+			return op, nil
+		}
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "remove '%s' predicate not supported on cross-shard join query", sqlparser.String(expr))
 	case *Filter:
 		idx := -1
 		for i, predicate := range op.Predicates {
@@ -327,7 +391,22 @@ func RemovePredicate(ctx *plancontext.PlanningContext, expr sqlparser.Expr, op a
 		// remove the predicate from this filter
 		op.Predicates = append(op.Predicates[:idx], op.Predicates[idx+1:]...)
 		return op, nil
-
+	case *Table:
+		var keep []sqlparser.Expr
+		for _, predicate := range op.QTable.Predicates {
+			if !sqlparser.EqualsExpr(predicate, expr) {
+				keep = append(keep, predicate)
+			}
+		}
+		if len(keep) == len(op.QTable.Predicates) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "this should not happen - tried to remove predicate from table op")
+		}
+		// the query table is shared with the other operators built from the same query graph,
+		// so we use a copy of it without the predicate
+		qtable := *op.QTable
+		qtable.Predicates = keep
+		op.QTable = &qtable
+		return op, nil
 	default:
 		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "this should not happen - tried to remove predicate from table op")
 	}
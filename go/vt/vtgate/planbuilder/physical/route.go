@@ -109,6 +109,8 @@ func (r *Route) Cost() int {
 		return 10
 	case engine.MultiEqual:
 		return 10
+	case engine.Range:
+		return 15
 	case engine.Scatter:
 		return 20
 	}
@@ -174,6 +176,9 @@ func (r *Route) searchForNewVindexes(ctx *plancontext.PlanningContext, predicate
 	case *sqlparser.IsExpr:
 		found := r.planIsExpr(ctx, node)
 		newVindexFound = newVindexFound || found
+	case *sqlparser.BetweenExpr:
+		found := r.planBetweenOp(ctx, node)
+		newVindexFound = newVindexFound || found
 	}
 	return newVindexFound, nil
 }
@@ -205,7 +210,9 @@ func (r *Route) planComparison(ctx *plancontext.PlanningContext, cmp *sqlparser.
 	case sqlparser.LikeOp:
 		found := r.planLikeOp(ctx, cmp)
 		return found, false, nil
-
+	case sqlparser.GreaterThanOp, sqlparser.GreaterEqualOp, sqlparser.LessThanOp, sqlparser.LessEqualOp:
+		found := r.planRangeOp(ctx, cmp)
+		return found, false, nil
 	}
 	return false, false, nil
 }
@@ -316,6 +323,11 @@ func (r *Route) haveMatchingVindex(
 				newVindexFound = true
 			}
 		case vindexes.MultiColumn:
+			if vfunc(v.ColVindex) != v.ColVindex.Vindex {
+				// the columns of a multi column vindex are only collected for an exact match,
+				// a LIKE prefix on one of them can't be combined with the others
+				break
+			}
 			colLoweredName := ""
 			indexOfCol := -1
 			for idx, col := range v.ColVindex.Columns {
@@ -545,6 +557,122 @@ func (r *Route) planLikeOp(ctx *plancontext.PlanningContext, node *sqlparser.Com
 
 }
 
+func (r *Route) planRangeOp(ctx *plancontext.PlanningContext, cmp *sqlparser.ComparisonExpr) bool {
+	column, ok := cmp.Left.(*sqlparser.ColName)
+	bound := cmp.Right
+	lower := cmp.Operator == sqlparser.GreaterThanOp || cmp.Operator == sqlparser.GreaterEqualOp
+	if !ok {
+		column, ok = cmp.Right.(*sqlparser.ColName)
+		if !ok {
+			return false
+		}
+		// the column is on the right side, so the bound is on the other side of it
+		bound = cmp.Left
+		lower = !lower
+	}
+	if lower {
+		return r.planRange(ctx, cmp, column, bound, nil)
+	}
+	return r.planRange(ctx, cmp, column, nil, bound)
+}
+
+func (r *Route) planBetweenOp(ctx *plancontext.PlanningContext, node *sqlparser.BetweenExpr) bool {
+	column, ok := node.Left.(*sqlparser.ColName)
+	if !ok || !node.IsBetween {
+		return false
+	}
+	return r.planRange(ctx, node, column, node.From, node.To)
+}
+
+// planRange adds the options of routing the predicate to the shards holding the values
+// of the column between low and high. A nil bound leaves that side of the range open.
+// Ordered vindexes also get an option for the range that the predicate forms together
+// with a predicate seen before, bounding the other side of the same column.
+func (r *Route) planRange(ctx *plancontext.PlanningContext, node sqlparser.Expr, column *sqlparser.ColName, low, high sqlparser.Expr) bool {
+	valueExprs := []sqlparser.Expr{low, high}
+	values := make([]evalengine.Expr, 2)
+	for i, expr := range valueExprs {
+		if expr == nil {
+			valueExprs[i] = &sqlparser.NullVal{}
+			values[i] = evalengine.NullExpr
+			continue
+		}
+		values[i] = r.makeEvalEngineExpr(ctx, expr)
+		if values[i] == nil {
+			return false
+		}
+	}
+
+	newVindexFound := false
+	for _, v := range r.VindexPreds {
+		if !ctx.SemTable.DirectDeps(column).IsSolvedBy(v.TableID) {
+			continue
+		}
+		vindex, ok := v.ColVindex.Vindex.(vindexes.Ordered)
+		if !ok || !column.Name.Equal(v.ColVindex.Columns[0]) {
+			continue
+		}
+		option := &VindexOption{
+			Values:      values,
+			ValueExprs:  valueExprs,
+			Predicates:  []sqlparser.Expr{node},
+			OpCode:      engine.Range,
+			FoundVindex: vindex,
+			Cost:        costFor(v.ColVindex, engine.Range),
+			Ready:       true,
+		}
+		var combined []*VindexOption
+		bounded := false
+		for _, other := range v.Options {
+			if other.OpCode != engine.Range {
+				continue
+			}
+			bounded = bounded || (!isOpenBound(other.ValueExprs[0]) && !isOpenBound(other.ValueExprs[1]))
+			if c := combineRanges(option, other); c != nil {
+				combined = append(combined, c)
+			}
+		}
+		if bounded && (isOpenBound(low) || isOpenBound(high)) {
+			// a range bounded on both sides is already known, and this one can't do better
+			continue
+		}
+		// options of the same cost are picked last one first, so the combined ranges go last
+		v.Options = append(v.Options, option)
+		v.Options = append(v.Options, combined...)
+		newVindexFound = true
+	}
+	return newVindexFound
+}
+
+// isOpenBound returns true if the expression leaves its side of a range open.
+func isOpenBound(expr sqlparser.Expr) bool {
+	if expr == nil {
+		return true
+	}
+	_, ok := expr.(*sqlparser.NullVal)
+	return ok
+}
+
+// combineRanges returns the range bounded on both sides by the two options,
+// if each one of them only bounds the side the other one leaves open.
+func combineRanges(a, b *VindexOption) *VindexOption {
+	if isOpenBound(a.ValueExprs[0]) {
+		a, b = b, a
+	}
+	if isOpenBound(a.ValueExprs[0]) || !isOpenBound(a.ValueExprs[1]) || !isOpenBound(b.ValueExprs[0]) || isOpenBound(b.ValueExprs[1]) {
+		return nil
+	}
+	return &VindexOption{
+		Values:      []evalengine.Expr{a.Values[0], b.Values[1]},
+		ValueExprs:  []sqlparser.Expr{a.ValueExprs[0], b.ValueExprs[1]},
+		Predicates:  append(append([]sqlparser.Expr{}, a.Predicates...), b.Predicates...),
+		OpCode:      engine.Range,
+		FoundVindex: a.FoundVindex,
+		Cost:        a.Cost,
+		Ready:       true,
+	}
+}
+
 func (r *Route) planCompositeInOpRecursive(
 	ctx *plancontext.PlanningContext,
 	cmp *sqlparser.ComparisonExpr,
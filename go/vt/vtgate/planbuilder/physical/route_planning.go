@@ -340,7 +340,7 @@ func findBestJoin(
 			if err != nil {
 				return nil, 0, 0, err
 			}
-			if bestPlan == nil || plan.Cost() < bestPlan.Cost() {
+			if bestPlan == nil || cheaper(ctx, plan, bestPlan) {
 				bestPlan = plan
 				// remember which plans we based on, so we can remove them later
 				lIdx = i
@@ -384,7 +384,23 @@ func mergeOrJoin(ctx *plancontext.PlanningContext, lhs, rhs abstract.PhysicalOpe
 		LeftJoin: !inner,
 	}
 
-	return pushJoinPredicates(ctx, joinPredicates, join)
+	applyJoin, err := pushJoinPredicates(ctx, joinPredicates, join)
+	if err != nil || !inner {
+		return applyJoin, err
+	}
+
+	// when the statistics of the tables are known, we compare the nested loop join with a hash join
+	if _, ok := estimateOp(ctx, applyJoin); !ok {
+		return applyJoin, nil
+	}
+	hashJoin, err := createHashJoin(ctx, lhs, rhs, joinPredicates)
+	if err != nil {
+		return nil, err
+	}
+	if hashJoin != nil && cheaper(ctx, hashJoin, applyJoin) {
+		return hashJoin, nil
+	}
+	return applyJoin, nil
 }
 
 func createRouteOperatorForJoin(ctx *plancontext.PlanningContext, aRoute, bRoute *Route, joinPredicates []sqlparser.Expr, inner bool) (*Route, error) {
@@ -497,7 +513,7 @@ func tryMerge(
 			return nil, nil
 		}
 		fallthrough
-	case engine.Scatter, engine.IN:
+	case engine.Scatter, engine.IN, engine.Range:
 		if len(joinPredicates) == 0 {
 			// If we are doing two Scatters, we have to make sure that the
 			// joins are on the correct vindex to allow them to be merged
@@ -505,7 +521,8 @@ func tryMerge(
 			return nil, nil
 		}
 		if !sameKeyspace {
-			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+			// routes to different keyspaces can never be merged
+			return nil, nil
 		}
 
 		canMerge := canMergeOnFilters(ctx, aRoute, bRoute, joinPredicates)
@@ -559,6 +576,8 @@ func leaves(op abstract.Operator) (sources []abstract.Operator) {
 		// physical
 	case *ApplyJoin:
 		return []abstract.Operator{op.LHS, op.RHS}
+	case *HashJoin:
+		return []abstract.Operator{op.LHS, op.RHS}
 	case *Filter:
 		return []abstract.Operator{op.Source}
 	case *Route:
@@ -700,6 +719,15 @@ func VisitOperators(op abstract.PhysicalOperator, f func(tbl abstract.PhysicalOp
 		if err != nil {
 			return err
 		}
+	case *HashJoin:
+		err := VisitOperators(op.LHS, f)
+		if err != nil {
+			return err
+		}
+		err = VisitOperators(op.RHS, f)
+		if err != nil {
+			return err
+		}
 	case *Filter:
 		err := VisitOperators(op.Source, f)
 		if err != nil {
@@ -846,6 +874,8 @@ func pushJoinPredicates(
 	switch op := op.(type) {
 	case *ApplyJoin:
 		return pushJoinPredicateOnJoin(ctx, exprs, op)
+	case *HashJoin:
+		return PushPredicate(ctx, sqlparser.AndExpressions(exprs...), op.Clone())
 	case *Route:
 		return pushJoinPredicateOnRoute(ctx, exprs, op)
 	case *Table:
@@ -53,16 +53,11 @@ func optimizeSubQuery(ctx *plancontext.PlanningContext, op *abstract.SubQuery) (
 			continue
 		}
 
-		if inner.ExtractedSubquery.OpCode == int(engine.PulloutExists) {
-			correlatedTree, err := createCorrelatedSubqueryOp(ctx, innerOp, outerOp, preds, inner.ExtractedSubquery)
-			if err != nil {
-				return nil, err
-			}
-			outerOp = correlatedTree
-			continue
+		correlatedTree, err := createCorrelatedSubqueryOp(ctx, innerOp, outerOp, preds, inner.ExtractedSubquery)
+		if err != nil {
+			return nil, err
 		}
-
-		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+		outerOp = correlatedTree
 	}
 
 	/*
@@ -233,9 +228,34 @@ func createCorrelatedSubqueryOp(
 	preds []sqlparser.Expr,
 	extractedSubquery *sqlparser.ExtractedSubquery,
 ) (*CorrelatedSubQueryOp, error) {
-	newOuter, err := RemovePredicate(ctx, extractedSubquery, outerOp)
-	if err != nil {
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "exists sub-queries are only supported with AND clause")
+	switch innerOp.(type) {
+	case *SubQueryOp, *CorrelatedSubQueryOp:
+		// the correlated predicates cannot be pushed down through a nested subquery
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+	}
+
+	var newOuter abstract.PhysicalOperator
+	var predicate sqlparser.Expr
+	if extractedSubquery.OpCode == int(engine.PulloutExists) {
+		// an EXISTS that is ANDed with the rest of the predicates
+		// only has to filter the outer rows, it can be planned as a semi-join
+		newOuter, _ = RemovePredicate(ctx, extractedSubquery, outerOp)
+	}
+	if newOuter == nil {
+		// the predicate using the subquery has to be evaluated by vtgate for every outer row
+		var err error
+		predicate, err = findPredicateUsingSubquery(outerOp, extractedSubquery)
+		if err != nil {
+			return nil, err
+		}
+		newOuter, err = RemovePredicate(ctx, predicate, outerOp)
+		if err != nil {
+			return nil, err
+		}
+		err = resetRoutingWithoutPredicate(ctx, newOuter, predicate)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	resultOuterOp := newOuter
@@ -280,6 +300,16 @@ func createCorrelatedSubqueryOp(
 		if rewriteError != nil {
 			return nil, rewriteError
 		}
+
+		// the columns of the outer query have been replaced by arguments, so
+		// the predicate no longer depends on the tables of the outer operator
+		tableSet := ctx.SemTable.Direct[pred]
+		tableSet.RemoveInPlace(resultOuterOp.TableID())
+		ctx.SemTable.Direct[pred] = tableSet
+		tableSet = ctx.SemTable.Recursive[pred]
+		tableSet.RemoveInPlace(resultOuterOp.TableID())
+		ctx.SemTable.Recursive[pred] = tableSet
+
 		var err error
 		innerOp, err = PushPredicate(ctx, pred, innerOp)
 		if err != nil {
@@ -291,5 +321,82 @@ func createCorrelatedSubqueryOp(
 		Inner:     innerOp,
 		Extracted: extractedSubquery,
 		Vars:      vars,
+		Predicate: predicate,
 	}, nil
 }
+
+// findPredicateUsingSubquery returns the predicate of the outer operator that contains the given subquery.
+func findPredicateUsingSubquery(outerOp abstract.PhysicalOperator, extractedSubquery *sqlparser.ExtractedSubquery) (sqlparser.Expr, error) {
+	var predicate sqlparser.Expr
+	err := VisitOperators(outerOp, func(op abstract.PhysicalOperator) (bool, error) {
+		switch op := op.(type) {
+		case *Filter:
+			for _, expr := range op.Predicates {
+				if containsSubquery(expr, extractedSubquery) {
+					predicate = expr
+					return false, nil
+				}
+			}
+		case *Table:
+			for _, expr := range op.QTable.Predicates {
+				if containsSubquery(expr, extractedSubquery) {
+					predicate = expr
+					return false, nil
+				}
+			}
+		case *ApplyJoin:
+			if containsSubquery(op.Predicate, extractedSubquery) {
+				return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery in a join predicate")
+			}
+		case *CorrelatedSubQueryOp:
+			if containsSubquery(op.Predicate, extractedSubquery) {
+				return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multiple cross-shard correlated subqueries in the same predicate")
+			}
+		}
+		return predicate == nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if predicate == nil {
+		// the subquery is not used in a predicate, for instance it is part of the select list
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard correlated subquery")
+	}
+	return predicate, nil
+}
+
+func containsSubquery(expr sqlparser.Expr, extractedSubquery *sqlparser.ExtractedSubquery) bool {
+	if expr == nil {
+		return false
+	}
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if node == extractedSubquery {
+			found = true
+		}
+		return !found, nil
+	}, expr)
+	return found
+}
+
+// resetRoutingWithoutPredicate recomputes the routing of the routes that used the given predicate,
+// since it has been removed and will not be sent down to the tablets anymore
+func resetRoutingWithoutPredicate(ctx *plancontext.PlanningContext, op abstract.PhysicalOperator, predicate sqlparser.Expr) error {
+	return VisitOperators(op, func(op abstract.PhysicalOperator) (bool, error) {
+		route, isRoute := op.(*Route)
+		if !isRoute {
+			return true, nil
+		}
+		var seenPredicates []sqlparser.Expr
+		for _, expr := range route.SeenPredicates {
+			if !sqlparser.EqualsExpr(expr, predicate) {
+				seenPredicates = append(seenPredicates, expr)
+			}
+		}
+		if len(seenPredicates) == len(route.SeenPredicates) {
+			return true, nil
+		}
+		route.SeenPredicates = seenPredicates
+		return true, route.resetRoutingSelections(ctx)
+	})
+}
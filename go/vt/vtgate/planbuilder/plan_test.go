@@ -239,6 +239,27 @@ func TestPlan(t *testing.T) {
 	testFile(t, "show_cases_no_default_keyspace.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "stream_cases.txt", testOutputTempDir, vschemaWrapper)
 	testFile(t, "systemtables_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "window_cases.txt", testOutputTempDir, vschemaWrapper)
+	testFile(t, "join_batch_cases.txt", testOutputTempDir, vschemaWrapper)
+}
+
+func TestPlanWithStatistics(t *testing.T) {
+	vschema := loadSchema(t, "schema_test.json", true)
+	tables := vschema.Keyspaces["user"].Tables
+	tables["user"].Statistics = &vindexes.TableStatistics{
+		RowCount:          10000,
+		ColumnCardinality: map[string]uint64{"id": 10000, "col": 1000},
+	}
+	tables["user_extra"].Statistics = &vindexes.TableStatistics{
+		RowCount:          100,
+		ColumnCardinality: map[string]uint64{"user_id": 100, "extra_id": 100, "col": 100},
+	}
+	vschemaWrapper := &vschemaWrapper{
+		v:             vschema,
+		sysVarEnabled: true,
+	}
+
+	testFile(t, "statistics_cases.txt", makeTestOutput(t), vschemaWrapper)
 }
 
 func TestSysVarSetDisabled(t *testing.T) {
@@ -425,10 +446,26 @@ func loadSchema(t testing.TB, filename string, setCollation bool) *vindexes.VSch
 				}
 			}
 		}
+
+		// setting the primary keys of some tables, as the schema tracker would
+		for tblName, pk := range testPrimaryKeys {
+			if table := ks.Tables[tblName]; table != nil {
+				for _, col := range pk {
+					table.PrimaryKey = append(table.PrimaryKey, sqlparser.NewColIdent(col))
+				}
+			}
+		}
 	}
 	return vschema
 }
 
+var testPrimaryKeys = map[string][]string{
+	"user":        {"id"},
+	"user_extra":  {"extra_id"},
+	"music":       {"id"},
+	"music_extra": {"user_id", "music_id"},
+}
+
 var _ plancontext.VSchema = (*vschemaWrapper)(nil)
 
 type vschemaWrapper struct {
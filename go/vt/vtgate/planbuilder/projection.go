@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ logicalPlan = (*projection)(nil)
+
+// projection is the logicalPlan for engine.Projection.
+// It evaluates expressions at the vtgate level, on top of the rows returned by its input.
+type projection struct {
+	logicalPlanCommon
+	eProjection *engine.Projection
+	// columns holds the select expressions produced by this plan, in the order of eProjection.Exprs
+	columns []sqlparser.SelectExpr
+}
+
+// newProjection builds a new projection evaluating the given expressions.
+func newProjection(input logicalPlan, columns []sqlparser.SelectExpr, names []string, exprs []evalengine.Expr) *projection {
+	return &projection{
+		logicalPlanCommon: newBuilderCommon(input),
+		eProjection: &engine.Projection{
+			Cols:  names,
+			Exprs: exprs,
+		},
+		columns: columns,
+	}
+}
+
+// Primitive implements the logicalPlan interface
+func (p *projection) Primitive() engine.Primitive {
+	p.eProjection.Input = p.input.Primitive()
+	return p.eProjection
+}
+
+// OutputColumns implements the logicalPlan interface
+func (p *projection) OutputColumns() []sqlparser.SelectExpr {
+	return p.columns
+}
@@ -39,22 +39,25 @@ For easy reference, opcodes are:
 	SelectDBA         7
 	SelectReference   8
 	SelectNone        9
-	NumRouteOpcodes   10
+	ByDestination     10
+	SelectRange       11
+	NumRouteOpcodes   12
 */
 
 func TestJoinCanMerge(t *testing.T) {
 	testcases := [engine.NumOpcodes][engine.NumOpcodes]bool{
-		{true, false, false, false, false, false, false, false, true, false, false},
-		{false, true, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, true, true, false, false},
-		{true, true, true, true, true, true, true, true, true, true, true},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
+		{true, false, false, false, false, false, false, false, true, false, false, false},
+		{false, true, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, true, true, false, false, false},
+		{true, true, true, true, true, true, true, true, true, true, true, true},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
 	}
 
 	ks := &vindexes.Keyspace{}
@@ -82,17 +85,18 @@ func TestJoinCanMerge(t *testing.T) {
 
 func TestSubqueryCanMerge(t *testing.T) {
 	testcases := [engine.NumOpcodes][engine.NumOpcodes]bool{
-		{true, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, true, true, false, false},
-		{true, true, true, true, true, true, true, true, true, true, true},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
+		{true, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, true, true, false, false, false},
+		{true, true, true, true, true, true, true, true, true, true, true, true},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
 	}
 
 	ks := &vindexes.Keyspace{}
@@ -112,17 +116,18 @@ func TestSubqueryCanMerge(t *testing.T) {
 
 func TestUnionCanMerge(t *testing.T) {
 	testcases := [engine.NumOpcodes][engine.NumOpcodes]bool{
-		{true, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, true, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, true, false, false, false},
-		{false, false, false, false, false, false, false, false, true, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
-		{false, false, false, false, false, false, false, false, false, false, false},
+		{true, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, true, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, true, false, false, false, false},
+		{false, false, false, false, false, false, false, false, true, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
+		{false, false, false, false, false, false, false, false, false, false, false, false},
 	}
 	ks := &vindexes.Keyspace{}
 	lRoute := &route{}
@@ -33,14 +33,16 @@ import (
 
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
 )
 
 func buildSelectPlan(query string) selectPlanner {
 	return func(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
-		sel := stmt.(*sqlparser.Select)
-		if sel.With != nil {
-			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in select statement")
+		expanded, err := semantics.ExpandCTEs(stmt)
+		if err != nil {
+			return nil, err
 		}
+		sel := expanded.(*sqlparser.Select)
 
 		p, err := handleDualSelects(sel, vschema)
 		if err != nil {
@@ -68,7 +70,7 @@ func buildSelectPlan(query string) selectPlanner {
 
 		if shouldRetryWithCNFRewriting(plan) {
 			// by transforming the predicates to CNF, the planner will sometimes find better plans
-			primitive := rewriteToCNFAndReplan(stmt, getPlan)
+			primitive := rewriteToCNFAndReplan(sel, getPlan)
 			if primitive != nil {
 				return primitive, nil
 			}
@@ -209,6 +211,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars *s
 			return err
 		}
 	}
+	if err := pb.checkWindowFunctions(sel); err != nil {
+		return err
+	}
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
@@ -59,7 +59,10 @@ func transformCorrelatedSubQueryPlan(ctx *plancontext.PlanningContext, op *physi
 	if err != nil {
 		return nil, err
 	}
-	return newSemiJoin(outer, inner, op.Vars), nil
+	if op.Predicate == nil {
+		return newSemiJoin(outer, inner, op.Vars), nil
+	}
+	return newCorrelatedSubquery(ctx, op, outer, inner)
 }
 
 func mergeSubQueryOpPlan(ctx *plancontext.PlanningContext, inner, outer logicalPlan, n *physical.SubQueryOp) logicalPlan {
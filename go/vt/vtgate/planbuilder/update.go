@@ -31,9 +31,17 @@ import (
 
 // buildUpdatePlan builds the instructions for an UPDATE statement.
 func buildUpdatePlan(stmt sqlparser.Statement, reservedVars *sqlparser.ReservedVars, vschema plancontext.VSchema) (engine.Primitive, error) {
+	stmt, err := semantics.ExpandCTEs(stmt)
+	if err != nil {
+		return nil, err
+	}
 	upd := stmt.(*sqlparser.Update)
-	if upd.With != nil {
-		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: with expression in update statement")
+	if err := checkUpdatableTarget("UPDATE", upd.TableExprs); err != nil {
+		return nil, err
+	}
+	withInput, err := buildUpdateWithInput(upd, reservedVars, vschema)
+	if err != nil || withInput != nil {
+		return withInput, err
 	}
 	dml, ksidVindex, err := buildDMLPlan(vschema, "update", stmt, reservedVars, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
 	if err != nil {
@@ -0,0 +1,285 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/abstract"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder/plancontext"
+)
+
+var _ logicalPlan = (*window)(nil)
+
+// window is the logicalPlan for engine.Window.
+// It evaluates window functions on top of a route
+// that returns rows sorted by the window specification.
+type window struct {
+	logicalPlanCommon
+	eWindow *engine.Window
+	// functions holds the window function expressions,
+	// in the order of the columns appended by the primitive.
+	functions []sqlparser.SelectExpr
+}
+
+// Primitive implements the logicalPlan interface
+func (w *window) Primitive() engine.Primitive {
+	w.eWindow.Input = w.input.Primitive()
+	return w.eWindow
+}
+
+// OutputColumns implements the logicalPlan interface
+func (w *window) OutputColumns() []sqlparser.SelectExpr {
+	outputCols := sqlparser.CloneSelectExprs(w.input.OutputColumns())
+	return append(outputCols, w.functions...)
+}
+
+// windowsOf returns the window specifications used in the SELECT and ORDER BY clauses.
+func windowsOf(sel *sqlparser.Select) []*sqlparser.OverClause {
+	var windows []*sqlparser.OverClause
+	collect := func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if fExpr, ok := node.(*sqlparser.FuncExpr); ok && fExpr.IsWindowFunction() {
+			windows = append(windows, fExpr.Over)
+		}
+		return true, nil
+	}
+	_ = sqlparser.Walk(collect, sel.SelectExprs)
+	_ = sqlparser.Walk(collect, sel.OrderBy)
+	return windows
+}
+
+// windowsArePartitionedByUniqueVindex returns true if every window is partitioned
+// by at least one column that has a unique vindex. In that case, all the rows of
+// a partition live in the same shard and the window functions can be evaluated by MySQL.
+func windowsArePartitionedByUniqueVindex(windows []*sqlparser.OverClause, isUniqueVindex func(sqlparser.Expr) bool) bool {
+	for _, over := range windows {
+		found := false
+		for _, expr := range over.PartitionBy {
+			if isUniqueVindex(expr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// checkWindowFunctions verifies that the window functions of the query can be
+// pushed down to the underlying route. The V3 planner does not evaluate window
+// functions at the vtgate level.
+func (pb *primitiveBuilder) checkWindowFunctions(sel *sqlparser.Select) error {
+	windows := windowsOf(sel)
+	if len(windows) == 0 {
+		return nil
+	}
+	rb, isRoute := pb.plan.(*route)
+	if !isRoute {
+		return vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions in cross-shard join")
+	}
+	if rb.isSingleShard() {
+		return nil
+	}
+	isUniqueVindex := func(expr sqlparser.Expr) bool {
+		vindex := pb.st.Vindex(expr, rb)
+		return vindex != nil && vindex.IsUnique()
+	}
+	if !windowsArePartitionedByUniqueVindex(windows, isUniqueVindex) {
+		return vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard window function not partitioned by a unique vindex")
+	}
+	return nil
+}
+
+// needsWindowPlanning returns true if the query has window functions that can't be evaluated by MySQL alone,
+// because the rows of a single partition can come from different shards.
+func (hp *horizonPlanning) needsWindowPlanning(ctx *plancontext.PlanningContext, plan logicalPlan) (bool, error) {
+	windows := windowsOf(hp.sel)
+	if len(windows) == 0 {
+		return false, nil
+	}
+	if _, isRoute := plan.(*routeGen4); !isRoute {
+		return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions in cross-shard join")
+	}
+	isUniqueVindex := func(expr sqlparser.Expr) bool {
+		return exprHasUniqueVindex(ctx.VSchema, ctx.SemTable, expr)
+	}
+	return !windowsArePartitionedByUniqueVindex(windows, isUniqueVindex), nil
+}
+
+// planWindowFunctions plans a scatter query with window functions that have to be evaluated at the vtgate level.
+// The route is asked to merge-sort its results by the PARTITION BY and ORDER BY expressions of the window,
+// and the window functions are computed by an engine.Window primitive on top of it.
+// The resulting plan is SimpleProjection -> [MemorySort] -> Window -> Route, where the memory sort
+// is only needed when the query has an ORDER BY clause.
+func (hp *horizonPlanning) planWindowFunctions(ctx *plancontext.PlanningContext, rb *routeGen4) (logicalPlan, error) {
+	if hp.qp.NeedsAggregation() || hp.sel.Having != nil || hp.qp.NeedsDistinct() || hp.qp.HasStar {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard window functions with aggregation, distinct or star expression")
+	}
+
+	eWindow := &engine.Window{}
+	w := &window{
+		logicalPlanCommon: newBuilderCommon(rb),
+		eWindow:           eWindow,
+	}
+
+	// The select expressions without window functions are pushed to the route.
+	// The window functions are evaluated by the Window primitive, which
+	// appends their values after the columns of the route.
+	var over *sqlparser.OverClause
+	var windowExprs []*sqlparser.FuncExpr
+	selectCols := make([]int, len(hp.qp.SelectExprs))
+	funcIdx := make(map[int]int)
+	for i, e := range hp.qp.SelectExprs {
+		aliasedExpr, err := e.GetAliasedExpr()
+		if err != nil {
+			return nil, err
+		}
+		fExpr, isFunc := aliasedExpr.Expr.(*sqlparser.FuncExpr)
+		if !isFunc || !fExpr.IsWindowFunction() {
+			if sqlparser.ContainsWindowFunction(aliasedExpr.Expr) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex window function expression: %s", sqlparser.String(aliasedExpr.Expr))
+			}
+			offset, _, err := pushProjection(ctx, aliasedExpr, rb, true, false, false)
+			if err != nil {
+				return nil, err
+			}
+			selectCols[i] = offset
+			continue
+		}
+
+		opcode, supported := engine.SupportedWindowFunctions[fExpr.Name.Lowered()]
+		if !supported || len(fExpr.Exprs) != 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: window function '%s'", sqlparser.String(fExpr))
+		}
+		if over == nil {
+			over = fExpr.Over
+		} else if !sqlparser.EqualsRefOfOverClause(over, fExpr.Over) {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: window functions with different window specifications")
+		}
+		alias := aliasedExpr.As.String()
+		if alias == "" {
+			alias = sqlparser.String(fExpr)
+		}
+		funcIdx[i] = len(windowExprs)
+		windowExprs = append(windowExprs, fExpr)
+		eWindow.Functions = append(eWindow.Functions, &engine.WindowParams{Opcode: opcode, Alias: alias})
+		w.functions = append(w.functions, aliasedExpr)
+	}
+	if over == nil {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: window function must be in the select list")
+	}
+
+	// The route has to return the rows sorted by the partition, and inside each partition by the window order.
+	var windowOrder []abstract.OrderBy
+	for _, expr := range over.PartitionBy {
+		windowOrder = append(windowOrder, abstract.OrderBy{
+			Inner:         &sqlparser.Order{Expr: expr, Direction: sqlparser.AscOrder},
+			WeightStrExpr: expr,
+		})
+	}
+	partitionCount := len(windowOrder)
+	for _, order := range over.OrderBy {
+		windowOrder = append(windowOrder, abstract.OrderBy{
+			Inner:         &sqlparser.Order{Expr: order.Expr, Direction: order.Direction},
+			WeightStrExpr: order.Expr,
+		})
+	}
+	for _, order := range windowOrder {
+		if isSpecialOrderBy(order) {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: window ordering on %s", sqlparser.String(order.Inner.Expr))
+		}
+	}
+	if _, err := planOrderByForRoute(ctx, windowOrder, rb, false); err != nil {
+		return nil, err
+	}
+	eWindow.PartitionBy = rb.eroute.OrderBy[:partitionCount]
+	eWindow.OrderBy = rb.eroute.OrderBy[partitionCount:]
+
+	// The ORDER BY of the query is applied after the window functions have been evaluated.
+	type sortKey struct {
+		params engine.OrderByParams
+		// function is the index of the window function used for sorting, or -1
+		function int
+	}
+	var sortKeys []sortKey
+	for _, order := range hp.qp.OrderExprs {
+		if isSpecialOrderBy(order) {
+			continue
+		}
+		key := sortKey{function: -1}
+		for i, fExpr := range windowExprs {
+			if sqlparser.EqualsExpr(fExpr, order.WeightStrExpr) {
+				key.function = i
+				break
+			}
+		}
+		if key.function == -1 {
+			if sqlparser.ContainsWindowFunction(order.WeightStrExpr) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: in scatter query: complex order by expression: %s", sqlparser.String(order.Inner.Expr))
+			}
+			offset, weightStringOffset, err := wrapAndPushExpr(ctx, order.Inner.Expr, order.WeightStrExpr, rb)
+			if err != nil {
+				return nil, err
+			}
+			key.params.Col = offset
+			key.params.WeightStringCol = weightStringOffset
+			key.params.CollationID = ctx.SemTable.CollationForExpr(order.Inner.Expr)
+		}
+		key.params.Desc = order.Inner.Direction == sqlparser.DescOrder
+		sortKeys = append(sortKeys, key)
+	}
+
+	// Now that all the columns have been pushed to the route, we know where the window function values end up.
+	routeColumns := len(rb.OutputColumns())
+	for i := range selectCols {
+		if idx, isFunc := funcIdx[i]; isFunc {
+			selectCols[i] = routeColumns + idx
+		}
+	}
+
+	var plan logicalPlan = w
+	if len(sortKeys) > 0 {
+		primitive := &engine.MemorySort{}
+		ms := &memorySort{
+			resultsBuilder: resultsBuilder{
+				logicalPlanCommon: newBuilderCommon(w),
+				weightStrings:     make(map[*resultColumn]int),
+				truncater:         primitive,
+			},
+			eMemorySort: primitive,
+		}
+		for _, key := range sortKeys {
+			params := key.params
+			if key.function != -1 {
+				params.Col = routeColumns + key.function
+				params.WeightStringCol = -1
+			}
+			params.StarColFixedIndex = params.Col
+			ms.eMemorySort.OrderBy = append(ms.eMemorySort.OrderBy, params)
+		}
+		plan = ms
+	}
+
+	return &simpleProjection{
+		logicalPlanCommon: newBuilderCommon(plan),
+		eSimpleProj:       &engine.SimpleProjection{Cols: selectCols},
+	}, nil
+}
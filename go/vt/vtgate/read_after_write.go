@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Read after write consistency lets a session read its own writes from replicas.
+//
+// With session_track_gtids = own_gtid, the GTIDs of the commits of the session
+// are added to its read_after_write_gtid. Queries of the session that go to a
+// replica carry that GTID set, and the replica waits up to read_after_write_timeout
+// seconds for it to be applied before answering. If it does not catch up in time,
+// the query is sent to the primary instead.
+
+// trackWrittenGTIDs adds the GTIDs of the writes the session just committed to its
+// read after write GTID. Tablets do not return the GTID of a commit, so the GTID set
+// the primary executed right after the commit is used, which contains it.
+func (e *Executor) trackWrittenGTIDs(ctx context.Context, safeSession *SafeSession, result *sqltypes.Result) {
+	targets := safeSession.takeWrittenShards()
+	if len(targets) == 0 {
+		return
+	}
+
+	gw := e.resolver.resolver.GetGateway()
+	rss := make([]*srvtopo.ResolvedShard, len(targets))
+	queries := make([]*querypb.BoundQuery, len(targets))
+	for i, target := range targets {
+		rss[i] = &srvtopo.ResolvedShard{Target: target, Gateway: gw}
+		queries[i] = &querypb.BoundQuery{Sql: "select @@global.gtid_executed"}
+	}
+	qr, errs := e.scatterConn.ExecuteMultiShard(ctx, rss, queries, NewSafeSession(nil), false, false)
+	err := vterrors.Aggregate(errs)
+
+	var gtids mysql.GTIDSet
+	for _, row := range qr.Rows {
+		if err != nil {
+			break
+		}
+		var pos mysql.Position
+		pos, err = mysql.ParsePosition(mysql.Mysql56FlavorID, row[0].ToString())
+		if err != nil {
+			break
+		}
+		if gtids == nil {
+			gtids = pos.GTIDSet
+		} else {
+			gtids = gtids.Union(pos.GTIDSet)
+		}
+	}
+	if err == nil && gtids != nil {
+		err = safeSession.addReadAfterWriteGTID(gtids)
+	}
+	if err != nil {
+		// The writes were committed, so the statement must not fail.
+		safeSession.RecordWarning(&querypb.QueryWarning{
+			Code:    mysql.ERUnknownError,
+			Message: fmt.Sprintf("cannot track the GTID of the commit, reads from replicas may not see it: %v", err),
+		})
+		return
+	}
+	if result != nil {
+		gtid, _ := safeSession.readAfterWrite()
+		result.SessionStateChanges = gtid
+	}
+}
+
+// readAfterWriteBindVars returns the bind variables for a query of the session
+// that is sent to target outside of a transaction. If target is a replica and the
+// session has a read after write GTID, the replica is asked to wait for it.
+func readAfterWriteBindVars(session *SafeSession, target *querypb.Target, bindVars map[string]*querypb.BindVariable) map[string]*querypb.BindVariable {
+	if session == nil || session.Session == nil {
+		return bindVars
+	}
+	switch target.GetTabletType() {
+	case topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY:
+	default:
+		return bindVars
+	}
+	gtid, timeout := session.readAfterWrite()
+	if gtid == "" {
+		return bindVars
+	}
+	bvs := make(map[string]*querypb.BindVariable, len(bindVars)+2)
+	for k, v := range bindVars {
+		bvs[k] = v
+	}
+	bvs[sqltypes.BvReadAfterWriteGTID] = sqltypes.StringBindVariable(gtid)
+	bvs[sqltypes.BvReadAfterWriteTimeout] = sqltypes.Float64BindVariable(timeout)
+	return bvs
+}
+
+// isReadAfterWriteTimeout returns true if err was returned by a replica that did
+// not apply the writes of the session in time.
+func isReadAfterWriteTimeout(err error) bool {
+	return err != nil && vterrors.Code(err) == vtrpcpb.Code_FAILED_PRECONDITION &&
+		strings.Contains(err.Error(), vterrors.ReadAfterWriteTimeout)
+}
+
+// primaryTarget returns the target of the primary of the shard of target.
+func primaryTarget(target *querypb.Target) *querypb.Target {
+	return &querypb.Target{
+		Keyspace:   target.Keyspace,
+		Shard:      target.Shard,
+		TabletType: topodatapb.TabletType_PRIMARY,
+		Cell:       target.Cell,
+	}
+}
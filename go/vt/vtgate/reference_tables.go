@@ -0,0 +1,235 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// Global reference tables are copied by VReplication from their unsharded keyspace
+// to all the other keyspaces, by one workflow per table and keyspace whose streams
+// are tagged with schema.ReferenceTableTag.
+//
+// The referenceTableMonitor checks these streams periodically. It asks vtctld to
+// create the workflows that are missing, and routes the queries on a copy to the
+// global reference table as long as the streams of the copy are missing, not
+// running, still copying or lagging more than reference_table_max_lag. An idle
+// stream only records a heartbeat every vreplication_heartbeat_update_interval, so
+// reference_table_max_lag must be larger than that interval. Until the first check
+// completes, all queries on copies are routed to the global reference table.
+
+// referenceTableRequestRetry is how long the monitor waits before it asks again
+// for the creation of the workflow of a copy that is still missing.
+const referenceTableRequestRetry = 5 * time.Minute
+
+type referenceTableMonitor struct {
+	executor *Executor
+	maxLag   time.Duration
+	ticks    *timer.Timer
+	cancel   context.CancelFunc
+
+	mu sync.Mutex
+	// requested are the times the copies were last requested, by keyspace.table
+	requested map[string]time.Time
+	// unroutable is the number of copies that queries are not routed to
+	unroutable int
+}
+
+func newReferenceTableMonitor(executor *Executor, maxLag, interval time.Duration) *referenceTableMonitor {
+	return &referenceTableMonitor{
+		executor:  executor,
+		maxLag:    maxLag,
+		ticks:     timer.NewTimer(interval),
+		requested: make(map[string]time.Time),
+	}
+}
+
+// Open starts checking the copies of the global reference tables.
+func (m *referenceTableMonitor) Open() {
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	m.ticks.Start(func() { m.check(ctx) })
+}
+
+// Close stops checking the copies of the global reference tables.
+func (m *referenceTableMonitor) Close() {
+	if m.cancel != nil {
+		m.cancel()
+	}
+	m.ticks.Stop()
+}
+
+// Unroutable returns the number of copies of global reference tables that
+// queries are not routed to.
+func (m *referenceTableMonitor) Unroutable() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return int64(m.unroutable)
+}
+
+func (m *referenceTableMonitor) check(ctx context.Context) {
+	vschema := m.executor.VSchema()
+	if vschema == nil {
+		return
+	}
+	unroutable := make(map[string]map[string]bool)
+	count := 0
+	for ksName, sources := range vschema.GlobalReferences() {
+		healthy, err := m.keyspaceCopies(ctx, ksName)
+		if err != nil {
+			log.Warningf("cannot check the copies of the global reference tables in keyspace %s: %v", ksName, err)
+		}
+		for _, source := range sources {
+			tblName := source.Name.String()
+			ok, found := healthy[tblName]
+			if err == nil && !found {
+				m.requestCopy(ctx, ksName, source.Keyspace.Name, tblName)
+			}
+			if ok {
+				continue
+			}
+			if unroutable[ksName] == nil {
+				unroutable[ksName] = make(map[string]bool)
+			}
+			unroutable[ksName][tblName] = true
+			count++
+		}
+	}
+
+	m.mu.Lock()
+	m.unroutable = count
+	m.mu.Unlock()
+	m.executor.vm.SetUnroutableReferences(unroutable)
+}
+
+// keyspaceCopies returns whether the copies of the global reference tables in the
+// keyspace can be used by queries, by table. The tables without streams are missing.
+func (m *referenceTableMonitor) keyspaceCopies(ctx context.Context, keyspace string) (map[string]bool, error) {
+	rss, err := m.executor.resolver.resolver.ResolveDestination(ctx, keyspace, topodatapb.TabletType_PRIMARY, key.DestinationAllShards{})
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf("select workflow, state, (select count(*) from _vt.copy_state where vrepl_id = id), transaction_timestamp, time_heartbeat, unix_timestamp() from _vt.vreplication where tags = %s",
+		sqlparser.String(sqlparser.NewStrLiteral(schema.ReferenceTableTag)))
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{Sql: query}
+	}
+	qr, errs := m.executor.scatterConn.ExecuteMultiShard(ctx, rss, queries, NewSafeSession(nil), false, false)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return nil, err
+	}
+	return referenceCopiesHealth(qr, len(rss), m.maxLag)
+}
+
+// referenceCopiesHealth returns, by table, whether the copies of global reference tables
+// of a keyspace with the given number of shards can be used by queries, given the rows of
+// their streams. A copy can be used if every shard has a running stream that is done
+// copying and lags at most maxLag behind its source.
+// A stream is known to be up to date with its source as of the last transaction it applied,
+// or as of its last heartbeat, which it only records while it has caught up and the source
+// is idle. Its lag is how long ago the latest of the two was, so that an idle stream is not
+// lagging as long as it keeps heartbeating, while a stalled one is.
+func referenceCopiesHealth(qr *sqltypes.Result, shards int, maxLag time.Duration) (map[string]bool, error) {
+	healthyStreams := make(map[string]int)
+	for _, row := range qr.Rows {
+		tblName := schema.ReferenceTableFromWorkflow(row[0].ToString())
+		if tblName == "" {
+			continue
+		}
+		var values [4]int64
+		for i := range values {
+			v, err := nullableToInt64(row[2+i])
+			if err != nil {
+				return nil, err
+			}
+			values[i] = v
+		}
+		copying, transactionTimestamp, heartbeat, now := values[0], values[1], values[2], values[3]
+		lastCurrent := transactionTimestamp
+		if heartbeat > lastCurrent {
+			lastCurrent = heartbeat
+		}
+		healthy := row[1].ToString() == binlogplayer.BlpRunning &&
+			copying == 0 &&
+			time.Duration(now-lastCurrent)*time.Second <= maxLag
+		if healthy {
+			healthyStreams[tblName]++
+		} else if _, ok := healthyStreams[tblName]; !ok {
+			healthyStreams[tblName] = 0
+		}
+	}
+	healthy := make(map[string]bool, len(healthyStreams))
+	for tblName, count := range healthyStreams {
+		healthy[tblName] = count == shards
+	}
+	return healthy, nil
+}
+
+func nullableToInt64(v sqltypes.Value) (int64, error) {
+	if v.IsNull() {
+		return 0, nil
+	}
+	return v.ToInt64()
+}
+
+// requestCopy asks vtctld to create the workflow that copies the global reference
+// table of sourceKeyspace to keyspace.
+func (m *referenceTableMonitor) requestCopy(ctx context.Context, keyspace, sourceKeyspace, table string) {
+	rt := schema.NewReferenceTable(keyspace, sourceKeyspace, table)
+	m.mu.Lock()
+	last, ok := m.requested[rt.EntryName()]
+	if ok && time.Since(last) < referenceTableRequestRetry {
+		m.mu.Unlock()
+		return
+	}
+	m.requested[rt.EntryName()] = time.Now()
+	m.mu.Unlock()
+
+	ts, err := m.executor.serv.GetTopoServer()
+	if err != nil {
+		log.Errorf("cannot request the copy of %s: %v", rt.ToString(), err)
+		return
+	}
+	conn, err := ts.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		log.Errorf("cannot request the copy of %s: %v", rt.ToString(), err)
+		return
+	}
+	// vtctld picks up the request and creates the workflow
+	if err := rt.WriteTopo(ctx, conn, schema.ReferenceTableRequestsPath()); err != nil {
+		log.Errorf("cannot request the copy of %s: %v", rt.ToString(), err)
+		return
+	}
+	log.Infof("requested the copy of %s", rt.ToString())
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+func TestReferenceCopiesHealth(t *testing.T) {
+	fields := sqltypes.MakeTestFields("workflow|state|copying|transaction_timestamp|time_heartbeat|now", "varchar|varchar|int64|int64|int64|int64")
+	qr := sqltypes.MakeTestResult(fields,
+		// all shards up to date
+		"reference_country|Running|0|999|0|1000",
+		"reference_country|Running|0|1000|0|1000",
+		// one shard lagging
+		"reference_currency|Running|0|999|0|1000",
+		"reference_currency|Running|0|969|0|1000",
+		// one shard copying
+		"reference_region|Running|0|1000|0|1000",
+		"reference_region|Running|1|1000|0|1000",
+		// one shard stopped
+		"reference_language|Running|0|1000|0|1000",
+		"reference_language|Stopped|0|1000|0|1000",
+		// one shard without stream
+		"reference_timezone|Running|0|1000|0|1000",
+		// idle source, both shards heartbeating
+		"reference_unit|Running|0|100|999|1000",
+		"reference_unit|Running|0|0|1000|1000",
+		// idle source, one shard without recent heartbeat
+		"reference_tax|Running|0|100|999|1000",
+		"reference_tax|Running|0|100|900|1000",
+		// not a reference table
+		"commerce2customer|Running|0|1000|0|1000",
+	)
+	healthy, err := referenceCopiesHealth(qr, 2, 30*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{
+		"country":  true,
+		"currency": false,
+		"region":   false,
+		"language": false,
+		"timezone": false,
+		"unit":     true,
+		"tax":      false,
+	}, healthy)
+}
+
+func TestSetUnroutableReferences(t *testing.T) {
+	srvVSchema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"main": {
+				Tables: map[string]*vschemapb.Table{
+					"country": {Type: vindexes.TypeGlobalReference},
+				},
+			},
+			"customer": {},
+		},
+	}
+	vm := &VSchemaManager{}
+	var vs *vindexes.VSchema
+	rebuilds := 0
+	vm.subscriber = func(vschema *vindexes.VSchema, _ *VSchemaStats) {
+		vs = vschema
+		rebuilds++
+	}
+	vm.VSchemaUpdate(srvVSchema, nil)
+	require.Equal(t, 1, rebuilds)
+	assert.Equal(t, "main", vs.Keyspaces["customer"].Tables["country"].Keyspace.Name, "copies are not used before they are checked")
+
+	vm.SetUnroutableReferences(map[string]map[string]bool{})
+	require.Equal(t, 2, rebuilds)
+	assert.Equal(t, "customer", vs.Keyspaces["customer"].Tables["country"].Keyspace.Name)
+
+	vm.SetUnroutableReferences(map[string]map[string]bool{})
+	assert.Equal(t, 2, rebuilds, "nothing changed")
+
+	vm.SetUnroutableReferences(map[string]map[string]bool{"customer": {"country": true}})
+	require.Equal(t, 3, rebuilds)
+	table, err := vs.FindTable("customer", "country")
+	require.NoError(t, err)
+	assert.Equal(t, "main", table.Keyspace.Name)
+	assert.Equal(t, map[string][]*vindexes.Table{"customer": {table}}, vs.GlobalReferences())
+
+	// the tables stay unroutable when the vschema changes
+	vm.VSchemaUpdate(srvVSchema, nil)
+	table, err = vs.FindTable("customer", "country")
+	require.NoError(t, err)
+	assert.Equal(t, sqlparser.NewTableIdent("country"), table.Name)
+	assert.Equal(t, "main", table.Keyspace.Name)
+
+	vm.SetUnroutableReferences(nil)
+	table, err = vs.FindTable("customer", "country")
+	require.NoError(t, err)
+	assert.Equal(t, "customer", table.Keyspace.Name)
+}
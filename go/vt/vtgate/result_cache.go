@@ -0,0 +1,278 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"sort"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/resultcache"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+)
+
+func newResultCache(vsm *vstreamManager) *resultcache.Cache {
+	config := resultcache.Config{
+		MaxMemoryUsage: *resultCacheMemory,
+		TTL:            *resultCacheTTL,
+	}
+	return resultcache.New(config, func(ctx context.Context, keyspace string, send func([]*binlogdatapb.VEvent) error) error {
+		vgtid := &binlogdatapb.VGtid{ShardGtids: []*binlogdatapb.ShardGtid{{Keyspace: keyspace, Gtid: "current"}}}
+		// Heartbeats tell the cache that the stream is up even if the keyspace sees no writes.
+		flags := &vtgatepb.VStreamFlags{HeartbeatInterval: 1}
+		return vsm.VStream(ctx, topodatapb.TabletType_PRIMARY, vgtid, nil, flags, send)
+	})
+}
+
+// nonDeterministicFuncs are the functions whose results differ between two
+// executions of the same query, which makes the query results uncacheable.
+var nonDeterministicFuncs = map[string]bool{
+	"rand":              true,
+	"uuid":              true,
+	"uuid_short":        true,
+	"sysdate":           true,
+	"now":               true,
+	"unix_timestamp":    true,
+	"connection_id":     true,
+	"last_insert_id":    true,
+	"found_rows":        true,
+	"row_count":         true,
+	"database":          true,
+	"schema":            true,
+	"user":              true,
+	"current_user":      true,
+	"session_user":      true,
+	"system_user":       true,
+	"sleep":             true,
+	"get_lock":          true,
+	"release_lock":      true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+	"release_all_locks": true,
+}
+
+// cacheableTables returns the keyspace.table names the results of the plan
+// are read from, or nil if the results of the plan can never be cached.
+func cacheableTables(stmt sqlparser.Statement, plan *engine.Plan) []string {
+	if plan.Type != sqlparser.StmtSelect || plan.Instructions == nil {
+		return nil
+	}
+	if needs := plan.BindVarNeeds; needs != nil &&
+		(len(needs.NeedFunctionResult) > 0 || len(needs.NeedSystemVariable) > 0 || len(needs.NeedUserDefinedVariables) > 0) {
+		return nil
+	}
+
+	cacheable := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			if node.Lock != sqlparser.NoLock || node.Into != nil {
+				cacheable = false
+			}
+		case *sqlparser.Union:
+			if node.Lock != sqlparser.NoLock || node.Into != nil {
+				cacheable = false
+			}
+		case *sqlparser.CurTimeFuncExpr:
+			cacheable = false
+		case *sqlparser.FuncExpr:
+			if nonDeterministicFuncs[node.Name.Lowered()] {
+				cacheable = false
+			}
+		}
+		return cacheable, nil
+	}, stmt)
+	if !cacheable {
+		return nil
+	}
+
+	var tables []string
+	seen := map[string]bool{}
+	var visit func(primitive engine.Primitive) bool
+	visit = func(primitive engine.Primitive) bool {
+		inputs := primitive.Inputs()
+		if len(inputs) > 0 {
+			for _, input := range inputs {
+				if !visit(input) {
+					return false
+				}
+			}
+			return true
+		}
+		switch primitive := primitive.(type) {
+		case *engine.Route:
+			if primitive.Opcode == engine.Next || primitive.Opcode == engine.DBA || primitive.TableName == "" {
+				return false
+			}
+			for _, name := range strings.Split(primitive.TableName, ", ") {
+				_, name, err := sqlparser.ParseTable(name)
+				if err != nil {
+					return false
+				}
+				table := primitive.Keyspace.Name + "." + name
+				if !seen[table] {
+					seen[table] = true
+					tables = append(tables, table)
+				}
+			}
+			return true
+		case *engine.SingleRow:
+			return true
+		}
+		return false
+	}
+	if !visit(plan.Instructions) {
+		return nil
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// resultCacheOptedIn returns true if all the tables set result_cache in the vschema.
+func resultCacheOptedIn(vschema *vindexes.VSchema, tables []string) bool {
+	if len(tables) == 0 {
+		return false
+	}
+	for _, name := range tables {
+		i := strings.IndexByte(name, '.')
+		ks, ok := vschema.Keyspaces[name[:i]]
+		if !ok {
+			return false
+		}
+		table, ok := ks.Tables[name[i+1:]]
+		if !ok || !table.ResultCache {
+			return false
+		}
+	}
+	return true
+}
+
+// replicationLag returns the highest replication lag of the serving tablets of the
+// given type in the keyspaces of the tables. It returns false if a keyspace has no
+// such tablet known to the gateway, in which case the lag is unknown.
+func (e *Executor) replicationLag(tabletType topodatapb.TabletType, tables []string) (time.Duration, bool) {
+	keyspaces := map[string]bool{}
+	for _, table := range tables {
+		keyspaces[table[:strings.IndexByte(table, '.')]] = false
+	}
+	var lag uint32
+	for _, status := range e.scatterConn.gateway.TabletsCacheStatus() {
+		if status.Target.TabletType != tabletType {
+			continue
+		}
+		if _, ok := keyspaces[status.Target.Keyspace]; !ok {
+			continue
+		}
+		for _, th := range status.TabletsStats {
+			if !th.Serving || th.Stats == nil {
+				continue
+			}
+			keyspaces[status.Target.Keyspace] = true
+			if th.Stats.ReplicationLagSeconds > lag {
+				lag = th.Stats.ReplicationLagSeconds
+			}
+		}
+	}
+	for _, found := range keyspaces {
+		if !found {
+			return 0, false
+		}
+	}
+	// the lag is reported in whole seconds
+	return time.Duration(lag+1) * time.Second, true
+}
+
+// resultCacheKey returns the key results are cached under. Besides the
+// normalized query and its bind variables, it includes the target and the
+// caller, so that a cached result is never served to a user who could not
+// have read it from the tablets.
+func resultCacheKey(vcursor *vcursorImpl, query string, bindVars map[string]*querypb.BindVariable) string {
+	h := sha256.New()
+	writeKeyPart(h, vcursor.planPrefixKey())
+	writeKeyPart(h, callerid.GetUsername(callerid.ImmediateCallerIDFromContext(vcursor.ctx)))
+	writeKeyPart(h, callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(vcursor.ctx)))
+	writeKeyPart(h, query)
+
+	names := make([]string, 0, len(bindVars))
+	for name := range bindVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		bv := bindVars[name]
+		writeKeyPart(h, name)
+		writeKeyPart(h, bv.Type.String())
+		writeKeyPart(h, string(bv.Value))
+		for _, value := range bv.Values {
+			writeKeyPart(h, value.Type.String())
+			writeKeyPart(h, string(value.Value))
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeKeyPart writes a length prefixed string, so that no two different
+// sets of parts hash the same.
+func writeKeyPart(h hash.Hash, part string) {
+	var length [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(length[:], uint64(len(part)))
+	_, _ = h.Write(length[:n])
+	_, _ = h.Write([]byte(part))
+}
+
+// executeWithResultCache serves the plan from the result cache if it can,
+// and otherwise executes it and caches its results.
+func (e *Executor) executeWithResultCache(safeSession *SafeSession, plan *engine.Plan, vcursor *vcursorImpl, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rc := e.resultCache
+	if rc == nil || plan.ResultCacheTables == nil || plan.ResultCacheTTL <= 0 || safeSession.InTransaction() || safeSession.InReservedConn() {
+		return vcursor.ExecutePrimitive(plan.Instructions, bindVars, true)
+	}
+
+	key := resultCacheKey(vcursor, plan.Original, bindVars)
+	if qr, ok := rc.Get(key, plan.ResultCacheTables); ok {
+		return qr, nil
+	}
+	snapshot, cacheable := rc.Snapshot(plan.ResultCacheTables)
+	qr, err := vcursor.ExecutePrimitive(plan.Instructions, bindVars, true)
+	if err != nil || !cacheable {
+		return qr, err
+	}
+	// The stream of invalidations reads the binlogs of the primary,
+	// the other tablets may not have applied the last changes yet.
+	var lag time.Duration
+	if vcursor.tabletType != topodatapb.TabletType_PRIMARY {
+		if lag, cacheable = e.replicationLag(vcursor.tabletType, plan.ResultCacheTables); !cacheable {
+			return qr, nil
+		}
+	}
+	rc.Set(key, snapshot, plan.ResultCacheTables, qr, plan.ResultCacheTTL, lag)
+	return qr, nil
+}
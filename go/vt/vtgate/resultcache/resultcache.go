@@ -0,0 +1,416 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resultcache implements the vtgate query result cache.
+//
+// Results are cached under a key derived from the normalized query and its
+// bind variables. Every entry remembers the tables it was read from; a
+// VStream per keyspace bumps the generation of a table whenever a row event
+// for it is seen, which invalidates all entries that read from it. Entries
+// also expire after their TTL, so a stalled stream can never serve stale
+// results forever. While the stream of a keyspace is not running, results
+// from that keyspace are not cached at all.
+//
+// The stream reads the binlogs of the primary, while results may be read from
+// replicas that have not applied the latest writes yet. A result read from
+// replicas is not cached if one of its tables was invalidated more recently
+// than the replication lag of the replicas.
+package resultcache
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// Streamer streams the binlog events of a keyspace to send until ctx
+// is canceled or the stream fails.
+type Streamer func(ctx context.Context, keyspace string, send func([]*binlogdatapb.VEvent) error) error
+
+// Config configures a Cache.
+type Config struct {
+	// MaxMemoryUsage is the maximum number of bytes the cached results may use.
+	MaxMemoryUsage int64
+	// TTL is how long results are cached when their query does not ask for a TTL.
+	TTL time.Duration
+	// RetryDelay is how long to wait before restarting a failed stream.
+	RetryDelay time.Duration
+}
+
+// Snapshot records the generations of a set of tables before a query
+// is executed, so that a result that raced with a write is not cached.
+type Snapshot struct {
+	generation uint64
+	taken      time.Time
+	// invalidated is the last time one of the tables was invalidated
+	invalidated time.Time
+}
+
+type entry struct {
+	result     *sqltypes.Result
+	tables     []string
+	generation uint64
+	expires    time.Time
+	size       int64
+}
+
+type keyspaceState struct {
+	generation  uint64
+	invalidated time.Time
+	live        bool
+	started     bool
+}
+
+type tableStats struct {
+	Hits          int64
+	Misses        int64
+	Invalidations int64
+}
+
+// Cache is a result cache invalidated by binlog events.
+type Cache struct {
+	config   Config
+	streamer Streamer
+	entries  *cache.LRUCache
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu          sync.Mutex
+	tables      map[string]uint64
+	invalidated map[string]time.Time
+	keyspaces   map[string]*keyspaceState
+	stats       map[string]*tableStats
+	hits        int64
+	misses      int64
+}
+
+// now is replaced in tests.
+var now = time.Now
+
+// New creates a Cache. The stream of a keyspace is started the first
+// time a result from it is about to be cached.
+func New(config Config, streamer Streamer) *Cache {
+	if config.RetryDelay == 0 {
+		config.RetryDelay = 5 * time.Second
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Cache{
+		config:   config,
+		streamer: streamer,
+		entries: cache.NewLRUCache(config.MaxMemoryUsage, func(v interface{}) int64 {
+			return v.(*entry).size
+		}),
+		ctx:         ctx,
+		cancel:      cancel,
+		tables:      make(map[string]uint64),
+		invalidated: make(map[string]time.Time),
+		keyspaces:   make(map[string]*keyspaceState),
+		stats:       make(map[string]*tableStats),
+	}
+}
+
+// Close stops all streams.
+func (c *Cache) Close() {
+	c.cancel()
+	c.wg.Wait()
+}
+
+// TTL returns how long the results of a query may be cached. A positive
+// requested TTL comes from the query itself and wins; otherwise the
+// configured TTL is used if every table of the query opted in.
+func (c *Cache) TTL(requested time.Duration, optedIn bool) time.Duration {
+	if requested > 0 {
+		return requested
+	}
+	if !optedIn {
+		return 0
+	}
+	return c.config.TTL
+}
+
+// Get returns a copy of the result cached under key, if it is still valid.
+func (c *Cache) Get(key string, tables []string) (*sqltypes.Result, bool) {
+	v, ok := c.entries.Get(key)
+	if !ok {
+		c.recordLookup(tables, false)
+		return nil, false
+	}
+	e := v.(*entry)
+	c.mu.Lock()
+	valid := now().Before(e.expires) && c.generationLocked(e.tables) == e.generation
+	c.mu.Unlock()
+	if !valid {
+		c.entries.Delete(key)
+		c.recordLookup(tables, false)
+		return nil, false
+	}
+	c.recordLookup(tables, true)
+	return e.result.Copy(), true
+}
+
+// Snapshot must be called before executing a query whose result is to be
+// cached. It returns false if the result must not be cached because the
+// stream of one of the keyspaces is not running yet.
+func (c *Cache) Snapshot(tables []string) (Snapshot, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ok := true
+	for _, table := range tables {
+		ks := keyspaceOf(table)
+		state := c.keyspaceLocked(ks)
+		if !state.started {
+			state.started = true
+			c.wg.Add(1)
+			go c.watch(ks)
+		}
+		if !state.live {
+			ok = false
+		}
+	}
+	return Snapshot{
+		generation:  c.generationLocked(tables),
+		taken:       now(),
+		invalidated: c.invalidatedLocked(tables),
+	}, ok
+}
+
+// Set caches a copy of result under key, unless one of the tables has
+// changed since the snapshot was taken. The result was read from tablets
+// replicating with up to lag behind the primary: it is not cached either
+// if one of the tables was invalidated less than lag before the snapshot,
+// since the tablets might not have applied the change yet.
+func (c *Cache) Set(key string, snapshot Snapshot, tables []string, result *sqltypes.Result, ttl, lag time.Duration) {
+	c.mu.Lock()
+	current := c.generationLocked(tables)
+	c.mu.Unlock()
+	if current != snapshot.generation {
+		return
+	}
+	if lag > 0 && snapshot.invalidated.After(snapshot.taken.Add(-lag)) {
+		return
+	}
+	result = result.Copy()
+	c.entries.Set(key, &entry{
+		result:     result,
+		tables:     tables,
+		generation: snapshot.generation,
+		expires:    now().Add(ttl),
+		size:       result.CachedSize(true) + int64(len(key)),
+	})
+}
+
+// Invalidate drops all cached results that read from the table.
+func (c *Cache) Invalidate(keyspace, table string) {
+	name := keyspace + "." + table
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tables[name]++
+	c.invalidated[name] = now()
+	c.statsLocked(name).Invalidations++
+}
+
+// InvalidateKeyspace drops all cached results that read from the keyspace.
+func (c *Cache) InvalidateKeyspace(keyspace string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	state := c.keyspaceLocked(keyspace)
+	state.generation++
+	state.invalidated = now()
+	for name, stats := range c.stats {
+		if keyspaceOf(name) == keyspace {
+			stats.Invalidations++
+		}
+	}
+}
+
+func (c *Cache) watch(keyspace string) {
+	defer c.wg.Done()
+	for {
+		err := c.streamer(c.ctx, keyspace, func(events []*binlogdatapb.VEvent) error {
+			c.setLive(keyspace, true)
+			for _, event := range events {
+				switch event.Type {
+				case binlogdatapb.VEventType_ROW:
+					// vtgate qualifies the table names of the row events with their keyspace.
+					c.Invalidate(keyspace, strings.TrimPrefix(event.RowEvent.TableName, keyspace+"."))
+				case binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_JOURNAL:
+					c.InvalidateKeyspace(keyspace)
+				}
+			}
+			return nil
+		})
+		// Events may have been missed, nothing cached so far can be trusted.
+		c.setLive(keyspace, false)
+		c.InvalidateKeyspace(keyspace)
+		if c.ctx.Err() != nil {
+			return
+		}
+		log.Warningf("result cache: stream for keyspace %s ended, restarting in %v: %v", keyspace, c.config.RetryDelay, err)
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-time.After(c.config.RetryDelay):
+		}
+	}
+}
+
+func (c *Cache) setLive(keyspace string, live bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keyspaceLocked(keyspace).live = live
+}
+
+func (c *Cache) keyspaceLocked(keyspace string) *keyspaceState {
+	state, ok := c.keyspaces[keyspace]
+	if !ok {
+		state = &keyspaceState{}
+		c.keyspaces[keyspace] = state
+	}
+	return state
+}
+
+func (c *Cache) statsLocked(table string) *tableStats {
+	stats, ok := c.stats[table]
+	if !ok {
+		stats = &tableStats{}
+		c.stats[table] = stats
+	}
+	return stats
+}
+
+// generationLocked sums the generations of the tables and their keyspaces.
+// Generations only ever grow, so the sum changes whenever any of them does.
+func (c *Cache) generationLocked(tables []string) uint64 {
+	var generation uint64
+	for _, table := range tables {
+		generation += c.tables[table]
+		if state, ok := c.keyspaces[keyspaceOf(table)]; ok {
+			generation += state.generation
+		}
+	}
+	return generation
+}
+
+// invalidatedLocked returns the last time one of the tables or their keyspaces was invalidated.
+func (c *Cache) invalidatedLocked(tables []string) time.Time {
+	var invalidated time.Time
+	for _, table := range tables {
+		if at := c.invalidated[table]; at.After(invalidated) {
+			invalidated = at
+		}
+		if state, ok := c.keyspaces[keyspaceOf(table)]; ok && state.invalidated.After(invalidated) {
+			invalidated = state.invalidated
+		}
+	}
+	return invalidated
+}
+
+func (c *Cache) recordLookup(tables []string, hit bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if hit {
+		c.hits++
+	} else {
+		c.misses++
+	}
+	for _, table := range tables {
+		stats := c.statsLocked(table)
+		if hit {
+			stats.Hits++
+		} else {
+			stats.Misses++
+		}
+	}
+}
+
+func keyspaceOf(table string) string {
+	if i := strings.IndexByte(table, '.'); i >= 0 {
+		return table[:i]
+	}
+	return table
+}
+
+// TableStatus is the result cache status of a single table.
+type TableStatus struct {
+	Table         string
+	Hits          int64
+	Misses        int64
+	HitRate       float64
+	Invalidations int64
+}
+
+// Status is the status of the result cache, as shown on its debug page.
+type Status struct {
+	Entries     int
+	MemoryUsage int64
+	MaxMemory   int64
+	Evictions   int64
+	Hits        int64
+	Misses      int64
+	HitRate     float64
+	Keyspaces   map[string]bool
+	Tables      []TableStatus
+}
+
+// Status returns the current status of the cache.
+func (c *Cache) Status() *Status {
+	status := &Status{
+		Entries:     c.entries.Len(),
+		MemoryUsage: c.entries.UsedCapacity(),
+		MaxMemory:   c.entries.MaxCapacity(),
+		Evictions:   c.entries.Evictions(),
+		Keyspaces:   make(map[string]bool),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	status.Hits = c.hits
+	status.Misses = c.misses
+	status.HitRate = hitRate(c.hits, c.misses)
+	for ks, state := range c.keyspaces {
+		status.Keyspaces[ks] = state.live
+	}
+	for table, stats := range c.stats {
+		status.Tables = append(status.Tables, TableStatus{
+			Table:         table,
+			Hits:          stats.Hits,
+			Misses:        stats.Misses,
+			HitRate:       hitRate(stats.Hits, stats.Misses),
+			Invalidations: stats.Invalidations,
+		})
+	}
+	sort.Slice(status.Tables, func(i, j int) bool {
+		return status.Tables[i].Table < status.Tables[j].Table
+	})
+	return status
+}
+
+func hitRate(hits, misses int64) float64 {
+	if hits+misses == 0 {
+		return 0
+	}
+	return float64(hits) / float64(hits+misses)
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resultcache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/test/utils"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+)
+
+// fakeStreamer hands out the send function of every stream it serves,
+// and ends a stream when an error is pushed to it.
+type fakeStreamer struct {
+	sends chan func([]*binlogdatapb.VEvent) error
+	errs  chan error
+}
+
+func newFakeStreamer() *fakeStreamer {
+	return &fakeStreamer{
+		sends: make(chan func([]*binlogdatapb.VEvent) error, 10),
+		errs:  make(chan error, 10),
+	}
+}
+
+func (fs *fakeStreamer) stream(ctx context.Context, keyspace string, send func([]*binlogdatapb.VEvent) error) error {
+	fs.sends <- send
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-fs.errs:
+		return err
+	}
+}
+
+// start takes a snapshot to start the stream of ks, and marks it live.
+func (fs *fakeStreamer) start(t *testing.T, c *Cache, tables []string) func([]*binlogdatapb.VEvent) error {
+	t.Helper()
+	_, ok := c.Snapshot(tables)
+	require.False(t, ok, "stream cannot be live before it started")
+	send := <-fs.sends
+	require.NoError(t, send([]*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_HEARTBEAT}}))
+	return send
+}
+
+// rowEvent returns a row event of the table in keyspace ks, with the table name qualified like vtgate does.
+func rowEvent(table string) []*binlogdatapb.VEvent {
+	return []*binlogdatapb.VEvent{{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "ks." + table},
+	}}
+}
+
+func cacheResult(t *testing.T, c *Cache, key string, tables []string, result *sqltypes.Result) {
+	t.Helper()
+	snapshot, ok := c.Snapshot(tables)
+	require.True(t, ok)
+	c.Set(key, snapshot, tables, result, time.Minute, 0)
+}
+
+func TestResultCacheTTL(t *testing.T) {
+	c := New(Config{MaxMemoryUsage: 1 << 20, TTL: time.Minute}, newFakeStreamer().stream)
+	defer c.Close()
+
+	assert.Equal(t, time.Minute, c.TTL(0, true))
+	assert.Equal(t, time.Duration(0), c.TTL(0, false))
+	assert.Equal(t, time.Second, c.TTL(time.Second, false))
+	assert.Equal(t, time.Second, c.TTL(time.Second, true))
+}
+
+func TestResultCacheInvalidation(t *testing.T) {
+	fs := newFakeStreamer()
+	c := New(Config{MaxMemoryUsage: 1 << 20}, fs.stream)
+	defer c.Close()
+
+	t1 := []string{"ks.t1"}
+	t2 := []string{"ks.t2"}
+	send := fs.start(t, c, t1)
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+	cacheResult(t, c, "q1", t1, result)
+	cacheResult(t, c, "q2", t2, result)
+
+	got, ok := c.Get("q1", t1)
+	require.True(t, ok)
+	utils.MustMatch(t, result, got)
+
+	// A write to t1 only invalidates the results that read from t1.
+	require.NoError(t, send(rowEvent("t1")))
+	_, ok = c.Get("q1", t1)
+	assert.False(t, ok)
+	_, ok = c.Get("q2", t2)
+	assert.True(t, ok)
+
+	// A DDL invalidates the whole keyspace.
+	require.NoError(t, send([]*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_DDL}}))
+	_, ok = c.Get("q2", t2)
+	assert.False(t, ok)
+
+	status := c.Status()
+	assert.EqualValues(t, 2, status.Hits)
+	assert.EqualValues(t, 2, status.Misses)
+	assert.Equal(t, map[string]bool{"ks": true}, status.Keyspaces)
+	require.Len(t, status.Tables, 2)
+	assert.Equal(t, TableStatus{Table: "ks.t1", Hits: 1, Misses: 1, HitRate: 0.5, Invalidations: 2}, status.Tables[0])
+}
+
+func TestResultCacheWriteDuringExecution(t *testing.T) {
+	fs := newFakeStreamer()
+	c := New(Config{MaxMemoryUsage: 1 << 20}, fs.stream)
+	defer c.Close()
+
+	tables := []string{"ks.t1"}
+	send := fs.start(t, c, tables)
+	snapshot, ok := c.Snapshot(tables)
+	require.True(t, ok)
+	require.NoError(t, send(rowEvent("t1")))
+	c.Set("q1", snapshot, tables, &sqltypes.Result{}, time.Minute, 0)
+	_, ok = c.Get("q1", tables)
+	assert.False(t, ok, "a result that raced with a write must not be cached")
+}
+
+func TestResultCacheReplicationLag(t *testing.T) {
+	fs := newFakeStreamer()
+	c := New(Config{MaxMemoryUsage: 1 << 20}, fs.stream)
+	defer c.Close()
+
+	tables := []string{"ks.t1"}
+	send := fs.start(t, c, tables)
+	defer func() { now = time.Now }()
+	start := time.Now()
+	now = func() time.Time { return start }
+
+	// Nothing was invalidated yet, lagging tablets can't miss a change.
+	snapshot, ok := c.Snapshot(tables)
+	require.True(t, ok)
+	c.Set("q1", snapshot, tables, &sqltypes.Result{}, time.Minute, 10*time.Second)
+	_, ok = c.Get("q1", tables)
+	assert.True(t, ok)
+
+	require.NoError(t, send(rowEvent("t1")))
+	now = func() time.Time { return start.Add(5 * time.Second) }
+	snapshot, ok = c.Snapshot(tables)
+	require.True(t, ok)
+	c.Set("q1", snapshot, tables, &sqltypes.Result{}, time.Minute, 10*time.Second)
+	_, ok = c.Get("q1", tables)
+	assert.False(t, ok, "tablets lagging behind the invalidation may have served a stale result")
+
+	// The primary, or replicas that caught up with the invalidation, can be cached.
+	c.Set("q1", snapshot, tables, &sqltypes.Result{}, time.Minute, 0)
+	_, ok = c.Get("q1", tables)
+	assert.True(t, ok)
+
+	now = func() time.Time { return start.Add(15 * time.Second) }
+	snapshot, ok = c.Snapshot(tables)
+	require.True(t, ok)
+	c.Set("q2", snapshot, tables, &sqltypes.Result{}, time.Minute, 10*time.Second)
+	_, ok = c.Get("q2", tables)
+	assert.True(t, ok)
+}
+
+func TestResultCacheExpiry(t *testing.T) {
+	fs := newFakeStreamer()
+	c := New(Config{MaxMemoryUsage: 1 << 20}, fs.stream)
+	defer c.Close()
+
+	tables := []string{"ks.t1"}
+	fs.start(t, c, tables)
+	cacheResult(t, c, "q1", tables, &sqltypes.Result{})
+
+	defer func() { now = time.Now }()
+	now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	_, ok := c.Get("q1", tables)
+	assert.False(t, ok)
+}
+
+func TestResultCacheStreamFailure(t *testing.T) {
+	fs := newFakeStreamer()
+	c := New(Config{MaxMemoryUsage: 1 << 20, RetryDelay: time.Millisecond}, fs.stream)
+	defer c.Close()
+
+	tables := []string{"ks.t1"}
+	fs.start(t, c, tables)
+	cacheResult(t, c, "q1", tables, &sqltypes.Result{})
+
+	fs.errs <- errors.New("stream broke")
+	// The stream is restarted, but until it delivers events nothing is cached
+	// and nothing cached before the failure is served.
+	send := <-fs.sends
+	_, ok := c.Get("q1", tables)
+	assert.False(t, ok)
+	_, ok = c.Snapshot(tables)
+	assert.False(t, ok)
+
+	require.NoError(t, send(nil))
+	_, ok = c.Snapshot(tables)
+	assert.True(t, ok)
+}
+
+func TestResultCacheMemoryLimit(t *testing.T) {
+	fs := newFakeStreamer()
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1")
+	size := result.CachedSize(true) + 2
+	c := New(Config{MaxMemoryUsage: 2 * size}, fs.stream)
+	defer c.Close()
+
+	tables := []string{"ks.t1"}
+	fs.start(t, c, tables)
+	cacheResult(t, c, "q1", tables, result)
+	cacheResult(t, c, "q2", tables, result)
+	cacheResult(t, c, "q3", tables, result)
+
+	_, ok := c.Get("q1", tables)
+	assert.False(t, ok, "oldest entry should have been evicted")
+	_, ok = c.Get("q3", tables)
+	assert.True(t, ok)
+	status := c.Status()
+	assert.EqualValues(t, 1, status.Evictions)
+	assert.Equal(t, 2*size, status.MemoryUsage)
+}
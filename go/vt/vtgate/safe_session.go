@@ -23,6 +23,7 @@ import (
 
 	"google.golang.org/protobuf/proto"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -49,6 +50,10 @@ type SafeSession struct {
 	// this is a signal that found_rows has already been handles by the primitives,
 	// and doesn't have to be updated by the executor
 	foundRowsHandled bool
+
+	// writtenShards are the primaries the session committed writes to, whose GTIDs
+	// have not been added to the session's read after write GTID yet.
+	writtenShards []*querypb.Target
 	*vtgatepb.Session
 }
 
@@ -600,6 +605,79 @@ func (session *SafeSession) SetSessionTrackGtids(enable bool) {
 	session.ReadAfterWrite.SessionTrackGtids = enable
 }
 
+// readAfterWrite returns the GTID set a replica has to have applied before it may
+// answer a query of the session, and how many seconds it may wait for it.
+func (session *SafeSession) readAfterWrite() (gtid string, timeout float64) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.ReadAfterWrite.GetReadAfterWriteGtid(), session.ReadAfterWrite.GetReadAfterWriteTimeout()
+}
+
+// transactionTargets returns the primaries the session has an open transaction on.
+func (session *SafeSession) transactionTargets() []*querypb.Target {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	var targets []*querypb.Target
+	for _, sessions := range [][]*vtgatepb.Session_ShardSession{session.PreSessions, session.ShardSessions, session.PostSessions} {
+		for _, shardSession := range sessions {
+			if shardSession.TransactionId != 0 && shardSession.Target.GetTabletType() == topodatapb.TabletType_PRIMARY {
+				targets = append(targets, shardSession.Target)
+			}
+		}
+	}
+	return targets
+}
+
+// recordWrites remembers the primaries the session committed writes to, if the
+// session tracks the GTIDs of its writes.
+func (session *SafeSession) recordWrites(targets ...*querypb.Target) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.Session == nil || !session.ReadAfterWrite.GetSessionTrackGtids() {
+		return
+	}
+outer:
+	for _, target := range targets {
+		if target.GetTabletType() != topodatapb.TabletType_PRIMARY {
+			continue
+		}
+		for _, written := range session.writtenShards {
+			if written.Keyspace == target.Keyspace && written.Shard == target.Shard {
+				continue outer
+			}
+		}
+		session.writtenShards = append(session.writtenShards, target)
+	}
+}
+
+// takeWrittenShards returns and forgets the primaries recorded by recordWrites.
+func (session *SafeSession) takeWrittenShards() []*querypb.Target {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	targets := session.writtenShards
+	session.writtenShards = nil
+	return targets
+}
+
+// addReadAfterWriteGTID adds gtid to the GTID set the replicas have to have applied
+// before they may answer a query of the session.
+func (session *SafeSession) addReadAfterWriteGTID(gtid mysql.GTIDSet) error {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.ReadAfterWrite == nil {
+		session.ReadAfterWrite = &vtgatepb.ReadAfterWrite{}
+	}
+	if current := session.ReadAfterWrite.ReadAfterWriteGtid; current != "" {
+		pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, current)
+		if err != nil {
+			return err
+		}
+		gtid = gtid.Union(pos.GTIDSet)
+	}
+	session.ReadAfterWrite.ReadAfterWriteGtid = gtid.String()
+	return nil
+}
+
 func removeShard(tabletAlias *topodatapb.TabletAlias, sessions []*vtgatepb.Session_ShardSession) ([]*vtgatepb.Session_ShardSession, error) {
 	idx := -1
 	for i, session := range sessions {
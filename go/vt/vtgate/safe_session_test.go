@@ -22,6 +22,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql"
+
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
@@ -67,3 +69,23 @@ func TestPrequeries(t *testing.T) {
 		t.Errorf("got %v but wanted %v", preQueries, want)
 	}
 }
+
+func TestAddReadAfterWriteGTID(t *testing.T) {
+	session := NewSafeSession(&vtgatepb.Session{})
+	gtid := func(s string) mysql.GTIDSet {
+		pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, s)
+		require.NoError(t, err)
+		return pos.GTIDSet
+	}
+
+	require.NoError(t, session.addReadAfterWriteGTID(gtid("3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5")))
+	got, _ := session.readAfterWrite()
+	require.Equal(t, "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5", got)
+
+	require.NoError(t, session.addReadAfterWriteGTID(gtid("3e11fa47-71ca-11e1-9e33-c80aa9429562:6-8,4e11fa47-71ca-11e1-9e33-c80aa9429562:1")))
+	got, _ = session.readAfterWrite()
+	require.Equal(t, "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-8,4e11fa47-71ca-11e1-9e33-c80aa9429562:1", got)
+
+	session.ReadAfterWrite.ReadAfterWriteGtid = "bad"
+	require.Error(t, session.addReadAfterWriteGTID(gtid("3e11fa47-71ca-11e1-9e33-c80aa9429562:9")))
+}
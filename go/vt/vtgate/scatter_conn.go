@@ -225,7 +225,18 @@ func (stc *ScatterConn) ExecuteMultiShard(
 
 			switch info.actionNeeded {
 			case nothing:
-				innerqr, err = qs.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, info.transactionID, info.reservedID, opts)
+				bindVars := queries[i].BindVariables
+				if transactionID == 0 && reservedID == 0 {
+					bindVars = readAfterWriteBindVars(session, rs.Target, bindVars)
+				}
+				innerqr, err = qs.Execute(ctx, rs.Target, queries[i].Sql, bindVars, info.transactionID, info.reservedID, opts)
+				if isReadAfterWriteTimeout(err) {
+					// The replica has not caught up with the writes of the session, read from the primary instead.
+					innerqr, err = rs.Gateway.Execute(ctx, primaryTarget(rs.Target), queries[i].Sql, queries[i].BindVariables, 0, 0, opts)
+				}
+				if err == nil && autocommit {
+					session.recordWrites(rs.Target)
+				}
 				if err != nil {
 					retryRequest(func() {
 						// we seem to have lost our connection. it was a reserved connection, let's try to recreate it
@@ -394,7 +405,15 @@ func (stc *ScatterConn) StreamExecuteMulti(
 
 			switch info.actionNeeded {
 			case nothing:
-				err = qs.StreamExecute(ctx, rs.Target, query, bindVars[i], transactionID, reservedID, opts, callback)
+				bvs := bindVars[i]
+				if transactionID == 0 && reservedID == 0 {
+					bvs = readAfterWriteBindVars(session, rs.Target, bvs)
+				}
+				err = qs.StreamExecute(ctx, rs.Target, query, bvs, transactionID, reservedID, opts, callback)
+				if isReadAfterWriteTimeout(err) {
+					// The replica has not caught up with the writes of the session, read from the primary instead.
+					err = rs.Gateway.StreamExecute(ctx, primaryTarget(rs.Target), query, bindVars[i], 0, 0, opts, callback)
+				}
 				if err != nil {
 					retryRequest(func() {
 						// we seem to have lost our connection. it was a reserved connection, let's try to recreate it
@@ -428,3 +428,81 @@ func TestIsConnClosed(t *testing.T) {
 		})
 	}
 }
+
+func TestReadAfterWriteOnReplica(t *testing.T) {
+	keyspace := "TestReadAfterWriteOnReplica"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbcReplica := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	sbcPrimary := hc.AddTestTablet("aa", "1", 1, keyspace, "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+
+	gtid := "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5"
+	session := NewSafeSession(&vtgatepb.Session{
+		ReadAfterWrite: &vtgatepb.ReadAfterWrite{ReadAfterWriteGtid: gtid, ReadAfterWriteTimeout: 0.5},
+	})
+	rss := []*srvtopo.ResolvedShard{{
+		Target:  &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_REPLICA},
+		Gateway: sc.gateway,
+	}}
+	queries := []*querypb.BoundQuery{{
+		Sql:           "select id from t",
+		BindVariables: map[string]*querypb.BindVariable{"v": sqltypes.Int64BindVariable(1)},
+	}}
+
+	// the replica is asked to wait for the writes of the session.
+	_, errs := sc.ExecuteMultiShard(ctx, rss, queries, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	wantQueries := []*querypb.BoundQuery{{
+		Sql: "select id from t",
+		BindVariables: map[string]*querypb.BindVariable{
+			"v":                              sqltypes.Int64BindVariable(1),
+			sqltypes.BvReadAfterWriteGTID:    sqltypes.StringBindVariable(gtid),
+			sqltypes.BvReadAfterWriteTimeout: sqltypes.Float64BindVariable(0.5),
+		},
+	}}
+	utils.MustMatch(t, wantQueries, sbcReplica.Queries)
+	assert.Empty(t, sbcPrimary.Queries)
+	sbcReplica.Queries = nil
+
+	// the replica has not caught up, the query goes to the primary.
+	sbcReplica.EphemeralShardErr = vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.ReadAfterWriteTimeout)
+	_, errs = sc.ExecuteMultiShard(ctx, rss, queries, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	utils.MustMatch(t, wantQueries, sbcReplica.Queries)
+	utils.MustMatch(t, queries, sbcPrimary.Queries)
+	sbcReplica.Queries = nil
+	sbcPrimary.Queries = nil
+
+	// other errors are returned as is.
+	sbcReplica.EphemeralShardErr = vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "bad query")
+	_, errs = sc.ExecuteMultiShard(ctx, rss, queries, session, false, false)
+	require.EqualError(t, vterrors.Aggregate(errs), "target: TestReadAfterWriteOnReplica.0.replica: bad query")
+	assert.Empty(t, sbcPrimary.Queries)
+}
+
+func TestReadAfterWriteTracksWrites(t *testing.T) {
+	keyspace := "TestReadAfterWriteTracksWrites"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck(nil)
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_PRIMARY, true, 1, nil)
+
+	target := &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_PRIMARY}
+	rss := []*srvtopo.ResolvedShard{{Target: target, Gateway: sbc}}
+	queries := []*querypb.BoundQuery{{Sql: "update t set a = 1"}}
+
+	// writes are not tracked unless the session asks for it.
+	session := NewSafeSession(&vtgatepb.Session{})
+	_, errs := sc.ExecuteMultiShard(ctx, rss, queries, session, true, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	assert.Empty(t, session.takeWrittenShards())
+
+	session.SetSessionTrackGtids(true)
+	_, errs = sc.ExecuteMultiShard(ctx, rss, queries, session, true, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	_, errs = sc.ExecuteMultiShard(ctx, rss, queries, session, true, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	utils.MustMatch(t, []*querypb.Target{target}, session.takeWrittenShards())
+	assert.Empty(t, session.takeWrittenShards())
+}
@@ -18,6 +18,7 @@ package schema
 
 import (
 	"context"
+	"strings"
 	"sync"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
@@ -46,18 +48,25 @@ type (
 
 		mu     sync.Mutex
 		tables *tableMap
+		stats  map[keyspaceStr]map[tableNameStr]*vindexes.TableStatistics
 		ctx    context.Context
 		signal func() // a function that we'll call whenever we have new schema data
 
 		// map of keyspace currently tracked
 		tracked      map[keyspaceStr]*updateController
 		consumeDelay time.Duration
+
+		// statsRefreshInterval is how often the statistics of the tables are reloaded.
+		statsRefreshInterval time.Duration
 	}
 )
 
 // defaultConsumeDelay is the default time, the updateController will wait before checking the schema fetch request queue.
 const defaultConsumeDelay = 1 * time.Second
 
+// defaultStatsRefreshInterval is the default time between two reloads of the table statistics.
+const defaultStatsRefreshInterval = 5 * time.Minute
+
 // NewTracker creates the tracker object.
 func NewTracker(ch chan *discovery.TabletHealth, user *string) *Tracker {
 	ctx := context.Background()
@@ -70,18 +79,28 @@ func NewTracker(ch chan *discovery.TabletHealth, user *string) *Tracker {
 	return &Tracker{
 		ctx:          ctx,
 		ch:           ch,
-		tables:       &tableMap{m: map[keyspaceStr]map[tableNameStr][]vindexes.Column{}},
+		tables:       &tableMap{m: map[keyspaceStr]map[tableNameStr][]vindexes.Column{}, pk: map[keyspaceStr]map[tableNameStr][]sqlparser.ColIdent{}},
+		stats:        map[keyspaceStr]map[tableNameStr]*vindexes.TableStatistics{},
 		tracked:      map[keyspaceStr]*updateController{},
 		consumeDelay: defaultConsumeDelay,
+
+		statsRefreshInterval: defaultStatsRefreshInterval,
 	}
 }
 
+// SetStatisticsRefreshInterval sets how often the table statistics are reloaded.
+// It must be called before Start. A zero interval disables the reloads.
+func (t *Tracker) SetStatisticsRefreshInterval(interval time.Duration) {
+	t.statsRefreshInterval = interval
+}
+
 // LoadKeyspace loads the keyspace schema.
 func (t *Tracker) LoadKeyspace(conn queryservice.QueryService, target *querypb.Target) error {
 	res, err := conn.Execute(t.ctx, target, mysql.FetchTables, nil, 0, 0, nil)
 	if err != nil {
 		return err
 	}
+	stats := t.fetchStatistics(conn, target)
 	t.mu.Lock()
 	defer t.mu.Unlock()
 	// We must clear out any previous schema before loading it here as this is called
@@ -90,6 +109,9 @@ func (t *Tracker) LoadKeyspace(conn queryservice.QueryService, target *querypb.T
 	// tablet is simply restarted or potentially when we elect a new primary.
 	t.clearKeyspaceTables(target.Keyspace)
 	t.updateTables(target.Keyspace, res)
+	if stats != nil {
+		t.stats[target.Keyspace] = stats
+	}
 	t.tracked[target.Keyspace].setLoaded(true)
 	log.Infof("finished loading schema for keyspace %s. Found %d columns in total across the tables", target.Keyspace, len(res.Rows))
 	return nil
@@ -112,6 +134,20 @@ func (t *Tracker) Start() {
 			}
 		}
 	}(ctx, t)
+	if t.statsRefreshInterval > 0 {
+		go func(ctx context.Context, t *Tracker) {
+			ticker := time.NewTicker(t.statsRefreshInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					t.refreshStatistics()
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(ctx, t)
+	}
 }
 
 // getKeyspaceUpdateController returns the updateController for the given keyspace
@@ -168,6 +204,103 @@ func (t *Tracker) Tables(ks string) map[string][]vindexes.Column {
 	return m
 }
 
+// PrimaryKeys returns a map with the primary key columns for all known tables in the keyspace
+func (t *Tracker) PrimaryKeys(ks string) map[string][]sqlparser.ColIdent {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	m := t.tables.pk[ks]
+	if m == nil {
+		return map[string][]sqlparser.ColIdent{}
+	}
+
+	return m
+}
+
+// Statistics returns a map with the statistics for all known tables in the keyspace
+func (t *Tracker) Statistics(ks string) map[string]*vindexes.TableStatistics {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	m := t.stats[ks]
+	if m == nil {
+		return map[string]*vindexes.TableStatistics{}
+	}
+
+	return m
+}
+
+// fetchStatistics loads the row and cardinality estimates of the tables from the tablet.
+// The statistics are only used to compare the cost of plans, so a failure to load them
+// is logged and does not fail the schema tracking.
+func (t *Tracker) fetchStatistics(conn queryservice.QueryService, target *querypb.Target) map[tableNameStr]*vindexes.TableStatistics {
+	res, err := conn.Execute(t.ctx, target, mysql.BaseShowTableStatistics, nil, 0, 0, nil)
+	if err != nil {
+		log.Warningf("error fetching table statistics for keyspace %s: %v", target.Keyspace, err)
+		return nil
+	}
+	stats := make(map[tableNameStr]*vindexes.TableStatistics)
+	for _, row := range res.Rows {
+		if len(row) != 4 {
+			continue
+		}
+		tbl := row[0].ToString()
+		ts := stats[tbl]
+		if ts == nil {
+			ts = &vindexes.TableStatistics{}
+			stats[tbl] = ts
+		}
+		if rows, err := evalengine.ToUint64(row[1]); err == nil {
+			ts.RowCount = rows
+		}
+		if row[2].IsNull() {
+			continue
+		}
+		cardinality, err := evalengine.ToUint64(row[3])
+		if err != nil {
+			continue
+		}
+		if ts.ColumnCardinality == nil {
+			ts.ColumnCardinality = make(map[string]uint64)
+		}
+		ts.ColumnCardinality[strings.ToLower(row[2].ToString())] = cardinality
+	}
+	return stats
+}
+
+// refreshStatistics reloads the statistics of the loaded keyspaces from their primaries.
+// The row and cardinality estimates change as the data changes, which the tablets do not
+// signal, so they are reloaded periodically rather than only along with the schema.
+func (t *Tracker) refreshStatistics() {
+	t.mu.Lock()
+	var primaries []*discovery.TabletHealth
+	for _, ksUpdater := range t.tracked {
+		if th := ksUpdater.statisticsSource(); th != nil {
+			primaries = append(primaries, th)
+		}
+	}
+	t.mu.Unlock()
+
+	updated := false
+	for _, th := range primaries {
+		stats := t.fetchStatistics(th.Conn, th.Target)
+		if stats == nil {
+			continue
+		}
+		t.mu.Lock()
+		t.stats[th.Target.Keyspace] = stats
+		t.mu.Unlock()
+		updated = true
+	}
+
+	t.mu.Lock()
+	signal := t.signal
+	t.mu.Unlock()
+	if updated && signal != nil {
+		signal()
+	}
+}
+
 func (t *Tracker) updateSchema(th *discovery.TabletHealth) bool {
 	tablesUpdated := th.Stats.TableSchemaChanged
 	tables, err := sqltypes.BuildBindVariable(tablesUpdated)
@@ -184,9 +317,15 @@ func (t *Tracker) updateSchema(th *discovery.TabletHealth) bool {
 		return false
 	}
 
+	stats := t.fetchStatistics(th.Conn, th.Target)
+
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	if stats != nil {
+		t.stats[th.Target.Keyspace] = stats
+	}
+
 	// first we empty all prior schema. deleted tables will not show up in the result,
 	// so this is the only chance to delete
 	for _, tbl := range tablesUpdated {
@@ -202,12 +341,16 @@ func (t *Tracker) updateTables(keyspace string, res *sqltypes.Result) {
 		colName := row[1].ToString()
 		colType := row[2].ToString()
 		collation := row[3].ToString()
+		colKey := row[4].ToString()
 
 		cType := sqlparser.ColumnType{Type: colType}
 		col := vindexes.Column{Name: sqlparser.NewColIdent(colName), Type: cType.SQLType(), CollationName: collation}
 		cols := t.tables.get(keyspace, tbl)
 
 		t.tables.set(keyspace, tbl, append(cols, col))
+		if colKey == "PRI" {
+			t.tables.addPrimaryKey(keyspace, tbl, col.Name)
+		}
 	}
 }
 
@@ -233,7 +376,8 @@ func (t *Tracker) AddNewKeyspace(conn queryservice.QueryService, target *querypb
 }
 
 type tableMap struct {
-	m map[keyspaceStr]map[tableNameStr][]vindexes.Column
+	m  map[keyspaceStr]map[tableNameStr][]vindexes.Column
+	pk map[keyspaceStr]map[tableNameStr][]sqlparser.ColIdent
 }
 
 func (tm *tableMap) set(ks, tbl string, cols []vindexes.Column) {
@@ -253,7 +397,17 @@ func (tm *tableMap) get(ks, tbl string) []vindexes.Column {
 	return m[tbl]
 }
 
+func (tm *tableMap) addPrimaryKey(ks, tbl string, col sqlparser.ColIdent) {
+	m := tm.pk[ks]
+	if m == nil {
+		m = make(map[tableNameStr][]sqlparser.ColIdent)
+		tm.pk[ks] = m
+	}
+	m[tbl] = append(m[tbl], col)
+}
+
 func (tm *tableMap) delete(ks, tbl string) {
+	delete(tm.pk[ks], tbl)
 	m := tm.m[ks]
 	if m == nil {
 		return
@@ -267,5 +421,6 @@ func (tm *tableMap) delete(ks, tbl string) {
 func (t *Tracker) clearKeyspaceTables(ks string) {
 	if t.tables != nil && t.tables.m != nil {
 		delete(t.tables.m, ks)
+		delete(t.tables.pk, ks)
 	}
 }
@@ -51,8 +51,8 @@ func TestTracking(t *testing.T) {
 		Type:     target.TabletType,
 	}
 	fields := sqltypes.MakeTestFields(
-		"table_name|col_name|col_type|collation_name",
-		"varchar|varchar|varchar|varchar",
+		"table_name|col_name|col_type|collation_name|column_key",
+		"varchar|varchar|varchar|varchar|varchar",
 	)
 
 	type delta struct {
@@ -63,7 +63,7 @@ func TestTracking(t *testing.T) {
 		d0 = delta{
 			result: sqltypes.MakeTestResult(
 				fields,
-				"prior|id|int|",
+				"prior|id|int||PRI",
 			),
 			updTbl: []string{"prior"},
 		}
@@ -71,9 +71,9 @@ func TestTracking(t *testing.T) {
 		d1 = delta{
 			result: sqltypes.MakeTestResult(
 				fields,
-				"t1|id|int|",
-				"t1|name|varchar|utf8_bin",
-				"t2|id|varchar|utf8_bin",
+				"t1|id|int||PRI",
+				"t1|name|varchar|utf8_bin|",
+				"t2|id|varchar|utf8_bin|PRI",
 			),
 			updTbl: []string{"t1", "t2"},
 		}
@@ -81,9 +81,9 @@ func TestTracking(t *testing.T) {
 		d2 = delta{
 			result: sqltypes.MakeTestResult(
 				fields,
-				"t2|id|varchar|utf8_bin",
-				"t2|name|varchar|utf8_bin",
-				"t3|id|datetime|",
+				"t2|id|varchar|utf8_bin|PRI",
+				"t2|name|varchar|utf8_bin|",
+				"t3|id|datetime||",
 			),
 			updTbl: []string{"prior", "t1", "t2", "t3"},
 		}
@@ -91,7 +91,7 @@ func TestTracking(t *testing.T) {
 		d3 = delta{
 			result: sqltypes.MakeTestResult(
 				fields,
-				"t4|name|varchar|utf8_bin",
+				"t4|name|varchar|utf8_bin|",
 			),
 			updTbl: []string{"t4"},
 		}
@@ -183,7 +183,7 @@ func TestTracking(t *testing.T) {
 
 			require.False(t, waitTimeout(&wg, time.Second), "schema was updated but received no signal")
 
-			require.Equal(t, 1, len(sbc.StringQueries()))
+			require.Equal(t, []string{mysql.FetchTables, mysql.BaseShowTableStatistics}, sbc.StringQueries())
 
 			_, keyspacePresent := tracker.tracked[target.Keyspace]
 			require.Equal(t, true, keyspacePresent)
@@ -247,7 +247,7 @@ func TestTrackingUnHealthyTablet(t *testing.T) {
 		},
 	}
 
-	sbc.SetResults([]*sqltypes.Result{{}, {}, {}})
+	sbc.SetResults([]*sqltypes.Result{{}, {}, {}, {}, {}, {}})
 	for _, tcase := range tcases {
 		ch <- &discovery.TabletHealth{
 			Conn:    sbc,
@@ -260,7 +260,140 @@ func TestTrackingUnHealthyTablet(t *testing.T) {
 	}
 
 	require.False(t, waitTimeout(&wg, 5*time.Second), "schema was updated but received no signal")
-	require.Equal(t, []string{mysql.FetchTables, mysql.FetchUpdatedTables, mysql.FetchTables}, sbc.StringQueries())
+	require.Equal(t, []string{
+		mysql.FetchTables, mysql.BaseShowTableStatistics,
+		mysql.FetchUpdatedTables, mysql.BaseShowTableStatistics,
+		mysql.FetchTables, mysql.BaseShowTableStatistics,
+	}, sbc.StringQueries())
+}
+
+func TestTrackingStatistics(t *testing.T) {
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_PRIMARY,
+		Cell:       "aa",
+	}
+	tablet := &topodatapb.Tablet{
+		Keyspace: target.Keyspace,
+		Shard:    target.Shard,
+		Type:     target.TabletType,
+	}
+
+	sbc := sandboxconn.NewSandboxConn(tablet)
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("table_name|col_name|col_type|collation_name|column_key", "varchar|varchar|varchar|varchar|varchar"),
+			"t1|id|int||PRI",
+			"t1|name|varchar|utf8_bin|",
+			"t2|id|int||PRI",
+		),
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("table_name|table_rows|column_name|cardinality", "varchar|uint64|varchar|int64"),
+			"t1|1000|id|1000",
+			"t1|1000|Name|20",
+			"t2|10|null|null",
+		),
+	})
+
+	tracker := NewTracker(nil, nil)
+	tracker.tracked[target.Keyspace] = tracker.newUpdateController()
+	require.NoError(t, tracker.LoadKeyspace(sbc, target))
+
+	utils.MustMatch(t, map[string]*vindexes.TableStatistics{
+		"t1": {RowCount: 1000, ColumnCardinality: map[string]uint64{"id": 1000, "name": 20}},
+		"t2": {RowCount: 10},
+	}, tracker.Statistics("ks"))
+	require.Empty(t, tracker.Statistics("unknown"))
+}
+
+func TestTrackingStatisticsRefresh(t *testing.T) {
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_PRIMARY,
+		Cell:       "aa",
+	}
+	tablet := &topodatapb.Tablet{
+		Keyspace: target.Keyspace,
+		Shard:    target.Shard,
+		Type:     target.TabletType,
+	}
+	statsFields := sqltypes.MakeTestFields("table_name|table_rows|column_name|cardinality", "varchar|uint64|varchar|int64")
+
+	sbc := sandboxconn.NewSandboxConn(tablet)
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("table_name|col_name|col_type|collation_name|column_key", "varchar|varchar|varchar|varchar|varchar"),
+			"t1|id|int||PRI",
+		),
+		sqltypes.MakeTestResult(statsFields, "t1|1000|id|1000"),
+		sqltypes.MakeTestResult(statsFields, "t1|5000|id|5000"),
+	})
+
+	tracker := NewTracker(nil, nil)
+	signals := 0
+	tracker.RegisterSignalReceiver(func() {
+		signals++
+	})
+	ksUpdater := tracker.newUpdateController()
+	tracker.tracked[target.Keyspace] = ksUpdater
+	require.NoError(t, tracker.LoadKeyspace(sbc, target))
+
+	// the statistics are only reloaded from a healthy primary that was seen by the tracker
+	tracker.refreshStatistics()
+	require.Zero(t, signals)
+
+	ksUpdater.add(&discovery.TabletHealth{
+		Conn:    sbc,
+		Tablet:  tablet,
+		Target:  target,
+		Serving: true,
+		Stats:   &querypb.RealtimeStats{},
+	})
+	tracker.refreshStatistics()
+	require.Equal(t, 1, signals)
+	utils.MustMatch(t, map[string]*vindexes.TableStatistics{
+		"t1": {RowCount: 5000, ColumnCardinality: map[string]uint64{"id": 5000}},
+	}, tracker.Statistics("ks"))
+	require.Equal(t, []string{mysql.FetchTables, mysql.BaseShowTableStatistics, mysql.BaseShowTableStatistics}, sbc.StringQueries())
+}
+
+func TestTrackingPrimaryKeys(t *testing.T) {
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_PRIMARY,
+		Cell:       "aa",
+	}
+	tablet := &topodatapb.Tablet{
+		Keyspace: target.Keyspace,
+		Shard:    target.Shard,
+		Type:     target.TabletType,
+	}
+
+	sbc := sandboxconn.NewSandboxConn(tablet)
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("table_name|col_name|col_type|collation_name|column_key", "varchar|varchar|varchar|varchar|varchar"),
+			"t1|id|int||PRI",
+			"t1|user_id|int||MUL",
+			"t2|a|int||PRI",
+			"t2|b|int||PRI",
+			"t3|id|int||",
+		),
+		{},
+	})
+
+	tracker := NewTracker(nil, nil)
+	tracker.tracked[target.Keyspace] = tracker.newUpdateController()
+	require.NoError(t, tracker.LoadKeyspace(sbc, target))
+
+	utils.MustMatch(t, map[string][]sqlparser.ColIdent{
+		"t1": {sqlparser.NewColIdent("id")},
+		"t2": {sqlparser.NewColIdent("a"), sqlparser.NewColIdent("b")},
+	}, tracker.PrimaryKeys("ks"))
+	require.Empty(t, tracker.PrimaryKeys("unknown"))
 }
 
 func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
@@ -41,6 +41,10 @@ type (
 		signal         func()
 		loaded         bool
 
+		// primary is the last healthy primary tablet of the keyspace, from which the
+		// table statistics are reloaded.
+		primary *discovery.TabletHealth
+
 		// we'll only log a failed keyspace loading once
 		ignore bool
 	}
@@ -130,8 +134,10 @@ func (u *updateController) add(th *discovery.TabletHealth) {
 	// The connection will get reset and the tracker needs to reload the schema for the keyspace.
 	if !th.Serving {
 		u.loaded = false
+		u.primary = nil
 		return
 	}
+	u.primary = th
 
 	// If the keyspace schema is loaded and there is no schema change detected. Then there is nothing to process.
 	if len(th.Stats.TableSchemaChanged) == 0 && u.loaded {
@@ -156,6 +162,17 @@ func (u *updateController) add(th *discovery.TabletHealth) {
 	u.queue.items = append(u.queue.items, th)
 }
 
+// statisticsSource returns the primary tablet to reload the table statistics from,
+// or nil if the keyspace is not loaded.
+func (u *updateController) statisticsSource() *discovery.TabletHealth {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if !u.loaded || u.ignore {
+		return nil
+	}
+	return u.primary
+}
+
 func (u *updateController) setLoaded(loaded bool) {
 	u.mu.Lock()
 	defer u.mu.Unlock()
@@ -61,6 +61,13 @@ func newAnalyzer(dbName string, si SchemaInformation) *analyzer {
 
 // Analyze analyzes the parsed query.
 func Analyze(statement sqlparser.SelectStatement, currentDb string, si SchemaInformation) (*SemTable, error) {
+	// Common table expressions are analyzed and planned as derived tables.
+	// The planners expand them on a copy of the statement before analyzing it,
+	// so this only rewrites the statements of the other callers in place.
+	if err := expandCTEs(statement); err != nil {
+		return nil, err
+	}
+
 	analyzer := newAnalyzer(currentDb, si)
 
 	// Analysis for initial scope
@@ -218,6 +225,11 @@ func isParentSelect(cursor *sqlparser.Cursor) bool {
 	return isSelect
 }
 
+func isParentSelectStatement(cursor *sqlparser.Cursor) bool {
+	_, isSelect := cursor.Parent().(sqlparser.SelectStatement)
+	return isSelect
+}
+
 type originable interface {
 	tableSetFor(t *sqlparser.AliasedTableExpr) TableSet
 	depsForExpr(expr sqlparser.Expr) (direct, recursive TableSet, typ *Type)
@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package semantics
+
+import (
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// ExpandCTEs returns the statement with the common table expressions of the statement, and of any statement
+// nested in it, rewritten into derived tables. Every reference to a CTE is replaced by a copy of its subquery,
+// so the rest of the analysis and the planners only have to deal with derived tables.
+// The given statement is not modified: if it has any CTE, a rewritten copy of it is returned.
+// Recursive CTEs can't be expressed as derived tables, and an error is returned when one is found.
+func ExpandCTEs(stmt sqlparser.Statement) (sqlparser.Statement, error) {
+	if !hasCTEs(stmt) {
+		return stmt, nil
+	}
+	stmt = sqlparser.CloneStatement(stmt)
+	if err := expandCTEs(stmt); err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// hasCTEs returns true if the statement, or any statement nested in it, has a WITH clause
+func hasCTEs(stmt sqlparser.Statement) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		found = found || getWith(node) != nil
+		return !found, nil
+	}, stmt)
+	return found
+}
+
+// expandCTEs rewrites the common table expressions of the statement into derived tables, in place
+func expandCTEs(stmt sqlparser.Statement) error {
+	var err error
+	_ = sqlparser.Rewrite(stmt, nil, func(cursor *sqlparser.Cursor) bool {
+		// the rewriter works bottom up, so any WITH clause in the subtree
+		// of the current node has already been expanded when we get here
+		with := getWith(cursor.Node())
+		if with == nil {
+			return true
+		}
+		if with.Recursive {
+			err = vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression outside of the outermost select of the Gen4 planner")
+			return false
+		}
+		// a CTE can reference the CTEs that are defined before it
+		for i, cte := range with.CTEs {
+			replaceCTEReferences(cte.Subquery.Select, with.CTEs[:i])
+		}
+		setWith(cursor.Node(), nil)
+		replaceCTEReferences(cursor.Node(), with.CTEs)
+		return true
+	})
+	return err
+}
+
+// replaceCTEReferences replaces all the table references to the given CTEs with derived tables
+func replaceCTEReferences(node sqlparser.SQLNode, ctes []*sqlparser.CommonTableExpr) {
+	if len(ctes) == 0 {
+		return
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		aliasedTable, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		tableName, ok := aliasedTable.Expr.(sqlparser.TableName)
+		if !ok || !tableName.Qualifier.IsEmpty() {
+			return true, nil
+		}
+		cte := findCTE(ctes, tableName.Name)
+		if cte == nil {
+			return true, nil
+		}
+		aliasedTable.Expr = &sqlparser.DerivedTable{Select: sqlparser.CloneSelectStatement(cte.Subquery.Select)}
+		if aliasedTable.As.IsEmpty() {
+			aliasedTable.As = cte.TableID
+		}
+		if len(aliasedTable.Columns) == 0 {
+			aliasedTable.Columns = sqlparser.CloneColumns(cte.Columns)
+		}
+		return false, nil
+	}, node)
+}
+
+// findCTE returns the last CTE with the given name, or nil if there is none
+func findCTE(ctes []*sqlparser.CommonTableExpr, name sqlparser.TableIdent) *sqlparser.CommonTableExpr {
+	for i := len(ctes) - 1; i >= 0; i-- {
+		if ctes[i].TableID.String() == name.String() {
+			return ctes[i]
+		}
+	}
+	return nil
+}
+
+func getWith(node sqlparser.SQLNode) *sqlparser.With {
+	switch node := node.(type) {
+	case *sqlparser.Select:
+		return node.With
+	case *sqlparser.Union:
+		return node.With
+	case *sqlparser.Update:
+		return node.With
+	case *sqlparser.Delete:
+		return node.With
+	}
+	return nil
+}
+
+func setWith(node sqlparser.SQLNode, with *sqlparser.With) {
+	switch node := node.(type) {
+	case *sqlparser.Select:
+		node.With = with
+	case *sqlparser.Union:
+		node.With = with
+	case *sqlparser.Update:
+		node.With = with
+	case *sqlparser.Delete:
+		node.With = with
+	}
+}
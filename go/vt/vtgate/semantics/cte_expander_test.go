@@ -0,0 +1,78 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package semantics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestExpandCTEs(t *testing.T) {
+	tcases := []struct {
+		sql      string
+		expected string
+		err      string
+	}{{
+		sql:      "with x as (select id from t1) select * from x",
+		expected: "select * from (select id from t1) as x",
+	}, {
+		sql:      "with x(a, b) as (select id, col from t1) select a from x as y where b = 1",
+		expected: "select a from (select id, col from t1) as y(a, b) where b = 1",
+	}, {
+		sql:      "with x as (select id from t1), y as (select id from x) select * from x join y on x.id = y.id",
+		expected: "select * from (select id from t1) as x join (select id from (select id from t1) as x) as y on x.id = y.id",
+	}, {
+		sql:      "with x as (select id from t1) select * from t2 where id in (select id from x)",
+		expected: "select * from t2 where id in (select id from (select id from t1) as x)",
+	}, {
+		sql:      "with x as (select id from t1) select * from ks.x",
+		expected: "select * from ks.x",
+	}, {
+		// the inner CTE shadows the outer one
+		sql:      "with x as (select id from t1) select * from (with x as (select id from t2) select id from x) as dt, x",
+		expected: "select * from (select id from (select id from t2) as x) as dt, (select id from t1) as x",
+	}, {
+		sql:      "with x as (select id from t1) select id from x union select id from x",
+		expected: "select id from (select id from t1) as x union select id from (select id from t1) as x",
+	}, {
+		sql:      "with x as (select id from t1) delete from t2 where id in (select id from x)",
+		expected: "delete from t2 where id in (select id from (select id from t1) as x)",
+	}, {
+		sql: "with recursive x as (select 1 as n union all select n + 1 from x where n < 10) select * from x",
+		err: "unsupported: recursive common table expression outside of the outermost select of the Gen4 planner",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.sql, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tcase.sql)
+			require.NoError(t, err)
+			original := sqlparser.String(stmt)
+			expanded, err := ExpandCTEs(stmt)
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tcase.expected, sqlparser.String(expanded))
+			// the original statement is left untouched
+			assert.Equal(t, original, sqlparser.String(stmt))
+		})
+	}
+}
@@ -91,6 +91,10 @@ func (s *scoper) down(cursor *sqlparser.Cursor) error {
 		}
 		wScope.tables = []TableInfo{createVTableInfoForExpressions(node, s.currentScope().tables, s.org)}
 	case sqlparser.OrderBy:
+		if !isParentSelectStatement(cursor) {
+			// the ORDER BY of a window specification uses the scope of the surrounding SELECT
+			break
+		}
 		err := s.createSpecialScopePostProjection(cursor.Parent())
 		if err != nil {
 			return err
@@ -102,6 +106,9 @@ func (s *scoper) down(cursor *sqlparser.Cursor) error {
 			}
 		}
 	case sqlparser.GroupBy:
+		if !isParentSelectStatement(cursor) {
+			break
+		}
 		err := s.createSpecialScopePostProjection(cursor.Parent())
 		if err != nil {
 			return err
@@ -139,8 +146,12 @@ func keepIntLiteral(e sqlparser.Expr) *sqlparser.Literal {
 func (s *scoper) up(cursor *sqlparser.Cursor) error {
 	node := cursor.Node()
 	switch node := node.(type) {
-	case *sqlparser.Select, sqlparser.OrderBy, sqlparser.GroupBy:
+	case *sqlparser.Select:
 		s.popScope()
+	case sqlparser.OrderBy, sqlparser.GroupBy:
+		if isParentSelectStatement(cursor) {
+			s.popScope()
+		}
 	case *sqlparser.Where:
 		if node.Type != sqlparser.HavingClause {
 			break
@@ -274,7 +274,7 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 			// or if a reparent operation is in progress.
 			if kev := gw.kev; kev != nil {
 				if kev.TargetIsBeingResharded(target) {
-					err = vterrors.Errorf(vtrpcpb.Code_CLUSTER_EVENT, "current keyspace is being resharded")
+					err = vterrors.New(vtrpcpb.Code_CLUSTER_EVENT, vterrors.KeyspaceResharding)
 					continue
 				}
 				if kev.PrimaryIsNotServing(target) {
@@ -76,10 +76,17 @@ func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
 	case vtgatepb.TransactionMode_UNSPECIFIED:
 		twopc = txc.mode == vtgatepb.TransactionMode_TWOPC
 	}
+	written := session.transactionTargets()
+	var err error
 	if twopc {
-		return txc.commit2PC(ctx, session)
+		err = txc.commit2PC(ctx, session)
+	} else {
+		err = txc.commitNormal(ctx, session)
+	}
+	if err == nil {
+		session.recordWrites(written...)
 	}
-	return txc.commitNormal(ctx, session)
+	return err
 }
 
 func (txc *TxConn) queryService(alias *topodatapb.TabletAlias) (queryservice.QueryService, error) {
@@ -100,6 +100,7 @@ type vcursorImpl struct {
 	collation      collations.ID
 
 	ignoreMaxMemoryRows bool
+	memoryBudget        *engine.MemoryBudget
 	vschema             *vindexes.VSchema
 	vm                  VSchemaOperator
 	semTable            *semantics.SemTable
@@ -203,6 +204,14 @@ func (vc *vcursorImpl) ExceedsMaxMemoryRows(numRows int) bool {
 	return !vc.ignoreMaxMemoryRows && numRows > *maxMemoryRows
 }
 
+// MemoryBudget returns the memory budget of the query, or nil if spilling to disk is disabled.
+func (vc *vcursorImpl) MemoryBudget() *engine.MemoryBudget {
+	if vc.memoryBudget == nil && *queryMemoryBudget > 0 {
+		vc.memoryBudget = engine.NewMemoryBudget(*queryMemoryBudget, *spillDir)
+	}
+	return vc.memoryBudget
+}
+
 // SetIgnoreMaxMemoryRows sets the ignoreMaxMemoryRows value.
 func (vc *vcursorImpl) SetIgnoreMaxMemoryRows(ignoreMaxMemoryRows bool) {
 	vc.ignoreMaxMemoryRows = ignoreMaxMemoryRows
@@ -651,6 +660,31 @@ func (vc *vcursorImpl) SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error {
 	return onlineDDl.WriteTopo(vc.ctx, conn, schema.MigrationRequestsPath())
 }
 
+// SubmitMaterializedView implements the VCursor interface
+func (vc *vcursorImpl) SubmitMaterializedView(mv *schema.MaterializedView) error {
+	if vc.topoServer == nil {
+		return vterrors.New(vtrpcpb.Code_INTERNAL, "Unable to apply materialized view DDL toposerver unavailable, ensure this vtgate is not using filtered keyspaces")
+	}
+	conn, err := vc.topoServer.ConnForCell(vc.ctx, topo.GlobalCell)
+	if err != nil {
+		return err
+	}
+	// vtctld picks up the request and creates, drops or refreshes the workflow
+	return mv.WriteTopo(vc.ctx, conn, schema.MaterializedViewRequestsPath())
+}
+
+// FailedMaterializedViews implements the VCursor interface
+func (vc *vcursorImpl) FailedMaterializedViews(keyspace string) ([]*schema.MaterializedView, error) {
+	if vc.topoServer == nil {
+		return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "Unable to read failed materialized view requests toposerver unavailable, ensure this vtgate is not using filtered keyspaces")
+	}
+	conn, err := vc.topoServer.ConnForCell(vc.ctx, topo.GlobalCell)
+	if err != nil {
+		return nil, err
+	}
+	return schema.ReadMaterializedViewFailuresTopo(vc.ctx, conn, keyspace)
+}
+
 func commentedShardQueries(shardQueries []*querypb.BoundQuery, marginComments sqlparser.MarginComments) []*querypb.BoundQuery {
 	if marginComments.Leading == "" && marginComments.Trailing == "" {
 		return shardQueries
@@ -28,6 +28,7 @@ var (
 	_ SingleColumn = (*Binary)(nil)
 	_ Reversible   = (*Binary)(nil)
 	_ Hashing      = (*Binary)(nil)
+	_ Ordered      = (*Binary)(nil)
 )
 
 // Binary is a vindex that converts binary bits to a keyspace id.
@@ -86,6 +87,18 @@ func (vind *Binary) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination
 	return out, nil
 }
 
+// MapRange returns the key range holding the ids between low and high.
+// Only string bounds are used, since MySQL compares the column as a number otherwise.
+func (vind *Binary) MapRange(_ VCursor, low, high sqltypes.Value) (key.Destination, error) {
+	hash := func(id sqltypes.Value) ([]byte, error) {
+		if !id.IsText() && !id.IsBinary() {
+			return nil, fmt.Errorf("Binary.MapRange: not a string bound: %v", id)
+		}
+		return vind.Hash(id)
+	}
+	return mapOrderedRange(hash, low, high), nil
+}
+
 func (vind *Binary) Hash(id sqltypes.Value) ([]byte, error) {
 	return id.ToBytes()
 }
@@ -28,6 +28,7 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var binOnlyVindex SingleColumn
@@ -102,3 +103,15 @@ func TestBinaryReverseMap(t *testing.T) {
 		t.Errorf("ReverseMap(): %v, want %s", err, wantErr)
 	}
 }
+
+func TestBinaryMapRange(t *testing.T) {
+	ordered := binOnlyVindex.(Ordered)
+	got, err := ordered.MapRange(nil, sqltypes.NewVarBinary("a"), sqltypes.NewVarChar("b"))
+	require.NoError(t, err)
+	assert.Equal(t, key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("a"), End: []byte("b\x00")}}, got)
+
+	// MySQL compares strings with numbers as numbers, which is not the order of the keyspace ids
+	got, err = ordered.MapRange(nil, sqltypes.NewInt64(10), sqltypes.NewVarChar("b"))
+	require.NoError(t, err)
+	assert.Equal(t, key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{End: []byte("b\x00")}}, got)
+}
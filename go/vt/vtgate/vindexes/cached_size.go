@@ -348,6 +348,22 @@ func (cached *NumericStaticMap) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *Range) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	// field splits []int64
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.splits)) * int64(8))
+	}
+	return size
+}
 func (cached *RegionExperimental) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -406,7 +422,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(176)
+		size += int64(216)
 	}
 	// field Type string
 	size += hack.RuntimeAllocSize(int64(len(cached.Type)))
@@ -448,6 +464,53 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	{
 		size += hack.RuntimeAllocSize(int64(cap(cached.Pinned)))
 	}
+	// field Statistics *vitess.io/vitess/go/vt/vtgate/vindexes.TableStatistics
+	size += cached.Statistics.CachedSize(true)
+	// field PrimaryKey []vitess.io/vitess/go/vt/sqlparser.ColIdent
+	{
+		size += hack.RuntimeAllocSize(int64(cap(cached.PrimaryKey)) * int64(40))
+		for _, elem := range cached.PrimaryKey {
+			size += elem.CachedSize(false)
+		}
+	}
+	return size
+}
+func (cached *TableStatistics) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field ColumnCardinality map[string]uint64
+	if cached.ColumnCardinality != nil {
+		size += int64(48)
+		hmap := reflect.ValueOf(cached.ColumnCardinality)
+		numBuckets := int(math.Pow(2, float64((*(*uint8)(unsafe.Pointer(hmap.Pointer() + uintptr(9)))))))
+		numOldBuckets := (*(*uint16)(unsafe.Pointer(hmap.Pointer() + uintptr(10))))
+		size += hack.RuntimeAllocSize(int64(numOldBuckets * 208))
+		if len(cached.ColumnCardinality) > 0 || numBuckets > 1 {
+			size += hack.RuntimeAllocSize(int64(numBuckets * 208))
+		}
+		for k := range cached.ColumnCardinality {
+			size += hack.RuntimeAllocSize(int64(len(k)))
+		}
+	}
+	return size
+}
+func (cached *TimeBucket) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field name string
+	size += hack.RuntimeAllocSize(int64(len(cached.name)))
+	// field interval string
+	size += hack.RuntimeAllocSize(int64(len(cached.interval)))
 	return size
 }
 func (cached *UnicodeLooseMD5) CachedSize(alloc bool) int64 {
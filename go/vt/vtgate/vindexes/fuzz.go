@@ -90,7 +90,9 @@ var availableVindexes = []string{"binary",
 	"unicode_loose_xxhash",
 	"reverse_bits",
 	"region_json",
-	"null"}
+	"null",
+	"range",
+	"time_bucket"}
 
 // FuzzVindex implements the vindexes fuzzer
 func FuzzVindex(data []byte) int {
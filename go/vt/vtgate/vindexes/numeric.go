@@ -31,6 +31,7 @@ var (
 	_ SingleColumn = (*Numeric)(nil)
 	_ Reversible   = (*Numeric)(nil)
 	_ Hashing      = (*Numeric)(nil)
+	_ Ordered      = (*Numeric)(nil)
 )
 
 // Numeric defines a bit-pattern mapping of a uint64 to the KeyspaceId.
@@ -104,6 +105,11 @@ func (*Numeric) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error)
 	return reverseIds, nil
 }
 
+// MapRange returns the key range holding the ids between low and high.
+func (vind *Numeric) MapRange(_ VCursor, low, high sqltypes.Value) (key.Destination, error) {
+	return mapOrderedRange(vind.Hash, low, high), nil
+}
+
 func (*Numeric) Hash(id sqltypes.Value) ([]byte, error) {
 	num, err := evalengine.ToUint64(id)
 	if err != nil {
@@ -25,6 +25,7 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var numeric SingleColumn
@@ -105,3 +106,38 @@ func TestNumericReverseMapBadData(t *testing.T) {
 		t.Errorf("numeric.Map: %v, want %v", err, want)
 	}
 }
+
+func TestNumericMapRange(t *testing.T) {
+	ordered := numeric.(Ordered)
+	tcases := []struct {
+		low, high sqltypes.Value
+		want      key.Destination
+	}{{
+		low:  sqltypes.NewInt64(1),
+		high: sqltypes.NewInt64(2),
+		want: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			Start: []byte("\x00\x00\x00\x00\x00\x00\x00\x01"),
+			End:   []byte("\x00\x00\x00\x00\x00\x00\x00\x02\x00"),
+		}},
+	}, {
+		low:  sqltypes.NULL,
+		high: sqltypes.NewUint64(0xff00000000000000),
+		want: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{
+			End: []byte("\xff\x00\x00\x00\x00\x00\x00\x00\x00"),
+		}},
+	}, {
+		// bounds that can't be mapped leave the range open
+		low:  sqltypes.NewInt64(-1),
+		high: sqltypes.NewFloat64(1.5),
+		want: key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{}},
+	}, {
+		low:  sqltypes.NewInt64(2),
+		high: sqltypes.NewInt64(1),
+		want: key.DestinationNone{},
+	}}
+	for _, tcase := range tcases {
+		got, err := ordered.MapRange(nil, tcase.low, tcase.high)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, got, "%v - %v", tcase.low, tcase.high)
+	}
+}
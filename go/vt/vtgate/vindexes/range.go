@@ -0,0 +1,193 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var (
+	_ SingleColumn = (*Range)(nil)
+	_ Reversible   = (*Range)(nil)
+	_ Hashing      = (*Range)(nil)
+	_ Ordered      = (*Range)(nil)
+)
+
+// Range maps integer values to keyspace ids using explicit split points.
+// N split points define N+1 buckets: bucket 0 holds the values below the first
+// split point, and bucket i holds the values from split point i-1 up to,
+// but not including, split point i.
+// The buckets are spread evenly over the keyspace id space, so that a keyspace
+// sharded into N+1 even shards (-40, 40-80, 80-c0, c0- for 3 split points)
+// gets exactly one bucket per shard.
+// The keyspace id is the start of the bucket followed by the value itself,
+// which keeps the mapping order-preserving. It's Unique and Reversible.
+type Range struct {
+	name   string
+	splits []int64
+}
+
+// NewRange creates a Range vindex.
+// The split_points parameter is a comma-separated list of strictly increasing integers.
+func NewRange(name string, params map[string]string) (Vindex, error) {
+	splits, err := parseSplitPoints(params["split_points"])
+	if err != nil {
+		return nil, err
+	}
+	return &Range{name: name, splits: splits}, nil
+}
+
+func parseSplitPoints(param string) ([]int64, error) {
+	if strings.TrimSpace(param) == "" {
+		return nil, fmt.Errorf("range: split_points is required")
+	}
+	var splits []int64
+	for _, part := range strings.Split(param, ",") {
+		split, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("range: invalid split point %q: %v", part, err)
+		}
+		if len(splits) > 0 && split <= splits[len(splits)-1] {
+			return nil, fmt.Errorf("range: split points must be strictly increasing: %d follows %d", split, splits[len(splits)-1])
+		}
+		splits = append(splits, split)
+	}
+	return splits, nil
+}
+
+// String returns the name of the vindex.
+func (vind *Range) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this vindex as 1.
+func (*Range) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (*Range) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (*Range) NeedsVCursor() bool {
+	return false
+}
+
+// Verify returns true if ids and ksids match.
+func (vind *Range) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, 0, len(ids))
+	for i, id := range ids {
+		ksid, err := vind.Hash(id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, bytes.Equal(ksid, ksids[i]))
+	}
+	return out, nil
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *Range) Map(_ VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(ids))
+	for _, id := range ids {
+		ksid, err := vind.Hash(id)
+		if err != nil {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		out = append(out, key.DestinationKeyspaceID(ksid))
+	}
+	return out, nil
+}
+
+// ReverseMap returns the associated ids for the ksids.
+func (*Range) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
+	reverseIds := make([]sqltypes.Value, len(ksids))
+	for i, ksid := range ksids {
+		value, err := bucketValue(ksid)
+		if err != nil {
+			return nil, fmt.Errorf("range: %v", err)
+		}
+		reverseIds[i] = sqltypes.NewInt64(value)
+	}
+	return reverseIds, nil
+}
+
+// MapRange returns the key range holding the ids between low and high.
+func (vind *Range) MapRange(_ VCursor, low, high sqltypes.Value) (key.Destination, error) {
+	return mapOrderedRange(vind.Hash, low, high), nil
+}
+
+// Hash returns the keyspace id of the bucket the id falls in.
+func (vind *Range) Hash(id sqltypes.Value) ([]byte, error) {
+	value, err := evalengine.ToInt64(id)
+	if err != nil {
+		return nil, err
+	}
+	return bucketKeyspaceID(vind.bucket(value), len(vind.splits)+1, value), nil
+}
+
+// bucket returns the index of the bucket holding the value.
+func (vind *Range) bucket(value int64) int {
+	return sort.Search(len(vind.splits), func(i int) bool {
+		return vind.splits[i] > value
+	})
+}
+
+// bucketKeyspaceID returns the keyspace id of a value in the given bucket out of buckets:
+// 8 bytes for the start of the bucket, followed by 8 bytes that sort like the value.
+func bucketKeyspaceID(bucket, buckets int, value int64) []byte {
+	var ksid [16]byte
+	binary.BigEndian.PutUint64(ksid[:8], bucketStart(bucket, buckets))
+	binary.BigEndian.PutUint64(ksid[8:], uint64(value)^(1<<63))
+	return ksid[:]
+}
+
+// bucketStart returns the start of the bucket when the keyspace id space
+// is divided evenly into the given number of buckets.
+func bucketStart(bucket, buckets int) uint64 {
+	if buckets <= 1 {
+		return 0
+	}
+	// the width of a bucket is 2^64 / buckets
+	width, _ := bits.Div64(1, 0, uint64(buckets))
+	return uint64(bucket) * width
+}
+
+// bucketValue returns the value encoded in a keyspace id built by bucketKeyspaceID.
+func bucketValue(ksid []byte) (int64, error) {
+	if len(ksid) != 16 {
+		return 0, fmt.Errorf("length of keyspace id is not 16: %d", len(ksid))
+	}
+	return int64(binary.BigEndian.Uint64(ksid[8:]) ^ (1 << 63)), nil
+}
+
+func init() {
+	Register("range", NewRange)
+}
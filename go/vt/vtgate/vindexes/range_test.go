@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+var rangeVindex SingleColumn
+
+func init() {
+	vindex, err := CreateVindex("range", "range", map[string]string{"split_points": "100, 200,300"})
+	if err != nil {
+		panic(err)
+	}
+	rangeVindex = vindex.(SingleColumn)
+}
+
+func TestRangeInfo(t *testing.T) {
+	assert.Equal(t, 1, rangeVindex.Cost())
+	assert.Equal(t, "range", rangeVindex.String())
+	assert.True(t, rangeVindex.IsUnique())
+	assert.False(t, rangeVindex.NeedsVCursor())
+}
+
+func TestRangeParams(t *testing.T) {
+	for _, splits := range []string{"", "1,a", "1,3,2", "1,1"} {
+		_, err := CreateVindex("range", "range", map[string]string{"split_points": splits})
+		assert.Error(t, err, splits)
+	}
+}
+
+func TestRangeMap(t *testing.T) {
+	got, err := rangeVindex.Map(nil, []sqltypes.Value{
+		sqltypes.NewInt64(-5),
+		sqltypes.NewInt64(99),
+		sqltypes.NewInt64(100),
+		sqltypes.NewUint64(250),
+		sqltypes.NewVarChar("300"),
+		sqltypes.NewFloat64(1.1),
+		sqltypes.NULL,
+	})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceID([]byte("\x00\x00\x00\x00\x00\x00\x00\x00\x7f\xff\xff\xff\xff\xff\xff\xfb")),
+		key.DestinationKeyspaceID([]byte("\x00\x00\x00\x00\x00\x00\x00\x00\x80\x00\x00\x00\x00\x00\x00\x63")),
+		key.DestinationKeyspaceID([]byte("\x40\x00\x00\x00\x00\x00\x00\x00\x80\x00\x00\x00\x00\x00\x00\x64")),
+		key.DestinationKeyspaceID([]byte("\x80\x00\x00\x00\x00\x00\x00\x00\x80\x00\x00\x00\x00\x00\x00\xfa")),
+		key.DestinationKeyspaceID([]byte("\xc0\x00\x00\x00\x00\x00\x00\x00\x80\x00\x00\x00\x00\x00\x01\x2c")),
+		key.DestinationNone{},
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestRangeOrder(t *testing.T) {
+	values := []int64{-1 << 63, -1000, -1, 0, 1, 99, 100, 101, 199, 200, 300, 1 << 40, 1<<63 - 1}
+	var prev []byte
+	for _, v := range values {
+		ksid, err := rangeVindex.(Hashing).Hash(sqltypes.NewInt64(v))
+		require.NoError(t, err)
+		assert.Equal(t, 1, bytes.Compare(ksid, prev), "%d does not sort after the previous value", v)
+		prev = ksid
+	}
+}
+
+func TestRangeVerify(t *testing.T) {
+	ksid, err := rangeVindex.(Hashing).Hash(sqltypes.NewInt64(150))
+	require.NoError(t, err)
+	got, err := rangeVindex.Verify(nil,
+		[]sqltypes.Value{sqltypes.NewInt64(150), sqltypes.NewInt64(151)},
+		[][]byte{ksid, ksid})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, got)
+
+	_, err = rangeVindex.Verify(nil, []sqltypes.Value{sqltypes.NewVarChar("abc")}, [][]byte{ksid})
+	assert.Error(t, err)
+}
+
+func TestRangeReverseMap(t *testing.T) {
+	var ksids [][]byte
+	want := []sqltypes.Value{sqltypes.NewInt64(-5), sqltypes.NewInt64(150), sqltypes.NewInt64(1 << 50)}
+	for _, v := range want {
+		ksid, err := rangeVindex.(Hashing).Hash(v)
+		require.NoError(t, err)
+		ksids = append(ksids, ksid)
+	}
+	got, err := rangeVindex.(Reversible).ReverseMap(nil, ksids)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = rangeVindex.(Reversible).ReverseMap(nil, [][]byte{[]byte("\x00\x00\x00\x00\x00\x00\x00\x01")})
+	assert.EqualError(t, err, "range: length of keyspace id is not 16: 8")
+}
+
+func TestBucketStart(t *testing.T) {
+	assert.EqualValues(t, 0, bucketStart(0, 1))
+	assert.EqualValues(t, uint64(0x8000000000000000), bucketStart(1, 2))
+	assert.EqualValues(t, uint64(0x5555555555555555), bucketStart(1, 3))
+	assert.EqualValues(t, uint64(0xaaaaaaaaaaaaaaaa), bucketStart(2, 3))
+}
+
+func TestRangeMapRange(t *testing.T) {
+	low, err := rangeVindex.(Hashing).Hash(sqltypes.NewInt64(150))
+	require.NoError(t, err)
+	high, err := rangeVindex.(Hashing).Hash(sqltypes.NewInt64(250))
+	require.NoError(t, err)
+	got, err := rangeVindex.(Ordered).MapRange(nil, sqltypes.NewInt64(150), sqltypes.NewInt64(250))
+	require.NoError(t, err)
+	kr := got.(key.DestinationKeyRange).KeyRange
+	assert.Equal(t, low, kr.Start)
+	assert.Equal(t, append(high, 0), kr.End)
+	// 150 is in the second bucket and 250 in the third one
+	assert.Equal(t, "40000000000000008000000000000096-800000000000000080000000000000fa00", key.KeyRangeString(kr))
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+var (
+	_ SingleColumn = (*TimeBucket)(nil)
+	_ Reversible   = (*TimeBucket)(nil)
+	_ Hashing      = (*TimeBucket)(nil)
+)
+
+const (
+	timeBucketMonth = "month"
+	timeBucketDay   = "day"
+)
+
+// TimeBucket maps date and time values to keyspace ids by their month, or their day.
+// The periods are assigned to a fixed number of buckets in a round-robin fashion,
+// and the buckets are spread evenly over the keyspace id space like the ones of
+// the Range vindex. With 4 buckets and a keyspace sharded as -40, 40-80, 80-c0, c0-,
+// January goes to -40, February to 40-80, and so on.
+// Within a bucket, the keyspace id sorts like the time. It's Unique and Reversible.
+type TimeBucket struct {
+	name     string
+	buckets  int
+	interval string
+}
+
+// NewTimeBucket creates a TimeBucket vindex.
+// The buckets parameter is required, and the interval parameter is
+// either "month", which is the default, or "day".
+func NewTimeBucket(name string, params map[string]string) (Vindex, error) {
+	buckets, err := strconv.Atoi(params["buckets"])
+	if err != nil || buckets <= 0 {
+		return nil, fmt.Errorf("time_bucket: buckets must be a positive integer, got %q", params["buckets"])
+	}
+	interval := params["interval"]
+	switch interval {
+	case "":
+		interval = timeBucketMonth
+	case timeBucketMonth, timeBucketDay:
+	default:
+		return nil, fmt.Errorf("time_bucket: unsupported interval %q", interval)
+	}
+	return &TimeBucket{name: name, buckets: buckets, interval: interval}, nil
+}
+
+// String returns the name of the vindex.
+func (vind *TimeBucket) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this vindex as 1.
+func (*TimeBucket) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (*TimeBucket) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (*TimeBucket) NeedsVCursor() bool {
+	return false
+}
+
+// Verify returns true if ids and ksids match.
+func (vind *TimeBucket) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, 0, len(ids))
+	for i, id := range ids {
+		ksid, err := vind.Hash(id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, bytes.Equal(ksid, ksids[i]))
+	}
+	return out, nil
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *TimeBucket) Map(_ VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(ids))
+	for _, id := range ids {
+		ksid, err := vind.Hash(id)
+		if err != nil {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		out = append(out, key.DestinationKeyspaceID(ksid))
+	}
+	return out, nil
+}
+
+// ReverseMap returns the associated ids for the ksids, as DATETIME values in UTC.
+func (*TimeBucket) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
+	reverseIds := make([]sqltypes.Value, len(ksids))
+	for i, ksid := range ksids {
+		micros, err := bucketValue(ksid)
+		if err != nil {
+			return nil, fmt.Errorf("time_bucket: %v", err)
+		}
+		t := time.Unix(micros/1e6, (micros%1e6)*1e3).UTC()
+		layout := "2006-01-02 15:04:05"
+		if t.Nanosecond() != 0 {
+			layout += ".000000"
+		}
+		reverseIds[i] = sqltypes.NewDatetime(t.Format(layout))
+	}
+	return reverseIds, nil
+}
+
+// Hash returns the keyspace id of the bucket of the period the id falls in.
+func (vind *TimeBucket) Hash(id sqltypes.Value) ([]byte, error) {
+	t, err := parseBucketTime(id)
+	if err != nil {
+		return nil, err
+	}
+	var period int64
+	switch vind.interval {
+	case timeBucketDay:
+		period = floorDiv(t.Unix(), 24*60*60)
+	default:
+		period = int64(t.Year())*12 + int64(t.Month()) - 1
+	}
+	bucket := int(((period % int64(vind.buckets)) + int64(vind.buckets)) % int64(vind.buckets))
+	micros := t.Unix()*1e6 + int64(t.Nanosecond()/1e3)
+	return bucketKeyspaceID(bucket, vind.buckets, micros), nil
+}
+
+// bucketTimeLayouts are the formats accepted for string values. Fractional
+// seconds are accepted after the seconds even if the layout doesn't have them.
+var bucketTimeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// parseBucketTime returns the time of a DATE, DATETIME, TIMESTAMP or string value,
+// read as UTC.
+func parseBucketTime(id sqltypes.Value) (time.Time, error) {
+	switch {
+	case id.Type() == sqltypes.Date, id.Type() == sqltypes.Datetime, id.Type() == sqltypes.Timestamp,
+		id.IsText(), id.IsBinary():
+	default:
+		return time.Time{}, fmt.Errorf("time_bucket: cannot use %v as a time", id)
+	}
+	for _, layout := range bucketTimeLayouts {
+		if t, err := time.ParseInLocation(layout, id.ToString(), time.UTC); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("time_bucket: cannot parse %q as a time", id.ToString())
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func init() {
+	Register("time_bucket", NewTimeBucket)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+var timeBucket SingleColumn
+
+func init() {
+	vindex, err := CreateVindex("time_bucket", "tb", map[string]string{"buckets": "4"})
+	if err != nil {
+		panic(err)
+	}
+	timeBucket = vindex.(SingleColumn)
+}
+
+func TestTimeBucketInfo(t *testing.T) {
+	assert.Equal(t, 1, timeBucket.Cost())
+	assert.Equal(t, "tb", timeBucket.String())
+	assert.True(t, timeBucket.IsUnique())
+	assert.False(t, timeBucket.NeedsVCursor())
+}
+
+func TestTimeBucketParams(t *testing.T) {
+	for _, params := range []map[string]string{
+		nil,
+		{"buckets": "0"},
+		{"buckets": "x"},
+		{"buckets": "4", "interval": "week"},
+	} {
+		_, err := CreateVindex("time_bucket", "tb", params)
+		assert.Error(t, err, params)
+	}
+}
+
+func TestTimeBucketMap(t *testing.T) {
+	got, err := timeBucket.Map(nil, []sqltypes.Value{
+		sqltypes.NewDate("2022-01-31"),
+		sqltypes.NewDatetime("2022-02-01 00:00:00"),
+		sqltypes.NewTimestamp("2022-03-15 12:30:00.5"),
+		sqltypes.NewVarChar("2022-04-01"),
+		sqltypes.NewVarChar("2022-05-01T10:00:00"),
+		sqltypes.NewVarChar("yesterday"),
+		sqltypes.NewInt64(20220101),
+		sqltypes.NULL,
+	})
+	require.NoError(t, err)
+	var prefixes []string
+	for _, dest := range got {
+		ksid, ok := dest.(key.DestinationKeyspaceID)
+		if !ok {
+			prefixes = append(prefixes, "none")
+			continue
+		}
+		prefixes = append(prefixes, hex.EncodeToString(ksid[:8]))
+	}
+	assert.Equal(t, []string{
+		"0000000000000000",
+		"4000000000000000",
+		"8000000000000000",
+		"c000000000000000",
+		"0000000000000000",
+		"none",
+		"none",
+		"none",
+	}, prefixes)
+}
+
+func TestTimeBucketDay(t *testing.T) {
+	vindex, err := CreateVindex("time_bucket", "tb", map[string]string{"buckets": "2", "interval": "day"})
+	require.NoError(t, err)
+	tb := vindex.(Hashing)
+	for _, tcase := range []struct {
+		day    string
+		prefix byte
+	}{
+		{"1970-01-01", 0x00},
+		{"1970-01-02 23:59:59", 0x80},
+		{"1969-12-31", 0x80},
+		{"1969-12-30", 0x00},
+	} {
+		ksid, err := tb.Hash(sqltypes.NewVarChar(tcase.day))
+		require.NoError(t, err)
+		assert.Equal(t, tcase.prefix, ksid[0], tcase.day)
+	}
+}
+
+func TestTimeBucketVerify(t *testing.T) {
+	ksid, err := timeBucket.(Hashing).Hash(sqltypes.NewDatetime("2022-03-15 12:30:00"))
+	require.NoError(t, err)
+	got, err := timeBucket.Verify(nil,
+		[]sqltypes.Value{sqltypes.NewVarChar("2022-03-15 12:30:00"), sqltypes.NewVarChar("2022-03-15 12:30:01")},
+		[][]byte{ksid, ksid})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, got)
+}
+
+func TestTimeBucketReverseMap(t *testing.T) {
+	var ksids [][]byte
+	for _, v := range []string{"2022-03-15 12:30:00", "1960-07-01 00:00:00.250000", "2022-01-01"} {
+		ksid, err := timeBucket.(Hashing).Hash(sqltypes.NewVarChar(v))
+		require.NoError(t, err)
+		ksids = append(ksids, ksid)
+	}
+	got, err := timeBucket.(Reversible).ReverseMap(nil, ksids)
+	require.NoError(t, err)
+	want := []sqltypes.Value{
+		sqltypes.NewDatetime("2022-03-15 12:30:00"),
+		sqltypes.NewDatetime("1960-07-01 00:00:00.250000"),
+		sqltypes.NewDatetime("2022-01-01 00:00:00"),
+	}
+	assert.Equal(t, want, got)
+
+	_, err = timeBucket.(Reversible).ReverseMap(nil, [][]byte{[]byte("\x00")})
+	assert.EqualError(t, err, "time_bucket: length of keyspace id is not 16: 1")
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package vindexes
 
 import (
+	"bytes"
 	"fmt"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -25,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -116,6 +118,18 @@ type Prefixable interface {
 	PrefixVindex() SingleColumn
 }
 
+// An Ordered vindex is one whose keyspace ids sort in the same order
+// as its ids. This is optional. If present, VTGate can send range
+// predicates on the vindex column only to the shards they overlap.
+// This needs the Gen4 planner, and is only done for select statements.
+type Ordered interface {
+	SingleColumn
+	// MapRange returns the destination holding the keyspace ids of
+	// all the ids between low and high, both included. A NULL bound
+	// leaves that side of the range open.
+	MapRange(vcursor VCursor, low, high sqltypes.Value) (key.Destination, error)
+}
+
 // A Lookup vindex is one that needs to lookup
 // a previously stored map to compute the keyspace
 // id from an id. This means that the creation of
@@ -197,6 +211,29 @@ func Verify(vindex Vindex, vcursor VCursor, rowsColValues [][]sqltypes.Value, ks
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex '%T' does not have Verify function", vindex)
 }
 
+// mapOrderedRange returns the key range holding the keyspace ids of the ids between
+// low and high, for a vindex whose hash function preserves the order of the ids.
+// A bound that cannot be hashed leaves that side of the range open, which can only
+// make the range larger than needed.
+func mapOrderedRange(hash func(sqltypes.Value) ([]byte, error), low, high sqltypes.Value) key.Destination {
+	var start, end []byte
+	if !low.IsNull() {
+		if ksid, err := hash(low); err == nil {
+			start = ksid
+		}
+	}
+	if !high.IsNull() {
+		if ksid, err := hash(high); err == nil {
+			// the smallest key that sorts after the keyspace id of high
+			end = append(ksid[:len(ksid):len(ksid)], 0)
+		}
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) >= 0 {
+		return key.DestinationNone{}
+	}
+	return key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: start, End: end}}
+}
+
 func firstColsOnly(rowsColValues [][]sqltypes.Value) []sqltypes.Value {
 	firstCols := make([]sqltypes.Value, 0, len(rowsColValues))
 	for _, val := range rowsColValues {
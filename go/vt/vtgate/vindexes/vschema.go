@@ -54,6 +54,9 @@ var TabletTypeSuffix = map[topodatapb.TabletType]string{
 const (
 	TypeSequence  = "sequence"
 	TypeReference = "reference"
+	// TypeGlobalReference is the type of a table of an unsharded keyspace that
+	// is copied by VReplication to all the other keyspaces, as a reference table.
+	TypeGlobalReference = "global_reference"
 )
 
 // VSchema represents the denormalized version of SrvVSchema,
@@ -96,6 +99,30 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	ResultCache             bool                 `json:"result_cache,omitempty"`
+	Statistics              *TableStatistics     `json:"statistics,omitempty"`
+	// PrimaryKey contains the primary key columns of the table, as known by the schema tracker
+	PrimaryKey []sqlparser.ColIdent `json:"primary_key,omitempty"`
+	// Source is the global reference table this table is a copy of, if any.
+	Source *Table `json:"-"`
+}
+
+// TableStatistics contains the estimates gathered by the schema tracker that
+// the planner uses to compare the cost of the plans of a query.
+type TableStatistics struct {
+	// RowCount is the estimated number of rows of the table in a shard
+	RowCount uint64 `json:"row_count"`
+	// ColumnCardinality is the estimated number of distinct values of the columns
+	// that are the first column of an index, keyed by their lowercase name
+	ColumnCardinality map[string]uint64 `json:"column_cardinality,omitempty"`
+}
+
+// Cardinality returns the estimated number of distinct values of a column, or 0 if unknown.
+func (ts *TableStatistics) Cardinality(col sqlparser.ColIdent) uint64 {
+	if ts == nil {
+		return 0
+	}
+	return ts.ColumnCardinality[col.Lowered()]
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -196,6 +223,7 @@ func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema) {
 	}
 	buildKeyspaces(source, vschema)
 	resolveAutoIncrement(source, vschema)
+	addGlobalReferences(vschema)
 	addDual(vschema)
 	buildRoutingRule(source, vschema)
 	return vschema
@@ -268,15 +296,22 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			Name:                    sqlparser.NewTableIdent(tname),
 			Keyspace:                keyspace,
 			ColumnListAuthoritative: table.ColumnListAuthoritative,
+			ResultCache:             table.ResultCache,
 		}
 		switch table.Type {
 		case "", TypeReference:
 			t.Type = table.Type
-		case TypeSequence:
-			if keyspace.Sharded && table.Pinned == "" {
-				return fmt.Errorf("sequence table has to be in an unsharded keyspace or must be pinned: %s", tname)
+		case TypeGlobalReference:
+			if keyspace.Sharded {
+				return fmt.Errorf("global reference table %s must be in an unsharded keyspace", tname)
 			}
 			t.Type = table.Type
+		case TypeSequence:
+			// A sequence of a sharded keyspace that isn't pinned has a row in
+			// every shard, and interleaves their values using its increment column.
+			// The increment is only known by the tablets, and is checked against
+			// the number of shards whenever values are generated.
+			t.Type = table.Type
 		default:
 			return fmt.Errorf("unidentified table type %s", table.Type)
 		}
@@ -288,8 +323,8 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			t.Pinned = decoded
 		}
 
-		// If keyspace is sharded, then any table that's not a reference or pinned must have vindexes.
-		if keyspace.Sharded && t.Type != TypeReference && table.Pinned == "" && len(table.ColumnVindexes) == 0 {
+		// If keyspace is sharded, then any table that's not a reference, a sequence or pinned must have vindexes.
+		if keyspace.Sharded && t.Type != TypeReference && t.Type != TypeSequence && table.Pinned == "" && len(table.ColumnVindexes) == 0 {
 			return fmt.Errorf("missing primary col vindex for table: %s", tname)
 		}
 
@@ -429,6 +464,74 @@ func resolveAutoIncrement(source *vschemapb.SrvVSchema, vschema *VSchema) {
 	}
 }
 
+// addGlobalReferences adds a reference table to all keyspaces for every global
+// reference table, whose Source is the global reference table. A reference table
+// the keyspace already has becomes the copy. Unqualified references to the table
+// are resolved to the global reference table.
+func addGlobalReferences(vschema *VSchema) {
+	for _, source := range vschema.Keyspaces {
+		for tname, t := range source.Tables {
+			if t.Type != TypeGlobalReference {
+				continue
+			}
+			if _, ok := vschema.uniqueTables[tname]; ok {
+				vschema.uniqueTables[tname] = t
+			}
+			for _, ks := range vschema.Keyspaces {
+				if ks == source {
+					continue
+				}
+				existing := ks.Tables[tname]
+				switch {
+				case existing == nil:
+					ks.Tables[tname] = &Table{
+						Type:                    TypeReference,
+						Name:                    t.Name,
+						Keyspace:                ks.Keyspace,
+						Columns:                 t.Columns,
+						ColumnListAuthoritative: t.ColumnListAuthoritative,
+						ResultCache:             t.ResultCache,
+						Source:                  t,
+					}
+				case existing.Type == TypeReference && existing.Source == nil:
+					existing.Source = t
+				default:
+					ks.Error = fmt.Errorf("table %s conflicts with the global reference table %s.%s", tname, source.Keyspace.Name, tname)
+				}
+			}
+		}
+	}
+}
+
+// RouteToSource makes queries on the copy of a global reference table in keyspace
+// go to the global reference table instead. It is used when the copy is stale.
+func (vschema *VSchema) RouteToSource(keyspace, tablename string) {
+	ks := vschema.Keyspaces[keyspace]
+	if ks == nil {
+		return
+	}
+	if t := ks.Tables[tablename]; t != nil && t.Source != nil {
+		ks.Tables[tablename] = t.Source
+	}
+}
+
+// GlobalReferences returns the global reference tables that are copied to each
+// keyspace, including the ones whose copy is routed to the source.
+func (vschema *VSchema) GlobalReferences() map[string][]*Table {
+	sources := make(map[string][]*Table)
+	for ksname, ks := range vschema.Keyspaces {
+		for _, t := range ks.Tables {
+			switch {
+			case t.Source != nil:
+				sources[ksname] = append(sources[ksname], t.Source)
+			case t.Type == TypeGlobalReference && t.Keyspace != ks.Keyspace:
+				sources[ksname] = append(sources[ksname], t)
+			}
+		}
+	}
+	return sources
+}
+
 // addDual adds dual as a valid table to all keyspaces.
 // For sharded keyspaces, it gets pinned against keyspace id '0x00'.
 func addDual(vschema *VSchema) {
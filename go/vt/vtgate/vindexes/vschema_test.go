@@ -331,6 +331,25 @@ func TestVSchemaColumns(t *testing.T) {
 	}
 }
 
+func TestVSchemaResultCache(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ResultCache: true,
+					},
+					"t2": {},
+				},
+			},
+		},
+	}
+	got := BuildVSchema(&good)
+	require.NoError(t, got.Keyspaces["unsharded"].Error)
+	assert.True(t, got.Keyspaces["unsharded"].Tables["t1"].ResultCache)
+	assert.False(t, got.Keyspaces["unsharded"].Tables["t2"].ResultCache)
+}
+
 func TestVSchemaColumnListAuthoritative(t *testing.T) {
 	good := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -2143,25 +2162,120 @@ func TestBadSequenceName(t *testing.T) {
 	}
 }
 
-func TestBadShardedSequence(t *testing.T) {
-	bad := vschemapb.SrvVSchema{
+func TestShardedSequence(t *testing.T) {
+	input := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
 			"sharded": {
 				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
 				Tables: map[string]*vschemapb.Table{
-					"t1": {
+					"seq": {
 						Type: "sequence",
 					},
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Column: "c1",
+							Name:   "hash",
+						}},
+						AutoIncrement: &vschemapb.AutoIncrement{
+							Column:   "c1",
+							Sequence: "seq",
+						},
+					},
 				},
 			},
 		},
 	}
-	got := BuildVSchema(&bad)
-	err := got.Keyspaces["sharded"].Error
-	want := "sequence table has to be in an unsharded keyspace or must be pinned: t1"
-	if err == nil || err.Error() != want {
-		t.Errorf("BuildVSchema: %v, want %v", err, want)
+	got := BuildVSchema(&input)
+	require.NoError(t, got.Keyspaces["sharded"].Error)
+	seq := got.Keyspaces["sharded"].Tables["seq"]
+	require.NotNil(t, seq)
+	assert.Equal(t, TypeSequence, seq.Type)
+	assert.True(t, seq.Keyspace.Sharded)
+	assert.Equal(t, seq, got.Keyspaces["sharded"].Tables["t1"].AutoIncrement.Sequence)
+}
+
+func TestGlobalReference(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"country": {
+						Type: "global_reference",
+					},
+				},
+			},
+			"sharded": {
+				Sharded: true,
+				Tables: map[string]*vschemapb.Table{
+					"country": {
+						Type: "reference",
+					},
+				},
+			},
+			"other": {},
+		},
+	}
+	got := BuildVSchema(&input)
+	require.NoError(t, got.Keyspaces["unsharded"].Error)
+	require.NoError(t, got.Keyspaces["sharded"].Error)
+	require.NoError(t, got.Keyspaces["other"].Error)
+
+	source := got.Keyspaces["unsharded"].Tables["country"]
+	assert.Equal(t, TypeGlobalReference, source.Type)
+	assert.Nil(t, source.Source)
+	for _, ks := range []string{"sharded", "other"} {
+		copied := got.Keyspaces[ks].Tables["country"]
+		assert.Equal(t, TypeReference, copied.Type)
+		assert.Equal(t, ks, copied.Keyspace.Name)
+		assert.Equal(t, source, copied.Source)
+	}
+
+	// Unqualified names are resolved to the source.
+	table, err := got.FindTable("", "country")
+	require.NoError(t, err)
+	assert.Equal(t, source, table)
+
+	assert.Equal(t, map[string][]*Table{"sharded": {source}, "other": {source}}, got.GlobalReferences())
+	got.RouteToSource("sharded", "country")
+	table, err = got.FindTable("sharded", "country")
+	require.NoError(t, err)
+	assert.Equal(t, source, table)
+	assert.Equal(t, map[string][]*Table{"sharded": {source}, "other": {source}}, got.GlobalReferences())
+}
+
+func TestGlobalReferenceErrors(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"country": {
+						Type: "global_reference",
+					},
+				},
+			},
+			"unsharded2": {
+				Tables: map[string]*vschemapb.Table{
+					"country": {},
+				},
+			},
+			"sharded": {
+				Sharded: true,
+				Tables: map[string]*vschemapb.Table{
+					"region": {
+						Type: "global_reference",
+					},
+				},
+			},
+		},
 	}
+	got := BuildVSchema(&input)
+	assert.EqualError(t, got.Keyspaces["unsharded2"].Error, "table country conflicts with the global reference table unsharded.country")
+	assert.EqualError(t, got.Keyspaces["sharded"].Error, "global reference table region must be in an unsharded keyspace")
 }
 
 func TestFindTable(t *testing.T) {
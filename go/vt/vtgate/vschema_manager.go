@@ -18,6 +18,7 @@ package vtgate
 
 import (
 	"context"
+	"reflect"
 	"sync"
 
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -44,11 +45,20 @@ type VSchemaManager struct {
 	cell              string
 	subscriber        func(vschema *vindexes.VSchema, stats *VSchemaStats)
 	schema            SchemaInfo
+
+	// unroutableReferences are the tables, by keyspace, whose copy of a global
+	// reference table must not be used by queries
+	unroutableReferences map[string]map[string]bool
+	// referencesChecked is set once the copies of the global reference tables have been
+	// checked. Until then, none of them is used by queries.
+	referencesChecked bool
 }
 
 // SchemaInfo is an interface to schema tracker.
 type SchemaInfo interface {
 	Tables(ks string) map[string][]vindexes.Column
+	Statistics(ks string) map[string]*vindexes.TableStatistics
+	PrimaryKeys(ks string) map[string][]sqlparser.ColIdent
 }
 
 // GetCurrentSrvVschema returns a copy of the latest SrvVschema from the
@@ -128,7 +138,7 @@ func (vm *VSchemaManager) VSchemaUpdate(v *vschemapb.SrvVSchema, err error) bool
 			vschema = vindexes.BuildVSchema(&vschemapb.SrvVSchema{})
 		}
 	} else {
-		vschema = vm.buildAndEnhanceVSchema(v)
+		vschema = vm.buildAndEnhanceVSchema(v, vm.unroutableReferences, vm.referencesChecked)
 		vm.currentVschema = vschema
 	}
 
@@ -159,6 +169,8 @@ func vSchemaStats(err error, vschema *vindexes.VSchema) *VSchemaStats {
 func (vm *VSchemaManager) Rebuild() {
 	vm.mu.Lock()
 	v := vm.currentSrvVschema
+	unroutable := vm.unroutableReferences
+	checked := vm.referencesChecked
 	vm.mu.Unlock()
 
 	log.Infof("Received schema update")
@@ -167,7 +179,7 @@ func (vm *VSchemaManager) Rebuild() {
 		return
 	}
 
-	vschema := vm.buildAndEnhanceVSchema(v)
+	vschema := vm.buildAndEnhanceVSchema(v, unroutable, checked)
 	vm.mu.Lock()
 	vm.currentVschema = vschema
 	vm.mu.Unlock()
@@ -178,18 +190,50 @@ func (vm *VSchemaManager) Rebuild() {
 	}
 }
 
+// SetUnroutableReferences sets the copies of global reference tables, by keyspace and table,
+// whose queries must be routed to the global reference table, and rebuilds the vschema if
+// they changed. Before it is first called, no copy is used by queries.
+func (vm *VSchemaManager) SetUnroutableReferences(unroutable map[string]map[string]bool) {
+	if len(unroutable) == 0 {
+		unroutable = nil
+	}
+	vm.mu.Lock()
+	changed := !vm.referencesChecked || !reflect.DeepEqual(vm.unroutableReferences, unroutable)
+	vm.unroutableReferences = unroutable
+	vm.referencesChecked = true
+	vm.mu.Unlock()
+
+	if changed {
+		vm.Rebuild()
+	}
+}
+
 // buildAndEnhanceVSchema builds a new VSchema and uses information from the schema tracker to update it
-func (vm *VSchemaManager) buildAndEnhanceVSchema(v *vschemapb.SrvVSchema) *vindexes.VSchema {
+func (vm *VSchemaManager) buildAndEnhanceVSchema(v *vschemapb.SrvVSchema, unroutable map[string]map[string]bool, referencesChecked bool) *vindexes.VSchema {
 	vschema := vindexes.BuildVSchema(v)
 	if vm.schema != nil {
 		vm.updateFromSchema(vschema)
 	}
+	if !referencesChecked {
+		for ksName, sources := range vschema.GlobalReferences() {
+			for _, source := range sources {
+				vschema.RouteToSource(ksName, source.Name.String())
+			}
+		}
+	}
+	for ksName, tables := range unroutable {
+		for tblName := range tables {
+			vschema.RouteToSource(ksName, tblName)
+		}
+	}
 	return vschema
 }
 
 func (vm *VSchemaManager) updateFromSchema(vschema *vindexes.VSchema) {
 	for ksName, ks := range vschema.Keyspaces {
 		m := vm.schema.Tables(ksName)
+		stats := vm.schema.Statistics(ksName)
+		pks := vm.schema.PrimaryKeys(ksName)
 
 		for tblName, columns := range m {
 			vTbl := ks.Tables[tblName]
@@ -200,9 +244,14 @@ func (vm *VSchemaManager) updateFromSchema(vschema *vindexes.VSchema) {
 					Keyspace:                ks.Keyspace,
 					Columns:                 columns,
 					ColumnListAuthoritative: true,
+					Statistics:              stats[tblName],
+					PrimaryKey:              pks[tblName],
 				}
 				continue
 			}
+			// the statistics are estimates and are used as long as the tracker knows the table
+			vTbl.Statistics = stats[tblName]
+			vTbl.PrimaryKey = pks[tblName]
 			if !vTbl.ColumnListAuthoritative {
 				// if we found the matching table and the vschema view of it is not authoritative, then we just update the columns of the table
 				vTbl.Columns = columns
@@ -136,11 +136,19 @@ func TestRebuildVSchema(t *testing.T) {
 	tblCol1 := &vindexes.Table{Name: sqlparser.NewTableIdent("tbl"), Keyspace: ks, Columns: cols1, ColumnListAuthoritative: true}
 	tblCol2 := &vindexes.Table{Name: sqlparser.NewTableIdent("tbl"), Keyspace: ks, Columns: cols2, ColumnListAuthoritative: true}
 	tblCol2NA := &vindexes.Table{Name: sqlparser.NewTableIdent("tbl"), Keyspace: ks, Columns: cols2}
+	stats := &vindexes.TableStatistics{RowCount: 100, ColumnCardinality: map[string]uint64{"id": 100}}
+	tblCol1Stats := &vindexes.Table{Name: sqlparser.NewTableIdent("tbl"), Keyspace: ks, Columns: cols1, ColumnListAuthoritative: true, Statistics: stats}
+	pk := []sqlparser.ColIdent{sqlparser.NewColIdent("id")}
+	tblCol1PK := &vindexes.Table{Name: sqlparser.NewTableIdent("tbl"), Keyspace: ks, Columns: cols1, ColumnListAuthoritative: true, PrimaryKey: pk}
+	tblCol2PK := &vindexes.Table{Name: sqlparser.NewTableIdent("tbl"), Keyspace: ks, Columns: cols2, ColumnListAuthoritative: true, PrimaryKey: pk}
+	tblCol2Stats := &vindexes.Table{Name: sqlparser.NewTableIdent("tbl"), Keyspace: ks, Columns: cols2, ColumnListAuthoritative: true, Statistics: stats}
 
 	tcases := []struct {
 		name       string
 		srvVschema *vschemapb.SrvVSchema
 		schema     map[string][]vindexes.Column
+		stats      map[string]*vindexes.TableStatistics
+		pks        map[string][]sqlparser.ColIdent
 		expected   *vindexes.VSchema
 	}{{
 		name: "0 Schematracking- 1 srvVSchema",
@@ -192,6 +200,42 @@ func TestRebuildVSchema(t *testing.T) {
 		schema: map[string][]vindexes.Column{"tbl": cols1},
 		// schema tracker will be ignored for authoritative tables.
 		expected: makeTestVSchema("ks", false, map[string]*vindexes.Table{"dual": dual, "tbl": tblCol2}),
+	}, {
+		name:       "1 Schematracking with statistics - 0 srvVSchema",
+		srvVschema: makeTestSrvVSchema("ks", false, nil),
+		schema:     map[string][]vindexes.Column{"tbl": cols1},
+		stats:      map[string]*vindexes.TableStatistics{"tbl": stats},
+		expected:   makeTestVSchema("ks", false, map[string]*vindexes.Table{"dual": dual, "tbl": tblCol1Stats}),
+	}, {
+		name: "1 Schematracking with statistics - 1 srvVSchema (have columns) authoritative",
+		srvVschema: makeTestSrvVSchema("ks", false, map[string]*vschemapb.Table{
+			"tbl": {
+				Columns:                 []*vschemapb.Column{{Name: "uid", Type: querypb.Type_INT64}, {Name: "name", Type: querypb.Type_VARCHAR}},
+				ColumnListAuthoritative: true,
+			},
+		}),
+		schema: map[string][]vindexes.Column{"tbl": cols1},
+		stats:  map[string]*vindexes.TableStatistics{"tbl": stats},
+		// statistics are used even when the columns of the vschema are authoritative.
+		expected: makeTestVSchema("ks", false, map[string]*vindexes.Table{"dual": dual, "tbl": tblCol2Stats}),
+	}, {
+		name:       "1 Schematracking with primary key - 0 srvVSchema",
+		srvVschema: makeTestSrvVSchema("ks", false, nil),
+		schema:     map[string][]vindexes.Column{"tbl": cols1},
+		pks:        map[string][]sqlparser.ColIdent{"tbl": pk},
+		expected:   makeTestVSchema("ks", false, map[string]*vindexes.Table{"dual": dual, "tbl": tblCol1PK}),
+	}, {
+		name: "1 Schematracking with primary key - 1 srvVSchema (have columns) authoritative",
+		srvVschema: makeTestSrvVSchema("ks", false, map[string]*vschemapb.Table{
+			"tbl": {
+				Columns:                 []*vschemapb.Column{{Name: "uid", Type: querypb.Type_INT64}, {Name: "name", Type: querypb.Type_VARCHAR}},
+				ColumnListAuthoritative: true,
+			},
+		}),
+		schema: map[string][]vindexes.Column{"tbl": cols1},
+		pks:    map[string][]sqlparser.ColIdent{"tbl": pk},
+		// the primary key is used even when the columns of the vschema are authoritative.
+		expected: makeTestVSchema("ks", false, map[string]*vindexes.Table{"dual": dual, "tbl": tblCol2PK}),
 	}, {
 		name:   "srvVschema received as nil",
 		schema: map[string][]vindexes.Column{"tbl": cols1},
@@ -205,7 +249,7 @@ func TestRebuildVSchema(t *testing.T) {
 	for _, tcase := range tcases {
 		t.Run(tcase.name, func(t *testing.T) {
 			vs = nil
-			vm.schema = &fakeSchema{t: tcase.schema}
+			vm.schema = &fakeSchema{t: tcase.schema, s: tcase.stats, pk: tcase.pks}
 			vm.currentSrvVschema = tcase.srvVschema
 			vm.currentVschema = nil
 			vm.Rebuild()
@@ -250,7 +294,9 @@ func makeTestSrvVSchema(ks string, sharded bool, tbls map[string]*vschemapb.Tabl
 }
 
 type fakeSchema struct {
-	t map[string][]vindexes.Column
+	t  map[string][]vindexes.Column
+	s  map[string]*vindexes.TableStatistics
+	pk map[string][]sqlparser.ColIdent
 }
 
 var _ SchemaInfo = (*fakeSchema)(nil)
@@ -258,3 +304,11 @@ var _ SchemaInfo = (*fakeSchema)(nil)
 func (f *fakeSchema) Tables(string) map[string][]vindexes.Column {
 	return f.t
 }
+
+func (f *fakeSchema) Statistics(string) map[string]*vindexes.TableStatistics {
+	return f.s
+}
+
+func (f *fakeSchema) PrimaryKeys(string) map[string][]sqlparser.ColIdent {
+	return f.pk
+}
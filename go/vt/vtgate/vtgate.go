@@ -66,6 +66,8 @@ var (
 	_                    = flag.Bool("disable_local_gateway", false, "deprecated: if specified, this process will not route any queries to local tablets in the local cell")
 	maxMemoryRows        = flag.Int("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
 	warnMemoryRows       = flag.Int("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
+	queryMemoryBudget    = flag.Int64("query_memory_budget", 0, "Maximum number of bytes that sorts, hash joins and distincts of a query hold in memory before spilling rows to disk. 0 disables spilling.")
+	spillDir             = flag.String("spill_dir", "", "Directory for the temporary files of queries that go over query_memory_budget. Defaults to the system temporary directory.")
 	defaultDDLStrategy   = flag.String("ddl_strategy", string(schema.DDLStrategyDirect), "Set default strategy for DDL statements. Override with @@ddl_strategy session variable")
 	dbDDLPlugin          = flag.String("dbddl_plugin", "fail", "controls how to handle CREATE/DROP DATABASE. use it if you are using your own database provisioning service")
 	noScatter            = flag.Bool("no_scatter", false, "when set to true, the planner will fail instead of producing a plan that includes scatter queries")
@@ -94,8 +96,18 @@ var (
 	enableOnlineDDL = flag.Bool("enable_online_ddl", true, "Allow users to submit, review and control Online DDL")
 	enableDirectDDL = flag.Bool("enable_direct_ddl", true, "Allow users to submit direct DDL statements")
 
-	enableSchemaChangeSignal = flag.Bool("schema_change_signal", false, "Enable the schema tracker; requires queryserver-config-schema-change-signal to be enabled on the underlying vttablets for this to work")
-	schemaChangeUser         = flag.String("schema_change_signal_user", "", "User to be used to send down query to vttablet to retrieve schema changes")
+	enableSchemaChangeSignal   = flag.Bool("schema_change_signal", false, "Enable the schema tracker; requires queryserver-config-schema-change-signal to be enabled on the underlying vttablets for this to work")
+	schemaChangeUser           = flag.String("schema_change_signal_user", "", "User to be used to send down query to vttablet to retrieve schema changes")
+	schemaStatsRefreshInterval = flag.Duration("schema_statistics_refresh_interval", 5*time.Minute, "How often the schema tracker reloads the row and cardinality estimates of the tables, which the planner uses to compare the cost of plans. 0 only reloads them along with schema changes")
+
+	// flags for the query result cache
+	enableResultCache = flag.Bool("enable_result_cache", false, "Cache the results of SELECTs on the tables that set result_cache in the vschema, or that carry a RESULT_CACHE_TTL_MS query comment. Cached results are invalidated by a VStream of the keyspaces they were read from.")
+	resultCacheMemory = flag.Int64("result_cache_memory", 64*1024*1024, "Maximum number of bytes the result cache holds")
+	resultCacheTTL    = flag.Duration("result_cache_ttl", time.Minute, "How long results of the tables that set result_cache in the vschema are cached, in case an invalidation is missed")
+
+	// flags for the global reference tables
+	referenceTableMaxLag        = flag.Duration("reference_table_max_lag", 30*time.Second, "Queries on the copy of a global reference table are routed to the global reference table while the copy lags more than this behind it")
+	referenceTableCheckInterval = flag.Duration("reference_table_check_interval", 10*time.Second, "How often the copies of the global reference tables are checked")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -207,6 +219,7 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 	var st *vtschema.Tracker
 	if *enableSchemaChangeSignal {
 		st = vtschema.NewTracker(gw.hc.Subscribe(), schemaChangeUser)
+		st.SetStatisticsRefreshInterval(*schemaStatsRefreshInterval)
 		addKeyspaceToTracker(ctx, srvResolver, st, gw)
 		si = st
 	}
@@ -235,6 +248,16 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 		st.RegisterSignalReceiver(executor.vm.Rebuild)
 	}
 
+	// release the requests buffered during a MoveTables cutover once the routing rules were switched
+	executor.onRoutingRulesChange(gw.buffer.HandleRoutingRulesChange)
+
+	if *enableResultCache {
+		executor.resultCache = newResultCache(vsm)
+	}
+
+	rtm := newReferenceTableMonitor(executor, *referenceTableMaxLag, *referenceTableCheckInterval)
+	_ = stats.NewGaugeFunc("UnroutableReferenceTables", "Number of copies of global reference tables whose queries are routed to the global reference table", rtm.Unroutable)
+
 	// TODO: call serv.WatchSrvVSchema here
 
 	rpcVTGate = &VTGate{
@@ -280,11 +303,16 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 		if st != nil && *enableSchemaChangeSignal {
 			st.Start()
 		}
+		rtm.Open()
 	})
 	servenv.OnTerm(func() {
 		if st != nil && *enableSchemaChangeSignal {
 			st.Stop()
 		}
+		if executor.resultCache != nil {
+			executor.resultCache.Close()
+		}
+		rtm.Close()
 	})
 	rpcVTGate.registerDebugHealthHandler()
 	rpcVTGate.registerDebugEnvHandler()
@@ -47,11 +47,13 @@ import (
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
@@ -59,6 +61,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
@@ -108,6 +111,7 @@ var ghostOverridePath = flag.String("gh-ost-path", "", "override default gh-ost
 var ptOSCOverridePath = flag.String("pt-osc-path", "", "override default pt-online-schema-change binary full path")
 var migrationCheckInterval = flag.Duration("migration_check_interval", 1*time.Minute, "Interval between migration checks")
 var retainOnlineDDLTables = flag.Duration("retain_online_ddl_tables", 24*time.Hour, "How long should vttablet keep an old migrated table before purging it")
+var maxConcurrentOnlineDDLs = flag.Int("max_concurrent_online_ddl", 2, "Maximum number of online DDL ALTER migrations that may run concurrently on a tablet; CREATE and DROP migrations are not counted")
 var migrationNextCheckIntervals = []time.Duration{1 * time.Second, 5 * time.Second, 10 * time.Second, 20 * time.Second}
 
 const (
@@ -121,6 +125,8 @@ const (
 	databasePoolSize                         = 3
 	vreplicationCutOverThreshold             = 5 * time.Second
 	vreplicationTestSuiteWaitSeconds         = 5
+	cutOverBackoffInitial                    = 10 * time.Second
+	cutOverBackoffMax                        = 10 * time.Minute
 )
 
 var (
@@ -144,6 +150,7 @@ type Executor struct {
 	pool           *connpool.Pool
 	tabletTypeFunc func() topodatapb.TabletType
 	ts             *topo.Server
+	lagThrottler   *throttle.Throttler
 	tabletAlias    *topodatapb.TabletAlias
 
 	keyspace string
@@ -166,6 +173,10 @@ type Executor struct {
 	isOpen            bool
 	schemaInitialized bool
 
+	// sharedThrottling lists the vreplication migrations currently throttled by this executor
+	// so that they share the throttler fairly
+	sharedThrottling map[string]bool
+
 	initVreplicationDDLOnce sync.Once
 }
 
@@ -201,7 +212,10 @@ func newGCTableRetainTime() time.Time {
 }
 
 // NewExecutor creates a new gh-ost executor.
-func NewExecutor(env tabletenv.Env, tabletAlias *topodatapb.TabletAlias, ts *topo.Server, tabletTypeFunc func() topodatapb.TabletType) *Executor {
+func NewExecutor(env tabletenv.Env, tabletAlias *topodatapb.TabletAlias, ts *topo.Server,
+	lagThrottler *throttle.Throttler,
+	tabletTypeFunc func() topodatapb.TabletType,
+) *Executor {
 	return &Executor{
 		env:         env,
 		tabletAlias: proto.Clone(tabletAlias).(*topodatapb.TabletAlias),
@@ -212,6 +226,7 @@ func NewExecutor(env tabletenv.Env, tabletAlias *topodatapb.TabletAlias, ts *top
 		}),
 		tabletTypeFunc: tabletTypeFunc,
 		ts:             ts,
+		lagThrottler:   lagThrottler,
 		ticks:          timer.NewTimer(*migrationCheckInterval),
 	}
 }
@@ -331,6 +346,13 @@ func (e *Executor) allowConcurrentMigration(onlineDDL *schema.OnlineDDL) bool {
 		// Reminder that REVERT is supported for CREATE, DROP and for 'online' ALTER, but never for
 		// 'gh-ost' or 'pt-osc' ALTERs
 		return true
+	case sqlparser.AlterDDLAction:
+		// 'vitess' ALTERs are allowed to run concurrently: they are all served by the vreplication engine,
+		// and share the throttler. 'gh-ost' and 'pt-osc' ALTERs each run an external process and are not.
+		switch onlineDDL.StrategySetting().Strategy {
+		case schema.DDLStrategyOnline, schema.DDLStrategyVitess:
+			return true
+		}
 	}
 	return false
 }
@@ -359,22 +381,51 @@ func (e *Executor) isAnyNonConcurrentMigrationRunning() bool {
 	return nonConcurrentMigrationFound
 }
 
-// isAnyMigrationRunningOnTable sees if there's any migration running right now
-// operating on given table.
-func (e *Executor) isAnyMigrationRunningOnTable(tableName string) bool {
-	sameTableMigrationFound := false
+// isAnyMigrationRunningOnRelatedTables sees if there's any migration running right now
+// operating on, or depending on, any of the given tables, e.g. a migration on the same table,
+// or on a view that reads from the table.
+func (e *Executor) isAnyMigrationRunningOnRelatedTables(relatedTables map[string]bool) bool {
+	relatedMigrationFound := false
 	e.ownedRunningMigrations.Range(func(_, val interface{}) bool {
 		onlineDDL, ok := val.(*schema.OnlineDDL)
 		if !ok {
 			return true
 		}
-		if onlineDDL.Table == tableName {
-			sameTableMigrationFound = true
-			return false // stop iteration, no need to review other migrations
+		for tableName := range onlineDDL.GetRelatedTables() {
+			if relatedTables[tableName] {
+				relatedMigrationFound = true
+				return false // stop iteration, no need to review other migrations
+			}
+		}
+		return true
+	})
+	return relatedMigrationFound
+}
+
+// countOwnedRunningAlterMigrations returns the number of ALTER migrations this executor runs right now
+func (e *Executor) countOwnedRunningAlterMigrations() (count int) {
+	e.ownedRunningMigrations.Range(func(_, val interface{}) bool {
+		onlineDDL, ok := val.(*schema.OnlineDDL)
+		if !ok {
+			return true
+		}
+		if action, err := onlineDDL.GetAction(); err == nil && action == sqlparser.AlterDDLAction {
+			count++
 		}
 		return true
 	})
-	return sameTableMigrationFound
+	return count
+}
+
+// isMaxConcurrentAlterMigrationsReached checks if the given migration is an ALTER that can't run
+// because -max_concurrent_online_ddl ALTER migrations already run. Concurrent ALTERs each copy
+// a whole table, so their number is bounded; CREATE and DROP are quick and not bounded.
+func (e *Executor) isMaxConcurrentAlterMigrationsReached(onlineDDL *schema.OnlineDDL) bool {
+	action, err := onlineDDL.GetAction()
+	if err != nil || action != sqlparser.AlterDDLAction {
+		return false
+	}
+	return e.countOwnedRunningAlterMigrations() >= *maxConcurrentOnlineDDLs
 }
 
 // isAnyConflictingMigrationRunning checks if there's any running migration that conflicts with the
@@ -384,13 +435,43 @@ func (e *Executor) isAnyConflictingMigrationRunning(onlineDDL *schema.OnlineDDL)
 	if e.isAnyNonConcurrentMigrationRunning() && !e.allowConcurrentMigration(onlineDDL) {
 		return true
 	}
-	if e.isAnyMigrationRunningOnTable(onlineDDL.Table) {
+	if e.isAnyMigrationRunningOnRelatedTables(onlineDDL.GetRelatedTables()) {
+		return true
+	}
+	if e.isMaxConcurrentAlterMigrationsReached(onlineDDL) {
 		return true
 	}
 
 	return false
 }
 
+// shareThrottling splits the throttler between the given running vreplication migrations: each of
+// n concurrent migrations is throttled by a ratio of 1-1/n, such that together they load the server
+// about as much as a single migration does, and none of them starves the others.
+// The shared ratio is kept apart from any throttling of the migrations by the operator, which
+// still applies on top of it, and is left untouched when the migrations stop sharing the throttler.
+func (e *Executor) shareThrottling(uuids []string) {
+	if e.lagThrottler == nil {
+		return
+	}
+	sharedThrottling := map[string]bool{}
+	if len(uuids) > 1 {
+		ratio := 1 - 1/float64(len(uuids))
+		// the throttling expires unless renewed by the next review
+		expireAt := time.Now().Add(2 * *migrationCheckInterval)
+		for _, uuid := range uuids {
+			e.lagThrottler.ThrottleAppShared(uuid, expireAt, ratio)
+			sharedThrottling[uuid] = true
+		}
+	}
+	for uuid := range e.sharedThrottling {
+		if !sharedThrottling[uuid] {
+			e.lagThrottler.UnthrottleAppShared(uuid)
+		}
+	}
+	e.sharedThrottling = sharedThrottling
+}
+
 func (e *Executor) ghostPanicFlagFileName(uuid string) string {
 	return path.Join(os.TempDir(), fmt.Sprintf("ghost.%s.panic.flag", uuid))
 }
@@ -524,6 +605,78 @@ func (e *Executor) tableExists(ctx context.Context, tableName string) (bool, err
 	return (row != nil), nil
 }
 
+// showCreateTable returns the CREATE TABLE statement of a given table.
+func (e *Executor) showCreateTable(ctx context.Context, tableName string) (string, error) {
+	parsed := sqlparser.BuildParsedQuery(sqlShowCreateTable, tableName)
+	rs, err := e.execQuery(ctx, parsed.Query)
+	if err != nil {
+		return "", err
+	}
+	if len(rs.Rows) == 0 || len(rs.Rows[0]) < 2 {
+		return "", vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "unexpected result for SHOW CREATE TABLE %s", tableName)
+	}
+	return rs.Rows[0][1].ToString(), nil
+}
+
+// isInstantDDLCapable checks whether the backend MySQL server can apply the given ALTER TABLE
+// migration with ALGORITHM=INSTANT, based on its version and on the current schema of the table.
+func (e *Executor) isInstantDDLCapable(ctx context.Context, onlineDDL *schema.OnlineDDL, alterTable *sqlparser.AlterTable) (bool, error) {
+	variables, err := e.readMySQLVariables(ctx)
+	if err != nil {
+		return false, err
+	}
+	capableOf, err := mysqlctl.ServerVersionCapableOf(variables.version)
+	if err != nil {
+		return false, err
+	}
+	createTableQuery, err := e.showCreateTable(ctx, onlineDDL.Table)
+	if err != nil {
+		return false, err
+	}
+	stmt, err := sqlparser.ParseStrictDDL(createTableQuery)
+	if err != nil {
+		return false, err
+	}
+	createTable, ok := stmt.(*sqlparser.CreateTable)
+	if !ok {
+		return false, vterrors.Errorf(vtrpcpb.Code_UNKNOWN, "expected CREATE TABLE for %s, got: %s", onlineDDL.Table, createTableQuery)
+	}
+	return schemadiff.AlterTableCapableOfInstantDDL(createTable, alterTable, capableOf)
+}
+
+// executeInstantDDL runs an ALTER TABLE migration directly, with ALGORITHM=INSTANT such that MySQL
+// never falls back to copying or rebuilding the table. It returns false, and leaves the table as it
+// is, if MySQL rejects the statement because it cannot apply it instantly.
+func (e *Executor) executeInstantDDL(ctx context.Context, onlineDDL *schema.OnlineDDL, alterTable *sqlparser.AlterTable) (applied bool, err error) {
+	alterTable = sqlparser.CloneRefOfAlterTable(alterTable)
+	alterTable.AlterOptions = append(alterTable.AlterOptions, sqlparser.AlgorithmValue("INSTANT"))
+
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	restoreSQLModeFunc, err := e.initMigrationSQLMode(ctx, onlineDDL, conn)
+	defer restoreSQLModeFunc()
+	if err != nil {
+		return false, err
+	}
+
+	_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusRunning, false, progressPctStarted, etaSecondsUnknown, rowsCopiedUnknown)
+	if _, err := conn.ExecuteFetch(sqlparser.String(alterTable), 0, false); err != nil {
+		if merr, ok := err.(*mysql.SQLError); ok {
+			switch merr.Num {
+			case mysql.ERAlterOperationNotSupported, mysql.ERAlterOperationNotSupportedReason:
+				return false, nil
+			}
+		}
+		return false, err
+	}
+	_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusComplete, false, progressPctFull, etaSecondsNow, rowsCopiedUnknown)
+	return true, nil
+}
+
 func (e *Executor) parseAlterOptions(ctx context.Context, onlineDDL *schema.OnlineDDL) string {
 	// Temporary hack (2020-08-11)
 	// Because sqlparser does not do full blown ALTER TABLE parsing,
@@ -634,8 +787,98 @@ func (e *Executor) terminateVReplMigration(ctx context.Context, uuid string) err
 	return nil
 }
 
-// cutOverVReplMigration stops vreplication, then removes the _vt.vreplication entry for the given migration
-func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) error {
+// cutOverBackoff returns how long to wait before another cut-over attempt, after the given number
+// of failed attempts. The wait doubles with each failure, up to cutOverBackoffMax.
+func cutOverBackoff(failures int64) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+	backoff := cutOverBackoffInitial
+	for i := int64(1); i < failures && backoff < cutOverBackoffMax; i++ {
+		backoff *= 2
+	}
+	if backoff > cutOverBackoffMax {
+		backoff = cutOverBackoffMax
+	}
+	return backoff
+}
+
+// attemptVReplCutOver cuts over a vreplication migration, and counts the attempt as well as its outcome.
+// A failed cut-over does not fail the migration: it is retried on a later review, after a backoff.
+func (e *Executor) attemptVReplCutOver(ctx context.Context, s *VReplStream) {
+	uuid := s.workflow
+	_ = e.incrementCutOverCounter(ctx, sqlIncrementCutOverAttempts, uuid)
+	if err := e.cutOverVReplMigration(ctx, s); err != nil {
+		log.Errorf("cut-over of migration %v failed: %v", uuid, err)
+		_ = e.incrementCutOverCounter(ctx, sqlIncrementCutOverFailures, uuid)
+		_ = e.updateMigrationMessage(ctx, uuid, fmt.Sprintf("cut-over failed: %v", err))
+		e.triggerNextCheckInterval()
+		return
+	}
+	_ = e.incrementCutOverCounter(ctx, sqlIncrementCutOverSuccesses, uuid)
+}
+
+// internalDBUsers returns the MySQL users of the connections vttablet opens for its own
+// operations, such as online DDL, vreplication and replication management. The app users
+// are not among them: the transactions of the applications run as these users, and they
+// are the usual holders of the metadata locks a forced cut-over has to break.
+func (e *Executor) internalDBUsers() map[string]bool {
+	dbConfigs := e.env.Config().DB
+	users := map[string]bool{}
+	for _, user := range []string{
+		dbConfigs.Dba.User,
+		dbConfigs.Filtered.User,
+		dbConfigs.Repl.User,
+	} {
+		if user != "" {
+			users[user] = true
+		}
+	}
+	return users
+}
+
+// killTableLockers kills the processes that hold metadata locks on the given table,
+// so that a forced cut-over does not wait on them. The cut-over connection itself,
+// as well as the internal connections of vttablet, are never killed.
+func (e *Executor) killTableLockers(ctx context.Context, conn *dbconnpool.DBConnection, tableName string) error {
+	query, err := sqlparser.ParseAndBind(sqlFindProcessesLockingTable,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(tableName),
+	)
+	if err != nil {
+		return err
+	}
+	rs, err := conn.ExecuteFetch(query, math.MaxInt32, true)
+	if err != nil {
+		return err
+	}
+	internalUsers := e.internalDBUsers()
+	for _, row := range rs.Named().Rows {
+		processID := row.AsInt64("process_id", 0)
+		if processID == 0 {
+			continue
+		}
+		if internalUsers[row.AsString("process_user", "")] {
+			log.Infof("not killing process %v, which locks table %v: it is an internal vttablet connection", processID, tableName)
+			continue
+		}
+		killQuery, err := sqlparser.ParseAndBind(sqlKillProcess, sqltypes.Int64BindVariable(processID))
+		if err != nil {
+			return err
+		}
+		log.Infof("killing process %v, which locks table %v, for a forced cut-over", processID, tableName)
+		if _, err := conn.ExecuteFetch(killQuery, 0, false); err != nil {
+			// the process may have terminated in the meantime
+			log.Warningf("cannot kill process %v: %v", processID, err)
+		}
+	}
+	return nil
+}
+
+// cutOverVReplMigration stops vreplication, then removes the _vt.vreplication entry for the given migration.
+// If the cut-over fails after vreplication is stopped, the stream is started again, so that the
+// migration keeps up with the source table until the next cut-over attempt.
+func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) (err error) {
 	// sanity checks:
 	vreplTable, err := getVreplTable(ctx, s)
 	if err != nil {
@@ -659,6 +902,11 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 		return err
 	}
 	isVreplicationTestSuite := onlineDDL.StrategySetting().IsVreplicationTestSuite()
+	// The lock timeout is the budget of the steps of the cut-over that block writes to the table
+	lockTimeout, err := onlineDDL.StrategySetting().CutOverLockTimeout()
+	if err != nil {
+		return err
+	}
 
 	// A bit early on, we create the table swap query. We do so here and not at cut-over time
 	// just because there might just be an error, and we prefer to bail out now, and not
@@ -729,7 +977,11 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 	}
 
 	waitForPos := func() error {
-		ctx, cancel := context.WithTimeout(ctx, 2*vreplicationCutOverThreshold)
+		waitTimeout := 2 * vreplicationCutOverThreshold
+		if lockTimeout > 0 {
+			waitTimeout = lockTimeout
+		}
+		ctx, cancel := context.WithTimeout(ctx, waitTimeout)
 		defer cancel()
 		// Wait for target to reach the up-to-date pos
 		if err := tmClient.VReplicationWaitForPos(ctx, tablet.Tablet, int(s.id), mysql.EncodePosition(postWritesPos)); err != nil {
@@ -746,6 +998,18 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 	if _, err := tmClient.VReplicationExec(ctx, tablet.Tablet, binlogplayer.StopVReplication(uint32(s.id), "stopped for online DDL cutover")); err != nil {
 		return err
 	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		// The tables were not swapped. The context may have expired while waiting on locks,
+		// so the stream is restarted with a context of its own.
+		restartCtx, cancel := context.WithTimeout(context.Background(), vreplicationCutOverThreshold)
+		defer cancel()
+		if _, restartErr := tmClient.VReplicationExec(restartCtx, tablet.Tablet, binlogplayer.StartVReplication(uint32(s.id))); restartErr != nil {
+			log.Errorf("cannot restart vreplication for migration %v after a failed cut-over: %v", s.workflow, restartErr)
+		}
+	}()
 
 	// rename tables atomically (remember, writes on source tables are stopped)
 	{
@@ -765,6 +1029,24 @@ func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) er
 				return err
 			}
 			defer conn.Close()
+			if lockTimeout > 0 {
+				lockWaitTimeoutSeconds := int64(lockTimeout.Seconds())
+				if lockWaitTimeoutSeconds < 1 {
+					lockWaitTimeoutSeconds = 1
+				}
+				query, err := sqlparser.ParseAndBind(sqlSetSessionLockWaitTimeout, sqltypes.Int64BindVariable(lockWaitTimeoutSeconds))
+				if err != nil {
+					return err
+				}
+				if _, err := conn.ExecuteFetch(query, 0, false); err != nil {
+					return err
+				}
+			}
+			if onlineDDL.StrategySetting().IsForceCutOver() {
+				if err := e.killTableLockers(ctx, conn, onlineDDL.Table); err != nil {
+					return err
+				}
+			}
 			if _, err = conn.ExecuteFetch(swapQuery, 0, false); err != nil {
 				return err
 			}
@@ -2343,6 +2625,59 @@ func (e *Executor) executeAlterDDLActionMigration(ctx context.Context, onlineDDL
 	}
 
 	// This is a real TABLE
+	if alterTable, ok := ddlStmt.(*sqlparser.AlterTable); ok && onlineDDL.StrategySetting().IsPreferInstantDDL() {
+		// An ALTER that MySQL applies instantly is only a metadata change, and does not benefit
+		// from running through a table copy. We apply it directly, and only fall back to the
+		// migration strategy if MySQL turns out not to apply it instantly.
+		// The migration is marked running and owned while runNextMigration still holds the
+		// migration mutex, so that it is neither picked up again nor run alongside a conflicting
+		// migration while it is attempted.
+		e.ownedRunningMigrations.Store(onlineDDL.UUID, onlineDDL)
+		if err := e.updateMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusRunning); err != nil {
+			return failMigration(err)
+		}
+		go func() {
+			applied, err := e.executeInstantDDLIfCapable(ctx, onlineDDL, alterTable)
+			if err != nil {
+				failMigration(err)
+				return
+			}
+			if applied {
+				e.ownedRunningMigrations.Delete(onlineDDL.UUID)
+				return
+			}
+			if err := e.executeAlterTableWithStrategy(ctx, onlineDDL); err != nil {
+				failMigration(err)
+			}
+		}()
+		return nil
+	}
+	return e.executeAlterTableWithStrategy(ctx, onlineDDL)
+}
+
+// executeInstantDDLIfCapable applies an ALTER TABLE migration with ALGORITHM=INSTANT if MySQL is
+// capable of it. It returns false if the migration still needs to run with its strategy.
+func (e *Executor) executeInstantDDLIfCapable(ctx context.Context, onlineDDL *schema.OnlineDDL, alterTable *sqlparser.AlterTable) (applied bool, err error) {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	isInstantDDLCapable, err := e.isInstantDDLCapable(ctx, onlineDDL, alterTable)
+	if err != nil || !isInstantDDLCapable {
+		return false, err
+	}
+	log.Infof("executeInstantDDLIfCapable: migration %s applies with ALGORITHM=INSTANT, executing directly", onlineDDL.UUID)
+	applied, err = e.executeInstantDDL(ctx, onlineDDL, alterTable)
+	if err == nil && !applied {
+		log.Infof("executeInstantDDLIfCapable: MySQL rejected ALGORITHM=INSTANT for migration %s, running it with strategy %s", onlineDDL.UUID, onlineDDL.Strategy)
+	}
+	return applied, err
+}
+
+// executeAlterTableWithStrategy runs an ALTER TABLE migration with its online strategy.
+func (e *Executor) executeAlterTableWithStrategy(ctx context.Context, onlineDDL *schema.OnlineDDL) error {
+	failMigration := func(err error) error {
+		return e.failMigration(ctx, onlineDDL, err)
+	}
 	switch onlineDDL.Strategy {
 	case schema.DDLStrategyOnline, schema.DDLStrategyVitess:
 		go func() {
@@ -2551,7 +2886,9 @@ func (e *Executor) runNextMigration(ctx context.Context) error {
 	// getNonConflictingMigration finds a single 'ready' migration which does not conflict with running migrations.
 	// Conflicts are:
 	// - a migration is 'ready' but is not set to run _concurrently_, and there's a running migration that is also non-concurrent
-	// - a migration is 'ready' but there's another migration 'running' on the exact same table
+	// - a migration is 'ready' but there's another migration 'running' on the same table, or on a view reading
+	//   from the table (or vice versa)
+	// - a migration is a 'ready' ALTER, and -max_concurrent_online_ddl ALTER migrations are already running
 	getNonConflictingMigration := func() (*schema.OnlineDDL, error) {
 		r, err := e.execQuery(ctx, sqlSelectReadyMigrations)
 		if err != nil {
@@ -2785,6 +3122,7 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 		return countRunnning, cancellable, err
 	}
 	uuidsFoundRunning := map[string]bool{}
+	var runningVReplMigrations []string
 	for _, row := range r.Named().Rows {
 		uuid := row["migration_uuid"].ToString()
 		onlineDDL, _, err := e.readMigration(ctx, uuid)
@@ -2793,6 +3131,9 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 		}
 		postponeCompletion := row.AsBool("postpone_completion", false)
 		elapsedSeconds := row.AsInt64("elapsed_seconds", 0)
+		cutOverFailures := row.AsInt64("cutover_failures", 0)
+		// NULL when there was no cut-over attempt yet
+		secondsSinceLastCutOverAttempt := row.AsInt64("seconds_since_last_cutover_attempt", -1)
 
 		uuidsFoundRunning[uuid] = true
 
@@ -2814,6 +3155,7 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 					// VReplication migrations are unique in this respect: we are able to complete
 					// a vreplicaiton migration started by another tablet.
 					e.ownedRunningMigrations.Store(uuid, onlineDDL)
+					runningVReplMigrations = append(runningVReplMigrations, uuid)
 					_ = e.updateMigrationTimestamp(ctx, "liveness_timestamp", uuid)
 					_ = e.updateMigrationTablet(ctx, uuid)
 					_ = e.updateRowsCopied(ctx, uuid, s.rowsCopied)
@@ -2836,10 +3178,20 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 						isReady = false
 					}
 					if isReady {
-						if err := e.cutOverVReplMigration(ctx, s); err != nil {
-							return countRunnning, cancellable, err
+						// A -cutover-window was validated when the migration was submitted
+						if window, _ := onlineDDL.StrategySetting().CutOverWindow(); window != nil && !window.Contains(time.Now()) {
+							isReady = false
 						}
 					}
+					if isReady && secondsSinceLastCutOverAttempt >= 0 {
+						// back off after failed cut-overs, so as not to block writes to the table over and over
+						if time.Duration(secondsSinceLastCutOverAttempt)*time.Second < cutOverBackoff(cutOverFailures) {
+							isReady = false
+						}
+					}
+					if isReady {
+						e.attemptVReplCutOver(ctx, s)
+					}
 				}
 			}
 		case schema.DDLStrategyPTOSC:
@@ -2876,6 +3228,7 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 		}
 		countRunnning++
 	}
+	e.shareThrottling(runningVReplMigrations)
 	{
 		// now, let's look at UUIDs we own and _think_ should be running, and see which of tham _isn't_ actually running or pending...
 		pendingUUIDS, err := e.readPendingMigrationsUUIDs(ctx)
@@ -3378,6 +3731,18 @@ func (e *Executor) updateRowsCopied(ctx context.Context, uuid string, rowsCopied
 	return err
 }
 
+// incrementCutOverCounter runs one of the queries that count cut-over attempts and their outcome
+func (e *Executor) incrementCutOverCounter(ctx context.Context, sqlQuery string, uuid string) error {
+	query, err := sqlparser.ParseAndBind(sqlQuery,
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
 func (e *Executor) updateMigrationIsView(ctx context.Context, uuid string, isView bool) error {
 	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationIsView,
 		sqltypes.BoolBindVariable(isView),
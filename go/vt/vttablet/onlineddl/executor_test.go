@@ -19,3 +19,71 @@ Functionality of this Executor is tested in go/test/endtoend/onlineddl/...
 */
 
 package onlineddl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/schema"
+)
+
+func TestCutOverBackoff(t *testing.T) {
+	assert.Equal(t, time.Duration(0), cutOverBackoff(0))
+	assert.Equal(t, 10*time.Second, cutOverBackoff(1))
+	assert.Equal(t, 20*time.Second, cutOverBackoff(2))
+	assert.Equal(t, 80*time.Second, cutOverBackoff(4))
+	assert.Equal(t, 10*time.Minute, cutOverBackoff(7))
+	assert.Equal(t, 10*time.Minute, cutOverBackoff(1000))
+}
+
+func TestAllowConcurrentMigration(t *testing.T) {
+	tt := []struct {
+		strategy string
+		sql      string
+		allowed  bool
+	}{
+		{strategy: "vitess -allow-concurrent", sql: "alter table t add column c int", allowed: true},
+		{strategy: "online -allow-concurrent", sql: "alter table t add column c int", allowed: true},
+		{strategy: "vitess", sql: "alter table t add column c int", allowed: false},
+		{strategy: "gh-ost -allow-concurrent", sql: "alter table t add column c int", allowed: false},
+		{strategy: "pt-osc -allow-concurrent", sql: "alter table t add column c int", allowed: false},
+		{strategy: "gh-ost -allow-concurrent", sql: "create table t (id int primary key)", allowed: true},
+	}
+	e := &Executor{}
+	for _, ts := range tt {
+		t.Run(ts.strategy+" "+ts.sql, func(t *testing.T) {
+			setting, err := schema.ParseDDLStrategy(ts.strategy)
+			assert.NoError(t, err)
+			onlineDDL := &schema.OnlineDDL{Strategy: setting.Strategy, Options: setting.Options, SQL: ts.sql}
+			assert.Equal(t, ts.allowed, e.allowConcurrentMigration(onlineDDL))
+		})
+	}
+}
+
+func TestMaxConcurrentAlterMigrations(t *testing.T) {
+	defer func(val int) { *maxConcurrentOnlineDDLs = val }(*maxConcurrentOnlineDDLs)
+	*maxConcurrentOnlineDDLs = 2
+
+	newOnlineDDL := func(uuid, table, sql string) *schema.OnlineDDL {
+		setting, err := schema.ParseDDLStrategy("vitess -allow-concurrent")
+		require.NoError(t, err)
+		return &schema.OnlineDDL{UUID: uuid, Table: table, Strategy: setting.Strategy, Options: setting.Options, SQL: sql}
+	}
+	e := &Executor{}
+	e.ownedRunningMigrations.Store("1", newOnlineDDL("1", "t1", "alter table t1 add column c int"))
+	assert.False(t, e.isAnyConflictingMigrationRunning(newOnlineDDL("2", "t2", "alter table t2 add column c int")))
+
+	e.ownedRunningMigrations.Store("2", newOnlineDDL("2", "t2", "alter table t2 add column c int"))
+	assert.Equal(t, 2, e.countOwnedRunningAlterMigrations())
+	assert.True(t, e.isAnyConflictingMigrationRunning(newOnlineDDL("3", "t3", "alter table t3 add column c int")))
+	assert.False(t, e.isAnyConflictingMigrationRunning(newOnlineDDL("4", "t4", "create table t4 (id int primary key)")))
+
+	e.ownedRunningMigrations.Store("4", newOnlineDDL("4", "t4", "create table t4 (id int primary key)"))
+	assert.Equal(t, 2, e.countOwnedRunningAlterMigrations())
+
+	e.ownedRunningMigrations.Delete("1")
+	assert.False(t, e.isAnyConflictingMigrationRunning(newOnlineDDL("3", "t3", "alter table t3 add column c int")))
+}
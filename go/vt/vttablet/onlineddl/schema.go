@@ -72,6 +72,10 @@ const (
 	alterSchemaMigrationsTableRevertedUUID             = "ALTER TABLE _vt.schema_migrations add column reverted_uuid varchar(64) NOT NULL DEFAULT ''"
 	alterSchemaMigrationsTableRevertedUUIDIndex        = "ALTER TABLE _vt.schema_migrations add KEY reverted_uuid_idx (reverted_uuid(64))"
 	alterSchemaMigrationsTableIsView                   = "ALTER TABLE _vt.schema_migrations add column is_view tinyint unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableCutOverAttempts          = "ALTER TABLE _vt.schema_migrations add column cutover_attempts int unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableCutOverFailures          = "ALTER TABLE _vt.schema_migrations add column cutover_failures int unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableCutOverSuccesses         = "ALTER TABLE _vt.schema_migrations add column cutover_successes int unsigned NOT NULL DEFAULT 0"
+	alterSchemaMigrationsTableLastCutOverAttempt       = "ALTER TABLE _vt.schema_migrations add column last_cutover_attempt_timestamp timestamp NULL DEFAULT NULL"
 
 	sqlInsertMigration = `INSERT IGNORE INTO _vt.schema_migrations (
 		migration_uuid,
@@ -124,6 +128,22 @@ const (
 		WHERE
 			migration_uuid=%a
 	`
+	sqlIncrementCutOverAttempts = `UPDATE _vt.schema_migrations
+			SET cutover_attempts=cutover_attempts+1,
+				last_cutover_attempt_timestamp=NOW()
+		WHERE
+			migration_uuid=%a
+	`
+	sqlIncrementCutOverFailures = `UPDATE _vt.schema_migrations
+			SET cutover_failures=cutover_failures+1
+		WHERE
+			migration_uuid=%a
+	`
+	sqlIncrementCutOverSuccesses = `UPDATE _vt.schema_migrations
+			SET cutover_successes=cutover_successes+1
+		WHERE
+			migration_uuid=%a
+	`
 	sqlUpdateMigrationETASeconds = `UPDATE _vt.schema_migrations
 			SET eta_seconds=%a
 		WHERE
@@ -268,7 +288,9 @@ const (
 	sqlSelectRunningMigrations = `SELECT
 			migration_uuid,
 			postpone_completion,
-			timestampdiff(second, started_timestamp, now()) as elapsed_seconds
+			timestampdiff(second, started_timestamp, now()) as elapsed_seconds,
+			cutover_failures,
+			timestampdiff(second, last_cutover_attempt_timestamp, now()) as seconds_since_last_cutover_attempt
 		FROM _vt.schema_migrations
 		WHERE
 			migration_status='running'
@@ -480,6 +502,7 @@ const (
 	sqlAlterTableOptions = "ALTER TABLE `%a` %s"
 	sqlShowColumnsFrom   = "SHOW COLUMNS FROM `%a`"
 	sqlShowTableStatus   = "SHOW TABLE STATUS LIKE '%a'"
+	sqlShowCreateTable   = "SHOW CREATE TABLE `%a`"
 	sqlGetAutoIncrement  = `
 		SELECT
 			AUTO_INCREMENT
@@ -516,6 +539,20 @@ const (
 		`
 	sqlSwapTables  = "RENAME TABLE `%a` TO `%a`, `%a` TO `%a`, `%a` TO `%a`"
 	sqlRenameTable = "RENAME TABLE `%a` TO `%a`"
+
+	sqlSetSessionLockWaitTimeout = "SET SESSION lock_wait_timeout=%a"
+	sqlFindProcessesLockingTable = `SELECT
+			threads.processlist_id AS process_id,
+			threads.processlist_user AS process_user
+		FROM performance_schema.metadata_locks
+			JOIN performance_schema.threads ON (metadata_locks.owner_thread_id=threads.thread_id)
+		WHERE
+			metadata_locks.object_type='TABLE'
+			AND metadata_locks.object_schema=%a
+			AND metadata_locks.object_name=%a
+			AND threads.processlist_id!=connection_id()
+	`
+	sqlKillProcess = "KILL %a"
 )
 
 const (
@@ -571,4 +608,8 @@ var ApplyDDL = []string{
 	alterSchemaMigrationsTableRevertedUUID,
 	alterSchemaMigrationsTableRevertedUUIDIndex,
 	alterSchemaMigrationsTableIsView,
+	alterSchemaMigrationsTableCutOverAttempts,
+	alterSchemaMigrationsTableCutOverFailures,
+	alterSchemaMigrationsTableCutOverSuccesses,
+	alterSchemaMigrationsTableLastCutOverAttempt,
 }
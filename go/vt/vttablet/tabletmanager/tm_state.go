@@ -39,6 +39,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 )
 
@@ -352,7 +353,7 @@ func (ts *tmState) applyDenyList(ctx context.Context) (err error) {
 		// that we don't add a rule to deny all tables
 		if len(tables) > 0 {
 			log.Infof("Denying tables %v", strings.Join(tables, ", "))
-			qr := rules.NewQueryRule("enforce denied tables", "denied_table", rules.QRFailRetry)
+			qr := rules.NewQueryRule(vterrors.DeniedTables, "denied_table", rules.QRFailRetry)
 			for _, t := range tables {
 				qr.AddTableCond(t)
 			}
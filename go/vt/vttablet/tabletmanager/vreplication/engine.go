@@ -126,7 +126,7 @@ type Engine struct {
 	journaler map[string]*journalEvent
 	ec        *externalConnector
 
-	throttlerClient *throttle.Client
+	lagThrottler *throttle.Throttler
 }
 
 type journalEvent struct {
@@ -139,13 +139,13 @@ type journalEvent struct {
 // A nil ts means that the Engine is disabled.
 func NewEngine(config *tabletenv.TabletConfig, ts *topo.Server, cell string, mysqld mysqlctl.MysqlDaemon, lagThrottler *throttle.Throttler) *Engine {
 	vre := &Engine{
-		controllers:     make(map[int]*controller),
-		ts:              ts,
-		cell:            cell,
-		mysqld:          mysqld,
-		journaler:       make(map[string]*journalEvent),
-		ec:              newExternalConnector(config.ExternalConnections),
-		throttlerClient: throttle.NewBackgroundClient(lagThrottler, throttlerAppName, throttle.ThrottleCheckPrimaryWrite),
+		controllers:  make(map[int]*controller),
+		ts:           ts,
+		cell:         cell,
+		mysqld:       mysqld,
+		journaler:    make(map[string]*journalEvent),
+		ec:           newExternalConnector(config.ExternalConnections),
+		lagThrottler: lagThrottler,
 	}
 
 	return vre
@@ -17,6 +17,7 @@ limitations under the License.
 package vreplication
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -201,6 +202,33 @@ type TablePlan struct {
 	FieldsToSkip            map[string]bool
 	ConvertCharset          map[string](*binlogdatapb.CharsetConversion)
 	HasExtraSourcePkColumns bool
+	// MinMax is set if the target table has min or max columns.
+	MinMax *MinMaxPlan
+}
+
+// MinMaxPlan is used by vplayer to maintain the min and max columns of a
+// grouped table. Inserts and updates fold the new value into these columns,
+// but a deleted or changed row that held the minimum or maximum of its group
+// can only be taken out by recomputing the group from the source rows.
+type MinMaxPlan struct {
+	// Select reads the min and max columns of the group of the before image.
+	Select *sqlparser.ParsedQuery
+	// Update sets the recomputed min and max columns, bound as m_<column>,
+	// of the group of the before image.
+	Update *sqlparser.ParsedQuery
+	// Columns lists the min and max columns in the order returned by Select.
+	Columns []*MinMaxColumn
+	// GroupColumns are the source columns the table is grouped by.
+	GroupColumns []string
+}
+
+// MinMaxColumn is a min or max column of a MinMaxPlan.
+type MinMaxColumn struct {
+	// Name is the name of the target column.
+	Name string
+	// Source is the name of the source column it aggregates.
+	Source string
+	IsMax  bool
 }
 
 // MarshalJSON performs a custom JSON Marshalling.
@@ -214,6 +242,8 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 		Insert       *sqlparser.ParsedQuery `json:",omitempty"`
 		Update       *sqlparser.ParsedQuery `json:",omitempty"`
 		Delete       *sqlparser.ParsedQuery `json:",omitempty"`
+		MinMaxSelect *sqlparser.ParsedQuery `json:",omitempty"`
+		MinMaxUpdate *sqlparser.ParsedQuery `json:",omitempty"`
 		PKReferences []string               `json:",omitempty"`
 	}{
 		TargetName:   tp.TargetName,
@@ -226,6 +256,10 @@ func (tp *TablePlan) MarshalJSON() ([]byte, error) {
 		Delete:       tp.Delete,
 		PKReferences: tp.PKReferences,
 	}
+	if tp.MinMax != nil {
+		v.MinMaxSelect = tp.MinMax.Select
+		v.MinMaxUpdate = tp.MinMax.Update
+	}
 	return json.Marshal(&v)
 }
 
@@ -388,6 +422,159 @@ func (tp *TablePlan) applyChange(rowChange *binlogdatapb.RowChange, executor fun
 	return nil, nil
 }
 
+// applyMinMax recomputes the min and max columns of the group of the before
+// image of rowChange if the change took away the minimum or maximum of that
+// group. It must be called after the change itself was applied. The rows of
+// the group are streamed from the source, which can be ahead of the applied
+// position: events up to that position fold into the recomputed values again,
+// which min and max tolerate.
+func (tp *TablePlan) applyMinMax(ctx context.Context, rowChange *binlogdatapb.RowChange, vsClient VStreamerClient, executor func(string) (*sqltypes.Result, error)) error {
+	if tp.MinMax == nil || rowChange.Before == nil {
+		return nil
+	}
+	before := sqltypes.MakeRowTrusted(tp.Fields, rowChange.Before)
+	var after []sqltypes.Value
+	if rowChange.After != nil {
+		after = sqltypes.MakeRowTrusted(tp.Fields, rowChange.After)
+	}
+	bindvars := make(map[string]*querypb.BindVariable, len(tp.Fields)+len(tp.MinMax.Columns))
+	for i, field := range tp.Fields {
+		bindvars["b_"+field.Name] = sqltypes.ValueBindVariable(before[i])
+	}
+	qr, err := execParsedQuery(tp.MinMax.Select, bindvars, executor)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) == 0 {
+		// The change was not applied because the row is beyond lastpk.
+		return nil
+	}
+	groupChanged := after != nil && !tp.sameGroup(before, after)
+	taken := false
+	for i, col := range tp.MinMax.Columns {
+		idx := tp.fieldIndex(col.Source)
+		if before[idx].IsNull() {
+			continue
+		}
+		if after != nil && !groupChanged && valsEqual(before[idx], after[idx]) {
+			continue
+		}
+		// If the values cannot be compared, the group is recomputed to be safe.
+		result, err := evalengine.NullsafeCompare(before[idx], qr.Rows[0][i], collations.ID(tp.Fields[idx].Charset))
+		if err != nil || result == 0 {
+			taken = true
+			break
+		}
+	}
+	if !taken {
+		return nil
+	}
+
+	query, err := tp.minMaxSourceQuery(before)
+	if err != nil {
+		return err
+	}
+	values := make([]sqltypes.Value, len(tp.MinMax.Columns))
+	err = vsClient.VStreamRows(ctx, query, nil, func(rows *binlogdatapb.VStreamRowsResponse) error {
+		for _, row := range rows.Rows {
+			vals := sqltypes.MakeRowTrusted(tp.Fields, row)
+			if !tp.sameGroup(before, vals) {
+				continue
+			}
+			for i, col := range tp.MinMax.Columns {
+				idx := tp.fieldIndex(col.Source)
+				// NULL values are ignored by MIN and MAX.
+				if vals[idx].IsNull() {
+					continue
+				}
+				if values[i].IsNull() {
+					values[i] = vals[idx]
+					continue
+				}
+				result, err := evalengine.NullsafeCompare(vals[idx], values[i], collations.ID(tp.Fields[idx].Charset))
+				if err != nil {
+					return err
+				}
+				if (col.IsMax && result > 0) || (!col.IsMax && result < 0) {
+					values[i] = vals[idx]
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for i, col := range tp.MinMax.Columns {
+		bindvars["m_"+col.Name] = sqltypes.ValueBindVariable(values[i])
+	}
+	_, err = execParsedQuery(tp.MinMax.Update, bindvars, executor)
+	return err
+}
+
+// minMaxSourceQuery returns the query that streams the source rows of the
+// group of the given row. The vstreamer can only filter on integral and string
+// literals, so the group values of other types are matched by sameGroup alone.
+func (tp *TablePlan) minMaxSourceQuery(row []sqltypes.Value) (string, error) {
+	stmt, err := sqlparser.Parse(tp.SendRule.Filter)
+	if err != nil {
+		return "", err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return "", fmt.Errorf("unexpected: %v", tp.SendRule.Filter)
+	}
+	for _, name := range tp.MinMax.GroupColumns {
+		val := row[tp.fieldIndex(name)]
+		var literal *sqlparser.Literal
+		switch {
+		case val.IsNull():
+			continue
+		case val.IsIntegral():
+			literal = sqlparser.NewIntLiteral(val.ToString())
+		case val.IsText() || val.IsBinary():
+			literal = sqlparser.NewStrLiteral(val.ToString())
+		default:
+			continue
+		}
+		sel.AddWhere(&sqlparser.ComparisonExpr{
+			Operator: sqlparser.EqualOp,
+			Left:     &sqlparser.ColName{Name: sqlparser.NewColIdent(name)},
+			Right:    literal,
+		})
+	}
+	return sqlparser.String(sel), nil
+}
+
+// sameGroup returns true if both rows have the same values for the group columns.
+func (tp *TablePlan) sameGroup(row1, row2 []sqltypes.Value) bool {
+	for _, name := range tp.MinMax.GroupColumns {
+		idx := tp.fieldIndex(name)
+		v1, v2 := row1[idx], row2[idx]
+		if v1.IsNull() || v2.IsNull() {
+			if v1.IsNull() != v2.IsNull() {
+				return false
+			}
+			continue
+		}
+		result, err := evalengine.NullsafeCompare(v1, v2, collations.ID(tp.Fields[idx].Charset))
+		if err != nil || result != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldIndex returns the index of the named field.
+func (tp *TablePlan) fieldIndex(name string) int {
+	for i, field := range tp.Fields {
+		if strings.EqualFold(field.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
 func execParsedQuery(pq *sqlparser.ParsedQuery, bindvars map[string]*querypb.BindVariable, executor func(string) (*sqltypes.Result, error)) (*sqltypes.Result, error) {
 	sql, err := pq.GenerateQuery(bindvars, nil)
 	if err != nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package vreplication
 
 import (
+	"context"
 	"encoding/json"
 	"strings"
 	"testing"
@@ -24,9 +25,11 @@ import (
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 type TestReplicatorPlan struct {
@@ -44,6 +47,8 @@ type TestTablePlan struct {
 	Insert       string   `json:",omitempty"`
 	Update       string   `json:",omitempty"`
 	Delete       string   `json:",omitempty"`
+	MinMaxSelect string   `json:",omitempty"`
+	MinMaxUpdate string   `json:",omitempty"`
 	PKReferences []string `json:",omitempty"`
 }
 
@@ -316,6 +321,62 @@ func TestBuildPlayerPlan(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		// min and max
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, min(c2) as mn, max(c3) as mx from t2 group by c1",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, c2, c3 from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1"},
+					InsertFront:  "insert into t1(c1,mn,mx)",
+					InsertValues: "(:a_c1,:a_c2,:a_c3)",
+					InsertOnDup:  "on duplicate key update mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx))",
+					Insert:       "insert into t1(c1,mn,mx) values (:a_c1,:a_c2,:a_c3) on duplicate key update mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx))",
+					Update:       "update t1 set mn=least(ifnull(mn, :a_c2), ifnull(:a_c2, mn)), mx=greatest(ifnull(mx, :a_c3), ifnull(:a_c3, mx)) where c1=:b_c1",
+					Delete:       "update t1 set mn=mn, mx=mx where c1=:b_c1",
+					MinMaxSelect: "select mn, mx from t1 where c1=:b_c1",
+					MinMaxUpdate: "update t1 set mn=:m_mn, mx=:m_mx where c1=:b_c1",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select c1, c2, c3, pk1, pk2 from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"c1", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,mn,mx)",
+					InsertValues: "(:a_c1,:a_c2,:a_c3)",
+					InsertOnDup:  "on duplicate key update mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx))",
+					Insert:       "insert into t1(c1,mn,mx) select :a_c1, :a_c2, :a_c3 from dual where (:a_pk1,:a_pk2) <= (1,'aaa') on duplicate key update mn=least(ifnull(mn, values(mn)), ifnull(values(mn), mn)), mx=greatest(ifnull(mx, values(mx)), ifnull(values(mx), mx))",
+					Update:       "update t1 set mn=least(ifnull(mn, :a_c2), ifnull(:a_c2, mn)), mx=greatest(ifnull(mx, :a_c3), ifnull(:a_c3, mx)) where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "update t1 set mn=mn, mx=mx where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					MinMaxSelect: "select mn, mx from t1 where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					MinMaxUpdate: "update t1 set mn=:m_mn, mx=:m_mx where c1=:b_c1 and (:b_pk1,:b_pk2) <= (1,'aaa')",
+				},
+			},
+		},
 	}, {
 		input: &binlogdatapb.Filter{
 			Rules: []*binlogdatapb.Rule{{
@@ -667,6 +728,15 @@ func TestBuildPlayerPlan(t *testing.T) {
 			}},
 		},
 		err: "group by expression is not allowed to reference an aggregate expression: a",
+	}, {
+		// min and max need the group by columns to recompute a group
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select a+b as c, max(d) as m from t1 group by c",
+			}},
+		},
+		err: "unsupported: min or max with a group by expression that is not a column: a + b",
 	}}
 
 	PrimaryKeyInfos := map[string][]*ColumnInfo{
@@ -768,3 +838,99 @@ func TestBuildPlayerPlanExclude(t *testing.T) {
 	wantPlan, _ := json.Marshal(want)
 	assert.Equal(t, string(gotPlan), string(wantPlan))
 }
+
+type fakeMinMaxStreamer struct {
+	VStreamerClient
+	query string
+	rows  []*querypb.Row
+}
+
+func (fs *fakeMinMaxStreamer) VStreamRows(ctx context.Context, query string, lastpk *querypb.QueryResult, send func(*binlogdatapb.VStreamRowsResponse) error) error {
+	fs.query = query
+	return send(&binlogdatapb.VStreamRowsResponse{Rows: fs.rows})
+}
+
+func TestApplyMinMax(t *testing.T) {
+	input := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "select c1, min(c2) as mn, max(c3) as mx from t2 group by c1",
+		}},
+	}
+	colInfos := map[string][]*ColumnInfo{
+		"t1": {&ColumnInfo{Name: "c1", IsPK: true}},
+	}
+	rp, err := buildReplicatorPlan(input, colInfos, nil, binlogplayer.NewStats())
+	require.NoError(t, err)
+	fields := sqltypes.MakeTestFields("c1|c2|c3", "int64|int64|int64")
+	tp, err := rp.buildExecutionPlan(&binlogdatapb.FieldEvent{TableName: "t2", Fields: fields})
+	require.NoError(t, err)
+
+	row := func(vals ...int64) *querypb.Row {
+		var values []sqltypes.Value
+		for _, val := range vals {
+			if val < 0 {
+				values = append(values, sqltypes.NULL)
+				continue
+			}
+			values = append(values, sqltypes.NewInt64(val))
+		}
+		return sqltypes.RowToProto3(values)
+	}
+	// The view holds mn=1 and mx=5 for the group c1=1.
+	stored := sqltypes.MakeTestResult(sqltypes.MakeTestFields("mn|mx", "int64|int64"), "1|5")
+	testcases := []struct {
+		name    string
+		change  *binlogdatapb.RowChange
+		query   string
+		queries []string
+	}{{
+		name:    "delete of the maximum",
+		change:  &binlogdatapb.RowChange{Before: row(1, 2, 5)},
+		query:   "select c1, c2, c3 from t2 where c1 = 1",
+		queries: []string{"select mn, mx from t1 where c1=1", "update t1 set mn=1, mx=3 where c1=1"},
+	}, {
+		name:    "update that lowers the maximum",
+		change:  &binlogdatapb.RowChange{Before: row(1, 2, 5), After: row(1, 2, 0)},
+		query:   "select c1, c2, c3 from t2 where c1 = 1",
+		queries: []string{"select mn, mx from t1 where c1=1", "update t1 set mn=1, mx=3 where c1=1"},
+	}, {
+		name:    "update that moves the maximum to another group",
+		change:  &binlogdatapb.RowChange{Before: row(1, 2, 5), After: row(2, 2, 5)},
+		query:   "select c1, c2, c3 from t2 where c1 = 1",
+		queries: []string{"select mn, mx from t1 where c1=1", "update t1 set mn=1, mx=3 where c1=1"},
+	}, {
+		name:    "update that keeps the maximum",
+		change:  &binlogdatapb.RowChange{Before: row(1, 3, 5), After: row(1, 2, 5)},
+		queries: []string{"select mn, mx from t1 where c1=1"},
+	}, {
+		name:    "delete of neither minimum nor maximum",
+		change:  &binlogdatapb.RowChange{Before: row(1, 3, 4)},
+		queries: []string{"select mn, mx from t1 where c1=1"},
+	}, {
+		name:    "delete of a null value",
+		change:  &binlogdatapb.RowChange{Before: row(1, -1, -1)},
+		queries: []string{"select mn, mx from t1 where c1=1"},
+	}, {
+		name:   "insert",
+		change: &binlogdatapb.RowChange{After: row(1, 0, 9)},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			// The source still has these rows of group 1, and one of group 2 that
+			// must be ignored.
+			fs := &fakeMinMaxStreamer{rows: []*querypb.Row{row(1, 1, 3), row(1, 4, -1), row(2, 0, 9)}}
+			var queries []string
+			err := tp.applyMinMax(context.Background(), tcase.change, fs, func(sql string) (*sqltypes.Result, error) {
+				queries = append(queries, sql)
+				if strings.HasPrefix(sql, "select") {
+					return stored, nil
+				}
+				return &sqltypes.Result{}, nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tcase.query, fs.query)
+			assert.Equal(t, tcase.queries, queries)
+		})
+	}
+}
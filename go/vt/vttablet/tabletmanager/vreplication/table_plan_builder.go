@@ -64,6 +64,7 @@ type colExpr struct {
 	// operation==opExpr: full expression is set
 	// operation==opCount: nothing is set.
 	// operation==opSum: for 'sum(a)', expr is set to 'a'.
+	// operation==opMin, opMax: for 'min(a)' or 'max(a)', expr is set to 'a'.
 	operation operation
 	// expr stores the expected field name from vstreamer and dictates
 	// the generated bindvar names, like a_col or b_col.
@@ -85,6 +86,8 @@ const (
 	opExpr = operation(iota)
 	opCount
 	opSum
+	opMin
+	opMax
 )
 
 // insertType describes the type of insert statement to generate.
@@ -353,6 +356,7 @@ func (tpb *tablePlanBuilder) generate() *TablePlan {
 		Stats:                   tpb.stats,
 		FieldsToSkip:            fieldsToSkip,
 		HasExtraSourcePkColumns: (len(tpb.extraSourcePkCols) > 0),
+		MinMax:                  tpb.generateMinMaxPlan(),
 	}
 }
 
@@ -433,7 +437,7 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 			}
 			cexpr.operation = opCount
 			return cexpr, nil
-		case "sum":
+		case "sum", "min", "max":
 			if len(expr.Exprs) != 1 {
 				return nil, fmt.Errorf("unexpected: %v", sqlparser.String(expr))
 			}
@@ -448,7 +452,14 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 			if !innerCol.Qualifier.IsEmpty() {
 				return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(innerCol))
 			}
-			cexpr.operation = opSum
+			switch fname {
+			case "sum":
+				cexpr.operation = opSum
+			case "min":
+				cexpr.operation = opMin
+			case "max":
+				cexpr.operation = opMax
+			}
 			cexpr.expr = innerCol
 			tpb.addCol(innerCol.Name)
 			cexpr.references[innerCol.Name.Lowered()] = true
@@ -515,6 +526,18 @@ func (tpb *tablePlanBuilder) analyzeGroupBy(groupBy sqlparser.GroupBy) error {
 		}
 		cexpr.isGrouped = true
 	}
+	// The min and max of a group are recomputed by streaming the rows of the group
+	// from the source, which is filtered by the values of its columns.
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.operation != opMin && cexpr.operation != opMax {
+			continue
+		}
+		for _, gexpr := range tpb.colExprs {
+			if _, ok := gexpr.expr.(*sqlparser.ColName); gexpr.isGrouped && !ok {
+				return fmt.Errorf("unsupported: min or max with a group by expression that is not a column: %v", sqlparser.String(gexpr.expr))
+			}
+		}
+	}
 	// If all colExprs are grouped, then it's an insertIgnore.
 	tpb.onInsert = insertIgnore
 	for _, cExpr := range tpb.colExprs {
@@ -679,6 +702,8 @@ func (tpb *tablePlanBuilder) generateValuesPart(buf *sqlparser.TrackedBuffer, bv
 		case opSum:
 			// NULL values must be treated as 0 for SUM.
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
+		case opMin, opMax:
+			buf.Myprintf("%v", cexpr.expr)
 		}
 	}
 	buf.Myprintf(")")
@@ -702,6 +727,8 @@ func (tpb *tablePlanBuilder) generateSelectPart(buf *sqlparser.TrackedBuffer, bv
 			buf.WriteString("1")
 		case opSum:
 			buf.Myprintf("ifnull(%v, 0)", cexpr.expr)
+		case opMin, opMax:
+			buf.Myprintf("%v", cexpr.expr)
 		}
 	}
 	buf.WriteString(" from dual where ")
@@ -739,6 +766,10 @@ func (tpb *tablePlanBuilder) generateOnDupPart(buf *sqlparser.TrackedBuffer) *sq
 		case opSum:
 			buf.Myprintf("%v", cexpr.colName)
 			buf.Myprintf("+ifnull(values(%v), 0)", cexpr.colName)
+		case opMin, opMax:
+			// NULL values are ignored by MIN and MAX.
+			buf.Myprintf("%s(ifnull(%v, values(%v)), ifnull(values(%v), %v))",
+				minMaxFunc(cexpr.operation), cexpr.colName, cexpr.colName, cexpr.colName, cexpr.colName)
 		}
 	}
 	return buf.ParsedQuery()
@@ -777,6 +808,12 @@ func (tpb *tablePlanBuilder) generateUpdateStatement() *sqlparser.ParsedQuery {
 			buf.Myprintf("-ifnull(%v, 0)", cexpr.expr)
 			bvf.mode = bvAfter
 			buf.Myprintf("+ifnull(%v, 0)", cexpr.expr)
+		case opMin, opMax:
+			// The new value is folded in. If the old value was the minimum
+			// or maximum, vplayer recomputes the group through the MinMaxPlan.
+			bvf.mode = bvAfter
+			buf.Myprintf("%s(ifnull(%v, %v), ifnull(%v, %v))",
+				minMaxFunc(cexpr.operation), cexpr.colName, cexpr.expr, cexpr.expr, cexpr.colName)
 		}
 	}
 	tpb.generateWhere(buf, bvf)
@@ -807,6 +844,10 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 				buf.Myprintf("%v-1", cexpr.colName)
 			case opSum:
 				buf.Myprintf("%v-ifnull(%v, 0)", cexpr.colName, cexpr.expr)
+			case opMin, opMax:
+				// A deleted minimum or maximum is recomputed by vplayer
+				// through the MinMaxPlan.
+				buf.Myprintf("%v", cexpr.colName)
 			}
 		}
 		tpb.generateWhere(buf, bvf)
@@ -816,6 +857,59 @@ func (tpb *tablePlanBuilder) generateDeleteStatement() *sqlparser.ParsedQuery {
 	return buf.ParsedQuery()
 }
 
+// generateMinMaxPlan returns the plan that recomputes the min and max columns
+// of a group, or nil if the table has none.
+func (tpb *tablePlanBuilder) generateMinMaxPlan() *MinMaxPlan {
+	if tpb.onInsert != insertOnDup {
+		return nil
+	}
+	plan := &MinMaxPlan{}
+	bvf := &bindvarFormatter{}
+	sel := sqlparser.NewTrackedBuffer(bvf.formatter)
+	upd := sqlparser.NewTrackedBuffer(bvf.formatter)
+	sel.Myprintf("select ")
+	upd.Myprintf("update %v set ", tpb.name)
+	separator := ""
+	for _, cexpr := range tpb.colExprs {
+		if cexpr.isGrouped {
+			// analyzeGroupBy made sure that these are columns if there are min or max columns.
+			if col, ok := cexpr.expr.(*sqlparser.ColName); ok {
+				plan.GroupColumns = append(plan.GroupColumns, col.Name.String())
+			}
+			continue
+		}
+		if cexpr.operation != opMin && cexpr.operation != opMax {
+			continue
+		}
+		name := cexpr.colName.String()
+		plan.Columns = append(plan.Columns, &MinMaxColumn{
+			Name:   name,
+			Source: cexpr.expr.(*sqlparser.ColName).Name.String(),
+			IsMax:  cexpr.operation == opMax,
+		})
+		sel.Myprintf("%s%v", separator, cexpr.colName)
+		upd.Myprintf("%s%v=%a", separator, cexpr.colName, ":m_"+name)
+		separator = ", "
+	}
+	if len(plan.Columns) == 0 {
+		return nil
+	}
+	sel.Myprintf(" from %v", tpb.name)
+	tpb.generateWhere(sel, bvf)
+	tpb.generateWhere(upd, bvf)
+	plan.Select = sel.ParsedQuery()
+	plan.Update = upd.ParsedQuery()
+	return plan
+}
+
+// minMaxFunc returns the function that combines two values of a MIN or MAX column.
+func minMaxFunc(op operation) string {
+	if op == opMin {
+		return "least"
+	}
+	return "greatest"
+}
+
 func (tpb *tablePlanBuilder) generateWhere(buf *sqlparser.TrackedBuffer, bvf *bindvarFormatter) {
 	buf.WriteString(" where ")
 	bvf.mode = bvBefore
@@ -38,6 +38,7 @@ import (
 	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 )
@@ -101,6 +102,10 @@ type vreplicator struct {
 
 	originalFKCheckSetting int64
 	originalSQLMode        string
+
+	// throttlerClient checks the throttler on behalf of this stream only, so that the
+	// throttler can tell the streams of different workflows apart
+	throttlerClient *throttle.Client
 }
 
 // newVReplicator creates a new vreplicator. The valid fields from the source are:
@@ -124,7 +129,7 @@ type vreplicator struct {
 //   alias like "a+b as targetcol" must be used.
 //   More advanced constructs can be used. Please see the table plan builder
 //   documentation for more info.
-func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
+func newVReplicator(id uint32, workflow string, source *binlogdatapb.BinlogSource, sourceVStreamer VStreamerClient, stats *binlogplayer.Stats, dbClient binlogplayer.DBClient, mysqld mysqlctl.MysqlDaemon, vre *Engine) *vreplicator {
 	if *vreplicationHeartbeatUpdateInterval > vreplicationMinimumHeartbeatUpdateInterval {
 		log.Warningf("the supplied value for vreplication_heartbeat_update_interval:%d seconds is larger than the maximum allowed:%d seconds, vreplication will fallback to %d",
 			*vreplicationHeartbeatUpdateInterval, vreplicationMinimumHeartbeatUpdateInterval, vreplicationMinimumHeartbeatUpdateInterval)
@@ -137,9 +142,16 @@ func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreame
 		stats:           stats,
 		dbClient:        newVDBClient(dbClient, stats),
 		mysqld:          mysqld,
+		throttlerClient: throttle.NewBackgroundClient(vre.lagThrottler, workflowThrottlerAppName(workflow), throttle.ThrottleCheckPrimaryWrite),
 	}
 }
 
+// workflowThrottlerAppName returns the name the streams of the given workflow check the throttler with.
+// Throttling either "vreplication" or the workflow name throttles the streams.
+func workflowThrottlerAppName(workflow string) string {
+	return fmt.Sprintf("%s:%s", throttlerAppName, workflow)
+}
+
 // Replicate starts a vreplication stream. It can be in one of three phases:
 // 1. Init: If a request is issued with no starting position, we assume that the
 // contents of the tables must be copied first. During this phase, the list of
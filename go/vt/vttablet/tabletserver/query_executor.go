@@ -91,6 +91,19 @@ var sequenceFields = []*querypb.Field{
 	},
 }
 
+// interleavedSequenceFields are returned by the sequences whose increment is
+// more than 1, so that the caller knows how far apart the values it got are.
+var interleavedSequenceFields = []*querypb.Field{
+	{
+		Name: "nextval",
+		Type: sqltypes.Int64,
+	},
+	{
+		Name: "increment",
+		Type: sqltypes.Int64,
+	},
+}
+
 func (qre *QueryExecutor) shouldConsolidate() bool {
 	cm := qre.tsv.qe.consolidatorMode.Get()
 	return cm == tabletenv.Enable || (cm == tabletenv.NotOnPrimary && qre.tabletType != topodatapb.TabletType_PRIMARY)
@@ -157,6 +170,9 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		if qre.bindVars[sqltypes.BvReplaceSchemaName] != nil {
 			qre.bindVars[sqltypes.BvSchemaName] = sqltypes.StringBindVariable(qre.tsv.config.DB.DBName)
 		}
+		if err := qre.waitForReadAfterWriteGTID(); err != nil {
+			return nil, err
+		}
 		qr, err := qre.execSelect()
 		if err != nil {
 			return nil, err
@@ -279,6 +295,12 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 		return err
 	}
 
+	if qre.connID == 0 {
+		if err := qre.waitForReadAfterWriteGTID(); err != nil {
+			return err
+		}
+	}
+
 	sql, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
 	if err != nil {
 		return err
@@ -536,68 +558,158 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid increment for sequence %s: %s", tableName, v.String())
 	}
 
-	t := qre.plan.Table
-	t.SequenceInfo.Lock()
-	defer t.SequenceInfo.Unlock()
-	if t.SequenceInfo.NextVal == 0 || t.SequenceInfo.NextVal+inc > t.SequenceInfo.LastVal {
+	seq := qre.plan.Table.SequenceInfo
+	seq.Lock()
+	defer seq.Unlock()
+	if seq.NextVal == 0 || seq.NextVal+inc*seq.Increment > seq.LastVal {
 		_, err := qre.execAsTransaction(func(conn *StatefulConnection) (*sqltypes.Result, error) {
-			query := fmt.Sprintf("select next_id, cache from %s where id = 0 for update", sqlparser.String(tableName))
-			qr, err := qre.execStatefulConn(conn, query, false)
+			nextID, cache, increment, err := qre.readSequence(conn, tableName, seq.HasIncrement)
 			if err != nil {
 				return nil, err
 			}
-			if len(qr.Rows) != 1 {
-				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected rows from reading sequence %s (possible mis-route): %d", tableName, len(qr.Rows))
-			}
-			nextID, err := evalengine.ToInt64(qr.Rows[0][0])
-			if err != nil {
-				return nil, vterrors.Wrapf(err, "error loading sequence %s", tableName)
-			}
 			// If LastVal does not match next ID, then either:
 			// VTTablet just started, and we're initializing the cache, or
-			// Someone reset the id underneath us.
-			if t.SequenceInfo.LastVal != nextID {
-				if nextID < t.SequenceInfo.LastVal {
-					log.Warningf("Sequence next ID value %v is below the currently cached max %v, updating it to max", nextID, t.SequenceInfo.LastVal)
-					nextID = t.SequenceInfo.LastVal
+			// Someone reset the id (or the increment) underneath us.
+			if seq.LastVal != nextID || seq.Increment != increment {
+				if nextID < seq.LastVal {
+					log.Warningf("Sequence next ID value %v is below the currently cached max %v, updating it to max", nextID, seq.LastVal)
+					nextID = seq.LastVal
 				}
-				t.SequenceInfo.NextVal = nextID
-				t.SequenceInfo.LastVal = nextID
+				seq.NextVal = nextID
+				seq.LastVal = nextID
 			}
-			cache, err := evalengine.ToInt64(qr.Rows[0][1])
-			if err != nil {
-				return nil, vterrors.Wrapf(err, "error loading sequence %s", tableName)
-			}
-			if cache < 1 {
-				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid cache value for sequence %s: %d", tableName, cache)
-			}
-			newLast := nextID + cache
-			for newLast < t.SequenceInfo.NextVal+inc {
-				newLast += cache
+			seq.Increment = increment
+			seq.Cache = cache
+			block := cache * increment
+			newLast := nextID + block
+			for newLast < seq.NextVal+inc*increment {
+				newLast += block
 			}
-			query = fmt.Sprintf("update %s set next_id = %d where id = 0", sqlparser.String(tableName), newLast)
-			conn.TxProperties().RecordQuery(query)
-			_, err = qre.execStatefulConn(conn, query, false)
-			if err != nil {
+			if err := qre.writeSequence(conn, tableName, newLast); err != nil {
 				return nil, err
 			}
-			t.SequenceInfo.LastVal = newLast
+			seq.LastVal = newLast
 			return nil, nil
 		})
 		if err != nil {
 			return nil, err
 		}
 	}
-	ret := t.SequenceInfo.NextVal
-	t.SequenceInfo.NextVal += inc
+	ret := seq.NextVal
+	seq.NextVal += inc * seq.Increment
+	qre.prefetchSequence(tableName)
+	if seq.Increment == 1 {
+		return &sqltypes.Result{
+			Fields: sequenceFields,
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewInt64(ret),
+			}},
+		}, nil
+	}
 	return &sqltypes.Result{
-		Fields: sequenceFields,
+		Fields: interleavedSequenceFields,
 		Rows: [][]sqltypes.Value{{
 			sqltypes.NewInt64(ret),
+			sqltypes.NewInt64(seq.Increment),
 		}},
 	}, nil
 }
 
+// readSequence locks the row of the sequence table, and returns its next_id,
+// cache and increment. The increment is 1 if the table has no increment column,
+// or if it's NULL.
+func (qre *QueryExecutor) readSequence(conn *StatefulConnection, tableName sqlparser.TableIdent, hasIncrement bool) (nextID, cache, increment int64, err error) {
+	query := fmt.Sprintf("select next_id, cache from %s where id = 0 for update", sqlparser.String(tableName))
+	if hasIncrement {
+		query = fmt.Sprintf("select next_id, cache, increment from %s where id = 0 for update", sqlparser.String(tableName))
+	}
+	qr, err := qre.execStatefulConn(conn, query, false)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if len(qr.Rows) != 1 {
+		return 0, 0, 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected rows from reading sequence %s (possible mis-route): %d", tableName, len(qr.Rows))
+	}
+	nextID, err = evalengine.ToInt64(qr.Rows[0][0])
+	if err != nil {
+		return 0, 0, 0, vterrors.Wrapf(err, "error loading sequence %s", tableName)
+	}
+	cache, err = evalengine.ToInt64(qr.Rows[0][1])
+	if err != nil {
+		return 0, 0, 0, vterrors.Wrapf(err, "error loading sequence %s", tableName)
+	}
+	if cache < 1 {
+		return 0, 0, 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid cache value for sequence %s: %d", tableName, cache)
+	}
+	increment = 1
+	if hasIncrement && !qr.Rows[0][2].IsNull() {
+		increment, err = evalengine.ToInt64(qr.Rows[0][2])
+		if err != nil {
+			return 0, 0, 0, vterrors.Wrapf(err, "error loading sequence %s", tableName)
+		}
+		if increment < 1 {
+			return 0, 0, 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid increment value for sequence %s: %d", tableName, increment)
+		}
+	}
+	return nextID, cache, increment, nil
+}
+
+func (qre *QueryExecutor) writeSequence(conn *StatefulConnection, tableName sqlparser.TableIdent, nextID int64) error {
+	query := fmt.Sprintf("update %s set next_id = %d where id = 0", sqlparser.String(tableName), nextID)
+	conn.TxProperties().RecordQuery(query)
+	_, err := qre.execStatefulConn(conn, query, false)
+	return err
+}
+
+// prefetchSequence allocates the block that follows the cached one in the
+// background, once less than the configured percentage of the cache remains.
+// It must be called with the sequence locked.
+func (qre *QueryExecutor) prefetchSequence(tableName sqlparser.TableIdent) {
+	seq := qre.plan.Table.SequenceInfo
+	percent := int64(qre.tsv.config.SequencePrefetchPercent)
+	if percent == 0 || seq.Cache <= 1 || seq.Prefetching || seq.Remaining()*100 >= seq.Cache*percent {
+		return
+	}
+	seq.Prefetching = true
+
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), qre.tsv.config.Oltp.TxTimeoutSeconds.Get())
+	prefetcher := &QueryExecutor{
+		bindVars: make(map[string]*querypb.BindVariable),
+		options:  qre.options,
+		ctx:      ctx,
+		logStats: tabletenv.NewLogStats(ctx, "SequencePrefetch"),
+		tsv:      qre.tsv,
+	}
+	go func() {
+		defer cancel()
+		var nextID, increment, newLast int64
+		_, err := prefetcher.execAsTransaction(func(conn *StatefulConnection) (*sqltypes.Result, error) {
+			var cache int64
+			var err error
+			nextID, cache, increment, err = prefetcher.readSequence(conn, tableName, seq.HasIncrement)
+			if err != nil {
+				return nil, err
+			}
+			newLast = nextID + cache*increment
+			return nil, prefetcher.writeSequence(conn, tableName, newLast)
+		})
+
+		seq.Lock()
+		defer seq.Unlock()
+		seq.Prefetching = false
+		if err != nil {
+			log.Warningf("Could not prefetch the next block of sequence %s: %v", sqlparser.String(tableName), err)
+			return
+		}
+		// The block can only extend the cached one if it follows it. If the cache
+		// was refilled in the meantime, or the sequence was reset underneath us,
+		// the block is given up, which only leaves a gap.
+		if nextID == seq.LastVal && increment == seq.Increment {
+			seq.LastVal = newLast
+		}
+	}()
+}
+
 // execSelect sends a query to mysql only if another identical query is not running. Otherwise, it waits and
 // reuses the result. If the plan is missing field info, it sends the query to mysql requesting full info.
 func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
@@ -624,6 +736,93 @@ func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 	return qre.execDBConn(conn, sql, true)
 }
 
+// waitForReadAfterWriteGTID makes a replica wait until it has applied the GTID set
+// sent by vtgate for read after write consistency. GTIDs of servers the replica does
+// not replicate from, like the primaries of other shards, are ignored. If the replica
+// does not catch up within the timeout sent along, it returns an error and vtgate
+// sends the query to the primary instead.
+func (qre *QueryExecutor) waitForReadAfterWriteGTID() error {
+	gtidBV := qre.bindVars[sqltypes.BvReadAfterWriteGTID]
+	timeoutBV := qre.bindVars[sqltypes.BvReadAfterWriteTimeout]
+	delete(qre.bindVars, sqltypes.BvReadAfterWriteGTID)
+	delete(qre.bindVars, sqltypes.BvReadAfterWriteTimeout)
+	if gtidBV == nil || qre.tabletType == topodatapb.TabletType_PRIMARY {
+		return nil
+	}
+
+	pos, err := mysql.ParsePosition(mysql.Mysql56FlavorID, string(gtidBV.Value))
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid read after write GTID %q: %v", gtidBV.Value, err)
+	}
+	wanted, ok := pos.GTIDSet.(mysql.Mysql56GTIDSet)
+	if !ok || len(wanted) == 0 {
+		return nil
+	}
+	var timeout float64
+	if timeoutBV != nil {
+		v, err := sqltypes.BindVariableToValue(timeoutBV)
+		if err != nil {
+			return err
+		}
+		if timeout, err = v.ToFloat64(); err != nil {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid read after write timeout: %v", err)
+		}
+	}
+
+	conn, err := qre.getConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Recycle()
+
+	qr, err := conn.Exec(qre.ctx, "select @@global.gtid_executed, (select group_concat(source_uuid) from performance_schema.replication_connection_status)", 1, false)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected result for gtid_executed: %v", qr.Rows)
+	}
+	known := make(map[mysql.SID]bool)
+	executed, err := mysql.ParsePosition(mysql.Mysql56FlavorID, qr.Rows[0][0].ToString())
+	if err != nil {
+		return err
+	}
+	if executed, ok := executed.GTIDSet.(mysql.Mysql56GTIDSet); ok {
+		for _, sid := range executed.SIDs() {
+			known[sid] = true
+		}
+	}
+	for _, source := range strings.Split(qr.Rows[0][1].ToString(), ",") {
+		if sid, err := mysql.ParseSID(strings.TrimSpace(source)); err == nil {
+			known[sid] = true
+		}
+	}
+	gtids := make(mysql.Mysql56GTIDSet)
+	for sid, intervals := range wanted {
+		if known[sid] {
+			gtids[sid] = intervals
+		}
+	}
+	if len(gtids) == 0 {
+		return nil
+	}
+
+	// Both queries return 0 once the GTIDs are applied, wait_for_executed_gtid_set
+	// returns 1 if it times out.
+	query := fmt.Sprintf("select gtid_subset(%s, @@global.gtid_executed) = 0", sqltypes.EncodeStringSQL(gtids.String()))
+	if timeout > 0 {
+		query = fmt.Sprintf("select wait_for_executed_gtid_set(%s, %v)", sqltypes.EncodeStringSQL(gtids.String()), timeout)
+	}
+	qr, err = conn.Exec(qre.ctx, query, 1, false)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 || qr.Rows[0][0].ToString() != "0" {
+		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, vterrors.ReadAfterWriteTimeout)
+	}
+	return nil
+}
+
 func (qre *QueryExecutor) execDMLLimit(conn *StatefulConnection) (*sqltypes.Result, error) {
 	maxrows := qre.tsv.qe.maxResultSize.Get()
 	qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
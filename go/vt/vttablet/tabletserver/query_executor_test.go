@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -484,6 +485,63 @@ func TestQueryExecutorSelectImpossible(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorReadAfterWrite(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	fields := sqltypes.MakeTestFields("a|b", "int64|varchar")
+	selectResult := sqltypes.MakeTestResult(fields, "1|aaa")
+	db.AddQuery("select * from t where 1 != 1", sqltypes.MakeTestResult(fields))
+	db.AddQuery("select * from t limit 10001", selectResult)
+	// the replica replicates from 4e11fa47 and knows 3e11fa47, but not 5e11fa47.
+	db.AddQuery(
+		"select @@global.gtid_executed, (select group_concat(source_uuid) from performance_schema.replication_connection_status)",
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("gtid_executed|source_uuid", "varchar|varchar"), "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-3|4e11fa47-71ca-11e1-9e33-c80aa9429562"),
+	)
+	gtid := "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5,4e11fa47-71ca-11e1-9e33-c80aa9429562:1-2,5e11fa47-71ca-11e1-9e33-c80aa9429562:1-7"
+	waitQuery := "select wait_for_executed_gtid_set('3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5,4e11fa47-71ca-11e1-9e33-c80aa9429562:1-2', 0.5)"
+	subsetQuery := "select gtid_subset('3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5,4e11fa47-71ca-11e1-9e33-c80aa9429562:1-2', @@global.gtid_executed) = 0"
+	waitResult := func(v string) *sqltypes.Result {
+		return sqltypes.MakeTestResult(sqltypes.MakeTestFields("wait", "int64"), v)
+	}
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	execute := func(tabletType topodatapb.TabletType, timeout float64) (*sqltypes.Result, error) {
+		qre := newTestQueryExecutor(ctx, tsv, "select * from t", 0)
+		qre.tabletType = tabletType
+		qre.bindVars[sqltypes.BvReadAfterWriteGTID] = sqltypes.StringBindVariable(gtid)
+		qre.bindVars[sqltypes.BvReadAfterWriteTimeout] = sqltypes.Float64BindVariable(timeout)
+		return qre.Execute()
+	}
+
+	// the replica has applied the GTIDs.
+	db.AddQuery(waitQuery, waitResult("0"))
+	got, err := execute(topodatapb.TabletType_REPLICA, 0.5)
+	require.NoError(t, err)
+	assert.Equal(t, selectResult, got)
+	assert.Equal(t, 1, db.GetQueryCalledNum(waitQuery))
+
+	// the replica did not catch up in time.
+	db.AddQuery(waitQuery, waitResult("1"))
+	_, err = execute(topodatapb.TabletType_REPLICA, 0.5)
+	require.EqualError(t, err, vterrors.ReadAfterWriteTimeout)
+	assert.Equal(t, vtrpcpb.Code_FAILED_PRECONDITION, vterrors.Code(err))
+
+	// without a timeout, the replica does not wait.
+	db.AddQuery(subsetQuery, waitResult("1"))
+	_, err = execute(topodatapb.TabletType_REPLICA, 0)
+	require.EqualError(t, err, vterrors.ReadAfterWriteTimeout)
+	db.AddQuery(subsetQuery, waitResult("0"))
+	_, err = execute(topodatapb.TabletType_REPLICA, 0)
+	require.NoError(t, err)
+
+	// the primary has all the writes.
+	_, err = execute(topodatapb.TabletType_PRIMARY, 0.5)
+	require.NoError(t, err)
+	assert.Equal(t, 2, db.GetQueryCalledNum(waitQuery))
+}
+
 // TestDisableOnlineDDL checks whether disabling online DDLs throws the correct error or not
 func TestDisableOnlineDDL(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
@@ -654,15 +712,17 @@ func TestQueryExecutorPlanPassSelectWithLockOutsideATransaction(t *testing.T) {
 func TestQueryExecutorPlanNextval(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
-	selQuery := "select next_id, cache from seq where id = 0 for update"
+	selQuery := "select next_id, cache, increment from seq where id = 0 for update"
 	db.AddQuery(selQuery, &sqltypes.Result{
 		Fields: []*querypb.Field{
 			{Type: sqltypes.Int64},
 			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
 		},
 		Rows: [][]sqltypes.Value{{
 			sqltypes.NewInt64(1),
 			sqltypes.NewInt64(3),
+			sqltypes.NULL,
 		}},
 	})
 	updateQuery := "update seq set next_id = 4 where id = 0"
@@ -714,10 +774,12 @@ func TestQueryExecutorPlanNextval(t *testing.T) {
 		Fields: []*querypb.Field{
 			{Type: sqltypes.Int64},
 			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
 		},
 		Rows: [][]sqltypes.Value{{
 			sqltypes.NewInt64(4),
 			sqltypes.NewInt64(3),
+			sqltypes.NewInt64(1),
 		}},
 	})
 	updateQuery = "update seq set next_id = 7 where id = 0"
@@ -746,10 +808,12 @@ func TestQueryExecutorPlanNextval(t *testing.T) {
 		Fields: []*querypb.Field{
 			{Type: sqltypes.Int64},
 			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
 		},
 		Rows: [][]sqltypes.Value{{
 			sqltypes.NewInt64(7),
 			sqltypes.NewInt64(3),
+			sqltypes.NewInt64(1),
 		}},
 	})
 	updateQuery = "update seq set next_id = 13 where id = 0"
@@ -773,6 +837,82 @@ func TestQueryExecutorPlanNextval(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPlanNextvalInterleaved(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	sequenceRow := func(nextID int64) *sqltypes.Result {
+		return &sqltypes.Result{
+			Fields: []*querypb.Field{
+				{Type: sqltypes.Int64},
+				{Type: sqltypes.Int64},
+				{Type: sqltypes.Int64},
+			},
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewInt64(nextID),
+				sqltypes.NewInt64(3),
+				sqltypes.NewInt64(4),
+			}},
+		}
+	}
+	selQuery := "select next_id, cache, increment from seq where id = 0 for update"
+	db.AddQuery(selQuery, sequenceRow(2))
+	db.AddQuery("update seq set next_id = 14 where id = 0", &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// The block holds 2, 6 and 10.
+	qre := newTestQueryExecutor(ctx, tsv, "select next 2 values from seq", 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	want := &sqltypes.Result{
+		Fields: []*querypb.Field{{
+			Name: "nextval",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "increment",
+			Type: sqltypes.Int64,
+		}},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(2),
+			sqltypes.NewInt64(4),
+		}},
+	}
+	assert.Equal(t, want, got)
+	seq := qre.plan.Table.SequenceInfo
+
+	// Handing out 10 leaves nothing in the cache, which
+	// allocates the next block in the background.
+	db.AddQuery(selQuery, sequenceRow(14))
+	db.AddQuery("update seq set next_id = 26 where id = 0", &sqltypes.Result{})
+	tsv.config.SequencePrefetchPercent = 50
+	qre = newTestQueryExecutor(ctx, tsv, "select next value from seq", 0)
+	got, err = qre.Execute()
+	require.NoError(t, err)
+	want.Rows[0][0] = sqltypes.NewInt64(10)
+	assert.Equal(t, want, got)
+	assert.Eventually(t, func() bool {
+		seq.Lock()
+		defer seq.Unlock()
+		return !seq.Prefetching
+	}, 5*time.Second, 10*time.Millisecond)
+
+	seq.Lock()
+	assert.EqualValues(t, 14, seq.NextVal)
+	assert.EqualValues(t, 26, seq.LastVal)
+	assert.EqualValues(t, 3, seq.Remaining())
+	seq.Unlock()
+
+	// 14 comes from the prefetched block, without a db access.
+	tsv.config.SequencePrefetchPercent = 0
+	db.DeleteQuery(selQuery)
+	qre = newTestQueryExecutor(ctx, tsv, "select next value from seq", 0)
+	got, err = qre.Execute()
+	require.NoError(t, err)
+	want.Rows[0][0] = sqltypes.NewInt64(14)
+	assert.Equal(t, want, got)
+}
+
 func TestQueryExecutorMessageStreamACL(t *testing.T) {
 	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
 	tableacl.Register(aclName, &simpleacl.Factory{})
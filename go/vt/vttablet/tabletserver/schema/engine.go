@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"sync"
 	"time"
 
@@ -104,6 +105,7 @@ func NewEngine(env tabletenv.Env) *Engine {
 	se.innoDbReadRowsCounter = env.Exporter().NewCounter("InnodbRowsRead", "number of rows read by mysql")
 
 	env.Exporter().HandleFunc("/debug/schema", se.handleDebugSchema)
+	env.Exporter().HandleFunc("/debug/sequences", se.handleDebugSequences)
 	env.Exporter().HandleFunc("/schemaz", func(w http.ResponseWriter, r *http.Request) {
 		// Ensure schema engine is Open. If vttablet came up in a non_serving role,
 		// the schema engine may not have been initialized.
@@ -595,6 +597,60 @@ func (se *Engine) handleHTTPSchema(response http.ResponseWriter) {
 	response.Write(buf.Bytes())
 }
 
+// SequenceStatus is the state of a sequence as cached by this tablet.
+type SequenceStatus struct {
+	Name        string
+	NextVal     int64
+	LastVal     int64
+	Increment   int64
+	Cache       int64
+	Remaining   int64
+	Prefetching bool
+}
+
+// GetSequenceStatuses returns the state of the sequence tables, sorted by name.
+// Remaining is the number of values the tablet can hand out with NEXT n VALUES
+// before it needs to allocate another block from the sequence table.
+func (se *Engine) GetSequenceStatuses() []*SequenceStatus {
+	var statuses []*SequenceStatus
+	for _, t := range se.GetSchema() {
+		if t.SequenceInfo == nil {
+			continue
+		}
+		t.SequenceInfo.Lock()
+		statuses = append(statuses, &SequenceStatus{
+			Name:        t.Name.String(),
+			NextVal:     t.SequenceInfo.NextVal,
+			LastVal:     t.SequenceInfo.LastVal,
+			Increment:   t.SequenceInfo.Increment,
+			Cache:       t.SequenceInfo.Cache,
+			Remaining:   t.SequenceInfo.Remaining(),
+			Prefetching: t.SequenceInfo.Prefetching,
+		})
+		t.SequenceInfo.Unlock()
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+func (se *Engine) handleDebugSequences(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	b, err := json.MarshalIndent(se.GetSequenceStatuses(), "", " ")
+	if err != nil {
+		response.Write([]byte(err.Error()))
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	json.HTMLEscape(buf, b)
+	response.Write(buf.Bytes())
+}
+
 // Test methods. Do not use in non-test code.
 
 // NewEngineForTests creates a new engine, that can't query the
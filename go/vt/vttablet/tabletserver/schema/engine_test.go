@@ -491,6 +491,44 @@ func TestStatsURL(t *testing.T) {
 	se.handleDebugSchema(response, request)
 }
 
+func TestSequenceStatuses(t *testing.T) {
+	se := NewEngineForTests()
+	se.SetTableForTests(&Table{Name: sqlparser.NewTableIdent("t1")})
+	se.SetTableForTests(&Table{
+		Name:         sqlparser.NewTableIdent("seq2"),
+		Type:         Sequence,
+		SequenceInfo: &SequenceInfo{},
+	})
+	se.SetTableForTests(&Table{
+		Name: sqlparser.NewTableIdent("seq1"),
+		Type: Sequence,
+		SequenceInfo: &SequenceInfo{
+			NextVal:      13,
+			LastVal:      41,
+			HasIncrement: true,
+			Increment:    4,
+			Cache:        10,
+		},
+	})
+
+	want := []*SequenceStatus{{
+		Name:      "seq1",
+		NextVal:   13,
+		LastVal:   41,
+		Increment: 4,
+		Cache:     10,
+		Remaining: 7,
+	}, {
+		Name: "seq2",
+	}}
+	assert.Equal(t, want, se.GetSequenceStatuses())
+
+	request, _ := http.NewRequest("GET", "/debug/sequences", nil)
+	response := httptest.NewRecorder()
+	se.handleDebugSequences(response, request)
+	assert.Contains(t, response.Body.String(), `"Remaining": 7`)
+}
+
 func newEngine(queryCacheSize int, reloadTime time.Duration, idleTimeout time.Duration, db *fakesqldb.DB) *Engine {
 	config := tabletenv.NewDefaultConfig()
 	config.QueryCacheSize = queryCacheSize
@@ -567,7 +605,7 @@ func initialSchema() map[string]*Table {
 			CreateTime:    1427325875,
 			FileSize:      0x64,
 			AllocatedSize: 0x96,
-			SequenceInfo:  &SequenceInfo{},
+			SequenceInfo:  &SequenceInfo{HasIncrement: true},
 		},
 		"msg": {
 			Name: sqlparser.NewTableIdent("msg"),
@@ -38,6 +38,11 @@ func LoadTable(conn *connpool.DBConn, tableName string, comment string) (*Table,
 	case strings.Contains(comment, "vitess_sequence"):
 		ta.Type = Sequence
 		ta.SequenceInfo = &SequenceInfo{}
+		for _, field := range ta.Fields {
+			if strings.EqualFold(field.Name, "increment") {
+				ta.SequenceInfo.HasIncrement = true
+			}
+		}
 	case strings.Contains(comment, "vitess_message"):
 		if err := loadMessageInfo(ta, comment); err != nil {
 			return nil, err
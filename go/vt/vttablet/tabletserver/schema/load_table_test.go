@@ -84,6 +84,30 @@ func TestLoadTableSequence(t *testing.T) {
 	}
 }
 
+func TestLoadTableShardedSequence(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int32,
+		}, {
+			Name: "next_id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "cache",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "increment",
+			Type: sqltypes.Int64,
+		}},
+	})
+	table, err := newTestLoadTable("USER_TABLE", "vitess_sequence", db)
+	require.NoError(t, err)
+	assert.Equal(t, Sequence, table.Type)
+	assert.Equal(t, &SequenceInfo{HasIncrement: true}, table.SequenceInfo)
+}
+
 func TestLoadTableMessage(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
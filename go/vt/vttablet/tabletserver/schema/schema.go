@@ -67,6 +67,31 @@ type SequenceInfo struct {
 	sync.Mutex
 	NextVal int64
 	LastVal int64
+
+	// HasIncrement is set if the sequence table has an increment column.
+	// A sharded sequence uses it to interleave the values of its shards:
+	// each shard hands out every Increment-th value, starting from its
+	// own next_id.
+	HasIncrement bool
+	// Increment is the difference between two consecutive values handed
+	// out by this tablet, and Cache the number of values of the last
+	// block allocated from the sequence table. A cache of 1 makes the
+	// sequence gap-free: no values are held in memory, so none are lost
+	// when the tablet restarts, and no block is ever prefetched.
+	Increment int64
+	Cache     int64
+	// Prefetching is set while the next block is allocated in the background.
+	Prefetching bool
+}
+
+// Remaining returns the number of values that can be handed out
+// before a new block must be allocated. It must be called with the
+// lock held.
+func (seq *SequenceInfo) Remaining() int64 {
+	if seq.NextVal == 0 || seq.Increment == 0 {
+		return 0
+	}
+	return (seq.LastVal - seq.NextVal) / seq.Increment
 }
 
 // MessageInfo contains info specific to message tables.
@@ -50,7 +50,9 @@ func TestSchamazHandler1(t *testing.T) {
 		`<td>id: INT32<br>next_id: INT64<br>cache: INT64<br>increment: INT64<br></td>`,
 		`<td>id<br></td>`,
 		`<td>sequence</td>`,
-		`<td>{{0 0} 0 0}&lt;nil&gt;</td>`,
+		// the sequence info starts with its mutex, whose representation depends on the go version,
+		// followed by NextVal, LastVal, HasIncrement, Increment, Cache and Prefetching
+		`<td>\{\{.*\} 0 0 true 0 0 false\}&lt;nil&gt;</td>`,
 	}
 	matched, err = regexp.Match(strings.Join(seq, `\s*`), body)
 	require.NoError(t, err)
@@ -114,6 +114,7 @@ type Throttler struct {
 	mysqlClusterThresholds *cache.Cache
 	aggregatedMetrics      *cache.Cache
 	throttledApps          *cache.Cache
+	sharedThrottledApps    *cache.Cache
 	recentApps             *cache.Cache
 	metricsHealth          *cache.Cache
 
@@ -166,6 +167,7 @@ func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topo
 		throttler.MetricsThreshold = sync2.NewAtomicFloat64(throttleThreshold.Seconds())
 
 		throttler.throttledApps = cache.New(cache.NoExpiration, 10*time.Second)
+		throttler.sharedThrottledApps = cache.New(cache.NoExpiration, 10*time.Second)
 		throttler.mysqlClusterThresholds = cache.New(cache.NoExpiration, 0)
 		throttler.aggregatedMetrics = cache.New(aggregatedMetricsExpiration, aggregatedMetricsCleanup)
 		throttler.recentApps = cache.New(recentAppsExpiration, time.Minute)
@@ -670,6 +672,30 @@ func (throttler *Throttler) UnthrottleApp(appName string) (appThrottle *base.App
 	return base.NewAppThrottle(appName, time.Now(), 0)
 }
 
+// ThrottleAppShared throttles an app, until expireAt, by its share of the throttler when it runs
+// alongside other apps. This is independent of ThrottleApp, which remains in effect: the app is
+// throttled by either ratio.
+func (throttler *Throttler) ThrottleAppShared(appName string, expireAt time.Time, ratio float64) {
+	if throttler.sharedThrottledApps == nil {
+		// the lag throttler is disabled
+		return
+	}
+	ttl := time.Until(expireAt)
+	if ttl <= 0 {
+		throttler.sharedThrottledApps.Delete(appName)
+		return
+	}
+	throttler.sharedThrottledApps.Set(appName, ratio, ttl)
+}
+
+// UnthrottleAppShared cancels the throttling of an app by ThrottleAppShared, if any.
+func (throttler *Throttler) UnthrottleAppShared(appName string) {
+	if throttler.sharedThrottledApps == nil {
+		return
+	}
+	throttler.sharedThrottledApps.Delete(appName)
+}
+
 // IsAppThrottled tells whether some app should be throttled.
 // Assuming an app is throttled to some extend, it will randomize the result based
 // on the throttle ratio
@@ -677,12 +703,14 @@ func (throttler *Throttler) IsAppThrottled(appName string) bool {
 	isSingleAppNameThrottled := func(singleAppName string) bool {
 		if object, found := throttler.throttledApps.Get(singleAppName); found {
 			appThrottle := object.(*base.AppThrottle)
-			if appThrottle.ExpireAt.Before(time.Now()) {
-				// throttling cleanup hasn't purged yet, but it is expired
-				return false
+			// throttling cleanup may not have purged it yet, although it is expired
+			if appThrottle.ExpireAt.After(time.Now()) && rand.Float64() < appThrottle.Ratio {
+				return true
 			}
-			// handle ratio
-			if rand.Float64() < appThrottle.Ratio {
+		}
+		// the share of the throttler is drawn apart, such that both ratios apply
+		if object, found := throttler.sharedThrottledApps.Get(singleAppName); found {
+			if rand.Float64() < object.(float64) {
 				return true
 			}
 		}
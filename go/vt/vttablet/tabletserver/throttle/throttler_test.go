@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsAppThrottledShared(t *testing.T) {
+	throttler := &Throttler{
+		throttledApps:       cache.New(cache.NoExpiration, 10*time.Second),
+		sharedThrottledApps: cache.New(cache.NoExpiration, 10*time.Second),
+	}
+	expireAt := time.Now().Add(time.Hour)
+
+	throttler.ThrottleAppShared("uuid1", expireAt, 1)
+	assert.True(t, throttler.IsAppThrottled("vreplication:uuid1"))
+	assert.False(t, throttler.IsAppThrottled("vreplication:uuid2"))
+
+	// the operator's throttling is not affected by the share of the throttler
+	throttler.ThrottleApp("uuid1", expireAt, 1)
+	throttler.UnthrottleAppShared("uuid1")
+	assert.True(t, throttler.IsAppThrottled("vreplication:uuid1"))
+	throttler.UnthrottleApp("uuid1")
+	assert.False(t, throttler.IsAppThrottled("vreplication:uuid1"))
+
+	// and the share of the throttler still applies when the operator throttles less
+	throttler.ThrottleAppShared("uuid1", expireAt, 1)
+	throttler.ThrottleApp("uuid1", expireAt, 0)
+	assert.True(t, throttler.IsAppThrottled("vreplication:uuid1"))
+
+	throttler.ThrottleAppShared("uuid2", time.Now().Add(-time.Second), 1)
+	assert.False(t, throttler.IsAppThrottled("vreplication:uuid2"), "expired")
+}
@@ -0,0 +1,846 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vtctl/schematools"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// LookupVindexDiffReport is the summary of the differences between a lookup vindex
+// and the table that owns it.
+type LookupVindexDiffReport struct {
+	Vindex      string
+	OwnerTable  string
+	LookupTable string
+	// ProcessedRows is the number of owner rows that were compared.
+	ProcessedRows int
+	MatchingRows  int
+	// MissingRows is the number of owner rows without an entry in the lookup table.
+	// The owner rows of a non-unique lookup vindex that share a keyspace id count once.
+	MissingRows int
+	// OrphanRows is the number of lookup rows without an owner row.
+	OrphanRows int
+	// MismatchedRows is the number of lookup rows that point to the wrong keyspace id.
+	MismatchedRows int
+	// RepairedRows is the number of lookup rows that were inserted, deleted or updated.
+	RepairedRows int
+
+	MissingRowsSample    []*RowDiff
+	OrphanRowsSample     []*RowDiff
+	MismatchedRowsSample []*DiffMismatch
+}
+
+// lookupVindexDiffer compares the rows of an owner table with the rows of the
+// lookup table of one of its lookup vindexes.
+type lookupVindexDiffer struct {
+	report *LookupVindexDiffReport
+
+	// fromCols are the columns of the lookup vindex in the owner table,
+	// and lookupFromCols the matching columns of the lookup table.
+	fromCols       []string
+	lookupFromCols []string
+	toCol          string
+	ignoreNulls    bool
+	// unique is false for the lookup vindexes that map a 'from' value to several keyspace ids.
+	unique bool
+	// fromCollations are the collations the 'from' columns are ordered and compared with,
+	// which must be the same in the owner table and in the lookup table.
+	fromCollations []collations.ID
+
+	// primaryCols are the columns of the primary vindex of the owner table,
+	// which computes the keyspace ids the lookup table should point to.
+	primaryCols   []string
+	primaryVindex vindexes.Vindex
+
+	ownerKeyspace   string
+	ownerTable      string
+	lookupKeyspace  string
+	lookupTable     string
+	ownerStreamers  map[string]*shardStreamer
+	lookupStreamers map[string]*shardStreamer
+
+	// lookupShards and lookupVindex are used to find the shard of the lookup rows to repair.
+	// lookupVindex is nil if the lookup keyspace is unsharded.
+	lookupShards      []*topo.ShardInfo
+	lookupVindex      vindexes.Vindex
+	lookupVindexFroms []int
+
+	// repairs holds the statements that repair the lookup table, by shard.
+	repairs map[string][]string
+}
+
+// LookupVindexDiff compares every row of the table that owns a lookup vindex with the
+// entries of the lookup table, and reports the owner rows that have no lookup entry,
+// the lookup entries that have no owner row, and the lookup entries that point to
+// another keyspace id than the one of their owner row.
+// If repair is set, the lookup table is then fixed on the primaries of its shards.
+// The repairs only apply if the lookup rows are still in the state that was observed,
+// but the owner table can have changed since it was read: the diff should be run
+// again afterwards to confirm that the lookup vindex is consistent.
+func (wr *Wrangler) LookupVindexDiff(ctx context.Context, qualifiedVindexName, cell, tabletTypesStr, format string, repair bool) (*LookupVindexDiffReport, error) {
+	log.Infof("Starting LookupVindexDiff for %s, cell %s, tabletTypes %s, repair %v", qualifiedVindexName, cell, tabletTypesStr, repair)
+	if cell == "" {
+		cells, err := wr.ts.GetCellInfoNames(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(cells) == 0 {
+			// Unreachable
+			return nil, fmt.Errorf("there are no cells in the topo")
+		}
+		cell = cells[0]
+	}
+
+	ld, err := wr.buildLookupVindexDiffer(ctx, qualifiedVindexName)
+	if err != nil {
+		return nil, err
+	}
+	if err := wr.selectLookupVindexDiffTablets(ctx, ld, cell, tabletTypesStr); err != nil {
+		return nil, err
+	}
+	if err := wr.loadLookupVindexDiffCollations(ctx, ld); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ownerQuery := buildOrderedSelect(ld.ownerTable, append(append([]string{}, ld.fromCols...), ld.primaryCols...), len(ld.fromCols))
+	if err := startLookupVindexDiffStreams(ctx, ld.ownerKeyspace, ld.ownerStreamers, ownerQuery); err != nil {
+		return nil, err
+	}
+	lookupQuery := buildOrderedSelect(ld.lookupTable, append(append([]string{}, ld.lookupFromCols...), ld.toCol), len(ld.lookupFromCols))
+	if err := startLookupVindexDiffStreams(ctx, ld.lookupKeyspace, ld.lookupStreamers, lookupQuery); err != nil {
+		return nil, err
+	}
+
+	var comparePKs []compareColInfo
+	for i, collationID := range ld.fromCollations {
+		comparePKs = append(comparePKs, compareColInfo{colIndex: i, collation: collations.Local().LookupByID(collationID), isPK: true})
+	}
+	owners := newPrimitiveExecutor(ctx, newMergeSorter(ld.ownerStreamers, comparePKs))
+	lookups := newPrimitiveExecutor(ctx, newMergeSorter(ld.lookupStreamers, comparePKs))
+	if err := ld.diff(owners, lookups); err != nil {
+		return nil, err
+	}
+
+	if repair {
+		if err := wr.repairLookupVindex(ctx, ld); err != nil {
+			return ld.report, err
+		}
+	}
+
+	dr := ld.report
+	if format == "json" {
+		json, err := json.MarshalIndent(dr, "", "")
+		if err != nil {
+			wr.Logger().Printf("Error converting report to json: %v", err.Error())
+		}
+		wr.logger.Printf("%s", json)
+	} else {
+		wr.Logger().Printf("Summary for lookup vindex %v (owner %v, lookup table %v):\n", dr.Vindex, dr.OwnerTable, dr.LookupTable)
+		wr.Logger().Printf("\tProcessedRows: %v\n", dr.ProcessedRows)
+		wr.Logger().Printf("\tMatchingRows: %v\n", dr.MatchingRows)
+		wr.Logger().Printf("\tMissingRows: %v\n", dr.MissingRows)
+		wr.Logger().Printf("\tOrphanRows: %v\n", dr.OrphanRows)
+		wr.Logger().Printf("\tMismatchedRows: %v\n", dr.MismatchedRows)
+		if repair {
+			wr.Logger().Printf("\tRepairedRows: %v\n", dr.RepairedRows)
+		}
+		for i, rs := range dr.MissingRowsSample {
+			wr.Logger().Printf("\tSample owner row missing from the lookup table %v:\n", i)
+			formatSampleRow(wr.Logger(), rs, true)
+		}
+		for i, rs := range dr.OrphanRowsSample {
+			wr.Logger().Printf("\tSample orphan row in the lookup table %v:\n", i)
+			formatSampleRow(wr.Logger(), rs, true)
+		}
+		for i, rs := range dr.MismatchedRowsSample {
+			wr.Logger().Printf("\tSample rows with mismatch %v:\n", i)
+			wr.Logger().Printf("\t\tOwner row:\n")
+			formatSampleRow(wr.Logger(), rs.Source, false)
+			wr.Logger().Printf("\t\tLookup row:\n")
+			formatSampleRow(wr.Logger(), rs.Target, true)
+		}
+	}
+	return dr, nil
+}
+
+// buildLookupVindexDiffer validates the lookup vindex and its owner, and builds the differ.
+func (wr *Wrangler) buildLookupVindexDiffer(ctx context.Context, qualifiedVindexName string) (*lookupVindexDiffer, error) {
+	splits := strings.Split(qualifiedVindexName, ".")
+	if len(splits) != 2 {
+		return nil, fmt.Errorf("vindex name should be of the form keyspace.vindex: %s", qualifiedVindexName)
+	}
+	ownerKeyspace, vindexName := splits[0], splits[1]
+	ownerVSchema, err := wr.ts.GetVSchema(ctx, ownerKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	vindex := ownerVSchema.Vindexes[vindexName]
+	if vindex == nil {
+		return nil, fmt.Errorf("vindex %s not found in vschema", qualifiedVindexName)
+	}
+	switch vindex.Type {
+	case "lookup", "lookup_unique", "consistent_lookup", "consistent_lookup_unique":
+	default:
+		return nil, fmt.Errorf("vindex %s is of type %s: only lookup vindexes that store keyspace ids can be compared", qualifiedVindexName, vindex.Type)
+	}
+	if vindex.Owner == "" {
+		return nil, fmt.Errorf("vindex %s has no owner table", qualifiedVindexName)
+	}
+	splits = strings.Split(vindex.Params["table"], ".")
+	if len(splits) != 2 {
+		return nil, fmt.Errorf("table name in vindex should be of the form keyspace.table: %s", vindex.Params["table"])
+	}
+
+	ld := &lookupVindexDiffer{
+		report: &LookupVindexDiffReport{
+			Vindex:      qualifiedVindexName,
+			OwnerTable:  ownerKeyspace + "." + vindex.Owner,
+			LookupTable: vindex.Params["table"],
+		},
+		lookupFromCols: splitColumns(vindex.Params["from"]),
+		toCol:          strings.TrimSpace(vindex.Params["to"]),
+		ignoreNulls:    vindex.Params["ignore_nulls"] == "true",
+		unique:         strings.HasSuffix(vindex.Type, "_unique"),
+		ownerKeyspace:  ownerKeyspace,
+		ownerTable:     vindex.Owner,
+		lookupKeyspace: splits[0],
+		lookupTable:    splits[1],
+		repairs:        make(map[string][]string),
+	}
+	if len(ld.lookupFromCols) == 0 || ld.toCol == "" {
+		return nil, fmt.Errorf("vindex %s must have 'from' and 'to' columns", qualifiedVindexName)
+	}
+
+	ownerTable := ownerVSchema.Tables[vindex.Owner]
+	if ownerTable == nil || len(ownerTable.ColumnVindexes) == 0 {
+		return nil, fmt.Errorf("owner table %s of vindex %s has no vindexes", vindex.Owner, qualifiedVindexName)
+	}
+	for _, cv := range ownerTable.ColumnVindexes {
+		if cv.Name == vindexName {
+			ld.fromCols = columnVindexColumns(cv)
+		}
+	}
+	if len(ld.fromCols) != len(ld.lookupFromCols) {
+		return nil, fmt.Errorf("owner table %s must have the columns %v for vindex %s, got %v", vindex.Owner, ld.lookupFromCols, qualifiedVindexName, ld.fromCols)
+	}
+	primary := ownerTable.ColumnVindexes[0]
+	ld.primaryCols = columnVindexColumns(primary)
+	ld.primaryVindex, err = createVindex(ownerVSchema, primary.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ld.lookupShards, err = wr.ts.GetServingShards(ctx, ld.lookupKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	lookupVSchema, err := wr.ts.GetVSchema(ctx, ld.lookupKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	if lookupVSchema.Sharded {
+		table := lookupVSchema.Tables[ld.lookupTable]
+		if table == nil || len(table.ColumnVindexes) == 0 {
+			return nil, fmt.Errorf("lookup table %s has no vindexes", vindex.Params["table"])
+		}
+		for _, col := range columnVindexColumns(table.ColumnVindexes[0]) {
+			idx := indexOfColumn(ld.lookupFromCols, col)
+			if idx == -1 {
+				return nil, fmt.Errorf("lookup table %s must be sharded by its 'from' columns %v", vindex.Params["table"], ld.lookupFromCols)
+			}
+			ld.lookupVindexFroms = append(ld.lookupVindexFroms, idx)
+		}
+		ld.lookupVindex, err = createVindex(lookupVSchema, table.ColumnVindexes[0].Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ld, nil
+}
+
+// selectLookupVindexDiffTablets picks the tablets that stream the owner and lookup tables.
+func (wr *Wrangler) selectLookupVindexDiffTablets(ctx context.Context, ld *lookupVindexDiffer, cell, tabletTypesStr string) error {
+	var err error
+	ld.ownerStreamers, err = wr.lookupVindexDiffStreamers(ctx, ld.ownerKeyspace, cell, tabletTypesStr)
+	if err != nil {
+		return err
+	}
+	ld.lookupStreamers, err = wr.lookupVindexDiffStreamers(ctx, ld.lookupKeyspace, cell, tabletTypesStr)
+	return err
+}
+
+func (wr *Wrangler) lookupVindexDiffStreamers(ctx context.Context, keyspace, cell, tabletTypesStr string) (map[string]*shardStreamer, error) {
+	shards, err := wr.ts.GetServingShards(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	participants := make(map[string]*shardStreamer, len(shards))
+	for _, shard := range shards {
+		participants[shard.ShardName()] = &shardStreamer{}
+	}
+	err = forAllStreamers(participants, func(shard string, participant *shardStreamer) error {
+		tp, err := discovery.NewTabletPicker(wr.ts, []string{cell}, keyspace, shard, tabletTypesStr)
+		if err != nil {
+			return err
+		}
+		tablet, err := tp.PickForStreaming(ctx)
+		if err != nil {
+			return err
+		}
+		participant.tablet = tablet
+		return nil
+	})
+	return participants, err
+}
+
+// loadLookupVindexDiffCollations loads the collations of the 'from' columns of the owner
+// and lookup tables from the schema of one of their streaming tablets. The rows of both
+// tables are ordered by these columns, so they can only be compared if the columns have
+// the same collation.
+func (wr *Wrangler) loadLookupVindexDiffCollations(ctx context.Context, ld *lookupVindexDiffer) error {
+	ownerCollations, err := wr.lookupVindexDiffCollations(ctx, ld.ownerStreamers, ld.ownerTable, ld.fromCols)
+	if err != nil {
+		return err
+	}
+	lookupCollations, err := wr.lookupVindexDiffCollations(ctx, ld.lookupStreamers, ld.lookupTable, ld.lookupFromCols)
+	if err != nil {
+		return err
+	}
+	env := collations.Local()
+	for i, collationID := range ownerCollations {
+		if lookupCollations[i] != collationID {
+			return fmt.Errorf("column %s of owner table %s has collation %s, but column %s of lookup table %s has collation %s: they cannot be compared",
+				ld.fromCols[i], ld.report.OwnerTable, env.LookupByID(collationID).Name(), ld.lookupFromCols[i], ld.report.LookupTable, env.LookupByID(lookupCollations[i]).Name())
+		}
+	}
+	ld.fromCollations = ownerCollations
+	return nil
+}
+
+// lookupVindexDiffCollations returns the collations of the given columns of the table, as
+// the schema of one of the streaming tablets defines them. The columns which are not
+// textual, or whose collation is not known, are compared as bytes.
+func (wr *Wrangler) lookupVindexDiffCollations(ctx context.Context, participants map[string]*shardStreamer, table string, cols []string) ([]collations.ID, error) {
+	var tablet *topodatapb.Tablet
+	for _, participant := range participants {
+		tablet = participant.tablet
+		break
+	}
+	if tablet == nil {
+		return nil, fmt.Errorf("no tablet to read the schema of table %s from", table)
+	}
+	schm, err := schematools.GetSchema(ctx, wr.ts, wr.tmc, tablet.Alias, []string{table}, nil, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "GetSchema")
+	}
+	var tableDefinition *tabletmanagerdatapb.TableDefinition
+	for _, td := range schm.GetTableDefinitions() {
+		if td.Name == table {
+			tableDefinition = td
+		}
+	}
+	if tableDefinition == nil {
+		return nil, fmt.Errorf("table %s not found in the schema of tablet %s", table, topoproto.TabletAliasString(tablet.Alias))
+	}
+	columnCollations, err := getColumnCollations(tableDefinition)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]collations.ID, 0, len(cols))
+	for _, col := range cols {
+		id, ok := columnCollations[strings.ToLower(col)]
+		if !ok {
+			id = collations.CollationBinaryID
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// textualTypes are the column types which MySQL orders by their collation.
+var textualTypes = map[string]bool{
+	"char":       true,
+	"varchar":    true,
+	"tinytext":   true,
+	"text":       true,
+	"mediumtext": true,
+	"longtext":   true,
+}
+
+// getColumnCollations returns the collations of the textual columns of a table by their
+// lowercase name, as its CREATE TABLE statement defines them or inherits them from the table.
+func getColumnCollations(table *tabletmanagerdatapb.TableDefinition) (map[string]collations.ID, error) {
+	stmt, err := sqlparser.Parse(table.Schema)
+	if err != nil {
+		return nil, err
+	}
+	createTable, ok := stmt.(*sqlparser.CreateTable)
+	if !ok || createTable.TableSpec == nil {
+		return nil, fmt.Errorf("unexpected schema for table %s: %s", table.Name, table.Schema)
+	}
+	env := collations.Local()
+	var tableCharset, tableCollate string
+	for _, option := range createTable.TableSpec.Options {
+		switch strings.ToUpper(option.Name) {
+		case "CHARSET":
+			tableCharset = option.String
+		case "COLLATE":
+			tableCollate = option.String
+		}
+	}
+	tableCollation := lookupCollation(env, tableCharset, tableCollate)
+
+	columnCollations := make(map[string]collations.ID)
+	for _, col := range createTable.TableSpec.Columns {
+		if !textualTypes[strings.ToLower(col.Type.Type)] {
+			continue
+		}
+		var collate string
+		if col.Type.Options != nil {
+			collate = col.Type.Options.Collate
+		}
+		collation := tableCollation
+		if col.Type.Charset != "" || collate != "" {
+			collation = lookupCollation(env, col.Type.Charset, collate)
+		}
+		if collation != nil {
+			columnCollations[col.Name.Lowered()] = collation.ID()
+		}
+	}
+	return columnCollations, nil
+}
+
+// lookupCollation returns the collation named by "collate", or else the default collation of
+// "charset". It returns nil if neither is known. MySQL 8.0.24 and above report utf8mb3 where
+// earlier versions report utf8, which is how the collations environment knows it.
+func lookupCollation(env *collations.Environment, charset, collate string) collations.Collation {
+	utf8mb3 := strings.NewReplacer("utf8mb3", "utf8")
+	if collate != "" {
+		return env.LookupByName(utf8mb3.Replace(strings.ToLower(collate)))
+	}
+	if charset != "" {
+		return env.DefaultCollationForCharset(utf8mb3.Replace(strings.ToLower(charset)))
+	}
+	return nil
+}
+
+// startLookupVindexDiffStreams starts the query streams, and waits for them to send their fields.
+// There is no need for a consistent snapshot across the keyspaces: the differences
+// that come from concurrent writes go away when the diff is run again.
+func startLookupVindexDiffStreams(ctx context.Context, keyspace string, participants map[string]*shardStreamer, query string) error {
+	return forAllStreamers(participants, func(shard string, participant *shardStreamer) error {
+		participant.result = make(chan *sqltypes.Result, 1)
+		gtidch := make(chan string, 1)
+		go streamOne(ctx, keyspace, shard, participant, query, gtidch)
+		gtid, ok := <-gtidch
+		if !ok {
+			return participant.err
+		}
+		participant.snapshotPosition = gtid
+		return nil
+	})
+}
+
+// diff walks the owner and lookup rows, which are both sorted by their 'from' columns,
+// and compares the keyspace ids of every group of rows with the same 'from' values.
+func (ld *lookupVindexDiffer) diff(owners, lookups *primitiveExecutor) error {
+	ownerRow, err := owners.next()
+	if err != nil {
+		return err
+	}
+	lookupRow, err := lookups.next()
+	if err != nil {
+		return err
+	}
+	n := len(ld.fromCols)
+	for ownerRow != nil || lookupRow != nil {
+		keyRow := ownerRow
+		switch {
+		case ownerRow == nil:
+			keyRow = lookupRow
+		case lookupRow != nil:
+			c, err := ld.compareFrom(ownerRow, lookupRow)
+			if err != nil {
+				return err
+			}
+			if c > 0 {
+				keyRow = lookupRow
+			}
+		}
+		key := keyRow[:n]
+
+		var ownerGroup, lookupGroup [][]sqltypes.Value
+		for ownerRow != nil {
+			c, err := ld.compareFrom(ownerRow, key)
+			if err != nil {
+				return err
+			}
+			if c != 0 {
+				break
+			}
+			ownerGroup = append(ownerGroup, ownerRow)
+			if ownerRow, err = owners.next(); err != nil {
+				return err
+			}
+		}
+		for lookupRow != nil {
+			c, err := ld.compareFrom(lookupRow, key)
+			if err != nil {
+				return err
+			}
+			if c != 0 {
+				break
+			}
+			lookupGroup = append(lookupGroup, lookupRow)
+			if lookupRow, err = lookups.next(); err != nil {
+				return err
+			}
+		}
+		if err := ld.diffGroup(ownerGroup, lookupGroup); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffGroup compares the owner rows and lookup rows that have the same 'from' values.
+// The owner rows that have a lookup row with their keyspace id are matching.
+// The remaining owner and lookup rows are paired up as mismatches, and the rest
+// are either missing from the lookup table or orphans.
+// The owner rows of a non-unique lookup vindex that have the same keyspace id share
+// a single lookup row, so only the first of them is compared with the lookup rows.
+func (ld *lookupVindexDiffer) diffGroup(ownerRows, lookupRows [][]sqltypes.Value) error {
+	n := len(ld.fromCols)
+	if ld.ignoreNulls && len(ownerRows) > 0 {
+		for _, value := range ownerRows[0][:n] {
+			if value.IsNull() {
+				// These owner rows don't need lookup rows, so any lookup row is an orphan.
+				ownerRows = nil
+				break
+			}
+		}
+	}
+	dr := ld.report
+	dr.ProcessedRows += len(ownerRows)
+
+	matched := make([]bool, len(lookupRows))
+	var missing [][]sqltypes.Value
+	var missingKsids [][]byte
+	// foundKsids tells, by keyspace id, whether the first owner row with that keyspace id has a lookup row
+	foundKsids := make(map[string]bool)
+	for _, ownerRow := range ownerRows {
+		ksid, err := ld.keyspaceID(ownerRow[n:])
+		if err != nil {
+			return err
+		}
+		if found, ok := foundKsids[string(ksid)]; ok && !ld.unique {
+			if found {
+				dr.MatchingRows++
+			}
+			continue
+		}
+		found := false
+		for i, lookupRow := range lookupRows {
+			if !matched[i] && bytes.Equal(lookupRow[n].Raw(), ksid) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		foundKsids[string(ksid)] = found
+		if found {
+			dr.MatchingRows++
+			continue
+		}
+		missing = append(missing, ownerRow)
+		missingKsids = append(missingKsids, ksid)
+	}
+	var orphans [][]sqltypes.Value
+	for i, lookupRow := range lookupRows {
+		if !matched[i] {
+			orphans = append(orphans, lookupRow)
+		}
+	}
+
+	for len(missing) > 0 && len(orphans) > 0 {
+		dr.MismatchedRows++
+		query, err := ld.addRepair(ld.updateQuery(orphans[0][:n], orphans[0][n], missingKsids[0]), orphans[0][:n])
+		if err != nil {
+			return err
+		}
+		if len(dr.MismatchedRowsSample) < maxVDiffReportSampleRows {
+			dr.MismatchedRowsSample = append(dr.MismatchedRowsSample, &DiffMismatch{
+				Source: ld.ownerRowDiff(missing[0]),
+				Target: ld.lookupRowDiff(orphans[0], query),
+			})
+		}
+		missing, missingKsids, orphans = missing[1:], missingKsids[1:], orphans[1:]
+	}
+	for i, ownerRow := range missing {
+		dr.MissingRows++
+		query, err := ld.addRepair(ld.insertQuery(ownerRow[:n], missingKsids[i]), ownerRow[:n])
+		if err != nil {
+			return err
+		}
+		if len(dr.MissingRowsSample) < maxVDiffReportSampleRows {
+			rd := ld.ownerRowDiff(ownerRow)
+			rd.Query = query
+			dr.MissingRowsSample = append(dr.MissingRowsSample, rd)
+		}
+	}
+	for _, lookupRow := range orphans {
+		dr.OrphanRows++
+		query, err := ld.addRepair(ld.deleteQuery(lookupRow[:n], lookupRow[n]), lookupRow[:n])
+		if err != nil {
+			return err
+		}
+		if len(dr.OrphanRowsSample) < maxVDiffReportSampleRows {
+			dr.OrphanRowsSample = append(dr.OrphanRowsSample, ld.lookupRowDiff(lookupRow, query))
+		}
+	}
+	return nil
+}
+
+// keyspaceID computes the keyspace id of an owner row from the values of its primary vindex.
+func (ld *lookupVindexDiffer) keyspaceID(values []sqltypes.Value) ([]byte, error) {
+	destinations, err := vindexes.Map(ld.primaryVindex, nil, [][]sqltypes.Value{values})
+	if err != nil {
+		return nil, err
+	}
+	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return nil, fmt.Errorf("cannot compute the keyspace id of %s row %v: got %v", ld.ownerTable, values, destinations[0])
+	}
+	return ksid, nil
+}
+
+func (ld *lookupVindexDiffer) insertQuery(from []sqltypes.Value, ksid []byte) string {
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "insert ignore into %s(", sqlparser.String(sqlparser.NewTableIdent(ld.lookupTable)))
+	for _, col := range ld.lookupFromCols {
+		fmt.Fprintf(buf, "%s, ", sqlparser.String(sqlparser.NewColIdent(col)))
+	}
+	fmt.Fprintf(buf, "%s) values (", sqlparser.String(sqlparser.NewColIdent(ld.toCol)))
+	for _, value := range from {
+		value.EncodeSQLStringBuilder(buf)
+		buf.WriteString(", ")
+	}
+	sqltypes.MakeTrusted(sqltypes.VarBinary, ksid).EncodeSQLStringBuilder(buf)
+	buf.WriteString(")")
+	return buf.String()
+}
+
+func (ld *lookupVindexDiffer) deleteQuery(from []sqltypes.Value, to sqltypes.Value) string {
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "delete from %s", sqlparser.String(sqlparser.NewTableIdent(ld.lookupTable)))
+	ld.writeLookupWhere(buf, from, to)
+	return buf.String()
+}
+
+func (ld *lookupVindexDiffer) updateQuery(from []sqltypes.Value, to sqltypes.Value, ksid []byte) string {
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "update %s set %s = ", sqlparser.String(sqlparser.NewTableIdent(ld.lookupTable)), sqlparser.String(sqlparser.NewColIdent(ld.toCol)))
+	sqltypes.MakeTrusted(sqltypes.VarBinary, ksid).EncodeSQLStringBuilder(buf)
+	ld.writeLookupWhere(buf, from, to)
+	return buf.String()
+}
+
+// writeLookupWhere writes a where clause that only matches the lookup row with
+// the given values, so that a repair does nothing if the row has changed since.
+func (ld *lookupVindexDiffer) writeLookupWhere(buf *strings.Builder, from []sqltypes.Value, to sqltypes.Value) {
+	buf.WriteString(" where ")
+	for i, col := range ld.lookupFromCols {
+		fmt.Fprintf(buf, "%s = ", sqlparser.String(sqlparser.NewColIdent(col)))
+		from[i].EncodeSQLStringBuilder(buf)
+		buf.WriteString(" and ")
+	}
+	fmt.Fprintf(buf, "%s = ", sqlparser.String(sqlparser.NewColIdent(ld.toCol)))
+	to.EncodeSQLStringBuilder(buf)
+}
+
+// addRepair records the repair query for the shard of the lookup table that holds
+// the 'from' values, and returns the query.
+func (ld *lookupVindexDiffer) addRepair(query string, from []sqltypes.Value) (string, error) {
+	shard, err := ld.lookupShard(from)
+	if err != nil {
+		return "", err
+	}
+	ld.repairs[shard] = append(ld.repairs[shard], query)
+	return query, nil
+}
+
+// lookupShard returns the shard of the lookup table that holds the 'from' values.
+func (ld *lookupVindexDiffer) lookupShard(from []sqltypes.Value) (string, error) {
+	if ld.lookupVindex == nil {
+		if len(ld.lookupShards) != 1 {
+			return "", fmt.Errorf("unsharded keyspace %s has %d serving shards", ld.lookupKeyspace, len(ld.lookupShards))
+		}
+		return ld.lookupShards[0].ShardName(), nil
+	}
+	values := make([]sqltypes.Value, 0, len(ld.lookupVindexFroms))
+	for _, idx := range ld.lookupVindexFroms {
+		values = append(values, from[idx])
+	}
+	destinations, err := vindexes.Map(ld.lookupVindex, nil, [][]sqltypes.Value{values})
+	if err != nil {
+		return "", err
+	}
+	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return "", fmt.Errorf("cannot compute the keyspace id of %s row %v: got %v", ld.lookupTable, values, destinations[0])
+	}
+	for _, shard := range ld.lookupShards {
+		if key.KeyRangeContains(shard.KeyRange, ksid) {
+			return shard.ShardName(), nil
+		}
+	}
+	return "", fmt.Errorf("no serving shard of keyspace %s holds keyspace id %v", ld.lookupKeyspace, ksid)
+}
+
+func (ld *lookupVindexDiffer) ownerRowDiff(row []sqltypes.Value) *RowDiff {
+	rd := &RowDiff{Row: make(map[string]sqltypes.Value)}
+	for i, col := range ld.fromCols {
+		rd.Row[col] = row[i]
+	}
+	for i, col := range ld.primaryCols {
+		rd.Row[col] = row[len(ld.fromCols)+i]
+	}
+	return rd
+}
+
+func (ld *lookupVindexDiffer) lookupRowDiff(row []sqltypes.Value, query string) *RowDiff {
+	rd := &RowDiff{Row: make(map[string]sqltypes.Value), Query: query}
+	for i, col := range ld.lookupFromCols {
+		rd.Row[col] = row[i]
+	}
+	rd.Row[ld.toCol] = row[len(ld.lookupFromCols)]
+	return rd
+}
+
+// repairLookupVindex runs the repair queries on the primaries of the lookup shards.
+func (wr *Wrangler) repairLookupVindex(ctx context.Context, ld *lookupVindexDiffer) error {
+	for _, shard := range ld.lookupShards {
+		queries := ld.repairs[shard.ShardName()]
+		if len(queries) == 0 {
+			continue
+		}
+		primary, err := wr.ts.GetTablet(ctx, shard.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		for _, query := range queries {
+			qr, err := wr.tmc.ExecuteFetchAsApp(ctx, primary.Tablet, false, []byte(query), 1)
+			if err != nil {
+				return fmt.Errorf("repairing lookup table on %v/%v with %q: %v", ld.lookupKeyspace, shard.ShardName(), query, err)
+			}
+			ld.report.RepairedRows += int(qr.RowsAffected)
+		}
+	}
+	return nil
+}
+
+// buildOrderedSelect returns a query that selects the columns of the table,
+// ordered by the first orderBy ones.
+func buildOrderedSelect(table string, cols []string, orderBy int) string {
+	sel := &sqlparser.Select{
+		From: sqlparser.TableExprs{&sqlparser.AliasedTableExpr{
+			Expr: sqlparser.TableName{Name: sqlparser.NewTableIdent(table)},
+		}},
+	}
+	for i, col := range cols {
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(col)}})
+		if i < orderBy {
+			sel.OrderBy = append(sel.OrderBy, &sqlparser.Order{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(col)}, Direction: sqlparser.AscOrder})
+		}
+	}
+	return sqlparser.String(sel)
+}
+
+// compareFrom compares the 'from' values of two rows, the way the merge sorters order them.
+func (ld *lookupVindexDiffer) compareFrom(a, b []sqltypes.Value) (int, error) {
+	for i, collationID := range ld.fromCollations {
+		c, err := evalengine.NullsafeCompare(a[i], b[i], collationID)
+		if err != nil || c != 0 {
+			return c, err
+		}
+	}
+	return 0, nil
+}
+
+func createVindex(ks *vschemapb.Keyspace, name string) (vindexes.Vindex, error) {
+	vindex := ks.Vindexes[name]
+	if vindex == nil {
+		return nil, fmt.Errorf("vindex %s not found in vschema", name)
+	}
+	vdx, err := vindexes.CreateVindex(vindex.Type, name, vindex.Params)
+	if err != nil {
+		return nil, err
+	}
+	if vdx.NeedsVCursor() {
+		return nil, fmt.Errorf("vindex %s of type %s needs to run queries to compute keyspace ids, and cannot be used outside of vtgate", name, vindex.Type)
+	}
+	return vdx, nil
+}
+
+func columnVindexColumns(cv *vschemapb.ColumnVindex) []string {
+	if len(cv.Columns) != 0 {
+		return cv.Columns
+	}
+	return []string{cv.Column}
+}
+
+func splitColumns(cols string) []string {
+	var out []string
+	for _, col := range strings.Split(cols, ",") {
+		if col = strings.TrimSpace(col); col != "" {
+			out = append(out, col)
+		}
+	}
+	return out
+}
+
+func indexOfColumn(cols []string, col string) int {
+	for i, c := range cols {
+		if strings.EqualFold(c, col) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,352 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/logutil"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// testLookupVindexDiffTMClient returns the schema of the keyspace of each tablet,
+// and records the repair queries.
+type testLookupVindexDiffTMClient struct {
+	*testVDiffTMClient
+
+	schemas map[string]*tabletmanagerdatapb.SchemaDefinition
+
+	mu      sync.Mutex
+	queries map[uint32][]string
+}
+
+func newTestLookupVindexDiffTMClient(tmc *testVDiffTMClient, ownerSchema, lookupSchema string) *testLookupVindexDiffTMClient {
+	return &testLookupVindexDiffTMClient{
+		testVDiffTMClient: tmc,
+		schemas: map[string]*tabletmanagerdatapb.SchemaDefinition{
+			"owner":  {TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{Name: "t1", Schema: ownerSchema}}},
+			"lookup": {TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{Name: "lkp", Schema: lookupSchema}}},
+		},
+		queries: make(map[uint32][]string),
+	}
+}
+
+func (tmc *testLookupVindexDiffTMClient) GetSchema(ctx context.Context, tablet *topodatapb.Tablet, tables, excludeTables []string, includeViews bool) (*tabletmanagerdatapb.SchemaDefinition, error) {
+	return tmc.schemas[tablet.Keyspace], nil
+}
+
+func (tmc *testLookupVindexDiffTMClient) ExecuteFetchAsApp(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int) (*querypb.QueryResult, error) {
+	tmc.mu.Lock()
+	defer tmc.mu.Unlock()
+	tmc.queries[tablet.Alias.Uid] = append(tmc.queries[tablet.Alias.Uid], string(query))
+	return &querypb.QueryResult{RowsAffected: 1}, nil
+}
+
+// newTestLookupVindexDiffEnv creates the "owner" keyspace with the table t1, which owns the lookup
+// vindex val_idx of the given type on its column val, and the unsharded "lookup" keyspace with its lookup table lkp.
+func newTestLookupVindexDiffEnv(t *testing.T, vindexType string) *testVDiffEnv {
+	env := newTestVDiffEnv(nil, nil, "", nil)
+	ctx := context.Background()
+
+	env.addTablet(100, "owner", "-80", topodatapb.TabletType_PRIMARY)
+	env.addTablet(101, "owner", "-80", topodatapb.TabletType_REPLICA)
+	env.addTablet(110, "owner", "80-", topodatapb.TabletType_PRIMARY)
+	env.addTablet(111, "owner", "80-", topodatapb.TabletType_REPLICA)
+	env.addTablet(200, "lookup", "0", topodatapb.TabletType_PRIMARY)
+	env.addTablet(201, "lookup", "0", topodatapb.TabletType_REPLICA)
+
+	err := env.topoServ.SaveVSchema(ctx, "owner", &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+			"val_idx": {
+				Type:   vindexType,
+				Params: map[string]string{"table": "lookup.lkp", "from": "val", "to": "keyspace_id"},
+				Owner:  "t1",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{
+					{Name: "hash", Column: "id"},
+					{Name: "val_idx", Column: "val"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	err = env.topoServ.SaveVSchema(ctx, "lookup", &vschemapb.Keyspace{
+		Tables: map[string]*vschemapb.Table{"lkp": {}},
+	})
+	require.NoError(t, err)
+	return env
+}
+
+func TestLookupVindexDiff(t *testing.T) {
+	env := newTestLookupVindexDiffEnv(t, "lookup_unique")
+	defer env.close()
+	ctx := context.Background()
+
+	ksid := func(s string) sqltypes.Value {
+		b, err := hex.DecodeString(s)
+		require.NoError(t, err)
+		return sqltypes.MakeTrusted(sqltypes.VarBinary, b)
+	}
+	// The hash of 1, 2, 3 and 4.
+	ksid1, ksid2, ksid3, ksid4 := ksid("166b40b44aba4bd6"), ksid("06e7ea22ce92708f"), ksid("4eb190c9a2fa169c"), ksid("d2fd8867d50d2dfe")
+
+	ownerQuery := "select val, id from t1 order by val asc"
+	ownerFields := sqltypes.MakeTestFields("val|id", "varchar|int64")
+	env.tablets[101].setResults(ownerQuery, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields,
+		"a|1",
+		"c|3",
+		"d|2",
+	))
+	env.tablets[111].setResults(ownerQuery, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields,
+		"b|4",
+	))
+	lookupFields := sqltypes.MakeTestFields("val|keyspace_id", "varchar|varbinary")
+	env.tablets[201].setResults("select val, keyspace_id from lkp order by val asc", vdiffSourceGtid, []*sqltypes.Result{
+		{Fields: lookupFields},
+		{Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarChar("a"), ksid1},
+			{sqltypes.NewVarChar("b"), ksid4},
+			{sqltypes.NewVarChar("c"), ksid1},
+			{sqltypes.NewVarChar("z"), ksid3},
+		}},
+	})
+
+	tmc := newTestLookupVindexDiffTMClient(env.tmc,
+		"create table t1 (id bigint not null, val varchar(128), primary key (id)) engine InnoDB charset utf8mb4 collate utf8mb4_bin",
+		"create table lkp (val varchar(128) collate utf8mb4_bin not null, keyspace_id varbinary(128), primary key (val, keyspace_id)) engine InnoDB charset latin1",
+	)
+	wr := New(logutil.NewMemoryLogger(), env.topoServ, tmc)
+
+	dr, err := wr.LookupVindexDiff(ctx, "owner.val_idx", env.cell, "replica", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, 4, dr.ProcessedRows)
+	assert.Equal(t, 2, dr.MatchingRows)
+	assert.Equal(t, 1, dr.MissingRows)
+	assert.Equal(t, 1, dr.OrphanRows)
+	assert.Equal(t, 1, dr.MismatchedRows)
+	assert.Equal(t, 0, dr.RepairedRows)
+	assert.Empty(t, tmc.queries)
+
+	encode := func(v sqltypes.Value) string {
+		buf := &strings.Builder{}
+		v.EncodeSQLStringBuilder(buf)
+		return buf.String()
+	}
+	wantQueries := []string{
+		"update lkp set keyspace_id = " + encode(ksid3) + " where val = 'c' and keyspace_id = " + encode(ksid1),
+		"insert ignore into lkp(val, keyspace_id) values ('d', " + encode(ksid2) + ")",
+		"delete from lkp where val = 'z' and keyspace_id = " + encode(ksid3),
+	}
+	require.Len(t, dr.MismatchedRowsSample, 1)
+	assert.Equal(t, sqltypes.NewVarChar("c"), dr.MismatchedRowsSample[0].Source.Row["val"])
+	assert.Equal(t, sqltypes.NewInt64(3), dr.MismatchedRowsSample[0].Source.Row["id"])
+	assert.Equal(t, ksid1, dr.MismatchedRowsSample[0].Target.Row["keyspace_id"])
+	assert.Equal(t, wantQueries[0], dr.MismatchedRowsSample[0].Target.Query)
+	require.Len(t, dr.MissingRowsSample, 1)
+	assert.Equal(t, wantQueries[1], dr.MissingRowsSample[0].Query)
+	require.Len(t, dr.OrphanRowsSample, 1)
+	assert.Equal(t, wantQueries[2], dr.OrphanRowsSample[0].Query)
+
+	dr, err = wr.LookupVindexDiff(ctx, "owner.val_idx", env.cell, "replica", "json", true)
+	require.NoError(t, err)
+	assert.Equal(t, 3, dr.RepairedRows)
+	assert.Equal(t, map[uint32][]string{200: wantQueries}, tmc.queries)
+}
+
+// TestLookupVindexDiffNonUnique tests that the owner rows of a non-unique lookup vindex that have
+// the same 'from' values and keyspace id are compared with their single lookup row.
+func TestLookupVindexDiffNonUnique(t *testing.T) {
+	env := newTestLookupVindexDiffEnv(t, "lookup")
+	defer env.close()
+	ctx := context.Background()
+
+	ksid := func(s string) sqltypes.Value {
+		b, err := hex.DecodeString(s)
+		require.NoError(t, err)
+		return sqltypes.MakeTrusted(sqltypes.VarBinary, b)
+	}
+	// The hash of 1, 2 and 3.
+	ksid1, ksid2, ksid3 := ksid("166b40b44aba4bd6"), ksid("06e7ea22ce92708f"), ksid("4eb190c9a2fa169c")
+
+	ownerQuery := "select val, id from t1 order by val asc"
+	ownerFields := sqltypes.MakeTestFields("val|id", "varchar|int64")
+	env.tablets[101].setResults(ownerQuery, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields,
+		"a|1",
+		"a|1",
+		"c|1",
+		"c|1",
+		"d|2",
+		"d|2",
+	))
+	env.tablets[111].setResults(ownerQuery, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields))
+	lookupFields := sqltypes.MakeTestFields("val|keyspace_id", "varchar|varbinary")
+	env.tablets[201].setResults("select val, keyspace_id from lkp order by val asc", vdiffSourceGtid, []*sqltypes.Result{
+		{Fields: lookupFields},
+		{Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarChar("a"), ksid1},
+			{sqltypes.NewVarChar("c"), ksid3},
+		}},
+	})
+
+	tmc := newTestLookupVindexDiffTMClient(env.tmc,
+		"create table t1 (id bigint not null, val varchar(128), primary key (id)) engine InnoDB charset utf8mb4 collate utf8mb4_bin",
+		"create table lkp (val varchar(128) collate utf8mb4_bin not null, keyspace_id varbinary(128), primary key (val, keyspace_id)) engine InnoDB charset latin1",
+	)
+	wr := New(logutil.NewMemoryLogger(), env.topoServ, tmc)
+
+	dr, err := wr.LookupVindexDiff(ctx, "owner.val_idx", env.cell, "replica", "", true)
+	require.NoError(t, err)
+	assert.Equal(t, 6, dr.ProcessedRows)
+	assert.Equal(t, 2, dr.MatchingRows)
+	assert.Equal(t, 1, dr.MissingRows)
+	assert.Equal(t, 0, dr.OrphanRows)
+	assert.Equal(t, 1, dr.MismatchedRows)
+	assert.Equal(t, 2, dr.RepairedRows)
+
+	encode := func(v sqltypes.Value) string {
+		buf := &strings.Builder{}
+		v.EncodeSQLStringBuilder(buf)
+		return buf.String()
+	}
+	assert.Equal(t, map[uint32][]string{200: {
+		"update lkp set keyspace_id = " + encode(ksid1) + " where val = 'c' and keyspace_id = " + encode(ksid3),
+		"insert ignore into lkp(val, keyspace_id) values ('d', " + encode(ksid2) + ")",
+	}}, tmc.queries)
+}
+
+// TestLookupVindexDiffCollation tests that the 'from' values are ordered and compared
+// with the collation of their columns.
+func TestLookupVindexDiffCollation(t *testing.T) {
+	env := newTestLookupVindexDiffEnv(t, "lookup_unique")
+	defer env.close()
+	ctx := context.Background()
+
+	ksid := func(s string) sqltypes.Value {
+		b, err := hex.DecodeString(s)
+		require.NoError(t, err)
+		return sqltypes.MakeTrusted(sqltypes.VarBinary, b)
+	}
+	// The hash of 1, 3 and 4.
+	ksid1, ksid3, ksid4 := ksid("166b40b44aba4bd6"), ksid("4eb190c9a2fa169c"), ksid("d2fd8867d50d2dfe")
+
+	// The rows are in the case-insensitive order of utf8mb4_0900_ai_ci, where "B" sorts
+	// between "a" and "c" and where "a" and "A" are equal.
+	ownerQuery := "select val, id from t1 order by val asc"
+	ownerFields := sqltypes.MakeTestFields("val|id", "varchar|int64")
+	env.tablets[101].setResults(ownerQuery, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields,
+		"a|1",
+		"c|3",
+	))
+	env.tablets[111].setResults(ownerQuery, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(ownerFields,
+		"B|4",
+	))
+	lookupFields := sqltypes.MakeTestFields("val|keyspace_id", "varchar|varbinary")
+	env.tablets[201].setResults("select val, keyspace_id from lkp order by val asc", vdiffSourceGtid, []*sqltypes.Result{
+		{Fields: lookupFields},
+		{Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarChar("A"), ksid1},
+			{sqltypes.NewVarChar("b"), ksid4},
+			{sqltypes.NewVarChar("C"), ksid3},
+		}},
+	})
+
+	tmc := newTestLookupVindexDiffTMClient(env.tmc,
+		"create table t1 (id bigint not null, val varchar(128), primary key (id)) engine InnoDB default charset=utf8mb4 collate=utf8mb4_0900_ai_ci",
+		"create table lkp (val varchar(128) collate utf8mb4_0900_ai_ci not null, keyspace_id varbinary(128), primary key (val, keyspace_id)) engine InnoDB default charset=utf8mb4 collate=utf8mb4_bin",
+	)
+	wr := New(logutil.NewMemoryLogger(), env.topoServ, tmc)
+
+	dr, err := wr.LookupVindexDiff(ctx, "owner.val_idx", env.cell, "replica", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, 3, dr.ProcessedRows)
+	assert.Equal(t, 3, dr.MatchingRows)
+	assert.Equal(t, 0, dr.MissingRows)
+	assert.Equal(t, 0, dr.OrphanRows)
+	assert.Equal(t, 0, dr.MismatchedRows)
+
+	// The rows of columns with different collations are not ordered the same way.
+	tmc = newTestLookupVindexDiffTMClient(env.tmc,
+		"create table t1 (id bigint not null, val varchar(128), primary key (id)) engine InnoDB default charset=utf8mb4 collate=utf8mb4_0900_ai_ci",
+		"create table lkp (val varbinary(128) not null, keyspace_id varbinary(128), primary key (val, keyspace_id)) engine InnoDB default charset=utf8mb4",
+	)
+	wr = New(logutil.NewMemoryLogger(), env.topoServ, tmc)
+	_, err = wr.LookupVindexDiff(ctx, "owner.val_idx", env.cell, "replica", "", false)
+	assert.EqualError(t, err, "column val of owner table owner.t1 has collation utf8mb4_0900_ai_ci, but column val of lookup table lookup.lkp has collation binary: they cannot be compared")
+}
+
+func TestLookupVindexDiffErrors(t *testing.T) {
+	env := newTestVDiffEnv(nil, nil, "", nil)
+	defer env.close()
+	ctx := context.Background()
+
+	err := env.topoServ.SaveVSchema(ctx, "owner", &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+			"unowned": {
+				Type:   "lookup_unique",
+				Params: map[string]string{"table": "lookup.lkp", "from": "val", "to": "keyspace_id"},
+			},
+			"lookup_hash": {
+				Type:   "lookup_hash_unique",
+				Params: map[string]string{"table": "lookup.lkp", "from": "val", "to": "keyspace_id"},
+				Owner:  "t1",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	testcases := []struct {
+		vindex  string
+		wantErr string
+	}{{
+		vindex:  "owner",
+		wantErr: "vindex name should be of the form keyspace.vindex: owner",
+	}, {
+		vindex:  "owner.none",
+		wantErr: "vindex owner.none not found in vschema",
+	}, {
+		vindex:  "owner.hash",
+		wantErr: "vindex owner.hash is of type hash: only lookup vindexes that store keyspace ids can be compared",
+	}, {
+		vindex:  "owner.lookup_hash",
+		wantErr: "vindex owner.lookup_hash is of type lookup_hash_unique: only lookup vindexes that store keyspace ids can be compared",
+	}, {
+		vindex:  "owner.unowned",
+		wantErr: "vindex owner.unowned has no owner table",
+	}}
+	for _, tcase := range testcases {
+		_, err := env.wr.LookupVindexDiff(ctx, tcase.vindex, env.cell, "replica", "", false)
+		assert.EqualError(t, err, tcase.wantErr, tcase.vindex)
+	}
+}
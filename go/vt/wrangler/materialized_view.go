@@ -0,0 +1,426 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// Materialized views are materialize workflows named after the view. Their streams
+// are tagged with schema.MaterializedViewTag so that they can be told apart from
+// other workflows, and listed by SHOW MATERIALIZED VIEWS.
+
+// CreateMaterializedView creates the table of a materialized view and the workflow
+// that keeps it up to date with the table the view selects from.
+func (wr *Wrangler) CreateMaterializedView(ctx context.Context, mv *schema.MaterializedView) error {
+	stmt, err := mv.Statement()
+	if err != nil {
+		return err
+	}
+	sel, ok := stmt.Select.(*sqlparser.Select)
+	if !ok {
+		return fmt.Errorf("materialized view must be a simple select: %s", sqlparser.String(stmt.Select))
+	}
+	sourceTable, err := materializedViewSourceTable(sel)
+	if err != nil {
+		return err
+	}
+	sourceDDL, err := wr.getTableDDL(ctx, mv.SourceKeyspace, sourceTable)
+	if err != nil {
+		return err
+	}
+	createDDL, err := materializedViewDDL(mv.Name, sel, sourceDDL)
+	if err != nil {
+		return err
+	}
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       mv.Name,
+		SourceKeyspace: mv.SourceKeyspace,
+		TargetKeyspace: mv.Keyspace,
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      mv.Name,
+			SourceExpression: sqlparser.String(sel),
+			CreateDdl:        createDDL,
+		}},
+	}
+	mz, err := wr.prepareMaterializerStreams(ctx, ms)
+	if err != nil {
+		return err
+	}
+	err = mz.forAllTargets(func(target *topo.ShardInfo) error {
+		primary, err := wr.ts.GetTablet(ctx, target.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		return wr.tagWorkflowStreams(ctx, primary, mv.Name, schema.MaterializedViewTag)
+	})
+	if err != nil {
+		return err
+	}
+	return mz.startStreams(ctx)
+}
+
+// DropMaterializedView deletes the workflow of a materialized view and drops its table.
+func (wr *Wrangler) DropMaterializedView(ctx context.Context, mv *schema.MaterializedView) error {
+	stmt, err := mv.Statement()
+	if err != nil {
+		return err
+	}
+	mz, err := wr.materializedViewTargets(ctx, mv)
+	if err != nil {
+		if stmt.IfExists && isMaterializedViewNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return mz.forAllTargets(func(target *topo.ShardInfo) error {
+		primary, err := wr.ts.GetTablet(ctx, target.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(primary.DbName()), encodeString(mv.Name))
+		if _, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, query); err != nil {
+			return err
+		}
+		query = fmt.Sprintf("drop table if exists %s.%s",
+			sqlescape.EscapeID(sqlescape.UnescapeID(primary.DbName())),
+			sqlescape.EscapeID(mv.Name))
+		_, err = wr.ExecuteFetchAsDba(ctx, primary.Alias, query, 1, false, true)
+		return err
+	})
+}
+
+// RefreshMaterializedView recomputes a materialized view from scratch: the streams of its
+// workflow are recreated and copy the source table again into the emptied view table.
+func (wr *Wrangler) RefreshMaterializedView(ctx context.Context, mv *schema.MaterializedView) error {
+	mz, err := wr.materializedViewTargets(ctx, mv)
+	if err != nil {
+		return err
+	}
+	return mz.forAllTargets(func(target *topo.ShardInfo) error {
+		primary, err := wr.ts.GetTablet(ctx, target.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		dbName := primary.DbName()
+		query := fmt.Sprintf("select source from _vt.vreplication where db_name=%s and workflow=%s", encodeString(dbName), encodeString(mv.Name))
+		p3qr, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, query)
+		if err != nil {
+			return err
+		}
+		qr := sqltypes.Proto3ToResult(p3qr)
+		sources := make([]*binlogdatapb.BinlogSource, 0, len(qr.Rows))
+		for _, row := range qr.Rows {
+			sourceBytes, err := row[0].ToBytes()
+			if err != nil {
+				return err
+			}
+			bls := &binlogdatapb.BinlogSource{}
+			if err := prototext.Unmarshal(sourceBytes, bls); err != nil {
+				return err
+			}
+			sources = append(sources, bls)
+		}
+
+		query = fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(dbName), encodeString(mv.Name))
+		if _, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, query); err != nil {
+			return err
+		}
+		query = fmt.Sprintf("truncate table %s.%s",
+			sqlescape.EscapeID(sqlescape.UnescapeID(dbName)),
+			sqlescape.EscapeID(mv.Name))
+		if _, err := wr.ExecuteFetchAsDba(ctx, primary.Alias, query, 1, false, false); err != nil {
+			return err
+		}
+		for _, bls := range sources {
+			query := binlogplayer.CreateVReplicationState(mv.Name, bls, "", binlogplayer.BlpStopped, dbName)
+			if _, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, query); err != nil {
+				return err
+			}
+		}
+		if err := wr.tagWorkflowStreams(ctx, primary, mv.Name, schema.MaterializedViewTag); err != nil {
+			return err
+		}
+		query = fmt.Sprintf("update _vt.vreplication set state='Running' where db_name=%s and workflow=%s", encodeString(dbName), encodeString(mv.Name))
+		_, err = wr.tmc.VReplicationExec(ctx, primary.Tablet, query)
+		return err
+	})
+}
+
+// materializedViewTargets returns a materializer over the target shards of the
+// materialized view. It fails if the view does not exist.
+func (wr *Wrangler) materializedViewTargets(ctx context.Context, mv *schema.MaterializedView) (*materializer, error) {
+	targetShards, err := wr.ts.GetServingShards(ctx, mv.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+	mz := &materializer{
+		wr:           wr,
+		targetShards: targetShards,
+	}
+	found := false
+	for _, target := range targetShards {
+		primary, err := wr.ts.GetTablet(ctx, target.PrimaryAlias)
+		if err != nil {
+			return nil, err
+		}
+		query := fmt.Sprintf("select 1 from _vt.vreplication where db_name=%s and workflow=%s and tags=%s",
+			encodeString(primary.DbName()), encodeString(mv.Name), encodeString(schema.MaterializedViewTag))
+		p3qr, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, query)
+		if err != nil {
+			return nil, err
+		}
+		if len(p3qr.Rows) != 0 {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("%s %s.%s", errMaterializedViewNotFound, mv.Keyspace, mv.Name)
+	}
+	return mz, nil
+}
+
+const errMaterializedViewNotFound = "materialized view not found:"
+
+func isMaterializedViewNotFound(err error) bool {
+	return strings.HasPrefix(err.Error(), errMaterializedViewNotFound)
+}
+
+// tagWorkflowStreams sets the tags of the streams of a workflow on the given primary.
+func (wr *Wrangler) tagWorkflowStreams(ctx context.Context, primary *topo.TabletInfo, workflow, tag string) error {
+	query := fmt.Sprintf("update _vt.vreplication set tags=%s where db_name=%s and workflow=%s",
+		encodeString(tag), encodeString(primary.DbName()), encodeString(workflow))
+	_, err := wr.tmc.VReplicationExec(ctx, primary.Tablet, query)
+	return err
+}
+
+// getTableDDL returns the CREATE TABLE statement of a table, as found on the primary
+// of the first serving shard of the keyspace.
+func (wr *Wrangler) getTableDDL(ctx context.Context, keyspace, table string) (string, error) {
+	shards, err := wr.sourceTs.GetServingShards(ctx, keyspace)
+	if err != nil {
+		return "", err
+	}
+	if shards[0].PrimaryAlias == nil {
+		return "", fmt.Errorf("shard must have a primary for reading the schema: %v", shards[0].ShardName())
+	}
+	ti, err := wr.sourceTs.GetTablet(ctx, shards[0].PrimaryAlias)
+	if err != nil {
+		return "", err
+	}
+	sd, err := wr.tmc.GetSchema(ctx, ti.Tablet, []string{table}, nil, false)
+	if err != nil {
+		return "", err
+	}
+	if len(sd.TableDefinitions) == 0 {
+		return "", fmt.Errorf("table %s not found in keyspace %s", table, keyspace)
+	}
+	return sd.TableDefinitions[0].Schema, nil
+}
+
+// materializedViewSourceTable returns the table the view selects from. It removes the
+// keyspace qualifier from the select, since the vreplication filter runs against the
+// source database.
+func materializedViewSourceTable(sel *sqlparser.Select) (string, error) {
+	if len(sel.From) != 1 {
+		return "", fmt.Errorf("materialized view must select from a single table: %s", sqlparser.String(sel))
+	}
+	tableExpr, ok := sel.From[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return "", fmt.Errorf("materialized view must select from a single table: %s", sqlparser.String(sel))
+	}
+	tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+	if !ok {
+		return "", fmt.Errorf("materialized view must select from a single table: %s", sqlparser.String(sel))
+	}
+	tableExpr.Expr = sqlparser.TableName{Name: tableName.Name}
+	return tableName.Name.String(), nil
+}
+
+// materializedViewDDL builds the CREATE TABLE statement of a materialized view from its
+// select and the CREATE TABLE statement of the table it selects from. The grouping
+// columns are the primary key of the view. Without GROUP BY, the view must select the
+// primary key columns of the source table, which are then its primary key.
+func materializedViewDDL(name string, sel *sqlparser.Select, sourceDDL string) (string, error) {
+	stmt, err := sqlparser.ParseStrictDDL(sourceDDL)
+	if err != nil {
+		return "", err
+	}
+	source, ok := stmt.(*sqlparser.CreateTable)
+	if !ok || source.TableSpec == nil {
+		return "", fmt.Errorf("unexpected source table definition: %s", sourceDDL)
+	}
+	sourceCols := make(map[string]*sqlparser.ColumnDefinition, len(source.TableSpec.Columns))
+	for _, col := range source.TableSpec.Columns {
+		sourceCols[col.Name.Lowered()] = col
+	}
+	sourceColumnType := func(expr sqlparser.Expr) (sqlparser.ColumnType, error) {
+		colName, ok := expr.(*sqlparser.ColName)
+		if !ok {
+			return sqlparser.ColumnType{}, fmt.Errorf("materialized view only supports columns and count, sum, min and max of columns: %s", sqlparser.String(expr))
+		}
+		col, ok := sourceCols[colName.Name.Lowered()]
+		if !ok {
+			return sqlparser.ColumnType{}, fmt.Errorf("column %s not found in %s", sqlparser.String(colName), sqlparser.String(source.Table))
+		}
+		ct := col.Type
+		ct.Options = &sqlparser.ColumnTypeOptions{}
+		if col.Type.Options != nil {
+			ct.Options.Collate = col.Type.Options.Collate
+		}
+		return ct, nil
+	}
+
+	var pkCols []sqlparser.ColIdent
+	if len(sel.GroupBy) == 0 {
+		for _, index := range source.TableSpec.Indexes {
+			if !index.Info.Primary {
+				continue
+			}
+			for _, col := range index.Columns {
+				pkCols = append(pkCols, col.Column)
+			}
+		}
+	} else {
+		for _, expr := range sel.GroupBy {
+			colName, ok := expr.(*sqlparser.ColName)
+			if !ok {
+				return "", fmt.Errorf("materialized view can only group by columns: %s", sqlparser.String(expr))
+			}
+			pkCols = append(pkCols, colName.Name)
+		}
+	}
+	isPK := func(col sqlparser.ColIdent) bool {
+		for _, pkCol := range pkCols {
+			if pkCol.Equal(col) {
+				return true
+			}
+		}
+		return false
+	}
+
+	spec := &sqlparser.TableSpec{}
+	for _, selExpr := range sel.SelectExprs {
+		aliased, ok := selExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return "", fmt.Errorf("materialized view must list its columns: %s", sqlparser.String(selExpr))
+		}
+		col := &sqlparser.ColumnDefinition{Name: aliased.As}
+		switch expr := aliased.Expr.(type) {
+		case *sqlparser.ColName:
+			if col.Name.IsEmpty() {
+				col.Name = expr.Name
+			}
+			if col.Type, err = sourceColumnType(expr); err != nil {
+				return "", err
+			}
+			if isPK(col.Name) {
+				notNull := false
+				col.Type.Options.Null = &notNull
+			}
+		case *sqlparser.FuncExpr:
+			if col.Name.IsEmpty() {
+				return "", fmt.Errorf("expression needs an alias: %s", sqlparser.String(aliased))
+			}
+			if len(sel.GroupBy) == 0 {
+				return "", fmt.Errorf("materialized view needs a group by for aggregate: %s", sqlparser.String(aliased))
+			}
+			var inner sqlparser.Expr
+			if len(expr.Exprs) == 1 {
+				if innerExpr, ok := expr.Exprs[0].(*sqlparser.AliasedExpr); ok {
+					inner = innerExpr.Expr
+				}
+			}
+			switch expr.Name.Lowered() {
+			case "count":
+				col.Type = sqlparser.ColumnType{Type: "bigint", Options: &sqlparser.ColumnTypeOptions{}}
+			case "sum":
+				ct, err := sourceColumnType(inner)
+				if err != nil {
+					return "", err
+				}
+				col.Type = sumColumnType(ct)
+			case "min", "max":
+				if col.Type, err = sourceColumnType(inner); err != nil {
+					return "", err
+				}
+			default:
+				return "", fmt.Errorf("materialized view only supports count, sum, min and max: %s", sqlparser.String(expr))
+			}
+		default:
+			return "", fmt.Errorf("materialized view only supports columns and count, sum, min and max of columns: %s", sqlparser.String(aliased))
+		}
+		spec.Columns = append(spec.Columns, col)
+	}
+
+	if len(pkCols) == 0 {
+		return "", fmt.Errorf("materialized view needs a group by or the primary key of %s", sqlparser.String(source.Table))
+	}
+	pk := &sqlparser.IndexDefinition{
+		Info: &sqlparser.IndexInfo{Type: "primary key", Name: sqlparser.NewColIdent("PRIMARY"), Primary: true, Unique: true},
+	}
+	for _, pkCol := range pkCols {
+		found := false
+		for _, col := range spec.Columns {
+			if col.Name.Equal(pkCol) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", fmt.Errorf("materialized view must select %s", sqlparser.String(pkCol))
+		}
+		pk.Columns = append(pk.Columns, &sqlparser.IndexColumn{Column: pkCol})
+	}
+	spec.Indexes = append(spec.Indexes, pk)
+
+	return sqlparser.String(&sqlparser.CreateTable{
+		Table:     sqlparser.TableName{Name: sqlparser.NewTableIdent(name)},
+		TableSpec: spec,
+	}), nil
+}
+
+// sumColumnType returns the type of the sum of a column of type ct. Like in MySQL,
+// the sum of approximate values is a double, and other sums are exact.
+func sumColumnType(ct sqlparser.ColumnType) sqlparser.ColumnType {
+	switch strings.ToLower(ct.Type) {
+	case "float", "double", "real":
+		return sqlparser.ColumnType{Type: "double", Options: &sqlparser.ColumnTypeOptions{}}
+	case "decimal", "numeric":
+		scale := ct.Scale
+		if scale == nil {
+			scale = sqlparser.NewIntLiteral("0")
+		}
+		return sqlparser.ColumnType{Type: "decimal", Length: sqlparser.NewIntLiteral("65"), Scale: scale, Options: &sqlparser.ColumnTypeOptions{}}
+	}
+	return sqlparser.ColumnType{Type: "decimal", Length: sqlparser.NewIntLiteral("65"), Scale: sqlparser.NewIntLiteral("0"), Options: &sqlparser.ColumnTypeOptions{}}
+}
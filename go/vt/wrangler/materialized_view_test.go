@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestMaterializedViewDDL(t *testing.T) {
+	sourceDDL := "CREATE TABLE `orders` (\n" +
+		"  `id` bigint NOT NULL,\n" +
+		"  `customer_id` bigint NOT NULL,\n" +
+		"  `price` decimal(10,2) DEFAULT NULL,\n" +
+		"  `weight` double DEFAULT NULL,\n" +
+		"  `qty` int DEFAULT NULL,\n" +
+		"  `note` varchar(64) COLLATE utf8mb4_bin DEFAULT NULL,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"
+
+	testcases := []struct {
+		sel         string
+		sourceTable string
+		filter      string
+		ddl         string
+		err         string
+	}{{
+		sel:         "select customer_id, count(*) as cnt, sum(price) as total, sum(weight) as weight, sum(qty) as qty, min(note) as first_note, max(price) as top from ks.orders group by customer_id",
+		sourceTable: "orders",
+		filter:      "select customer_id, count(*) as cnt, sum(price) as total, sum(weight) as weight, sum(qty) as qty, min(note) as first_note, max(price) as top from orders group by customer_id",
+		ddl: "create table mv (\n" +
+			"\tcustomer_id bigint not null,\n" +
+			"\tcnt bigint,\n" +
+			"\ttotal decimal(65,2),\n" +
+			"\tweight double,\n" +
+			"\tqty decimal(65,0),\n" +
+			"\tfirst_note varchar(64) collate utf8mb4_bin,\n" +
+			"\ttop decimal(10,2),\n" +
+			"\tprimary key (customer_id)\n" +
+			")",
+	}, {
+		sel:         "select id, note from orders",
+		sourceTable: "orders",
+		filter:      "select id, note from orders",
+		ddl: "create table mv (\n" +
+			"\tid bigint not null,\n" +
+			"\tnote varchar(64) collate utf8mb4_bin,\n" +
+			"\tprimary key (id)\n" +
+			")",
+	}, {
+		sel:         "select customer_id, count(*) from orders group by customer_id",
+		sourceTable: "orders",
+		err:         "expression needs an alias: count(*)",
+	}, {
+		sel:         "select customer_id, avg(price) as a from orders group by customer_id",
+		sourceTable: "orders",
+		err:         "materialized view only supports count, sum, min and max: avg(price)",
+	}, {
+		sel:         "select customer_id, sum(price) as total from orders",
+		sourceTable: "orders",
+		err:         "materialized view needs a group by for aggregate: sum(price) as total",
+	}, {
+		sel:         "select note from orders",
+		sourceTable: "orders",
+		err:         "materialized view must select id",
+	}, {
+		sel:         "select customer_id, min(unknown) as m from orders group by customer_id",
+		sourceTable: "orders",
+		err:         "column unknown not found in orders",
+	}, {
+		sel: "select customer_id from orders join customer on orders.customer_id = customer.id",
+		err: "materialized view must select from a single table: select customer_id from orders join customer on orders.customer_id = customer.id",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.sel, func(t *testing.T) {
+			stmt, err := sqlparser.Parse(tc.sel)
+			require.NoError(t, err)
+			sel := stmt.(*sqlparser.Select)
+			sourceTable, err := materializedViewSourceTable(sel)
+			if tc.sourceTable == "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.sourceTable, sourceTable)
+
+			ddl, err := materializedViewDDL("mv", sel, sourceDDL)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.filter, sqlparser.String(sel))
+			assert.Equal(t, tc.ddl, ddl)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2022 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+
+	"vitess.io/vitess/go/sqlescape"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/topo"
+
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+// CreateReferenceTableCopy creates the materialize workflow that copies a global reference
+// table to another keyspace. Its streams are tagged with schema.ReferenceTableTag, which
+// is how vtgate finds them to check the lag of the copy.
+func (wr *Wrangler) CreateReferenceTableCopy(ctx context.Context, rt *schema.ReferenceTable) error {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       rt.Workflow(),
+		SourceKeyspace: rt.SourceKeyspace,
+		TargetKeyspace: rt.Keyspace,
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      rt.Table,
+			SourceExpression: fmt.Sprintf("select * from %s", sqlescape.EscapeID(rt.Table)),
+			CreateDdl:        createDDLAsCopy,
+		}},
+	}
+	mz, err := wr.prepareMaterializerStreams(ctx, ms)
+	if err != nil {
+		return err
+	}
+	err = mz.forAllTargets(func(target *topo.ShardInfo) error {
+		primary, err := wr.ts.GetTablet(ctx, target.PrimaryAlias)
+		if err != nil {
+			return err
+		}
+		return wr.tagWorkflowStreams(ctx, primary, rt.Workflow(), schema.ReferenceTableTag)
+	})
+	if err != nil {
+		return err
+	}
+	return mz.startStreams(ctx)
+}
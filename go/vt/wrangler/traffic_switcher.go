@@ -1166,7 +1166,12 @@ func (ts *trafficSwitcher) createReverseVReplication(ctx context.Context) error
 					}
 					// TODO(sougou): handle degenerate cases like sequence, etc.
 					// We currently assume the primary vindex is the best way to filter, which may not be true.
-					inKeyrange = fmt.Sprintf(" where in_keyrange(%s, '%s.%s', '%s')", sqlparser.String(vtable.ColumnVindexes[0].Columns[0]), ts.SourceKeyspaceName(), vtable.ColumnVindexes[0].Name, key.KeyRangeString(source.GetShard().KeyRange))
+					// A multi-column vindex needs all its columns.
+					cols := make([]string, 0, len(vtable.ColumnVindexes[0].Columns))
+					for _, col := range vtable.ColumnVindexes[0].Columns {
+						cols = append(cols, sqlparser.String(col))
+					}
+					inKeyrange = fmt.Sprintf(" where in_keyrange(%s, '%s.%s', '%s')", strings.Join(cols, ", "), ts.SourceKeyspaceName(), vtable.ColumnVindexes[0].Name, key.KeyRangeString(source.GetShard().KeyRange))
 				}
 				filter = fmt.Sprintf("select * from %s%s", sqlescape.EscapeID(rule.Match), inKeyrange)
 			}
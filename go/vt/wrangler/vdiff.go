@@ -608,7 +608,7 @@ func (df *vdiff) selectTablets(ctx context.Context, ts *trafficSwitcher) error {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err1 = df.forAll(df.sources, func(shard string, source *shardStreamer) error {
+		err1 = forAllStreamers(df.sources, func(shard string, source *shardStreamer) error {
 			sourceTopo := df.ts.TopoServer()
 			if ts.ExternalTopo() != nil {
 				sourceTopo = ts.ExternalTopo()
@@ -630,7 +630,7 @@ func (df *vdiff) selectTablets(ctx context.Context, ts *trafficSwitcher) error {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		err2 = df.forAll(df.targets, func(shard string, target *shardStreamer) error {
+		err2 = forAllStreamers(df.targets, func(shard string, target *shardStreamer) error {
 			tp, err := discovery.NewTabletPicker(df.ts.TopoServer(), []string{df.targetCell}, df.ts.TargetKeyspaceName(), shard, df.tabletTypesStr)
 			if err != nil {
 				return err
@@ -656,7 +656,7 @@ func (df *vdiff) selectTablets(ctx context.Context, ts *trafficSwitcher) error {
 func (df *vdiff) stopTargets(ctx context.Context) error {
 	var mu sync.Mutex
 
-	err := df.forAll(df.targets, func(shard string, target *shardStreamer) error {
+	err := forAllStreamers(df.targets, func(shard string, target *shardStreamer) error {
 		query := fmt.Sprintf("update _vt.vreplication set state='Stopped', message='for vdiff' where db_name=%s and workflow=%s", encodeString(target.primary.DbName()), encodeString(df.ts.WorkflowName()))
 		_, err := df.ts.TabletManagerClient().VReplicationExec(ctx, target.primary.Tablet, query)
 		if err != nil {
@@ -711,7 +711,7 @@ func (df *vdiff) stopTargets(ctx context.Context) error {
 func (df *vdiff) startQueryStreams(ctx context.Context, keyspace string, participants map[string]*shardStreamer, query string, filteredReplicationWaitTime time.Duration) error {
 	waitCtx, cancel := context.WithTimeout(ctx, filteredReplicationWaitTime)
 	defer cancel()
-	return df.forAll(participants, func(shard string, participant *shardStreamer) error {
+	return forAllStreamers(participants, func(shard string, participant *shardStreamer) error {
 		// Iteration for each participant.
 		if participant.position.IsZero() {
 			return fmt.Errorf("workflow %s.%s: stream has not started on tablet %s", df.targetKeyspace, df.workflow, participant.primary.Alias.String())
@@ -725,7 +725,7 @@ func (df *vdiff) startQueryStreams(ctx context.Context, keyspace string, partici
 		gtidch := make(chan string, 1)
 
 		// Start the stream in a separate goroutine.
-		go df.streamOne(ctx, keyspace, shard, participant, query, gtidch)
+		go streamOne(ctx, keyspace, shard, participant, query, gtidch)
 
 		// Wait for the gtid to be sent. If it's not received, there was an error
 		// which would be stored in participant.err.
@@ -745,7 +745,7 @@ func (df *vdiff) startQueryStreams(ctx context.Context, keyspace string, partici
 // Before returning, it sets participant.err, and closes all channels.
 // If any channel is closed, then participant.err can be checked if there was an error.
 // The shardStreamer's StreamExecute consumes the result channel.
-func (df *vdiff) streamOne(ctx context.Context, keyspace, shard string, participant *shardStreamer, query string, gtidch chan string) {
+func streamOne(ctx context.Context, keyspace, shard string, participant *shardStreamer, query string, gtidch chan string) {
 	defer close(participant.result)
 	defer close(gtidch)
 
@@ -809,7 +809,7 @@ func (df *vdiff) syncTargets(ctx context.Context, filteredReplicationWaitTime ti
 		return err
 	}
 
-	err = df.forAll(df.targets, func(shard string, target *shardStreamer) error {
+	err = forAllStreamers(df.targets, func(shard string, target *shardStreamer) error {
 		pos, err := df.ts.TabletManagerClient().PrimaryPosition(ctx, target.primary.Tablet)
 		if err != nil {
 			return err
@@ -826,7 +826,7 @@ func (df *vdiff) syncTargets(ctx context.Context, filteredReplicationWaitTime ti
 
 // restartTargets restarts the stopped target vreplication streams.
 func (df *vdiff) restartTargets(ctx context.Context) error {
-	return df.forAll(df.targets, func(shard string, target *shardStreamer) error {
+	return forAllStreamers(df.targets, func(shard string, target *shardStreamer) error {
 		query := fmt.Sprintf("update _vt.vreplication set state='Running', message='', stop_pos='' where db_name=%s and workflow=%s", encodeString(target.primary.DbName()), encodeString(df.ts.WorkflowName()))
 		log.Infof("restarting target replication with %s", query)
 		_, err := df.ts.TabletManagerClient().VReplicationExec(ctx, target.primary.Tablet, query)
@@ -834,7 +834,8 @@ func (df *vdiff) restartTargets(ctx context.Context) error {
 	})
 }
 
-func (df *vdiff) forAll(participants map[string]*shardStreamer, f func(string, *shardStreamer) error) error {
+// forAllStreamers runs f for all the participants in parallel, and aggregates the errors.
+func forAllStreamers(participants map[string]*shardStreamer, f func(string, *shardStreamer) error) error {
 	var wg sync.WaitGroup
 	allErrors := &concurrency.AllErrorRecorder{}
 	for shard, participant := range participants {
@@ -27642,6 +27642,9 @@ export namespace vschema {
 
         /** Table column_list_authoritative */
         column_list_authoritative?: (boolean|null);
+
+        /** Table result_cache */
+        result_cache?: (boolean|null);
     }
 
     /** Represents a Table. */
@@ -27671,6 +27674,9 @@ export namespace vschema {
         /** Table column_list_authoritative. */
         public column_list_authoritative: boolean;
 
+        /** Table result_cache. */
+        public result_cache: boolean;
+
         /**
          * Creates a new Table instance using the specified properties.
          * @param [properties] Properties to set